@@ -13,6 +13,7 @@ import (
 	"github.com/SscSPs/money_managemet_app/internal/platform/config"
 	"github.com/SscSPs/money_managemet_app/internal/platform/database"
 	"github.com/SscSPs/money_managemet_app/internal/repositories/database/pgsql"
+	"github.com/SscSPs/money_managemet_app/internal/repositories/pricefile"
 	"github.com/SscSPs/money_managemet_app/internal/utils"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -65,6 +66,9 @@ func main() {
 	// --- Dependency Injection Setup ---
 	logger.Info("Initializing repositories...")
 	repoProvider := pgsql.NewRepositoryProvider(dbPool)
+	if cfg.PriceFilePath != "" {
+		repoProvider.PriceProvider = pricefile.NewLocalFileProvider(cfg.PriceFilePath)
+	}
 
 	// Create Service Container
 	logger.Info("Initializing services...")
@@ -15,6 +15,16 @@ const (
 	Expense   AccountType = "EXPENSE"
 )
 
+// CashFlowCategory classifies an account for the cash flow statement.
+type CashFlowCategory string
+
+const (
+	CashFlowCash      CashFlowCategory = "CASH"      // Cash and cash equivalents (ASSET accounts only)
+	CashFlowOperating CashFlowCategory = "OPERATING" // Working-capital accounts
+	CashFlowInvesting CashFlowCategory = "INVESTING" // Long-term assets such as property or investments
+	CashFlowFinancing CashFlowCategory = "FINANCING" // Loans, owner contributions and drawings
+)
+
 // Account represents a financial account within the core domain.
 // This is the primary representation used by services.
 type Account struct {
@@ -29,4 +39,23 @@ type Account struct {
 	IsActive        bool            `json:"isActive"`        // Soft delete or status flag
 	AuditFields                     // Embed CreatedAt, CreatedBy, etc.
 	Balance         decimal.Decimal `json:"balance"` // Added: Persisted account balance
+	// CashFlowCategory is optional; empty means the default for the account type is used.
+	CashFlowCategory CashFlowCategory `json:"cashFlowCategory,omitempty"`
+}
+
+// EffectiveCashFlowCategory returns the cash flow classification of the account,
+// falling back to OPERATING for assets/liabilities and FINANCING for equity when none is set.
+// Revenue and expense accounts are not classified and return an empty category.
+func (a Account) EffectiveCashFlowCategory() CashFlowCategory {
+	if a.CashFlowCategory != "" {
+		return a.CashFlowCategory
+	}
+	switch a.AccountType {
+	case Asset, Liability:
+		return CashFlowOperating
+	case Equity:
+		return CashFlowFinancing
+	default:
+		return ""
+	}
 }
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AgingBuckets splits outstanding amounts by the number of days they are past their due date
+type AgingBuckets struct {
+	NotDue     decimal.Decimal `json:"notDue"`
+	Days0To30  decimal.Decimal `json:"days0To30"` // Due today up to 30 days overdue
+	Days31To60 decimal.Decimal `json:"days31To60"`
+	Days61To90 decimal.Decimal `json:"days61To90"`
+	Over90     decimal.Decimal `json:"over90"`
+	Total      decimal.Decimal `json:"total"`
+}
+
+// Add puts an amount in the bucket for the given number of days past due; negative days are not yet due
+func (b *AgingBuckets) Add(daysPastDue int, amount decimal.Decimal) {
+	switch {
+	case daysPastDue < 0:
+		b.NotDue = b.NotDue.Add(amount)
+	case daysPastDue <= 30:
+		b.Days0To30 = b.Days0To30.Add(amount)
+	case daysPastDue <= 60:
+		b.Days31To60 = b.Days31To60.Add(amount)
+	case daysPastDue <= 90:
+		b.Days61To90 = b.Days61To90.Add(amount)
+	default:
+		b.Over90 = b.Over90.Add(amount)
+	}
+	b.Total = b.Total.Add(amount)
+}
+
+// DaysPastDue returns the whole days from a due date to a date; negative before the due date
+func DaysPastDue(dueDate, asOf time.Time) int {
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	on := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+	return int(on.Sub(due).Hours() / 24)
+}
+
+// AgedItem is a document, such as an invoice or a bill, with an amount still outstanding
+type AgedItem struct {
+	DocumentID       string          `json:"documentID"`
+	DocumentNumber   string          `json:"documentNumber"`
+	CounterpartyID   string          `json:"counterpartyID"`
+	CounterpartyName string          `json:"counterpartyName"`
+	CurrencyCode     string          `json:"currencyCode"`
+	IssueDate        time.Time       `json:"issueDate"`
+	DueDate          time.Time       `json:"dueDate"`
+	DaysPastDue      int             `json:"daysPastDue"` // Negative when not yet due
+	Total            decimal.Decimal `json:"total"`
+	Outstanding      decimal.Decimal `json:"outstanding"`
+}
+
+// AgedBalance totals the outstanding documents of one counterparty in one currency
+type AgedBalance struct {
+	CounterpartyID   string       `json:"counterpartyID"`
+	CounterpartyName string       `json:"counterpartyName"`
+	CurrencyCode     string       `json:"currencyCode"`
+	Buckets          AgingBuckets `json:"buckets"`
+}
+
+// AgedTotal totals the outstanding documents in one currency
+type AgedTotal struct {
+	CurrencyCode string       `json:"currencyCode"`
+	Buckets      AgingBuckets `json:"buckets"`
+}
+
+// AgingReport lists outstanding documents as of a date, bucketed by days past due per counterparty and currency
+type AgingReport struct {
+	AsOf     time.Time     `json:"asOf"`
+	Items    []AgedItem    `json:"items"`
+	Balances []AgedBalance `json:"balances"`
+	Totals   []AgedTotal   `json:"totals"`
+}
+
+// NewAgingReport buckets outstanding documents as of a date. Items are ordered by counterparty name and due
+// date, balances by counterparty name and currency, and totals by currency.
+func NewAgingReport(asOf time.Time, items []AgedItem) AgingReport {
+	report := AgingReport{AsOf: asOf, Items: items, Balances: []AgedBalance{}, Totals: []AgedTotal{}}
+	if report.Items == nil {
+		report.Items = []AgedItem{}
+	}
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		a, b := report.Items[i], report.Items[j]
+		if nameA, nameB := strings.ToLower(a.CounterpartyName), strings.ToLower(b.CounterpartyName); nameA != nameB {
+			return nameA < nameB
+		}
+		if !a.DueDate.Equal(b.DueDate) {
+			return a.DueDate.Before(b.DueDate)
+		}
+		return a.DocumentNumber < b.DocumentNumber
+	})
+
+	balances := map[string]int{}
+	totals := map[string]int{}
+	for i := range report.Items {
+		item := &report.Items[i]
+		item.DaysPastDue = DaysPastDue(item.DueDate, asOf)
+
+		key := item.CounterpartyID + "|" + item.CurrencyCode
+		index, ok := balances[key]
+		if !ok {
+			index = len(report.Balances)
+			balances[key] = index
+			report.Balances = append(report.Balances, AgedBalance{
+				CounterpartyID:   item.CounterpartyID,
+				CounterpartyName: item.CounterpartyName,
+				CurrencyCode:     item.CurrencyCode,
+			})
+		}
+		report.Balances[index].Buckets.Add(item.DaysPastDue, item.Outstanding)
+
+		index, ok = totals[item.CurrencyCode]
+		if !ok {
+			index = len(report.Totals)
+			totals[item.CurrencyCode] = index
+			report.Totals = append(report.Totals, AgedTotal{CurrencyCode: item.CurrencyCode})
+		}
+		report.Totals[index].Buckets.Add(item.DaysPastDue, item.Outstanding)
+	}
+
+	sort.SliceStable(report.Balances, func(i, j int) bool {
+		a, b := report.Balances[i], report.Balances[j]
+		if nameA, nameB := strings.ToLower(a.CounterpartyName), strings.ToLower(b.CounterpartyName); nameA != nameB {
+			return nameA < nameB
+		}
+		if a.CounterpartyID != b.CounterpartyID {
+			return a.CounterpartyID < b.CounterpartyID
+		}
+		return a.CurrencyCode < b.CurrencyCode
+	})
+	sort.Slice(report.Totals, func(i, j int) bool {
+		return report.Totals[i].CurrencyCode < report.Totals[j].CurrencyCode
+	})
+	return report
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StatementLineStatus tracks what happened to a staged bank statement line
+type StatementLineStatus string
+
+const (
+	StatementLinePending StatementLineStatus = "PENDING" // Waiting to be turned into a journal
+	StatementLinePosted  StatementLineStatus = "POSTED"  // A journal was created from the line
+	StatementLineIgnored StatementLineStatus = "IGNORED" // Dismissed by the user; can be restored
+)
+
+// BankStatementImport records one uploaded statement file
+type BankStatementImport struct {
+	ImportID       string `json:"importID"`
+	WorkplaceID    string `json:"workplaceID"`
+	AccountID      string `json:"accountID"`
+	Format         string `json:"format"`
+	FileName       string `json:"fileName"`
+	TotalLines     int    `json:"totalLines"`     // Lines found in the file
+	NewLines       int    `json:"newLines"`       // Lines staged; the rest were already imported
+	DuplicateLines int    `json:"duplicateLines"` // Derived: TotalLines - NewLines
+	AuditFields
+}
+
+// BankStatementLine is a staged line of a bank statement. Amounts are seen from the account holder:
+// positive is money in (a debit to the account), negative is money out.
+type BankStatementLine struct {
+	LineID        string              `json:"lineID"`
+	WorkplaceID   string              `json:"workplaceID"`
+	AccountID     string              `json:"accountID"`
+	ImportID      string              `json:"importID"`
+	BankReference string              `json:"bankReference"` // FITID or bank reference, unique per account
+	Date          time.Time           `json:"date"`
+	Amount        decimal.Decimal     `json:"amount"`
+	Payee         string              `json:"payee"`
+	Memo          string              `json:"memo"`
+	Status        StatementLineStatus `json:"status"`
+	JournalID     string              `json:"journalID,omitempty"` // Set once posted
+	// Categorization assigned by a rule, or recorded when the line is posted manually
+	CounterAccountID string `json:"counterAccountID,omitempty"`
+	Description      string `json:"description,omitempty"`
+	Notes            string `json:"notes,omitempty"`
+	MatchedRuleID    string `json:"matchedRuleID,omitempty"` // Empty when categorized manually
+	AuditFields
+}
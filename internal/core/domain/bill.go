@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BillStatus is the state of a vendor bill, derived from its posting journal and the journals of its payments
+type BillStatus string
+
+const (
+	BillDraft         BillStatus = "DRAFT"
+	BillOpen          BillStatus = "OPEN"
+	BillPartiallyPaid BillStatus = "PARTIALLY_PAID"
+	BillPaid          BillStatus = "PAID"
+	BillOverdue       BillStatus = "OVERDUE"
+	BillVoid          BillStatus = "VOID" // The posting journal has been reversed
+)
+
+// BillLine is a line of a vendor bill debited to an EXPENSE account
+type BillLine struct {
+	LineID           string          `json:"lineID"`
+	BillID           string          `json:"billID"`
+	LineNumber       int             `json:"lineNumber"`
+	Description      string          `json:"description"`
+	ExpenseAccountID string          `json:"expenseAccountID"`
+	TaxCodeID        string          `json:"taxCodeID"` // Nullable
+	Amount           decimal.Decimal `json:"amount"`    // Includes the tax for inclusive tax codes
+	NetAmount        decimal.Decimal `json:"netAmount"`
+	TaxAmount        decimal.Decimal `json:"taxAmount"`
+}
+
+// BillPayment is a payment made against a bill
+type BillPayment struct {
+	PaymentID          string          `json:"paymentID"`
+	BillID             string          `json:"billID"`
+	JournalID          string          `json:"journalID"`
+	PaymentAccountID   string          `json:"paymentAccountID"`
+	ScheduledPaymentID string          `json:"scheduledPaymentID"` // Nullable; set when the payment settles a scheduled payment
+	PaymentDate        time.Time       `json:"paymentDate"`
+	Amount             decimal.Decimal `json:"amount"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	CreatedBy          string          `json:"createdBy"`
+}
+
+// ScheduledBillPayment is a payment planned against a bill, posted once its date has come
+type ScheduledBillPayment struct {
+	ScheduledPaymentID string          `json:"scheduledPaymentID"`
+	BillID             string          `json:"billID"`
+	PaymentAccountID   string          `json:"paymentAccountID"`
+	ScheduledDate      time.Time       `json:"scheduledDate"`
+	Amount             decimal.Decimal `json:"amount"`
+	PaymentID          string          `json:"paymentID"` // Payment posted for it whose journal is still posted; empty while pending
+	CreatedAt          time.Time       `json:"createdAt"`
+	CreatedBy          string          `json:"createdBy"`
+}
+
+// Pending reports whether the scheduled payment has not been posted yet
+func (p ScheduledBillPayment) Pending() bool {
+	return p.PaymentID == ""
+}
+
+// Bill is a vendor bill owed to a vendor, a payee of the workplace. Drafts can be edited freely; posting one
+// posts a journal debiting the expense accounts of its lines and crediting the payable account with the total.
+type Bill struct {
+	BillID            string                 `json:"billID"`
+	WorkplaceID       string                 `json:"workplaceID"`
+	VendorID          string                 `json:"vendorID"`
+	PayableAccountID  string                 `json:"payableAccountID"`
+	Reference         string                 `json:"reference"` // Nullable; the vendor's bill number
+	BillDate          time.Time              `json:"billDate"`
+	DueDate           time.Time              `json:"dueDate"`
+	CurrencyCode      string                 `json:"currencyCode"`
+	Notes             string                 `json:"notes"`
+	Subtotal          decimal.Decimal        `json:"subtotal"`
+	TaxTotal          decimal.Decimal        `json:"taxTotal"`
+	Total             decimal.Decimal        `json:"total"`
+	PostJournalID     string                 `json:"postJournalID"`     // Empty for drafts
+	PostJournalStatus JournalStatus          `json:"postJournalStatus"` // Status of the posting journal; empty for drafts
+	Lines             []BillLine             `json:"lines"`
+	Payments          []BillPayment          `json:"payments"` // Payments whose journals are still posted
+	ScheduledPayments []ScheduledBillPayment `json:"scheduledPayments"`
+	AuditFields
+}
+
+// AmountPaid sums the payments dated on or before a date
+func (b Bill) AmountPaid(asOf time.Time) decimal.Decimal {
+	paid := decimal.Zero
+	for _, payment := range b.Payments {
+		if !payment.PaymentDate.After(asOf) {
+			paid = paid.Add(payment.Amount)
+		}
+	}
+	return paid
+}
+
+// Unpaid returns the total less all recorded payments, whatever their dates
+func (b Bill) Unpaid() decimal.Decimal {
+	unpaid := b.Total
+	for _, payment := range b.Payments {
+		unpaid = unpaid.Sub(payment.Amount)
+	}
+	return unpaid
+}
+
+// PendingScheduled sums the scheduled payments that have not been posted yet
+func (b Bill) PendingScheduled() decimal.Decimal {
+	pending := decimal.Zero
+	for _, scheduled := range b.ScheduledPayments {
+		if scheduled.Pending() {
+			pending = pending.Add(scheduled.Amount)
+		}
+	}
+	return pending
+}
+
+// Outstanding returns the amount still owed on a date; zero for drafts and void bills
+func (b Bill) Outstanding(asOf time.Time) decimal.Decimal {
+	if b.PostJournalID == "" || b.PostJournalStatus != Posted {
+		return decimal.Zero
+	}
+	outstanding := b.Total.Sub(b.AmountPaid(asOf))
+	if outstanding.IsNegative() {
+		return decimal.Zero
+	}
+	return outstanding
+}
+
+// Status derives the status of the bill on a date. Unpaid amounts past the due date make a bill overdue
+// whether or not it has been partially paid.
+func (b Bill) Status(asOf time.Time) BillStatus {
+	switch {
+	case b.PostJournalID == "":
+		return BillDraft
+	case b.PostJournalStatus != Posted:
+		return BillVoid
+	}
+	outstanding := b.Outstanding(asOf)
+	switch {
+	case outstanding.IsZero():
+		return BillPaid
+	case asOf.After(b.DueDate):
+		return BillOverdue
+	case outstanding.LessThan(b.Total):
+		return BillPartiallyPaid
+	}
+	return BillOpen
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BudgetPeriodType defines how a budget year is divided
+type BudgetPeriodType string
+
+const (
+	BudgetMonthly BudgetPeriodType = "MONTHLY" // One amount per account per calendar month
+	BudgetYearly  BudgetPeriodType = "YEARLY"  // One amount per account for the whole year
+)
+
+// Budget holds planned revenue and expense amounts of a workplace for one calendar year
+type Budget struct {
+	BudgetID    string           `json:"budgetID"`
+	WorkplaceID string           `json:"workplaceID"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	PeriodType  BudgetPeriodType `json:"periodType"`
+	Year        int              `json:"year"`
+	Lines       []BudgetLine     `json:"lines"`
+	AuditFields
+}
+
+// BudgetLine is the planned amount of one account for one period of a budget.
+// A line on a parent account covers the parent and all of its descendants.
+type BudgetLine struct {
+	AccountID   string          `json:"accountID"`
+	PeriodStart time.Time       `json:"periodStart"` // First day of the month (monthly) or year (yearly)
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+// Periods returns the periods of the budget year: twelve months for monthly budgets, the whole year otherwise
+func (b Budget) Periods() []ReportPeriod {
+	start := time.Date(b.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(b.Year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	if b.PeriodType == BudgetMonthly {
+		return MonthlyPeriods(start, end)
+	}
+	return []ReportPeriod{{Label: start.Format("2006"), From: start, To: end}}
+}
+
+// BudgetVsActualLine compares the budget of one account with its actual movement
+type BudgetVsActualLine struct {
+	AccountID   string           `json:"accountID"`
+	Name        string           `json:"name"`
+	AccountType AccountType      `json:"accountType"`
+	Budgeted    decimal.Decimal  `json:"budgeted"`
+	Actual      decimal.Decimal  `json:"actual"`      // Movement of the account and its descendants, in the account's normal sign
+	Remaining   decimal.Decimal  `json:"remaining"`   // Budgeted minus actual; negative when over budget
+	PercentUsed *decimal.Decimal `json:"percentUsed"` // Actual relative to budgeted; nil when nothing is budgeted
+}
+
+// BudgetVsActualSection groups the budget lines of revenue or expense accounts
+type BudgetVsActualSection struct {
+	Lines []BudgetVsActualLine `json:"lines"`
+	Total BudgetVsActualLine   `json:"total"`
+	// Unbudgeted is the actual movement of accounts not covered by any budget line
+	Unbudgeted decimal.Decimal `json:"unbudgeted"`
+}
+
+// BudgetVsActualReport compares a budget with the actual revenue and expenses of a period
+type BudgetVsActualReport struct {
+	BudgetID string                `json:"budgetID"`
+	Name     string                `json:"name"`
+	Period   ReportPeriod          `json:"period"`
+	Revenue  BudgetVsActualSection `json:"revenue"`
+	Expenses BudgetVsActualSection `json:"expenses"`
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// RuleSign restricts a categorization rule to money in or money out
+type RuleSign string
+
+const (
+	RuleSignAny RuleSign = "ANY"
+	RuleSignIn  RuleSign = "IN"  // Positive statement amounts
+	RuleSignOut RuleSign = "OUT" // Negative statement amounts
+)
+
+// CategorizationRule assigns a counter-account, description and notes to matching staged bank statement lines.
+// Empty conditions match everything; rules are applied in ascending Priority and the first match wins.
+type CategorizationRule struct {
+	RuleID             string           `json:"ruleID"`
+	WorkplaceID        string           `json:"workplaceID"`
+	Name               string           `json:"name"`
+	Priority           int              `json:"priority"`
+	IsActive           bool             `json:"isActive"`
+	PayeePattern       string           `json:"payeePattern"`       // Case-insensitive regular expression on the payee
+	DescriptionPattern string           `json:"descriptionPattern"` // Case-insensitive regular expression on the memo
+	MinAmount          *decimal.Decimal `json:"minAmount"`          // Inclusive bound on the absolute amount
+	MaxAmount          *decimal.Decimal `json:"maxAmount"`          // Inclusive bound on the absolute amount
+	SourceAccountID    string           `json:"sourceAccountID"`    // Only lines of this statement account
+	Sign               RuleSign         `json:"sign"`
+	CounterAccountID   string           `json:"counterAccountID"`
+	JournalDescription string           `json:"journalDescription"` // Description of the journal created for the line
+	Notes              string           `json:"notes"`              // Notes of the counter-account transaction
+	AuditFields
+}
+
+// CategorizationMatch reports the rule applied to a staged line
+type CategorizationMatch struct {
+	LineID           string `json:"lineID"`
+	BankReference    string `json:"bankReference"`
+	RuleID           string `json:"ruleID"`
+	RuleName         string `json:"ruleName"`
+	CounterAccountID string `json:"counterAccountID"`
+}
+
+// CategorizationRuleSuggestion proposes a rule for a payee that was repeatedly posted to the same counter-account by hand
+type CategorizationRuleSuggestion struct {
+	Name             string   `json:"name"`
+	PayeePattern     string   `json:"payeePattern"`
+	ExamplePayee     string   `json:"examplePayee"`
+	SourceAccountID  string   `json:"sourceAccountID"`
+	Sign             RuleSign `json:"sign"`
+	CounterAccountID string   `json:"counterAccountID"`
+	Occurrences      int      `json:"occurrences"`
+}
+
+// CategorizationResult summarises a run of the categorization rules over pending lines
+type CategorizationResult struct {
+	ExaminedLines int                   `json:"examinedLines"`
+	Matches       []CategorizationMatch `json:"matches"`
+}
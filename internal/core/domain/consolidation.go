@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ConsolidationGroup combines the books of several workplaces, one per entity of a group, into consolidated
+// reports in a single group currency. The group is managed from its home workplace.
+type ConsolidationGroup struct {
+	GroupID      string                        `json:"groupID"`
+	WorkplaceID  string                        `json:"workplaceID"` // Home workplace
+	Name         string                        `json:"name"`
+	Description  string                        `json:"description"` // Nullable
+	CurrencyCode string                        `json:"currencyCode"`
+	MemberIDs    []string                      `json:"memberIDs"` // Workplaces of the entities, in report order
+	Accounts     []ConsolidationAccountMapping `json:"accounts"`
+	AuditFields
+}
+
+// ConsolidationAccountMapping holds the group settings of one account of a member workplace.
+// Accounts sharing a GroupCode form one consolidated line; without a GroupCode the account's CFID is used,
+// and accounts with neither stay on a line of their own.
+type ConsolidationAccountMapping struct {
+	AccountID    string `json:"accountID"`
+	GroupCode    string `json:"groupCode,omitempty"` // Nullable
+	Intercompany bool   `json:"intercompany"`        // Balances with other entities, eliminated on consolidation
+}
+
+// Mapping returns the settings of an account, if the group has any
+func (g ConsolidationGroup) Mapping(accountID string) (ConsolidationAccountMapping, bool) {
+	for _, mapping := range g.Accounts {
+		if mapping.AccountID == accountID {
+			return mapping, true
+		}
+	}
+	return ConsolidationAccountMapping{}, false
+}
+
+// ConsolidationBalance is the net debit-positive amount of one account of a member workplace, in the
+// currency of the account
+type ConsolidationBalance struct {
+	WorkplaceID  string          `json:"workplaceID"`
+	AccountID    string          `json:"accountID"`
+	CFID         string          `json:"cfid"`
+	Name         string          `json:"name"`
+	AccountType  AccountType     `json:"accountType"`
+	CurrencyCode string          `json:"currencyCode"`
+	NetAmount    decimal.Decimal `json:"netAmount"`
+}
+
+// ConsolidatedEntityAmount is the contribution of one account of an entity to a consolidated line
+type ConsolidatedEntityAmount struct {
+	WorkplaceID  string          `json:"workplaceID"`
+	AccountID    string          `json:"accountID"`
+	Name         string          `json:"name"`
+	CurrencyCode string          `json:"currencyCode"`
+	Amount       decimal.Decimal `json:"amount"`     // In the currency of the account
+	Rate         decimal.Decimal `json:"rate"`       // Rate into the group currency
+	Translated   decimal.Decimal `json:"translated"` // In the group currency
+	Intercompany bool            `json:"intercompany"`
+}
+
+// ConsolidatedLine is one line of a consolidated report: the matched accounts of all entities, translated into
+// the group currency, less the eliminated intercompany amounts
+type ConsolidatedLine struct {
+	Code         string                     `json:"code,omitempty"` // Group code or CFID the accounts were matched on
+	Name         string                     `json:"name"`
+	AccountType  AccountType                `json:"accountType"`
+	Entities     []ConsolidatedEntityAmount `json:"entities"`
+	Combined     decimal.Decimal            `json:"combined"`     // Sum of the translated amounts
+	Elimination  decimal.Decimal            `json:"elimination"`  // Negated translated amounts of intercompany accounts
+	Consolidated decimal.Decimal            `json:"consolidated"` // Combined plus Elimination
+}
+
+// ConsolidationRate is the exchange rate used to translate the accounts of one currency
+type ConsolidationRate struct {
+	FromCurrencyCode string          `json:"fromCurrencyCode"`
+	ToCurrencyCode   string          `json:"toCurrencyCode"`
+	Rate             decimal.Decimal `json:"rate"`
+	DateEffective    time.Time       `json:"dateEffective"`
+}
+
+// ConsolidatedTrialBalance is the trial balance of a consolidation group. Line amounts are debit-positive.
+// IntercompanyDifference is the debit-positive sum of the eliminated intercompany balances; it is zero when
+// the intercompany balances of the entities offset each other.
+type ConsolidatedTrialBalance struct {
+	GroupID                string              `json:"groupID"`
+	CurrencyCode           string              `json:"currencyCode"`
+	AsOf                   time.Time           `json:"asOf"`
+	Lines                  []ConsolidatedLine  `json:"lines"`
+	TotalDebit             decimal.Decimal     `json:"totalDebit"`
+	TotalCredit            decimal.Decimal     `json:"totalCredit"`
+	IntercompanyDifference decimal.Decimal     `json:"intercompanyDifference"`
+	Rates                  []ConsolidationRate `json:"rates"`
+}
+
+// ConsolidatedPAndLReport is the profit and loss report of a consolidation group. Revenue is credit-positive
+// and expenses debit-positive. IntercompanyDifference is the net profit removed by the eliminations.
+type ConsolidatedPAndLReport struct {
+	GroupID                string              `json:"groupID"`
+	CurrencyCode           string              `json:"currencyCode"`
+	From                   time.Time           `json:"from"`
+	To                     time.Time           `json:"to"`
+	Revenue                []ConsolidatedLine  `json:"revenue"`
+	Expenses               []ConsolidatedLine  `json:"expenses"`
+	NetProfit              decimal.Decimal     `json:"netProfit"`
+	IntercompanyDifference decimal.Decimal     `json:"intercompanyDifference"`
+	Rates                  []ConsolidationRate `json:"rates"`
+}
+
+// ConsolidatedBalanceSheetReport is the balance sheet of a consolidation group. Assets are debit-positive,
+// liabilities and equity credit-positive. IntercompanyDifference is the debit-positive sum of the eliminated
+// intercompany balances.
+type ConsolidatedBalanceSheetReport struct {
+	GroupID                string              `json:"groupID"`
+	CurrencyCode           string              `json:"currencyCode"`
+	AsOf                   time.Time           `json:"asOf"`
+	Assets                 []ConsolidatedLine  `json:"assets"`
+	Liabilities            []ConsolidatedLine  `json:"liabilities"`
+	Equity                 []ConsolidatedLine  `json:"equity"`
+	TotalAssets            decimal.Decimal     `json:"totalAssets"`
+	TotalLiabilities       decimal.Decimal     `json:"totalLiabilities"`
+	TotalEquity            decimal.Decimal     `json:"totalEquity"`
+	IntercompanyDifference decimal.Decimal     `json:"intercompanyDifference"`
+	Rates                  []ConsolidationRate `json:"rates"`
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CreditCard holds the billing-cycle settings of a credit card LIABILITY account. Statements close on the
+// closing day of every month and are due on the due day, in the following month when the due day is not
+// after the closing day. Days past the end of a month fall on its last day.
+type CreditCard struct {
+	AccountID             string          `json:"accountID"`
+	WorkplaceID           string          `json:"workplaceID"`
+	ClosingDay            int             `json:"closingDay"`
+	DueDay                int             `json:"dueDay"`
+	CreditLimit           decimal.Decimal `json:"creditLimit"`
+	MinimumPaymentPercent decimal.Decimal `json:"minimumPaymentPercent"` // Percent of the statement balance
+	MinimumPaymentAmount  decimal.Decimal `json:"minimumPaymentAmount"`  // Floor of the minimum payment
+	AuditFields
+}
+
+// dayInMonth returns the given day of a month, moved back to the last day of shorter months
+func dayInMonth(year int, month time.Month, day int) time.Time {
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if lastDay := firstOfMonth.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// ClosingDate returns the closing date of the cycle ending in the given month
+func (c CreditCard) ClosingDate(year int, month time.Month) time.Time {
+	return dayInMonth(year, month, c.ClosingDay)
+}
+
+// CycleFor returns the billing cycle that contains the given date
+func (c CreditCard) CycleFor(date time.Time) StatementCycle {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	closing := c.ClosingDate(date.Year(), date.Month())
+	if date.After(closing) {
+		closing = c.ClosingDate(date.Year(), date.Month()+1)
+	}
+	return c.cycleClosingOn(closing)
+}
+
+// LastClosedCycle returns the latest billing cycle that closed before the given date
+func (c CreditCard) LastClosedCycle(date time.Time) StatementCycle {
+	current := c.CycleFor(date)
+	return c.CycleFor(current.StartDate.AddDate(0, 0, -1))
+}
+
+// cycleClosingOn returns the billing cycle closing on the given closing date
+func (c CreditCard) cycleClosingOn(closing time.Time) StatementCycle {
+	previous := c.ClosingDate(closing.Year(), closing.Month()-1)
+	dueMonth := closing.Month()
+	if c.DueDay <= c.ClosingDay {
+		dueMonth++
+	}
+	return StatementCycle{
+		StartDate:   previous.AddDate(0, 0, 1),
+		ClosingDate: closing,
+		DueDate:     dayInMonth(closing.Year(), dueMonth, c.DueDay),
+	}
+}
+
+// StatementCycle is a billing cycle of a credit card. Transactions dated from StartDate through ClosingDate
+// belong to its statement.
+type StatementCycle struct {
+	StartDate   time.Time `json:"startDate"`
+	ClosingDate time.Time `json:"closingDate"`
+	DueDate     time.Time `json:"dueDate"`
+}
+
+// CreditCardStatement is the statement of one billing cycle. Amounts follow the liability convention:
+// balances are positive when owed, charges credit the account and payments debit it.
+type CreditCardStatement struct {
+	AccountID           string          `json:"accountID"`
+	CurrencyCode        string          `json:"currencyCode"`
+	Cycle               StatementCycle  `json:"cycle"`
+	Closed              bool            `json:"closed"` // False while the cycle is still open
+	OpeningBalance      decimal.Decimal `json:"openingBalance"`
+	Charges             decimal.Decimal `json:"charges"`
+	Payments            decimal.Decimal `json:"payments"` // Payments and refunds in the cycle
+	StatementBalance    decimal.Decimal `json:"statementBalance"`
+	MinimumDue          decimal.Decimal `json:"minimumDue"`
+	PaidSinceClosing    decimal.Decimal `json:"paidSinceClosing"`    // Payments dated after closing through the due date
+	RemainingDue        decimal.Decimal `json:"remainingDue"`        // Statement balance not yet paid, never negative
+	RemainingMinimumDue decimal.Decimal `json:"remainingMinimumDue"` // Minimum due not yet paid, never negative
+	Transactions        []Transaction   `json:"transactions"`
+}
+
+// CreditCardSummary is the current position of a credit card
+type CreditCardSummary struct {
+	CreditCard      CreditCard           `json:"creditCard"`
+	AccountName     string               `json:"accountName"`
+	CurrencyCode    string               `json:"currencyCode"`
+	CurrentBalance  decimal.Decimal      `json:"currentBalance"`
+	AvailableCredit decimal.Decimal      `json:"availableCredit"` // Credit limit less the current balance, never negative
+	CurrentCycle    StatementCycle       `json:"currentCycle"`
+	LastStatement   *CreditCardStatement `json:"lastStatement"`
+}
+
+// UpcomingDue is a statement payment falling due soon
+type UpcomingDue struct {
+	AccountID           string          `json:"accountID"`
+	AccountName         string          `json:"accountName"`
+	CurrencyCode        string          `json:"currencyCode"`
+	ClosingDate         time.Time       `json:"closingDate"`
+	DueDate             time.Time       `json:"dueDate"`
+	DaysUntilDue        int             `json:"daysUntilDue"`
+	StatementBalance    decimal.Decimal `json:"statementBalance"`
+	MinimumDue          decimal.Decimal `json:"minimumDue"`
+	RemainingDue        decimal.Decimal `json:"remainingDue"`
+	RemainingMinimumDue decimal.Decimal `json:"remainingMinimumDue"`
+}
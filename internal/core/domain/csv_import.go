@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CSVAmountMode tells how the amount of a CSV row is laid out
+type CSVAmountMode string
+
+const (
+	CSVAmountSigned      CSVAmountMode = "SIGNED"       // One column; positive is money in
+	CSVAmountInverted    CSVAmountMode = "INVERTED"     // One column; positive is money out
+	CSVAmountDebitCredit CSVAmountMode = "DEBIT_CREDIT" // Separate money-out and money-in columns
+)
+
+// CSVImportMode selects what an import produces
+type CSVImportMode string
+
+const (
+	CSVImportStage   CSVImportMode = "STAGE"   // Stage the rows as bank statement lines for review
+	CSVImportJournal CSVImportMode = "JOURNAL" // Stage the rows and post each new line against a counter-account
+)
+
+// CSVImportProfile is a saved description of one bank's CSV export for an account.
+// Column numbers are 1-based; 0 means the column is not present.
+type CSVImportProfile struct {
+	ProfileID        string        `json:"profileID"`
+	WorkplaceID      string        `json:"workplaceID"`
+	AccountID        string        `json:"accountID"`
+	Name             string        `json:"name"`
+	Delimiter        string        `json:"delimiter"`
+	SkipRows         int           `json:"skipRows"`
+	HasHeader        bool          `json:"hasHeader"`
+	DateColumn       int           `json:"dateColumn"`
+	DateFormat       string        `json:"dateFormat"`
+	AmountMode       CSVAmountMode `json:"amountMode"`
+	AmountColumn     int           `json:"amountColumn"`
+	DebitColumn      int           `json:"debitColumn"`
+	CreditColumn     int           `json:"creditColumn"`
+	DecimalSeparator string        `json:"decimalSeparator"`
+	PayeeColumn      int           `json:"payeeColumn"`
+	MemoColumn       int           `json:"memoColumn"`
+	ReferenceColumn  int           `json:"referenceColumn"`
+	AuditFields
+}
+
+// CSVPreviewRow is one parsed CSV row; Error is set when the row cannot be imported
+type CSVPreviewRow struct {
+	RowNumber     int             `json:"rowNumber"`
+	Date          time.Time       `json:"date"`
+	Amount        decimal.Decimal `json:"amount"` // Positive is money in
+	Payee         string          `json:"payee"`
+	Memo          string          `json:"memo"`
+	BankReference string          `json:"bankReference"`
+	Error         string          `json:"error,omitempty"`
+}
+
+// CSVImportPreview is the result of reading a CSV file with a profile, without saving anything
+type CSVImportPreview struct {
+	ProfileID    string          `json:"profileID"`
+	AccountID    string          `json:"accountID"`
+	CurrencyCode string          `json:"currencyCode"`
+	Rows         []CSVPreviewRow `json:"rows"`
+	ValidRows    int             `json:"validRows"`
+	InvalidRows  int             `json:"invalidRows"`
+}
+
+// CSVImportFailure records a staged line that could not be posted as a journal; the line stays pending
+type CSVImportFailure struct {
+	LineID        string `json:"lineID"`
+	BankReference string `json:"bankReference"`
+	Error         string `json:"error"`
+}
+
+// CSVImportResult summarises a CSV import
+type CSVImportResult struct {
+	Import      BankStatementImport `json:"import"`
+	Mode        CSVImportMode       `json:"mode"`
+	SkippedRows int                 `json:"skippedRows"` // Rows that could not be read; see the preview
+	PostedLines int                 `json:"postedLines"` // Only for JOURNAL imports
+	Failures    []CSVImportFailure  `json:"failures"`
+}
@@ -0,0 +1,110 @@
+package domain
+
+// Dimension is an analytic axis of a workplace, such as a project, a cost center or a free tag, used to slice
+// reports independently of the account hierarchy. Each transaction line carries at most one value per dimension,
+// either its own or the one of its journal.
+type Dimension struct {
+	DimensionID string           `json:"dimensionID"`
+	WorkplaceID string           `json:"workplaceID"`
+	Code        string           `json:"code"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"` // Nullable
+	IsActive    bool             `json:"isActive"`
+	Values      []DimensionValue `json:"values"` // Ordered by code
+	AuditFields
+}
+
+// DimensionValue is one of the values of a dimension, such as a single project. Inactive values stay on the
+// lines already tagged with them but cannot be assigned anymore.
+type DimensionValue struct {
+	ValueID     string `json:"valueID"`
+	DimensionID string `json:"dimensionID"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	IsActive    bool   `json:"isActive"`
+	AuditFields
+}
+
+// Value returns the value of the dimension with the given ID
+func (d Dimension) Value(valueID string) (DimensionValue, bool) {
+	for _, value := range d.Values {
+		if value.ValueID == valueID {
+			return value, true
+		}
+	}
+	return DimensionValue{}, false
+}
+
+// DimensionTag assigns a value of a dimension to a journal or a transaction line
+type DimensionTag struct {
+	DimensionID string `json:"dimensionID"`
+	ValueID     string `json:"valueID"`
+}
+
+// UntaggedValueID is the value ID filters use to match lines that have no value for a dimension,
+// neither their own nor their journal's
+const UntaggedValueID = ""
+
+// DimensionFilter restricts a report to the lines whose effective value for a dimension is one of ValueIDs.
+// Reports apply every filter they are given, so filters on different dimensions narrow each other.
+type DimensionFilter struct {
+	DimensionID string   `json:"dimensionID"`
+	ValueIDs    []string `json:"valueIDs"` // UntaggedValueID matches lines without a value
+}
+
+// DimensionGroup is one column of a report grouped by a dimension: the report run with Filters, which restrict
+// it to a single value of the dimension (or to the untagged lines) on top of the requested filters.
+type DimensionGroup struct {
+	ValueID string            `json:"valueID"` // UntaggedValueID for the lines without a value
+	Code    string            `json:"code"`
+	Name    string            `json:"name"`
+	Filters []DimensionFilter `json:"filters"`
+}
+
+// ReportDimensions holds the dimension filters of a report request and, when it is grouped by a dimension,
+// one group per value of that dimension
+type ReportDimensions struct {
+	Filters []DimensionFilter `json:"filters"`
+	GroupBy *Dimension        `json:"groupBy,omitempty"`
+	Groups  []DimensionGroup  `json:"groups,omitempty"`
+}
+
+// Groups splits a report on the dimension into one group per value, followed by a group for the untagged
+// lines. A filter on the dimension itself limits the groups to the values it allows; filters on other
+// dimensions apply to every group. Groups of all values together cover the same lines as the ungrouped report.
+func (d Dimension) Groups(filters []DimensionFilter) []DimensionGroup {
+	others := make([]DimensionFilter, 0, len(filters))
+	var allowed map[string]bool
+	for _, filter := range filters {
+		if filter.DimensionID != d.DimensionID {
+			others = append(others, filter)
+			continue
+		}
+		// Several filters on the same dimension narrow each other as well
+		next := make(map[string]bool, len(filter.ValueIDs))
+		for _, valueID := range filter.ValueIDs {
+			if allowed == nil || allowed[valueID] {
+				next[valueID] = true
+			}
+		}
+		allowed = next
+	}
+
+	group := func(valueID, code, name string) DimensionGroup {
+		groupFilters := make([]DimensionFilter, len(others), len(others)+1)
+		copy(groupFilters, others)
+		groupFilters = append(groupFilters, DimensionFilter{DimensionID: d.DimensionID, ValueIDs: []string{valueID}})
+		return DimensionGroup{ValueID: valueID, Code: code, Name: name, Filters: groupFilters}
+	}
+
+	groups := []DimensionGroup{}
+	for _, value := range d.Values {
+		if allowed == nil || allowed[value.ValueID] {
+			groups = append(groups, group(value.ValueID, value.Code, value.Name))
+		}
+	}
+	if allowed == nil || allowed[UntaggedValueID] {
+		groups = append(groups, group(UntaggedValueID, "", "Untagged"))
+	}
+	return groups
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DuplicatePairStatus is the review state of a suspected duplicate pair
+type DuplicatePairStatus string
+
+const (
+	DuplicatePending   DuplicatePairStatus = "PENDING"
+	DuplicateConfirmed DuplicatePairStatus = "CONFIRMED" // One of the journals was reversed
+	DuplicateDismissed DuplicatePairStatus = "DISMISSED" // Both journals are genuine
+)
+
+// DuplicateJournalPair flags a journal that looks like a repeat of an earlier one: same accounts, equal
+// amounts, dates within the detection window and a similar description
+type DuplicateJournalPair struct {
+	PairID               string              `json:"pairID"`
+	WorkplaceID          string              `json:"workplaceID"`
+	JournalID            string              `json:"journalID"`            // The journal whose creation raised the flag
+	DuplicateOfJournalID string              `json:"duplicateOfJournalID"` // The earlier journal it resembles
+	Similarity           float64             `json:"similarity"`           // Description similarity between 0 and 1
+	Status               DuplicatePairStatus `json:"status"`
+	ReversedJournalID    string              `json:"reversedJournalID,omitempty"` // Journal reversed when the pair was confirmed
+	ResolutionNote       string              `json:"resolutionNote,omitempty"`
+	ResolvedAt           *time.Time          `json:"resolvedAt,omitempty"`
+	ResolvedBy           string              `json:"resolvedBy,omitempty"`
+	AuditFields
+
+	// Details of both journals, filled in when pairs are read back
+	Journal     *DuplicateJournalInfo `json:"journal,omitempty"`
+	DuplicateOf *DuplicateJournalInfo `json:"duplicateOf,omitempty"`
+}
+
+// DuplicateJournalInfo summarizes one side of a duplicate pair for review
+type DuplicateJournalInfo struct {
+	JournalID    string          `json:"journalID"`
+	JournalDate  time.Time       `json:"journalDate"`
+	Description  string          `json:"description"`
+	CurrencyCode string          `json:"currencyCode"`
+	Amount       decimal.Decimal `json:"amount"`
+	Status       JournalStatus   `json:"status"`
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// EnvelopeSettings enables envelope budgeting for a workplace. Income and spending before the
+// start month are ignored.
+type EnvelopeSettings struct {
+	WorkplaceID string    `json:"workplaceID"`
+	StartMonth  time.Time `json:"startMonth"` // First day of the first envelope month
+	AuditFields
+}
+
+// EnvelopeAllocation assigns money to (positive) or takes money from (negative) an expense envelope for a month
+type EnvelopeAllocation struct {
+	AllocationID string          `json:"allocationID"`
+	WorkplaceID  string          `json:"workplaceID"`
+	AccountID    string          `json:"accountID"`
+	Month        time.Time       `json:"month"` // First day of the month
+	Amount       decimal.Decimal `json:"amount"`
+	TransferID   string          `json:"transferID,omitempty"` // Shared by both allocations of a move between envelopes
+	Note         string          `json:"note,omitempty"`
+	AuditFields
+}
+
+// EnvelopeLine is the state of one envelope in a month.
+// Available = CarriedOver + Assigned - Activity; a negative available (overspend) is carried into the next month.
+type EnvelopeLine struct {
+	AccountID   string          `json:"accountID"`
+	Name        string          `json:"name"`
+	CarriedOver decimal.Decimal `json:"carriedOver"`
+	Assigned    decimal.Decimal `json:"assigned"`
+	Activity    decimal.Decimal `json:"activity"` // Spending on the envelope account and its descendants
+	Available   decimal.Decimal `json:"available"`
+}
+
+// EnvelopeMonth is the derived envelope budget state of one month
+type EnvelopeMonth struct {
+	Month      ReportPeriod    `json:"month"`
+	StartMonth time.Time       `json:"startMonth"`
+	Income     decimal.Decimal `json:"income"` // Revenue posted in the month
+	// AvailableToBudget is all income since the start month less all money assigned up to this month
+	// and less spending not covered by any envelope
+	AvailableToBudget   decimal.Decimal `json:"availableToBudget"`
+	UnenvelopedActivity decimal.Decimal `json:"unenvelopedActivity"` // Spending of the month on accounts outside any envelope
+	Envelopes           []EnvelopeLine  `json:"envelopes"`
+	Total               EnvelopeLine    `json:"total"`
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "github.com/shopspring/decimal"
+
+// JournalTwin identifies the mirrored journal of an intercompany posting in the other workplace.
+// Both journals of a posting link to each other and are created, and reversed together, atomically.
+type JournalTwin struct {
+	JournalID   string        `json:"journalID"`
+	WorkplaceID string        `json:"workplaceID"`
+	Status      JournalStatus `json:"status"`
+}
+
+// JournalPosting is a journal ready to be persisted: its transactions and the net signed change of
+// every account it affects.
+type JournalPosting struct {
+	Journal        Journal
+	Transactions   []Transaction
+	BalanceChanges map[string]decimal.Decimal
+}
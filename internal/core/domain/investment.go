@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SecurityType classifies a security in the security master
+type SecurityType string
+
+const (
+	SecurityStock      SecurityType = "STOCK"
+	SecurityMutualFund SecurityType = "MUTUAL_FUND"
+	SecurityETF        SecurityType = "ETF"
+	SecurityBond       SecurityType = "BOND"
+	SecurityCrypto     SecurityType = "CRYPTO"
+	SecurityOther      SecurityType = "OTHER"
+)
+
+// CostMethod defines which lots a sell consumes and at what cost
+type CostMethod string
+
+const (
+	CostFIFO    CostMethod = "FIFO"    // Oldest lots are sold first
+	CostLIFO    CostMethod = "LIFO"    // Newest lots are sold first
+	CostAverage CostMethod = "AVERAGE" // Every unit carries the average cost of the position
+)
+
+// Security is a stock, fund or other instrument held in investment accounts.
+// Trades are priced in the currency of the security, and holdings are valued with the commodity prices
+// of its symbol in that currency.
+type Security struct {
+	SecurityID   string       `json:"securityID"`
+	WorkplaceID  string       `json:"workplaceID"`
+	Symbol       string       `json:"symbol"`
+	Name         string       `json:"name"`
+	SecurityType SecurityType `json:"securityType"`
+	CurrencyCode string       `json:"currencyCode"`
+	CostMethod   CostMethod   `json:"costMethod"`
+	IsActive     bool         `json:"isActive"`
+	AuditFields
+}
+
+// SecurityLine is a posted transaction line moving units of a security in or out of an investment account.
+// Debit lines buy units; credit lines sell units.
+type SecurityLine struct {
+	TransactionID   string          `json:"transactionID"`
+	JournalID       string          `json:"journalID"`
+	AccountID       string          `json:"accountID"`
+	SecurityID      string          `json:"securityID"`
+	TransactionType TransactionType `json:"transactionType"`
+	Amount          decimal.Decimal `json:"amount"`
+	Quantity        decimal.Decimal `json:"quantity"`
+	UnitPrice       decimal.Decimal `json:"unitPrice"`
+	JournalDate     time.Time       `json:"journalDate"`
+	CreatedAt       time.Time       `json:"createdAt"`
+}
+
+// Lot is the part of a purchase still held in an investment account
+type Lot struct {
+	AccountID     string          `json:"accountID"`
+	SecurityID    string          `json:"securityID"`
+	TransactionID string          `json:"transactionID"` // Buy line that opened the lot
+	JournalID     string          `json:"journalID"`
+	AcquiredOn    time.Time       `json:"acquiredOn"`
+	Quantity      decimal.Decimal `json:"quantity"`  // Units remaining
+	CostBasis     decimal.Decimal `json:"costBasis"` // Cost of the remaining units
+	UnitPrice     decimal.Decimal `json:"unitPrice"` // Price paid per unit
+}
+
+// Holding is the position in one security held in one investment account as of a date.
+// Price, MarketValue and UnrealizedGain are nil when no price is known on or before the date.
+type Holding struct {
+	AccountID      string           `json:"accountID"`
+	SecurityID     string           `json:"securityID"`
+	Symbol         string           `json:"symbol"`
+	CurrencyCode   string           `json:"currencyCode"`
+	Quantity       decimal.Decimal  `json:"quantity"`
+	CostBasis      decimal.Decimal  `json:"costBasis"`
+	Price          *decimal.Decimal `json:"price,omitempty"`
+	PriceDate      *time.Time       `json:"priceDate,omitempty"`
+	MarketValue    *decimal.Decimal `json:"marketValue,omitempty"`
+	UnrealizedGain *decimal.Decimal `json:"unrealizedGain,omitempty"`
+}
+
+// Holdings lists the positions of a workplace as of a date
+type Holdings struct {
+	AsOf     time.Time `json:"asOf"`
+	Holdings []Holding `json:"holdings"`
+}
+
+// UnrealizedGainTotal sums the priced holdings in one currency. Holdings without a price are counted
+// in UnpricedCostBasis only.
+type UnrealizedGainTotal struct {
+	CurrencyCode      string          `json:"currencyCode"`
+	CostBasis         decimal.Decimal `json:"costBasis"`
+	MarketValue       decimal.Decimal `json:"marketValue"`
+	UnrealizedGain    decimal.Decimal `json:"unrealizedGain"`
+	UnpricedCostBasis decimal.Decimal `json:"unpricedCostBasis"`
+}
+
+// UnrealizedGainReport lists the unrealized gain of each holding as of a date, with totals per currency
+type UnrealizedGainReport struct {
+	AsOf     time.Time             `json:"asOf"`
+	Totals   []UnrealizedGainTotal `json:"totals"`
+	Holdings []Holding             `json:"holdings"`
+}
+
+// Trade is the outcome of a buy or sell: the journal posted and, for sells, the realized gain or loss
+type Trade struct {
+	Journal      Journal         `json:"journal"`
+	CostBasis    decimal.Decimal `json:"costBasis"`    // Cost of the units bought or sold
+	Proceeds     decimal.Decimal `json:"proceeds"`     // Net proceeds of a sell; zero for buys
+	RealizedGain decimal.Decimal `json:"realizedGain"` // Negative for a loss; zero for buys
+}
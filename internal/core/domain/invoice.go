@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// InvoiceStatus is the state of an invoice, derived from its issue journal and the journals of its payments
+type InvoiceStatus string
+
+const (
+	InvoiceDraft         InvoiceStatus = "DRAFT"
+	InvoiceIssued        InvoiceStatus = "ISSUED"
+	InvoicePartiallyPaid InvoiceStatus = "PARTIALLY_PAID"
+	InvoicePaid          InvoiceStatus = "PAID"
+	InvoiceOverdue       InvoiceStatus = "OVERDUE"
+	InvoiceVoid          InvoiceStatus = "VOID" // The issue journal has been reversed
+)
+
+// InvoiceLine is a line item of an invoice credited to a REVENUE account
+type InvoiceLine struct {
+	LineID           string          `json:"lineID"`
+	InvoiceID        string          `json:"invoiceID"`
+	LineNumber       int             `json:"lineNumber"`
+	Description      string          `json:"description"`
+	Quantity         decimal.Decimal `json:"quantity"`
+	UnitPrice        decimal.Decimal `json:"unitPrice"`
+	RevenueAccountID string          `json:"revenueAccountID"`
+	TaxCodeID        string          `json:"taxCodeID"` // Nullable
+	Amount           decimal.Decimal `json:"amount"`    // Quantity times unit price; includes the tax for inclusive tax codes
+	NetAmount        decimal.Decimal `json:"netAmount"`
+	TaxAmount        decimal.Decimal `json:"taxAmount"`
+}
+
+// InvoicePayment is a payment received against an invoice
+type InvoicePayment struct {
+	PaymentID   string          `json:"paymentID"`
+	InvoiceID   string          `json:"invoiceID"`
+	JournalID   string          `json:"journalID"`
+	PaymentDate time.Time       `json:"paymentDate"`
+	Amount      decimal.Decimal `json:"amount"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy"`
+}
+
+// Invoice is a sales invoice billed to a customer, a payee of the workplace. Drafts can be edited freely;
+// issuing one numbers it and posts a journal debiting the receivable account with the total and crediting
+// the revenue accounts of its lines.
+type Invoice struct {
+	InvoiceID           string           `json:"invoiceID"`
+	WorkplaceID         string           `json:"workplaceID"`
+	CustomerID          string           `json:"customerID"`
+	ReceivableAccountID string           `json:"receivableAccountID"`
+	InvoiceNumber       string           `json:"invoiceNumber"` // Empty for drafts
+	IssueDate           time.Time        `json:"issueDate"`
+	DueDate             time.Time        `json:"dueDate"`
+	CurrencyCode        string           `json:"currencyCode"`
+	Notes               string           `json:"notes"`
+	Subtotal            decimal.Decimal  `json:"subtotal"`
+	TaxTotal            decimal.Decimal  `json:"taxTotal"`
+	Total               decimal.Decimal  `json:"total"`
+	IssueJournalID      string           `json:"issueJournalID"`     // Empty for drafts
+	IssueJournalStatus  JournalStatus    `json:"issueJournalStatus"` // Status of the issue journal; empty for drafts
+	Lines               []InvoiceLine    `json:"lines"`
+	Payments            []InvoicePayment `json:"payments"` // Payments whose journals are still posted
+	AuditFields
+}
+
+// AmountPaid sums the payments dated on or before a date
+func (i Invoice) AmountPaid(asOf time.Time) decimal.Decimal {
+	paid := decimal.Zero
+	for _, payment := range i.Payments {
+		if !payment.PaymentDate.After(asOf) {
+			paid = paid.Add(payment.Amount)
+		}
+	}
+	return paid
+}
+
+// Unpaid returns the total less all recorded payments, whatever their dates
+func (i Invoice) Unpaid() decimal.Decimal {
+	unpaid := i.Total
+	for _, payment := range i.Payments {
+		unpaid = unpaid.Sub(payment.Amount)
+	}
+	return unpaid
+}
+
+// Outstanding returns the amount still owed on a date; zero for drafts and void invoices
+func (i Invoice) Outstanding(asOf time.Time) decimal.Decimal {
+	if i.IssueJournalID == "" || i.IssueJournalStatus != Posted {
+		return decimal.Zero
+	}
+	outstanding := i.Total.Sub(i.AmountPaid(asOf))
+	if outstanding.IsNegative() {
+		return decimal.Zero
+	}
+	return outstanding
+}
+
+// Status derives the status of the invoice on a date. Unpaid amounts past the due date make an invoice
+// overdue whether or not it has been partially paid.
+func (i Invoice) Status(asOf time.Time) InvoiceStatus {
+	switch {
+	case i.IssueJournalID == "":
+		return InvoiceDraft
+	case i.IssueJournalStatus != Posted:
+		return InvoiceVoid
+	}
+	outstanding := i.Outstanding(asOf)
+	switch {
+	case outstanding.IsZero():
+		return InvoicePaid
+	case asOf.After(i.DueDate):
+		return InvoiceOverdue
+	case outstanding.LessThan(i.Total):
+		return InvoicePartiallyPaid
+	}
+	return InvoiceIssued
+}
+
+// InvoiceSequence numbers the invoices of a workplace, e.g. INV-00042
+type InvoiceSequence struct {
+	WorkplaceID   string    `json:"workplaceID"`
+	Prefix        string    `json:"prefix"`
+	NextNumber    int64     `json:"nextNumber"`
+	Padding       int       `json:"padding"` // Minimum number of digits
+	LastUpdatedAt time.Time `json:"lastUpdatedAt"`
+	LastUpdatedBy string    `json:"lastUpdatedBy"`
+}
+
+// Format renders an invoice number with the prefix of the sequence, zero-padded to its minimum digits
+func (s InvoiceSequence) Format(number int64) string {
+	return fmt.Sprintf("%s%0*d", s.Prefix, s.Padding, number)
+}
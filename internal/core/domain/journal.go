@@ -26,5 +26,11 @@ type Journal struct {
 	OriginalJournalID  *string         `json:"originalJournalID,omitempty"`  // Link to the journal this one reverses
 	ReversingJournalID *string         `json:"reversingJournalID,omitempty"` // Link to the journal that reverses this one
 	Amount             decimal.Decimal `json:"amount,omitempty"`             // Total amount of movement (sum of debits or credits)
+	PayeeID            string          `json:"payeeID,omitempty"`            // Nullable; counterparty of the journal
+	Dimensions         []DimensionTag  `json:"dimensions,omitempty"`         // Values defaulted onto every line without its own value for the dimension
+	Twin               *JournalTwin    `json:"twin,omitempty"`               // Mirrored journal of an intercompany posting
 	AuditFields
+
+	DuplicateWarnings []DuplicateJournalPair `json:"duplicateWarnings,omitempty"` // Set by CreateJournal when the journal resembles earlier ones
+	TwinToReverse     *JournalTwin           `json:"twinToReverse,omitempty"`     // Set by ReverseJournal when the reversed journal's twin is still posted
 }
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LoanFrequency is how often loan installments fall due
+type LoanFrequency string
+
+const (
+	LoanWeekly     LoanFrequency = "WEEKLY"
+	LoanBiweekly   LoanFrequency = "BIWEEKLY"
+	LoanMonthly    LoanFrequency = "MONTHLY"
+	LoanQuarterly  LoanFrequency = "QUARTERLY"
+	LoanSemiAnnual LoanFrequency = "SEMI_ANNUAL"
+	LoanAnnual     LoanFrequency = "ANNUAL"
+)
+
+// PeriodsPerYear returns the number of installments per year, or 0 for an unknown frequency
+func (f LoanFrequency) PeriodsPerYear() int {
+	switch f {
+	case LoanWeekly:
+		return 52
+	case LoanBiweekly:
+		return 26
+	case LoanMonthly:
+		return 12
+	case LoanQuarterly:
+		return 4
+	case LoanSemiAnnual:
+		return 2
+	case LoanAnnual:
+		return 1
+	}
+	return 0
+}
+
+// DueDate returns the date the n-th installment falls due for a loan disbursed on start. Month-based
+// frequencies keep the day of month of start, moved back to the last day of shorter months.
+func (f LoanFrequency) DueDate(start time.Time, n int) time.Time {
+	switch f {
+	case LoanWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case LoanBiweekly:
+		return start.AddDate(0, 0, 14*n)
+	}
+	months := 12 / f.PeriodsPerYear() * n
+	firstOfMonth := time.Date(start.Year(), start.Month()+time.Month(months), 1, 0, 0, 0, 0, start.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := start.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, start.Location())
+}
+
+// PrepaymentMode tells how the schedule is recalculated after an extra principal payment
+type PrepaymentMode string
+
+const (
+	PrepaymentReducePayment PrepaymentMode = "REDUCE_PAYMENT" // Keep the term; lower the installments
+	PrepaymentReduceTerm    PrepaymentMode = "REDUCE_TERM"    // Keep the installment amount; finish earlier
+)
+
+// LoanPaymentType distinguishes scheduled installments from extra principal payments
+type LoanPaymentType string
+
+const (
+	LoanPaymentInstallment LoanPaymentType = "INSTALLMENT"
+	LoanPaymentPrepayment  LoanPaymentType = "PREPAYMENT"
+)
+
+// Loan is a loan or mortgage tracked in a LIABILITY account. Installments are equal annuity payments
+// split into interest on the outstanding principal and repayment of principal.
+type Loan struct {
+	LoanID                string          `json:"loanID"`
+	WorkplaceID           string          `json:"workplaceID"`
+	AccountID             string          `json:"accountID"`         // LIABILITY account of the loan
+	InterestAccountID     string          `json:"interestAccountID"` // EXPENSE account charged with interest
+	Name                  string          `json:"name"`
+	Principal             decimal.Decimal `json:"principal"`
+	AnnualRate            decimal.Decimal `json:"annualRate"` // Nominal annual rate in percent
+	Term                  int             `json:"term"`       // Number of installments
+	Frequency             LoanFrequency   `json:"frequency"`
+	StartDate             time.Time       `json:"startDate"`
+	DisbursementJournalID string          `json:"disbursementJournalID,omitempty"`
+	AuditFields
+}
+
+// PeriodicRate returns the interest rate applied per installment period
+func (l Loan) PeriodicRate() decimal.Decimal {
+	return l.AnnualRate.Div(decimal.NewFromInt(int64(100 * l.Frequency.PeriodsPerYear())))
+}
+
+// LoanPayment is an installment or prepayment posted against a loan
+type LoanPayment struct {
+	PaymentID         string          `json:"paymentID"`
+	LoanID            string          `json:"loanID"`
+	JournalID         string          `json:"journalID"`
+	PaymentType       LoanPaymentType `json:"paymentType"`
+	InstallmentNumber int             `json:"installmentNumber,omitempty"` // Set for installments
+	PrepaymentMode    PrepaymentMode  `json:"prepaymentMode,omitempty"`    // Set for prepayments
+	PaymentDate       time.Time       `json:"paymentDate"`
+	Principal         decimal.Decimal `json:"principal"`
+	Interest          decimal.Decimal `json:"interest"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	CreatedBy         string          `json:"createdBy"`
+}
+
+// Installment is one line of an amortization schedule. Paid installments show the amounts posted.
+type Installment struct {
+	Number             int             `json:"number"`
+	DueDate            time.Time       `json:"dueDate"`
+	Payment            decimal.Decimal `json:"payment"`
+	Principal          decimal.Decimal `json:"principal"`
+	Interest           decimal.Decimal `json:"interest"`
+	RemainingPrincipal decimal.Decimal `json:"remainingPrincipal"` // Outstanding after this installment
+	Paid               bool            `json:"paid"`
+	JournalID          string          `json:"journalID,omitempty"`
+}
+
+// LoanSchedule is the amortization schedule of a loan with its outstanding principal compared to the
+// balance of the loan account
+type LoanSchedule struct {
+	Loan               Loan            `json:"loan"`
+	CurrencyCode       string          `json:"currencyCode"`
+	Installments       []Installment   `json:"installments"`
+	Prepayments        []LoanPayment   `json:"prepayments"`
+	RemainingPrincipal decimal.Decimal `json:"remainingPrincipal"` // Principal less all principal repaid
+	AccountBalance     decimal.Decimal `json:"accountBalance"`     // Balance of the LIABILITY account
+	Difference         decimal.Decimal `json:"difference"`         // AccountBalance less RemainingPrincipal
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Payee is a counterparty of a workplace, such as a vendor, customer or employer.
+// Aliases are alternative spellings used to recognise the payee in free-text descriptions.
+type Payee struct {
+	PayeeID                 string   `json:"payeeID"`
+	WorkplaceID             string   `json:"workplaceID"`
+	Name                    string   `json:"name"`
+	Aliases                 []string `json:"aliases"`
+	DefaultCounterAccountID string   `json:"defaultCounterAccountID"` // Nullable; suggested other side of journals with the payee
+	IsActive                bool     `json:"isActive"`
+	AuditFields
+}
+
+// PayeeMatch is the payee recognised in a free-text description and the name or alias that matched
+type PayeeMatch struct {
+	Payee       Payee  `json:"payee"`
+	MatchedTerm string `json:"matchedTerm"`
+}
+
+// PayeeAmount is the spend and income attributed to one payee in one currency over a period.
+// Spend is the net debit to EXPENSE accounts and Income the net credit to REVENUE accounts.
+type PayeeAmount struct {
+	PayeeID      string          `json:"payeeID"` // Empty for lines without a payee
+	Name         string          `json:"name"`
+	CurrencyCode string          `json:"currencyCode"`
+	Spend        decimal.Decimal `json:"spend"`
+	Income       decimal.Decimal `json:"income"`
+}
+
+// PayeeReport rolls up spend and income per payee over a period
+type PayeeReport struct {
+	Payees []PayeeAmount `json:"payees"`
+}
+
+// NormalizePayee lower-cases a payee and collapses its whitespace so spelling variants group together
+func NormalizePayee(payee string) string {
+	return strings.Join(strings.Fields(strings.ToLower(payee)), " ")
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Sources of commodity prices other than a price provider, which is recorded under its own name
+const (
+	PriceSourceManual = "MANUAL" // Entered through the API
+	PriceSourceImport = "IMPORT" // Loaded from an uploaded CSV file
+)
+
+// CommodityPrice is the price of one unit of a security, crypto asset or other commodity in a quote
+// currency on a date. Prices are kept apart from currency ExchangeRates.
+type CommodityPrice struct {
+	Commodity     string          `json:"commodity"` // Upper-case symbol
+	QuoteCurrency string          `json:"quoteCurrency"`
+	PriceDate     time.Time       `json:"priceDate"`
+	Price         decimal.Decimal `json:"price"`
+	Source        string          `json:"source"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CreatedBy     string          `json:"createdBy"`
+}
+
+// PriceKey identifies the price series of a commodity in a quote currency
+type PriceKey struct {
+	Commodity     string `json:"commodity"`
+	QuoteCurrency string `json:"quoteCurrency"`
+}
+
+// PriceRowError explains why a row of a price file was not loaded
+type PriceRowError struct {
+	RowNumber int    `json:"rowNumber"`
+	Error     string `json:"error"`
+}
+
+// PriceImportResult summarises a bulk load of prices from a file or a price provider
+type PriceImportResult struct {
+	Source   string          `json:"source"`
+	Imported int             `json:"imported"` // Prices stored, including prices that replaced earlier ones
+	Errors   []PriceRowError `json:"errors"`   // Rows skipped
+}
+
+// NormalizeCommodity upper-cases a commodity symbol and trims its whitespace
+func NormalizeCommodity(commodity string) string {
+	return strings.ToUpper(strings.TrimSpace(commodity))
+}
+
+// PriceDay truncates a time to the UTC calendar day prices are recorded for
+func PriceDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ClearingStatus tells whether a transaction line has been matched against a bank statement
+type ClearingStatus string
+
+const (
+	Uncleared  ClearingStatus = "UNCLEARED"
+	Cleared    ClearingStatus = "CLEARED"    // Ticked in an open reconciliation session
+	Reconciled ClearingStatus = "RECONCILED" // Locked by a completed reconciliation session
+)
+
+// ReconciliationStatus is the state of a reconciliation session
+type ReconciliationStatus string
+
+const (
+	ReconciliationInProgress ReconciliationStatus = "IN_PROGRESS"
+	ReconciliationCompleted  ReconciliationStatus = "COMPLETED"
+)
+
+// Reconciliation is a session matching an ASSET or LIABILITY account against a bank statement
+type Reconciliation struct {
+	ReconciliationID string               `json:"reconciliationID"`
+	WorkplaceID      string               `json:"workplaceID"`
+	AccountID        string               `json:"accountID"`
+	StatementDate    time.Time            `json:"statementDate"`
+	EndingBalance    decimal.Decimal      `json:"endingBalance"` // In the account's balance convention
+	Status           ReconciliationStatus `json:"status"`
+	CompletedAt      *time.Time           `json:"completedAt,omitempty"`
+	CompletedBy      string               `json:"completedBy,omitempty"`
+	AuditFields
+}
+
+// ReconciliationTotals are the debit-minus-credit sums of an account's reconciled lines and of the lines
+// cleared in one session
+type ReconciliationTotals struct {
+	ReconciledTotal decimal.Decimal
+	ClearedTotal    decimal.Decimal
+	ClearedCount    int
+}
+
+// ReconciliationSummary is a session with its live difference. Balances use the account's convention:
+// debits increase ASSET accounts and credits increase LIABILITY accounts.
+type ReconciliationSummary struct {
+	Reconciliation
+	OpeningBalance decimal.Decimal `json:"openingBalance"` // Lines reconciled by earlier sessions
+	ClearedAmount  decimal.Decimal `json:"clearedAmount"`  // Net effect of the lines cleared in this session
+	ClearedCount   int             `json:"clearedCount"`
+	ClearedBalance decimal.Decimal `json:"clearedBalance"` // OpeningBalance + ClearedAmount
+	Difference     decimal.Decimal `json:"difference"`     // EndingBalance - ClearedBalance; zero when the session can be completed
+}
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -36,3 +39,245 @@ type BalanceSheetReport struct {
 	TotalLiabilities decimal.Decimal `json:"totalLiabilities"`
 	TotalEquity      decimal.Decimal `json:"totalEquity"`
 }
+
+// AccountMovement holds the debit-positive balance of a balance sheet account before a period
+// and its net debit-positive movement within that period. It is the raw input for the cash flow report.
+type AccountMovement struct {
+	AccountID        string           `json:"accountID"`
+	Name             string           `json:"name"`
+	AccountType      AccountType      `json:"accountType"`
+	CashFlowCategory CashFlowCategory `json:"cashFlowCategory"` // Effective category (never empty)
+	OpeningBalance   decimal.Decimal  `json:"openingBalance"`   // Net debit balance before the period start
+	Movement         decimal.Decimal  `json:"movement"`         // Net debit movement within the period
+}
+
+// CashFlowSection groups the cash effect of the accounts belonging to one cash flow activity
+type CashFlowSection struct {
+	Lines []AccountAmount `json:"lines"` // Cash effect per account (positive = cash inflow)
+	Total decimal.Decimal `json:"total"`
+}
+
+// CashFlowReport represents an indirect-method cash flow statement
+type CashFlowReport struct {
+	NetProfit          decimal.Decimal `json:"netProfit"`          // Starting point of the operating section
+	Operating          CashFlowSection `json:"operating"`          // Working-capital adjustments
+	NetOperating       decimal.Decimal `json:"netOperating"`       // Net profit plus operating adjustments
+	Investing          CashFlowSection `json:"investing"`          // Cash used in or generated by investing activities
+	Financing          CashFlowSection `json:"financing"`          // Cash used in or generated by financing activities
+	NetChangeInCash    decimal.Decimal `json:"netChangeInCash"`    // Sum of operating, investing and financing activities
+	OpeningCash        decimal.Decimal `json:"openingCash"`        // Balance of CASH accounts before the period
+	ClosingCash        decimal.Decimal `json:"closingCash"`        // Balance of CASH accounts at the end of the period
+	ActualChangeInCash decimal.Decimal `json:"actualChangeInCash"` // Movement of CASH accounts within the period
+	Difference         decimal.Decimal `json:"difference"`         // NetChangeInCash minus ActualChangeInCash
+	Reconciled         bool            `json:"reconciled"`         // True when Difference is zero
+}
+
+// LedgerCounterAccount identifies an account on the other side of a ledger entry's journal
+type LedgerCounterAccount struct {
+	AccountID string `json:"accountID"`
+	Name      string `json:"name"`
+}
+
+// LedgerEntry represents a single posting in an account's general ledger
+type LedgerEntry struct {
+	AccountID          string                 `json:"accountID"`
+	TransactionID      string                 `json:"transactionID"`
+	JournalID          string                 `json:"journalID"`
+	JournalDate        time.Time              `json:"journalDate"`
+	TransactionDate    time.Time              `json:"transactionDate"`
+	JournalDescription string                 `json:"journalDescription"`
+	Notes              string                 `json:"notes"`
+	TransactionType    TransactionType        `json:"transactionType"`
+	Amount             decimal.Decimal        `json:"amount"`         // Positive value
+	RunningBalance     decimal.Decimal        `json:"runningBalance"` // Balance after this entry, in the account's normal sign
+	CounterAccounts    []LedgerCounterAccount `json:"counterAccounts"`
+}
+
+// AccountLedger represents the postings of one account for a period, bracketed by its opening and closing balances.
+// Balances follow the account's normal sign (debit-positive for assets and expenses, credit-positive otherwise).
+type AccountLedger struct {
+	AccountID      string          `json:"accountID"`
+	Name           string          `json:"name"`
+	AccountType    AccountType     `json:"accountType"`
+	CurrencyCode   string          `json:"currencyCode"`
+	OpeningBalance decimal.Decimal `json:"openingBalance"` // Balance before the period start
+	TotalDebit     decimal.Decimal `json:"totalDebit"`
+	TotalCredit    decimal.Decimal `json:"totalCredit"`
+	ClosingBalance decimal.Decimal `json:"closingBalance"` // Balance at the period end
+	Entries        []LedgerEntry   `json:"entries"`
+}
+
+// GeneralLedgerReport represents a general ledger for a period
+type GeneralLedgerReport struct {
+	Accounts []AccountLedger `json:"accounts"`
+}
+
+// ReportPeriod represents one column of a comparative report.
+// Balance sheet comparisons use To as the as-of date of the column.
+type ReportPeriod struct {
+	Label string    `json:"label"`
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+}
+
+// MonthlyPeriods splits the range from..to into calendar-month periods, clipping the first and last month to the range
+func MonthlyPeriods(from, to time.Time) []ReportPeriod {
+	periods := []ReportPeriod{}
+	for start := from; !start.After(to); {
+		nextMonth := time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, start.Location())
+		end := nextMonth.AddDate(0, 0, -1)
+		if end.After(to) {
+			end = to
+		}
+		periods = append(periods, ReportPeriod{Label: start.Format("2006-01"), From: start, To: end})
+		start = nextMonth
+	}
+	return periods
+}
+
+// YearOverYearPeriods returns the range from..to preceded by the same range one year earlier
+func YearOverYearPeriods(from, to time.Time) []ReportPeriod {
+	lastFrom, lastTo := from.AddDate(-1, 0, 0), to.AddDate(-1, 0, 0)
+	return []ReportPeriod{
+		{Label: lastFrom.Format("2006-01-02") + ".." + lastTo.Format("2006-01-02"), From: lastFrom, To: lastTo},
+		{Label: from.Format("2006-01-02") + ".." + to.Format("2006-01-02"), From: from, To: to},
+	}
+}
+
+// PeriodAccountAmount is the net amount of an account within one period of a multi-period query.
+// NetAmount follows the account's normal sign, as in AccountAmount.
+type PeriodAccountAmount struct {
+	PeriodIndex int             `json:"periodIndex"` // Index into the requested periods
+	AccountID   string          `json:"accountID"`
+	Name        string          `json:"name"`
+	AccountType AccountType     `json:"accountType"`
+	NetAmount   decimal.Decimal `json:"netAmount"`
+}
+
+// ComparativeValue represents an amount in one period column together with its change from the previous column
+type ComparativeValue struct {
+	Amount          decimal.Decimal  `json:"amount"`
+	Variance        decimal.Decimal  `json:"variance"`        // Amount minus the previous column's amount (zero for the first column)
+	VariancePercent *decimal.Decimal `json:"variancePercent"` // Variance relative to the previous column; nil when the previous amount is zero
+}
+
+// ComparativeLine represents one account across all period columns
+type ComparativeLine struct {
+	AccountID string             `json:"accountID"`
+	Name      string             `json:"name"`
+	Values    []ComparativeValue `json:"values"` // One value per period
+}
+
+// ComparativeSection groups comparative lines of one account type with per-period totals
+type ComparativeSection struct {
+	Lines  []ComparativeLine  `json:"lines"`
+	Totals []ComparativeValue `json:"totals"`
+}
+
+// ComparativePAndLReport represents a profit and loss report with one column per period
+type ComparativePAndLReport struct {
+	Periods   []ReportPeriod     `json:"periods"`
+	Revenue   ComparativeSection `json:"revenue"`
+	Expenses  ComparativeSection `json:"expenses"`
+	NetProfit []ComparativeValue `json:"netProfit"`
+}
+
+// ComparativeBalanceSheetReport represents a balance sheet with one column per as-of date
+type ComparativeBalanceSheetReport struct {
+	Periods     []ReportPeriod     `json:"periods"`
+	Assets      ComparativeSection `json:"assets"`
+	Liabilities ComparativeSection `json:"liabilities"`
+	Equity      ComparativeSection `json:"equity"`
+}
+
+// TimeSeriesInterval is the width of the buckets of a time series
+type TimeSeriesInterval string
+
+const (
+	IntervalDay   TimeSeriesInterval = "day"
+	IntervalWeek  TimeSeriesInterval = "week" // ISO weeks, starting on Monday
+	IntervalMonth TimeSeriesInterval = "month"
+)
+
+// TimeSeriesMode selects whether a time series reports balances or flows
+type TimeSeriesMode string
+
+const (
+	// TimeSeriesCumulative reports the balance at the end of each bucket (e.g. net worth)
+	TimeSeriesCumulative TimeSeriesMode = "cumulative"
+	// TimeSeriesPeriodic reports the net movement within each bucket (e.g. category spending)
+	TimeSeriesPeriodic TimeSeriesMode = "periodic"
+)
+
+// TimeSeriesSelection chooses the accounts of a time series. An account is included when it matches
+// any of the criteria; ParentAccountIDs include the parent and all of its descendants.
+type TimeSeriesSelection struct {
+	AccountIDs       []string      `json:"accountIDs"`
+	AccountTypes     []AccountType `json:"accountTypes"`
+	ParentAccountIDs []string      `json:"parentAccountIDs"`
+}
+
+// IsEmpty reports whether no selection criteria are set
+func (s TimeSeriesSelection) IsEmpty() bool {
+	return len(s.AccountIDs) == 0 && len(s.AccountTypes) == 0 && len(s.ParentAccountIDs) == 0
+}
+
+// TimeSeriesBuckets splits the range from..to into day, week or month buckets,
+// clipping the first and last bucket to the range
+func TimeSeriesBuckets(from, to time.Time, interval TimeSeriesInterval) []ReportPeriod {
+	buckets := []ReportPeriod{}
+	for start := from; !start.After(to); {
+		var next time.Time
+		var label string
+		switch interval {
+		case IntervalWeek:
+			// Days since Monday (Sunday is the last day of an ISO week)
+			offset := (int(start.Weekday()) + 6) % 7
+			next = time.Date(start.Year(), start.Month(), start.Day()-offset+7, 0, 0, 0, 0, start.Location())
+			year, week := start.ISOWeek()
+			label = fmt.Sprintf("%d-W%02d", year, week)
+		case IntervalMonth:
+			next = time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, start.Location())
+			label = start.Format("2006-01")
+		default:
+			next = time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, start.Location())
+			label = start.Format("2006-01-02")
+		}
+		end := next.AddDate(0, 0, -1)
+		if end.After(to) {
+			end = to
+		}
+		buckets = append(buckets, ReportPeriod{Label: label, From: start, To: end})
+		start = next
+	}
+	return buckets
+}
+
+// TimeSeriesPoint is the debit-positive balance and flow of one account in one bucket of a time series query
+type TimeSeriesPoint struct {
+	BucketIndex int             `json:"bucketIndex"` // Index into the requested buckets
+	AccountID   string          `json:"accountID"`
+	Name        string          `json:"name"`
+	AccountType AccountType     `json:"accountType"`
+	Flow        decimal.Decimal `json:"flow"`    // Net debit movement within the bucket
+	Balance     decimal.Decimal `json:"balance"` // Net debit balance at the end of the bucket
+}
+
+// TimeSeriesLine holds the values of one account for every bucket, in the account's normal sign
+type TimeSeriesLine struct {
+	AccountID   string            `json:"accountID"`
+	Name        string            `json:"name"`
+	AccountType AccountType       `json:"accountType"`
+	Values      []decimal.Decimal `json:"values"` // One value per bucket
+}
+
+// TimeSeriesReport represents per-bucket balances or flows of the selected accounts.
+// Totals are debit-positive (assets minus liabilities gives net worth), except when every selected
+// account is credit-normal, in which case they are credit-positive like the lines.
+type TimeSeriesReport struct {
+	Interval TimeSeriesInterval `json:"interval"`
+	Mode     TimeSeriesMode     `json:"mode"`
+	Buckets  []ReportPeriod     `json:"buckets"`
+	Lines    []TimeSeriesLine   `json:"lines"`
+	Totals   []decimal.Decimal  `json:"totals"` // One total per bucket
+}
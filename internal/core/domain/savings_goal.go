@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SavingsGoalStatus describes how a savings goal is progressing
+type SavingsGoalStatus string
+
+const (
+	GoalAchieved SavingsGoalStatus = "ACHIEVED" // The linked balances reached the target amount
+	GoalOnTrack  SavingsGoalStatus = "ON_TRACK" // The average contribution covers the required monthly contribution
+	GoalBehind   SavingsGoalStatus = "BEHIND"   // Contributions are too low, or the target date has passed
+)
+
+// SavingsGoal is a target amount to be saved by a date in one or more ASSET accounts
+type SavingsGoal struct {
+	GoalID       string          `json:"goalID"`
+	WorkplaceID  string          `json:"workplaceID"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	TargetAmount decimal.Decimal `json:"targetAmount"`
+	TargetDate   time.Time       `json:"targetDate"`
+	CurrencyCode string          `json:"currencyCode"` // Shared by all linked accounts
+	AccountIDs   []string        `json:"accountIDs"`
+	AuditFields
+}
+
+// SavingsGoalProgress is the derived progress of a savings goal as of a date
+type SavingsGoalProgress struct {
+	Goal            SavingsGoal     `json:"goal"`
+	AsOf            time.Time       `json:"asOf"`
+	CurrentAmount   decimal.Decimal `json:"currentAmount"` // Sum of the linked account balances
+	RemainingAmount decimal.Decimal `json:"remainingAmount"`
+	PercentComplete decimal.Decimal `json:"percentComplete"`
+	MonthsRemaining int             `json:"monthsRemaining"` // Whole months until the target month; 0 when it has passed
+	// RequiredMonthlyContribution is what must be saved each remaining month to reach the target on time
+	RequiredMonthlyContribution decimal.Decimal `json:"requiredMonthlyContribution"`
+	// AverageMonthlyContribution is the average net inflow of the linked accounts over the trailing complete months
+	AverageMonthlyContribution decimal.Decimal `json:"averageMonthlyContribution"`
+	TrailingMonths             int             `json:"trailingMonths"`
+	// ProjectedCompletionDate extrapolates the average contribution; nil when it is not positive
+	ProjectedCompletionDate *time.Time        `json:"projectedCompletionDate"`
+	Status                  SavingsGoalStatus `json:"status"`
+}
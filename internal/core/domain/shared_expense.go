@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SplitMethod defines how a shared expense is divided between members
+type SplitMethod string
+
+const (
+	SplitEqual      SplitMethod = "EQUAL"      // Divided evenly between the members
+	SplitPercentage SplitMethod = "PERCENTAGE" // Each member bears a percentage; percentages add up to 100
+	SplitExact      SplitMethod = "EXACT"      // Each member bears an exact amount; amounts add up to the total
+)
+
+// MemberAccounts are the sub-accounts tracking one member's position in one currency. Debits to the receivable
+// (ASSET) account record what the member owes; credits to the payable (LIABILITY) account record what the
+// member is owed. The entry without a UserID holds the parent accounts the member sub-accounts are grouped under.
+type MemberAccounts struct {
+	WorkplaceID         string    `json:"workplaceID"`
+	UserID              string    `json:"userID,omitempty"`
+	CurrencyCode        string    `json:"currencyCode"`
+	ReceivableAccountID string    `json:"receivableAccountID"`
+	PayableAccountID    string    `json:"payableAccountID"`
+	CreatedAt           time.Time `json:"createdAt"`
+	CreatedBy           string    `json:"createdBy"`
+}
+
+// ExpenseShare is the part of a shared expense borne by one member
+type ExpenseShare struct {
+	UserID string          `json:"userID"`
+	Value  decimal.Decimal `json:"value"`  // Percentage or exact amount as entered; zero for equal splits
+	Amount decimal.Decimal `json:"amount"` // Amount borne by the member in the expense currency
+}
+
+// SharedExpense marks an expense journal as shared between workplace members. The member who paid is owed the
+// shares of the other members, which are posted to the member accounts by the share journal.
+type SharedExpense struct {
+	SharedExpenseID string          `json:"sharedExpenseID"`
+	WorkplaceID     string          `json:"workplaceID"`
+	JournalID       string          `json:"journalID"` // The expense journal being shared
+	PaidByUserID    string          `json:"paidByUserID"`
+	SplitMethod     SplitMethod     `json:"splitMethod"`
+	CurrencyCode    string          `json:"currencyCode"`
+	TotalAmount     decimal.Decimal `json:"totalAmount"`    // Net expense amount of the journal
+	ShareJournalID  string          `json:"shareJournalID"` // Journal posting the shares to the member accounts
+	Shares          []ExpenseShare  `json:"shares"`
+	AuditFields
+}
+
+// MemberBalance is the position of a member in one currency. Receivable is what the member owes and Payable
+// what the member is owed; a positive Net means the other members owe this member.
+type MemberBalance struct {
+	UserID       string          `json:"userID"`
+	UserName     string          `json:"userName"`
+	CurrencyCode string          `json:"currencyCode"`
+	Receivable   decimal.Decimal `json:"receivable"`
+	Payable      decimal.Decimal `json:"payable"`
+	Net          decimal.Decimal `json:"net"` // Payable - Receivable
+}
+
+// MemberDebt is a repayment that would settle the balances: FromUserID pays ToUserID the amount
+type MemberDebt struct {
+	FromUserID   string          `json:"fromUserID"`
+	ToUserID     string          `json:"toUserID"`
+	CurrencyCode string          `json:"currencyCode"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+// MemberBalances lists the member positions of a workplace together with the repayments that settle them
+type MemberBalances struct {
+	Balances []MemberBalance `json:"balances"`
+	Debts    []MemberDebt    `json:"debts"`
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "github.com/shopspring/decimal"
+
+// TaxRole marks a journal line generated from a tax code as carrying the net amount or the tax.
+type TaxRole string
+
+const (
+	TaxRoleNet TaxRole = "NET"
+	TaxRoleTax TaxRole = "TAX"
+)
+
+// TaxCode is a VAT/GST rate of a workplace. Tax computed with the code is posted to its tax account.
+// Amounts entered against an inclusive code already contain the tax; exclusive codes add it on top.
+type TaxCode struct {
+	TaxCodeID    string          `json:"taxCodeID"`
+	WorkplaceID  string          `json:"workplaceID"`
+	Code         string          `json:"code"`
+	Name         string          `json:"name"`
+	Rate         decimal.Decimal `json:"rate"` // Percent, e.g. 20 for 20%
+	TaxAccountID string          `json:"taxAccountID"`
+	Inclusive    bool            `json:"inclusive"`
+	IsActive     bool            `json:"isActive"`
+	AuditFields
+}
+
+// Split divides an amount entered against the code into its net and tax parts, rounding the tax to the
+// given number of decimal places. For inclusive codes the parts add up to the amount; for exclusive codes
+// the net part is the amount itself.
+func (t TaxCode) Split(amount decimal.Decimal, precision int32) (net, tax decimal.Decimal) {
+	hundred := decimal.NewFromInt(100)
+	if t.Inclusive {
+		tax = amount.Mul(t.Rate).Div(hundred.Add(t.Rate)).Round(precision)
+		return amount.Sub(tax), tax
+	}
+	return amount, amount.Mul(t.Rate).Div(hundred).Round(precision)
+}
+
+// TaxReturnLine summarizes the lines posted with one tax code in one currency over a period.
+// Credit lines count as sales and output tax, debit lines as purchases and input tax.
+type TaxReturnLine struct {
+	TaxCodeID        string          `json:"taxCodeID"`
+	Code             string          `json:"code"`
+	Name             string          `json:"name"`
+	Rate             decimal.Decimal `json:"rate"`
+	CurrencyCode     string          `json:"currencyCode"`
+	TaxableSales     decimal.Decimal `json:"taxableSales"`
+	OutputTax        decimal.Decimal `json:"outputTax"`
+	TaxablePurchases decimal.Decimal `json:"taxablePurchases"`
+	InputTax         decimal.Decimal `json:"inputTax"`
+	NetTax           decimal.Decimal `json:"netTax"` // Output tax less input tax; positive when tax is payable
+}
+
+// TaxReturnTotal sums the lines of a tax return in one currency
+type TaxReturnTotal struct {
+	CurrencyCode string          `json:"currencyCode"`
+	OutputTax    decimal.Decimal `json:"outputTax"`
+	InputTax     decimal.Decimal `json:"inputTax"`
+	NetTax       decimal.Decimal `json:"netTax"`
+}
+
+// TaxReturn summarizes taxable amounts and tax collected and paid per tax code over a period
+type TaxReturn struct {
+	Lines  []TaxReturnLine  `json:"lines"`
+	Totals []TaxReturnTotal `json:"totals"`
+}
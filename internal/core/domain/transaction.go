@@ -16,14 +16,23 @@ const (
 
 // Transaction represents a single line item within a Journal, affecting one account.
 type Transaction struct {
-	TransactionID   string          `json:"transactionID"`   // Primary Key (e.g., UUID)
-	JournalID       string          `json:"journalID"`       // FK -> Journal.journalID (Not Null)
-	AccountID       string          `json:"accountID"`       // FK -> Account.accountID (Not Null)
-	Amount          decimal.Decimal `json:"amount"`          // Positive value; Precise decimal type
-	TransactionType TransactionType `json:"transactionType"` // DEBIT or CREDIT (Not Null)
-	CurrencyCode    string          `json:"currencyCode"`    // Must match Journal currency (Not Null)
-	Notes           string          `json:"notes"`           // Nullable
-	TransactionDate time.Time       `json:"transactionDate"` // Date of the transaction (may differ from journal date)
+	TransactionID    string          `json:"transactionID"`    // Primary Key (e.g., UUID)
+	JournalID        string          `json:"journalID"`        // FK -> Journal.journalID (Not Null)
+	AccountID        string          `json:"accountID"`        // FK -> Account.accountID (Not Null)
+	Amount           decimal.Decimal `json:"amount"`           // Positive value; Precise decimal type
+	TransactionType  TransactionType `json:"transactionType"`  // DEBIT or CREDIT (Not Null)
+	CurrencyCode     string          `json:"currencyCode"`     // Must match Journal currency (Not Null)
+	Notes            string          `json:"notes"`            // Nullable
+	TransactionDate  time.Time       `json:"transactionDate"`  // Date of the transaction (may differ from journal date)
+	ClearingStatus   ClearingStatus  `json:"clearingStatus"`   // UNCLEARED, CLEARED or RECONCILED
+	ReconciliationID string          `json:"reconciliationID"` // Nullable; session that cleared the line
+	PayeeID          string          `json:"payeeID"`          // Nullable; overrides the payee of the journal for this line
+	SecurityID       string          `json:"securityID"`       // Nullable; security bought or sold by an investment line
+	Quantity         decimal.Decimal `json:"quantity"`         // Units of the security moved by the line; zero for other lines
+	UnitPrice        decimal.Decimal `json:"unitPrice"`        // Trade price per unit of the security; zero for other lines
+	TaxCodeID        string          `json:"taxCodeID"`        // Nullable; tax code the line was split with
+	TaxRole          TaxRole         `json:"taxRole"`          // NET or TAX for lines split with a tax code; empty otherwise
+	Dimensions       []DimensionTag  `json:"dimensions"`       // The line's own values; the journal's apply to the other dimensions
 	AuditFields
 	// RunningBalance represents the balance of the AccountID *after* this transaction was applied.
 	// This needs to be calculated and stored by the repository during SaveJournal.
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// BankStatementReader defines read operations for staged bank statement data
+type BankStatementReader interface {
+	// FindStatementLineByID retrieves a staged statement line.
+	FindStatementLineByID(ctx context.Context, lineID string) (*domain.BankStatementLine, error)
+
+	// ListStatementLines retrieves the staged lines of an account, newest first, optionally filtered by status.
+	ListStatementLines(ctx context.Context, accountID string, status domain.StatementLineStatus, limit int, offset int) ([]domain.BankStatementLine, error)
+
+	// ListStatementLinesByImport retrieves the lines staged by one import, oldest first.
+	ListStatementLinesByImport(ctx context.Context, importID string) ([]domain.BankStatementLine, error)
+
+	// ListPendingStatementLines retrieves the pending lines of a workplace, optionally limited to one account.
+	ListPendingStatementLines(ctx context.Context, workplaceID string, accountID string) ([]domain.BankStatementLine, error)
+
+	// ListManuallyPostedLines retrieves posted lines since a date whose counter-account was not assigned by a rule.
+	ListManuallyPostedLines(ctx context.Context, workplaceID string, since time.Time) ([]domain.BankStatementLine, error)
+}
+
+// BankStatementWriter defines write operations for staged bank statement data
+type BankStatementWriter interface {
+	// SaveStatementImport records an import and stages its lines in one transaction. Lines whose bank
+	// reference already exists for the account are skipped; the returned import carries the counts.
+	SaveStatementImport(ctx context.Context, statementImport domain.BankStatementImport, lines []domain.BankStatementLine) (*domain.BankStatementImport, error)
+
+	// UpdateStatementLineStatus moves a line from one status to another and sets its journal ID
+	// (empty clears it). Returns ErrConflict when the line is no longer in the expected status.
+	UpdateStatementLineStatus(ctx context.Context, lineID string, from, to domain.StatementLineStatus, journalID string, userID string, now time.Time) error
+}
+
+// BankStatementCategorizationWriter stores the categorization of staged lines
+type BankStatementCategorizationWriter interface {
+	// SaveStatementLineCategorizations stores the counter-account, description, notes and matched rule of lines.
+	SaveStatementLineCategorizations(ctx context.Context, lines []domain.BankStatementLine) error
+}
+
+// BankStatementRepositoryFacade combines all bank statement repository interfaces
+type BankStatementRepositoryFacade interface {
+	BankStatementReader
+	BankStatementWriter
+	BankStatementCategorizationWriter
+}
+
+// BankStatementRepositoryWithTx extends BankStatementRepositoryFacade with transaction capabilities
+type BankStatementRepositoryWithTx interface {
+	BankStatementRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// BillReader defines read operations for vendor bills and their payments
+type BillReader interface {
+	// FindBillByID retrieves a bill with its lines, the status of its posting journal, the payments whose
+	// journals are still posted and its scheduled payments.
+	FindBillByID(ctx context.Context, billID string) (*domain.Bill, error)
+
+	// ListBills retrieves the bills of a workplace, optionally of one vendor, newest first. Lines are not
+	// loaded; the status of the posting journal, the posted payments and the scheduled payments are.
+	ListBills(ctx context.Context, workplaceID string, vendorID string) ([]domain.Bill, error)
+
+	// ListDueScheduledBillPayments retrieves the pending scheduled payments of the workplace's posted bills
+	// dated on or before a date, oldest first.
+	ListDueScheduledBillPayments(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.ScheduledBillPayment, error)
+}
+
+// BillWriter defines write operations for vendor bills and their payments
+type BillWriter interface {
+	// SaveBill persists a new draft bill and its lines.
+	SaveBill(ctx context.Context, bill domain.Bill) error
+
+	// UpdateBill replaces the details and lines of a draft bill.
+	UpdateBill(ctx context.Context, bill domain.Bill) error
+
+	// DeleteBill removes a bill and its lines.
+	DeleteBill(ctx context.Context, billID string) error
+
+	// MarkBillPosted records the posting journal of a draft bill.
+	MarkBillPosted(ctx context.Context, billID string, journalID string, userID string, at time.Time) error
+
+	// SaveBillPayment records a payment made against a bill.
+	SaveBillPayment(ctx context.Context, payment domain.BillPayment) error
+
+	// SaveScheduledBillPayment records a payment planned against a bill.
+	SaveScheduledBillPayment(ctx context.Context, scheduled domain.ScheduledBillPayment) error
+
+	// DeleteScheduledBillPayment removes a scheduled payment.
+	DeleteScheduledBillPayment(ctx context.Context, scheduledPaymentID string) error
+}
+
+// BillRepositoryFacade combines all bill repository interfaces
+type BillRepositoryFacade interface {
+	BillReader
+	BillWriter
+}
+
+// BillRepositoryWithTx extends BillRepositoryFacade with transaction capabilities
+type BillRepositoryWithTx interface {
+	BillRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// BudgetReader defines read operations for budget data
+type BudgetReader interface {
+	// FindBudgetByID retrieves a budget together with its lines.
+	FindBudgetByID(ctx context.Context, budgetID string) (*domain.Budget, error)
+
+	// ListBudgets retrieves the budgets of a workplace without their lines, newest year first.
+	ListBudgets(ctx context.Context, workplaceID string) ([]domain.Budget, error)
+}
+
+// BudgetWriter defines write operations for budget data
+type BudgetWriter interface {
+	// SaveBudget persists a new budget and its lines.
+	SaveBudget(ctx context.Context, budget domain.Budget) error
+
+	// UpdateBudget updates a budget's name and description.
+	UpdateBudget(ctx context.Context, budget domain.Budget) error
+
+	// SetBudgetLines inserts or replaces the given lines of a budget; lines with a zero amount are removed.
+	SetBudgetLines(ctx context.Context, budgetID string, lines []domain.BudgetLine, userID string, now time.Time) error
+
+	// DeleteBudget removes a budget and its lines.
+	DeleteBudget(ctx context.Context, budgetID string) error
+}
+
+// BudgetRepositoryFacade combines all budget-related repository interfaces
+type BudgetRepositoryFacade interface {
+	BudgetReader
+	BudgetWriter
+}
+
+// BudgetRepositoryWithTx extends BudgetRepositoryFacade with transaction capabilities
+type BudgetRepositoryWithTx interface {
+	BudgetRepositoryFacade
+	TransactionManager
+}
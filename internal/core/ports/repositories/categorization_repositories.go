@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// CategorizationRuleReader defines read operations for categorization rules
+type CategorizationRuleReader interface {
+	// FindCategorizationRuleByID retrieves a categorization rule.
+	FindCategorizationRuleByID(ctx context.Context, ruleID string) (*domain.CategorizationRule, error)
+
+	// ListCategorizationRules retrieves the rules of a workplace in the order they are applied.
+	ListCategorizationRules(ctx context.Context, workplaceID string) ([]domain.CategorizationRule, error)
+}
+
+// CategorizationRuleWriter defines write operations for categorization rules
+type CategorizationRuleWriter interface {
+	// SaveCategorizationRule persists a new rule.
+	SaveCategorizationRule(ctx context.Context, rule domain.CategorizationRule) error
+
+	// UpdateCategorizationRule updates an existing rule.
+	UpdateCategorizationRule(ctx context.Context, rule domain.CategorizationRule) error
+
+	// DeleteCategorizationRule removes a rule; lines it matched keep their categorization.
+	DeleteCategorizationRule(ctx context.Context, ruleID string) error
+}
+
+// CategorizationRuleRepositoryFacade combines all categorization rule repository interfaces
+type CategorizationRuleRepositoryFacade interface {
+	CategorizationRuleReader
+	CategorizationRuleWriter
+}
+
+// CategorizationRuleRepositoryWithTx extends CategorizationRuleRepositoryFacade with transaction capabilities
+type CategorizationRuleRepositoryWithTx interface {
+	CategorizationRuleRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// ConsolidationReader defines read operations for consolidation groups and the balances they combine
+type ConsolidationReader interface {
+	// FindConsolidationGroupByID retrieves a group with its members and account mappings.
+	FindConsolidationGroupByID(ctx context.Context, groupID string) (*domain.ConsolidationGroup, error)
+
+	// ListConsolidationGroups retrieves the groups managed from a workplace ordered by name, with their members
+	// and account mappings.
+	ListConsolidationGroups(ctx context.Context, workplaceID string) ([]domain.ConsolidationGroup, error)
+
+	// GetConsolidationBalances retrieves the net posted amount of every account of the given workplaces with
+	// journals dated up to to, and from from onwards when from is not nil. Reversed and reversing journals are
+	// ignored, as in the workplace reports.
+	GetConsolidationBalances(ctx context.Context, workplaceIDs []string, from *time.Time, to time.Time) ([]domain.ConsolidationBalance, error)
+}
+
+// ConsolidationWriter defines write operations for consolidation groups
+type ConsolidationWriter interface {
+	// SaveConsolidationGroup persists a new group with its members and account mappings.
+	// Returns ErrDuplicate when the name is already used in the home workplace.
+	SaveConsolidationGroup(ctx context.Context, group domain.ConsolidationGroup) error
+
+	// UpdateConsolidationGroup updates a group and replaces its members and account mappings.
+	// Returns ErrDuplicate when the name is already used in the home workplace.
+	UpdateConsolidationGroup(ctx context.Context, group domain.ConsolidationGroup) error
+
+	// DeleteConsolidationGroup removes a group with its members and account mappings.
+	DeleteConsolidationGroup(ctx context.Context, groupID string) error
+}
+
+// ConsolidationRepositoryFacade combines all consolidation repository interfaces
+type ConsolidationRepositoryFacade interface {
+	ConsolidationReader
+	ConsolidationWriter
+}
+
+// ConsolidationRepositoryWithTx extends ConsolidationRepositoryFacade with transaction capabilities
+type ConsolidationRepositoryWithTx interface {
+	ConsolidationRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// CreditCardReader defines read operations for credit cards and their billing cycles
+type CreditCardReader interface {
+	// FindCreditCardByAccountID retrieves the billing-cycle settings of an account.
+	FindCreditCardByAccountID(ctx context.Context, accountID string) (*domain.CreditCard, error)
+
+	// ListCreditCards retrieves the credit cards of a workplace.
+	ListCreditCards(ctx context.Context, workplaceID string) ([]domain.CreditCard, error)
+
+	// GetCreditCardBalanceBefore sums, as credits minus debits, the account's lines dated before a date.
+	// Only lines of posted, non-reversal journals are counted.
+	GetCreditCardBalanceBefore(ctx context.Context, workplaceID string, accountID string, before time.Time) (decimal.Decimal, error)
+
+	// ListCreditCardTransactions retrieves the account's lines of posted, non-reversal journals dated from
+	// from (inclusive) to to (exclusive), oldest first.
+	ListCreditCardTransactions(ctx context.Context, workplaceID string, accountID string, from, to time.Time) ([]domain.Transaction, error)
+}
+
+// CreditCardWriter defines write operations for credit cards
+type CreditCardWriter interface {
+	// SaveCreditCard persists the billing-cycle settings of an account. Returns ErrDuplicate when the account already has them.
+	SaveCreditCard(ctx context.Context, card domain.CreditCard) error
+
+	// UpdateCreditCard replaces the billing-cycle settings of an account.
+	UpdateCreditCard(ctx context.Context, card domain.CreditCard) error
+
+	// DeleteCreditCard removes the billing-cycle settings of an account; the account is left untouched.
+	DeleteCreditCard(ctx context.Context, accountID string) error
+}
+
+// CreditCardRepositoryFacade combines all credit card repository interfaces
+type CreditCardRepositoryFacade interface {
+	CreditCardReader
+	CreditCardWriter
+}
+
+// CreditCardRepositoryWithTx extends CreditCardRepositoryFacade with transaction capabilities
+type CreditCardRepositoryWithTx interface {
+	CreditCardRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// CSVImportProfileReader defines read operations for CSV import profiles
+type CSVImportProfileReader interface {
+	// FindCSVImportProfileByID retrieves a CSV import profile.
+	FindCSVImportProfileByID(ctx context.Context, profileID string) (*domain.CSVImportProfile, error)
+
+	// ListCSVImportProfiles retrieves the profiles of a workplace, optionally limited to one account.
+	ListCSVImportProfiles(ctx context.Context, workplaceID string, accountID string) ([]domain.CSVImportProfile, error)
+}
+
+// CSVImportProfileWriter defines write operations for CSV import profiles
+type CSVImportProfileWriter interface {
+	// SaveCSVImportProfile persists a new profile. Returns ErrDuplicate when the account already has a profile with the name.
+	SaveCSVImportProfile(ctx context.Context, profile domain.CSVImportProfile) error
+
+	// UpdateCSVImportProfile updates an existing profile.
+	UpdateCSVImportProfile(ctx context.Context, profile domain.CSVImportProfile) error
+
+	// DeleteCSVImportProfile removes a profile.
+	DeleteCSVImportProfile(ctx context.Context, profileID string) error
+}
+
+// CSVImportProfileRepositoryFacade combines all CSV import profile repository interfaces
+type CSVImportProfileRepositoryFacade interface {
+	CSVImportProfileReader
+	CSVImportProfileWriter
+}
+
+// CSVImportProfileRepositoryWithTx extends CSVImportProfileRepositoryFacade with transaction capabilities
+type CSVImportProfileRepositoryWithTx interface {
+	CSVImportProfileRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// DimensionReader defines read operations for analytic dimensions and the tags of journals
+type DimensionReader interface {
+	// FindDimensionByID retrieves a dimension with its values ordered by code.
+	FindDimensionByID(ctx context.Context, dimensionID string) (*domain.Dimension, error)
+
+	// ListDimensions retrieves the dimensions of a workplace ordered by code, each with its values ordered by code.
+	ListDimensions(ctx context.Context, workplaceID string) ([]domain.Dimension, error)
+
+	// FindJournalDimensions retrieves the values assigned to a journal and the values assigned to its lines,
+	// keyed by transaction ID. Lines without values of their own are omitted.
+	FindJournalDimensions(ctx context.Context, journalID string) ([]domain.DimensionTag, map[string][]domain.DimensionTag, error)
+}
+
+// DimensionWriter defines write operations for analytic dimensions and the tags of journals
+type DimensionWriter interface {
+	// SaveDimension persists a new dimension. Returns ErrDuplicate when the code is already used in the workplace.
+	SaveDimension(ctx context.Context, dimension domain.Dimension) error
+
+	// UpdateDimension updates a dimension. Returns ErrDuplicate when the code is already used in the workplace.
+	UpdateDimension(ctx context.Context, dimension domain.Dimension) error
+
+	// DeleteDimension removes a dimension and its values. Returns ErrConflict when journals or lines are tagged
+	// with one of its values.
+	DeleteDimension(ctx context.Context, dimensionID string) error
+
+	// SaveDimensionValue persists a new value. Returns ErrDuplicate when the code is already used in the dimension.
+	SaveDimensionValue(ctx context.Context, value domain.DimensionValue) error
+
+	// UpdateDimensionValue updates a value. Returns ErrDuplicate when the code is already used in the dimension.
+	UpdateDimensionValue(ctx context.Context, value domain.DimensionValue) error
+
+	// DeleteDimensionValue removes a value. Returns ErrConflict when journals or lines are tagged with it.
+	DeleteDimensionValue(ctx context.Context, valueID string) error
+
+	// ReplaceJournalDimensions replaces, in one database transaction, the values assigned to a journal and
+	// those of the lines present in lineTags. Lines absent from lineTags keep their values.
+	ReplaceJournalDimensions(ctx context.Context, journalID string, journalTags []domain.DimensionTag, lineTags map[string][]domain.DimensionTag) error
+}
+
+// DimensionRepositoryFacade combines all dimension repository interfaces
+type DimensionRepositoryFacade interface {
+	DimensionReader
+	DimensionWriter
+}
+
+// DimensionRepositoryWithTx extends DimensionRepositoryFacade with transaction capabilities
+type DimensionRepositoryWithTx interface {
+	DimensionRepositoryFacade
+	TransactionManager
+}
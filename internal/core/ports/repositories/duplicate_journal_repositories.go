@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// DuplicateJournalReader defines read operations for suspected duplicate journals
+type DuplicateJournalReader interface {
+	// FindDuplicateCandidates retrieves posted, non-reversal journals of the workplace other than the given one
+	// with the same currency and amount, dated within [from, to].
+	FindDuplicateCandidates(ctx context.Context, workplaceID string, journalID string, currencyCode string, amount decimal.Decimal, from time.Time, to time.Time) ([]domain.Journal, error)
+
+	// FindDuplicatePairByID retrieves a suspected duplicate pair with the details of both journals.
+	FindDuplicatePairByID(ctx context.Context, pairID string) (*domain.DuplicateJournalPair, error)
+
+	// ListDuplicatePairs retrieves the pairs of a workplace, optionally filtered by status, newest first.
+	ListDuplicatePairs(ctx context.Context, workplaceID string, status domain.DuplicatePairStatus) ([]domain.DuplicateJournalPair, error)
+}
+
+// DuplicateJournalWriter defines write operations for suspected duplicate journals
+type DuplicateJournalWriter interface {
+	// SaveDuplicatePairs persists newly flagged pairs; pairs already recorded are left untouched.
+	SaveDuplicatePairs(ctx context.Context, pairs []domain.DuplicateJournalPair) error
+
+	// ResolveDuplicatePair records the review decision of a pending pair. Returns ErrConflict when it is no longer pending.
+	ResolveDuplicatePair(ctx context.Context, pair domain.DuplicateJournalPair) error
+}
+
+// DuplicateJournalRepositoryFacade combines all duplicate journal repository interfaces
+type DuplicateJournalRepositoryFacade interface {
+	DuplicateJournalReader
+	DuplicateJournalWriter
+}
+
+// DuplicateJournalRepositoryWithTx extends DuplicateJournalRepositoryFacade with transaction capabilities
+type DuplicateJournalRepositoryWithTx interface {
+	DuplicateJournalRepositoryFacade
+	TransactionManager
+}
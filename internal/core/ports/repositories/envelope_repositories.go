@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// EnvelopeReader defines read operations for envelope budgeting data
+type EnvelopeReader interface {
+	// FindEnvelopeSettings retrieves the envelope settings of a workplace; returns ErrNotFound when envelope budgeting is not enabled.
+	FindEnvelopeSettings(ctx context.Context, workplaceID string) (*domain.EnvelopeSettings, error)
+
+	// ListEnvelopeAllocations retrieves the allocations of a workplace for the months between from and to (inclusive), oldest first.
+	ListEnvelopeAllocations(ctx context.Context, workplaceID string, from, to time.Time) ([]domain.EnvelopeAllocation, error)
+
+	// ListEnvelopeAccountIDs retrieves the distinct accounts that have ever received an allocation in a workplace.
+	ListEnvelopeAccountIDs(ctx context.Context, workplaceID string) ([]string, error)
+}
+
+// EnvelopeWriter defines write operations for envelope budgeting data
+type EnvelopeWriter interface {
+	// SaveEnvelopeSettings inserts or replaces the envelope settings of a workplace.
+	SaveEnvelopeSettings(ctx context.Context, settings domain.EnvelopeSettings) error
+
+	// SaveEnvelopeAllocations persists allocations in a single transaction.
+	SaveEnvelopeAllocations(ctx context.Context, allocations []domain.EnvelopeAllocation) error
+}
+
+// EnvelopeRepositoryFacade combines all envelope-related repository interfaces
+type EnvelopeRepositoryFacade interface {
+	EnvelopeReader
+	EnvelopeWriter
+}
+
+// EnvelopeRepositoryWithTx extends EnvelopeRepositoryFacade with transaction capabilities
+type EnvelopeRepositoryWithTx interface {
+	EnvelopeRepositoryFacade
+	TransactionManager
+}
@@ -11,6 +11,8 @@ import (
 type ExchangeRateReader interface {
 	// FindExchangeRate retrieves an exchange rate between two currencies.
 	FindExchangeRate(ctx context.Context, fromCurrencyCode, toCurrencyCode string) (*domain.ExchangeRate, error)
+	// FindExchangeRateAsOf retrieves the exchange rate between two currencies effective on a date.
+	FindExchangeRateAsOf(ctx context.Context, fromCurrencyCode, toCurrencyCode string, date time.Time) (*domain.ExchangeRate, error)
 	// FindExchangeRateByID retrieves an exchange rate by its ID.
 	FindExchangeRateByID(ctx context.Context, rateID string) (*domain.ExchangeRate, error)
 	// FindExchangeRateByIDs retrieves exchange rates by their IDs.
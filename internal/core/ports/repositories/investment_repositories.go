@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// InvestmentReader defines read operations for securities and investment lines
+type InvestmentReader interface {
+	// FindSecurityByID retrieves a security.
+	FindSecurityByID(ctx context.Context, securityID string) (*domain.Security, error)
+
+	// ListSecurities retrieves the securities of a workplace, ordered by symbol.
+	ListSecurities(ctx context.Context, workplaceID string) ([]domain.Security, error)
+
+	// ListSecurityLines retrieves the investment lines of posted journals dated on or before asOf, in the order they
+	// were booked. Reversed journals and their reversals are left out. Empty accountID or securityID match any.
+	ListSecurityLines(ctx context.Context, workplaceID string, accountID string, securityID string, asOf time.Time) ([]domain.SecurityLine, error)
+}
+
+// InvestmentWriter defines write operations for securities
+type InvestmentWriter interface {
+	// SaveSecurity persists a new security. Returns ErrDuplicate when the symbol is already used in the workplace.
+	SaveSecurity(ctx context.Context, security domain.Security) error
+
+	// UpdateSecurity updates a security. Returns ErrDuplicate when the symbol is already used in the workplace.
+	UpdateSecurity(ctx context.Context, security domain.Security) error
+
+	// DeleteSecurity removes a security. Returns ErrConflict when transactions still reference it.
+	DeleteSecurity(ctx context.Context, securityID string) error
+}
+
+// InvestmentRepositoryFacade combines all investment repository interfaces
+type InvestmentRepositoryFacade interface {
+	InvestmentReader
+	InvestmentWriter
+}
+
+// InvestmentRepositoryWithTx extends InvestmentRepositoryFacade with transaction capabilities
+type InvestmentRepositoryWithTx interface {
+	InvestmentRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// InvoiceReader defines read operations for invoices, their payments and numbering
+type InvoiceReader interface {
+	// FindInvoiceByID retrieves an invoice with its lines, the status of its issue journal and the payments
+	// whose journals are still posted.
+	FindInvoiceByID(ctx context.Context, invoiceID string) (*domain.Invoice, error)
+
+	// ListInvoices retrieves the invoices of a workplace, optionally of one customer, newest first. Lines are
+	// not loaded; the status of the issue journal and the payments whose journals are still posted are.
+	ListInvoices(ctx context.Context, workplaceID string, customerID string) ([]domain.Invoice, error)
+
+	// GetInvoiceSequence retrieves the invoice numbering of a workplace. Returns ErrNotFound when it has not been set up.
+	GetInvoiceSequence(ctx context.Context, workplaceID string) (*domain.InvoiceSequence, error)
+}
+
+// InvoiceWriter defines write operations for invoices, their payments and numbering
+type InvoiceWriter interface {
+	// SaveInvoice persists a new draft invoice and its lines.
+	SaveInvoice(ctx context.Context, invoice domain.Invoice) error
+
+	// UpdateInvoice replaces the details and lines of a draft invoice.
+	UpdateInvoice(ctx context.Context, invoice domain.Invoice) error
+
+	// DeleteInvoice removes an invoice and its lines.
+	DeleteInvoice(ctx context.Context, invoiceID string) error
+
+	// MarkInvoiceIssued records the number and issue journal of an invoice. Returns ErrDuplicate when the
+	// number is already used in the workplace.
+	MarkInvoiceIssued(ctx context.Context, invoiceID string, invoiceNumber string, journalID string, userID string, at time.Time) error
+
+	// SaveInvoicePayment records a payment received against an invoice.
+	SaveInvoicePayment(ctx context.Context, payment domain.InvoicePayment) error
+
+	// SaveInvoiceSequence creates or replaces the invoice numbering of a workplace.
+	SaveInvoiceSequence(ctx context.Context, sequence domain.InvoiceSequence) error
+
+	// NextInvoiceNumber takes the next number of the workplace's invoice sequence, setting the sequence up with
+	// its defaults when needed, and returns it formatted with the prefix and padding.
+	NextInvoiceNumber(ctx context.Context, workplaceID string) (string, error)
+}
+
+// InvoiceRepositoryFacade combines all invoice repository interfaces
+type InvoiceRepositoryFacade interface {
+	InvoiceReader
+	InvoiceWriter
+}
+
+// InvoiceRepositoryWithTx extends InvoiceRepositoryFacade with transaction capabilities
+type InvoiceRepositoryWithTx interface {
+	InvoiceRepositoryFacade
+	TransactionManager
+}
@@ -23,6 +23,10 @@ type JournalWriter interface {
 	// SaveJournal persists a journal and its transactions, updating account balances within a transaction.
 	SaveJournal(ctx context.Context, journal domain.Journal, transactions []domain.Transaction, balanceChanges map[string]decimal.Decimal) error
 
+	// SaveTwinJournals persists the two journals of an intercompany posting in one transaction.
+	// A journal reversing another marks the original REVERSED; returns ErrConflict when it is no longer posted.
+	SaveTwinJournals(ctx context.Context, first domain.JournalPosting, second domain.JournalPosting) error
+
 	// UpdateJournalStatusAndLinks updates the status and reversal linkage (original/reversing IDs) of a journal.
 	UpdateJournalStatusAndLinks(ctx context.Context, journalID string, status domain.JournalStatus, reversingJournalID *string, originalJournalID *string, updatedByUserID string, updatedAt time.Time) error
 
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// LoanReader defines read operations for loans and their payments
+type LoanReader interface {
+	// FindLoanByID retrieves a loan.
+	FindLoanByID(ctx context.Context, loanID string) (*domain.Loan, error)
+
+	// ListLoans retrieves the loans of a workplace, ordered by name.
+	ListLoans(ctx context.Context, workplaceID string) ([]domain.Loan, error)
+
+	// ListLoanPayments retrieves the payments of a loan whose journals are still posted, oldest first.
+	ListLoanPayments(ctx context.Context, loanID string) ([]domain.LoanPayment, error)
+}
+
+// LoanWriter defines write operations for loans and their payments
+type LoanWriter interface {
+	// SaveLoan persists a new loan. Returns ErrDuplicate when the account already backs a loan.
+	SaveLoan(ctx context.Context, loan domain.Loan) error
+
+	// UpdateLoan updates the name and interest account of a loan.
+	UpdateLoan(ctx context.Context, loan domain.Loan) error
+
+	// DeleteLoan removes a loan and its payment records; posted journals are left untouched.
+	DeleteLoan(ctx context.Context, loanID string) error
+
+	// SaveLoanPayment records an installment or prepayment posted against a loan.
+	SaveLoanPayment(ctx context.Context, payment domain.LoanPayment) error
+}
+
+// LoanRepositoryFacade combines all loan repository interfaces
+type LoanRepositoryFacade interface {
+	LoanReader
+	LoanWriter
+}
+
+// LoanRepositoryWithTx extends LoanRepositoryFacade with transaction capabilities
+type LoanRepositoryWithTx interface {
+	LoanRepositoryFacade
+	TransactionManager
+}
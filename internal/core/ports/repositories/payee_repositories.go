@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// PayeeReader defines read operations for payees
+type PayeeReader interface {
+	// FindPayeeByID retrieves a payee with its aliases.
+	FindPayeeByID(ctx context.Context, payeeID string) (*domain.Payee, error)
+
+	// ListPayees retrieves the payees of a workplace with their aliases, ordered by name.
+	ListPayees(ctx context.Context, workplaceID string) ([]domain.Payee, error)
+}
+
+// PayeeWriter defines write operations for payees
+type PayeeWriter interface {
+	// SavePayee persists a new payee and its aliases. Returns ErrDuplicate when the name or an alias is already used in the workplace.
+	SavePayee(ctx context.Context, payee domain.Payee) error
+
+	// UpdatePayee updates a payee and replaces its aliases. Returns ErrDuplicate when the name or an alias is already used in the workplace.
+	UpdatePayee(ctx context.Context, payee domain.Payee) error
+
+	// DeletePayee removes a payee. Returns ErrConflict when journals or transactions still reference it.
+	DeletePayee(ctx context.Context, payeeID string) error
+}
+
+// PayeeRepositoryFacade combines all payee repository interfaces
+type PayeeRepositoryFacade interface {
+	PayeeReader
+	PayeeWriter
+}
+
+// PayeeRepositoryWithTx extends PayeeRepositoryFacade with transaction capabilities
+type PayeeRepositoryWithTx interface {
+	PayeeRepositoryFacade
+	TransactionManager
+}
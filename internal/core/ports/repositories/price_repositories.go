@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// PriceReader defines read operations for commodity prices
+type PriceReader interface {
+	// ListPrices retrieves the prices of a commodity in a quote currency dated between from and to inclusive,
+	// newest first. Zero from or to leave that end open.
+	ListPrices(ctx context.Context, key domain.PriceKey, from time.Time, to time.Time) ([]domain.CommodityPrice, error)
+
+	// FindPricesAsOf retrieves, for each of the given price series, the latest price dated on or before asOf.
+	// Series without such a price are left out.
+	FindPricesAsOf(ctx context.Context, keys []domain.PriceKey, asOf time.Time) ([]domain.CommodityPrice, error)
+}
+
+// PriceWriter defines write operations for commodity prices
+type PriceWriter interface {
+	// SavePrices stores prices in a single transaction, replacing any price already recorded for the same
+	// commodity, quote currency and date.
+	SavePrices(ctx context.Context, prices []domain.CommodityPrice) error
+}
+
+// PriceRepositoryFacade combines all price repository interfaces
+type PriceRepositoryFacade interface {
+	PriceReader
+	PriceWriter
+}
+
+// PriceRepositoryWithTx extends PriceRepositoryFacade with transaction capabilities
+type PriceRepositoryWithTx interface {
+	PriceRepositoryFacade
+	TransactionManager
+}
+
+// PriceProvider is an external source of commodity prices, such as a market data feed or a local file
+type PriceProvider interface {
+	// Name identifies the provider; it is recorded as the source of the prices it supplies.
+	Name() string
+
+	// FetchPrices retrieves the prices dated between from and to inclusive. An empty commodity or quote
+	// currency matches any.
+	FetchPrices(ctx context.Context, commodity string, quoteCurrency string, from time.Time, to time.Time) ([]domain.CommodityPrice, error)
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// ReconciliationReader defines read operations for reconciliation sessions
+type ReconciliationReader interface {
+	// FindReconciliationByID retrieves a reconciliation session.
+	FindReconciliationByID(ctx context.Context, reconciliationID string) (*domain.Reconciliation, error)
+
+	// ListReconciliations retrieves the sessions of a workplace, optionally for one account, latest statement first.
+	ListReconciliations(ctx context.Context, workplaceID string, accountID string) ([]domain.Reconciliation, error)
+
+	// GetReconciliationTotals sums the account's reconciled lines and the lines cleared in the session.
+	// Only lines of posted, non-reversal journals are counted.
+	GetReconciliationTotals(ctx context.Context, accountID string, reconciliationID string) (*domain.ReconciliationTotals, error)
+
+	// ListReconciliationCandidates retrieves the account's lines dated on or before the statement date that
+	// are not yet reconciled or that belong to the session.
+	ListReconciliationCandidates(ctx context.Context, workplaceID string, accountID string, reconciliationID string, statementDate time.Time) ([]domain.Transaction, error)
+
+	// FindAccountTransactionsByIDs retrieves lines of posted, non-reversal journals of the account by ID;
+	// unknown IDs are left out.
+	FindAccountTransactionsByIDs(ctx context.Context, workplaceID string, accountID string, transactionIDs []string) ([]domain.Transaction, error)
+}
+
+// ReconciliationWriter defines write operations for reconciliation sessions
+type ReconciliationWriter interface {
+	// SaveReconciliation persists a new session. Returns ErrDuplicate when the account already has an open session.
+	SaveReconciliation(ctx context.Context, reconciliation domain.Reconciliation) error
+
+	// SetTransactionsClearing sets the clearing status and session of lines.
+	SetTransactionsClearing(ctx context.Context, transactionIDs []string, status domain.ClearingStatus, reconciliationID string, userID string, now time.Time) error
+
+	// CompleteReconciliation marks the session completed and its cleared lines reconciled in a single transaction.
+	CompleteReconciliation(ctx context.Context, reconciliation domain.Reconciliation) error
+
+	// DeleteReconciliation removes an open session and returns its cleared lines to UNCLEARED in a single transaction.
+	DeleteReconciliation(ctx context.Context, reconciliationID string, userID string, now time.Time) error
+}
+
+// ReconciliationRepositoryFacade combines all reconciliation repository interfaces
+type ReconciliationRepositoryFacade interface {
+	ReconciliationReader
+	ReconciliationWriter
+}
+
+// ReconciliationRepositoryWithTx extends ReconciliationRepositoryFacade with transaction capabilities
+type ReconciliationRepositoryWithTx interface {
+	ReconciliationRepositoryFacade
+	TransactionManager
+}
@@ -7,14 +7,46 @@ import (
 	"github.com/SscSPs/money_managemet_app/internal/core/domain"
 )
 
-// ReportingRepository defines operations for retrieving financial report data
+// ReportingRepository defines operations for retrieving financial report data.
+// Every query only considers the transaction lines matching all of the given dimension filters; nil applies none.
 type ReportingRepository interface {
 	// GetTrialBalanceData retrieves trial balance data as of a specific date
-	GetTrialBalanceData(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.TrialBalanceRow, error)
+	GetTrialBalanceData(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter) ([]domain.TrialBalanceRow, error)
 
 	// GetProfitAndLossData retrieves profit and loss data for a specific period
-	GetProfitAndLossData(ctx context.Context, workplaceID string, from, to time.Time) ([]domain.AccountAmount, []domain.AccountAmount, error)
+	GetProfitAndLossData(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountAmount, []domain.AccountAmount, error)
 
 	// GetBalanceSheetData retrieves balance sheet data as of a specific date
-	GetBalanceSheetData(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.AccountAmount, []domain.AccountAmount, []domain.AccountAmount, error)
+	GetBalanceSheetData(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter) ([]domain.AccountAmount, []domain.AccountAmount, []domain.AccountAmount, error)
+
+	// GetBalanceSheetMovements retrieves, for every balance sheet account, the balance before from and the movement between from and to
+	GetBalanceSheetMovements(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountMovement, error)
+
+	// GetLedgerAccounts retrieves the accounts of a workplace (or the given subset) with their net debit balance before a date.
+	// The returned ledgers carry no entries; OpeningBalance is debit-positive regardless of account type.
+	GetLedgerAccounts(ctx context.Context, workplaceID string, accountIDs []string, before time.Time, filters []domain.DimensionFilter) ([]domain.AccountLedger, error)
+
+	// GetLedgerEntries retrieves the postings of a workplace (or the given accounts) for a specific period,
+	// ordered by account and date, together with the counter-accounts of each posting's journal
+	GetLedgerEntries(ctx context.Context, workplaceID string, accountIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.LedgerEntry, error)
+
+	// GetProfitAndLossByPeriods retrieves revenue and expense amounts for several periods in a single query
+	GetProfitAndLossByPeriods(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.PeriodAccountAmount, error)
+
+	// GetBalanceSheetByPeriods retrieves asset, liability and equity balances as of the end of several periods in a single query
+	GetBalanceSheetByPeriods(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.PeriodAccountAmount, error)
+
+	// GetTimeSeries retrieves the debit-positive flow and end-of-bucket balance of every selected account for each bucket.
+	// Every selected account gets one point per bucket, ordered by account type, name and bucket.
+	GetTimeSeries(ctx context.Context, workplaceID string, selection domain.TimeSeriesSelection, buckets []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.TimeSeriesPoint, error)
+
+	// GetPayeeAmounts retrieves expense and revenue amounts per payee and currency for a period. A transaction's own
+	// payee takes precedence over its journal's; lines without a payee are grouped under an empty payee ID.
+	// An empty payeeIDs includes every payee and the unassigned lines.
+	GetPayeeAmounts(ctx context.Context, workplaceID string, payeeIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.PayeeAmount, error)
+
+	// GetTaxAmounts retrieves the net and tax amounts of lines split with a tax code, per tax code and currency,
+	// for a period. Credit lines are reported as sales and output tax, debit lines as purchases and input tax.
+	// NetTax is left for the caller to compute.
+	GetTaxAmounts(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.TaxReturnLine, error)
 }
@@ -3,12 +3,32 @@ package repositories
 // RepositoryProvider holds all repository interfaces needed by services.
 // This makes passing dependencies to the service container constructor cleaner.
 type RepositoryProvider struct {
-	AccountRepo      AccountRepositoryWithTx
-	CurrencyRepo     CurrencyRepositoryWithTx
-	ExchangeRateRepo ExchangeRateRepositoryWithTx
-	UserRepo         UserRepositoryWithTx
-	JournalRepo      JournalRepositoryWithTx
-	WorkplaceRepo    WorkplaceRepositoryWithTx
-	ReportingRepo    ReportingRepository
-	APITokenRepo     APITokenRepositoryWithTx
+	AccountRepo            AccountRepositoryWithTx
+	CurrencyRepo           CurrencyRepositoryWithTx
+	ExchangeRateRepo       ExchangeRateRepositoryWithTx
+	UserRepo               UserRepositoryWithTx
+	JournalRepo            JournalRepositoryWithTx
+	WorkplaceRepo          WorkplaceRepositoryWithTx
+	ReportingRepo          ReportingRepository
+	APITokenRepo           APITokenRepositoryWithTx
+	BudgetRepo             BudgetRepositoryWithTx
+	EnvelopeRepo           EnvelopeRepositoryWithTx
+	SavingsGoalRepo        SavingsGoalRepositoryWithTx
+	BankStatementRepo      BankStatementRepositoryWithTx
+	CSVImportProfileRepo   CSVImportProfileRepositoryWithTx
+	CategorizationRuleRepo CategorizationRuleRepositoryWithTx
+	ReconciliationRepo     ReconciliationRepositoryWithTx
+	DuplicateJournalRepo   DuplicateJournalRepositoryWithTx
+	PayeeRepo              PayeeRepositoryWithTx
+	SharedExpenseRepo      SharedExpenseRepositoryWithTx
+	InvestmentRepo         InvestmentRepositoryWithTx
+	PriceRepo              PriceRepositoryWithTx
+	LoanRepo               LoanRepositoryWithTx
+	CreditCardRepo         CreditCardRepositoryWithTx
+	TaxCodeRepo            TaxCodeRepositoryWithTx
+	InvoiceRepo            InvoiceRepositoryWithTx
+	BillRepo               BillRepositoryWithTx
+	DimensionRepo          DimensionRepositoryWithTx
+	ConsolidationRepo      ConsolidationRepositoryWithTx
+	PriceProvider          PriceProvider // Optional; nil when no price provider is configured
 }
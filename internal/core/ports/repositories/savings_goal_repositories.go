@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// SavingsGoalReader defines read operations for savings goal data
+type SavingsGoalReader interface {
+	// FindSavingsGoalByID retrieves a savings goal with its linked account IDs.
+	FindSavingsGoalByID(ctx context.Context, goalID string) (*domain.SavingsGoal, error)
+
+	// ListSavingsGoals retrieves the savings goals of a workplace with their linked account IDs, nearest target date first.
+	ListSavingsGoals(ctx context.Context, workplaceID string) ([]domain.SavingsGoal, error)
+}
+
+// SavingsGoalWriter defines write operations for savings goal data
+type SavingsGoalWriter interface {
+	// SaveSavingsGoal persists a new savings goal and its linked accounts.
+	SaveSavingsGoal(ctx context.Context, goal domain.SavingsGoal) error
+
+	// UpdateSavingsGoal updates a savings goal and replaces its linked accounts.
+	UpdateSavingsGoal(ctx context.Context, goal domain.SavingsGoal) error
+
+	// DeleteSavingsGoal removes a savings goal.
+	DeleteSavingsGoal(ctx context.Context, goalID string) error
+}
+
+// SavingsGoalRepositoryFacade combines all savings goal repository interfaces
+type SavingsGoalRepositoryFacade interface {
+	SavingsGoalReader
+	SavingsGoalWriter
+}
+
+// SavingsGoalRepositoryWithTx extends SavingsGoalRepositoryFacade with transaction capabilities
+type SavingsGoalRepositoryWithTx interface {
+	SavingsGoalRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// SharedExpenseReader defines read operations for shared expenses and member accounts
+type SharedExpenseReader interface {
+	// ListMemberAccounts retrieves the member accounts of a workplace, including the parent entries without a user.
+	ListMemberAccounts(ctx context.Context, workplaceID string) ([]domain.MemberAccounts, error)
+
+	// FindSharedExpenseByID retrieves a shared expense with its shares.
+	FindSharedExpenseByID(ctx context.Context, sharedExpenseID string) (*domain.SharedExpense, error)
+
+	// FindSharedExpenseByJournalID retrieves the shared expense of an expense journal. Returns ErrNotFound when the journal is not shared.
+	FindSharedExpenseByJournalID(ctx context.Context, journalID string) (*domain.SharedExpense, error)
+
+	// ListSharedExpenses retrieves the shared expenses of a workplace with their shares, newest first.
+	ListSharedExpenses(ctx context.Context, workplaceID string) ([]domain.SharedExpense, error)
+}
+
+// SharedExpenseWriter defines write operations for shared expenses and member accounts
+type SharedExpenseWriter interface {
+	// SaveMemberAccounts creates the given accounts and records them as member accounts in a single transaction.
+	// Returns ErrDuplicate when the member already has accounts in the currency.
+	SaveMemberAccounts(ctx context.Context, memberAccounts domain.MemberAccounts, accounts []domain.Account) error
+
+	// SaveSharedExpense persists a new shared expense and its shares. Returns ErrDuplicate when the journal is already shared.
+	SaveSharedExpense(ctx context.Context, expense domain.SharedExpense) error
+
+	// DeleteSharedExpense removes a shared expense and its shares.
+	DeleteSharedExpense(ctx context.Context, sharedExpenseID string) error
+}
+
+// SharedExpenseRepositoryFacade combines all shared expense repository interfaces
+type SharedExpenseRepositoryFacade interface {
+	SharedExpenseReader
+	SharedExpenseWriter
+}
+
+// SharedExpenseRepositoryWithTx extends SharedExpenseRepositoryFacade with transaction capabilities
+type SharedExpenseRepositoryWithTx interface {
+	SharedExpenseRepositoryFacade
+	TransactionManager
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// TaxCodeReader defines read operations for tax codes
+type TaxCodeReader interface {
+	// FindTaxCodeByID retrieves a tax code.
+	FindTaxCodeByID(ctx context.Context, taxCodeID string) (*domain.TaxCode, error)
+
+	// FindTaxCodesByIDs retrieves the tax codes with the given IDs, keyed by ID. Unknown IDs are omitted.
+	FindTaxCodesByIDs(ctx context.Context, taxCodeIDs []string) (map[string]domain.TaxCode, error)
+
+	// ListTaxCodes retrieves the tax codes of a workplace, ordered by code.
+	ListTaxCodes(ctx context.Context, workplaceID string) ([]domain.TaxCode, error)
+}
+
+// TaxCodeWriter defines write operations for tax codes
+type TaxCodeWriter interface {
+	// SaveTaxCode persists a new tax code. Returns ErrDuplicate when the code is already used in the workplace.
+	SaveTaxCode(ctx context.Context, taxCode domain.TaxCode) error
+
+	// UpdateTaxCode updates a tax code. Returns ErrDuplicate when the code is already used in the workplace.
+	UpdateTaxCode(ctx context.Context, taxCode domain.TaxCode) error
+
+	// DeleteTaxCode removes a tax code. Returns ErrConflict when transactions still reference it.
+	DeleteTaxCode(ctx context.Context, taxCodeID string) error
+}
+
+// TaxCodeRepositoryFacade combines all tax code repository interfaces
+type TaxCodeRepositoryFacade interface {
+	TaxCodeReader
+	TaxCodeWriter
+}
+
+// TaxCodeRepositoryWithTx extends TaxCodeRepositoryFacade with transaction capabilities
+type TaxCodeRepositoryWithTx interface {
+	TaxCodeRepositoryFacade
+	TransactionManager
+}
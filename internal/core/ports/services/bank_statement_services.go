@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// BankStatementReaderSvc defines read operations for staged bank statement lines
+type BankStatementReaderSvc interface {
+	// ListStatementLines retrieves the staged lines of an ASSET or LIABILITY account
+	ListStatementLines(ctx context.Context, workplaceID string, params dto.ListStatementLinesParams, userID string) ([]domain.BankStatementLine, error)
+}
+
+// BankStatementWriterSvc defines write operations for bank statement imports
+type BankStatementWriterSvc interface {
+	// ImportStatement parses a statement file and stages its lines, skipping lines already imported and categorizing new ones with the workplace rules
+	ImportStatement(ctx context.Context, workplaceID string, req dto.ImportBankStatementRequest, userID string) (*domain.BankStatementImport, error)
+
+	// PostStatementLine turns a pending line into a journal against the given counter-account, or the one assigned by a categorization rule
+	PostStatementLine(ctx context.Context, workplaceID string, lineID string, req dto.PostStatementLineRequest, userID string) (*domain.BankStatementLine, *domain.Journal, error)
+
+	// IgnoreStatementLine dismisses a pending line
+	IgnoreStatementLine(ctx context.Context, workplaceID string, lineID string, userID string) (*domain.BankStatementLine, error)
+
+	// RestoreStatementLine moves an ignored line back to pending
+	RestoreStatementLine(ctx context.Context, workplaceID string, lineID string, userID string) (*domain.BankStatementLine, error)
+}
+
+// BankStatementSvcFacade combines all bank statement service interfaces
+type BankStatementSvcFacade interface {
+	BankStatementReaderSvc
+	BankStatementWriterSvc
+}
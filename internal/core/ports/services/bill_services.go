@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// BillReaderSvc defines read operations for vendor bills and payables
+type BillReaderSvc interface {
+	// ListBills retrieves the bills of a workplace, newest first, optionally filtered by vendor and by their
+	// status today
+	ListBills(ctx context.Context, workplaceID string, params dto.ListBillsParams, userID string) ([]domain.Bill, error)
+
+	// GetBill retrieves a bill with its lines, payments and scheduled payments
+	GetBill(ctx context.Context, workplaceID string, billID string, userID string) (*domain.Bill, error)
+
+	// AgedPayables buckets the amounts owed on a date by how long they are past due, optionally only for bills
+	// payable to an account or its sub-accounts
+	AgedPayables(ctx context.Context, workplaceID string, asOf time.Time, accountID string, userID string) (*domain.AgingReport, error)
+}
+
+// BillWriterSvc defines write operations for vendor bills and their payments
+type BillWriterSvc interface {
+	// CreateBill creates a draft bill
+	CreateBill(ctx context.Context, workplaceID string, req dto.BillRequest, userID string) (*domain.Bill, error)
+
+	// UpdateBill replaces the content of a draft bill
+	UpdateBill(ctx context.Context, workplaceID string, billID string, req dto.BillRequest, userID string) (*domain.Bill, error)
+
+	// DeleteBill deletes a draft bill
+	DeleteBill(ctx context.Context, workplaceID string, billID string, userID string) error
+
+	// PostBill posts the journal of a draft bill to the expense and payable accounts
+	PostBill(ctx context.Context, workplaceID string, billID string, userID string) (*domain.Bill, error)
+
+	// VoidBill reverses the posting journal of a bill without payments
+	VoidBill(ctx context.Context, workplaceID string, billID string, userID string) (*domain.Bill, error)
+
+	// RecordBillPayment posts a settlement journal paying part or all of a posted bill
+	RecordBillPayment(ctx context.Context, workplaceID string, billID string, req dto.BillPaymentRequest, userID string) (*domain.BillPayment, error)
+
+	// ScheduleBillPayment plans a payment of a posted bill for a date
+	ScheduleBillPayment(ctx context.Context, workplaceID string, billID string, req dto.BillPaymentRequest, userID string) (*domain.ScheduledBillPayment, error)
+
+	// CancelScheduledBillPayment removes a scheduled payment that has not been posted
+	CancelScheduledBillPayment(ctx context.Context, workplaceID string, billID string, scheduledPaymentID string, userID string) error
+
+	// PostDueBillPayments posts the settlement journals of the scheduled payments due on or before a date
+	PostDueBillPayments(ctx context.Context, workplaceID string, asOf time.Time, userID string) ([]domain.BillPayment, error)
+}
+
+// BillSvcFacade combines all bill service interfaces
+type BillSvcFacade interface {
+	BillReaderSvc
+	BillWriterSvc
+}
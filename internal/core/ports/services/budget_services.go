@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// BudgetReaderSvc defines read operations for budgets
+type BudgetReaderSvc interface {
+	// GetBudgetByID retrieves a budget of a workplace with its lines
+	GetBudgetByID(ctx context.Context, workplaceID string, budgetID string, userID string) (*domain.Budget, error)
+
+	// ListBudgets retrieves the budgets of a workplace without their lines
+	ListBudgets(ctx context.Context, workplaceID string, userID string) ([]domain.Budget, error)
+
+	// BudgetVsActual compares a budget with the actual revenue and expenses of one month (1-12) or, when month is 0, the whole budget year
+	BudgetVsActual(ctx context.Context, workplaceID string, budgetID string, month int, userID string) (*domain.BudgetVsActualReport, error)
+}
+
+// BudgetWriterSvc defines write operations for budgets
+type BudgetWriterSvc interface {
+	// CreateBudget creates a budget with optional initial lines
+	CreateBudget(ctx context.Context, workplaceID string, req dto.CreateBudgetRequest, userID string) (*domain.Budget, error)
+
+	// UpdateBudget updates a budget's name and description
+	UpdateBudget(ctx context.Context, workplaceID string, budgetID string, req dto.UpdateBudgetRequest, userID string) (*domain.Budget, error)
+
+	// SetBudgetLines inserts or replaces budget lines
+	SetBudgetLines(ctx context.Context, workplaceID string, budgetID string, req dto.SetBudgetLinesRequest, userID string) (*domain.Budget, error)
+
+	// CopyActualsToBudget fills a budget from another year's actual revenue and expenses with a percentage adjustment
+	CopyActualsToBudget(ctx context.Context, workplaceID string, budgetID string, req dto.CopyBudgetActualsRequest, userID string) (*domain.Budget, error)
+
+	// DeleteBudget removes a budget and its lines
+	DeleteBudget(ctx context.Context, workplaceID string, budgetID string, userID string) error
+}
+
+// BudgetSvcFacade combines all budget-related service interfaces
+type BudgetSvcFacade interface {
+	BudgetReaderSvc
+	BudgetWriterSvc
+}
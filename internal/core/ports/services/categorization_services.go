@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// CategorizationReaderSvc defines read operations for categorization rules
+type CategorizationReaderSvc interface {
+	// ListCategorizationRules retrieves the rules of a workplace in the order they are applied
+	ListCategorizationRules(ctx context.Context, workplaceID string, userID string) ([]domain.CategorizationRule, error)
+
+	// GetCategorizationRule retrieves a categorization rule
+	GetCategorizationRule(ctx context.Context, workplaceID string, ruleID string, userID string) (*domain.CategorizationRule, error)
+
+	// SuggestCategorizationRules proposes rules for payees that were repeatedly posted to the same counter-account by hand
+	SuggestCategorizationRules(ctx context.Context, workplaceID string, userID string) ([]domain.CategorizationRuleSuggestion, error)
+}
+
+// CategorizationWriterSvc defines write operations for categorization rules
+type CategorizationWriterSvc interface {
+	// CreateCategorizationRule saves a new categorization rule
+	CreateCategorizationRule(ctx context.Context, workplaceID string, req dto.CategorizationRuleRequest, userID string) (*domain.CategorizationRule, error)
+
+	// UpdateCategorizationRule replaces the conditions and actions of a rule
+	UpdateCategorizationRule(ctx context.Context, workplaceID string, ruleID string, req dto.CategorizationRuleRequest, userID string) (*domain.CategorizationRule, error)
+
+	// DeleteCategorizationRule removes a rule; lines it categorized keep their counter-account
+	DeleteCategorizationRule(ctx context.Context, workplaceID string, ruleID string, userID string) error
+
+	// ApplyCategorizationRules runs the active rules over pending statement lines and reports the matches
+	ApplyCategorizationRules(ctx context.Context, workplaceID string, req dto.ApplyCategorizationRulesRequest, userID string) (*domain.CategorizationResult, error)
+}
+
+// CategorizationSvcFacade combines all categorization service interfaces
+type CategorizationSvcFacade interface {
+	CategorizationReaderSvc
+	CategorizationWriterSvc
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// ConsolidationReaderSvc defines read operations for consolidation groups
+type ConsolidationReaderSvc interface {
+	// ListConsolidationGroups retrieves the groups managed from a workplace, ordered by name
+	ListConsolidationGroups(ctx context.Context, workplaceID string, userID string) ([]domain.ConsolidationGroup, error)
+
+	// GetConsolidationGroup retrieves a group with its members and account mappings
+	GetConsolidationGroup(ctx context.Context, workplaceID string, groupID string, userID string) (*domain.ConsolidationGroup, error)
+}
+
+// ConsolidationWriterSvc defines write operations for consolidation groups
+type ConsolidationWriterSvc interface {
+	// CreateConsolidationGroup saves a new group; the user must be able to read every member workplace
+	CreateConsolidationGroup(ctx context.Context, workplaceID string, req dto.ConsolidationGroupRequest, userID string) (*domain.ConsolidationGroup, error)
+
+	// UpdateConsolidationGroup replaces the details, members and account mappings of a group
+	UpdateConsolidationGroup(ctx context.Context, workplaceID string, groupID string, req dto.ConsolidationGroupRequest, userID string) (*domain.ConsolidationGroup, error)
+
+	// DeleteConsolidationGroup removes a group; the books of its members are not touched
+	DeleteConsolidationGroup(ctx context.Context, workplaceID string, groupID string, userID string) error
+}
+
+// ConsolidationReportSvc defines the consolidated reports of a group. The user must be able to read every
+// member workplace.
+type ConsolidationReportSvc interface {
+	// ConsolidatedTrialBalance generates the consolidated trial balance of a group as of a date
+	ConsolidatedTrialBalance(ctx context.Context, workplaceID string, groupID string, asOf time.Time, userID string) (*domain.ConsolidatedTrialBalance, error)
+
+	// ConsolidatedProfitAndLoss generates the consolidated profit and loss report of a group for a period
+	ConsolidatedProfitAndLoss(ctx context.Context, workplaceID string, groupID string, from, to time.Time, userID string) (*domain.ConsolidatedPAndLReport, error)
+
+	// ConsolidatedBalanceSheet generates the consolidated balance sheet of a group as of a date
+	ConsolidatedBalanceSheet(ctx context.Context, workplaceID string, groupID string, asOf time.Time, userID string) (*domain.ConsolidatedBalanceSheetReport, error)
+}
+
+// ConsolidationSvcFacade combines all consolidation service interfaces
+type ConsolidationSvcFacade interface {
+	ConsolidationReaderSvc
+	ConsolidationWriterSvc
+	ConsolidationReportSvc
+}
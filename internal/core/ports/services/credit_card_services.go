@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// CreditCardReaderSvc defines read operations for credit cards, their statements and dues
+type CreditCardReaderSvc interface {
+	// ListCreditCards retrieves the credit cards of a workplace, ordered by account name
+	ListCreditCards(ctx context.Context, workplaceID string, userID string) ([]domain.CreditCard, error)
+
+	// GetCreditCard retrieves the current position of a credit card: balance, available credit and last statement
+	GetCreditCard(ctx context.Context, workplaceID string, accountID string, userID string) (*domain.CreditCardSummary, error)
+
+	// GetStatement builds the statement of the billing cycle containing params.Date, or of the last closed cycle
+	GetStatement(ctx context.Context, workplaceID string, accountID string, params dto.StatementParams, userID string) (*domain.CreditCardStatement, error)
+
+	// ListUpcomingDues retrieves the unpaid statements of the workplace's credit cards falling due within the given days
+	ListUpcomingDues(ctx context.Context, workplaceID string, params dto.UpcomingDuesParams, userID string) ([]domain.UpcomingDue, error)
+}
+
+// CreditCardWriterSvc defines write operations for credit cards
+type CreditCardWriterSvc interface {
+	// CreateCreditCard sets up billing cycles on a LIABILITY account
+	CreateCreditCard(ctx context.Context, workplaceID string, req dto.CreateCreditCardRequest, userID string) (*domain.CreditCard, error)
+
+	// UpdateCreditCard replaces the billing-cycle settings of a credit card
+	UpdateCreditCard(ctx context.Context, workplaceID string, accountID string, req dto.CreditCardRequest, userID string) (*domain.CreditCard, error)
+
+	// DeleteCreditCard removes the billing-cycle settings of an account; the account and its journals are kept
+	DeleteCreditCard(ctx context.Context, workplaceID string, accountID string, userID string) error
+}
+
+// CreditCardSvcFacade combines all credit card service interfaces
+type CreditCardSvcFacade interface {
+	CreditCardReaderSvc
+	CreditCardWriterSvc
+}
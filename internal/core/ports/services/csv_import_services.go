@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// CSVImportReaderSvc defines read operations for CSV imports
+type CSVImportReaderSvc interface {
+	// ListCSVImportProfiles retrieves the CSV import profiles of a workplace, optionally for one account
+	ListCSVImportProfiles(ctx context.Context, workplaceID string, accountID string, userID string) ([]domain.CSVImportProfile, error)
+
+	// GetCSVImportProfile retrieves a CSV import profile
+	GetCSVImportProfile(ctx context.Context, workplaceID string, profileID string, userID string) (*domain.CSVImportProfile, error)
+
+	// PreviewCSVImport reads a CSV file with a profile and reports every row without saving anything
+	PreviewCSVImport(ctx context.Context, workplaceID string, profileID string, data []byte, userID string) (*domain.CSVImportPreview, error)
+}
+
+// CSVImportWriterSvc defines write operations for CSV imports
+type CSVImportWriterSvc interface {
+	// CreateCSVImportProfile saves a CSV import profile for an ASSET or LIABILITY account
+	CreateCSVImportProfile(ctx context.Context, workplaceID string, req dto.CreateCSVImportProfileRequest, userID string) (*domain.CSVImportProfile, error)
+
+	// UpdateCSVImportProfile updates a CSV import profile
+	UpdateCSVImportProfile(ctx context.Context, workplaceID string, profileID string, req dto.UpdateCSVImportProfileRequest, userID string) (*domain.CSVImportProfile, error)
+
+	// DeleteCSVImportProfile removes a CSV import profile
+	DeleteCSVImportProfile(ctx context.Context, workplaceID string, profileID string, userID string) error
+
+	// ImportCSV stages the readable rows of a CSV file as statement lines and, in JOURNAL mode, posts the new ones
+	ImportCSV(ctx context.Context, workplaceID string, profileID string, req dto.ImportCSVRequest, userID string) (*domain.CSVImportResult, error)
+}
+
+// CSVImportSvcFacade combines all CSV import service interfaces
+type CSVImportSvcFacade interface {
+	CSVImportReaderSvc
+	CSVImportWriterSvc
+}
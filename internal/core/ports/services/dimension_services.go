@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// DimensionReaderSvc defines read operations for analytic dimensions
+type DimensionReaderSvc interface {
+	// ListDimensions retrieves the dimensions of a workplace with their values, ordered by code
+	ListDimensions(ctx context.Context, workplaceID string, params dto.ListDimensionsParams, userID string) ([]domain.Dimension, error)
+
+	// GetDimension retrieves a dimension with all its values
+	GetDimension(ctx context.Context, workplaceID string, dimensionID string, userID string) (*domain.Dimension, error)
+}
+
+// DimensionWriterSvc defines write operations for analytic dimensions and their values
+type DimensionWriterSvc interface {
+	// CreateDimension saves a new dimension without values
+	CreateDimension(ctx context.Context, workplaceID string, req dto.DimensionRequest, userID string) (*domain.Dimension, error)
+
+	// UpdateDimension replaces the details of a dimension
+	UpdateDimension(ctx context.Context, workplaceID string, dimensionID string, req dto.DimensionRequest, userID string) (*domain.Dimension, error)
+
+	// DeleteDimension removes a dimension none of whose values is in use
+	DeleteDimension(ctx context.Context, workplaceID string, dimensionID string, userID string) error
+
+	// CreateDimensionValue adds a value to a dimension
+	CreateDimensionValue(ctx context.Context, workplaceID string, dimensionID string, req dto.DimensionValueRequest, userID string) (*domain.DimensionValue, error)
+
+	// UpdateDimensionValue replaces the details of a dimension value
+	UpdateDimensionValue(ctx context.Context, workplaceID string, dimensionID string, valueID string, req dto.DimensionValueRequest, userID string) (*domain.DimensionValue, error)
+
+	// DeleteDimensionValue removes a value no journal or line is tagged with
+	DeleteDimensionValue(ctx context.Context, workplaceID string, dimensionID string, valueID string, userID string) error
+}
+
+// DimensionSvcFacade combines all dimension service interfaces
+type DimensionSvcFacade interface {
+	DimensionReaderSvc
+	DimensionWriterSvc
+}
@@ -0,0 +1,32 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// DuplicateJournalReaderSvc defines read operations for suspected duplicate journals
+type DuplicateJournalReaderSvc interface {
+	// ListDuplicatePairs retrieves the suspected duplicate pairs of a workplace for review
+	ListDuplicatePairs(ctx context.Context, workplaceID string, params dto.ListDuplicatePairsParams, userID string) ([]domain.DuplicateJournalPair, error)
+
+	// GetDuplicatePair retrieves a suspected duplicate pair
+	GetDuplicatePair(ctx context.Context, workplaceID string, pairID string, userID string) (*domain.DuplicateJournalPair, error)
+}
+
+// DuplicateJournalWriterSvc defines review operations for suspected duplicate journals
+type DuplicateJournalWriterSvc interface {
+	// ConfirmDuplicatePair reverses one journal of a pending pair and records the decision
+	ConfirmDuplicatePair(ctx context.Context, workplaceID string, pairID string, req dto.ConfirmDuplicatePairRequest, userID string) (*domain.DuplicateJournalPair, error)
+
+	// DismissDuplicatePair records that both journals of a pending pair are genuine
+	DismissDuplicatePair(ctx context.Context, workplaceID string, pairID string, req dto.DismissDuplicatePairRequest, userID string) (*domain.DuplicateJournalPair, error)
+}
+
+// DuplicateJournalSvcFacade combines all duplicate journal service interfaces
+type DuplicateJournalSvcFacade interface {
+	DuplicateJournalReaderSvc
+	DuplicateJournalWriterSvc
+}
@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// EnvelopeReaderSvc defines read operations for envelope budgeting
+type EnvelopeReaderSvc interface {
+	// GetEnvelopeSettings retrieves the envelope settings of a workplace
+	GetEnvelopeSettings(ctx context.Context, workplaceID string, userID string) (*domain.EnvelopeSettings, error)
+
+	// GetEnvelopeMonth derives the envelope budget state of the month containing the given date
+	GetEnvelopeMonth(ctx context.Context, workplaceID string, month time.Time, userID string) (*domain.EnvelopeMonth, error)
+
+	// ListEnvelopeAllocations retrieves the allocations of the month containing the given date
+	ListEnvelopeAllocations(ctx context.Context, workplaceID string, month time.Time, userID string) ([]domain.EnvelopeAllocation, error)
+}
+
+// EnvelopeWriterSvc defines write operations for envelope budgeting
+type EnvelopeWriterSvc interface {
+	// ConfigureEnvelopes enables envelope budgeting for a workplace or changes its start month
+	ConfigureEnvelopes(ctx context.Context, workplaceID string, req dto.ConfigureEnvelopesRequest, userID string) (*domain.EnvelopeSettings, error)
+
+	// AssignToEnvelope assigns money to (or takes money from) an expense envelope for a month
+	AssignToEnvelope(ctx context.Context, workplaceID string, req dto.AssignEnvelopeRequest, userID string) (*domain.EnvelopeAllocation, error)
+
+	// MoveBetweenEnvelopes moves money from one envelope to another within a month
+	MoveBetweenEnvelopes(ctx context.Context, workplaceID string, req dto.MoveEnvelopeRequest, userID string) ([]domain.EnvelopeAllocation, error)
+}
+
+// EnvelopeSvcFacade combines all envelope-related service interfaces
+type EnvelopeSvcFacade interface {
+	EnvelopeReaderSvc
+	EnvelopeWriterSvc
+}
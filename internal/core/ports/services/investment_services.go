@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// InvestmentReaderSvc defines read operations for securities, lots and holdings
+type InvestmentReaderSvc interface {
+	// ListSecurities retrieves the securities of a workplace, ordered by symbol
+	ListSecurities(ctx context.Context, workplaceID string, userID string) ([]domain.Security, error)
+
+	// GetSecurity retrieves a security
+	GetSecurity(ctx context.Context, workplaceID string, securityID string, userID string) (*domain.Security, error)
+
+	// ListSecurityPrices retrieves the commodity prices of a security's symbol in its currency, newest first
+	ListSecurityPrices(ctx context.Context, workplaceID string, securityID string, userID string) ([]domain.CommodityPrice, error)
+
+	// ListLots retrieves the open lots as of a date, oldest first
+	ListLots(ctx context.Context, workplaceID string, params dto.ListLotsParams, userID string) ([]domain.Lot, error)
+
+	// GetHoldings reports quantity, cost basis and market value per account and security as of a date
+	GetHoldings(ctx context.Context, workplaceID string, params dto.HoldingsParams, userID string) (*domain.Holdings, error)
+
+	// GetUnrealizedGains reports the unrealized gain of each holding as of a date, with totals per currency
+	GetUnrealizedGains(ctx context.Context, workplaceID string, params dto.HoldingsParams, userID string) (*domain.UnrealizedGainReport, error)
+}
+
+// InvestmentWriterSvc defines write operations for securities and trades
+type InvestmentWriterSvc interface {
+	// CreateSecurity adds a security to the security master
+	CreateSecurity(ctx context.Context, workplaceID string, req dto.SecurityRequest, userID string) (*domain.Security, error)
+
+	// UpdateSecurity replaces the details of a security
+	UpdateSecurity(ctx context.Context, workplaceID string, securityID string, req dto.SecurityRequest, userID string) (*domain.Security, error)
+
+	// DeleteSecurity removes a security that has never been traded
+	DeleteSecurity(ctx context.Context, workplaceID string, securityID string, userID string) error
+
+	// RecordSecurityPrice records the commodity price of a security's symbol on a date, replacing any price
+	// recorded for that date
+	RecordSecurityPrice(ctx context.Context, workplaceID string, securityID string, req dto.SecurityPriceRequest, userID string) (*domain.CommodityPrice, error)
+
+	// BuySecurity posts a journal moving cash into an investment account and opening a lot
+	BuySecurity(ctx context.Context, workplaceID string, req dto.BuySecurityRequest, userID string) (*domain.Trade, error)
+
+	// SellSecurity posts a journal closing lots at their cost basis and recording the realized gain or loss
+	SellSecurity(ctx context.Context, workplaceID string, req dto.SellSecurityRequest, userID string) (*domain.Trade, error)
+}
+
+// InvestmentSvcFacade combines all investment service interfaces
+type InvestmentSvcFacade interface {
+	InvestmentReaderSvc
+	InvestmentWriterSvc
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// InvoiceReaderSvc defines read operations for sales invoices and receivables
+type InvoiceReaderSvc interface {
+	// ListInvoices retrieves the invoices of a workplace, newest first, optionally filtered by customer and
+	// by their status today
+	ListInvoices(ctx context.Context, workplaceID string, params dto.ListInvoicesParams, userID string) ([]domain.Invoice, error)
+
+	// GetInvoice retrieves an invoice with its lines and payments
+	GetInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) (*domain.Invoice, error)
+
+	// GetInvoiceSequence retrieves the numbering of issued invoices, defaulting to INV-00001 onwards
+	GetInvoiceSequence(ctx context.Context, workplaceID string, userID string) (*domain.InvoiceSequence, error)
+
+	// AgedReceivables buckets the amounts outstanding on a date by how long they are past due
+	AgedReceivables(ctx context.Context, workplaceID string, asOf time.Time, userID string) (*domain.AgingReport, error)
+}
+
+// InvoiceWriterSvc defines write operations for sales invoices and their payments
+type InvoiceWriterSvc interface {
+	// CreateInvoice creates a draft invoice
+	CreateInvoice(ctx context.Context, workplaceID string, req dto.InvoiceRequest, userID string) (*domain.Invoice, error)
+
+	// UpdateInvoice replaces the content of a draft invoice
+	UpdateInvoice(ctx context.Context, workplaceID string, invoiceID string, req dto.InvoiceRequest, userID string) (*domain.Invoice, error)
+
+	// DeleteInvoice deletes a draft invoice
+	DeleteInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) error
+
+	// IssueInvoice numbers a draft invoice and posts its journal to the receivable and revenue accounts
+	IssueInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) (*domain.Invoice, error)
+
+	// RecordPayment posts a payment received against an issued invoice, moving it from the receivable account
+	RecordPayment(ctx context.Context, workplaceID string, invoiceID string, req dto.RecordInvoicePaymentRequest, userID string) (*domain.InvoicePayment, error)
+
+	// VoidInvoice reverses the issue journal of an invoice without payments
+	VoidInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) (*domain.Invoice, error)
+
+	// UpdateInvoiceSequence configures the numbering of issued invoices
+	UpdateInvoiceSequence(ctx context.Context, workplaceID string, req dto.InvoiceSequenceRequest, userID string) (*domain.InvoiceSequence, error)
+}
+
+// InvoiceSvcFacade combines all invoice service interfaces
+type InvoiceSvcFacade interface {
+	InvoiceReaderSvc
+	InvoiceWriterSvc
+}
@@ -24,10 +24,20 @@ type JournalWriterSvc interface {
 	// UpdateJournal updates journal details (excluding transactions).
 	UpdateJournal(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalRequest, requestingUserID string) (*domain.Journal, error)
 
+	// UpdateJournalDimensions replaces the dimension values of a posted journal and of some of its lines.
+	UpdateJournalDimensions(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalDimensionsRequest, requestingUserID string) (*domain.Journal, error)
+
 	
 
 	// ReverseJournal creates a reversal journal for an existing journal.
 	ReverseJournal(ctx context.Context, workplaceID string, journalID string, userID string) (*domain.Journal, error)
+
+	// CreateIntercompanyJournal writes mirrored journals in a workplace and a counterparty workplace atomically,
+	// balanced through due-from and due-to accounts and linked as twins. Returns the journal and its twin.
+	CreateIntercompanyJournal(ctx context.Context, workplaceID string, req dto.CreateIntercompanyJournalRequest, creatorUserID string) (*domain.Journal, *domain.Journal, error)
+
+	// ReverseIntercompanyJournal reverses a journal and its twin atomically. Returns both reversing journals.
+	ReverseIntercompanyJournal(ctx context.Context, workplaceID string, journalID string, userID string) (*domain.Journal, *domain.Journal, error)
 }
 
 // TransactionReaderSvc defines read operations for transaction data
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// LoanReaderSvc defines read operations for loans and their schedules
+type LoanReaderSvc interface {
+	// ListLoans retrieves the loans of a workplace, ordered by name
+	ListLoans(ctx context.Context, workplaceID string, userID string) ([]domain.Loan, error)
+
+	// GetLoan retrieves a loan
+	GetLoan(ctx context.Context, workplaceID string, loanID string, userID string) (*domain.Loan, error)
+
+	// GetLoanSchedule builds the amortization schedule of a loan and compares its remaining principal
+	// with the balance of the loan account
+	GetLoanSchedule(ctx context.Context, workplaceID string, loanID string, userID string) (*domain.LoanSchedule, error)
+}
+
+// LoanWriterSvc defines write operations for loans and their payments
+type LoanWriterSvc interface {
+	// CreateLoan defines a loan on a LIABILITY account, optionally posting its disbursement
+	CreateLoan(ctx context.Context, workplaceID string, req dto.CreateLoanRequest, userID string) (*domain.Loan, error)
+
+	// UpdateLoan replaces the name and interest account of a loan
+	UpdateLoan(ctx context.Context, workplaceID string, loanID string, req dto.UpdateLoanRequest, userID string) (*domain.Loan, error)
+
+	// DeleteLoan stops tracking a loan; journals already posted are kept
+	DeleteLoan(ctx context.Context, workplaceID string, loanID string, userID string) error
+
+	// PostInstallment posts the next unpaid installment as a journal splitting principal and interest
+	PostInstallment(ctx context.Context, workplaceID string, loanID string, req dto.PostInstallmentRequest, userID string) (*domain.LoanPayment, error)
+
+	// PostPrepayment posts an extra principal payment; the schedule is recalculated from it
+	PostPrepayment(ctx context.Context, workplaceID string, loanID string, req dto.PrepaymentRequest, userID string) (*domain.LoanPayment, error)
+}
+
+// LoanSvcFacade combines all loan service interfaces
+type LoanSvcFacade interface {
+	LoanReaderSvc
+	LoanWriterSvc
+}
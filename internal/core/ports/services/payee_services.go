@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// PayeeReaderSvc defines read operations for payees
+type PayeeReaderSvc interface {
+	// ListPayees retrieves the payees of a workplace ordered by name
+	ListPayees(ctx context.Context, workplaceID string, params dto.ListPayeesParams, userID string) ([]domain.Payee, error)
+
+	// GetPayee retrieves a payee with its aliases
+	GetPayee(ctx context.Context, workplaceID string, payeeID string, userID string) (*domain.Payee, error)
+
+	// ResolvePayee finds the active payee whose name or alias occurs in a free-text description; nil when none does
+	ResolvePayee(ctx context.Context, workplaceID string, text string, userID string) (*domain.PayeeMatch, error)
+}
+
+// PayeeWriterSvc defines write operations for payees
+type PayeeWriterSvc interface {
+	// CreatePayee saves a new payee
+	CreatePayee(ctx context.Context, workplaceID string, req dto.PayeeRequest, userID string) (*domain.Payee, error)
+
+	// UpdatePayee replaces the details and aliases of a payee
+	UpdatePayee(ctx context.Context, workplaceID string, payeeID string, req dto.PayeeRequest, userID string) (*domain.Payee, error)
+
+	// DeletePayee removes a payee that no journal or transaction references
+	DeletePayee(ctx context.Context, workplaceID string, payeeID string, userID string) error
+}
+
+// PayeeSvcFacade combines all payee service interfaces
+type PayeeSvcFacade interface {
+	PayeeReaderSvc
+	PayeeWriterSvc
+}
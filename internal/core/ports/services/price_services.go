@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// PriceReaderSvc defines read operations for commodity prices
+type PriceReaderSvc interface {
+	// ListPrices retrieves the prices of a commodity in a quote currency, newest first
+	ListPrices(ctx context.Context, params dto.ListPricesParams) ([]domain.CommodityPrice, error)
+
+	// GetPriceAsOf retrieves the latest price of a commodity in a quote currency dated on or before a date
+	GetPriceAsOf(ctx context.Context, params dto.PriceAsOfParams) (*domain.CommodityPrice, error)
+}
+
+// PriceWriterSvc defines write operations for commodity prices
+type PriceWriterSvc interface {
+	// RecordPrice records a price, replacing any price of the commodity and currency on that date
+	RecordPrice(ctx context.Context, req dto.RecordPriceRequest, userID string) (*domain.CommodityPrice, error)
+
+	// ImportPrices loads the valid rows of a CSV price file and reports the rows that were skipped
+	ImportPrices(ctx context.Context, data []byte, userID string) (*domain.PriceImportResult, error)
+
+	// SyncPrices loads prices from the configured price provider
+	SyncPrices(ctx context.Context, req dto.SyncPricesRequest, userID string) (*domain.PriceImportResult, error)
+}
+
+// PriceSvcFacade combines all price service interfaces
+type PriceSvcFacade interface {
+	PriceReaderSvc
+	PriceWriterSvc
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// ReconciliationReaderSvc defines read operations for reconciliation sessions
+type ReconciliationReaderSvc interface {
+	// ListReconciliations retrieves the sessions of a workplace, optionally for one account
+	ListReconciliations(ctx context.Context, workplaceID string, params dto.ListReconciliationsParams, userID string) ([]domain.Reconciliation, error)
+
+	// GetReconciliation retrieves a session with its live difference
+	GetReconciliation(ctx context.Context, workplaceID string, reconciliationID string, userID string) (*domain.ReconciliationSummary, error)
+
+	// ListReconciliationTransactions retrieves the transactions that can be ticked in a session
+	ListReconciliationTransactions(ctx context.Context, workplaceID string, reconciliationID string, userID string) ([]domain.Transaction, error)
+}
+
+// ReconciliationWriterSvc defines write operations for reconciliation sessions
+type ReconciliationWriterSvc interface {
+	// CreateReconciliation opens a session for an ASSET or LIABILITY account
+	CreateReconciliation(ctx context.Context, workplaceID string, req dto.CreateReconciliationRequest, userID string) (*domain.ReconciliationSummary, error)
+
+	// ClearTransactions ticks transactions as cleared in an open session
+	ClearTransactions(ctx context.Context, workplaceID string, reconciliationID string, req dto.ReconciliationClearingRequest, userID string) (*domain.ReconciliationSummary, error)
+
+	// UnclearTransactions unticks transactions cleared in an open session
+	UnclearTransactions(ctx context.Context, workplaceID string, reconciliationID string, req dto.ReconciliationClearingRequest, userID string) (*domain.ReconciliationSummary, error)
+
+	// CompleteReconciliation closes a session whose difference is zero and locks its cleared transactions
+	CompleteReconciliation(ctx context.Context, workplaceID string, reconciliationID string, userID string) (*domain.ReconciliationSummary, error)
+
+	// DeleteReconciliation discards an open session and unticks its transactions
+	DeleteReconciliation(ctx context.Context, workplaceID string, reconciliationID string, userID string) error
+}
+
+// ReconciliationSvcFacade combines all reconciliation service interfaces
+type ReconciliationSvcFacade interface {
+	ReconciliationReaderSvc
+	ReconciliationWriterSvc
+}
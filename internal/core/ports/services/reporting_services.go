@@ -7,14 +7,43 @@ import (
 	"github.com/SscSPs/money_managemet_app/internal/core/domain"
 )
 
-// ReportingService defines operations for generating financial reports
+// ReportingService defines operations for generating financial reports.
+// Reports only consider the transaction lines matching all of the given dimension filters; nil applies none.
 type ReportingService interface {
+	// ReportDimensions resolves dimension value IDs into report filters (values of one dimension are alternatives,
+	// dimensions narrow each other) and, when groupBy names a dimension, the groups to run the report for
+	ReportDimensions(ctx context.Context, workplaceID string, valueIDs []string, groupBy string, userID string) (*domain.ReportDimensions, error)
+
 	// TrialBalance generates a trial balance report as of a specific date
-	TrialBalance(ctx context.Context, workplaceID string, asOf time.Time, userID string) ([]domain.TrialBalanceRow, error)
+	TrialBalance(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter, userID string) ([]domain.TrialBalanceRow, error)
 
 	// ProfitAndLoss generates a profit and loss report for a specific period
-	ProfitAndLoss(ctx context.Context, workplaceID string, from, to time.Time, userID string) (*domain.PAndLReport, error)
+	ProfitAndLoss(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.PAndLReport, error)
 
 	// BalanceSheet generates a balance sheet report as of a specific date
-	BalanceSheet(ctx context.Context, workplaceID string, asOf time.Time, userID string) (*domain.BalanceSheetReport, error)
+	BalanceSheet(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter, userID string) (*domain.BalanceSheetReport, error)
+
+	// CashFlow generates an indirect-method cash flow statement for a specific period
+	CashFlow(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.CashFlowReport, error)
+
+	// GeneralLedger generates a general ledger for all accounts, or the given subset, for a specific period
+	GeneralLedger(ctx context.Context, workplaceID string, accountIDs []string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.GeneralLedgerReport, error)
+
+	// AccountStatement generates the ledger of a single account for a specific period
+	AccountStatement(ctx context.Context, workplaceID string, accountID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.AccountLedger, error)
+
+	// ComparativeProfitAndLoss generates a profit and loss report with one column per period and period-over-period variance
+	ComparativeProfitAndLoss(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter, userID string) (*domain.ComparativePAndLReport, error)
+
+	// ComparativeBalanceSheet generates a balance sheet as of the end of each period with period-over-period variance
+	ComparativeBalanceSheet(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter, userID string) (*domain.ComparativeBalanceSheetReport, error)
+
+	// TimeSeries generates per-bucket balances (cumulative mode) or flows (periodic mode) of the selected accounts over a range
+	TimeSeries(ctx context.Context, workplaceID string, selection domain.TimeSeriesSelection, from, to time.Time, interval domain.TimeSeriesInterval, mode domain.TimeSeriesMode, filters []domain.DimensionFilter, userID string) (*domain.TimeSeriesReport, error)
+
+	// PayeeReport rolls up spend and income per payee, optionally restricted to the given payees, for a specific period
+	PayeeReport(ctx context.Context, workplaceID string, payeeIDs []string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.PayeeReport, error)
+
+	// TaxReturnReport summarizes taxable amounts and tax collected and paid per tax code for a specific period
+	TaxReturnReport(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.TaxReturn, error)
 }
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// SavingsGoalReaderSvc defines read operations for savings goals
+type SavingsGoalReaderSvc interface {
+	// ListSavingsGoals retrieves the savings goals of a workplace
+	ListSavingsGoals(ctx context.Context, workplaceID string, userID string) ([]domain.SavingsGoal, error)
+
+	// GetSavingsGoalProgress retrieves a savings goal with its progress; the average contribution
+	// is taken over the given number of trailing complete months (0 uses the default)
+	GetSavingsGoalProgress(ctx context.Context, workplaceID string, goalID string, trailingMonths int, userID string) (*domain.SavingsGoalProgress, error)
+}
+
+// SavingsGoalWriterSvc defines write operations for savings goals
+type SavingsGoalWriterSvc interface {
+	// CreateSavingsGoal creates a savings goal linked to one or more ASSET accounts
+	CreateSavingsGoal(ctx context.Context, workplaceID string, req dto.CreateSavingsGoalRequest, userID string) (*domain.SavingsGoal, error)
+
+	// UpdateSavingsGoal updates a savings goal
+	UpdateSavingsGoal(ctx context.Context, workplaceID string, goalID string, req dto.UpdateSavingsGoalRequest, userID string) (*domain.SavingsGoal, error)
+
+	// DeleteSavingsGoal removes a savings goal
+	DeleteSavingsGoal(ctx context.Context, workplaceID string, goalID string, userID string) error
+}
+
+// SavingsGoalSvcFacade combines all savings goal service interfaces
+type SavingsGoalSvcFacade interface {
+	SavingsGoalReaderSvc
+	SavingsGoalWriterSvc
+}
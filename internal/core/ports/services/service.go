@@ -14,4 +14,23 @@ type ServiceContainer struct {
 	TokenService       TokenSvcFacade
 	GoogleOAuthHandler GoogleOAuthHandlerSvcFacade
 	APITokenSvc       APITokenSvc
+	Budget             BudgetSvcFacade
+	Envelope           EnvelopeSvcFacade
+	SavingsGoal        SavingsGoalSvcFacade
+	BankStatement      BankStatementSvcFacade
+	CSVImport          CSVImportSvcFacade
+	Categorization     CategorizationSvcFacade
+	Reconciliation     ReconciliationSvcFacade
+	DuplicateJournal   DuplicateJournalSvcFacade
+	Payee              PayeeSvcFacade
+	SharedExpense      SharedExpenseSvcFacade
+	Investment         InvestmentSvcFacade
+	Price              PriceSvcFacade
+	Loan               LoanSvcFacade
+	CreditCard         CreditCardSvcFacade
+	TaxCode            TaxCodeSvcFacade
+	Invoice            InvoiceSvcFacade
+	Bill               BillSvcFacade
+	Dimension          DimensionSvcFacade
+	Consolidation      ConsolidationSvcFacade
 }
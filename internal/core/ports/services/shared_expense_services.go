@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// SharedExpenseReaderSvc defines read operations for shared expenses and member balances
+type SharedExpenseReaderSvc interface {
+	// ListSharedExpenses retrieves the shared expenses of a workplace, newest first
+	ListSharedExpenses(ctx context.Context, workplaceID string, userID string) ([]domain.SharedExpense, error)
+
+	// GetSharedExpense retrieves a shared expense with its shares
+	GetSharedExpense(ctx context.Context, workplaceID string, sharedExpenseID string, userID string) (*domain.SharedExpense, error)
+
+	// GetMemberBalances computes what each member owes and is owed, and the repayments that settle the balances
+	GetMemberBalances(ctx context.Context, workplaceID string, userID string) (*domain.MemberBalances, error)
+}
+
+// SharedExpenseWriterSvc defines write operations for shared expenses
+type SharedExpenseWriterSvc interface {
+	// ShareExpense splits an expense journal between members and posts the shares to their member accounts
+	ShareExpense(ctx context.Context, workplaceID string, req dto.ShareExpenseRequest, userID string) (*domain.SharedExpense, error)
+
+	// UnshareExpense reverses the share journal of a shared expense and removes it
+	UnshareExpense(ctx context.Context, workplaceID string, sharedExpenseID string, userID string) error
+
+	// SettleUp posts a journal recording a repayment from one member to another
+	SettleUp(ctx context.Context, workplaceID string, req dto.SettleUpRequest, userID string) (*domain.Journal, error)
+}
+
+// SharedExpenseSvcFacade combines all shared expense service interfaces
+type SharedExpenseSvcFacade interface {
+	SharedExpenseReaderSvc
+	SharedExpenseWriterSvc
+}
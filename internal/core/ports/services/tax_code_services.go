@@ -0,0 +1,35 @@
+package services
+
+import (
+	"context"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+)
+
+// TaxCodeReaderSvc defines read operations for tax codes
+type TaxCodeReaderSvc interface {
+	// ListTaxCodes retrieves the tax codes of a workplace ordered by code
+	ListTaxCodes(ctx context.Context, workplaceID string, params dto.ListTaxCodesParams, userID string) ([]domain.TaxCode, error)
+
+	// GetTaxCode retrieves a tax code
+	GetTaxCode(ctx context.Context, workplaceID string, taxCodeID string, userID string) (*domain.TaxCode, error)
+}
+
+// TaxCodeWriterSvc defines write operations for tax codes
+type TaxCodeWriterSvc interface {
+	// CreateTaxCode saves a new tax code
+	CreateTaxCode(ctx context.Context, workplaceID string, req dto.TaxCodeRequest, userID string) (*domain.TaxCode, error)
+
+	// UpdateTaxCode replaces the details of a tax code
+	UpdateTaxCode(ctx context.Context, workplaceID string, taxCodeID string, req dto.TaxCodeRequest, userID string) (*domain.TaxCode, error)
+
+	// DeleteTaxCode removes a tax code that no transaction references
+	DeleteTaxCode(ctx context.Context, workplaceID string, taxCodeID string, userID string) error
+}
+
+// TaxCodeSvcFacade combines all tax code service interfaces
+type TaxCodeSvcFacade interface {
+	TaxCodeReaderSvc
+	TaxCodeWriterSvc
+}
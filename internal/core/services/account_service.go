@@ -82,6 +82,13 @@ func (s *accountService) CreateAccount(ctx context.Context, workplaceID string,
 		}
 	}
 
+	if err := validateCashFlowCategory(domain.AccountType(req.AccountType), req.CashFlowCategory); err != nil {
+		s.LogError(ctx, err, "Invalid cash flow category",
+			slog.String("account_type", string(req.AccountType)),
+			slog.String("cash_flow_category", string(req.CashFlowCategory)))
+		return nil, err
+	}
+
 	now := time.Now()
 	newAccountID := uuid.NewString()
 
@@ -106,15 +113,16 @@ func (s *accountService) CreateAccount(ctx context.Context, workplaceID string,
 
 	// Create domain.Account, ensuring WorkplaceID is set
 	account := domain.Account{
-		AccountID:       newAccountID,
-		WorkplaceID:     workplaceID,
-		Name:            req.Name,
-		AccountType:     domain.AccountType(req.AccountType),
-		CurrencyCode:    req.CurrencyCode,
-		CFID:            req.CFID,
-		ParentAccountID: parentID,
-		Description:     req.Description,
-		IsActive:        true,
+		AccountID:        newAccountID,
+		WorkplaceID:      workplaceID,
+		Name:             req.Name,
+		AccountType:      domain.AccountType(req.AccountType),
+		CurrencyCode:     req.CurrencyCode,
+		CFID:             req.CFID,
+		ParentAccountID:  parentID,
+		Description:      req.Description,
+		IsActive:         true,
+		CashFlowCategory: req.CashFlowCategory,
 		AuditFields: domain.AuditFields{
 			CreatedAt:     now,
 			CreatedBy:     userID,
@@ -274,6 +282,16 @@ func (s *accountService) UpdateAccount(ctx context.Context, workplaceID string,
 		account.IsActive = *req.IsActive
 		updated = true
 	}
+	if req.CashFlowCategory != nil {
+		if err := validateCashFlowCategory(account.AccountType, *req.CashFlowCategory); err != nil {
+			s.LogError(ctx, err, "Invalid cash flow category",
+				slog.String("account_id", accountID),
+				slog.String("cash_flow_category", string(*req.CashFlowCategory)))
+			return nil, err
+		}
+		account.CashFlowCategory = *req.CashFlowCategory
+		updated = true
+	}
 	if !updated {
 		s.LogDebug(ctx, "No fields provided for account update",
 			slog.String("account_id", accountID))
@@ -332,3 +350,19 @@ func (s *accountService) CalculateAccountBalance(ctx context.Context, workplaceI
 
 	return account.Balance, nil
 }
+
+// validateCashFlowCategory checks that a cash flow category is compatible with the account type.
+// Only balance sheet accounts can be classified, and only ASSET accounts can hold cash.
+func validateCashFlowCategory(accountType domain.AccountType, category domain.CashFlowCategory) error {
+	if category == "" {
+		return nil
+	}
+	switch accountType {
+	case domain.Revenue, domain.Expense:
+		return fmt.Errorf("%w: cash flow category cannot be set on %s accounts", apperrors.ErrValidation, accountType)
+	}
+	if category == domain.CashFlowCash && accountType != domain.Asset {
+		return fmt.Errorf("%w: only ASSET accounts can be designated as CASH", apperrors.ErrValidation)
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/utils/bankstatement"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// defaultStatementLineLimit is the page size used when listing statement lines without a limit
+const defaultStatementLineLimit = 100
+
+// bankStatementService implements the BankStatementSvcFacade interface
+type bankStatementService struct {
+	BaseService
+	statementRepo portsrepo.BankStatementRepositoryFacade
+	accountRepo   portsrepo.AccountReader
+	journalSvc    portssvc.JournalWriterSvc
+	ruleRepo      portsrepo.CategorizationRuleReader
+	payeeRepo     portsrepo.PayeeReader
+}
+
+// BankStatementServiceOption is a functional option for configuring the bank statement service
+type BankStatementServiceOption func(*bankStatementService)
+
+// WithBankStatementWorkplaceAuthorizer adds workplace authorizer dependency
+func WithBankStatementWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) BankStatementServiceOption {
+	return func(s *bankStatementService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// WithBankStatementCategorizationRules categorizes new lines with the workplace's rules as they are imported
+func WithBankStatementCategorizationRules(ruleRepo portsrepo.CategorizationRuleReader) BankStatementServiceOption {
+	return func(s *bankStatementService) {
+		s.ruleRepo = ruleRepo
+	}
+}
+
+// WithBankStatementPayees recognises the payee of lines as they are posted, tagging the journal with it and
+// falling back to the payee's default counter-account when neither the user nor a rule chose one
+func WithBankStatementPayees(payeeRepo portsrepo.PayeeReader) BankStatementServiceOption {
+	return func(s *bankStatementService) {
+		s.payeeRepo = payeeRepo
+	}
+}
+
+// NewBankStatementService creates a new bank statement service. Lines are posted through the journal
+// service so they get the same validation and balance updates as manually entered journals.
+func NewBankStatementService(statementRepo portsrepo.BankStatementRepositoryFacade, accountRepo portsrepo.AccountReader, journalSvc portssvc.JournalWriterSvc, options ...BankStatementServiceOption) portssvc.BankStatementSvcFacade {
+	svc := &bankStatementService{
+		statementRepo: statementRepo,
+		accountRepo:   accountRepo,
+		journalSvc:    journalSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure bankStatementService implements the BankStatementSvcFacade interface
+var _ portssvc.BankStatementSvcFacade = (*bankStatementService)(nil)
+
+// loadStatementAccount loads an account and checks that it is an active ASSET or LIABILITY account of
+// the workplace, as required for accounts that bank statements are imported into
+func loadStatementAccount(ctx context.Context, accountRepo portsrepo.AccountReader, workplaceID string, accountID string) (*domain.Account, error) {
+	account, err := accountRepo.FindAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: account %s not found in workplace", apperrors.ErrValidation, accountID)
+		}
+		return nil, fmt.Errorf("failed to load statement account: %w", err)
+	}
+	if account.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: account %s not found in workplace", apperrors.ErrValidation, accountID)
+	}
+	if account.AccountType != domain.Asset && account.AccountType != domain.Liability {
+		return nil, fmt.Errorf("%w: account %s is a %s account; statements can only be imported into ASSET or LIABILITY accounts",
+			apperrors.ErrValidation, account.Name, account.AccountType)
+	}
+	if !account.IsActive {
+		return nil, fmt.Errorf("%w: account %s is inactive", apperrors.ErrValidation, account.Name)
+	}
+	return account, nil
+}
+
+// statementTransaction builds the statement-account side of the journal for a statement line:
+// money in debits the account, money out credits it
+func statementTransaction(accountID string, amount decimal.Decimal, reference string) dto.CreateTransactionRequest {
+	transactionType := domain.Debit
+	if amount.IsNegative() {
+		transactionType = domain.Credit
+	}
+	return dto.CreateTransactionRequest{
+		AccountID:       accountID,
+		Amount:          amount.Abs(),
+		TransactionType: transactionType,
+		Notes:           reference,
+	}
+}
+
+// findStatementLine loads a staged line and verifies that it belongs to the workplace
+func (s *bankStatementService) findStatementLine(ctx context.Context, workplaceID string, lineID string) (*domain.BankStatementLine, error) {
+	line, err := s.statementRepo.FindStatementLineByID(ctx, lineID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find bank statement line by ID",
+			slog.String("line_id", lineID))
+		return nil, fmt.Errorf("failed to find bank statement line: %w", err)
+	}
+	if line.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Bank statement line found but belongs to different workplace",
+			slog.String("line_id", lineID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return line, nil
+}
+
+// moveStatementLine changes the status of a line, reporting a conflict when it is not in the expected status
+func (s *bankStatementService) moveStatementLine(ctx context.Context, line *domain.BankStatementLine, from, to domain.StatementLineStatus, journalID string, userID string) error {
+	if line.Status != from {
+		return fmt.Errorf("%w: statement line is %s, expected %s", apperrors.ErrConflict, line.Status, from)
+	}
+	now := time.Now()
+	if err := s.statementRepo.UpdateStatementLineStatus(ctx, line.LineID, from, to, journalID, userID, now); err != nil {
+		if errors.Is(err, apperrors.ErrConflict) {
+			return fmt.Errorf("%w: statement line was changed by another request", apperrors.ErrConflict)
+		}
+		s.LogError(ctx, err, "Failed to update bank statement line status",
+			slog.String("line_id", line.LineID))
+		return err
+	}
+	line.Status = to
+	line.JournalID = journalID
+	line.LastUpdatedAt = now
+	line.LastUpdatedBy = userID
+	return nil
+}
+
+func (s *bankStatementService) ImportStatement(ctx context.Context, workplaceID string, req dto.ImportBankStatementRequest, userID string) (*domain.BankStatementImport, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to import bank statement",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	account, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := bankstatement.ParseFormat(req.Format)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrValidation, err)
+	}
+	statement, err := bankstatement.Parse(format, req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrValidation, err)
+	}
+	if statement.CurrencyCode != "" && !strings.EqualFold(statement.CurrencyCode, account.CurrencyCode) {
+		return nil, fmt.Errorf("%w: statement currency %s does not match account currency %s",
+			apperrors.ErrValidation, statement.CurrencyCode, account.CurrencyCode)
+	}
+
+	now := time.Now()
+	audit := domain.AuditFields{
+		CreatedAt:     now,
+		CreatedBy:     userID,
+		LastUpdatedAt: now,
+		LastUpdatedBy: userID,
+	}
+	statementImport := domain.BankStatementImport{
+		ImportID:    uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AccountID:   account.AccountID,
+		Format:      string(statement.Format),
+		FileName:    req.FileName,
+		AuditFields: audit,
+	}
+
+	// Zero-amount lines (fee notices, balance markers) cannot become journals and are not staged
+	lines := make([]domain.BankStatementLine, 0, len(statement.Lines))
+	for _, parsed := range statement.Lines {
+		if parsed.Amount.IsZero() {
+			continue
+		}
+		lines = append(lines, domain.BankStatementLine{
+			LineID:        uuid.NewString(),
+			WorkplaceID:   workplaceID,
+			AccountID:     account.AccountID,
+			ImportID:      statementImport.ImportID,
+			BankReference: parsed.Reference,
+			Date:          parsed.Date,
+			Amount:        parsed.Amount,
+			Payee:         parsed.Payee,
+			Memo:          parsed.Memo,
+			Status:        domain.StatementLinePending,
+			AuditFields:   audit,
+		})
+	}
+
+	if err := categorizeNewStatementLines(ctx, s.ruleRepo, workplaceID, lines); err != nil {
+		s.LogError(ctx, err, "Failed to categorize bank statement lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	saved, err := s.statementRepo.SaveStatementImport(ctx, statementImport, lines)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to save bank statement import",
+			slog.String("account_id", account.AccountID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Bank statement imported successfully",
+		slog.String("import_id", saved.ImportID),
+		slog.String("format", saved.Format),
+		slog.Int("total_lines", saved.TotalLines),
+		slog.Int("new_lines", saved.NewLines))
+	return saved, nil
+}
+
+func (s *bankStatementService) ListStatementLines(ctx context.Context, workplaceID string, params dto.ListStatementLinesParams, userID string) ([]domain.BankStatementLine, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list bank statement lines",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if _, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, params.AccountID); err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultStatementLineLimit
+	}
+	lines, err := s.statementRepo.ListStatementLines(ctx, params.AccountID, domain.StatementLineStatus(params.Status), limit, params.Offset)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list bank statement lines",
+			slog.String("account_id", params.AccountID))
+		return nil, err
+	}
+	return lines, nil
+}
+
+func (s *bankStatementService) PostStatementLine(ctx context.Context, workplaceID string, lineID string, req dto.PostStatementLineRequest, userID string) (*domain.BankStatementLine, *domain.Journal, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to post bank statement line",
+			slog.String("workplace_id", workplaceID),
+			slog.String("line_id", lineID))
+		return nil, nil, err
+	}
+
+	line, err := s.findStatementLine(ctx, workplaceID, lineID)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, line.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	payeeMatch, err := resolvePayeeFromText(ctx, s.payeeRepo, workplaceID, strings.TrimSpace(line.Payee+" "+line.Memo))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to resolve payee of bank statement line",
+			slog.String("line_id", lineID))
+		return nil, nil, err
+	}
+	// A counter-account given by the user overrides the one assigned by a categorization rule,
+	// which in turn overrides the default counter-account of the line's payee
+	counterAccountID := req.CounterAccountID
+	if counterAccountID == "" {
+		counterAccountID = line.CounterAccountID
+	}
+	if counterAccountID == "" && payeeMatch != nil {
+		counterAccountID = payeeMatch.Payee.DefaultCounterAccountID
+	}
+	if counterAccountID == "" {
+		return nil, nil, fmt.Errorf("%w: no categorization rule or payee matched the line; a counter-account is required", apperrors.ErrValidation)
+	}
+	if counterAccountID == line.AccountID {
+		return nil, nil, fmt.Errorf("%w: counter-account must differ from the statement account", apperrors.ErrValidation)
+	}
+	if counterAccountID != line.CounterAccountID {
+		line.CounterAccountID = counterAccountID
+		line.MatchedRuleID = ""
+	}
+
+	counterNotes := line.Notes
+	if counterNotes == "" {
+		counterNotes = line.BankReference
+	}
+	statementSide := statementTransaction(line.AccountID, line.Amount, line.BankReference)
+	counterSide := dto.CreateTransactionRequest{
+		AccountID:       counterAccountID,
+		Amount:          statementSide.Amount,
+		TransactionType: domain.Credit,
+		Notes:           counterNotes,
+	}
+	if statementSide.TransactionType == domain.Credit {
+		counterSide.TransactionType = domain.Debit
+	}
+
+	description := strings.TrimSpace(req.Description)
+	if description == "" {
+		description = line.Description
+	}
+	if description == "" {
+		description = strings.TrimSpace(strings.Join([]string{line.Payee, line.Memo}, " "))
+	}
+	if description == "" {
+		description = "Bank statement " + line.BankReference
+	}
+	journalReq := dto.CreateJournalRequest{
+		Date:         line.Date,
+		Description:  description,
+		CurrencyCode: account.CurrencyCode,
+		Transactions: []dto.CreateTransactionRequest{statementSide, counterSide},
+	}
+	if payeeMatch != nil {
+		journalReq.PayeeID = payeeMatch.Payee.PayeeID
+	}
+
+	// Claim the line before creating the journal so a concurrent request cannot post it twice
+	if err := s.moveStatementLine(ctx, line, domain.StatementLinePending, domain.StatementLinePosted, "", userID); err != nil {
+		return nil, nil, err
+	}
+
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, journalReq, userID)
+	if err != nil {
+		if revertErr := s.moveStatementLine(ctx, line, domain.StatementLinePosted, domain.StatementLinePending, "", userID); revertErr != nil {
+			s.LogError(ctx, revertErr, "Failed to release bank statement line after journal error",
+				slog.String("line_id", lineID))
+		}
+		return nil, nil, err
+	}
+
+	if err := s.moveStatementLine(ctx, line, domain.StatementLinePosted, domain.StatementLinePosted, journal.JournalID, userID); err != nil {
+		s.LogError(ctx, err, "Failed to link journal to bank statement line",
+			slog.String("line_id", lineID),
+			slog.String("journal_id", journal.JournalID))
+		return nil, nil, err
+	}
+
+	// Record the counter-account actually used so manual choices can be turned into rule suggestions
+	line.Description = description
+	if err := s.statementRepo.SaveStatementLineCategorizations(ctx, []domain.BankStatementLine{*line}); err != nil {
+		s.LogError(ctx, err, "Failed to record categorization of posted bank statement line",
+			slog.String("line_id", lineID))
+	}
+
+	s.LogInfo(ctx, "Bank statement line posted successfully",
+		slog.String("line_id", lineID),
+		slog.String("journal_id", journal.JournalID))
+	return line, journal, nil
+}
+
+func (s *bankStatementService) IgnoreStatementLine(ctx context.Context, workplaceID string, lineID string, userID string) (*domain.BankStatementLine, error) {
+	return s.changeStatementLineStatus(ctx, workplaceID, lineID, domain.StatementLinePending, domain.StatementLineIgnored, userID)
+}
+
+func (s *bankStatementService) RestoreStatementLine(ctx context.Context, workplaceID string, lineID string, userID string) (*domain.BankStatementLine, error) {
+	return s.changeStatementLineStatus(ctx, workplaceID, lineID, domain.StatementLineIgnored, domain.StatementLinePending, userID)
+}
+
+// changeStatementLineStatus authorizes the user and moves a line between two statuses without a journal
+func (s *bankStatementService) changeStatementLineStatus(ctx context.Context, workplaceID string, lineID string, from, to domain.StatementLineStatus, userID string) (*domain.BankStatementLine, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to change bank statement line",
+			slog.String("workplace_id", workplaceID),
+			slog.String("line_id", lineID))
+		return nil, err
+	}
+
+	line, err := s.findStatementLine(ctx, workplaceID, lineID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.moveStatementLine(ctx, line, from, to, "", userID); err != nil {
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Bank statement line status changed",
+		slog.String("line_id", lineID),
+		slog.String("status", string(to)))
+	return line, nil
+}
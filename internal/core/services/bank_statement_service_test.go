@@ -0,0 +1,329 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock BankStatementRepository ---
+type MockBankStatementRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.BankStatementRepositoryFacade = (*MockBankStatementRepository)(nil)
+
+func (m *MockBankStatementRepository) FindStatementLineByID(ctx context.Context, lineID string) (*domain.BankStatementLine, error) {
+	args := m.Called(ctx, lineID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BankStatementLine), args.Error(1)
+}
+
+func (m *MockBankStatementRepository) ListStatementLines(ctx context.Context, accountID string, status domain.StatementLineStatus, limit int, offset int) ([]domain.BankStatementLine, error) {
+	args := m.Called(ctx, accountID, status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.BankStatementLine), args.Error(1)
+}
+
+func (m *MockBankStatementRepository) ListStatementLinesByImport(ctx context.Context, importID string) ([]domain.BankStatementLine, error) {
+	args := m.Called(ctx, importID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.BankStatementLine), args.Error(1)
+}
+
+func (m *MockBankStatementRepository) ListPendingStatementLines(ctx context.Context, workplaceID string, accountID string) ([]domain.BankStatementLine, error) {
+	args := m.Called(ctx, workplaceID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.BankStatementLine), args.Error(1)
+}
+
+func (m *MockBankStatementRepository) ListManuallyPostedLines(ctx context.Context, workplaceID string, since time.Time) ([]domain.BankStatementLine, error) {
+	args := m.Called(ctx, workplaceID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.BankStatementLine), args.Error(1)
+}
+
+func (m *MockBankStatementRepository) SaveStatementLineCategorizations(ctx context.Context, lines []domain.BankStatementLine) error {
+	args := m.Called(ctx, lines)
+	return args.Error(0)
+}
+
+func (m *MockBankStatementRepository) SaveStatementImport(ctx context.Context, statementImport domain.BankStatementImport, lines []domain.BankStatementLine) (*domain.BankStatementImport, error) {
+	args := m.Called(ctx, statementImport, lines)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BankStatementImport), args.Error(1)
+}
+
+func (m *MockBankStatementRepository) UpdateStatementLineStatus(ctx context.Context, lineID string, from, to domain.StatementLineStatus, journalID string, userID string, now time.Time) error {
+	args := m.Called(ctx, lineID, from, to, journalID, userID, now)
+	return args.Error(0)
+}
+
+// --- Mock JournalWriterSvc ---
+type MockJournalWriterSvc struct {
+	mock.Mock
+}
+
+var _ portssvc.JournalSvcFacade = (*MockJournalWriterSvc)(nil)
+
+func (m *MockJournalWriterSvc) GetJournalByID(ctx context.Context, workplaceID string, journalID string, requestingUserID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, requestingUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) ListJournals(ctx context.Context, workplaceID string, userID string, params dto.ListJournalsParams) (*dto.ListJournalsResponse, error) {
+	args := m.Called(ctx, workplaceID, userID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListJournalsResponse), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) ListTransactionsByAccount(ctx context.Context, workplaceID string, accountID string, userID string, params dto.ListTransactionsParams) (*dto.ListTransactionsResponse, error) {
+	args := m.Called(ctx, workplaceID, accountID, userID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListTransactionsResponse), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) CreateJournal(ctx context.Context, workplaceID string, req dto.CreateJournalRequest, creatorUserID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, req, creatorUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) UpdateJournal(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalRequest, requestingUserID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, req, requestingUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) UpdateJournalDimensions(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalDimensionsRequest, requestingUserID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, req, requestingUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) ReverseJournal(ctx context.Context, workplaceID string, journalID string, userID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
+
+func (m *MockJournalWriterSvc) CreateIntercompanyJournal(ctx context.Context, workplaceID string, req dto.CreateIntercompanyJournalRequest, creatorUserID string) (*domain.Journal, *domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, req, creatorUserID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Journal), args.Get(1).(*domain.Journal), args.Error(2)
+}
+
+func (m *MockJournalWriterSvc) ReverseIntercompanyJournal(ctx context.Context, workplaceID string, journalID string, userID string) (*domain.Journal, *domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, userID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Journal), args.Get(1).(*domain.Journal), args.Error(2)
+}
+
+// --- Test Suite Setup ---
+type BankStatementServiceTestSuite struct {
+	suite.Suite
+	mockStatementRepo *MockBankStatementRepository
+	mockAccountRepo   *MockAccountRepositoryFacade
+	mockJournalSvc    *MockJournalWriterSvc
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.BankStatementSvcFacade
+	workplaceID       string
+	userID            string
+	bankAccount       domain.Account
+}
+
+func (suite *BankStatementServiceTestSuite) SetupTest() {
+	suite.mockStatementRepo = new(MockBankStatementRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockJournalSvc = new(MockJournalWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewBankStatementService(suite.mockStatementRepo, suite.mockAccountRepo, suite.mockJournalSvc,
+		services.WithBankStatementWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccount = domain.Account{
+		AccountID:    uuid.NewString(),
+		WorkplaceID:  suite.workplaceID,
+		Name:         "Checking",
+		AccountType:  domain.Asset,
+		CurrencyCode: "EUR",
+		IsActive:     true,
+	}
+}
+
+func TestBankStatementService(t *testing.T) {
+	suite.Run(t, new(BankStatementServiceTestSuite))
+}
+
+const testStatementMT940 = ":20:STMT\r\n:25:NL91ABNA0417164300\r\n:28C:1/1\r\n:60F:C240101EUR1000,00\r\n" +
+	":61:2401020102D25,50NTRFREF1//B1\r\n:86:Coffee shop\r\n" +
+	":61:2401030103C100,00NTRFREF2//B2\r\n:86:Refund\r\n:62F:C240103EUR1074,50\r\n-"
+
+func (suite *BankStatementServiceTestSuite) TestImportStatement_StagesParsedLines() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.bankAccount.AccountID).Return(&suite.bankAccount, nil).Once()
+
+	var staged []domain.BankStatementLine
+	suite.mockStatementRepo.On("SaveStatementImport", ctx, mock.AnythingOfType("domain.BankStatementImport"), mock.Anything).
+		Run(func(args mock.Arguments) { staged = args.Get(2).([]domain.BankStatementLine) }).
+		Return(&domain.BankStatementImport{ImportID: "imp", Format: "MT940", TotalLines: 2, NewLines: 1, DuplicateLines: 1}, nil).Once()
+
+	result, err := suite.service.ImportStatement(ctx, suite.workplaceID, dto.ImportBankStatementRequest{
+		AccountID: suite.bankAccount.AccountID,
+		Data:      []byte(testStatementMT940),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(1, result.DuplicateLines)
+	suite.Require().Len(staged, 2)
+	suite.True(staged[0].Amount.Equal(decimal.RequireFromString("-25.50")))
+	suite.Equal(domain.StatementLinePending, staged[0].Status)
+	suite.Equal(suite.bankAccount.AccountID, staged[1].AccountID)
+	suite.NotEmpty(staged[1].BankReference)
+	suite.mockStatementRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BankStatementServiceTestSuite) TestImportStatement_RejectsCurrencyMismatch() {
+	ctx := context.Background()
+	usd := suite.bankAccount
+	usd.CurrencyCode = "USD"
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, usd.AccountID).Return(&usd, nil).Once()
+
+	_, err := suite.service.ImportStatement(ctx, suite.workplaceID, dto.ImportBankStatementRequest{
+		AccountID: usd.AccountID,
+		Format:    "mt940",
+		Data:      []byte(testStatementMT940),
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockStatementRepo.AssertNotCalled(suite.T(), "SaveStatementImport", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *BankStatementServiceTestSuite) TestPostStatementLine_CreatesJournalForOutflow() {
+	ctx := context.Background()
+	counterID := uuid.NewString()
+	line := &domain.BankStatementLine{
+		LineID:        uuid.NewString(),
+		WorkplaceID:   suite.workplaceID,
+		AccountID:     suite.bankAccount.AccountID,
+		BankReference: "REF1",
+		Date:          time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Amount:        decimal.RequireFromString("-25.50"),
+		Payee:         "Coffee shop",
+		Status:        domain.StatementLinePending,
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockStatementRepo.On("FindStatementLineByID", ctx, line.LineID).Return(line, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.bankAccount.AccountID).Return(&suite.bankAccount, nil).Once()
+	suite.mockStatementRepo.On("UpdateStatementLineStatus", ctx, line.LineID, domain.StatementLinePending, domain.StatementLinePosted, "", suite.userID, mock.Anything).Return(nil).Once()
+
+	var journalReq dto.CreateJournalRequest
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.AnythingOfType("dto.CreateJournalRequest"), suite.userID).
+		Run(func(args mock.Arguments) { journalReq = args.Get(2).(dto.CreateJournalRequest) }).
+		Return(&domain.Journal{JournalID: "journal-1"}, nil).Once()
+	suite.mockStatementRepo.On("UpdateStatementLineStatus", ctx, line.LineID, domain.StatementLinePosted, domain.StatementLinePosted, "journal-1", suite.userID, mock.Anything).Return(nil).Once()
+	suite.mockStatementRepo.On("SaveStatementLineCategorizations", ctx, mock.Anything).Return(nil).Once()
+
+	posted, journal, err := suite.service.PostStatementLine(ctx, suite.workplaceID, line.LineID, dto.PostStatementLineRequest{CounterAccountID: counterID}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("journal-1", journal.JournalID)
+	suite.Equal(domain.StatementLinePosted, posted.Status)
+	suite.Equal("journal-1", posted.JournalID)
+	suite.Equal("EUR", journalReq.CurrencyCode)
+	suite.Equal("Coffee shop", journalReq.Description)
+	suite.Require().Len(journalReq.Transactions, 2)
+	suite.Equal(domain.Credit, journalReq.Transactions[0].TransactionType)
+	suite.Equal(domain.Debit, journalReq.Transactions[1].TransactionType)
+	suite.Equal(counterID, journalReq.Transactions[1].AccountID)
+	suite.True(journalReq.Transactions[0].Amount.Equal(decimal.RequireFromString("25.50")))
+	suite.Equal(counterID, posted.CounterAccountID)
+	suite.mockStatementRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BankStatementServiceTestSuite) TestPostStatementLine_ReleasesLineWhenJournalFails() {
+	ctx := context.Background()
+	line := &domain.BankStatementLine{
+		LineID:      uuid.NewString(),
+		WorkplaceID: suite.workplaceID,
+		AccountID:   suite.bankAccount.AccountID,
+		Date:        time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		Amount:      decimal.RequireFromString("100"),
+		Status:      domain.StatementLinePending,
+	}
+	journalErr := fmt.Errorf("%w: counter-account not found", apperrors.ErrValidation)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockStatementRepo.On("FindStatementLineByID", ctx, line.LineID).Return(line, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.bankAccount.AccountID).Return(&suite.bankAccount, nil).Once()
+	suite.mockStatementRepo.On("UpdateStatementLineStatus", ctx, line.LineID, domain.StatementLinePending, domain.StatementLinePosted, "", suite.userID, mock.Anything).Return(nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(nil, journalErr).Once()
+	suite.mockStatementRepo.On("UpdateStatementLineStatus", ctx, line.LineID, domain.StatementLinePosted, domain.StatementLinePending, "", suite.userID, mock.Anything).Return(nil).Once()
+
+	_, _, err := suite.service.PostStatementLine(ctx, suite.workplaceID, line.LineID, dto.PostStatementLineRequest{CounterAccountID: uuid.NewString()}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockStatementRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BankStatementServiceTestSuite) TestIgnoreStatementLine_RejectsPostedLine() {
+	ctx := context.Background()
+	line := &domain.BankStatementLine{
+		LineID:      uuid.NewString(),
+		WorkplaceID: suite.workplaceID,
+		AccountID:   suite.bankAccount.AccountID,
+		Status:      domain.StatementLinePosted,
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockStatementRepo.On("FindStatementLineByID", ctx, line.LineID).Return(line, nil).Once()
+
+	_, err := suite.service.IgnoreStatementLine(ctx, suite.workplaceID, line.LineID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.mockStatementRepo.AssertNotCalled(suite.T(), "UpdateStatementLineStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
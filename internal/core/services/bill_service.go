@@ -0,0 +1,763 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// billService implements the BillSvcFacade interface
+type billService struct {
+	BaseService
+	billRepo     portsrepo.BillRepositoryFacade
+	accountRepo  portsrepo.AccountReader
+	currencyRepo portsrepo.CurrencyReader
+	taxCodeRepo  portsrepo.TaxCodeReader
+	payeeRepo    portsrepo.PayeeReader
+	journalSvc   portssvc.JournalWriterSvc
+}
+
+// BillServiceOption is a functional option for configuring the bill service
+type BillServiceOption func(*billService)
+
+// WithBillWorkplaceAuthorizer adds workplace authorizer dependency
+func WithBillWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) BillServiceOption {
+	return func(s *billService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewBillService creates a new service for vendor bills. Posting a bill and paying it post journals through the
+// journal service; the status of a bill and the payables are derived from the journals that are still posted,
+// so reversing a payment journal makes the amount owed again and its scheduled payment pending again.
+func NewBillService(billRepo portsrepo.BillRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, taxCodeRepo portsrepo.TaxCodeReader, payeeRepo portsrepo.PayeeReader, journalSvc portssvc.JournalWriterSvc, options ...BillServiceOption) portssvc.BillSvcFacade {
+	svc := &billService{
+		billRepo:     billRepo,
+		accountRepo:  accountRepo,
+		currencyRepo: currencyRepo,
+		taxCodeRepo:  taxCodeRepo,
+		payeeRepo:    payeeRepo,
+		journalSvc:   journalSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure billService implements the BillSvcFacade interface
+var _ portssvc.BillSvcFacade = (*billService)(nil)
+
+// findBill loads a bill and verifies that it belongs to the workplace
+func (s *billService) findBill(ctx context.Context, workplaceID string, billID string) (*domain.Bill, error) {
+	bill, err := s.billRepo.FindBillByID(ctx, billID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find bill by ID",
+			slog.String("bill_id", billID))
+		return nil, fmt.Errorf("failed to find bill: %w", err)
+	}
+	if bill.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Bill found but belongs to different workplace",
+			slog.String("bill_id", billID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return bill, nil
+}
+
+// findDraftBill loads a bill that has not been posted yet
+func (s *billService) findDraftBill(ctx context.Context, workplaceID string, billID string) (*domain.Bill, error) {
+	bill, err := s.findBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+	if bill.PostJournalID != "" {
+		return nil, fmt.Errorf("%w: bill has been posted and can no longer be changed", apperrors.ErrConflict)
+	}
+	return bill, nil
+}
+
+// findPayableBill loads a bill that has been posted and not voided
+func (s *billService) findPayableBill(ctx context.Context, workplaceID string, billID string) (*domain.Bill, error) {
+	bill, err := s.findBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+	switch bill.Status(todayUTC()) {
+	case domain.BillDraft:
+		return nil, fmt.Errorf("%w: bill must be posted before it can be paid", apperrors.ErrValidation)
+	case domain.BillVoid:
+		return nil, fmt.Errorf("%w: bill is void", apperrors.ErrValidation)
+	}
+	return bill, nil
+}
+
+// applyBillRequest validates the content of a request and copies it onto a draft bill. The payable account must
+// be a LIABILITY account and sets the currency of the bill; the expense accounts must be EXPENSE accounts in that
+// currency. Line amounts are split with their tax codes exactly as the journal service will split them on posting.
+func (s *billService) applyBillRequest(ctx context.Context, workplaceID string, bill *domain.Bill, req dto.BillRequest) error {
+	if _, err := loadWorkplacePayee(ctx, s.payeeRepo, workplaceID, req.VendorID); err != nil {
+		return err
+	}
+
+	accountIDs := []string{req.PayableAccountID}
+	taxCodeIDs := []string{}
+	for _, line := range req.Lines {
+		accountIDs = append(accountIDs, line.ExpenseAccountID)
+		if line.TaxCodeID != "" {
+			taxCodeIDs = append(taxCodeIDs, line.TaxCodeID)
+		}
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, uniqueStrings(accountIDs))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load accounts of bill",
+			slog.String("workplace_id", workplaceID))
+		return err
+	}
+	payable, ok := accounts[req.PayableAccountID]
+	if !ok || payable.WorkplaceID != workplaceID {
+		return fmt.Errorf("%w: payable account %s not found", apperrors.ErrValidation, req.PayableAccountID)
+	}
+	if payable.AccountType != domain.Liability || !payable.IsActive {
+		return fmt.Errorf("%w: payable account must be an active LIABILITY account", apperrors.ErrValidation)
+	}
+
+	taxCodes := map[string]domain.TaxCode{}
+	if len(taxCodeIDs) > 0 {
+		taxCodes, err = s.taxCodeRepo.FindTaxCodesByIDs(ctx, uniqueStrings(taxCodeIDs))
+		if err != nil {
+			s.LogError(ctx, err, "Failed to load tax codes of bill",
+				slog.String("workplace_id", workplaceID))
+			return err
+		}
+	}
+
+	billDate := dateOnly(req.BillDate)
+	dueDate := billDate
+	if req.DueDate != nil {
+		dueDate = dateOnly(*req.DueDate)
+	}
+	if dueDate.Before(billDate) {
+		return fmt.Errorf("%w: due date cannot be before the bill date", apperrors.ErrValidation)
+	}
+
+	precision := currencyPrecision(ctx, s.currencyRepo, payable.CurrencyCode)
+	lines := make([]domain.BillLine, 0, len(req.Lines))
+	subtotal, taxTotal := decimal.Zero, decimal.Zero
+	for i, lineReq := range req.Lines {
+		description := strings.TrimSpace(lineReq.Description)
+		if description == "" {
+			return fmt.Errorf("%w: line %d: description must not be blank", apperrors.ErrValidation, i+1)
+		}
+		amount := lineReq.Amount.Round(precision)
+		if !amount.IsPositive() {
+			return fmt.Errorf("%w: line %d: amount must be positive", apperrors.ErrValidation, i+1)
+		}
+		expense, ok := accounts[lineReq.ExpenseAccountID]
+		if !ok || expense.WorkplaceID != workplaceID {
+			return fmt.Errorf("%w: line %d: expense account %s not found", apperrors.ErrValidation, i+1, lineReq.ExpenseAccountID)
+		}
+		if expense.AccountType != domain.Expense || !expense.IsActive {
+			return fmt.Errorf("%w: line %d: expense account must be an active EXPENSE account", apperrors.ErrValidation, i+1)
+		}
+		if expense.CurrencyCode != payable.CurrencyCode {
+			return fmt.Errorf("%w: line %d: expense account currency %s does not match bill currency %s",
+				apperrors.ErrValidation, i+1, expense.CurrencyCode, payable.CurrencyCode)
+		}
+
+		net, tax := amount, decimal.Zero
+		if lineReq.TaxCodeID != "" {
+			taxCode, ok := taxCodes[lineReq.TaxCodeID]
+			if !ok || taxCode.WorkplaceID != workplaceID {
+				return fmt.Errorf("%w: line %d: tax code %s not found", apperrors.ErrValidation, i+1, lineReq.TaxCodeID)
+			}
+			if !taxCode.IsActive {
+				return fmt.Errorf("%w: line %d: tax code %s is inactive", apperrors.ErrValidation, i+1, taxCode.Code)
+			}
+			net, tax = taxCode.Split(amount, precision)
+		}
+		subtotal = subtotal.Add(net)
+		taxTotal = taxTotal.Add(tax)
+		lines = append(lines, domain.BillLine{
+			LineID:           uuid.NewString(),
+			BillID:           bill.BillID,
+			LineNumber:       i + 1,
+			Description:      description,
+			ExpenseAccountID: lineReq.ExpenseAccountID,
+			TaxCodeID:        lineReq.TaxCodeID,
+			Amount:           amount,
+			NetAmount:        net,
+			TaxAmount:        tax,
+		})
+	}
+
+	bill.VendorID = req.VendorID
+	bill.PayableAccountID = req.PayableAccountID
+	bill.CurrencyCode = payable.CurrencyCode
+	bill.Reference = strings.TrimSpace(req.Reference)
+	bill.BillDate = billDate
+	bill.DueDate = dueDate
+	bill.Notes = strings.TrimSpace(req.Notes)
+	bill.Subtotal = subtotal
+	bill.TaxTotal = taxTotal
+	bill.Total = subtotal.Add(taxTotal)
+	bill.Lines = lines
+	return nil
+}
+
+// billPaymentAccount verifies that a payment account is an ASSET or LIABILITY account of the workplace other
+// than the payable account of the bill
+func (s *billService) billPaymentAccount(ctx context.Context, workplaceID string, bill *domain.Bill, accountID string) error {
+	account, err := s.accountRepo.FindAccountByID(ctx, accountID)
+	if err != nil || account.WorkplaceID != workplaceID {
+		return fmt.Errorf("%w: payment account %s not found", apperrors.ErrValidation, accountID)
+	}
+	if (account.AccountType != domain.Asset && account.AccountType != domain.Liability) || account.AccountID == bill.PayableAccountID {
+		return fmt.Errorf("%w: payment account must be an ASSET or LIABILITY account other than the payable account", apperrors.ErrValidation)
+	}
+	return nil
+}
+
+// billDescription names a bill in journal descriptions
+func billDescription(bill *domain.Bill) string {
+	if bill.Reference != "" {
+		return "Bill " + bill.Reference
+	}
+	return "Bill of " + bill.BillDate.Format("2006-01-02")
+}
+
+// postBillPayment posts a settlement journal moving an amount from the payment account to the payable account
+// and records the payment, reversing the journal when the payment cannot be recorded
+func (s *billService) postBillPayment(ctx context.Context, workplaceID string, bill *domain.Bill, paymentAccountID string, amount decimal.Decimal, date time.Time, scheduledPaymentID string, userID string) (*domain.BillPayment, error) {
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         date,
+		Description:  "Payment of " + billDescription(bill),
+		CurrencyCode: bill.CurrencyCode,
+		PayeeID:      bill.VendorID,
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: bill.PayableAccountID, Amount: amount, TransactionType: domain.Debit},
+			{AccountID: paymentAccountID, Amount: amount, TransactionType: domain.Credit},
+		},
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post bill payment journal",
+			slog.String("bill_id", bill.BillID))
+		return nil, journalValidationError(err)
+	}
+
+	payment := &domain.BillPayment{
+		PaymentID:          uuid.NewString(),
+		BillID:             bill.BillID,
+		JournalID:          journal.JournalID,
+		PaymentAccountID:   paymentAccountID,
+		ScheduledPaymentID: scheduledPaymentID,
+		PaymentDate:        date,
+		Amount:             amount,
+		CreatedAt:          time.Now(),
+		CreatedBy:          userID,
+	}
+	if err := s.billRepo.SaveBillPayment(ctx, *payment); err != nil {
+		s.LogError(ctx, err, "Failed to save bill payment, reversing journal",
+			slog.String("bill_id", bill.BillID),
+			slog.String("journal_id", journal.JournalID))
+		if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, journal.JournalID, userID); reverseErr != nil {
+			s.LogError(ctx, reverseErr, "Failed to reverse bill payment journal",
+				slog.String("journal_id", journal.JournalID))
+		}
+		return nil, err
+	}
+	return payment, nil
+}
+
+func (s *billService) CreateBill(ctx context.Context, workplaceID string, req dto.BillRequest, userID string) (*domain.Bill, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create bill",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	bill := &domain.Bill{
+		BillID:            uuid.NewString(),
+		WorkplaceID:       workplaceID,
+		Payments:          []domain.BillPayment{},
+		ScheduledPayments: []domain.ScheduledBillPayment{},
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.applyBillRequest(ctx, workplaceID, bill, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.billRepo.SaveBill(ctx, *bill); err != nil {
+		s.LogError(ctx, err, "Failed to save bill",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Bill created successfully",
+		slog.String("bill_id", bill.BillID),
+		slog.String("workplace_id", workplaceID))
+	return bill, nil
+}
+
+func (s *billService) UpdateBill(ctx context.Context, workplaceID string, billID string, req dto.BillRequest, userID string) (*domain.Bill, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update bill",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+
+	bill, err := s.findDraftBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyBillRequest(ctx, workplaceID, bill, req); err != nil {
+		return nil, err
+	}
+
+	bill.LastUpdatedAt = time.Now()
+	bill.LastUpdatedBy = userID
+	if err := s.billRepo.UpdateBill(ctx, *bill); err != nil {
+		s.LogError(ctx, err, "Failed to update bill",
+			slog.String("bill_id", billID))
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: bill has been posted and can no longer be changed", apperrors.ErrConflict)
+		}
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Bill updated successfully",
+		slog.String("bill_id", billID),
+		slog.String("workplace_id", workplaceID))
+	return bill, nil
+}
+
+func (s *billService) DeleteBill(ctx context.Context, workplaceID string, billID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete bill",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return err
+	}
+
+	if _, err := s.findDraftBill(ctx, workplaceID, billID); err != nil {
+		return err
+	}
+	if err := s.billRepo.DeleteBill(ctx, billID); err != nil {
+		s.LogError(ctx, err, "Failed to delete bill",
+			slog.String("bill_id", billID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Bill deleted successfully",
+		slog.String("bill_id", billID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+func (s *billService) ListBills(ctx context.Context, workplaceID string, params dto.ListBillsParams, userID string) ([]domain.Bill, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list bills",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	bills, err := s.billRepo.ListBills(ctx, workplaceID, params.VendorID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list bills",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if params.Status == "" {
+		return bills, nil
+	}
+
+	asOf := todayUTC()
+	filtered := make([]domain.Bill, 0, len(bills))
+	for _, bill := range bills {
+		if bill.Status(asOf) == params.Status {
+			filtered = append(filtered, bill)
+		}
+	}
+	return filtered, nil
+}
+
+func (s *billService) GetBill(ctx context.Context, workplaceID string, billID string, userID string) (*domain.Bill, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view bill",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+	return s.findBill(ctx, workplaceID, billID)
+}
+
+func (s *billService) PostBill(ctx context.Context, workplaceID string, billID string, userID string) (*domain.Bill, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to post bill",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+
+	bill, err := s.findDraftBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]dto.CreateTransactionRequest, 0, len(bill.Lines)+1)
+	for _, line := range bill.Lines {
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID:       line.ExpenseAccountID,
+			Amount:          line.Amount,
+			TransactionType: domain.Debit,
+			Notes:           line.Description,
+			TaxCodeID:       line.TaxCodeID,
+		})
+	}
+	transactions = append(transactions, dto.CreateTransactionRequest{
+		AccountID: bill.PayableAccountID, Amount: bill.Total, TransactionType: domain.Credit, Notes: billDescription(bill),
+	})
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         bill.BillDate,
+		Description:  billDescription(bill),
+		CurrencyCode: bill.CurrencyCode,
+		PayeeID:      bill.VendorID,
+		Transactions: transactions,
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post bill journal",
+			slog.String("bill_id", billID))
+		return nil, journalValidationError(err)
+	}
+
+	now := time.Now()
+	if err := s.billRepo.MarkBillPosted(ctx, billID, journal.JournalID, userID, now); err != nil {
+		s.LogError(ctx, err, "Failed to mark bill posted, reversing journal",
+			slog.String("bill_id", billID),
+			slog.String("journal_id", journal.JournalID))
+		if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, journal.JournalID, userID); reverseErr != nil {
+			s.LogError(ctx, reverseErr, "Failed to reverse bill journal",
+				slog.String("journal_id", journal.JournalID))
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: bill has already been posted", apperrors.ErrConflict)
+		}
+		return nil, err
+	}
+
+	bill.PostJournalID = journal.JournalID
+	bill.PostJournalStatus = domain.Posted
+	bill.LastUpdatedAt = now
+	bill.LastUpdatedBy = userID
+
+	s.LogInfo(ctx, "Bill posted successfully",
+		slog.String("bill_id", billID),
+		slog.String("journal_id", journal.JournalID))
+	return bill, nil
+}
+
+func (s *billService) VoidBill(ctx context.Context, workplaceID string, billID string, userID string) (*domain.Bill, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to void bill",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+
+	bill, err := s.findBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case bill.PostJournalID == "":
+		return nil, fmt.Errorf("%w: draft bills are deleted rather than voided", apperrors.ErrConflict)
+	case bill.PostJournalStatus != domain.Posted:
+		return nil, fmt.Errorf("%w: bill is already void", apperrors.ErrConflict)
+	case len(bill.Payments) > 0:
+		return nil, fmt.Errorf("%w: reverse the payments of the bill before voiding it", apperrors.ErrConflict)
+	}
+
+	if _, err := s.journalSvc.ReverseJournal(ctx, workplaceID, bill.PostJournalID, userID); err != nil {
+		s.LogError(ctx, err, "Failed to reverse bill journal",
+			slog.String("bill_id", billID),
+			slog.String("journal_id", bill.PostJournalID))
+		return nil, err
+	}
+	bill.PostJournalStatus = domain.Reversed
+
+	s.LogInfo(ctx, "Bill voided successfully",
+		slog.String("bill_id", billID),
+		slog.String("journal_id", bill.PostJournalID))
+	return bill, nil
+}
+
+func (s *billService) RecordBillPayment(ctx context.Context, workplaceID string, billID string, req dto.BillPaymentRequest, userID string) (*domain.BillPayment, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to record bill payment",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+
+	bill, err := s.findPayableBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+	date := dateOnly(req.Date)
+	if date.Before(bill.BillDate) {
+		return nil, fmt.Errorf("%w: payment date cannot be before the bill date", apperrors.ErrValidation)
+	}
+	amount := req.Amount.Round(currencyPrecision(ctx, s.currencyRepo, bill.CurrencyCode))
+	unpaid := bill.Unpaid()
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", apperrors.ErrValidation)
+	}
+	if amount.GreaterThan(unpaid) {
+		return nil, fmt.Errorf("%w: amount %s exceeds the unpaid %s", apperrors.ErrValidation, amount, unpaid)
+	}
+	if err := s.billPaymentAccount(ctx, workplaceID, bill, req.PaymentAccountID); err != nil {
+		return nil, err
+	}
+
+	payment, err := s.postBillPayment(ctx, workplaceID, bill, req.PaymentAccountID, amount, date, "", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Bill payment recorded successfully",
+		slog.String("bill_id", billID),
+		slog.String("journal_id", payment.JournalID))
+	return payment, nil
+}
+
+func (s *billService) ScheduleBillPayment(ctx context.Context, workplaceID string, billID string, req dto.BillPaymentRequest, userID string) (*domain.ScheduledBillPayment, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to schedule bill payment",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+
+	bill, err := s.findPayableBill(ctx, workplaceID, billID)
+	if err != nil {
+		return nil, err
+	}
+	date := dateOnly(req.Date)
+	if date.Before(bill.BillDate) {
+		return nil, fmt.Errorf("%w: payment date cannot be before the bill date", apperrors.ErrValidation)
+	}
+	amount := req.Amount.Round(currencyPrecision(ctx, s.currencyRepo, bill.CurrencyCode))
+	unscheduled := bill.Unpaid().Sub(bill.PendingScheduled())
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", apperrors.ErrValidation)
+	}
+	if amount.GreaterThan(unscheduled) {
+		return nil, fmt.Errorf("%w: amount %s exceeds the unpaid amount not yet scheduled %s", apperrors.ErrValidation, amount, unscheduled)
+	}
+	if err := s.billPaymentAccount(ctx, workplaceID, bill, req.PaymentAccountID); err != nil {
+		return nil, err
+	}
+
+	scheduled := &domain.ScheduledBillPayment{
+		ScheduledPaymentID: uuid.NewString(),
+		BillID:             billID,
+		PaymentAccountID:   req.PaymentAccountID,
+		ScheduledDate:      date,
+		Amount:             amount,
+		CreatedAt:          time.Now(),
+		CreatedBy:          userID,
+	}
+	if err := s.billRepo.SaveScheduledBillPayment(ctx, *scheduled); err != nil {
+		s.LogError(ctx, err, "Failed to save scheduled bill payment",
+			slog.String("bill_id", billID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Bill payment scheduled successfully",
+		slog.String("bill_id", billID),
+		slog.String("scheduled_payment_id", scheduled.ScheduledPaymentID))
+	return scheduled, nil
+}
+
+func (s *billService) CancelScheduledBillPayment(ctx context.Context, workplaceID string, billID string, scheduledPaymentID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to cancel scheduled bill payment",
+			slog.String("workplace_id", workplaceID),
+			slog.String("bill_id", billID))
+		return err
+	}
+
+	bill, err := s.findBill(ctx, workplaceID, billID)
+	if err != nil {
+		return err
+	}
+	var scheduled *domain.ScheduledBillPayment
+	for i := range bill.ScheduledPayments {
+		if bill.ScheduledPayments[i].ScheduledPaymentID == scheduledPaymentID {
+			scheduled = &bill.ScheduledPayments[i]
+		}
+	}
+	if scheduled == nil {
+		return apperrors.ErrNotFound
+	}
+	if !scheduled.Pending() {
+		return fmt.Errorf("%w: the scheduled payment has been posted; reverse its journal instead", apperrors.ErrConflict)
+	}
+	if err := s.billRepo.DeleteScheduledBillPayment(ctx, scheduledPaymentID); err != nil {
+		s.LogError(ctx, err, "Failed to delete scheduled bill payment",
+			slog.String("scheduled_payment_id", scheduledPaymentID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Scheduled bill payment cancelled",
+		slog.String("bill_id", billID),
+		slog.String("scheduled_payment_id", scheduledPaymentID))
+	return nil
+}
+
+func (s *billService) PostDueBillPayments(ctx context.Context, workplaceID string, asOf time.Time, userID string) ([]domain.BillPayment, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to post due bill payments",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	due, err := s.billRepo.ListDueScheduledBillPayments(ctx, workplaceID, dateOnly(asOf))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list due scheduled bill payments",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	payments := []domain.BillPayment{}
+	bills := make(map[string]*domain.Bill)
+	for _, scheduled := range due {
+		bill, ok := bills[scheduled.BillID]
+		if !ok {
+			if bill, err = s.findBill(ctx, workplaceID, scheduled.BillID); err != nil {
+				return payments, err
+			}
+			bills[scheduled.BillID] = bill
+		}
+		// Payments recorded by hand since scheduling may leave less to pay than planned
+		amount := decimal.Min(scheduled.Amount, bill.Unpaid())
+		if !amount.IsPositive() {
+			s.LogInfo(ctx, "Skipping scheduled payment of a bill already paid",
+				slog.String("bill_id", bill.BillID),
+				slog.String("scheduled_payment_id", scheduled.ScheduledPaymentID))
+			continue
+		}
+		payment, err := s.postBillPayment(ctx, workplaceID, bill, scheduled.PaymentAccountID, amount, scheduled.ScheduledDate, scheduled.ScheduledPaymentID, userID)
+		if err != nil {
+			return payments, err
+		}
+		bill.Payments = append(bill.Payments, *payment)
+		payments = append(payments, *payment)
+	}
+
+	s.LogInfo(ctx, "Due bill payments posted",
+		slog.String("workplace_id", workplaceID),
+		slog.Int("count", len(payments)))
+	return payments, nil
+}
+
+func (s *billService) AgedPayables(ctx context.Context, workplaceID string, asOf time.Time, accountID string, userID string) (*domain.AgingReport, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view aged payables",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	bills, err := s.billRepo.ListBills(ctx, workplaceID, "")
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list bills for aged payables",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	asOf = dateOnly(asOf)
+	open := make([]domain.Bill, 0, len(bills))
+	accountIDs := []string{}
+	for _, bill := range bills {
+		if bill.BillDate.After(asOf) || !bill.Outstanding(asOf).IsPositive() {
+			continue
+		}
+		open = append(open, bill)
+		accountIDs = append(accountIDs, bill.PayableAccountID)
+	}
+
+	if accountID != "" && len(open) > 0 {
+		// Bills payable to a sub-account of the requested account are included
+		tree, err := loadAccountTree(ctx, s.accountRepo, uniqueStrings(accountIDs))
+		if err != nil {
+			s.LogError(ctx, err, "Failed to load payable accounts for aged payables",
+				slog.String("workplace_id", workplaceID))
+			return nil, err
+		}
+		filtered := open[:0]
+		for _, bill := range open {
+			visited := make(map[string]bool)
+			for id := bill.PayableAccountID; id != "" && !visited[id]; id = tree[id].ParentAccountID {
+				visited[id] = true
+				if id == accountID {
+					filtered = append(filtered, bill)
+					break
+				}
+			}
+		}
+		open = filtered
+	}
+
+	payees, err := s.payeeRepo.ListPayees(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list vendors for aged payables",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	names := make(map[string]string, len(payees))
+	for _, payee := range payees {
+		names[payee.PayeeID] = payee.Name
+	}
+
+	items := make([]domain.AgedItem, 0, len(open))
+	for _, bill := range open {
+		items = append(items, domain.AgedItem{
+			DocumentID:       bill.BillID,
+			DocumentNumber:   bill.Reference,
+			CounterpartyID:   bill.VendorID,
+			CounterpartyName: names[bill.VendorID],
+			CurrencyCode:     bill.CurrencyCode,
+			IssueDate:        bill.BillDate,
+			DueDate:          bill.DueDate,
+			Total:            bill.Total,
+			Outstanding:      bill.Outstanding(asOf),
+		})
+	}
+
+	report := domain.NewAgingReport(asOf, items)
+	return &report, nil
+}
@@ -0,0 +1,357 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock BillRepository ---
+type MockBillRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.BillRepositoryFacade = (*MockBillRepository)(nil)
+
+func (m *MockBillRepository) FindBillByID(ctx context.Context, billID string) (*domain.Bill, error) {
+	args := m.Called(ctx, billID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Bill), args.Error(1)
+}
+
+func (m *MockBillRepository) ListBills(ctx context.Context, workplaceID string, vendorID string) ([]domain.Bill, error) {
+	args := m.Called(ctx, workplaceID, vendorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Bill), args.Error(1)
+}
+
+func (m *MockBillRepository) ListDueScheduledBillPayments(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.ScheduledBillPayment, error) {
+	args := m.Called(ctx, workplaceID, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ScheduledBillPayment), args.Error(1)
+}
+
+func (m *MockBillRepository) SaveBill(ctx context.Context, bill domain.Bill) error {
+	args := m.Called(ctx, bill)
+	return args.Error(0)
+}
+
+func (m *MockBillRepository) UpdateBill(ctx context.Context, bill domain.Bill) error {
+	args := m.Called(ctx, bill)
+	return args.Error(0)
+}
+
+func (m *MockBillRepository) DeleteBill(ctx context.Context, billID string) error {
+	args := m.Called(ctx, billID)
+	return args.Error(0)
+}
+
+func (m *MockBillRepository) MarkBillPosted(ctx context.Context, billID string, journalID string, userID string, at time.Time) error {
+	args := m.Called(ctx, billID, journalID, userID, at)
+	return args.Error(0)
+}
+
+func (m *MockBillRepository) SaveBillPayment(ctx context.Context, payment domain.BillPayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockBillRepository) SaveScheduledBillPayment(ctx context.Context, scheduled domain.ScheduledBillPayment) error {
+	args := m.Called(ctx, scheduled)
+	return args.Error(0)
+}
+
+func (m *MockBillRepository) DeleteScheduledBillPayment(ctx context.Context, scheduledPaymentID string) error {
+	args := m.Called(ctx, scheduledPaymentID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type BillServiceTestSuite struct {
+	suite.Suite
+	mockBillRepo     *MockBillRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockCurrencyRepo *MockCurrencyRepository
+	mockTaxCodeRepo  *MockTaxCodeRepository
+	mockPayeeRepo    *MockPayeeRepository
+	mockJournalSvc   *MockJournalWriterSvc
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.BillSvcFacade
+	workplaceID      string
+	userID           string
+	vendor           domain.Payee
+	vat              domain.TaxCode
+}
+
+func (suite *BillServiceTestSuite) SetupTest() {
+	suite.mockBillRepo = new(MockBillRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockTaxCodeRepo = new(MockTaxCodeRepository)
+	suite.mockPayeeRepo = new(MockPayeeRepository)
+	suite.mockJournalSvc = new(MockJournalWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewBillService(suite.mockBillRepo, suite.mockAccountRepo, suite.mockCurrencyRepo,
+		suite.mockTaxCodeRepo, suite.mockPayeeRepo, suite.mockJournalSvc, services.WithBillWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.vendor = domain.Payee{PayeeID: uuid.NewString(), WorkplaceID: suite.workplaceID, Name: "Office Supplies Co", IsActive: true}
+	suite.vat = domain.TaxCode{TaxCodeID: uuid.NewString(), WorkplaceID: suite.workplaceID, Code: "VAT10",
+		Rate: decimal.NewFromInt(10), TaxAccountID: "vat-receivable", IsActive: true}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", mock.Anything, suite.userID, suite.workplaceID, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func TestBillService(t *testing.T) {
+	suite.Run(t, new(BillServiceTestSuite))
+}
+
+// expectBillContent mocks the vendor, the payable and expense accounts and the VAT code
+func (suite *BillServiceTestSuite) expectBillContent(ctx context.Context) {
+	suite.mockPayeeRepo.On("FindPayeeByID", ctx, suite.vendor.PayeeID).Return(&suite.vendor, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"payable":  {AccountID: "payable", WorkplaceID: suite.workplaceID, AccountType: domain.Liability, CurrencyCode: "USD", IsActive: true},
+		"supplies": {AccountID: "supplies", WorkplaceID: suite.workplaceID, AccountType: domain.Expense, CurrencyCode: "USD", IsActive: true},
+		"bank":     {AccountID: "bank", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true},
+	}, nil).Once()
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{suite.vat.TaxCodeID}).
+		Return(map[string]domain.TaxCode{suite.vat.TaxCodeID: suite.vat}, nil).Maybe()
+}
+
+func (suite *BillServiceTestSuite) billRequest(lines ...dto.BillLineRequest) dto.BillRequest {
+	return dto.BillRequest{
+		VendorID:         suite.vendor.PayeeID,
+		PayableAccountID: "payable",
+		Reference:        "SUP-981",
+		BillDate:         time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		Lines:            lines,
+	}
+}
+
+// postedBill returns a bill of 110 dated 31 March and due on 30 April, with the given payments
+func (suite *BillServiceTestSuite) postedBill(payments ...domain.BillPayment) *domain.Bill {
+	billID := uuid.NewString()
+	for i := range payments {
+		payments[i].BillID = billID
+	}
+	return &domain.Bill{
+		BillID:            billID,
+		WorkplaceID:       suite.workplaceID,
+		VendorID:          suite.vendor.PayeeID,
+		PayableAccountID:  "payable",
+		Reference:         "SUP-981",
+		BillDate:          time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		DueDate:           time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+		CurrencyCode:      "USD",
+		Subtotal:          decimal.NewFromInt(100),
+		TaxTotal:          decimal.NewFromInt(10),
+		Total:             decimal.NewFromInt(110),
+		PostJournalID:     "post-journal",
+		PostJournalStatus: domain.Posted,
+		Payments:          payments,
+	}
+}
+
+func billPayment(amount string, date time.Time) domain.BillPayment {
+	return domain.BillPayment{PaymentID: uuid.NewString(), JournalID: uuid.NewString(), PaymentDate: date, Amount: decimal.RequireFromString(amount)}
+}
+
+func (suite *BillServiceTestSuite) TestCreateBill_SplitsTaxAndDefaultsDueDate() {
+	ctx := context.Background()
+	suite.expectBillContent(ctx)
+	suite.mockBillRepo.On("SaveBill", ctx, mock.AnythingOfType("domain.Bill")).Return(nil).Once()
+
+	bill, err := suite.service.CreateBill(ctx, suite.workplaceID, suite.billRequest(
+		dto.BillLineRequest{Description: "Paper", ExpenseAccountID: "supplies", Amount: decimal.NewFromInt(100), TaxCodeID: suite.vat.TaxCodeID},
+		dto.BillLineRequest{Description: "Delivery", ExpenseAccountID: "supplies", Amount: decimal.NewFromInt(15)},
+	), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("USD", bill.CurrencyCode)
+	suite.True(bill.DueDate.Equal(bill.BillDate))
+	suite.True(bill.Subtotal.Equal(decimal.NewFromInt(115)))
+	suite.True(bill.TaxTotal.Equal(decimal.NewFromInt(10)))
+	suite.True(bill.Total.Equal(decimal.NewFromInt(125)))
+	suite.Equal(domain.BillDraft, bill.Status(time.Now()))
+	suite.mockBillRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BillServiceTestSuite) TestCreateBill_RejectsNonExpenseLineAccount() {
+	ctx := context.Background()
+	suite.expectBillContent(ctx)
+
+	_, err := suite.service.CreateBill(ctx, suite.workplaceID, suite.billRequest(
+		dto.BillLineRequest{Description: "Paper", ExpenseAccountID: "bank", Amount: decimal.NewFromInt(100)},
+	), suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockBillRepo.AssertNotCalled(suite.T(), "SaveBill", mock.Anything, mock.Anything)
+}
+
+func (suite *BillServiceTestSuite) TestPostBill_PostsExpensesAndPayable() {
+	ctx := context.Background()
+	bill := suite.postedBill()
+	bill.PostJournalID, bill.PostJournalStatus = "", ""
+	bill.Lines = []domain.BillLine{
+		{LineNumber: 1, Description: "Paper", ExpenseAccountID: "supplies", TaxCodeID: suite.vat.TaxCodeID,
+			Amount: decimal.NewFromInt(100), NetAmount: decimal.NewFromInt(100), TaxAmount: decimal.NewFromInt(10)},
+	}
+	suite.mockBillRepo.On("FindBillByID", ctx, bill.BillID).Return(bill, nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		// The expense line carries its tax code so the journal service debits the 10 of VAT separately
+		return r.Description == "Bill SUP-981" && r.PayeeID == suite.vendor.PayeeID && r.Date.Equal(bill.BillDate) &&
+			hasLines(r, expectedLine{"supplies", "100", domain.Debit}, expectedLine{"payable", "110", domain.Credit}) &&
+			r.Transactions[0].TaxCodeID == suite.vat.TaxCodeID
+	}), suite.userID).Return(&domain.Journal{JournalID: "post-journal"}, nil).Once()
+	suite.mockBillRepo.On("MarkBillPosted", ctx, bill.BillID, "post-journal", suite.userID, mock.Anything).Return(nil).Once()
+
+	posted, err := suite.service.PostBill(ctx, suite.workplaceID, bill.BillID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(domain.BillOpen, posted.Status(bill.BillDate))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockBillRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BillServiceTestSuite) TestRecordBillPayment_PartialPaymentSettlesPayable() {
+	ctx := context.Background()
+	bill := suite.postedBill(billPayment("60", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	suite.mockBillRepo.On("FindBillByID", ctx, bill.BillID).Return(bill, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "bank").Return(&domain.Account{AccountID: "bank", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD"}, nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return r.PayeeID == suite.vendor.PayeeID && r.CurrencyCode == "USD" &&
+			hasLines(r, expectedLine{"payable", "30", domain.Debit}, expectedLine{"bank", "30", domain.Credit})
+	}), suite.userID).Return(&domain.Journal{JournalID: "payment-journal"}, nil).Once()
+	suite.mockBillRepo.On("SaveBillPayment", ctx, mock.MatchedBy(func(p domain.BillPayment) bool {
+		return p.BillID == bill.BillID && p.JournalID == "payment-journal" && p.Amount.Equal(decimal.NewFromInt(30)) && p.ScheduledPaymentID == ""
+	})).Return(nil).Once()
+
+	payment, err := suite.service.RecordBillPayment(ctx, suite.workplaceID, bill.BillID, dto.BillPaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(30), Date: time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("payment-journal", payment.JournalID)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockBillRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BillServiceTestSuite) TestRecordBillPayment_ReversesJournalWhenNotSaved() {
+	ctx := context.Background()
+	bill := suite.postedBill()
+	suite.mockBillRepo.On("FindBillByID", ctx, bill.BillID).Return(bill, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "bank").Return(&domain.Account{AccountID: "bank", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD"}, nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(&domain.Journal{JournalID: "payment-journal"}, nil).Once()
+	suite.mockBillRepo.On("SaveBillPayment", ctx, mock.Anything).Return(errors.New("db down")).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "payment-journal", suite.userID).Return(&domain.Journal{}, nil).Once()
+
+	_, err := suite.service.RecordBillPayment(ctx, suite.workplaceID, bill.BillID, dto.BillPaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(110), Date: time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.Error(err)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+}
+
+func (suite *BillServiceTestSuite) TestScheduleBillPayment_RejectsAmountAboveUnscheduled() {
+	ctx := context.Background()
+	bill := suite.postedBill(billPayment("50", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	bill.ScheduledPayments = []domain.ScheduledBillPayment{
+		{ScheduledPaymentID: uuid.NewString(), BillID: bill.BillID, PaymentAccountID: "bank",
+			ScheduledDate: time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC), Amount: decimal.NewFromInt(40)},
+	}
+	suite.mockBillRepo.On("FindBillByID", ctx, bill.BillID).Return(bill, nil).Once()
+
+	_, err := suite.service.ScheduleBillPayment(ctx, suite.workplaceID, bill.BillID, dto.BillPaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(30), Date: time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockBillRepo.AssertNotCalled(suite.T(), "SaveScheduledBillPayment", mock.Anything, mock.Anything)
+}
+
+func (suite *BillServiceTestSuite) TestPostDueBillPayments_CapsAtUnpaidAndSkipsPaidBills() {
+	ctx := context.Background()
+	asOf := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	// 100 of 110 was paid by hand after scheduling 50, so only the remaining 10 is posted
+	partial := suite.postedBill(billPayment("100", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	paid := suite.postedBill(billPayment("110", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	due := []domain.ScheduledBillPayment{
+		{ScheduledPaymentID: "scheduled-partial", BillID: partial.BillID, PaymentAccountID: "bank", ScheduledDate: time.Date(2025, 4, 25, 0, 0, 0, 0, time.UTC), Amount: decimal.NewFromInt(50)},
+		{ScheduledPaymentID: "scheduled-paid", BillID: paid.BillID, PaymentAccountID: "bank", ScheduledDate: time.Date(2025, 4, 28, 0, 0, 0, 0, time.UTC), Amount: decimal.NewFromInt(20)},
+	}
+	suite.mockBillRepo.On("ListDueScheduledBillPayments", ctx, suite.workplaceID, asOf).Return(due, nil).Once()
+	suite.mockBillRepo.On("FindBillByID", ctx, partial.BillID).Return(partial, nil).Once()
+	suite.mockBillRepo.On("FindBillByID", ctx, paid.BillID).Return(paid, nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return r.Date.Equal(due[0].ScheduledDate) &&
+			hasLines(r, expectedLine{"payable", "10", domain.Debit}, expectedLine{"bank", "10", domain.Credit})
+	}), suite.userID).Return(&domain.Journal{JournalID: "scheduled-journal"}, nil).Once()
+	suite.mockBillRepo.On("SaveBillPayment", ctx, mock.MatchedBy(func(p domain.BillPayment) bool {
+		return p.ScheduledPaymentID == "scheduled-partial" && p.Amount.Equal(decimal.NewFromInt(10))
+	})).Return(nil).Once()
+
+	payments, err := suite.service.PostDueBillPayments(ctx, suite.workplaceID, asOf, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(payments, 1)
+	suite.Equal("scheduled-journal", payments[0].JournalID)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockBillRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BillServiceTestSuite) TestVoidBill_DraftConflicts() {
+	ctx := context.Background()
+	bill := suite.postedBill()
+	bill.PostJournalID, bill.PostJournalStatus = "", ""
+	suite.mockBillRepo.On("FindBillByID", ctx, bill.BillID).Return(bill, nil).Once()
+
+	_, err := suite.service.VoidBill(ctx, suite.workplaceID, bill.BillID, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "ReverseJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *BillServiceTestSuite) TestAgedPayables_FiltersByAccountHierarchy() {
+	ctx := context.Background()
+	// Due 30 April and unpaid: 61 days past due on 30 June
+	trade := suite.postedBill()
+	trade.PayableAccountID = "trade-payables"
+	other := suite.postedBill()
+	other.PayableAccountID = "accrued"
+	suite.mockBillRepo.On("ListBills", ctx, suite.workplaceID, "").Return([]domain.Bill{*trade, *other}, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"trade-payables": {AccountID: "trade-payables", ParentAccountID: "payables"},
+		"accrued":        {AccountID: "accrued"},
+		"payables":       {AccountID: "payables"},
+	}, nil)
+	suite.mockPayeeRepo.On("ListPayees", ctx, suite.workplaceID).Return([]domain.Payee{suite.vendor}, nil).Once()
+
+	report, err := suite.service.AgedPayables(ctx, suite.workplaceID, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), "payables", suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Items, 1)
+	suite.Equal(trade.BillID, report.Items[0].DocumentID)
+	suite.Equal("Office Supplies Co", report.Items[0].CounterpartyName)
+	suite.Require().Len(report.Totals, 1)
+	suite.True(report.Totals[0].Buckets.Days61To90.Equal(decimal.NewFromInt(110)))
+}
@@ -0,0 +1,557 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// budgetService implements the BudgetSvcFacade interface
+type budgetService struct {
+	BaseService
+	budgetRepo    portsrepo.BudgetRepositoryFacade
+	accountRepo   portsrepo.AccountReader
+	reportingRepo portsrepo.ReportingRepository
+}
+
+// BudgetServiceOption is a functional option for configuring the budget service
+type BudgetServiceOption func(*budgetService)
+
+// WithBudgetWorkplaceAuthorizer adds workplace authorizer dependency
+func WithBudgetWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) BudgetServiceOption {
+	return func(s *budgetService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewBudgetService creates a new budget service. Actuals are read through the reporting repository.
+func NewBudgetService(budgetRepo portsrepo.BudgetRepositoryFacade, accountRepo portsrepo.AccountReader, reportingRepo portsrepo.ReportingRepository, options ...BudgetServiceOption) portssvc.BudgetSvcFacade {
+	svc := &budgetService{
+		budgetRepo:    budgetRepo,
+		accountRepo:   accountRepo,
+		reportingRepo: reportingRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure budgetService implements the BudgetSvcFacade interface
+var _ portssvc.BudgetSvcFacade = (*budgetService)(nil)
+
+func (s *budgetService) CreateBudget(ctx context.Context, workplaceID string, req dto.CreateBudgetRequest, userID string) (*domain.Budget, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create budget",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("%w: budget name cannot be empty", apperrors.ErrValidation)
+	}
+	if req.PeriodType != domain.BudgetMonthly && req.PeriodType != domain.BudgetYearly {
+		return nil, fmt.Errorf("%w: invalid period type %q, use MONTHLY or YEARLY", apperrors.ErrValidation, req.PeriodType)
+	}
+
+	now := time.Now()
+	budget := domain.Budget{
+		BudgetID:    uuid.NewString(),
+		WorkplaceID: workplaceID,
+		Name:        req.Name,
+		Description: req.Description,
+		PeriodType:  req.PeriodType,
+		Year:        req.Year,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+
+	lines, err := s.toBudgetLines(ctx, budget, req.Lines)
+	if err != nil {
+		s.LogError(ctx, err, "Invalid budget lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	budget.Lines = lines
+
+	if err := s.budgetRepo.SaveBudget(ctx, budget); err != nil {
+		s.LogError(ctx, err, "Failed to save budget",
+			slog.String("budget_id", budget.BudgetID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Budget created successfully",
+		slog.String("budget_id", budget.BudgetID),
+		slog.String("workplace_id", workplaceID),
+		slog.Int("line_count", len(budget.Lines)))
+	return s.budgetRepo.FindBudgetByID(ctx, budget.BudgetID)
+}
+
+// findBudget loads a budget and verifies that it belongs to the workplace
+func (s *budgetService) findBudget(ctx context.Context, workplaceID string, budgetID string) (*domain.Budget, error) {
+	budget, err := s.budgetRepo.FindBudgetByID(ctx, budgetID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find budget by ID",
+			slog.String("budget_id", budgetID))
+		return nil, fmt.Errorf("failed to find budget: %w", err)
+	}
+	if budget.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Budget found but belongs to different workplace",
+			slog.String("budget_id", budgetID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return budget, nil
+}
+
+func (s *budgetService) GetBudgetByID(ctx context.Context, workplaceID string, budgetID string, userID string) (*domain.Budget, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view budget",
+			slog.String("workplace_id", workplaceID),
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+	return s.findBudget(ctx, workplaceID, budgetID)
+}
+
+func (s *budgetService) ListBudgets(ctx context.Context, workplaceID string, userID string) ([]domain.Budget, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list budgets",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	budgets, err := s.budgetRepo.ListBudgets(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list budgets",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to list budgets for workplace %s: %w", workplaceID, err)
+	}
+	return budgets, nil
+}
+
+func (s *budgetService) UpdateBudget(ctx context.Context, workplaceID string, budgetID string, req dto.UpdateBudgetRequest, userID string) (*domain.Budget, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update budget",
+			slog.String("workplace_id", workplaceID),
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	budget, err := s.findBudget(ctx, workplaceID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, fmt.Errorf("%w: budget name cannot be empty", apperrors.ErrValidation)
+		}
+		budget.Name = *req.Name
+	}
+	if req.Description != nil {
+		budget.Description = *req.Description
+	}
+	budget.LastUpdatedAt = time.Now()
+	budget.LastUpdatedBy = userID
+
+	if err := s.budgetRepo.UpdateBudget(ctx, *budget); err != nil {
+		s.LogError(ctx, err, "Failed to update budget",
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Budget updated successfully",
+		slog.String("budget_id", budgetID),
+		slog.String("workplace_id", workplaceID))
+	return budget, nil
+}
+
+func (s *budgetService) SetBudgetLines(ctx context.Context, workplaceID string, budgetID string, req dto.SetBudgetLinesRequest, userID string) (*domain.Budget, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to change budget lines",
+			slog.String("workplace_id", workplaceID),
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	budget, err := s.findBudget(ctx, workplaceID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := s.toBudgetLines(ctx, *budget, req.Lines)
+	if err != nil {
+		s.LogError(ctx, err, "Invalid budget lines",
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	if err := s.budgetRepo.SetBudgetLines(ctx, budgetID, lines, userID, time.Now()); err != nil {
+		s.LogError(ctx, err, "Failed to save budget lines",
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Budget lines saved successfully",
+		slog.String("budget_id", budgetID),
+		slog.Int("line_count", len(lines)))
+	return s.budgetRepo.FindBudgetByID(ctx, budgetID)
+}
+
+func (s *budgetService) CopyActualsToBudget(ctx context.Context, workplaceID string, budgetID string, req dto.CopyBudgetActualsRequest, userID string) (*domain.Budget, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to change budget lines",
+			slog.String("workplace_id", workplaceID),
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	budget, err := s.findBudget(ctx, workplaceID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	hundred := decimal.NewFromInt(100)
+	if req.AdjustmentPercent.LessThanOrEqual(hundred.Neg()) {
+		return nil, fmt.Errorf("%w: adjustment percent must be greater than -100", apperrors.ErrValidation)
+	}
+	factor := decimal.NewFromInt(1).Add(req.AdjustmentPercent.Div(hundred))
+
+	sourceYear := req.SourceYear
+	if sourceYear == 0 {
+		sourceYear = budget.Year - 1
+	}
+	source := domain.Budget{Year: sourceYear, PeriodType: budget.PeriodType}
+	sourcePeriods := source.Periods()
+	targetPeriods := budget.Periods()
+
+	amounts, err := s.reportingRepo.GetProfitAndLossByPeriods(ctx, workplaceID, sourcePeriods, nil)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve actuals to copy into budget",
+			slog.String("budget_id", budgetID),
+			slog.Int("source_year", sourceYear))
+		return nil, fmt.Errorf("failed to retrieve actuals for %d: %w", sourceYear, err)
+	}
+
+	lines := make([]domain.BudgetLine, 0, len(amounts))
+	for _, amount := range amounts {
+		// Net refunds or reversals leave nothing to budget for
+		if !amount.NetAmount.IsPositive() || amount.PeriodIndex < 0 || amount.PeriodIndex >= len(targetPeriods) {
+			continue
+		}
+		lines = append(lines, domain.BudgetLine{
+			AccountID:   amount.AccountID,
+			PeriodStart: targetPeriods[amount.PeriodIndex].From,
+			Amount:      amount.NetAmount.Mul(factor),
+		})
+	}
+
+	if err := s.budgetRepo.SetBudgetLines(ctx, budgetID, lines, userID, time.Now()); err != nil {
+		s.LogError(ctx, err, "Failed to save copied budget lines",
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Actuals copied into budget successfully",
+		slog.String("budget_id", budgetID),
+		slog.Int("source_year", sourceYear),
+		slog.String("adjustment_percent", req.AdjustmentPercent.String()),
+		slog.Int("line_count", len(lines)))
+	return s.budgetRepo.FindBudgetByID(ctx, budgetID)
+}
+
+func (s *budgetService) DeleteBudget(ctx context.Context, workplaceID string, budgetID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete budget",
+			slog.String("workplace_id", workplaceID),
+			slog.String("budget_id", budgetID))
+		return err
+	}
+
+	if _, err := s.findBudget(ctx, workplaceID, budgetID); err != nil {
+		return err
+	}
+
+	if err := s.budgetRepo.DeleteBudget(ctx, budgetID); err != nil {
+		s.LogError(ctx, err, "Failed to delete budget",
+			slog.String("budget_id", budgetID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Budget deleted successfully",
+		slog.String("budget_id", budgetID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+// toBudgetLines validates requested lines against the budget and the workplace's accounts
+// and converts them to domain lines
+func (s *budgetService) toBudgetLines(ctx context.Context, budget domain.Budget, reqLines []dto.BudgetLineRequest) ([]domain.BudgetLine, error) {
+	if len(reqLines) == 0 {
+		return []domain.BudgetLine{}, nil
+	}
+
+	accountIDs := make([]string, 0, len(reqLines))
+	for _, line := range reqLines {
+		accountIDs = append(accountIDs, line.AccountID)
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, accountIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load budget accounts: %w", err)
+	}
+
+	lines := make([]domain.BudgetLine, 0, len(reqLines))
+	seen := make(map[string]bool, len(reqLines))
+	for _, line := range reqLines {
+		account, ok := accounts[line.AccountID]
+		if !ok || account.WorkplaceID != budget.WorkplaceID {
+			return nil, fmt.Errorf("%w: account %s not found in workplace", apperrors.ErrValidation, line.AccountID)
+		}
+		if account.AccountType != domain.Revenue && account.AccountType != domain.Expense {
+			return nil, fmt.Errorf("%w: account %s is a %s account; only REVENUE and EXPENSE accounts can be budgeted",
+				apperrors.ErrValidation, account.Name, account.AccountType)
+		}
+		if line.Amount.IsNegative() {
+			return nil, fmt.Errorf("%w: budget amount for account %s cannot be negative", apperrors.ErrValidation, account.Name)
+		}
+
+		month := time.January
+		switch budget.PeriodType {
+		case domain.BudgetMonthly:
+			if line.Month < 1 || line.Month > 12 {
+				return nil, fmt.Errorf("%w: month (1-12) is required for lines of a monthly budget", apperrors.ErrValidation)
+			}
+			month = time.Month(line.Month)
+		default:
+			if line.Month != 0 {
+				return nil, fmt.Errorf("%w: month cannot be set on lines of a yearly budget", apperrors.ErrValidation)
+			}
+		}
+
+		periodStart := time.Date(budget.Year, month, 1, 0, 0, 0, 0, time.UTC)
+		key := line.AccountID + "|" + periodStart.Format("2006-01")
+		if seen[key] {
+			return nil, fmt.Errorf("%w: account %s is budgeted more than once for %s",
+				apperrors.ErrValidation, account.Name, periodStart.Format("2006-01"))
+		}
+		seen[key] = true
+
+		lines = append(lines, domain.BudgetLine{
+			AccountID:   line.AccountID,
+			PeriodStart: periodStart,
+			Amount:      line.Amount,
+		})
+	}
+	return lines, nil
+}
+
+// loadAccountTree loads the given accounts and all of their ancestors
+func loadAccountTree(ctx context.Context, accountRepo portsrepo.AccountReader, accountIDs []string) (map[string]domain.Account, error) {
+	tree := make(map[string]domain.Account)
+	pending := accountIDs
+	for len(pending) > 0 {
+		found, err := accountRepo.FindAccountsByIDs(ctx, pending)
+		if err != nil {
+			return nil, err
+		}
+		pending = nil
+		for id, account := range found {
+			tree[id] = account
+			if parentID := account.ParentAccountID; parentID != "" {
+				if _, loaded := tree[parentID]; !loaded {
+					if _, queued := found[parentID]; !queued {
+						pending = append(pending, parentID)
+					}
+				}
+			}
+		}
+	}
+	return tree, nil
+}
+
+// percentUsed returns actual relative to budgeted as a percentage, or nil when nothing is budgeted
+func percentUsed(budgeted, actual decimal.Decimal) *decimal.Decimal {
+	if budgeted.IsZero() {
+		return nil
+	}
+	percent := actual.Div(budgeted).Mul(decimal.NewFromInt(100)).Round(2)
+	return &percent
+}
+
+// newBudgetVsActualLine completes a line with its remaining amount and percentage used
+func newBudgetVsActualLine(account domain.Account, budgeted, actual decimal.Decimal) domain.BudgetVsActualLine {
+	return domain.BudgetVsActualLine{
+		AccountID:   account.AccountID,
+		Name:        account.Name,
+		AccountType: account.AccountType,
+		Budgeted:    budgeted,
+		Actual:      actual,
+		Remaining:   budgeted.Sub(actual),
+		PercentUsed: percentUsed(budgeted, actual),
+	}
+}
+
+// BudgetVsActual compares a budget with the actual revenue and expenses of one month or the whole budget year.
+// Actuals of an account count towards the lines of the account and of every budgeted ancestor. Section totals
+// only include the top-most budgeted accounts so that budgets nested under a budgeted parent are not counted twice.
+func (s *budgetService) BudgetVsActual(ctx context.Context, workplaceID string, budgetID string, month int, userID string) (*domain.BudgetVsActualReport, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view budget report",
+			slog.String("workplace_id", workplaceID),
+			slog.String("budget_id", budgetID))
+		return nil, err
+	}
+
+	budget, err := s.findBudget(ctx, workplaceID, budgetID)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := budget.Periods()
+	period := domain.ReportPeriod{Label: fmt.Sprintf("%d", budget.Year), From: periods[0].From, To: periods[len(periods)-1].To}
+	if month != 0 {
+		if budget.PeriodType != domain.BudgetMonthly {
+			return nil, fmt.Errorf("%w: a month can only be selected for monthly budgets", apperrors.ErrValidation)
+		}
+		if month < 1 || month > 12 {
+			return nil, fmt.Errorf("%w: month must be between 1 and 12", apperrors.ErrValidation)
+		}
+		period = periods[month-1]
+	}
+
+	budgeted := make(map[string]decimal.Decimal)
+	for _, line := range budget.Lines {
+		if line.PeriodStart.Before(period.From) || line.PeriodStart.After(period.To) {
+			continue
+		}
+		budgeted[line.AccountID] = budgeted[line.AccountID].Add(line.Amount)
+	}
+	// Accounts with lines in other periods still appear, with nothing budgeted for this period
+	for _, line := range budget.Lines {
+		if _, ok := budgeted[line.AccountID]; !ok {
+			budgeted[line.AccountID] = decimal.Zero
+		}
+	}
+
+	revenue, expenses, err := s.reportingRepo.GetProfitAndLossData(ctx, workplaceID, period.From, period.To, nil)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve actuals for budget report",
+			slog.String("budget_id", budgetID))
+		return nil, fmt.Errorf("failed to retrieve profit and loss data: %w", err)
+	}
+
+	accountIDs := make([]string, 0, len(budgeted)+len(revenue)+len(expenses))
+	for id := range budgeted {
+		accountIDs = append(accountIDs, id)
+	}
+	for _, amount := range append(append([]domain.AccountAmount{}, revenue...), expenses...) {
+		accountIDs = append(accountIDs, amount.AccountID)
+	}
+	tree, err := loadAccountTree(ctx, s.accountRepo, accountIDs)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load account hierarchy for budget report",
+			slog.String("budget_id", budgetID))
+		return nil, fmt.Errorf("failed to load account hierarchy: %w", err)
+	}
+
+	// budgetedAncestors lists the account itself and its ancestors that carry a budget line, nearest first
+	budgetedAncestors := func(accountID string) []string {
+		var result []string
+		visited := make(map[string]bool)
+		for id := accountID; id != "" && !visited[id]; id = tree[id].ParentAccountID {
+			visited[id] = true
+			if _, ok := budgeted[id]; ok {
+				result = append(result, id)
+			}
+		}
+		return result
+	}
+
+	actuals := make(map[string]decimal.Decimal)
+	unbudgeted := map[domain.AccountType]decimal.Decimal{domain.Revenue: decimal.Zero, domain.Expense: decimal.Zero}
+	addActuals := func(accountType domain.AccountType, amounts []domain.AccountAmount) {
+		for _, amount := range amounts {
+			owners := budgetedAncestors(amount.AccountID)
+			if len(owners) == 0 {
+				unbudgeted[accountType] = unbudgeted[accountType].Add(amount.NetAmount)
+				continue
+			}
+			for _, owner := range owners {
+				actuals[owner] = actuals[owner].Add(amount.NetAmount)
+			}
+		}
+	}
+	addActuals(domain.Revenue, revenue)
+	addActuals(domain.Expense, expenses)
+
+	sections := map[domain.AccountType]*domain.BudgetVsActualSection{
+		domain.Revenue: {Lines: []domain.BudgetVsActualLine{}},
+		domain.Expense: {Lines: []domain.BudgetVsActualLine{}},
+	}
+	totals := map[domain.AccountType][2]decimal.Decimal{}
+	for id, amount := range budgeted {
+		account, ok := tree[id]
+		if !ok {
+			continue
+		}
+		section, ok := sections[account.AccountType]
+		if !ok {
+			continue
+		}
+		actual := actuals[id]
+		section.Lines = append(section.Lines, newBudgetVsActualLine(account, amount, actual))
+
+		// Only top-most budgeted accounts count towards the totals
+		if len(budgetedAncestors(account.ParentAccountID)) == 0 {
+			t := totals[account.AccountType]
+			totals[account.AccountType] = [2]decimal.Decimal{t[0].Add(amount), t[1].Add(actual)}
+		}
+	}
+
+	for accountType, section := range sections {
+		sort.Slice(section.Lines, func(i, j int) bool {
+			if section.Lines[i].Name != section.Lines[j].Name {
+				return section.Lines[i].Name < section.Lines[j].Name
+			}
+			return section.Lines[i].AccountID < section.Lines[j].AccountID
+		})
+		t := totals[accountType]
+		section.Total = newBudgetVsActualLine(domain.Account{Name: "Total"}, t[0], t[1])
+		section.Unbudgeted = unbudgeted[accountType]
+	}
+
+	report := &domain.BudgetVsActualReport{
+		BudgetID: budget.BudgetID,
+		Name:     budget.Name,
+		Period:   period,
+		Revenue:  *sections[domain.Revenue],
+		Expenses: *sections[domain.Expense],
+	}
+
+	s.LogInfo(ctx, "Budget vs actual report generated successfully",
+		slog.String("budget_id", budgetID),
+		slog.String("period", period.Label),
+		slog.Int("revenue_lines", len(report.Revenue.Lines)),
+		slog.Int("expense_lines", len(report.Expenses.Lines)))
+	return report, nil
+}
@@ -0,0 +1,236 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock BudgetRepository ---
+type MockBudgetRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.BudgetRepositoryFacade = (*MockBudgetRepository)(nil)
+
+func (m *MockBudgetRepository) SaveBudget(ctx context.Context, budget domain.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) UpdateBudget(ctx context.Context, budget domain.Budget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) SetBudgetLines(ctx context.Context, budgetID string, lines []domain.BudgetLine, userID string, now time.Time) error {
+	args := m.Called(ctx, budgetID, lines, userID, now)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) DeleteBudget(ctx context.Context, budgetID string) error {
+	args := m.Called(ctx, budgetID)
+	return args.Error(0)
+}
+
+func (m *MockBudgetRepository) FindBudgetByID(ctx context.Context, budgetID string) (*domain.Budget, error) {
+	args := m.Called(ctx, budgetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Budget), args.Error(1)
+}
+
+func (m *MockBudgetRepository) ListBudgets(ctx context.Context, workplaceID string) ([]domain.Budget, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Budget), args.Error(1)
+}
+
+// --- Test Suite Setup ---
+type BudgetServiceTestSuite struct {
+	suite.Suite
+	mockBudgetRepo    *MockBudgetRepository
+	mockAccountRepo   *MockAccountRepositoryFacade
+	mockReportingRepo *MockReportingRepository
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.BudgetSvcFacade
+	workplaceID       string
+	userID            string
+	accounts          map[string]domain.Account
+}
+
+func (suite *BudgetServiceTestSuite) SetupTest() {
+	suite.mockBudgetRepo = new(MockBudgetRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockReportingRepo = new(MockReportingRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewBudgetService(suite.mockBudgetRepo, suite.mockAccountRepo, suite.mockReportingRepo,
+		services.WithBudgetWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.accounts = map[string]domain.Account{
+		"food":      {AccountID: "food", WorkplaceID: suite.workplaceID, Name: "Food", AccountType: domain.Expense},
+		"groceries": {AccountID: "groceries", WorkplaceID: suite.workplaceID, Name: "Groceries", AccountType: domain.Expense, ParentAccountID: "food"},
+		"dining":    {AccountID: "dining", WorkplaceID: suite.workplaceID, Name: "Dining", AccountType: domain.Expense, ParentAccountID: "food"},
+		"rent":      {AccountID: "rent", WorkplaceID: suite.workplaceID, Name: "Rent", AccountType: domain.Expense},
+		"salary":    {AccountID: "salary", WorkplaceID: suite.workplaceID, Name: "Salary", AccountType: domain.Revenue},
+		"bank":      {AccountID: "bank", WorkplaceID: suite.workplaceID, Name: "Bank", AccountType: domain.Asset},
+	}
+}
+
+func (suite *BudgetServiceTestSuite) monthly(lines ...domain.BudgetLine) *domain.Budget {
+	return &domain.Budget{
+		BudgetID:    "budget",
+		WorkplaceID: suite.workplaceID,
+		Name:        "Household",
+		PeriodType:  domain.BudgetMonthly,
+		Year:        2025,
+		Lines:       lines,
+	}
+}
+
+func budgetMonth(m time.Month) time.Time {
+	return time.Date(2025, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// --- Test Cases ---
+
+func (suite *BudgetServiceTestSuite) TestBudgetVsActual_RollsUpToParents() {
+	ctx := context.Background()
+	budget := suite.monthly(
+		domain.BudgetLine{AccountID: "food", PeriodStart: budgetMonth(time.March), Amount: decimal.NewFromInt(500)},
+		domain.BudgetLine{AccountID: "groceries", PeriodStart: budgetMonth(time.March), Amount: decimal.NewFromInt(300)},
+		domain.BudgetLine{AccountID: "salary", PeriodStart: budgetMonth(time.March), Amount: decimal.NewFromInt(2000)},
+		domain.BudgetLine{AccountID: "salary", PeriodStart: budgetMonth(time.April), Amount: decimal.NewFromInt(2000)},
+	)
+	from := budgetMonth(time.March)
+	to := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	revenue := []domain.AccountAmount{{AccountID: "salary", NetAmount: decimal.NewFromInt(2100)}}
+	expenses := []domain.AccountAmount{
+		{AccountID: "groceries", NetAmount: decimal.NewFromInt(240)},
+		{AccountID: "dining", NetAmount: decimal.NewFromInt(360)},
+		{AccountID: "rent", NetAmount: decimal.NewFromInt(900)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockBudgetRepo.On("FindBudgetByID", ctx, "budget").Return(budget, nil).Once()
+	suite.mockReportingRepo.On("GetProfitAndLossData", ctx, suite.workplaceID, from, to, mock.Anything).Return(revenue, expenses, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(suite.accounts, nil)
+
+	report, err := suite.service.BudgetVsActual(ctx, suite.workplaceID, "budget", 3, suite.userID)
+	suite.Require().NoError(err)
+	suite.Equal("2025-03", report.Period.Label)
+
+	suite.Require().Len(report.Expenses.Lines, 2)
+	food := report.Expenses.Lines[0]
+	suite.Equal("Food", food.Name)
+	suite.True(food.Actual.Equal(decimal.NewFromInt(600)))
+	suite.True(food.Remaining.Equal(decimal.NewFromInt(-100)))
+	suite.Require().NotNil(food.PercentUsed)
+	suite.True(food.PercentUsed.Equal(decimal.NewFromInt(120)))
+	groceries := report.Expenses.Lines[1]
+	suite.True(groceries.Actual.Equal(decimal.NewFromInt(240)))
+	suite.True(groceries.PercentUsed.Equal(decimal.NewFromInt(80)))
+
+	// Nested budgets are not counted twice, rent has no budget line
+	suite.True(report.Expenses.Total.Budgeted.Equal(decimal.NewFromInt(500)))
+	suite.True(report.Expenses.Total.Actual.Equal(decimal.NewFromInt(600)))
+	suite.True(report.Expenses.Unbudgeted.Equal(decimal.NewFromInt(900)))
+
+	suite.Require().Len(report.Revenue.Lines, 1)
+	suite.True(report.Revenue.Total.Budgeted.Equal(decimal.NewFromInt(2000)))
+	suite.True(report.Revenue.Total.Remaining.Equal(decimal.NewFromInt(-100)))
+}
+
+func (suite *BudgetServiceTestSuite) TestBudgetVsActual_MonthOnYearlyBudget() {
+	ctx := context.Background()
+	budget := suite.monthly()
+	budget.PeriodType = domain.BudgetYearly
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockBudgetRepo.On("FindBudgetByID", ctx, "budget").Return(budget, nil).Once()
+
+	report, err := suite.service.BudgetVsActual(ctx, suite.workplaceID, "budget", 3, suite.userID)
+	suite.Require().ErrorIs(err, apperrors.ErrValidation)
+	suite.Nil(report)
+}
+
+func (suite *BudgetServiceTestSuite) TestCopyActualsToBudget_AppliesAdjustment() {
+	ctx := context.Background()
+	budget := suite.monthly()
+	amounts := []domain.PeriodAccountAmount{
+		{PeriodIndex: 0, AccountID: "rent", NetAmount: decimal.NewFromInt(900)},
+		{PeriodIndex: 11, AccountID: "salary", NetAmount: decimal.NewFromInt(2000)},
+		{PeriodIndex: 5, AccountID: "dining", NetAmount: decimal.NewFromInt(-20)},
+	}
+	expected := []domain.BudgetLine{
+		{AccountID: "rent", PeriodStart: budgetMonth(time.January), Amount: decimal.NewFromInt(945)},
+		{AccountID: "salary", PeriodStart: budgetMonth(time.December), Amount: decimal.NewFromInt(2100)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockBudgetRepo.On("FindBudgetByID", ctx, "budget").Return(budget, nil).Twice()
+	suite.mockReportingRepo.On("GetProfitAndLossByPeriods", ctx, suite.workplaceID, mock.MatchedBy(func(periods []domain.ReportPeriod) bool {
+		return len(periods) == 12 && periods[0].From.Year() == 2024
+	}), mock.Anything).Return(amounts, nil).Once()
+	suite.mockBudgetRepo.On("SetBudgetLines", ctx, "budget", mock.MatchedBy(func(lines []domain.BudgetLine) bool {
+		if len(lines) != len(expected) {
+			return false
+		}
+		for i := range lines {
+			if lines[i].AccountID != expected[i].AccountID || !lines[i].PeriodStart.Equal(expected[i].PeriodStart) || !lines[i].Amount.Equal(expected[i].Amount) {
+				return false
+			}
+		}
+		return true
+	}), suite.userID, mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	_, err := suite.service.CopyActualsToBudget(ctx, suite.workplaceID, "budget",
+		dto.CopyBudgetActualsRequest{AdjustmentPercent: decimal.NewFromInt(5)}, suite.userID)
+	suite.Require().NoError(err)
+	suite.mockBudgetRepo.AssertExpectations(suite.T())
+	suite.mockReportingRepo.AssertExpectations(suite.T())
+}
+
+func (suite *BudgetServiceTestSuite) TestSetBudgetLines_Validation() {
+	ctx := context.Background()
+	budget := suite.monthly()
+
+	cases := map[string]dto.BudgetLineRequest{
+		"balance sheet account": {AccountID: "bank", Month: 1, Amount: decimal.NewFromInt(10)},
+		"missing month":         {AccountID: "rent", Amount: decimal.NewFromInt(10)},
+		"negative amount":       {AccountID: "rent", Month: 1, Amount: decimal.NewFromInt(-10)},
+		"unknown account":       {AccountID: "other", Month: 1, Amount: decimal.NewFromInt(10)},
+	}
+	for name, line := range cases {
+		suite.Run(name, func() {
+			suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+			suite.mockBudgetRepo.On("FindBudgetByID", ctx, "budget").Return(budget, nil).Once()
+			suite.mockAccountRepo.On("FindAccountsByIDs", ctx, []string{line.AccountID}).Return(suite.accounts, nil).Once()
+
+			_, err := suite.service.SetBudgetLines(ctx, suite.workplaceID, "budget",
+				dto.SetBudgetLinesRequest{Lines: []dto.BudgetLineRequest{line}}, suite.userID)
+			suite.Require().ErrorIs(err, apperrors.ErrValidation)
+		})
+	}
+	suite.mockBudgetRepo.AssertNotCalled(suite.T(), "SetBudgetLines", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBudgetService(t *testing.T) {
+	suite.Run(t, new(BudgetServiceTestSuite))
+}
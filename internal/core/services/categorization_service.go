@@ -0,0 +1,512 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultRulePriority is the priority of rules created without one
+	defaultRulePriority = 100
+	// suggestionLookbackMonths limits rule suggestions to recent manual categorizations
+	suggestionLookbackMonths = 12
+	// minSuggestionOccurrences is the number of identical manual categorizations needed to suggest a rule
+	minSuggestionOccurrences = 2
+)
+
+// categorizationService implements the CategorizationSvcFacade interface
+type categorizationService struct {
+	BaseService
+	ruleRepo      portsrepo.CategorizationRuleRepositoryFacade
+	accountRepo   portsrepo.AccountReader
+	statementRepo portsrepo.BankStatementRepositoryFacade
+}
+
+// CategorizationServiceOption is a functional option for configuring the categorization service
+type CategorizationServiceOption func(*categorizationService)
+
+// WithCategorizationWorkplaceAuthorizer adds workplace authorizer dependency
+func WithCategorizationWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) CategorizationServiceOption {
+	return func(s *categorizationService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewCategorizationService creates a new categorization service. Rules only fill in the categorization of
+// staged lines; journals are still created when a line is posted.
+func NewCategorizationService(ruleRepo portsrepo.CategorizationRuleRepositoryFacade, accountRepo portsrepo.AccountReader, statementRepo portsrepo.BankStatementRepositoryFacade, options ...CategorizationServiceOption) portssvc.CategorizationSvcFacade {
+	svc := &categorizationService{
+		ruleRepo:      ruleRepo,
+		accountRepo:   accountRepo,
+		statementRepo: statementRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure categorizationService implements the CategorizationSvcFacade interface
+var _ portssvc.CategorizationSvcFacade = (*categorizationService)(nil)
+
+// compiledRule is a categorization rule with its patterns compiled
+type compiledRule struct {
+	rule        domain.CategorizationRule
+	payee       *regexp.Regexp
+	description *regexp.Regexp
+}
+
+// compilePattern compiles a case-insensitive rule pattern; an empty pattern yields nil
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("(?i)" + pattern)
+}
+
+// compileCategorizationRules compiles the active rules, keeping their order. Rules whose patterns no
+// longer compile are skipped rather than blocking every other rule.
+func compileCategorizationRules(rules []domain.CategorizationRule) []compiledRule {
+	compiled := make([]compiledRule, 0, len(rules))
+	for _, rule := range rules {
+		if !rule.IsActive {
+			continue
+		}
+		payee, err := compilePattern(rule.PayeePattern)
+		if err != nil {
+			continue
+		}
+		description, err := compilePattern(rule.DescriptionPattern)
+		if err != nil {
+			continue
+		}
+		compiled = append(compiled, compiledRule{rule: rule, payee: payee, description: description})
+	}
+	return compiled
+}
+
+// matches reports whether every condition of the rule holds for the line
+func (r compiledRule) matches(line domain.BankStatementLine) bool {
+	if r.rule.SourceAccountID != "" && r.rule.SourceAccountID != line.AccountID {
+		return false
+	}
+	switch r.rule.Sign {
+	case domain.RuleSignIn:
+		if !line.Amount.IsPositive() {
+			return false
+		}
+	case domain.RuleSignOut:
+		if !line.Amount.IsNegative() {
+			return false
+		}
+	}
+	amount := line.Amount.Abs()
+	if r.rule.MinAmount != nil && amount.LessThan(*r.rule.MinAmount) {
+		return false
+	}
+	if r.rule.MaxAmount != nil && amount.GreaterThan(*r.rule.MaxAmount) {
+		return false
+	}
+	if r.payee != nil && !r.payee.MatchString(line.Payee) {
+		return false
+	}
+	if r.description != nil && !r.description.MatchString(line.Memo) {
+		return false
+	}
+	return true
+}
+
+// findMatchingRule returns the first rule matching the line, or nil
+func findMatchingRule(rules []compiledRule, line domain.BankStatementLine) *domain.CategorizationRule {
+	for i := range rules {
+		if rules[i].matches(line) {
+			return &rules[i].rule
+		}
+	}
+	return nil
+}
+
+// applyCategorizationRules sets the categorization of each line from the first matching rule, clearing
+// categorizations left by rules that no longer match. It returns the matches and the indexes of the
+// lines whose categorization changed.
+func applyCategorizationRules(rules []compiledRule, lines []domain.BankStatementLine) ([]domain.CategorizationMatch, []int) {
+	matches := []domain.CategorizationMatch{}
+	changed := []int{}
+	for i := range lines {
+		line := &lines[i]
+		before := [4]string{line.CounterAccountID, line.Description, line.Notes, line.MatchedRuleID}
+
+		rule := findMatchingRule(rules, *line)
+		if rule != nil {
+			line.CounterAccountID = rule.CounterAccountID
+			line.Description = rule.JournalDescription
+			line.Notes = rule.Notes
+			line.MatchedRuleID = rule.RuleID
+			matches = append(matches, domain.CategorizationMatch{
+				LineID:           line.LineID,
+				BankReference:    line.BankReference,
+				RuleID:           rule.RuleID,
+				RuleName:         rule.Name,
+				CounterAccountID: rule.CounterAccountID,
+			})
+		} else if line.MatchedRuleID != "" {
+			line.CounterAccountID = ""
+			line.Description = ""
+			line.Notes = ""
+			line.MatchedRuleID = ""
+		}
+
+		if before != [4]string{line.CounterAccountID, line.Description, line.Notes, line.MatchedRuleID} {
+			changed = append(changed, i)
+		}
+	}
+	return matches, changed
+}
+
+// categorizeNewStatementLines applies the workplace's rules to lines about to be staged. It is used by the
+// statement and CSV imports; a nil repository leaves the lines uncategorized.
+func categorizeNewStatementLines(ctx context.Context, ruleRepo portsrepo.CategorizationRuleReader, workplaceID string, lines []domain.BankStatementLine) error {
+	if ruleRepo == nil || len(lines) == 0 {
+		return nil
+	}
+	rules, err := ruleRepo.ListCategorizationRules(ctx, workplaceID)
+	if err != nil {
+		return fmt.Errorf("failed to load categorization rules: %w", err)
+	}
+	applyCategorizationRules(compileCategorizationRules(rules), lines)
+	return nil
+}
+
+// findRule loads a categorization rule and verifies that it belongs to the workplace
+func (s *categorizationService) findRule(ctx context.Context, workplaceID string, ruleID string) (*domain.CategorizationRule, error) {
+	rule, err := s.ruleRepo.FindCategorizationRuleByID(ctx, ruleID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find categorization rule by ID",
+			slog.String("rule_id", ruleID))
+		return nil, fmt.Errorf("failed to find categorization rule: %w", err)
+	}
+	if rule.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Categorization rule found but belongs to different workplace",
+			slog.String("rule_id", ruleID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return rule, nil
+}
+
+// buildRule validates a rule request and applies it to the rule
+func (s *categorizationService) buildRule(ctx context.Context, workplaceID string, rule *domain.CategorizationRule, req dto.CategorizationRuleRequest) error {
+	rule.Name = strings.TrimSpace(req.Name)
+	if rule.Name == "" {
+		return fmt.Errorf("%w: rule name cannot be empty", apperrors.ErrValidation)
+	}
+	rule.Priority = defaultRulePriority
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	rule.IsActive = req.IsActive == nil || *req.IsActive
+	rule.PayeePattern = strings.TrimSpace(req.PayeePattern)
+	rule.DescriptionPattern = strings.TrimSpace(req.DescriptionPattern)
+	rule.MinAmount = req.MinAmount
+	rule.MaxAmount = req.MaxAmount
+	rule.SourceAccountID = req.SourceAccountID
+	rule.Sign = req.Sign
+	if rule.Sign == "" {
+		rule.Sign = domain.RuleSignAny
+	}
+	rule.CounterAccountID = req.CounterAccountID
+	rule.JournalDescription = strings.TrimSpace(req.JournalDescription)
+	rule.Notes = strings.TrimSpace(req.Notes)
+
+	if _, err := compilePattern(rule.PayeePattern); err != nil {
+		return fmt.Errorf("%w: invalid payee pattern: %v", apperrors.ErrValidation, err)
+	}
+	if _, err := compilePattern(rule.DescriptionPattern); err != nil {
+		return fmt.Errorf("%w: invalid description pattern: %v", apperrors.ErrValidation, err)
+	}
+	if (rule.MinAmount != nil && rule.MinAmount.IsNegative()) || (rule.MaxAmount != nil && rule.MaxAmount.IsNegative()) {
+		return fmt.Errorf("%w: amount bounds apply to the absolute amount and cannot be negative", apperrors.ErrValidation)
+	}
+	if rule.MinAmount != nil && rule.MaxAmount != nil && rule.MinAmount.GreaterThan(*rule.MaxAmount) {
+		return fmt.Errorf("%w: minimum amount cannot exceed maximum amount", apperrors.ErrValidation)
+	}
+
+	if rule.SourceAccountID != "" {
+		if _, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, rule.SourceAccountID); err != nil {
+			return err
+		}
+		if rule.SourceAccountID == rule.CounterAccountID {
+			return fmt.Errorf("%w: counter-account must differ from the source account", apperrors.ErrValidation)
+		}
+	}
+	counter, err := s.accountRepo.FindAccountByID(ctx, rule.CounterAccountID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return fmt.Errorf("%w: counter-account %s not found in workplace", apperrors.ErrValidation, rule.CounterAccountID)
+		}
+		return fmt.Errorf("failed to load counter-account: %w", err)
+	}
+	if counter.WorkplaceID != workplaceID {
+		return fmt.Errorf("%w: counter-account %s not found in workplace", apperrors.ErrValidation, rule.CounterAccountID)
+	}
+	if !counter.IsActive {
+		return fmt.Errorf("%w: counter-account %s is inactive", apperrors.ErrValidation, counter.Name)
+	}
+	return nil
+}
+
+func (s *categorizationService) CreateCategorizationRule(ctx context.Context, workplaceID string, req dto.CategorizationRuleRequest, userID string) (*domain.CategorizationRule, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create categorization rule",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	rule := domain.CategorizationRule{
+		RuleID:      uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.buildRule(ctx, workplaceID, &rule, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.SaveCategorizationRule(ctx, rule); err != nil {
+		s.LogError(ctx, err, "Failed to save categorization rule",
+			slog.String("rule_id", rule.RuleID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Categorization rule created successfully",
+		slog.String("rule_id", rule.RuleID),
+		slog.String("workplace_id", workplaceID))
+	return &rule, nil
+}
+
+func (s *categorizationService) ListCategorizationRules(ctx context.Context, workplaceID string, userID string) ([]domain.CategorizationRule, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list categorization rules",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.ListCategorizationRules(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list categorization rules",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (s *categorizationService) GetCategorizationRule(ctx context.Context, workplaceID string, ruleID string, userID string) (*domain.CategorizationRule, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view categorization rule",
+			slog.String("workplace_id", workplaceID),
+			slog.String("rule_id", ruleID))
+		return nil, err
+	}
+	return s.findRule(ctx, workplaceID, ruleID)
+}
+
+func (s *categorizationService) UpdateCategorizationRule(ctx context.Context, workplaceID string, ruleID string, req dto.CategorizationRuleRequest, userID string) (*domain.CategorizationRule, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update categorization rule",
+			slog.String("workplace_id", workplaceID),
+			slog.String("rule_id", ruleID))
+		return nil, err
+	}
+
+	rule, err := s.findRule(ctx, workplaceID, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.buildRule(ctx, workplaceID, rule, req); err != nil {
+		return nil, err
+	}
+	rule.LastUpdatedAt = time.Now()
+	rule.LastUpdatedBy = userID
+
+	if err := s.ruleRepo.UpdateCategorizationRule(ctx, *rule); err != nil {
+		s.LogError(ctx, err, "Failed to update categorization rule",
+			slog.String("rule_id", ruleID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Categorization rule updated successfully",
+		slog.String("rule_id", ruleID))
+	return rule, nil
+}
+
+func (s *categorizationService) DeleteCategorizationRule(ctx context.Context, workplaceID string, ruleID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete categorization rule",
+			slog.String("workplace_id", workplaceID),
+			slog.String("rule_id", ruleID))
+		return err
+	}
+
+	if _, err := s.findRule(ctx, workplaceID, ruleID); err != nil {
+		return err
+	}
+	if err := s.ruleRepo.DeleteCategorizationRule(ctx, ruleID); err != nil {
+		s.LogError(ctx, err, "Failed to delete categorization rule",
+			slog.String("rule_id", ruleID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Categorization rule deleted successfully",
+		slog.String("rule_id", ruleID))
+	return nil
+}
+
+func (s *categorizationService) ApplyCategorizationRules(ctx context.Context, workplaceID string, req dto.ApplyCategorizationRulesRequest, userID string) (*domain.CategorizationResult, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to apply categorization rules",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if req.AccountID != "" {
+		if _, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, req.AccountID); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := s.ruleRepo.ListCategorizationRules(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list categorization rules",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	lines, err := s.statementRepo.ListPendingStatementLines(ctx, workplaceID, req.AccountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list pending bank statement lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	matches, changed := applyCategorizationRules(compileCategorizationRules(rules), lines)
+	if len(changed) > 0 {
+		now := time.Now()
+		updates := make([]domain.BankStatementLine, 0, len(changed))
+		for _, i := range changed {
+			lines[i].LastUpdatedAt = now
+			lines[i].LastUpdatedBy = userID
+			updates = append(updates, lines[i])
+		}
+		if err := s.statementRepo.SaveStatementLineCategorizations(ctx, updates); err != nil {
+			s.LogError(ctx, err, "Failed to save bank statement line categorizations",
+				slog.String("workplace_id", workplaceID))
+			return nil, err
+		}
+	}
+
+	s.LogInfo(ctx, "Categorization rules applied",
+		slog.String("workplace_id", workplaceID),
+		slog.Int("examined_lines", len(lines)),
+		slog.Int("matched_lines", len(matches)),
+		slog.Int("changed_lines", len(changed)))
+	return &domain.CategorizationResult{ExaminedLines: len(lines), Matches: matches}, nil
+}
+
+func (s *categorizationService) SuggestCategorizationRules(ctx context.Context, workplaceID string, userID string) ([]domain.CategorizationRuleSuggestion, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view categorization rule suggestions",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.ListCategorizationRules(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list categorization rules",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	since := time.Now().AddDate(0, -suggestionLookbackMonths, 0)
+	lines, err := s.statementRepo.ListManuallyPostedLines(ctx, workplaceID, since)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list manually posted bank statement lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	// Group by payee and counter-account, skipping lines an existing rule would already categorize
+	type suggestionKey struct{ payee, counterAccountID string }
+	compiled := compileCategorizationRules(rules)
+	groups := map[suggestionKey][]domain.BankStatementLine{}
+	keys := []suggestionKey{}
+	for _, line := range lines {
+		payee := domain.NormalizePayee(line.Payee)
+		if payee == "" || findMatchingRule(compiled, line) != nil {
+			continue
+		}
+		key := suggestionKey{payee: payee, counterAccountID: line.CounterAccountID}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], line)
+	}
+
+	suggestions := []domain.CategorizationRuleSuggestion{}
+	for _, key := range keys {
+		group := groups[key]
+		if len(group) < minSuggestionOccurrences {
+			continue
+		}
+		example := strings.TrimSpace(group[0].Payee)
+		suggestion := domain.CategorizationRuleSuggestion{
+			Name:             example,
+			PayeePattern:     `^\s*` + strings.Join(strings.Fields(regexp.QuoteMeta(key.payee)), `\s+`) + `\s*$`,
+			ExamplePayee:     example,
+			SourceAccountID:  group[0].AccountID,
+			Sign:             domain.RuleSignIn,
+			CounterAccountID: key.counterAccountID,
+			Occurrences:      len(group),
+		}
+		if group[0].Amount.IsNegative() {
+			suggestion.Sign = domain.RuleSignOut
+		}
+		// Only narrow the rule to an account or direction when every example agrees
+		for _, line := range group[1:] {
+			if line.AccountID != suggestion.SourceAccountID {
+				suggestion.SourceAccountID = ""
+			}
+			if line.Amount.IsNegative() != (suggestion.Sign == domain.RuleSignOut) {
+				suggestion.Sign = domain.RuleSignAny
+			}
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Occurrences > suggestions[j].Occurrences
+	})
+	return suggestions, nil
+}
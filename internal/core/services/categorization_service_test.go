@@ -0,0 +1,236 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock CategorizationRuleRepository ---
+type MockCategorizationRuleRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.CategorizationRuleRepositoryFacade = (*MockCategorizationRuleRepository)(nil)
+
+func (m *MockCategorizationRuleRepository) FindCategorizationRuleByID(ctx context.Context, ruleID string) (*domain.CategorizationRule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CategorizationRule), args.Error(1)
+}
+
+func (m *MockCategorizationRuleRepository) ListCategorizationRules(ctx context.Context, workplaceID string) ([]domain.CategorizationRule, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CategorizationRule), args.Error(1)
+}
+
+func (m *MockCategorizationRuleRepository) SaveCategorizationRule(ctx context.Context, rule domain.CategorizationRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockCategorizationRuleRepository) UpdateCategorizationRule(ctx context.Context, rule domain.CategorizationRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockCategorizationRuleRepository) DeleteCategorizationRule(ctx context.Context, ruleID string) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type CategorizationServiceTestSuite struct {
+	suite.Suite
+	mockRuleRepo      *MockCategorizationRuleRepository
+	mockAccountRepo   *MockAccountRepositoryFacade
+	mockStatementRepo *MockBankStatementRepository
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.CategorizationSvcFacade
+	workplaceID       string
+	userID            string
+	bankAccountID     string
+	groceriesID       string
+	salaryID          string
+}
+
+func (suite *CategorizationServiceTestSuite) SetupTest() {
+	suite.mockRuleRepo = new(MockCategorizationRuleRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockStatementRepo = new(MockBankStatementRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewCategorizationService(suite.mockRuleRepo, suite.mockAccountRepo, suite.mockStatementRepo,
+		services.WithCategorizationWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccountID = uuid.NewString()
+	suite.groceriesID = uuid.NewString()
+	suite.salaryID = uuid.NewString()
+}
+
+func TestCategorizationService(t *testing.T) {
+	suite.Run(t, new(CategorizationServiceTestSuite))
+}
+
+// rules returns the workplace rules in priority order: an outflow grocery rule, an inactive rule and an inflow salary rule
+func (suite *CategorizationServiceTestSuite) rules() []domain.CategorizationRule {
+	maxAmount := decimal.RequireFromString("200")
+	return []domain.CategorizationRule{
+		{RuleID: "groceries", Name: "Groceries", Priority: 10, IsActive: true, PayeePattern: "supermarket|bakery",
+			MaxAmount: &maxAmount, Sign: domain.RuleSignOut, CounterAccountID: suite.groceriesID, JournalDescription: "Groceries"},
+		{RuleID: "inactive", Name: "Inactive", Priority: 20, IsActive: false, Sign: domain.RuleSignAny, CounterAccountID: uuid.NewString()},
+		{RuleID: "salary", Name: "Salary", Priority: 50, IsActive: true, DescriptionPattern: `^salary`,
+			SourceAccountID: suite.bankAccountID, Sign: domain.RuleSignIn, CounterAccountID: suite.salaryID},
+	}
+}
+
+func (suite *CategorizationServiceTestSuite) TestApplyCategorizationRules_FirstMatchingRuleWins() {
+	ctx := context.Background()
+	lines := []domain.BankStatementLine{
+		{LineID: "l1", AccountID: suite.bankAccountID, BankReference: "R1", Amount: decimal.RequireFromString("-45.10"), Payee: "City SUPERMARKET"},
+		{LineID: "l2", AccountID: suite.bankAccountID, BankReference: "R2", Amount: decimal.RequireFromString("-450"), Payee: "Supermarket"},
+		{LineID: "l3", AccountID: suite.bankAccountID, BankReference: "R3", Amount: decimal.RequireFromString("3000"), Memo: "SALARY MARCH"},
+		{LineID: "l4", AccountID: suite.bankAccountID, BankReference: "R4", Amount: decimal.RequireFromString("-12"), Payee: "Cinema",
+			CounterAccountID: suite.groceriesID, MatchedRuleID: "groceries"},
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockRuleRepo.On("ListCategorizationRules", ctx, suite.workplaceID).Return(suite.rules(), nil).Once()
+	suite.mockStatementRepo.On("ListPendingStatementLines", ctx, suite.workplaceID, "").Return(lines, nil).Once()
+	var saved []domain.BankStatementLine
+	suite.mockStatementRepo.On("SaveStatementLineCategorizations", ctx, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).([]domain.BankStatementLine) }).
+		Return(nil).Once()
+
+	result, err := suite.service.ApplyCategorizationRules(ctx, suite.workplaceID, dto.ApplyCategorizationRulesRequest{}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(4, result.ExaminedLines)
+	suite.Require().Len(result.Matches, 2)
+	suite.Equal("l1", result.Matches[0].LineID)
+	suite.Equal("groceries", result.Matches[0].RuleID)
+	suite.Equal("l3", result.Matches[1].LineID)
+	suite.Equal("salary", result.Matches[1].RuleID)
+
+	// l2 exceeds the grocery amount range and was never categorized, so it is not saved; l4 loses its stale match
+	suite.Require().Len(saved, 3)
+	suite.Equal(suite.groceriesID, saved[0].CounterAccountID)
+	suite.Equal("Groceries", saved[0].Description)
+	suite.Equal(suite.salaryID, saved[1].CounterAccountID)
+	suite.Equal("l4", saved[2].LineID)
+	suite.Empty(saved[2].CounterAccountID)
+	suite.Empty(saved[2].MatchedRuleID)
+	suite.mockStatementRepo.AssertExpectations(suite.T())
+}
+
+func (suite *CategorizationServiceTestSuite) TestCreateCategorizationRule_RejectsInvalidPattern() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+
+	_, err := suite.service.CreateCategorizationRule(ctx, suite.workplaceID, dto.CategorizationRuleRequest{
+		Name:             "Broken",
+		PayeePattern:     "coffee(",
+		CounterAccountID: suite.groceriesID,
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockRuleRepo.AssertNotCalled(suite.T(), "SaveCategorizationRule", mock.Anything, mock.Anything)
+}
+
+func (suite *CategorizationServiceTestSuite) TestCreateCategorizationRule_AppliesDefaults() {
+	ctx := context.Background()
+	counter := &domain.Account{AccountID: suite.groceriesID, WorkplaceID: suite.workplaceID, Name: "Groceries", AccountType: domain.Expense, IsActive: true}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.groceriesID).Return(counter, nil).Once()
+	suite.mockRuleRepo.On("SaveCategorizationRule", ctx, mock.AnythingOfType("domain.CategorizationRule")).Return(nil).Once()
+
+	rule, err := suite.service.CreateCategorizationRule(ctx, suite.workplaceID, dto.CategorizationRuleRequest{
+		Name:             " Bakery ",
+		PayeePattern:     "bakery",
+		CounterAccountID: suite.groceriesID,
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("Bakery", rule.Name)
+	suite.Equal(100, rule.Priority)
+	suite.True(rule.IsActive)
+	suite.Equal(domain.RuleSignAny, rule.Sign)
+	suite.mockRuleRepo.AssertExpectations(suite.T())
+}
+
+func (suite *CategorizationServiceTestSuite) TestSuggestCategorizationRules_GroupsRepeatedManualChoices() {
+	ctx := context.Background()
+	otherAccountID := uuid.NewString()
+	posted := func(payee string, accountID string, amount string, counterID string) domain.BankStatementLine {
+		return domain.BankStatementLine{LineID: uuid.NewString(), AccountID: accountID, Payee: payee,
+			Amount: decimal.RequireFromString(amount), Status: domain.StatementLinePosted, CounterAccountID: counterID}
+	}
+	lines := []domain.BankStatementLine{
+		posted("Corner  Coffee", suite.bankAccountID, "-3.20", suite.groceriesID),
+		posted("corner coffee", otherAccountID, "-4.10", suite.groceriesID),
+		posted("CORNER COFFEE", suite.bankAccountID, "-2.90", suite.groceriesID),
+		posted("Gym", suite.bankAccountID, "-30", suite.groceriesID),         // Only once
+		posted("Bakery", suite.bankAccountID, "-5", suite.salaryID),          // Already covered by the grocery rule
+		posted("Bakery", suite.bankAccountID, "-6", suite.salaryID),          // Already covered by the grocery rule
+		posted("Corner coffee", suite.bankAccountID, "-3", uuid.NewString()), // Different counter-account
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockRuleRepo.On("ListCategorizationRules", ctx, suite.workplaceID).Return(suite.rules(), nil).Once()
+	suite.mockStatementRepo.On("ListManuallyPostedLines", ctx, suite.workplaceID, mock.AnythingOfType("time.Time")).Return(lines, nil).Once()
+
+	suggestions, err := suite.service.SuggestCategorizationRules(ctx, suite.workplaceID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(suggestions, 1)
+	suggestion := suggestions[0]
+	suite.Equal(3, suggestion.Occurrences)
+	suite.Equal(suite.groceriesID, suggestion.CounterAccountID)
+	suite.Equal(domain.RuleSignOut, suggestion.Sign)
+	suite.Empty(suggestion.SourceAccountID, "examples come from two accounts")
+	suite.Regexp("(?i)"+suggestion.PayeePattern, "Corner   COFFEE")
+	suite.NotRegexp("(?i)"+suggestion.PayeePattern, "Corner coffee shop")
+}
+
+func (suite *CategorizationServiceTestSuite) TestImportStatement_CategorizesNewLines() {
+	ctx := context.Background()
+	bankAccount := domain.Account{AccountID: suite.bankAccountID, WorkplaceID: suite.workplaceID, Name: "Checking",
+		AccountType: domain.Asset, CurrencyCode: "EUR", IsActive: true}
+	statementSvc := services.NewBankStatementService(suite.mockStatementRepo, suite.mockAccountRepo, new(MockJournalWriterSvc),
+		services.WithBankStatementWorkplaceAuthorizer(suite.mockWorkplaceSvc),
+		services.WithBankStatementCategorizationRules(suite.mockRuleRepo))
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.bankAccountID).Return(&bankAccount, nil).Once()
+	suite.mockRuleRepo.On("ListCategorizationRules", ctx, suite.workplaceID).Return([]domain.CategorizationRule{
+		{RuleID: "coffee", Name: "Coffee", IsActive: true, DescriptionPattern: "coffee", Sign: domain.RuleSignOut, CounterAccountID: suite.groceriesID},
+	}, nil).Once()
+	var staged []domain.BankStatementLine
+	suite.mockStatementRepo.On("SaveStatementImport", ctx, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { staged = args.Get(2).([]domain.BankStatementLine) }).
+		Return(&domain.BankStatementImport{ImportID: "import-1"}, nil).Once()
+
+	_, err := statementSvc.ImportStatement(ctx, suite.workplaceID, dto.ImportBankStatementRequest{
+		AccountID: suite.bankAccountID,
+		Format:    "mt940",
+		Data:      []byte(testStatementMT940),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(staged, 2)
+	suite.Equal("coffee", staged[0].MatchedRuleID)
+	suite.Equal(suite.groceriesID, staged[0].CounterAccountID)
+	suite.Empty(staged[1].MatchedRuleID)
+}
@@ -0,0 +1,540 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// consolidationService implements the ConsolidationSvcFacade interface
+type consolidationService struct {
+	BaseService
+	consolidationRepo portsrepo.ConsolidationRepositoryFacade
+	accountRepo       portsrepo.AccountReader
+	currencyRepo      portsrepo.CurrencyReader
+	exchangeRateRepo  portsrepo.ExchangeRateReader
+}
+
+// ConsolidationServiceOption is a functional option for configuring the consolidation service
+type ConsolidationServiceOption func(*consolidationService)
+
+// WithConsolidationWorkplaceAuthorizer adds workplace authorizer dependency
+func WithConsolidationWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) ConsolidationServiceOption {
+	return func(s *consolidationService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewConsolidationService creates a new consolidation service
+func NewConsolidationService(
+	consolidationRepo portsrepo.ConsolidationRepositoryFacade,
+	accountRepo portsrepo.AccountReader,
+	currencyRepo portsrepo.CurrencyReader,
+	exchangeRateRepo portsrepo.ExchangeRateReader,
+	options ...ConsolidationServiceOption,
+) portssvc.ConsolidationSvcFacade {
+	svc := &consolidationService{
+		consolidationRepo: consolidationRepo,
+		accountRepo:       accountRepo,
+		currencyRepo:      currencyRepo,
+		exchangeRateRepo:  exchangeRateRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure consolidationService implements the ConsolidationSvcFacade interface
+var _ portssvc.ConsolidationSvcFacade = (*consolidationService)(nil)
+
+// findGroup loads a group and verifies that it is managed from the workplace
+func (s *consolidationService) findGroup(ctx context.Context, workplaceID string, groupID string) (*domain.ConsolidationGroup, error) {
+	group, err := s.consolidationRepo.FindConsolidationGroupByID(ctx, groupID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find consolidation group by ID",
+			slog.String("group_id", groupID))
+		return nil, fmt.Errorf("failed to find consolidation group: %w", err)
+	}
+	if group.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Consolidation group found but belongs to different workplace",
+			slog.String("group_id", groupID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return group, nil
+}
+
+// authorizeMembers checks that the user can read the books of every member of a group
+func (s *consolidationService) authorizeMembers(ctx context.Context, memberIDs []string, userID string) error {
+	for _, memberID := range memberIDs {
+		if err := s.AuthorizeUser(ctx, userID, memberID, domain.RoleReadOnly); err != nil {
+			s.LogError(ctx, err, "User not authorized to read member workplace of consolidation group",
+				slog.String("user_id", userID),
+				slog.String("member_workplace_id", memberID))
+			return err
+		}
+	}
+	return nil
+}
+
+// buildGroup validates a group request and applies it to the group. The user must be able to read every member,
+// and accounts with settings must belong to a member.
+func (s *consolidationService) buildGroup(ctx context.Context, group *domain.ConsolidationGroup, req dto.ConsolidationGroupRequest, userID string) error {
+	group.Name = strings.TrimSpace(req.Name)
+	if group.Name == "" {
+		return fmt.Errorf("%w: group name cannot be empty", apperrors.ErrValidation)
+	}
+	group.Description = strings.TrimSpace(req.Description)
+	group.CurrencyCode = strings.ToUpper(req.CurrencyCode)
+	if _, err := s.currencyRepo.FindCurrencyByCode(ctx, group.CurrencyCode); err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return fmt.Errorf("%w: currency %s not found", apperrors.ErrValidation, group.CurrencyCode)
+		}
+		return err
+	}
+
+	members := make(map[string]bool, len(req.MemberIDs))
+	for _, memberID := range req.MemberIDs {
+		if members[memberID] {
+			return fmt.Errorf("%w: workplace %s is listed more than once", apperrors.ErrValidation, memberID)
+		}
+		members[memberID] = true
+	}
+	if err := s.authorizeMembers(ctx, req.MemberIDs, userID); err != nil {
+		return err
+	}
+	group.MemberIDs = req.MemberIDs
+
+	group.Accounts = make([]domain.ConsolidationAccountMapping, 0, len(req.Accounts))
+	if len(req.Accounts) == 0 {
+		return nil
+	}
+	accountIDs := make([]string, 0, len(req.Accounts))
+	for _, accountReq := range req.Accounts {
+		accountIDs = append(accountIDs, accountReq.AccountID)
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, uniqueStrings(accountIDs))
+	if err != nil {
+		return fmt.Errorf("failed to find accounts: %w", err)
+	}
+	seen := make(map[string]bool, len(req.Accounts))
+	for _, accountReq := range req.Accounts {
+		if seen[accountReq.AccountID] {
+			return fmt.Errorf("%w: account %s is listed more than once", apperrors.ErrValidation, accountReq.AccountID)
+		}
+		seen[accountReq.AccountID] = true
+		account, found := accounts[accountReq.AccountID]
+		if !found || !members[account.WorkplaceID] {
+			return fmt.Errorf("%w: account %s does not belong to a member of the group", apperrors.ErrValidation, accountReq.AccountID)
+		}
+		group.Accounts = append(group.Accounts, domain.ConsolidationAccountMapping{
+			AccountID:    accountReq.AccountID,
+			GroupCode:    strings.ToUpper(strings.TrimSpace(accountReq.GroupCode)),
+			Intercompany: accountReq.Intercompany,
+		})
+	}
+	return nil
+}
+
+// saveGroupError translates repository errors raised when saving a group
+func saveGroupError(err error) error {
+	if errors.Is(err, apperrors.ErrDuplicate) {
+		return fmt.Errorf("%w: a consolidation group with this name already exists", apperrors.ErrConflict)
+	}
+	return err
+}
+
+func (s *consolidationService) CreateConsolidationGroup(ctx context.Context, workplaceID string, req dto.ConsolidationGroupRequest, userID string) (*domain.ConsolidationGroup, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create consolidation group",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	group := domain.ConsolidationGroup{
+		GroupID:     uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.buildGroup(ctx, &group, req, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.consolidationRepo.SaveConsolidationGroup(ctx, group); err != nil {
+		s.LogError(ctx, err, "Failed to save consolidation group",
+			slog.String("group_id", group.GroupID),
+			slog.String("workplace_id", workplaceID))
+		return nil, saveGroupError(err)
+	}
+
+	s.LogInfo(ctx, "Consolidation group created successfully",
+		slog.String("group_id", group.GroupID),
+		slog.String("workplace_id", workplaceID),
+		slog.Int("members", len(group.MemberIDs)))
+	return &group, nil
+}
+
+func (s *consolidationService) ListConsolidationGroups(ctx context.Context, workplaceID string, userID string) ([]domain.ConsolidationGroup, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list consolidation groups",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	groups, err := s.consolidationRepo.ListConsolidationGroups(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list consolidation groups",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (s *consolidationService) GetConsolidationGroup(ctx context.Context, workplaceID string, groupID string, userID string) (*domain.ConsolidationGroup, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view consolidation group",
+			slog.String("workplace_id", workplaceID),
+			slog.String("group_id", groupID))
+		return nil, err
+	}
+	return s.findGroup(ctx, workplaceID, groupID)
+}
+
+func (s *consolidationService) UpdateConsolidationGroup(ctx context.Context, workplaceID string, groupID string, req dto.ConsolidationGroupRequest, userID string) (*domain.ConsolidationGroup, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update consolidation group",
+			slog.String("workplace_id", workplaceID),
+			slog.String("group_id", groupID))
+		return nil, err
+	}
+
+	group, err := s.findGroup(ctx, workplaceID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.buildGroup(ctx, group, req, userID); err != nil {
+		return nil, err
+	}
+	group.LastUpdatedAt = time.Now()
+	group.LastUpdatedBy = userID
+
+	if err := s.consolidationRepo.UpdateConsolidationGroup(ctx, *group); err != nil {
+		s.LogError(ctx, err, "Failed to update consolidation group",
+			slog.String("group_id", groupID))
+		return nil, saveGroupError(err)
+	}
+
+	s.LogInfo(ctx, "Consolidation group updated successfully",
+		slog.String("group_id", groupID),
+		slog.String("workplace_id", workplaceID))
+	return group, nil
+}
+
+func (s *consolidationService) DeleteConsolidationGroup(ctx context.Context, workplaceID string, groupID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete consolidation group",
+			slog.String("workplace_id", workplaceID),
+			slog.String("group_id", groupID))
+		return err
+	}
+
+	if _, err := s.findGroup(ctx, workplaceID, groupID); err != nil {
+		return err
+	}
+	if err := s.consolidationRepo.DeleteConsolidationGroup(ctx, groupID); err != nil {
+		s.LogError(ctx, err, "Failed to delete consolidation group",
+			slog.String("group_id", groupID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Consolidation group deleted successfully",
+		slog.String("group_id", groupID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+// reportGroup loads a group for a consolidated report after checking that the user can read the home workplace
+// and every member
+func (s *consolidationService) reportGroup(ctx context.Context, workplaceID string, groupID string, userID string) (*domain.ConsolidationGroup, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view consolidated report",
+			slog.String("workplace_id", workplaceID),
+			slog.String("group_id", groupID))
+		return nil, err
+	}
+	group, err := s.findGroup(ctx, workplaceID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeMembers(ctx, group.MemberIDs, userID); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// consolidate translates the balances of the members into the group currency and combines them into lines.
+// Accounts are matched on their group code, or on their CFID without one; other accounts get a line of their own.
+// Lines are debit-positive and ordered by code, with unmatched accounts last. Each currency is translated at its
+// latest exchange rate effective on or before date, and translated amounts are rounded to the precision of the
+// group currency.
+func (s *consolidationService) consolidate(ctx context.Context, group *domain.ConsolidationGroup, balances []domain.ConsolidationBalance, date time.Time) ([]domain.ConsolidatedLine, []domain.ConsolidationRate, error) {
+	precision := currencyPrecision(ctx, s.currencyRepo, group.CurrencyCode)
+
+	rates := []domain.ConsolidationRate{}
+	rateByCurrency := map[string]decimal.Decimal{group.CurrencyCode: decimal.NewFromInt(1)}
+	lines := []domain.ConsolidatedLine{}
+	lineByKey := make(map[string]int)
+	for _, balance := range balances {
+		rate, found := rateByCurrency[balance.CurrencyCode]
+		if !found {
+			exchangeRate, err := s.exchangeRateRepo.FindExchangeRateAsOf(ctx, balance.CurrencyCode, group.CurrencyCode, date)
+			if err != nil {
+				if errors.Is(err, apperrors.ErrNotFound) {
+					return nil, nil, fmt.Errorf("%w: no exchange rate from %s to %s on or before %s", apperrors.ErrValidation,
+						balance.CurrencyCode, group.CurrencyCode, date.Format("2006-01-02"))
+				}
+				return nil, nil, fmt.Errorf("failed to find exchange rate: %w", err)
+			}
+			rate = exchangeRate.Rate
+			rateByCurrency[balance.CurrencyCode] = rate
+			rates = append(rates, domain.ConsolidationRate{
+				FromCurrencyCode: balance.CurrencyCode,
+				ToCurrencyCode:   group.CurrencyCode,
+				Rate:             rate,
+				DateEffective:    exchangeRate.DateEffective,
+			})
+		}
+
+		mapping, _ := group.Mapping(balance.AccountID)
+		code := mapping.GroupCode
+		if code == "" {
+			code = balance.CFID
+		}
+		key := "code:" + code
+		if code == "" {
+			key = "account:" + balance.AccountID
+		}
+		i, found := lineByKey[key]
+		if !found {
+			i = len(lines)
+			lineByKey[key] = i
+			lines = append(lines, domain.ConsolidatedLine{
+				Code:        code,
+				Name:        balance.Name,
+				AccountType: balance.AccountType,
+				Entities:    []domain.ConsolidatedEntityAmount{},
+			})
+		}
+		line := &lines[i]
+		if line.AccountType != balance.AccountType {
+			return nil, nil, fmt.Errorf("%w: accounts matched on %s have different account types (%s and %s)",
+				apperrors.ErrValidation, code, line.AccountType, balance.AccountType)
+		}
+
+		translated := balance.NetAmount.Mul(rate).Round(precision)
+		line.Entities = append(line.Entities, domain.ConsolidatedEntityAmount{
+			WorkplaceID:  balance.WorkplaceID,
+			AccountID:    balance.AccountID,
+			Name:         balance.Name,
+			CurrencyCode: balance.CurrencyCode,
+			Amount:       balance.NetAmount,
+			Rate:         rate,
+			Translated:   translated,
+			Intercompany: mapping.Intercompany,
+		})
+		line.Combined = line.Combined.Add(translated)
+		if mapping.Intercompany {
+			line.Elimination = line.Elimination.Sub(translated)
+		}
+		line.Consolidated = line.Combined.Add(line.Elimination)
+	}
+
+	// Entities follow the order of the members of the group
+	position := make(map[string]int, len(group.MemberIDs))
+	for i, memberID := range group.MemberIDs {
+		position[memberID] = i
+	}
+	for i := range lines {
+		sort.SliceStable(lines[i].Entities, func(a, b int) bool {
+			return position[lines[i].Entities[a].WorkplaceID] < position[lines[i].Entities[b].WorkplaceID]
+		})
+	}
+	sort.SliceStable(lines, func(a, b int) bool {
+		if (lines[a].Code == "") != (lines[b].Code == "") {
+			return lines[b].Code == ""
+		}
+		if lines[a].Code != lines[b].Code {
+			return lines[a].Code < lines[b].Code
+		}
+		return lines[a].Name < lines[b].Name
+	})
+	return lines, rates, nil
+}
+
+// creditPositive turns a debit-positive line into a credit-positive one
+func creditPositive(line domain.ConsolidatedLine) domain.ConsolidatedLine {
+	entities := make([]domain.ConsolidatedEntityAmount, len(line.Entities))
+	for i, entity := range line.Entities {
+		entity.Amount = entity.Amount.Neg()
+		entity.Translated = entity.Translated.Neg()
+		entities[i] = entity
+	}
+	line.Entities = entities
+	line.Combined = line.Combined.Neg()
+	line.Elimination = line.Elimination.Neg()
+	line.Consolidated = line.Consolidated.Neg()
+	return line
+}
+
+// consolidatedBalances retrieves and consolidates the balances of the members of a group, translated at the rates
+// effective on the last day of the period
+func (s *consolidationService) consolidatedBalances(ctx context.Context, group *domain.ConsolidationGroup, from *time.Time, to time.Time) ([]domain.ConsolidatedLine, []domain.ConsolidationRate, error) {
+	balances, err := s.consolidationRepo.GetConsolidationBalances(ctx, group.MemberIDs, from, to)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve consolidation balances",
+			slog.String("group_id", group.GroupID))
+		return nil, nil, fmt.Errorf("failed to retrieve consolidation balances: %w", err)
+	}
+	return s.consolidate(ctx, group, balances, to)
+}
+
+func (s *consolidationService) ConsolidatedTrialBalance(ctx context.Context, workplaceID string, groupID string, asOf time.Time, userID string) (*domain.ConsolidatedTrialBalance, error) {
+	group, err := s.reportGroup(ctx, workplaceID, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	lines, rates, err := s.consolidatedBalances(ctx, group, nil, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ConsolidatedTrialBalance{
+		GroupID:      group.GroupID,
+		CurrencyCode: group.CurrencyCode,
+		AsOf:         asOf,
+		Lines:        lines,
+		Rates:        rates,
+	}
+	for _, line := range lines {
+		if line.Consolidated.IsPositive() {
+			report.TotalDebit = report.TotalDebit.Add(line.Consolidated)
+		} else {
+			report.TotalCredit = report.TotalCredit.Sub(line.Consolidated)
+		}
+		report.IntercompanyDifference = report.IntercompanyDifference.Sub(line.Elimination)
+	}
+
+	s.LogInfo(ctx, "Consolidated trial balance generated successfully",
+		slog.String("group_id", groupID),
+		slog.String("asOf", asOf.Format(time.RFC3339)),
+		slog.Int("line_count", len(lines)))
+	return report, nil
+}
+
+func (s *consolidationService) ConsolidatedProfitAndLoss(ctx context.Context, workplaceID string, groupID string, from, to time.Time, userID string) (*domain.ConsolidatedPAndLReport, error) {
+	group, err := s.reportGroup(ctx, workplaceID, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	lines, rates, err := s.consolidatedBalances(ctx, group, &from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ConsolidatedPAndLReport{
+		GroupID:      group.GroupID,
+		CurrencyCode: group.CurrencyCode,
+		From:         from,
+		To:           to,
+		Revenue:      []domain.ConsolidatedLine{},
+		Expenses:     []domain.ConsolidatedLine{},
+		Rates:        rates,
+	}
+	for _, line := range lines {
+		switch line.AccountType {
+		case domain.Revenue:
+			line = creditPositive(line)
+			report.Revenue = append(report.Revenue, line)
+			report.NetProfit = report.NetProfit.Add(line.Consolidated)
+			report.IntercompanyDifference = report.IntercompanyDifference.Sub(line.Elimination)
+		case domain.Expense:
+			report.Expenses = append(report.Expenses, line)
+			report.NetProfit = report.NetProfit.Sub(line.Consolidated)
+			report.IntercompanyDifference = report.IntercompanyDifference.Add(line.Elimination)
+		}
+	}
+
+	s.LogInfo(ctx, "Consolidated profit and loss report generated successfully",
+		slog.String("group_id", groupID),
+		slog.String("from", from.Format(time.RFC3339)),
+		slog.String("to", to.Format(time.RFC3339)))
+	return report, nil
+}
+
+func (s *consolidationService) ConsolidatedBalanceSheet(ctx context.Context, workplaceID string, groupID string, asOf time.Time, userID string) (*domain.ConsolidatedBalanceSheetReport, error) {
+	group, err := s.reportGroup(ctx, workplaceID, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	lines, rates, err := s.consolidatedBalances(ctx, group, nil, asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ConsolidatedBalanceSheetReport{
+		GroupID:      group.GroupID,
+		CurrencyCode: group.CurrencyCode,
+		AsOf:         asOf,
+		Assets:       []domain.ConsolidatedLine{},
+		Liabilities:  []domain.ConsolidatedLine{},
+		Equity:       []domain.ConsolidatedLine{},
+		Rates:        rates,
+	}
+	for _, line := range lines {
+		switch line.AccountType {
+		case domain.Asset:
+			report.Assets = append(report.Assets, line)
+			report.TotalAssets = report.TotalAssets.Add(line.Consolidated)
+		case domain.Liability:
+			report.Liabilities = append(report.Liabilities, creditPositive(line))
+			report.TotalLiabilities = report.TotalLiabilities.Sub(line.Consolidated)
+		case domain.Equity:
+			report.Equity = append(report.Equity, creditPositive(line))
+			report.TotalEquity = report.TotalEquity.Sub(line.Consolidated)
+		default:
+			continue
+		}
+		report.IntercompanyDifference = report.IntercompanyDifference.Sub(line.Elimination)
+	}
+
+	s.LogInfo(ctx, "Consolidated balance sheet generated successfully",
+		slog.String("group_id", groupID),
+		slog.String("asOf", asOf.Format(time.RFC3339)),
+		slog.Int("asset_lines", len(report.Assets)),
+		slog.Int("liability_lines", len(report.Liabilities)),
+		slog.Int("equity_lines", len(report.Equity)))
+	return report, nil
+}
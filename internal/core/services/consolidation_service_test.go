@@ -0,0 +1,364 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock ConsolidationRepository ---
+type MockConsolidationRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.ConsolidationRepositoryFacade = (*MockConsolidationRepository)(nil)
+
+func (m *MockConsolidationRepository) FindConsolidationGroupByID(ctx context.Context, groupID string) (*domain.ConsolidationGroup, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ConsolidationGroup), args.Error(1)
+}
+
+func (m *MockConsolidationRepository) ListConsolidationGroups(ctx context.Context, workplaceID string) ([]domain.ConsolidationGroup, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ConsolidationGroup), args.Error(1)
+}
+
+func (m *MockConsolidationRepository) GetConsolidationBalances(ctx context.Context, workplaceIDs []string, from *time.Time, to time.Time) ([]domain.ConsolidationBalance, error) {
+	args := m.Called(ctx, workplaceIDs, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.ConsolidationBalance), args.Error(1)
+}
+
+func (m *MockConsolidationRepository) SaveConsolidationGroup(ctx context.Context, group domain.ConsolidationGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockConsolidationRepository) UpdateConsolidationGroup(ctx context.Context, group domain.ConsolidationGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockConsolidationRepository) DeleteConsolidationGroup(ctx context.Context, groupID string) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type ConsolidationServiceTestSuite struct {
+	suite.Suite
+	mockConsolidationRepo *MockConsolidationRepository
+	mockAccountRepo       *MockAccountRepositoryFacade
+	mockCurrencyRepo      *MockCurrencyRepository
+	mockExchangeRateRepo  *MockExchangeRateRepository
+	mockWorkplaceSvc      *MockWorkplaceService
+	service               portssvc.ConsolidationSvcFacade
+	parentID              string
+	subsidiaryID          string
+	userID                string
+	asOf                  time.Time
+}
+
+func (suite *ConsolidationServiceTestSuite) SetupTest() {
+	suite.mockConsolidationRepo = new(MockConsolidationRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockExchangeRateRepo = new(MockExchangeRateRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewConsolidationService(suite.mockConsolidationRepo, suite.mockAccountRepo,
+		suite.mockCurrencyRepo, suite.mockExchangeRateRepo,
+		services.WithConsolidationWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.parentID = uuid.NewString()
+	suite.subsidiaryID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.asOf = time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+}
+
+func TestConsolidationService(t *testing.T) {
+	suite.Run(t, new(ConsolidationServiceTestSuite))
+}
+
+// group returns a USD group of the parent and its EUR subsidiary with the given account settings
+func (suite *ConsolidationServiceTestSuite) group(accounts ...domain.ConsolidationAccountMapping) *domain.ConsolidationGroup {
+	return &domain.ConsolidationGroup{
+		GroupID:      uuid.NewString(),
+		WorkplaceID:  suite.parentID,
+		Name:         "Group",
+		CurrencyCode: "USD",
+		MemberIDs:    []string{suite.parentID, suite.subsidiaryID},
+		Accounts:     accounts,
+	}
+}
+
+// expectReport sets up the authorization, group lookup and balances of a consolidated report
+func (suite *ConsolidationServiceTestSuite) expectReport(ctx context.Context, group *domain.ConsolidationGroup, from *time.Time, balances []domain.ConsolidationBalance) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleReadOnly).Return(nil)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleReadOnly).Return(nil)
+	suite.mockConsolidationRepo.On("FindConsolidationGroupByID", ctx, group.GroupID).Return(group, nil).Once()
+	suite.mockConsolidationRepo.On("GetConsolidationBalances", ctx, group.MemberIDs, from, suite.asOf).Return(balances, nil).Once()
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func (suite *ConsolidationServiceTestSuite) expectRate(ctx context.Context, from string, rate string) {
+	suite.mockExchangeRateRepo.On("FindExchangeRateAsOf", ctx, from, "USD", suite.asOf).Return(&domain.ExchangeRate{
+		FromCurrencyCode: from, ToCurrencyCode: "USD", Rate: decimal.RequireFromString(rate), DateEffective: suite.asOf,
+	}, nil).Once()
+}
+
+func balance(workplaceID, cfid, name string, accountType domain.AccountType, currencyCode string, amount int64) domain.ConsolidationBalance {
+	return domain.ConsolidationBalance{
+		WorkplaceID: workplaceID, AccountID: uuid.NewString(), CFID: cfid, Name: name,
+		AccountType: accountType, CurrencyCode: currencyCode, NetAmount: decimal.NewFromInt(amount),
+	}
+}
+
+// --- Test Cases ---
+
+func (suite *ConsolidationServiceTestSuite) TestCreateConsolidationGroup_NormalizesMappings() {
+	ctx := context.Background()
+	account := domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.subsidiaryID}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleMember).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, []string{account.AccountID}).
+		Return(map[string]domain.Account{account.AccountID: account}, nil).Once()
+	suite.mockConsolidationRepo.On("SaveConsolidationGroup", ctx, mock.MatchedBy(func(g domain.ConsolidationGroup) bool {
+		return g.Name == "Group" && g.CurrencyCode == "USD" && g.WorkplaceID == suite.parentID &&
+			len(g.Accounts) == 1 && g.Accounts[0].GroupCode == "IC-LOAN" && g.Accounts[0].Intercompany
+	})).Return(nil).Once()
+
+	group, err := suite.service.CreateConsolidationGroup(ctx, suite.parentID, dto.ConsolidationGroupRequest{
+		Name:         " Group ",
+		CurrencyCode: "usd",
+		MemberIDs:    []string{suite.parentID, suite.subsidiaryID},
+		Accounts:     []dto.ConsolidationAccountRequest{{AccountID: account.AccountID, GroupCode: " ic-loan ", Intercompany: true}},
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal([]string{suite.parentID, suite.subsidiaryID}, group.MemberIDs)
+	suite.mockConsolidationRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ConsolidationServiceTestSuite) TestCreateConsolidationGroup_ForbiddenOnMember() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleMember).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleReadOnly).Return(apperrors.ErrForbidden).Once()
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Once()
+
+	_, err := suite.service.CreateConsolidationGroup(ctx, suite.parentID, dto.ConsolidationGroupRequest{
+		Name: "Group", CurrencyCode: "USD", MemberIDs: []string{suite.parentID, suite.subsidiaryID},
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrForbidden)
+	suite.mockConsolidationRepo.AssertNotCalled(suite.T(), "SaveConsolidationGroup", mock.Anything, mock.Anything)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestCreateConsolidationGroup_AccountOutsideGroup() {
+	ctx := context.Background()
+	account := domain.Account{AccountID: uuid.NewString(), WorkplaceID: uuid.NewString()}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, mock.Anything, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, []string{account.AccountID}).
+		Return(map[string]domain.Account{account.AccountID: account}, nil).Once()
+
+	_, err := suite.service.CreateConsolidationGroup(ctx, suite.parentID, dto.ConsolidationGroupRequest{
+		Name: "Group", CurrencyCode: "USD", MemberIDs: []string{suite.parentID, suite.subsidiaryID},
+		Accounts: []dto.ConsolidationAccountRequest{{AccountID: account.AccountID}},
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestCreateConsolidationGroup_DuplicateName() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, mock.Anything, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Once()
+	suite.mockConsolidationRepo.On("SaveConsolidationGroup", ctx, mock.Anything).Return(apperrors.ErrDuplicate).Once()
+
+	_, err := suite.service.CreateConsolidationGroup(ctx, suite.parentID, dto.ConsolidationGroupRequest{
+		Name: "Group", CurrencyCode: "USD", MemberIDs: []string{suite.parentID},
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestGetConsolidationGroup_WrongWorkplace() {
+	ctx := context.Background()
+	group := suite.group()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockConsolidationRepo.On("FindConsolidationGroupByID", ctx, group.GroupID).Return(group, nil).Once()
+
+	_, err := suite.service.GetConsolidationGroup(ctx, suite.subsidiaryID, group.GroupID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrNotFound)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedTrialBalance_TranslatesAndEliminates() {
+	ctx := context.Background()
+	parentCash := balance(suite.parentID, "1000", "Cash", domain.Asset, "USD", 500)
+	subsidiaryCash := balance(suite.subsidiaryID, "1000", "Bank", domain.Asset, "EUR", 200)
+	loanToSubsidiary := balance(suite.parentID, "1500", "Loan to subsidiary", domain.Asset, "USD", 110)
+	loanFromParent := balance(suite.subsidiaryID, "2500", "Loan from parent", domain.Liability, "EUR", -100)
+	parentEquity := balance(suite.parentID, "3000", "Capital", domain.Equity, "USD", -610)
+	subsidiaryEquity := balance(suite.subsidiaryID, "3000", "Capital", domain.Equity, "EUR", -100)
+	group := suite.group(
+		domain.ConsolidationAccountMapping{AccountID: loanToSubsidiary.AccountID, Intercompany: true},
+		domain.ConsolidationAccountMapping{AccountID: loanFromParent.AccountID, Intercompany: true},
+	)
+	suite.expectReport(ctx, group, (*time.Time)(nil), []domain.ConsolidationBalance{
+		parentCash, loanToSubsidiary, parentEquity, subsidiaryCash, loanFromParent, subsidiaryEquity,
+	})
+	suite.expectRate(ctx, "EUR", "1.1")
+
+	report, err := suite.service.ConsolidatedTrialBalance(ctx, suite.parentID, group.GroupID, suite.asOf, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Lines, 4)
+	cash := report.Lines[0]
+	suite.Equal("1000", cash.Code)
+	suite.Require().Len(cash.Entities, 2)
+	suite.True(cash.Entities[1].Translated.Equal(decimal.NewFromInt(220)), "EUR 200 at 1.1")
+	suite.True(cash.Consolidated.Equal(decimal.NewFromInt(720)))
+	loan := report.Lines[1]
+	suite.True(loan.Combined.Equal(decimal.NewFromInt(110)))
+	suite.True(loan.Elimination.Equal(decimal.NewFromInt(-110)))
+	suite.True(loan.Consolidated.IsZero())
+	suite.True(report.Lines[2].Consolidated.IsZero(), "loan from parent is eliminated")
+	suite.True(report.Lines[3].Consolidated.Equal(decimal.NewFromInt(-720)), "equity 610 plus EUR 100 at 1.1")
+	suite.True(report.TotalDebit.Equal(decimal.NewFromInt(720)))
+	suite.True(report.TotalCredit.Equal(decimal.NewFromInt(720)))
+	suite.True(report.IntercompanyDifference.IsZero(), "intercompany balances offset each other")
+	suite.Require().Len(report.Rates, 1)
+	suite.Equal("EUR", report.Rates[0].FromCurrencyCode)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedTrialBalance_GroupCodeOverridesCFID() {
+	ctx := context.Background()
+	parentBank := balance(suite.parentID, "1010", "Checking", domain.Asset, "USD", 50)
+	subsidiaryBank := balance(suite.subsidiaryID, "1100", "Bank", domain.Asset, "USD", 70)
+	unmatched := balance(suite.subsidiaryID, "", "Petty cash", domain.Asset, "USD", 5)
+	group := suite.group(
+		domain.ConsolidationAccountMapping{AccountID: parentBank.AccountID, GroupCode: "BANK"},
+		domain.ConsolidationAccountMapping{AccountID: subsidiaryBank.AccountID, GroupCode: "BANK"},
+	)
+	suite.expectReport(ctx, group, (*time.Time)(nil), []domain.ConsolidationBalance{unmatched, parentBank, subsidiaryBank})
+
+	report, err := suite.service.ConsolidatedTrialBalance(ctx, suite.parentID, group.GroupID, suite.asOf, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Lines, 2)
+	suite.Equal("BANK", report.Lines[0].Code)
+	suite.True(report.Lines[0].Consolidated.Equal(decimal.NewFromInt(120)))
+	suite.Equal("", report.Lines[1].Code, "accounts without code or CFID come last")
+	suite.Empty(report.Rates)
+	suite.mockExchangeRateRepo.AssertNotCalled(suite.T(), "FindExchangeRateAsOf", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedTrialBalance_MismatchedAccountTypes() {
+	ctx := context.Background()
+	group := suite.group()
+	suite.expectReport(ctx, group, (*time.Time)(nil), []domain.ConsolidationBalance{
+		balance(suite.parentID, "1000", "Cash", domain.Asset, "USD", 10),
+		balance(suite.subsidiaryID, "1000", "Sales", domain.Revenue, "USD", -10),
+	})
+
+	_, err := suite.service.ConsolidatedTrialBalance(ctx, suite.parentID, group.GroupID, suite.asOf, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedTrialBalance_MissingRate() {
+	ctx := context.Background()
+	group := suite.group()
+	suite.expectReport(ctx, group, (*time.Time)(nil), []domain.ConsolidationBalance{
+		balance(suite.subsidiaryID, "1000", "Bank", domain.Asset, "GBP", 10),
+	})
+	suite.mockExchangeRateRepo.On("FindExchangeRateAsOf", ctx, "GBP", "USD", suite.asOf).Return(nil, apperrors.ErrNotFound).Once()
+
+	_, err := suite.service.ConsolidatedTrialBalance(ctx, suite.parentID, group.GroupID, suite.asOf, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedTrialBalance_ForbiddenOnMember() {
+	ctx := context.Background()
+	group := suite.group()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleReadOnly).Return(nil)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleReadOnly).Return(apperrors.ErrForbidden).Once()
+	suite.mockConsolidationRepo.On("FindConsolidationGroupByID", ctx, group.GroupID).Return(group, nil).Once()
+
+	_, err := suite.service.ConsolidatedTrialBalance(ctx, suite.parentID, group.GroupID, suite.asOf, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrForbidden)
+	suite.mockConsolidationRepo.AssertNotCalled(suite.T(), "GetConsolidationBalances", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedProfitAndLoss_EliminatesIntercompanyFees() {
+	ctx := context.Background()
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sales := balance(suite.parentID, "4000", "Sales", domain.Revenue, "USD", -1000)
+	managementFees := balance(suite.parentID, "4900", "Management fees", domain.Revenue, "USD", -55)
+	feesPaid := balance(suite.subsidiaryID, "6900", "Management fees", domain.Expense, "EUR", 50)
+	rent := balance(suite.subsidiaryID, "6000", "Rent", domain.Expense, "EUR", 100)
+	group := suite.group(
+		domain.ConsolidationAccountMapping{AccountID: managementFees.AccountID, Intercompany: true},
+		domain.ConsolidationAccountMapping{AccountID: feesPaid.AccountID, Intercompany: true},
+	)
+	suite.expectReport(ctx, group, &from, []domain.ConsolidationBalance{sales, managementFees, feesPaid, rent})
+	suite.expectRate(ctx, "EUR", "1.1")
+
+	report, err := suite.service.ConsolidatedProfitAndLoss(ctx, suite.parentID, group.GroupID, from, suite.asOf, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Revenue, 2)
+	suite.True(report.Revenue[0].Consolidated.Equal(decimal.NewFromInt(1000)), "revenue is credit-positive")
+	suite.True(report.Revenue[1].Combined.Equal(decimal.NewFromInt(55)))
+	suite.True(report.Revenue[1].Consolidated.IsZero())
+	suite.Require().Len(report.Expenses, 2)
+	suite.True(report.NetProfit.Equal(decimal.NewFromInt(890)), "1000 sales less EUR 100 rent at 1.1")
+	suite.True(report.IntercompanyDifference.IsZero())
+}
+
+func (suite *ConsolidationServiceTestSuite) TestConsolidatedBalanceSheet_UnmatchedIntercompany() {
+	ctx := context.Background()
+	receivable := balance(suite.parentID, "1500", "Due from subsidiary", domain.Asset, "USD", 120)
+	payable := balance(suite.subsidiaryID, "2500", "Due to parent", domain.Liability, "USD", -100)
+	cash := balance(suite.subsidiaryID, "1000", "Bank", domain.Asset, "USD", 100)
+	equity := balance(suite.parentID, "3000", "Capital", domain.Equity, "USD", -120)
+	group := suite.group(
+		domain.ConsolidationAccountMapping{AccountID: receivable.AccountID, Intercompany: true},
+		domain.ConsolidationAccountMapping{AccountID: payable.AccountID, Intercompany: true},
+	)
+	suite.expectReport(ctx, group, (*time.Time)(nil), []domain.ConsolidationBalance{receivable, payable, cash, equity})
+
+	report, err := suite.service.ConsolidatedBalanceSheet(ctx, suite.parentID, group.GroupID, suite.asOf, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(report.TotalAssets.Equal(decimal.NewFromInt(100)))
+	suite.True(report.TotalLiabilities.IsZero())
+	suite.Require().Len(report.Liabilities, 1)
+	suite.True(report.Liabilities[0].Combined.Equal(decimal.NewFromInt(100)), "liabilities are credit-positive")
+	suite.True(report.TotalEquity.Equal(decimal.NewFromInt(120)))
+	suite.True(report.IntercompanyDifference.Equal(decimal.NewFromInt(20)), "receivable exceeds payable by 20")
+}
@@ -0,0 +1,436 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/shopspring/decimal"
+)
+
+// defaultUpcomingDueDays is the window of the upcoming dues when none is given
+const defaultUpcomingDueDays = 30
+
+// creditCardService implements the CreditCardSvcFacade interface
+type creditCardService struct {
+	BaseService
+	cardRepo     portsrepo.CreditCardRepositoryFacade
+	accountRepo  portsrepo.AccountReader
+	currencyRepo portsrepo.CurrencyReader
+}
+
+// CreditCardServiceOption is a functional option for configuring the credit card service
+type CreditCardServiceOption func(*creditCardService)
+
+// WithCreditCardWorkplaceAuthorizer adds workplace authorizer dependency
+func WithCreditCardWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) CreditCardServiceOption {
+	return func(s *creditCardService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewCreditCardService creates a new service for credit cards. Statements are derived from the transactions of
+// the card account in each billing cycle; nothing but the cycle settings is stored.
+func NewCreditCardService(cardRepo portsrepo.CreditCardRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, options ...CreditCardServiceOption) portssvc.CreditCardSvcFacade {
+	svc := &creditCardService{
+		cardRepo:     cardRepo,
+		accountRepo:  accountRepo,
+		currencyRepo: currencyRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure creditCardService implements the CreditCardSvcFacade interface
+var _ portssvc.CreditCardSvcFacade = (*creditCardService)(nil)
+
+// applyCreditCardSettings validates the settings of a request and copies them onto a card
+func applyCreditCardSettings(card *domain.CreditCard, req dto.CreditCardRequest, precision int32) error {
+	if req.ClosingDay < 1 || req.ClosingDay > 31 || req.DueDay < 1 || req.DueDay > 31 {
+		return fmt.Errorf("%w: closing and due days must be between 1 and 31", apperrors.ErrValidation)
+	}
+	if req.CreditLimit.IsNegative() {
+		return fmt.Errorf("%w: credit limit cannot be negative", apperrors.ErrValidation)
+	}
+	if req.MinimumPaymentPercent.IsNegative() || req.MinimumPaymentPercent.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w: minimum payment percent must be between 0 and 100", apperrors.ErrValidation)
+	}
+	if req.MinimumPaymentAmount.IsNegative() {
+		return fmt.Errorf("%w: minimum payment amount cannot be negative", apperrors.ErrValidation)
+	}
+	card.ClosingDay = req.ClosingDay
+	card.DueDay = req.DueDay
+	card.CreditLimit = req.CreditLimit.Round(precision)
+	card.MinimumPaymentPercent = req.MinimumPaymentPercent
+	card.MinimumPaymentAmount = req.MinimumPaymentAmount.Round(precision)
+	return nil
+}
+
+// minimumDue returns the minimum payment of a statement balance: the larger of the percentage and the floor,
+// capped at the balance
+func minimumDue(card domain.CreditCard, statementBalance decimal.Decimal, precision int32) decimal.Decimal {
+	if !statementBalance.IsPositive() {
+		return decimal.Zero
+	}
+	minimum := statementBalance.Mul(card.MinimumPaymentPercent).Div(decimal.NewFromInt(100)).Round(precision)
+	if card.MinimumPaymentAmount.GreaterThan(minimum) {
+		minimum = card.MinimumPaymentAmount
+	}
+	if minimum.GreaterThan(statementBalance) {
+		minimum = statementBalance
+	}
+	return minimum
+}
+
+// findCard loads a credit card and its account, verifying that they belong to the workplace
+func (s *creditCardService) findCard(ctx context.Context, workplaceID string, accountID string) (*domain.CreditCard, *domain.Account, error) {
+	card, err := s.cardRepo.FindCreditCardByAccountID(ctx, accountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find credit card",
+			slog.String("account_id", accountID))
+		return nil, nil, fmt.Errorf("failed to find credit card: %w", err)
+	}
+	if card.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Credit card found but belongs to different workplace",
+			slog.String("account_id", accountID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, nil, apperrors.ErrNotFound
+	}
+	account, err := s.accountRepo.FindAccountByID(ctx, accountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find credit card account",
+			slog.String("account_id", accountID))
+		return nil, nil, err
+	}
+	return card, account, nil
+}
+
+// buildStatement sums the transactions of a billing cycle into its statement. Payments dated after the
+// closing date through the due date count towards the amounts due.
+func (s *creditCardService) buildStatement(ctx context.Context, card domain.CreditCard, account domain.Account, cycle domain.StatementCycle, asOf time.Time) (*domain.CreditCardStatement, error) {
+	precision := currencyPrecision(ctx, s.currencyRepo, account.CurrencyCode)
+	opening, err := s.cardRepo.GetCreditCardBalanceBefore(ctx, card.WorkplaceID, card.AccountID, cycle.StartDate)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to compute credit card opening balance",
+			slog.String("account_id", card.AccountID))
+		return nil, err
+	}
+	lines, err := s.cardRepo.ListCreditCardTransactions(ctx, card.WorkplaceID, card.AccountID, cycle.StartDate, cycle.ClosingDate.AddDate(0, 0, 1))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list credit card transactions",
+			slog.String("account_id", card.AccountID))
+		return nil, err
+	}
+
+	statement := &domain.CreditCardStatement{
+		AccountID:           card.AccountID,
+		CurrencyCode:        account.CurrencyCode,
+		Cycle:               cycle,
+		Closed:              asOf.After(cycle.ClosingDate),
+		OpeningBalance:      opening,
+		Charges:             decimal.Zero,
+		Payments:            decimal.Zero,
+		PaidSinceClosing:    decimal.Zero,
+		RemainingDue:        decimal.Zero,
+		RemainingMinimumDue: decimal.Zero,
+		Transactions:        lines,
+	}
+	for _, line := range lines {
+		if line.TransactionType == domain.Credit {
+			statement.Charges = statement.Charges.Add(line.Amount)
+		} else {
+			statement.Payments = statement.Payments.Add(line.Amount)
+		}
+	}
+	statement.StatementBalance = opening.Add(statement.Charges).Sub(statement.Payments)
+	statement.MinimumDue = minimumDue(card, statement.StatementBalance, precision)
+	if !statement.Closed {
+		return statement, nil
+	}
+
+	after, err := s.cardRepo.ListCreditCardTransactions(ctx, card.WorkplaceID, card.AccountID, cycle.ClosingDate.AddDate(0, 0, 1), cycle.DueDate.AddDate(0, 0, 1))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list credit card payments after closing",
+			slog.String("account_id", card.AccountID))
+		return nil, err
+	}
+	for _, line := range after {
+		if line.TransactionType == domain.Debit {
+			statement.PaidSinceClosing = statement.PaidSinceClosing.Add(line.Amount)
+		}
+	}
+	if remaining := statement.StatementBalance.Sub(statement.PaidSinceClosing); remaining.IsPositive() {
+		statement.RemainingDue = remaining
+	}
+	if remaining := statement.MinimumDue.Sub(statement.PaidSinceClosing); remaining.IsPositive() {
+		statement.RemainingMinimumDue = remaining
+	}
+	return statement, nil
+}
+
+func (s *creditCardService) CreateCreditCard(ctx context.Context, workplaceID string, req dto.CreateCreditCardRequest, userID string) (*domain.CreditCard, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create credit card",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	account, err := s.accountRepo.FindAccountByID(ctx, req.AccountID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: account %s not found", apperrors.ErrValidation, req.AccountID)
+		}
+		s.LogError(ctx, err, "Failed to find account for credit card",
+			slog.String("account_id", req.AccountID))
+		return nil, err
+	}
+	if account.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: account %s not found", apperrors.ErrValidation, req.AccountID)
+	}
+	if account.AccountType != domain.Liability {
+		return nil, fmt.Errorf("%w: a credit card must be a LIABILITY account", apperrors.ErrValidation)
+	}
+
+	now := time.Now()
+	card := &domain.CreditCard{
+		AccountID:   req.AccountID,
+		WorkplaceID: workplaceID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := applyCreditCardSettings(card, req.CreditCardRequest, currencyPrecision(ctx, s.currencyRepo, account.CurrencyCode)); err != nil {
+		return nil, err
+	}
+
+	if err := s.cardRepo.SaveCreditCard(ctx, *card); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: account %s is already a credit card", apperrors.ErrConflict, req.AccountID)
+		}
+		s.LogError(ctx, err, "Failed to save credit card",
+			slog.String("account_id", req.AccountID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Credit card created successfully",
+		slog.String("account_id", card.AccountID),
+		slog.String("workplace_id", workplaceID))
+	return card, nil
+}
+
+func (s *creditCardService) UpdateCreditCard(ctx context.Context, workplaceID string, accountID string, req dto.CreditCardRequest, userID string) (*domain.CreditCard, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update credit card",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", accountID))
+		return nil, err
+	}
+
+	card, account, err := s.findCard(ctx, workplaceID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyCreditCardSettings(card, req, currencyPrecision(ctx, s.currencyRepo, account.CurrencyCode)); err != nil {
+		return nil, err
+	}
+	card.LastUpdatedAt = time.Now()
+	card.LastUpdatedBy = userID
+
+	if err := s.cardRepo.UpdateCreditCard(ctx, *card); err != nil {
+		s.LogError(ctx, err, "Failed to update credit card",
+			slog.String("account_id", accountID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Credit card updated successfully",
+		slog.String("account_id", accountID),
+		slog.String("workplace_id", workplaceID))
+	return card, nil
+}
+
+func (s *creditCardService) DeleteCreditCard(ctx context.Context, workplaceID string, accountID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete credit card",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", accountID))
+		return err
+	}
+
+	if _, _, err := s.findCard(ctx, workplaceID, accountID); err != nil {
+		return err
+	}
+	if err := s.cardRepo.DeleteCreditCard(ctx, accountID); err != nil {
+		s.LogError(ctx, err, "Failed to delete credit card",
+			slog.String("account_id", accountID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Credit card deleted successfully",
+		slog.String("account_id", accountID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+func (s *creditCardService) ListCreditCards(ctx context.Context, workplaceID string, userID string) ([]domain.CreditCard, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list credit cards",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	cards, err := s.cardRepo.ListCreditCards(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list credit cards",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return cards, nil
+}
+
+func (s *creditCardService) GetCreditCard(ctx context.Context, workplaceID string, accountID string, userID string) (*domain.CreditCardSummary, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view credit card",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", accountID))
+		return nil, err
+	}
+
+	card, account, err := s.findCard(ctx, workplaceID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	asOf := todayUTC()
+	statement, err := s.buildStatement(ctx, *card, *account, card.LastClosedCycle(asOf), asOf)
+	if err != nil {
+		return nil, err
+	}
+
+	available := card.CreditLimit.Sub(account.Balance)
+	if available.IsNegative() {
+		available = decimal.Zero
+	}
+	return &domain.CreditCardSummary{
+		CreditCard:      *card,
+		AccountName:     account.Name,
+		CurrencyCode:    account.CurrencyCode,
+		CurrentBalance:  account.Balance,
+		AvailableCredit: available,
+		CurrentCycle:    card.CycleFor(asOf),
+		LastStatement:   statement,
+	}, nil
+}
+
+func (s *creditCardService) GetStatement(ctx context.Context, workplaceID string, accountID string, params dto.StatementParams, userID string) (*domain.CreditCardStatement, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view credit card statement",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", accountID))
+		return nil, err
+	}
+
+	card, account, err := s.findCard(ctx, workplaceID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	asOf := todayUTC()
+	cycle := card.LastClosedCycle(asOf)
+	if params.Date != nil {
+		cycle = card.CycleFor(*params.Date)
+	}
+	return s.buildStatement(ctx, *card, *account, cycle, asOf)
+}
+
+func (s *creditCardService) ListUpcomingDues(ctx context.Context, workplaceID string, params dto.UpcomingDuesParams, userID string) ([]domain.UpcomingDue, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list upcoming credit card dues",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	asOf := todayUTC()
+	if params.AsOf != nil {
+		asOf = dateOnly(*params.AsOf)
+	}
+	days := params.Days
+	if days <= 0 {
+		days = defaultUpcomingDueDays
+	}
+	until := asOf.AddDate(0, 0, days)
+
+	cards, err := s.cardRepo.ListCreditCards(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list credit cards",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if len(cards) == 0 {
+		return []domain.UpcomingDue{}, nil
+	}
+	accountIDs := make([]string, 0, len(cards))
+	for _, card := range cards {
+		accountIDs = append(accountIDs, card.AccountID)
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, accountIDs)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load credit card accounts",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	dues := []domain.UpcomingDue{}
+	for _, card := range cards {
+		account, ok := accounts[card.AccountID]
+		if !ok {
+			continue
+		}
+		cycle := card.LastClosedCycle(asOf)
+		if cycle.DueDate.Before(asOf) || cycle.DueDate.After(until) {
+			continue
+		}
+		statement, err := s.buildStatement(ctx, card, account, cycle, asOf)
+		if err != nil {
+			return nil, err
+		}
+		if !statement.RemainingDue.IsPositive() {
+			continue
+		}
+		dues = append(dues, domain.UpcomingDue{
+			AccountID:           card.AccountID,
+			AccountName:         account.Name,
+			CurrencyCode:        account.CurrencyCode,
+			ClosingDate:         cycle.ClosingDate,
+			DueDate:             cycle.DueDate,
+			DaysUntilDue:        int(cycle.DueDate.Sub(asOf).Hours() / 24),
+			StatementBalance:    statement.StatementBalance,
+			MinimumDue:          statement.MinimumDue,
+			RemainingDue:        statement.RemainingDue,
+			RemainingMinimumDue: statement.RemainingMinimumDue,
+		})
+	}
+	sort.SliceStable(dues, func(i, j int) bool {
+		if !dues[i].DueDate.Equal(dues[j].DueDate) {
+			return dues[i].DueDate.Before(dues[j].DueDate)
+		}
+		return strings.ToLower(dues[i].AccountName) < strings.ToLower(dues[j].AccountName)
+	})
+	return dues, nil
+}
@@ -0,0 +1,299 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock CreditCardRepository ---
+type MockCreditCardRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.CreditCardRepositoryFacade = (*MockCreditCardRepository)(nil)
+
+func (m *MockCreditCardRepository) FindCreditCardByAccountID(ctx context.Context, accountID string) (*domain.CreditCard, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CreditCard), args.Error(1)
+}
+
+func (m *MockCreditCardRepository) ListCreditCards(ctx context.Context, workplaceID string) ([]domain.CreditCard, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CreditCard), args.Error(1)
+}
+
+func (m *MockCreditCardRepository) GetCreditCardBalanceBefore(ctx context.Context, workplaceID string, accountID string, before time.Time) (decimal.Decimal, error) {
+	args := m.Called(ctx, workplaceID, accountID, before)
+	return args.Get(0).(decimal.Decimal), args.Error(1)
+}
+
+func (m *MockCreditCardRepository) ListCreditCardTransactions(ctx context.Context, workplaceID string, accountID string, from, to time.Time) ([]domain.Transaction, error) {
+	args := m.Called(ctx, workplaceID, accountID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Transaction), args.Error(1)
+}
+
+func (m *MockCreditCardRepository) SaveCreditCard(ctx context.Context, card domain.CreditCard) error {
+	args := m.Called(ctx, card)
+	return args.Error(0)
+}
+
+func (m *MockCreditCardRepository) UpdateCreditCard(ctx context.Context, card domain.CreditCard) error {
+	args := m.Called(ctx, card)
+	return args.Error(0)
+}
+
+func (m *MockCreditCardRepository) DeleteCreditCard(ctx context.Context, accountID string) error {
+	args := m.Called(ctx, accountID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type CreditCardServiceTestSuite struct {
+	suite.Suite
+	mockCardRepo     *MockCreditCardRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockCurrencyRepo *MockCurrencyRepository
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.CreditCardSvcFacade
+	workplaceID      string
+	userID           string
+	card             domain.CreditCard
+	account          domain.Account
+}
+
+func (suite *CreditCardServiceTestSuite) SetupTest() {
+	suite.mockCardRepo = new(MockCreditCardRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewCreditCardService(suite.mockCardRepo, suite.mockAccountRepo, suite.mockCurrencyRepo,
+		services.WithCreditCardWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	// Statements close on the 25th and are due on the 15th of the following month
+	suite.card = domain.CreditCard{
+		AccountID:             "visa",
+		WorkplaceID:           suite.workplaceID,
+		ClosingDay:            25,
+		DueDay:                15,
+		CreditLimit:           decimal.NewFromInt(1000),
+		MinimumPaymentPercent: decimal.NewFromInt(5),
+		MinimumPaymentAmount:  decimal.NewFromInt(25),
+	}
+	suite.account = domain.Account{
+		AccountID: "visa", WorkplaceID: suite.workplaceID, Name: "Visa", AccountType: domain.Liability,
+		CurrencyCode: "USD", Balance: decimal.NewFromInt(400),
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", mock.Anything, suite.userID, suite.workplaceID, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func TestCreditCardService(t *testing.T) {
+	suite.Run(t, new(CreditCardServiceTestSuite))
+}
+
+func cardDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func cardLine(amount int64, side domain.TransactionType) domain.Transaction {
+	return domain.Transaction{TransactionID: uuid.NewString(), AccountID: "visa", Amount: decimal.NewFromInt(amount), TransactionType: side}
+}
+
+// expectCard mocks the card and its account
+func (suite *CreditCardServiceTestSuite) expectCard(ctx context.Context) {
+	suite.mockCardRepo.On("FindCreditCardByAccountID", ctx, "visa").Return(&suite.card, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "visa").Return(&suite.account, nil).Once()
+}
+
+// expectCycle mocks the opening balance, the lines of the cycle and, when given, the lines after closing
+func (suite *CreditCardServiceTestSuite) expectCycle(ctx context.Context, cycle domain.StatementCycle, opening int64, lines []domain.Transaction, after []domain.Transaction) {
+	suite.mockCardRepo.On("GetCreditCardBalanceBefore", ctx, suite.workplaceID, "visa", cycle.StartDate).Return(decimal.NewFromInt(opening), nil).Once()
+	suite.mockCardRepo.On("ListCreditCardTransactions", ctx, suite.workplaceID, "visa", cycle.StartDate, cycle.ClosingDate.AddDate(0, 0, 1)).
+		Return(lines, nil).Once()
+	if after != nil {
+		suite.mockCardRepo.On("ListCreditCardTransactions", ctx, suite.workplaceID, "visa", cycle.ClosingDate.AddDate(0, 0, 1), cycle.DueDate.AddDate(0, 0, 1)).
+			Return(after, nil).Once()
+	}
+}
+
+func (suite *CreditCardServiceTestSuite) TestCycleFor_ClampsToMonthEnd() {
+	card := domain.CreditCard{ClosingDay: 31, DueDay: 20}
+
+	cycle := card.CycleFor(cardDate(2025, time.February, 10))
+
+	suite.Equal(cardDate(2025, time.February, 1), cycle.StartDate)
+	suite.Equal(cardDate(2025, time.February, 28), cycle.ClosingDate)
+	suite.Equal(cardDate(2025, time.March, 20), cycle.DueDate)
+}
+
+func (suite *CreditCardServiceTestSuite) TestGetStatement_SumsCycleAndAppliesLaterPayments() {
+	ctx := context.Background()
+	suite.expectCard(ctx)
+	cycle := domain.StatementCycle{
+		StartDate: cardDate(2025, time.February, 26), ClosingDate: cardDate(2025, time.March, 25), DueDate: cardDate(2025, time.April, 15),
+	}
+	suite.expectCycle(ctx, cycle, 200,
+		[]domain.Transaction{cardLine(300, domain.Credit), cardLine(200, domain.Debit)},
+		[]domain.Transaction{cardLine(100, domain.Debit), cardLine(50, domain.Credit)},
+	)
+	date := cardDate(2025, time.March, 10)
+
+	statement, err := suite.service.GetStatement(ctx, suite.workplaceID, "visa", dto.StatementParams{Date: &date}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(cycle, statement.Cycle)
+	suite.True(statement.Closed)
+	suite.True(statement.Charges.Equal(decimal.NewFromInt(300)))
+	suite.True(statement.Payments.Equal(decimal.NewFromInt(200)))
+	suite.True(statement.StatementBalance.Equal(decimal.NewFromInt(300)))
+	// 5% of 300 is 15, below the floor of 25
+	suite.True(statement.MinimumDue.Equal(decimal.NewFromInt(25)))
+	suite.True(statement.PaidSinceClosing.Equal(decimal.NewFromInt(100)))
+	suite.True(statement.RemainingDue.Equal(decimal.NewFromInt(200)))
+	suite.True(statement.RemainingMinimumDue.IsZero())
+	suite.Len(statement.Transactions, 2)
+}
+
+func (suite *CreditCardServiceTestSuite) TestGetStatement_OpenCycleHasNoAmountsDue() {
+	ctx := context.Background()
+	suite.expectCard(ctx)
+	date := time.Now().UTC().AddDate(0, 2, 0)
+	cycle := suite.card.CycleFor(date)
+	suite.expectCycle(ctx, cycle, 0, []domain.Transaction{cardLine(80, domain.Credit)}, nil)
+
+	statement, err := suite.service.GetStatement(ctx, suite.workplaceID, "visa", dto.StatementParams{Date: &date}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.False(statement.Closed)
+	suite.True(statement.StatementBalance.Equal(decimal.NewFromInt(80)))
+	suite.True(statement.RemainingDue.IsZero())
+	suite.mockCardRepo.AssertNumberOfCalls(suite.T(), "ListCreditCardTransactions", 1)
+}
+
+func (suite *CreditCardServiceTestSuite) TestGetCreditCard_AvailableCreditNeverNegative() {
+	ctx := context.Background()
+	suite.account.Balance = decimal.NewFromInt(1200)
+	suite.expectCard(ctx)
+	suite.mockCardRepo.On("GetCreditCardBalanceBefore", ctx, suite.workplaceID, "visa", mock.Anything).Return(decimal.NewFromInt(900), nil).Once()
+	suite.mockCardRepo.On("ListCreditCardTransactions", ctx, suite.workplaceID, "visa", mock.Anything, mock.Anything).Return([]domain.Transaction{}, nil)
+
+	summary, err := suite.service.GetCreditCard(ctx, suite.workplaceID, "visa", suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(summary.AvailableCredit.IsZero())
+	suite.True(summary.CurrentBalance.Equal(decimal.NewFromInt(1200)))
+	suite.Require().NotNil(summary.LastStatement)
+	suite.True(summary.LastStatement.Closed)
+	suite.True(summary.LastStatement.RemainingDue.Equal(decimal.NewFromInt(900)))
+}
+
+func (suite *CreditCardServiceTestSuite) TestGetCreditCard_OtherWorkplaceIsNotFound() {
+	ctx := context.Background()
+	suite.card.WorkplaceID = uuid.NewString()
+	suite.mockCardRepo.On("FindCreditCardByAccountID", ctx, "visa").Return(&suite.card, nil).Once()
+
+	_, err := suite.service.GetCreditCard(ctx, suite.workplaceID, "visa", suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrNotFound))
+}
+
+func (suite *CreditCardServiceTestSuite) TestListUpcomingDues_ListsUnpaidStatementsInWindow() {
+	ctx := context.Background()
+	paid := suite.card
+	paid.AccountID = "amex"
+	later := suite.card
+	later.AccountID = "mastercard"
+	later.ClosingDay = 5
+	later.DueDay = 28
+	suite.mockCardRepo.On("ListCreditCards", ctx, suite.workplaceID).Return([]domain.CreditCard{suite.card, paid, later}, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, []string{"visa", "amex", "mastercard"}).Return(map[string]domain.Account{
+		"visa":       suite.account,
+		"amex":       {AccountID: "amex", WorkplaceID: suite.workplaceID, Name: "Amex", AccountType: domain.Liability, CurrencyCode: "USD"},
+		"mastercard": {AccountID: "mastercard", WorkplaceID: suite.workplaceID, Name: "Mastercard", AccountType: domain.Liability, CurrencyCode: "USD"},
+	}, nil).Once()
+	cycle := domain.StatementCycle{
+		StartDate: cardDate(2025, time.February, 26), ClosingDate: cardDate(2025, time.March, 25), DueDate: cardDate(2025, time.April, 15),
+	}
+	suite.expectCycle(ctx, cycle, 0, []domain.Transaction{cardLine(500, domain.Credit)}, []domain.Transaction{cardLine(100, domain.Debit)})
+	suite.mockCardRepo.On("GetCreditCardBalanceBefore", ctx, suite.workplaceID, "amex", cycle.StartDate).Return(decimal.Zero, nil).Once()
+	suite.mockCardRepo.On("ListCreditCardTransactions", ctx, suite.workplaceID, "amex", cycle.StartDate, mock.Anything).
+		Return([]domain.Transaction{cardLine(300, domain.Credit)}, nil).Once()
+	suite.mockCardRepo.On("ListCreditCardTransactions", ctx, suite.workplaceID, "amex", cycle.ClosingDate.AddDate(0, 0, 1), mock.Anything).
+		Return([]domain.Transaction{cardLine(300, domain.Debit)}, nil).Once()
+	asOf := cardDate(2025, time.April, 1)
+
+	// The mastercard statement closed on March 5 and fell due on March 28, before the window
+	dues, err := suite.service.ListUpcomingDues(ctx, suite.workplaceID, dto.UpcomingDuesParams{AsOf: &asOf, Days: 30}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(dues, 1)
+	suite.Equal("visa", dues[0].AccountID)
+	suite.Equal(14, dues[0].DaysUntilDue)
+	suite.True(dues[0].RemainingDue.Equal(decimal.NewFromInt(400)))
+	suite.True(dues[0].MinimumDue.Equal(decimal.NewFromInt(25)))
+	suite.True(dues[0].RemainingMinimumDue.IsZero())
+}
+
+func (suite *CreditCardServiceTestSuite) TestCreateCreditCard_RequiresLiabilityAccount() {
+	ctx := context.Background()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "checking").Return(&domain.Account{
+		AccountID: "checking", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD",
+	}, nil).Once()
+
+	_, err := suite.service.CreateCreditCard(ctx, suite.workplaceID, dto.CreateCreditCardRequest{
+		AccountID: "checking", CreditCardRequest: dto.CreditCardRequest{ClosingDay: 25, DueDay: 15, CreditLimit: decimal.NewFromInt(1000)},
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockCardRepo.AssertNotCalled(suite.T(), "SaveCreditCard", mock.Anything, mock.Anything)
+}
+
+func (suite *CreditCardServiceTestSuite) TestCreateCreditCard_DuplicateIsConflict() {
+	ctx := context.Background()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "visa").Return(&suite.account, nil).Once()
+	suite.mockCardRepo.On("SaveCreditCard", ctx, mock.MatchedBy(func(c domain.CreditCard) bool {
+		return c.ClosingDay == 25 && c.DueDay == 15 && c.WorkplaceID == suite.workplaceID
+	})).Return(apperrors.ErrDuplicate).Once()
+
+	_, err := suite.service.CreateCreditCard(ctx, suite.workplaceID, dto.CreateCreditCardRequest{
+		AccountID: "visa", CreditCardRequest: dto.CreditCardRequest{ClosingDay: 25, DueDay: 15, CreditLimit: decimal.NewFromInt(1000)},
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+}
+
+func (suite *CreditCardServiceTestSuite) TestUpdateCreditCard_RejectsPercentAboveHundred() {
+	ctx := context.Background()
+	suite.expectCard(ctx)
+
+	_, err := suite.service.UpdateCreditCard(ctx, suite.workplaceID, "visa", dto.CreditCardRequest{
+		ClosingDay: 25, DueDay: 15, CreditLimit: decimal.NewFromInt(1000), MinimumPaymentPercent: decimal.NewFromInt(150),
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockCardRepo.AssertNotCalled(suite.T(), "UpdateCreditCard", mock.Anything, mock.Anything)
+}
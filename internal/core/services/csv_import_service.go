@@ -0,0 +1,499 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/utils/bankstatement"
+	"github.com/google/uuid"
+)
+
+// csvImportService implements the CSVImportSvcFacade interface
+type csvImportService struct {
+	BaseService
+	profileRepo   portsrepo.CSVImportProfileRepositoryFacade
+	accountRepo   portsrepo.AccountReader
+	currencyRepo  portsrepo.CurrencyReader
+	statementRepo portsrepo.BankStatementRepositoryFacade
+	statementSvc  portssvc.BankStatementWriterSvc
+	ruleRepo      portsrepo.CategorizationRuleReader
+}
+
+// CSVImportServiceOption is a functional option for configuring the CSV import service
+type CSVImportServiceOption func(*csvImportService)
+
+// WithCSVImportWorkplaceAuthorizer adds workplace authorizer dependency
+func WithCSVImportWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) CSVImportServiceOption {
+	return func(s *csvImportService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// WithCSVImportCategorizationRules categorizes new lines with the workplace's rules as they are imported
+func WithCSVImportCategorizationRules(ruleRepo portsrepo.CategorizationRuleReader) CSVImportServiceOption {
+	return func(s *csvImportService) {
+		s.ruleRepo = ruleRepo
+	}
+}
+
+// NewCSVImportService creates a new CSV import service. Rows are staged as bank statement lines, so
+// re-imports are deduplicated and journals are created the same way as for other statement formats.
+func NewCSVImportService(profileRepo portsrepo.CSVImportProfileRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, statementRepo portsrepo.BankStatementRepositoryFacade, statementSvc portssvc.BankStatementWriterSvc, options ...CSVImportServiceOption) portssvc.CSVImportSvcFacade {
+	svc := &csvImportService{
+		profileRepo:   profileRepo,
+		accountRepo:   accountRepo,
+		currencyRepo:  currencyRepo,
+		statementRepo: statementRepo,
+		statementSvc:  statementSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure csvImportService implements the CSVImportSvcFacade interface
+var _ portssvc.CSVImportSvcFacade = (*csvImportService)(nil)
+
+// csvLayout converts a saved profile to the layout understood by the CSV parser
+func csvLayout(profile domain.CSVImportProfile) bankstatement.CSVLayout {
+	delimiter, _ := utf8.DecodeRuneInString(profile.Delimiter)
+	return bankstatement.CSVLayout{
+		Delimiter:        delimiter,
+		SkipRows:         profile.SkipRows,
+		HasHeader:        profile.HasHeader,
+		DateColumn:       profile.DateColumn,
+		DateFormat:       profile.DateFormat,
+		AmountMode:       bankstatement.AmountMode(profile.AmountMode),
+		AmountColumn:     profile.AmountColumn,
+		DebitColumn:      profile.DebitColumn,
+		CreditColumn:     profile.CreditColumn,
+		DecimalSeparator: profile.DecimalSeparator,
+		PayeeColumn:      profile.PayeeColumn,
+		MemoColumn:       profile.MemoColumn,
+		ReferenceColumn:  profile.ReferenceColumn,
+	}
+}
+
+// validateCSVProfile checks the name and layout of a profile
+func validateCSVProfile(profile domain.CSVImportProfile) error {
+	if strings.TrimSpace(profile.Name) == "" {
+		return fmt.Errorf("%w: profile name cannot be empty", apperrors.ErrValidation)
+	}
+	if utf8.RuneCountInString(profile.Delimiter) != 1 {
+		return fmt.Errorf("%w: delimiter must be a single character", apperrors.ErrValidation)
+	}
+	if err := csvLayout(profile).Validate(); err != nil {
+		return fmt.Errorf("%w: %v", apperrors.ErrValidation, err)
+	}
+	return nil
+}
+
+// validateStatementTransaction applies the CreateTransactionRequest rules to the statement side of a row:
+// a positive amount that fits the currency precision
+func validateStatementTransaction(txn dto.CreateTransactionRequest, currencyCode string, precision int32) error {
+	if !txn.Amount.IsPositive() {
+		return errors.New("amount must not be zero")
+	}
+	if !txn.Amount.Equal(txn.Amount.Round(precision)) {
+		return fmt.Errorf("amount %s has more than %d decimal places for %s", txn.Amount, precision, currencyCode)
+	}
+	return nil
+}
+
+// findProfile loads a CSV import profile and verifies that it belongs to the workplace
+func (s *csvImportService) findProfile(ctx context.Context, workplaceID string, profileID string) (*domain.CSVImportProfile, error) {
+	profile, err := s.profileRepo.FindCSVImportProfileByID(ctx, profileID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find CSV import profile by ID",
+			slog.String("profile_id", profileID))
+		return nil, fmt.Errorf("failed to find CSV import profile: %w", err)
+	}
+	if profile.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "CSV import profile found but belongs to different workplace",
+			slog.String("profile_id", profileID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return profile, nil
+}
+
+// readCSV parses a file with a profile and checks each readable row against the account currency
+func (s *csvImportService) readCSV(ctx context.Context, workplaceID string, profile *domain.CSVImportProfile, data []byte) (*domain.Account, []domain.CSVPreviewRow, error) {
+	account, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, profile.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	precision := currencyPrecision(ctx, s.currencyRepo, account.CurrencyCode)
+
+	rows, err := bankstatement.ParseCSV(data, csvLayout(*profile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", apperrors.ErrValidation, err)
+	}
+
+	previewRows := make([]domain.CSVPreviewRow, 0, len(rows))
+	for _, row := range rows {
+		previewRow := domain.CSVPreviewRow{
+			RowNumber:     row.RowNumber,
+			Date:          row.Line.Date,
+			Amount:        row.Line.Amount,
+			Payee:         row.Line.Payee,
+			Memo:          row.Line.Memo,
+			BankReference: row.Line.Reference,
+		}
+		if row.Err != nil {
+			previewRow.Error = row.Err.Error()
+		} else if err := validateStatementTransaction(statementTransaction(account.AccountID, row.Line.Amount, row.Line.Reference), account.CurrencyCode, precision); err != nil {
+			previewRow.Error = err.Error()
+		}
+		previewRows = append(previewRows, previewRow)
+	}
+	return account, previewRows, nil
+}
+
+func (s *csvImportService) CreateCSVImportProfile(ctx context.Context, workplaceID string, req dto.CreateCSVImportProfileRequest, userID string) (*domain.CSVImportProfile, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create CSV import profile",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if _, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, req.AccountID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	profile := domain.CSVImportProfile{
+		ProfileID:        uuid.NewString(),
+		WorkplaceID:      workplaceID,
+		AccountID:        req.AccountID,
+		Name:             strings.TrimSpace(req.Name),
+		Delimiter:        req.Delimiter,
+		SkipRows:         req.SkipRows,
+		HasHeader:        req.HasHeader == nil || *req.HasHeader,
+		DateColumn:       req.DateColumn,
+		DateFormat:       req.DateFormat,
+		AmountMode:       req.AmountMode,
+		AmountColumn:     req.AmountColumn,
+		DebitColumn:      req.DebitColumn,
+		CreditColumn:     req.CreditColumn,
+		DecimalSeparator: req.DecimalSeparator,
+		PayeeColumn:      req.PayeeColumn,
+		MemoColumn:       req.MemoColumn,
+		ReferenceColumn:  req.ReferenceColumn,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if profile.Delimiter == "" {
+		profile.Delimiter = ","
+	}
+	if profile.DecimalSeparator == "" {
+		profile.DecimalSeparator = "."
+	}
+	if err := validateCSVProfile(profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.profileRepo.SaveCSVImportProfile(ctx, profile); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: the account already has a CSV import profile named %q", apperrors.ErrValidation, profile.Name)
+		}
+		s.LogError(ctx, err, "Failed to save CSV import profile",
+			slog.String("profile_id", profile.ProfileID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "CSV import profile created successfully",
+		slog.String("profile_id", profile.ProfileID),
+		slog.String("workplace_id", workplaceID))
+	return &profile, nil
+}
+
+func (s *csvImportService) ListCSVImportProfiles(ctx context.Context, workplaceID string, accountID string, userID string) ([]domain.CSVImportProfile, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list CSV import profiles",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	profiles, err := s.profileRepo.ListCSVImportProfiles(ctx, workplaceID, accountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list CSV import profiles",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (s *csvImportService) GetCSVImportProfile(ctx context.Context, workplaceID string, profileID string, userID string) (*domain.CSVImportProfile, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view CSV import profile",
+			slog.String("workplace_id", workplaceID),
+			slog.String("profile_id", profileID))
+		return nil, err
+	}
+	return s.findProfile(ctx, workplaceID, profileID)
+}
+
+func (s *csvImportService) UpdateCSVImportProfile(ctx context.Context, workplaceID string, profileID string, req dto.UpdateCSVImportProfileRequest, userID string) (*domain.CSVImportProfile, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update CSV import profile",
+			slog.String("workplace_id", workplaceID),
+			slog.String("profile_id", profileID))
+		return nil, err
+	}
+
+	profile, err := s.findProfile(ctx, workplaceID, profileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		profile.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Delimiter != nil {
+		profile.Delimiter = *req.Delimiter
+	}
+	if req.SkipRows != nil {
+		profile.SkipRows = *req.SkipRows
+	}
+	if req.HasHeader != nil {
+		profile.HasHeader = *req.HasHeader
+	}
+	if req.DateColumn != nil {
+		profile.DateColumn = *req.DateColumn
+	}
+	if req.DateFormat != nil {
+		profile.DateFormat = *req.DateFormat
+	}
+	if req.AmountMode != nil {
+		profile.AmountMode = *req.AmountMode
+	}
+	if req.AmountColumn != nil {
+		profile.AmountColumn = *req.AmountColumn
+	}
+	if req.DebitColumn != nil {
+		profile.DebitColumn = *req.DebitColumn
+	}
+	if req.CreditColumn != nil {
+		profile.CreditColumn = *req.CreditColumn
+	}
+	if req.DecimalSeparator != nil {
+		profile.DecimalSeparator = *req.DecimalSeparator
+	}
+	if req.PayeeColumn != nil {
+		profile.PayeeColumn = *req.PayeeColumn
+	}
+	if req.MemoColumn != nil {
+		profile.MemoColumn = *req.MemoColumn
+	}
+	if req.ReferenceColumn != nil {
+		profile.ReferenceColumn = *req.ReferenceColumn
+	}
+	if err := validateCSVProfile(*profile); err != nil {
+		return nil, err
+	}
+	profile.LastUpdatedAt = time.Now()
+	profile.LastUpdatedBy = userID
+
+	if err := s.profileRepo.UpdateCSVImportProfile(ctx, *profile); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: the account already has a CSV import profile named %q", apperrors.ErrValidation, profile.Name)
+		}
+		s.LogError(ctx, err, "Failed to update CSV import profile",
+			slog.String("profile_id", profileID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "CSV import profile updated successfully",
+		slog.String("profile_id", profileID))
+	return profile, nil
+}
+
+func (s *csvImportService) DeleteCSVImportProfile(ctx context.Context, workplaceID string, profileID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete CSV import profile",
+			slog.String("workplace_id", workplaceID),
+			slog.String("profile_id", profileID))
+		return err
+	}
+
+	if _, err := s.findProfile(ctx, workplaceID, profileID); err != nil {
+		return err
+	}
+	if err := s.profileRepo.DeleteCSVImportProfile(ctx, profileID); err != nil {
+		s.LogError(ctx, err, "Failed to delete CSV import profile",
+			slog.String("profile_id", profileID))
+		return err
+	}
+
+	s.LogInfo(ctx, "CSV import profile deleted successfully",
+		slog.String("profile_id", profileID))
+	return nil
+}
+
+func (s *csvImportService) PreviewCSVImport(ctx context.Context, workplaceID string, profileID string, data []byte, userID string) (*domain.CSVImportPreview, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to preview CSV import",
+			slog.String("workplace_id", workplaceID),
+			slog.String("profile_id", profileID))
+		return nil, err
+	}
+
+	profile, err := s.findProfile(ctx, workplaceID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	account, rows, err := s.readCSV(ctx, workplaceID, profile, data)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &domain.CSVImportPreview{
+		ProfileID:    profile.ProfileID,
+		AccountID:    account.AccountID,
+		CurrencyCode: account.CurrencyCode,
+		Rows:         rows,
+	}
+	for _, row := range rows {
+		if row.Error == "" {
+			preview.ValidRows++
+		} else {
+			preview.InvalidRows++
+		}
+	}
+	return preview, nil
+}
+
+func (s *csvImportService) ImportCSV(ctx context.Context, workplaceID string, profileID string, req dto.ImportCSVRequest, userID string) (*domain.CSVImportResult, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to import CSV",
+			slog.String("workplace_id", workplaceID),
+			slog.String("profile_id", profileID))
+		return nil, err
+	}
+
+	mode := req.Mode
+	if mode == "" {
+		mode = domain.CSVImportStage
+	}
+	profile, err := s.findProfile(ctx, workplaceID, profileID)
+	if err != nil {
+		return nil, err
+	}
+	if req.CounterAccountID != "" && req.CounterAccountID == profile.AccountID {
+		return nil, fmt.Errorf("%w: counter-account must differ from the statement account", apperrors.ErrValidation)
+	}
+	account, rows, err := s.readCSV(ctx, workplaceID, profile, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	audit := domain.AuditFields{
+		CreatedAt:     now,
+		CreatedBy:     userID,
+		LastUpdatedAt: now,
+		LastUpdatedBy: userID,
+	}
+	statementImport := domain.BankStatementImport{
+		ImportID:    uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AccountID:   account.AccountID,
+		Format:      string(bankstatement.FormatCSV),
+		FileName:    req.FileName,
+		AuditFields: audit,
+	}
+
+	result := &domain.CSVImportResult{Mode: mode, Failures: []domain.CSVImportFailure{}}
+	lines := make([]domain.BankStatementLine, 0, len(rows))
+	for _, row := range rows {
+		if row.Error != "" {
+			result.SkippedRows++
+			continue
+		}
+		lines = append(lines, domain.BankStatementLine{
+			LineID:        uuid.NewString(),
+			WorkplaceID:   workplaceID,
+			AccountID:     account.AccountID,
+			ImportID:      statementImport.ImportID,
+			BankReference: row.BankReference,
+			Date:          row.Date,
+			Amount:        row.Amount,
+			Payee:         row.Payee,
+			Memo:          row.Memo,
+			Status:        domain.StatementLinePending,
+			AuditFields:   audit,
+		})
+	}
+
+	if err := categorizeNewStatementLines(ctx, s.ruleRepo, workplaceID, lines); err != nil {
+		s.LogError(ctx, err, "Failed to categorize CSV lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	saved, err := s.statementRepo.SaveStatementImport(ctx, statementImport, lines)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to save CSV import",
+			slog.String("profile_id", profileID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	result.Import = *saved
+
+	if mode == domain.CSVImportJournal && saved.NewLines > 0 {
+		staged, err := s.statementRepo.ListStatementLinesByImport(ctx, saved.ImportID)
+		if err != nil {
+			s.LogError(ctx, err, "Failed to load staged CSV lines",
+				slog.String("import_id", saved.ImportID))
+			return nil, err
+		}
+		for _, line := range staged {
+			if line.Status != domain.StatementLinePending {
+				continue
+			}
+			// Lines categorized by a rule keep their counter-account; the requested one covers the rest
+			postReq := dto.PostStatementLineRequest{}
+			if line.CounterAccountID == "" {
+				postReq.CounterAccountID = req.CounterAccountID
+			}
+			if _, _, err := s.statementSvc.PostStatementLine(ctx, workplaceID, line.LineID, postReq, userID); err != nil {
+				result.Failures = append(result.Failures, domain.CSVImportFailure{
+					LineID:        line.LineID,
+					BankReference: line.BankReference,
+					Error:         err.Error(),
+				})
+				continue
+			}
+			result.PostedLines++
+		}
+	}
+
+	s.LogInfo(ctx, "CSV imported successfully",
+		slog.String("import_id", saved.ImportID),
+		slog.String("mode", string(mode)),
+		slog.Int("new_lines", saved.NewLines),
+		slog.Int("skipped_rows", result.SkippedRows),
+		slog.Int("posted_lines", result.PostedLines))
+	return result, nil
+}
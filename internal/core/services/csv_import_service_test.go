@@ -0,0 +1,246 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock CSVImportProfileRepository ---
+type MockCSVImportProfileRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.CSVImportProfileRepositoryFacade = (*MockCSVImportProfileRepository)(nil)
+
+func (m *MockCSVImportProfileRepository) FindCSVImportProfileByID(ctx context.Context, profileID string) (*domain.CSVImportProfile, error) {
+	args := m.Called(ctx, profileID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CSVImportProfile), args.Error(1)
+}
+
+func (m *MockCSVImportProfileRepository) ListCSVImportProfiles(ctx context.Context, workplaceID string, accountID string) ([]domain.CSVImportProfile, error) {
+	args := m.Called(ctx, workplaceID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CSVImportProfile), args.Error(1)
+}
+
+func (m *MockCSVImportProfileRepository) SaveCSVImportProfile(ctx context.Context, profile domain.CSVImportProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockCSVImportProfileRepository) UpdateCSVImportProfile(ctx context.Context, profile domain.CSVImportProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockCSVImportProfileRepository) DeleteCSVImportProfile(ctx context.Context, profileID string) error {
+	args := m.Called(ctx, profileID)
+	return args.Error(0)
+}
+
+// --- Mock BankStatementWriterSvc ---
+type MockBankStatementWriterSvc struct {
+	mock.Mock
+}
+
+var _ portssvc.BankStatementWriterSvc = (*MockBankStatementWriterSvc)(nil)
+
+func (m *MockBankStatementWriterSvc) ImportStatement(ctx context.Context, workplaceID string, req dto.ImportBankStatementRequest, userID string) (*domain.BankStatementImport, error) {
+	args := m.Called(ctx, workplaceID, req, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BankStatementImport), args.Error(1)
+}
+
+func (m *MockBankStatementWriterSvc) PostStatementLine(ctx context.Context, workplaceID string, lineID string, req dto.PostStatementLineRequest, userID string) (*domain.BankStatementLine, *domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, lineID, req, userID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.BankStatementLine), args.Get(1).(*domain.Journal), args.Error(2)
+}
+
+func (m *MockBankStatementWriterSvc) IgnoreStatementLine(ctx context.Context, workplaceID string, lineID string, userID string) (*domain.BankStatementLine, error) {
+	args := m.Called(ctx, workplaceID, lineID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BankStatementLine), args.Error(1)
+}
+
+func (m *MockBankStatementWriterSvc) RestoreStatementLine(ctx context.Context, workplaceID string, lineID string, userID string) (*domain.BankStatementLine, error) {
+	args := m.Called(ctx, workplaceID, lineID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.BankStatementLine), args.Error(1)
+}
+
+// --- Test Suite Setup ---
+type CSVImportServiceTestSuite struct {
+	suite.Suite
+	mockProfileRepo   *MockCSVImportProfileRepository
+	mockAccountRepo   *MockAccountRepositoryFacade
+	mockCurrencyRepo  *MockCurrencyRepository
+	mockStatementRepo *MockBankStatementRepository
+	mockStatementSvc  *MockBankStatementWriterSvc
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.CSVImportSvcFacade
+	workplaceID       string
+	userID            string
+	bankAccount       domain.Account
+	profile           domain.CSVImportProfile
+}
+
+func (suite *CSVImportServiceTestSuite) SetupTest() {
+	suite.mockProfileRepo = new(MockCSVImportProfileRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockStatementRepo = new(MockBankStatementRepository)
+	suite.mockStatementSvc = new(MockBankStatementWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewCSVImportService(suite.mockProfileRepo, suite.mockAccountRepo, suite.mockCurrencyRepo, suite.mockStatementRepo, suite.mockStatementSvc,
+		services.WithCSVImportWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccount = domain.Account{
+		AccountID:    uuid.NewString(),
+		WorkplaceID:  suite.workplaceID,
+		Name:         "Checking",
+		AccountType:  domain.Asset,
+		CurrencyCode: "USD",
+		IsActive:     true,
+	}
+	suite.profile = domain.CSVImportProfile{
+		ProfileID:        uuid.NewString(),
+		WorkplaceID:      suite.workplaceID,
+		AccountID:        suite.bankAccount.AccountID,
+		Name:             "My bank",
+		Delimiter:        ",",
+		HasHeader:        true,
+		DateColumn:       1,
+		DateFormat:       "YYYY-MM-DD",
+		AmountMode:       domain.CSVAmountInverted,
+		AmountColumn:     3,
+		DecimalSeparator: ".",
+		PayeeColumn:      2,
+	}
+}
+
+func TestCSVImportService(t *testing.T) {
+	suite.Run(t, new(CSVImportServiceTestSuite))
+}
+
+const testCSVFile = "Date,Payee,Amount\n" +
+	"2025-02-01,Grocer,12.30\n" +
+	"2025-02-02,Employer,-2000\n" +
+	"2025-02-03,Cafe,3.125\n" +
+	"2025-02-31,Broken,1.00\n"
+
+func (suite *CSVImportServiceTestSuite) expectReadCSV(ctx context.Context, role domain.UserWorkplaceRole) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, role).Return(nil).Once()
+	suite.mockProfileRepo.On("FindCSVImportProfileByID", ctx, suite.profile.ProfileID).Return(&suite.profile, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.bankAccount.AccountID).Return(&suite.bankAccount, nil).Once()
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Once()
+}
+
+func (suite *CSVImportServiceTestSuite) TestPreviewCSVImport_FlagsUnreadableAndImpreciseRows() {
+	ctx := context.Background()
+	suite.expectReadCSV(ctx, domain.RoleReadOnly)
+
+	preview, err := suite.service.PreviewCSVImport(ctx, suite.workplaceID, suite.profile.ProfileID, []byte(testCSVFile), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(2, preview.ValidRows)
+	suite.Equal(2, preview.InvalidRows)
+	suite.Require().Len(preview.Rows, 4)
+	suite.True(preview.Rows[0].Amount.Equal(decimal.RequireFromString("-12.30")), "INVERTED makes positive amounts money out")
+	suite.True(preview.Rows[1].Amount.Equal(decimal.RequireFromString("2000")))
+	suite.Contains(preview.Rows[2].Error, "decimal places")
+	suite.NotEmpty(preview.Rows[3].Error)
+	suite.mockStatementRepo.AssertNotCalled(suite.T(), "SaveStatementImport", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CSVImportServiceTestSuite) TestImportCSV_JournalModePostsNewLines() {
+	ctx := context.Background()
+	counterID := uuid.NewString()
+	suite.expectReadCSV(ctx, domain.RoleMember)
+
+	var staged []domain.BankStatementLine
+	suite.mockStatementRepo.On("SaveStatementImport", ctx, mock.AnythingOfType("domain.BankStatementImport"), mock.Anything).
+		Run(func(args mock.Arguments) { staged = args.Get(2).([]domain.BankStatementLine) }).
+		Return(&domain.BankStatementImport{ImportID: "imp", Format: "CSV", TotalLines: 2, NewLines: 2}, nil).Once()
+	suite.mockStatementRepo.On("ListStatementLinesByImport", ctx, "imp").Return([]domain.BankStatementLine{
+		{LineID: "line-1", BankReference: "A", Status: domain.StatementLinePending},
+		{LineID: "line-2", BankReference: "B", Status: domain.StatementLinePending},
+	}, nil).Once()
+	postReq := dto.PostStatementLineRequest{CounterAccountID: counterID}
+	suite.mockStatementSvc.On("PostStatementLine", ctx, suite.workplaceID, "line-1", postReq, suite.userID).
+		Return(&domain.BankStatementLine{}, &domain.Journal{}, nil).Once()
+	suite.mockStatementSvc.On("PostStatementLine", ctx, suite.workplaceID, "line-2", postReq, suite.userID).
+		Return(nil, nil, apperrors.ErrValidation).Once()
+
+	result, err := suite.service.ImportCSV(ctx, suite.workplaceID, suite.profile.ProfileID, dto.ImportCSVRequest{
+		Mode:             domain.CSVImportJournal,
+		CounterAccountID: counterID,
+		Data:             []byte(testCSVFile),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Len(staged, 2)
+	suite.Equal(2, result.SkippedRows)
+	suite.Equal(1, result.PostedLines)
+	suite.Require().Len(result.Failures, 1)
+	suite.Equal("B", result.Failures[0].BankReference)
+	suite.mockStatementSvc.AssertExpectations(suite.T())
+}
+
+func (suite *CSVImportServiceTestSuite) TestImportCSV_RejectsStatementAccountAsCounterAccount() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockProfileRepo.On("FindCSVImportProfileByID", ctx, suite.profile.ProfileID).Return(&suite.profile, nil).Once()
+
+	_, err := suite.service.ImportCSV(ctx, suite.workplaceID, suite.profile.ProfileID, dto.ImportCSVRequest{
+		Mode:             domain.CSVImportJournal,
+		CounterAccountID: suite.profile.AccountID,
+		Data:             []byte(testCSVFile),
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockStatementRepo.AssertNotCalled(suite.T(), "SaveStatementImport", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *CSVImportServiceTestSuite) TestCreateCSVImportProfile_RejectsIncompleteLayout() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.bankAccount.AccountID).Return(&suite.bankAccount, nil).Once()
+
+	_, err := suite.service.CreateCSVImportProfile(ctx, suite.workplaceID, dto.CreateCSVImportProfileRequest{
+		AccountID:    suite.bankAccount.AccountID,
+		Name:         "Broken",
+		DateColumn:   1,
+		DateFormat:   "DD/MM/YYYY",
+		AmountMode:   domain.CSVAmountDebitCredit,
+		AmountColumn: 2,
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockProfileRepo.AssertNotCalled(suite.T(), "SaveCSVImportProfile", mock.Anything, mock.Anything)
+}
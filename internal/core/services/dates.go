@@ -0,0 +1,13 @@
+package services
+
+import "time"
+
+// dateOnly truncates a date to midnight UTC
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// todayUTC returns the current date at midnight UTC
+func todayUTC() time.Time {
+	return dateOnly(time.Now().UTC())
+}
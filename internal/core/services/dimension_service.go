@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+)
+
+// dimensionService implements the DimensionSvcFacade interface
+type dimensionService struct {
+	BaseService
+	dimensionRepo portsrepo.DimensionRepositoryFacade
+}
+
+// DimensionServiceOption is a functional option for configuring the dimension service
+type DimensionServiceOption func(*dimensionService)
+
+// WithDimensionWorkplaceAuthorizer adds workplace authorizer dependency
+func WithDimensionWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) DimensionServiceOption {
+	return func(s *dimensionService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewDimensionService creates a new dimension service
+func NewDimensionService(dimensionRepo portsrepo.DimensionRepositoryFacade, options ...DimensionServiceOption) portssvc.DimensionSvcFacade {
+	svc := &dimensionService{
+		dimensionRepo: dimensionRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure dimensionService implements the DimensionSvcFacade interface
+var _ portssvc.DimensionSvcFacade = (*dimensionService)(nil)
+
+// resolveDimensionTags turns value IDs into tags on the dimensions of a workplace. Every value must be an active
+// value of an active dimension, and a dimension receives at most one value. Tags follow the order of dimensions.
+func resolveDimensionTags(dimensions []domain.Dimension, valueIDs []string) ([]domain.DimensionTag, error) {
+	tags := []domain.DimensionTag{}
+	if len(valueIDs) == 0 {
+		return tags, nil
+	}
+
+	requested := make(map[string]bool, len(valueIDs))
+	for _, valueID := range valueIDs {
+		requested[valueID] = true
+	}
+	for _, dimension := range dimensions {
+		var tag *domain.DimensionTag
+		for _, value := range dimension.Values {
+			if !requested[value.ValueID] {
+				continue
+			}
+			delete(requested, value.ValueID)
+			if !dimension.IsActive {
+				return nil, fmt.Errorf("%w: dimension %s is inactive", apperrors.ErrValidation, dimension.Code)
+			}
+			if !value.IsActive {
+				return nil, fmt.Errorf("%w: value %s of dimension %s is inactive", apperrors.ErrValidation, value.Code, dimension.Code)
+			}
+			if tag != nil {
+				return nil, fmt.Errorf("%w: only one value of dimension %s can be assigned", apperrors.ErrValidation, dimension.Code)
+			}
+			tag = &domain.DimensionTag{DimensionID: dimension.DimensionID, ValueID: value.ValueID}
+		}
+		if tag != nil {
+			tags = append(tags, *tag)
+		}
+	}
+	for _, valueID := range valueIDs {
+		if requested[valueID] {
+			return nil, fmt.Errorf("%w: dimension value %s not found", apperrors.ErrValidation, valueID)
+		}
+	}
+	return tags, nil
+}
+
+// findDimension loads a dimension and verifies that it belongs to the workplace
+func (s *dimensionService) findDimension(ctx context.Context, workplaceID string, dimensionID string) (*domain.Dimension, error) {
+	dimension, err := s.dimensionRepo.FindDimensionByID(ctx, dimensionID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find dimension by ID",
+			slog.String("dimension_id", dimensionID))
+		return nil, fmt.Errorf("failed to find dimension: %w", err)
+	}
+	if dimension.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Dimension found but belongs to different workplace",
+			slog.String("dimension_id", dimensionID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return dimension, nil
+}
+
+// findDimensionValue loads a dimension of the workplace and one of its values
+func (s *dimensionService) findDimensionValue(ctx context.Context, workplaceID string, dimensionID string, valueID string) (*domain.DimensionValue, error) {
+	dimension, err := s.findDimension(ctx, workplaceID, dimensionID)
+	if err != nil {
+		return nil, err
+	}
+	value, found := dimension.Value(valueID)
+	if !found {
+		return nil, apperrors.ErrNotFound
+	}
+	return &value, nil
+}
+
+// dimensionCode normalises a dimension or value code
+func dimensionCode(code string, what string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return "", fmt.Errorf("%w: %s code cannot be empty", apperrors.ErrValidation, what)
+	}
+	return code, nil
+}
+
+// buildDimension validates a dimension request and applies it to the dimension
+func buildDimension(dimension *domain.Dimension, req dto.DimensionRequest) error {
+	code, err := dimensionCode(req.Code, "dimension")
+	if err != nil {
+		return err
+	}
+	dimension.Code = code
+	dimension.Name = strings.TrimSpace(req.Name)
+	if dimension.Name == "" {
+		return fmt.Errorf("%w: dimension name cannot be empty", apperrors.ErrValidation)
+	}
+	dimension.Description = strings.TrimSpace(req.Description)
+	dimension.IsActive = req.IsActive == nil || *req.IsActive
+	return nil
+}
+
+// buildDimensionValue validates a dimension value request and applies it to the value
+func buildDimensionValue(value *domain.DimensionValue, req dto.DimensionValueRequest) error {
+	code, err := dimensionCode(req.Code, "value")
+	if err != nil {
+		return err
+	}
+	value.Code = code
+	value.Name = strings.TrimSpace(req.Name)
+	if value.Name == "" {
+		return fmt.Errorf("%w: value name cannot be empty", apperrors.ErrValidation)
+	}
+	value.IsActive = req.IsActive == nil || *req.IsActive
+	return nil
+}
+
+// saveDimensionError translates repository errors raised when saving a dimension or a value
+func saveDimensionError(err error, what string) error {
+	if errors.Is(err, apperrors.ErrDuplicate) {
+		return fmt.Errorf("%w: a %s with this code already exists", apperrors.ErrConflict, what)
+	}
+	return err
+}
+
+func (s *dimensionService) CreateDimension(ctx context.Context, workplaceID string, req dto.DimensionRequest, userID string) (*domain.Dimension, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create dimension",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	dimension := domain.Dimension{
+		DimensionID: uuid.NewString(),
+		WorkplaceID: workplaceID,
+		Values:      []domain.DimensionValue{},
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := buildDimension(&dimension, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.dimensionRepo.SaveDimension(ctx, dimension); err != nil {
+		s.LogError(ctx, err, "Failed to save dimension",
+			slog.String("dimension_id", dimension.DimensionID),
+			slog.String("workplace_id", workplaceID))
+		return nil, saveDimensionError(err, "dimension")
+	}
+
+	s.LogInfo(ctx, "Dimension created successfully",
+		slog.String("dimension_id", dimension.DimensionID),
+		slog.String("workplace_id", workplaceID))
+	return &dimension, nil
+}
+
+func (s *dimensionService) ListDimensions(ctx context.Context, workplaceID string, params dto.ListDimensionsParams, userID string) ([]domain.Dimension, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list dimensions",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	dimensions, err := s.dimensionRepo.ListDimensions(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list dimensions",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if params.IncludeInactive {
+		return dimensions, nil
+	}
+
+	active := make([]domain.Dimension, 0, len(dimensions))
+	for _, dimension := range dimensions {
+		if !dimension.IsActive {
+			continue
+		}
+		values := make([]domain.DimensionValue, 0, len(dimension.Values))
+		for _, value := range dimension.Values {
+			if value.IsActive {
+				values = append(values, value)
+			}
+		}
+		dimension.Values = values
+		active = append(active, dimension)
+	}
+	return active, nil
+}
+
+func (s *dimensionService) GetDimension(ctx context.Context, workplaceID string, dimensionID string, userID string) (*domain.Dimension, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view dimension",
+			slog.String("workplace_id", workplaceID),
+			slog.String("dimension_id", dimensionID))
+		return nil, err
+	}
+	return s.findDimension(ctx, workplaceID, dimensionID)
+}
+
+func (s *dimensionService) UpdateDimension(ctx context.Context, workplaceID string, dimensionID string, req dto.DimensionRequest, userID string) (*domain.Dimension, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update dimension",
+			slog.String("workplace_id", workplaceID),
+			slog.String("dimension_id", dimensionID))
+		return nil, err
+	}
+
+	dimension, err := s.findDimension(ctx, workplaceID, dimensionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := buildDimension(dimension, req); err != nil {
+		return nil, err
+	}
+	dimension.LastUpdatedAt = time.Now()
+	dimension.LastUpdatedBy = userID
+
+	if err := s.dimensionRepo.UpdateDimension(ctx, *dimension); err != nil {
+		s.LogError(ctx, err, "Failed to update dimension",
+			slog.String("dimension_id", dimensionID))
+		return nil, saveDimensionError(err, "dimension")
+	}
+
+	s.LogInfo(ctx, "Dimension updated successfully",
+		slog.String("dimension_id", dimensionID),
+		slog.String("workplace_id", workplaceID))
+	return dimension, nil
+}
+
+func (s *dimensionService) DeleteDimension(ctx context.Context, workplaceID string, dimensionID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete dimension",
+			slog.String("workplace_id", workplaceID),
+			slog.String("dimension_id", dimensionID))
+		return err
+	}
+
+	if _, err := s.findDimension(ctx, workplaceID, dimensionID); err != nil {
+		return err
+	}
+	if err := s.dimensionRepo.DeleteDimension(ctx, dimensionID); err != nil {
+		s.LogError(ctx, err, "Failed to delete dimension",
+			slog.String("dimension_id", dimensionID))
+		if errors.Is(err, apperrors.ErrConflict) {
+			return fmt.Errorf("%w: values of the dimension are assigned to journals; deactivate it instead", apperrors.ErrConflict)
+		}
+		return err
+	}
+
+	s.LogInfo(ctx, "Dimension deleted successfully",
+		slog.String("dimension_id", dimensionID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+func (s *dimensionService) CreateDimensionValue(ctx context.Context, workplaceID string, dimensionID string, req dto.DimensionValueRequest, userID string) (*domain.DimensionValue, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create dimension value",
+			slog.String("workplace_id", workplaceID),
+			slog.String("dimension_id", dimensionID))
+		return nil, err
+	}
+
+	if _, err := s.findDimension(ctx, workplaceID, dimensionID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	value := domain.DimensionValue{
+		ValueID:     uuid.NewString(),
+		DimensionID: dimensionID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := buildDimensionValue(&value, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.dimensionRepo.SaveDimensionValue(ctx, value); err != nil {
+		s.LogError(ctx, err, "Failed to save dimension value",
+			slog.String("value_id", value.ValueID),
+			slog.String("dimension_id", dimensionID))
+		return nil, saveDimensionError(err, "value")
+	}
+
+	s.LogInfo(ctx, "Dimension value created successfully",
+		slog.String("value_id", value.ValueID),
+		slog.String("dimension_id", dimensionID))
+	return &value, nil
+}
+
+func (s *dimensionService) UpdateDimensionValue(ctx context.Context, workplaceID string, dimensionID string, valueID string, req dto.DimensionValueRequest, userID string) (*domain.DimensionValue, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update dimension value",
+			slog.String("workplace_id", workplaceID),
+			slog.String("value_id", valueID))
+		return nil, err
+	}
+
+	value, err := s.findDimensionValue(ctx, workplaceID, dimensionID, valueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := buildDimensionValue(value, req); err != nil {
+		return nil, err
+	}
+	value.LastUpdatedAt = time.Now()
+	value.LastUpdatedBy = userID
+
+	if err := s.dimensionRepo.UpdateDimensionValue(ctx, *value); err != nil {
+		s.LogError(ctx, err, "Failed to update dimension value",
+			slog.String("value_id", valueID))
+		return nil, saveDimensionError(err, "value")
+	}
+
+	s.LogInfo(ctx, "Dimension value updated successfully",
+		slog.String("value_id", valueID),
+		slog.String("dimension_id", dimensionID))
+	return value, nil
+}
+
+func (s *dimensionService) DeleteDimensionValue(ctx context.Context, workplaceID string, dimensionID string, valueID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete dimension value",
+			slog.String("workplace_id", workplaceID),
+			slog.String("value_id", valueID))
+		return err
+	}
+
+	if _, err := s.findDimensionValue(ctx, workplaceID, dimensionID, valueID); err != nil {
+		return err
+	}
+	if err := s.dimensionRepo.DeleteDimensionValue(ctx, valueID); err != nil {
+		s.LogError(ctx, err, "Failed to delete dimension value",
+			slog.String("value_id", valueID))
+		if errors.Is(err, apperrors.ErrConflict) {
+			return fmt.Errorf("%w: value is assigned to journals; deactivate it instead", apperrors.ErrConflict)
+		}
+		return err
+	}
+
+	s.LogInfo(ctx, "Dimension value deleted successfully",
+		slog.String("value_id", valueID),
+		slog.String("dimension_id", dimensionID))
+	return nil
+}
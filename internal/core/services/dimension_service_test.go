@@ -0,0 +1,463 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock DimensionRepository ---
+type MockDimensionRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.DimensionRepositoryFacade = (*MockDimensionRepository)(nil)
+
+func (m *MockDimensionRepository) FindDimensionByID(ctx context.Context, dimensionID string) (*domain.Dimension, error) {
+	args := m.Called(ctx, dimensionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Dimension), args.Error(1)
+}
+
+func (m *MockDimensionRepository) ListDimensions(ctx context.Context, workplaceID string) ([]domain.Dimension, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Dimension), args.Error(1)
+}
+
+func (m *MockDimensionRepository) FindJournalDimensions(ctx context.Context, journalID string) ([]domain.DimensionTag, map[string][]domain.DimensionTag, error) {
+	args := m.Called(ctx, journalID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]domain.DimensionTag), args.Get(1).(map[string][]domain.DimensionTag), args.Error(2)
+}
+
+func (m *MockDimensionRepository) SaveDimension(ctx context.Context, dimension domain.Dimension) error {
+	args := m.Called(ctx, dimension)
+	return args.Error(0)
+}
+
+func (m *MockDimensionRepository) UpdateDimension(ctx context.Context, dimension domain.Dimension) error {
+	args := m.Called(ctx, dimension)
+	return args.Error(0)
+}
+
+func (m *MockDimensionRepository) DeleteDimension(ctx context.Context, dimensionID string) error {
+	args := m.Called(ctx, dimensionID)
+	return args.Error(0)
+}
+
+func (m *MockDimensionRepository) SaveDimensionValue(ctx context.Context, value domain.DimensionValue) error {
+	args := m.Called(ctx, value)
+	return args.Error(0)
+}
+
+func (m *MockDimensionRepository) UpdateDimensionValue(ctx context.Context, value domain.DimensionValue) error {
+	args := m.Called(ctx, value)
+	return args.Error(0)
+}
+
+func (m *MockDimensionRepository) DeleteDimensionValue(ctx context.Context, valueID string) error {
+	args := m.Called(ctx, valueID)
+	return args.Error(0)
+}
+
+func (m *MockDimensionRepository) ReplaceJournalDimensions(ctx context.Context, journalID string, journalTags []domain.DimensionTag, lineTags map[string][]domain.DimensionTag) error {
+	args := m.Called(ctx, journalID, journalTags, lineTags)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type DimensionServiceTestSuite struct {
+	suite.Suite
+	mockDimensionRepo *MockDimensionRepository
+	mockJournalRepo   *MockJournalRepository
+	mockReportingRepo *MockReportingRepository
+	mockAccountSvc    *MockAccountService2
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.DimensionSvcFacade
+	journalService    portssvc.JournalSvcFacade
+	reportingService  portssvc.ReportingService
+	workplaceID       string
+	userID            string
+	bankAccount       domain.Account
+	expenseAccount    domain.Account
+	project           domain.Dimension
+	costCenter        domain.Dimension
+}
+
+func (suite *DimensionServiceTestSuite) SetupTest() {
+	suite.mockDimensionRepo = new(MockDimensionRepository)
+	suite.mockJournalRepo = new(MockJournalRepository)
+	suite.mockReportingRepo = new(MockReportingRepository)
+	suite.mockAccountSvc = new(MockAccountService2)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewDimensionService(suite.mockDimensionRepo,
+		services.WithDimensionWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.journalService = services.NewJournalService(suite.mockJournalRepo, suite.mockAccountSvc, suite.mockWorkplaceSvc,
+		services.WithJournalDimensions(suite.mockDimensionRepo))
+	suite.reportingService = services.NewReportingService(suite.mockReportingRepo,
+		services.WithReportingWorkplaceAuthorizer(suite.mockWorkplaceSvc),
+		services.WithReportingDimensions(suite.mockDimensionRepo))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.expenseAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Expense, CurrencyCode: "USD", IsActive: true}
+	suite.project = suite.dimension("PROJECT", "ALPHA", "BETA")
+	suite.costCenter = suite.dimension("CC", "SALES", "OPS")
+}
+
+func TestDimensionService(t *testing.T) {
+	suite.Run(t, new(DimensionServiceTestSuite))
+}
+
+// dimension returns an active dimension of the workplace with one active value per code
+func (suite *DimensionServiceTestSuite) dimension(code string, valueCodes ...string) domain.Dimension {
+	dimension := domain.Dimension{DimensionID: uuid.NewString(), WorkplaceID: suite.workplaceID, Code: code, Name: code, IsActive: true}
+	for _, valueCode := range valueCodes {
+		dimension.Values = append(dimension.Values, domain.DimensionValue{
+			ValueID: uuid.NewString(), DimensionID: dimension.DimensionID, Code: valueCode, Name: valueCode, IsActive: true,
+		})
+	}
+	return dimension
+}
+
+func (suite *DimensionServiceTestSuite) dimensions() []domain.Dimension {
+	return []domain.Dimension{suite.costCenter, suite.project}
+}
+
+// expectCreateJournal sets up the authorization and account lookups of a journal creation
+func (suite *DimensionServiceTestSuite) expectCreateJournal(ctx context.Context) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.bankAccount.AccountID:    suite.bankAccount,
+		suite.expenseAccount.AccountID: suite.expenseAccount,
+	}, nil).Once()
+}
+
+// taggedRequest returns an expense paid from the bank, with the given values on the journal and on the expense line
+func (suite *DimensionServiceTestSuite) taggedRequest(journalValueIDs []string, lineValueIDs []string) dto.CreateJournalRequest {
+	return dto.CreateJournalRequest{
+		Date:              time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC),
+		Description:       "Tagged expense",
+		CurrencyCode:      "USD",
+		DimensionValueIDs: journalValueIDs,
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: suite.bankAccount.AccountID, Amount: decimal.NewFromInt(80), TransactionType: domain.Credit},
+			{AccountID: suite.expenseAccount.AccountID, Amount: decimal.NewFromInt(80), TransactionType: domain.Debit, DimensionValueIDs: lineValueIDs},
+		},
+	}
+}
+
+// postedJournal returns a posted journal of the workplace with a bank and an expense line
+func (suite *DimensionServiceTestSuite) postedJournal(status domain.JournalStatus) (*domain.Journal, []domain.Transaction) {
+	journal := &domain.Journal{JournalID: uuid.NewString(), WorkplaceID: suite.workplaceID, CurrencyCode: "USD", Status: status}
+	transactions := []domain.Transaction{
+		{TransactionID: uuid.NewString(), AccountID: suite.bankAccount.AccountID, Amount: decimal.NewFromInt(80), TransactionType: domain.Credit},
+		{TransactionID: uuid.NewString(), AccountID: suite.expenseAccount.AccountID, Amount: decimal.NewFromInt(80), TransactionType: domain.Debit},
+	}
+	return journal, transactions
+}
+
+func (suite *DimensionServiceTestSuite) expectGetJournal(ctx context.Context, journal *domain.Journal, transactions []domain.Transaction, journalTags []domain.DimensionTag) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockJournalRepo.On("FindJournalByID", ctx, journal.JournalID).Return(journal, nil).Once()
+	suite.mockJournalRepo.On("FindTransactionsByJournalID", ctx, journal.JournalID).Return(transactions, nil).Once()
+	suite.mockDimensionRepo.On("FindJournalDimensions", ctx, journal.JournalID).
+		Return(journalTags, map[string][]domain.DimensionTag{}, nil).Once()
+}
+
+// --- Test Cases ---
+
+func (suite *DimensionServiceTestSuite) TestCreateDimension_NormalizesCode() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDimensionRepo.On("SaveDimension", ctx, mock.MatchedBy(func(d domain.Dimension) bool {
+		return d.Code == "PROJECT" && d.Name == "Projects" && d.IsActive && d.WorkplaceID == suite.workplaceID
+	})).Return(nil).Once()
+
+	dimension, err := suite.service.CreateDimension(ctx, suite.workplaceID, dto.DimensionRequest{Code: " project ", Name: "Projects"}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("PROJECT", dimension.Code)
+	suite.NotNil(dimension.Values)
+	suite.mockDimensionRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DimensionServiceTestSuite) TestCreateDimension_DuplicateCode() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDimensionRepo.On("SaveDimension", ctx, mock.Anything).Return(apperrors.ErrDuplicate).Once()
+
+	_, err := suite.service.CreateDimension(ctx, suite.workplaceID, dto.DimensionRequest{Code: "CC", Name: "Cost centers"}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+func (suite *DimensionServiceTestSuite) TestListDimensions_HidesInactive() {
+	ctx := context.Background()
+	retired := suite.dimension("OLD", "X")
+	retired.IsActive = false
+	project := suite.project
+	project.Values = append([]domain.DimensionValue{}, project.Values...)
+	project.Values[1].IsActive = false
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Twice()
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return([]domain.Dimension{retired, project}, nil).Twice()
+
+	active, err := suite.service.ListDimensions(ctx, suite.workplaceID, dto.ListDimensionsParams{}, suite.userID)
+	suite.Require().NoError(err)
+	suite.Require().Len(active, 1)
+	suite.Equal("PROJECT", active[0].Code)
+	suite.Len(active[0].Values, 1)
+
+	all, err := suite.service.ListDimensions(ctx, suite.workplaceID, dto.ListDimensionsParams{IncludeInactive: true}, suite.userID)
+	suite.Require().NoError(err)
+	suite.Len(all, 2)
+	suite.Len(all[1].Values, 2)
+}
+
+func (suite *DimensionServiceTestSuite) TestDeleteDimensionValue_InUse() {
+	ctx := context.Background()
+	valueID := suite.project.Values[0].ValueID
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDimensionRepo.On("FindDimensionByID", ctx, suite.project.DimensionID).Return(&suite.project, nil).Once()
+	suite.mockDimensionRepo.On("DeleteDimensionValue", ctx, valueID).Return(apperrors.ErrConflict).Once()
+
+	err := suite.service.DeleteDimensionValue(ctx, suite.workplaceID, suite.project.DimensionID, valueID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+func (suite *DimensionServiceTestSuite) TestUpdateDimensionValue_OtherWorkplace() {
+	ctx := context.Background()
+	foreign := suite.project
+	foreign.WorkplaceID = uuid.NewString()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDimensionRepo.On("FindDimensionByID", ctx, foreign.DimensionID).Return(&foreign, nil).Once()
+
+	_, err := suite.service.UpdateDimensionValue(ctx, suite.workplaceID, foreign.DimensionID, foreign.Values[0].ValueID,
+		dto.DimensionValueRequest{Code: "ALPHA", Name: "Alpha"}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrNotFound)
+	suite.mockDimensionRepo.AssertNotCalled(suite.T(), "UpdateDimensionValue", mock.Anything, mock.Anything)
+}
+
+func (suite *DimensionServiceTestSuite) TestCreateJournal_TagsJournalAndLines() {
+	ctx := context.Background()
+	sales := suite.costCenter.Values[0]
+	alpha := suite.project.Values[0]
+	ops := suite.costCenter.Values[1]
+	suite.expectCreateJournal(ctx)
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return(suite.dimensions(), nil).Once()
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.MatchedBy(func(j domain.Journal) bool {
+		return len(j.Dimensions) == 2 && j.Dimensions[0].ValueID == sales.ValueID && j.Dimensions[1].ValueID == alpha.ValueID
+	}), mock.MatchedBy(func(txns []domain.Transaction) bool {
+		return len(txns) == 2 && len(txns[0].Dimensions) == 0 &&
+			len(txns[1].Dimensions) == 1 && txns[1].Dimensions[0] == domain.DimensionTag{DimensionID: suite.costCenter.DimensionID, ValueID: ops.ValueID}
+	}), mock.Anything).Return(nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taggedRequest([]string{alpha.ValueID, sales.ValueID}, []string{ops.ValueID}), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.mockJournalRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DimensionServiceTestSuite) TestCreateJournal_RejectsTwoValuesOfOneDimension() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return(suite.dimensions(), nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taggedRequest([]string{suite.project.Values[0].ValueID, suite.project.Values[1].ValueID}, nil), suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DimensionServiceTestSuite) TestCreateJournal_RejectsInactiveValue() {
+	ctx := context.Background()
+	project := suite.project
+	project.Values = append([]domain.DimensionValue{}, project.Values...)
+	project.Values[0].IsActive = false
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return([]domain.Dimension{project}, nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taggedRequest(nil, []string{project.Values[0].ValueID}), suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+func (suite *DimensionServiceTestSuite) TestUpdateJournalDimensions_RetagsPostedJournal() {
+	ctx := context.Background()
+	journal, transactions := suite.postedJournal(domain.Posted)
+	alpha := suite.project.Values[0]
+	beta := suite.project.Values[1]
+	journalTags := []domain.DimensionTag{{DimensionID: suite.project.DimensionID, ValueID: alpha.ValueID}}
+	suite.expectGetJournal(ctx, journal, transactions, journalTags)
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return(suite.dimensions(), nil).Once()
+	expenseLine := transactions[1].TransactionID
+	suite.mockDimensionRepo.On("ReplaceJournalDimensions", ctx, journal.JournalID, journalTags, map[string][]domain.DimensionTag{
+		expenseLine: {{DimensionID: suite.project.DimensionID, ValueID: beta.ValueID}},
+	}).Return(nil).Once()
+
+	updated, err := suite.journalService.UpdateJournalDimensions(ctx, suite.workplaceID, journal.JournalID, dto.UpdateJournalDimensionsRequest{
+		Transactions: []dto.UpdateTransactionDimensionsRequest{{TransactionID: expenseLine, DimensionValueIDs: []string{beta.ValueID}}},
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(journalTags, updated.Dimensions, "journal values are kept when not supplied")
+	suite.Equal(beta.ValueID, updated.Transactions[1].Dimensions[0].ValueID)
+	suite.True(updated.Transactions[1].Amount.Equal(decimal.NewFromInt(80)))
+	suite.mockDimensionRepo.AssertExpectations(suite.T())
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DimensionServiceTestSuite) TestUpdateJournalDimensions_ForeignLine() {
+	ctx := context.Background()
+	journal, transactions := suite.postedJournal(domain.Posted)
+	suite.expectGetJournal(ctx, journal, transactions, []domain.DimensionTag{})
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return(suite.dimensions(), nil).Once()
+
+	_, err := suite.journalService.UpdateJournalDimensions(ctx, suite.workplaceID, journal.JournalID, dto.UpdateJournalDimensionsRequest{
+		Transactions: []dto.UpdateTransactionDimensionsRequest{{TransactionID: uuid.NewString()}},
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockDimensionRepo.AssertNotCalled(suite.T(), "ReplaceJournalDimensions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DimensionServiceTestSuite) TestUpdateJournalDimensions_ReversedJournal() {
+	ctx := context.Background()
+	journal, transactions := suite.postedJournal(domain.Reversed)
+	suite.expectGetJournal(ctx, journal, transactions, []domain.DimensionTag{})
+	cleared := []string{}
+
+	_, err := suite.journalService.UpdateJournalDimensions(ctx, suite.workplaceID, journal.JournalID,
+		dto.UpdateJournalDimensionsRequest{DimensionValueIDs: &cleared}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.ErrorIs(err, services.ErrNotPosted)
+}
+
+func (suite *DimensionServiceTestSuite) TestReverseJournal_CopiesDimensionTags() {
+	ctx := context.Background()
+	sales := suite.costCenter.Values[0]
+	alpha := suite.project.Values[0]
+	original, transactions := suite.postedJournal(domain.Posted)
+	journalTags := []domain.DimensionTag{{DimensionID: suite.costCenter.DimensionID, ValueID: sales.ValueID}}
+	lineTags := map[string][]domain.DimensionTag{
+		transactions[1].TransactionID: {{DimensionID: suite.project.DimensionID, ValueID: alpha.ValueID}},
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockJournalRepo.On("FindJournalByID", ctx, original.JournalID).Return(original, nil).Once()
+	suite.mockJournalRepo.On("FindTransactionsByJournalID", ctx, original.JournalID).Return(transactions, nil).Once()
+	suite.mockDimensionRepo.On("FindJournalDimensions", ctx, original.JournalID).Return(journalTags, lineTags, nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.bankAccount.AccountID:    suite.bankAccount,
+		suite.expenseAccount.AccountID: suite.expenseAccount,
+	}, nil).Once()
+	var reversal domain.Journal
+	var reversalLines []domain.Transaction
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		reversal = args.Get(1).(domain.Journal)
+		reversalLines = args.Get(2).([]domain.Transaction)
+	}).Return(nil).Once()
+	suite.mockJournalRepo.On("UpdateJournalStatusAndLinks", ctx, original.JournalID, domain.Reversed, mock.Anything, mock.Anything, suite.userID, mock.Anything).Return(nil).Once()
+
+	_, err := suite.journalService.ReverseJournal(ctx, suite.workplaceID, original.JournalID, suite.userID)
+	suite.Require().NoError(err)
+
+	suite.Equal(journalTags, reversal.Dimensions)
+	suite.Require().Len(reversalLines, len(transactions))
+	for i, line := range reversalLines {
+		suite.Equal(transactions[i].AccountID, line.AccountID)
+		suite.Equal(lineTags[transactions[i].TransactionID], line.Dimensions)
+	}
+	suite.Empty(reversalLines[0].Dimensions, "lines without their own values stay untagged")
+}
+
+func (suite *DimensionServiceTestSuite) TestGroups_SplitsByValueAndUntagged() {
+	alpha := suite.project.Values[0]
+	sales := suite.costCenter.Values[0]
+	filters := []domain.DimensionFilter{
+		{DimensionID: suite.costCenter.DimensionID, ValueIDs: []string{sales.ValueID}},
+		{DimensionID: suite.project.DimensionID, ValueIDs: []string{alpha.ValueID, domain.UntaggedValueID}},
+	}
+
+	groups := suite.project.Groups(filters)
+
+	suite.Require().Len(groups, 2, "the filter on the grouped dimension limits the groups")
+	suite.Equal(alpha.ValueID, groups[0].ValueID)
+	suite.Equal(domain.UntaggedValueID, groups[1].ValueID)
+	suite.Equal("Untagged", groups[1].Name)
+	for _, group := range groups {
+		suite.Require().Len(group.Filters, 2)
+		suite.Equal(filters[0], group.Filters[0], "filters on other dimensions apply to every group")
+		suite.Equal([]string{group.ValueID}, group.Filters[1].ValueIDs)
+	}
+
+	suite.Len(suite.project.Groups(nil), 3, "every value plus the untagged lines")
+}
+
+func (suite *DimensionServiceTestSuite) TestReportDimensions_BuildsFiltersAndGroups() {
+	ctx := context.Background()
+	alpha := suite.project.Values[0]
+	beta := suite.project.Values[1]
+	ops := suite.costCenter.Values[1]
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return(suite.dimensions(), nil).Once()
+
+	dimensions, err := suite.reportingService.ReportDimensions(ctx, suite.workplaceID,
+		[]string{beta.ValueID, ops.ValueID, alpha.ValueID}, suite.costCenter.DimensionID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal([]domain.DimensionFilter{
+		{DimensionID: suite.costCenter.DimensionID, ValueIDs: []string{ops.ValueID}},
+		{DimensionID: suite.project.DimensionID, ValueIDs: []string{alpha.ValueID, beta.ValueID}},
+	}, dimensions.Filters, "values of one dimension are combined into one filter")
+	suite.Require().NotNil(dimensions.GroupBy)
+	suite.Require().Len(dimensions.Groups, 1)
+	suite.Equal(ops.ValueID, dimensions.Groups[0].ValueID)
+}
+
+func (suite *DimensionServiceTestSuite) TestReportDimensions_UnknownValue() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockDimensionRepo.On("ListDimensions", ctx, suite.workplaceID).Return(suite.dimensions(), nil).Once()
+
+	_, err := suite.reportingService.ReportDimensions(ctx, suite.workplaceID, []string{uuid.NewString()}, "", suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+func (suite *DimensionServiceTestSuite) TestTrialBalance_PassesFilters() {
+	ctx := context.Background()
+	asOf := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	filters := []domain.DimensionFilter{{DimensionID: suite.project.DimensionID, ValueIDs: []string{suite.project.Values[0].ValueID}}}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetTrialBalanceData", ctx, suite.workplaceID, asOf, filters).Return([]domain.TrialBalanceRow{}, nil).Once()
+
+	_, err := suite.reportingService.TrialBalance(ctx, suite.workplaceID, asOf, filters, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.mockReportingRepo.AssertExpectations(suite.T())
+}
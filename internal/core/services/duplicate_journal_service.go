@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultDuplicateWindowDays is how far apart two journals may be dated and still be flagged as duplicates
+	DefaultDuplicateWindowDays = 3
+	// duplicateSimilarityThreshold is the minimum description similarity for a pair to be flagged
+	duplicateSimilarityThreshold = 0.6
+)
+
+// duplicateJournalService implements the DuplicateJournalSvcFacade interface
+type duplicateJournalService struct {
+	BaseService
+	duplicateRepo portsrepo.DuplicateJournalRepositoryFacade
+	journalSvc    portssvc.JournalSvcFacade
+}
+
+// DuplicateJournalServiceOption is a functional option for configuring the duplicate journal service
+type DuplicateJournalServiceOption func(*duplicateJournalService)
+
+// WithDuplicateJournalWorkplaceAuthorizer adds workplace authorizer dependency
+func WithDuplicateJournalWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) DuplicateJournalServiceOption {
+	return func(s *duplicateJournalService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewDuplicateJournalService creates a new service for reviewing suspected duplicate journals. Pairs are flagged
+// by the journal service; confirming one reverses a journal through it.
+func NewDuplicateJournalService(duplicateRepo portsrepo.DuplicateJournalRepositoryFacade, journalSvc portssvc.JournalSvcFacade, options ...DuplicateJournalServiceOption) portssvc.DuplicateJournalSvcFacade {
+	svc := &duplicateJournalService{
+		duplicateRepo: duplicateRepo,
+		journalSvc:    journalSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure duplicateJournalService implements the DuplicateJournalSvcFacade interface
+var _ portssvc.DuplicateJournalSvcFacade = (*duplicateJournalService)(nil)
+
+// journalLineSignature identifies the accounts, sides and amounts of a journal regardless of line order
+func journalLineSignature(transactions []domain.Transaction) string {
+	lines := make([]string, 0, len(transactions))
+	for _, txn := range transactions {
+		lines = append(lines, txn.AccountID+"|"+string(txn.TransactionType)+"|"+txn.Amount.String())
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, ";")
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// descriptionSimilarity scores two descriptions between 0 and 1. It takes the better of the edit-distance ratio,
+// which tolerates typos, and the token overlap, which tolerates one description extending the other
+// (e.g. "Coffee shop" and "COFFEE SHOP LONDON 1234").
+func descriptionSimilarity(a, b string) float64 {
+	a, b = domain.NormalizePayee(a), domain.NormalizePayee(b)
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	editRatio := 1 - float64(levenshtein(ra, rb))/float64(max(len(ra), len(rb)))
+
+	tokensA := make(map[string]bool)
+	for _, token := range strings.Fields(a) {
+		tokensA[token] = true
+	}
+	tokensB := make(map[string]bool)
+	for _, token := range strings.Fields(b) {
+		tokensB[token] = true
+	}
+	shared := 0
+	for token := range tokensA {
+		if tokensB[token] {
+			shared++
+		}
+	}
+	overlap := float64(shared) / float64(min(len(tokensA), len(tokensB)))
+
+	return math.Round(max(editRatio, overlap)*100) / 100
+}
+
+// detectDuplicateJournals flags the journals of the workplace that look like the new journal: same accounts,
+// sides and amounts, dated within windowDays and with a similar description. Flagged pairs are saved as PENDING.
+func detectDuplicateJournals(ctx context.Context, duplicateRepo portsrepo.DuplicateJournalRepositoryFacade, transactionRepo portsrepo.TransactionReader, windowDays int, journal domain.Journal, transactions []domain.Transaction) ([]domain.DuplicateJournalPair, error) {
+	window := time.Duration(windowDays) * 24 * time.Hour
+	candidates, err := duplicateRepo.FindDuplicateCandidates(ctx, journal.WorkplaceID, journal.JournalID, journal.CurrencyCode,
+		journal.Amount, journal.JournalDate.Add(-window), journal.JournalDate.Add(window))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	candidateIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidateIDs = append(candidateIDs, candidate.JournalID)
+	}
+	candidateTransactions, err := transactionRepo.FindTransactionsByJournalIDs(ctx, candidateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load duplicate candidate transactions: %w", err)
+	}
+
+	signature := journalLineSignature(transactions)
+	pairs := make([]domain.DuplicateJournalPair, 0)
+	for _, candidate := range candidates {
+		if journalLineSignature(candidateTransactions[candidate.JournalID]) != signature {
+			continue
+		}
+		similarity := descriptionSimilarity(journal.Description, candidate.Description)
+		if similarity < duplicateSimilarityThreshold {
+			continue
+		}
+		pairs = append(pairs, domain.DuplicateJournalPair{
+			PairID:               uuid.NewString(),
+			WorkplaceID:          journal.WorkplaceID,
+			JournalID:            journal.JournalID,
+			DuplicateOfJournalID: candidate.JournalID,
+			Similarity:           similarity,
+			Status:               domain.DuplicatePending,
+			AuditFields: domain.AuditFields{
+				CreatedAt:     journal.CreatedAt,
+				CreatedBy:     journal.CreatedBy,
+				LastUpdatedAt: journal.CreatedAt,
+				LastUpdatedBy: journal.CreatedBy,
+			},
+			DuplicateOf: &domain.DuplicateJournalInfo{
+				JournalID:    candidate.JournalID,
+				JournalDate:  candidate.JournalDate,
+				Description:  candidate.Description,
+				CurrencyCode: candidate.CurrencyCode,
+				Amount:       candidate.Amount,
+				Status:       candidate.Status,
+			},
+		})
+	}
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	if err := duplicateRepo.SaveDuplicatePairs(ctx, pairs); err != nil {
+		return nil, fmt.Errorf("failed to save duplicate pairs: %w", err)
+	}
+	return pairs, nil
+}
+
+// findPair loads a pair and verifies that it belongs to the workplace
+func (s *duplicateJournalService) findPair(ctx context.Context, workplaceID string, pairID string) (*domain.DuplicateJournalPair, error) {
+	pair, err := s.duplicateRepo.FindDuplicatePairByID(ctx, pairID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find duplicate pair by ID",
+			slog.String("pair_id", pairID))
+		return nil, fmt.Errorf("failed to find duplicate pair: %w", err)
+	}
+	if pair.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Duplicate pair found but belongs to different workplace",
+			slog.String("pair_id", pairID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return pair, nil
+}
+
+// resolve records the review decision of a pending pair
+func (s *duplicateJournalService) resolve(ctx context.Context, pair *domain.DuplicateJournalPair, status domain.DuplicatePairStatus, note string, userID string) error {
+	now := time.Now()
+	pair.Status = status
+	pair.ResolutionNote = strings.TrimSpace(note)
+	pair.ResolvedAt = &now
+	pair.ResolvedBy = userID
+	pair.LastUpdatedAt = now
+	pair.LastUpdatedBy = userID
+	if err := s.duplicateRepo.ResolveDuplicatePair(ctx, *pair); err != nil {
+		s.LogError(ctx, err, "Failed to resolve duplicate pair",
+			slog.String("pair_id", pair.PairID),
+			slog.String("status", string(status)))
+		return err
+	}
+	return nil
+}
+
+// isReversed reports whether a journal has been reversed
+func (s *duplicateJournalService) isReversed(ctx context.Context, workplaceID string, journalID string, userID string) bool {
+	journal, err := s.journalSvc.GetJournalByID(ctx, workplaceID, journalID, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load journal of duplicate pair",
+			slog.String("journal_id", journalID))
+		return false
+	}
+	return journal.Status == domain.Reversed
+}
+
+func (s *duplicateJournalService) ListDuplicatePairs(ctx context.Context, workplaceID string, params dto.ListDuplicatePairsParams, userID string) ([]domain.DuplicateJournalPair, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list duplicate pairs",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	pairs, err := s.duplicateRepo.ListDuplicatePairs(ctx, workplaceID, params.Status)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list duplicate pairs",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return pairs, nil
+}
+
+func (s *duplicateJournalService) GetDuplicatePair(ctx context.Context, workplaceID string, pairID string, userID string) (*domain.DuplicateJournalPair, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view duplicate pair",
+			slog.String("workplace_id", workplaceID),
+			slog.String("pair_id", pairID))
+		return nil, err
+	}
+	return s.findPair(ctx, workplaceID, pairID)
+}
+
+func (s *duplicateJournalService) ConfirmDuplicatePair(ctx context.Context, workplaceID string, pairID string, req dto.ConfirmDuplicatePairRequest, userID string) (*domain.DuplicateJournalPair, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to confirm duplicate pair",
+			slog.String("workplace_id", workplaceID),
+			slog.String("pair_id", pairID))
+		return nil, err
+	}
+
+	pair, err := s.findPair(ctx, workplaceID, pairID)
+	if err != nil {
+		return nil, err
+	}
+	if pair.Status != domain.DuplicatePending {
+		return nil, fmt.Errorf("%w: duplicate pair %s is already %s", apperrors.ErrConflict, pairID, pair.Status)
+	}
+
+	reverseID := req.ReverseJournalID
+	if reverseID == "" {
+		reverseID = pair.JournalID
+	}
+	if reverseID != pair.JournalID && reverseID != pair.DuplicateOfJournalID {
+		return nil, fmt.Errorf("%w: journal %s is not part of duplicate pair %s", apperrors.ErrValidation, reverseID, pairID)
+	}
+
+	if _, err := s.journalSvc.ReverseJournal(ctx, workplaceID, reverseID, userID); err != nil {
+		// A journal that is already reversed, e.g. by an earlier confirmation whose decision failed to save,
+		// still confirms the pair so that the review does not stay stuck
+		if !errors.Is(err, apperrors.ErrConflict) || !s.isReversed(ctx, workplaceID, reverseID, userID) {
+			s.LogError(ctx, err, "Failed to reverse duplicate journal",
+				slog.String("pair_id", pairID),
+				slog.String("journal_id", reverseID))
+			return nil, err
+		}
+		s.LogInfo(ctx, "Duplicate journal already reversed, recording confirmation",
+			slog.String("pair_id", pairID),
+			slog.String("journal_id", reverseID))
+	}
+
+	pair.ReversedJournalID = reverseID
+	if err := s.resolve(ctx, pair, domain.DuplicateConfirmed, req.Note, userID); err != nil {
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Duplicate pair confirmed",
+		slog.String("pair_id", pairID),
+		slog.String("reversed_journal_id", reverseID))
+	return pair, nil
+}
+
+func (s *duplicateJournalService) DismissDuplicatePair(ctx context.Context, workplaceID string, pairID string, req dto.DismissDuplicatePairRequest, userID string) (*domain.DuplicateJournalPair, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to dismiss duplicate pair",
+			slog.String("workplace_id", workplaceID),
+			slog.String("pair_id", pairID))
+		return nil, err
+	}
+
+	pair, err := s.findPair(ctx, workplaceID, pairID)
+	if err != nil {
+		return nil, err
+	}
+	if pair.Status != domain.DuplicatePending {
+		return nil, fmt.Errorf("%w: duplicate pair %s is already %s", apperrors.ErrConflict, pairID, pair.Status)
+	}
+
+	if err := s.resolve(ctx, pair, domain.DuplicateDismissed, req.Note, userID); err != nil {
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Duplicate pair dismissed",
+		slog.String("pair_id", pairID))
+	return pair, nil
+}
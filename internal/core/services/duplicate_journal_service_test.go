@@ -0,0 +1,272 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock DuplicateJournalRepository ---
+type MockDuplicateJournalRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.DuplicateJournalRepositoryFacade = (*MockDuplicateJournalRepository)(nil)
+
+func (m *MockDuplicateJournalRepository) FindDuplicateCandidates(ctx context.Context, workplaceID string, journalID string, currencyCode string, amount decimal.Decimal, from time.Time, to time.Time) ([]domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, currencyCode, amount, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Journal), args.Error(1)
+}
+
+func (m *MockDuplicateJournalRepository) FindDuplicatePairByID(ctx context.Context, pairID string) (*domain.DuplicateJournalPair, error) {
+	args := m.Called(ctx, pairID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DuplicateJournalPair), args.Error(1)
+}
+
+func (m *MockDuplicateJournalRepository) ListDuplicatePairs(ctx context.Context, workplaceID string, status domain.DuplicatePairStatus) ([]domain.DuplicateJournalPair, error) {
+	args := m.Called(ctx, workplaceID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.DuplicateJournalPair), args.Error(1)
+}
+
+func (m *MockDuplicateJournalRepository) SaveDuplicatePairs(ctx context.Context, pairs []domain.DuplicateJournalPair) error {
+	args := m.Called(ctx, pairs)
+	return args.Error(0)
+}
+
+func (m *MockDuplicateJournalRepository) ResolveDuplicatePair(ctx context.Context, pair domain.DuplicateJournalPair) error {
+	args := m.Called(ctx, pair)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type DuplicateJournalServiceTestSuite struct {
+	suite.Suite
+	mockDuplicateRepo *MockDuplicateJournalRepository
+	mockJournalRepo   *MockJournalRepository
+	mockAccountSvc    *MockAccountService2
+	mockJournalSvc    *MockJournalWriterSvc
+	mockWorkplaceSvc  *MockWorkplaceService
+	journalService    portssvc.JournalSvcFacade
+	service           portssvc.DuplicateJournalSvcFacade
+	workplaceID       string
+	userID            string
+	bankAccount       domain.Account
+	expenseAccount    domain.Account
+}
+
+func (suite *DuplicateJournalServiceTestSuite) SetupTest() {
+	suite.mockDuplicateRepo = new(MockDuplicateJournalRepository)
+	suite.mockJournalRepo = new(MockJournalRepository)
+	suite.mockAccountSvc = new(MockAccountService2)
+	suite.mockJournalSvc = new(MockJournalWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.journalService = services.NewJournalService(suite.mockJournalRepo, suite.mockAccountSvc, suite.mockWorkplaceSvc,
+		services.WithJournalDuplicateDetection(suite.mockDuplicateRepo, services.DefaultDuplicateWindowDays))
+	suite.service = services.NewDuplicateJournalService(suite.mockDuplicateRepo, suite.mockJournalSvc,
+		services.WithDuplicateJournalWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.expenseAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Expense, CurrencyCode: "USD", IsActive: true}
+}
+
+func TestDuplicateJournalService(t *testing.T) {
+	suite.Run(t, new(DuplicateJournalServiceTestSuite))
+}
+
+// expenseLines returns the lines of a journal paying an expense from the bank account
+func (suite *DuplicateJournalServiceTestSuite) expenseLines(journalID string, amount string) []domain.Transaction {
+	return []domain.Transaction{
+		{JournalID: journalID, AccountID: suite.expenseAccount.AccountID, Amount: decimal.RequireFromString(amount), TransactionType: domain.Debit},
+		{JournalID: journalID, AccountID: suite.bankAccount.AccountID, Amount: decimal.RequireFromString(amount), TransactionType: domain.Credit},
+	}
+}
+
+// expectCreateJournal mocks the authorization, account lookup and save of a CreateJournal call
+func (suite *DuplicateJournalServiceTestSuite) expectCreateJournal(ctx context.Context) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.bankAccount.AccountID:    suite.bankAccount,
+		suite.expenseAccount.AccountID: suite.expenseAccount,
+	}, nil).Once()
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.AnythingOfType("domain.Journal"), mock.AnythingOfType("[]domain.Transaction"), mock.AnythingOfType("map[string]decimal.Decimal")).Return(nil).Once()
+}
+
+// coffeeRequest returns a request paying 4.50 for coffee on the given date
+func (suite *DuplicateJournalServiceTestSuite) coffeeRequest(date time.Time) dto.CreateJournalRequest {
+	return dto.CreateJournalRequest{
+		Date:         date,
+		Description:  "Coffee shop",
+		CurrencyCode: "USD",
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: suite.bankAccount.AccountID, Amount: decimal.RequireFromString("4.50"), TransactionType: domain.Credit},
+			{AccountID: suite.expenseAccount.AccountID, Amount: decimal.RequireFromString("4.5"), TransactionType: domain.Debit},
+		},
+	}
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestCreateJournal_FlagsMatchingJournals() {
+	ctx := context.Background()
+	date := time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)
+	window := time.Duration(services.DefaultDuplicateWindowDays) * 24 * time.Hour
+	suite.expectCreateJournal(ctx)
+	candidates := []domain.Journal{
+		{JournalID: "imported", JournalDate: date.AddDate(0, 0, -1), Description: "COFFEE SHOP LONDON 1234", CurrencyCode: "USD", Amount: decimal.RequireFromString("4.5")},
+		{JournalID: "other-accounts", JournalDate: date, Description: "Coffee shop", CurrencyCode: "USD", Amount: decimal.RequireFromString("4.5")},
+		{JournalID: "other-payee", JournalDate: date, Description: "Bus ticket", CurrencyCode: "USD", Amount: decimal.RequireFromString("4.5")},
+	}
+	suite.mockDuplicateRepo.On("FindDuplicateCandidates", ctx, suite.workplaceID, mock.AnythingOfType("string"), "USD",
+		mock.MatchedBy(func(d decimal.Decimal) bool { return d.Equal(decimal.RequireFromString("4.5")) }),
+		date.Add(-window), date.Add(window)).Return(candidates, nil).Once()
+	otherAccounts := suite.expenseLines("other-accounts", "4.5")
+	otherAccounts[0].AccountID = uuid.NewString()
+	suite.mockJournalRepo.On("FindTransactionsByJournalIDs", ctx, []string{"imported", "other-accounts", "other-payee"}).Return(map[string][]domain.Transaction{
+		"imported":       suite.expenseLines("imported", "4.50"),
+		"other-accounts": otherAccounts,
+		"other-payee":    suite.expenseLines("other-payee", "4.5"),
+	}, nil).Once()
+	var saved []domain.DuplicateJournalPair
+	suite.mockDuplicateRepo.On("SaveDuplicatePairs", ctx, mock.Anything).
+		Run(func(args mock.Arguments) { saved = args.Get(1).([]domain.DuplicateJournalPair) }).
+		Return(nil).Once()
+
+	journal, err := suite.journalService.CreateJournal(ctx, suite.workplaceID, suite.coffeeRequest(date), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(journal.DuplicateWarnings, 1)
+	warning := journal.DuplicateWarnings[0]
+	suite.Equal("imported", warning.DuplicateOfJournalID)
+	suite.Equal(journal.JournalID, warning.JournalID)
+	suite.Equal(domain.DuplicatePending, warning.Status)
+	suite.Equal(1.0, warning.Similarity)
+	suite.Equal("COFFEE SHOP LONDON 1234", warning.DuplicateOf.Description)
+	suite.Equal(journal.DuplicateWarnings, saved)
+	suite.mockDuplicateRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestCreateJournal_DetectionFailureDoesNotBlock() {
+	ctx := context.Background()
+	suite.expectCreateJournal(ctx)
+	suite.mockDuplicateRepo.On("FindDuplicateCandidates", ctx, suite.workplaceID, mock.Anything, "USD", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db down")).Once()
+
+	journal, err := suite.journalService.CreateJournal(ctx, suite.workplaceID, suite.coffeeRequest(time.Now()), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Empty(journal.DuplicateWarnings)
+	suite.mockJournalRepo.AssertExpectations(suite.T())
+}
+
+// pendingPair returns a pending pair of the workplace
+func (suite *DuplicateJournalServiceTestSuite) pendingPair() *domain.DuplicateJournalPair {
+	return &domain.DuplicateJournalPair{PairID: "pair-1", WorkplaceID: suite.workplaceID, JournalID: "new-journal",
+		DuplicateOfJournalID: "old-journal", Similarity: 0.9, Status: domain.DuplicatePending}
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestConfirmDuplicatePair_ReversesFlaggedJournalByDefault() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDuplicateRepo.On("FindDuplicatePairByID", ctx, "pair-1").Return(suite.pendingPair(), nil).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "new-journal", suite.userID).Return(&domain.Journal{JournalID: "reversal"}, nil).Once()
+	suite.mockDuplicateRepo.On("ResolveDuplicatePair", ctx, mock.MatchedBy(func(p domain.DuplicateJournalPair) bool {
+		return p.Status == domain.DuplicateConfirmed && p.ReversedJournalID == "new-journal" &&
+			p.ResolvedBy == suite.userID && p.ResolvedAt != nil && p.ResolutionNote == "entered twice"
+	})).Return(nil).Once()
+
+	pair, err := suite.service.ConfirmDuplicatePair(ctx, suite.workplaceID, "pair-1", dto.ConfirmDuplicatePairRequest{Note: " entered twice "}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(domain.DuplicateConfirmed, pair.Status)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockDuplicateRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestConfirmDuplicatePair_RetryAfterFailedResolveConfirms() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Twice()
+	suite.mockDuplicateRepo.On("FindDuplicatePairByID", ctx, "pair-1").Return(suite.pendingPair(), nil).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "new-journal", suite.userID).Return(&domain.Journal{JournalID: "reversal"}, nil).Once()
+	suite.mockDuplicateRepo.On("ResolveDuplicatePair", ctx, mock.Anything).Return(errors.New("connection reset")).Once()
+
+	_, err := suite.service.ConfirmDuplicatePair(ctx, suite.workplaceID, "pair-1", dto.ConfirmDuplicatePairRequest{}, suite.userID)
+	suite.Require().Error(err)
+
+	// The journal was reversed but the pair is still pending; a retry records the confirmation
+	suite.mockDuplicateRepo.On("FindDuplicatePairByID", ctx, "pair-1").Return(suite.pendingPair(), nil).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "new-journal", suite.userID).
+		Return(nil, fmt.Errorf("%w: journal status is REVERSED, expected POSTED", apperrors.ErrConflict)).Once()
+	suite.mockJournalSvc.On("GetJournalByID", ctx, suite.workplaceID, "new-journal", suite.userID).
+		Return(&domain.Journal{JournalID: "new-journal", Status: domain.Reversed}, nil).Once()
+	suite.mockDuplicateRepo.On("ResolveDuplicatePair", ctx, mock.MatchedBy(func(p domain.DuplicateJournalPair) bool {
+		return p.Status == domain.DuplicateConfirmed && p.ReversedJournalID == "new-journal"
+	})).Return(nil).Once()
+
+	pair, err := suite.service.ConfirmDuplicatePair(ctx, suite.workplaceID, "pair-1", dto.ConfirmDuplicatePairRequest{}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(domain.DuplicateConfirmed, pair.Status)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockDuplicateRepo.AssertExpectations(suite.T())
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestConfirmDuplicatePair_PostedJournalConflictIsReturned() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDuplicateRepo.On("FindDuplicatePairByID", ctx, "pair-1").Return(suite.pendingPair(), nil).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "new-journal", suite.userID).
+		Return(nil, fmt.Errorf("%w: journal has transactions locked by a completed reconciliation", apperrors.ErrConflict)).Once()
+	suite.mockJournalSvc.On("GetJournalByID", ctx, suite.workplaceID, "new-journal", suite.userID).
+		Return(&domain.Journal{JournalID: "new-journal", Status: domain.Posted}, nil).Once()
+
+	_, err := suite.service.ConfirmDuplicatePair(ctx, suite.workplaceID, "pair-1", dto.ConfirmDuplicatePairRequest{}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.mockDuplicateRepo.AssertNotCalled(suite.T(), "ResolveDuplicatePair", mock.Anything, mock.Anything)
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestConfirmDuplicatePair_RejectsJournalOutsidePair() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDuplicateRepo.On("FindDuplicatePairByID", ctx, "pair-1").Return(suite.pendingPair(), nil).Once()
+
+	_, err := suite.service.ConfirmDuplicatePair(ctx, suite.workplaceID, "pair-1",
+		dto.ConfirmDuplicatePairRequest{ReverseJournalID: uuid.NewString()}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "ReverseJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DuplicateJournalServiceTestSuite) TestDismissDuplicatePair_AlreadyReviewed() {
+	ctx := context.Background()
+	reviewed := suite.pendingPair()
+	reviewed.Status = domain.DuplicateConfirmed
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockDuplicateRepo.On("FindDuplicatePairByID", ctx, "pair-1").Return(reviewed, nil).Once()
+
+	_, err := suite.service.DismissDuplicatePair(ctx, suite.workplaceID, "pair-1", dto.DismissDuplicatePairRequest{}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.mockDuplicateRepo.AssertNotCalled(suite.T(), "ResolveDuplicatePair", mock.Anything, mock.Anything)
+}
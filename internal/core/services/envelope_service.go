@@ -0,0 +1,472 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// maxEnvelopeMonths limits how many months are replayed to derive the state of a month
+const maxEnvelopeMonths = 600
+
+// envelopeService implements the EnvelopeSvcFacade interface
+type envelopeService struct {
+	BaseService
+	envelopeRepo  portsrepo.EnvelopeRepositoryFacade
+	accountRepo   portsrepo.AccountReader
+	reportingRepo portsrepo.ReportingRepository
+}
+
+// EnvelopeServiceOption is a functional option for configuring the envelope service
+type EnvelopeServiceOption func(*envelopeService)
+
+// WithEnvelopeWorkplaceAuthorizer adds workplace authorizer dependency
+func WithEnvelopeWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) EnvelopeServiceOption {
+	return func(s *envelopeService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewEnvelopeService creates a new envelope budgeting service. Income and spending are read through the
+// reporting repository; only allocations are stored.
+func NewEnvelopeService(envelopeRepo portsrepo.EnvelopeRepositoryFacade, accountRepo portsrepo.AccountReader, reportingRepo portsrepo.ReportingRepository, options ...EnvelopeServiceOption) portssvc.EnvelopeSvcFacade {
+	svc := &envelopeService{
+		envelopeRepo:  envelopeRepo,
+		accountRepo:   accountRepo,
+		reportingRepo: reportingRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure envelopeService implements the EnvelopeSvcFacade interface
+var _ portssvc.EnvelopeSvcFacade = (*envelopeService)(nil)
+
+// parseEnvelopeMonth parses a YYYY-MM month into the first day of the month
+func parseEnvelopeMonth(value string) (time.Time, error) {
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid month %q, use YYYY-MM", apperrors.ErrValidation, value)
+	}
+	return month, nil
+}
+
+// firstOfMonth truncates a date to the first day of its month
+func firstOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// findSettings loads the envelope settings, turning a missing row into a validation error
+func (s *envelopeService) findSettings(ctx context.Context, workplaceID string) (*domain.EnvelopeSettings, error) {
+	settings, err := s.envelopeRepo.FindEnvelopeSettings(ctx, workplaceID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: envelope budgeting is not enabled for this workplace", apperrors.ErrValidation)
+		}
+		s.LogError(ctx, err, "Failed to load envelope settings",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to load envelope settings: %w", err)
+	}
+	return settings, nil
+}
+
+func (s *envelopeService) ConfigureEnvelopes(ctx context.Context, workplaceID string, req dto.ConfigureEnvelopesRequest, userID string) (*domain.EnvelopeSettings, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to configure envelopes",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	startMonth, err := parseEnvelopeMonth(req.StartMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	settings := domain.EnvelopeSettings{
+		WorkplaceID: workplaceID,
+		StartMonth:  startMonth,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.envelopeRepo.SaveEnvelopeSettings(ctx, settings); err != nil {
+		s.LogError(ctx, err, "Failed to save envelope settings",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Envelope budgeting configured",
+		slog.String("workplace_id", workplaceID),
+		slog.String("start_month", req.StartMonth))
+	return s.envelopeRepo.FindEnvelopeSettings(ctx, workplaceID)
+}
+
+func (s *envelopeService) GetEnvelopeSettings(ctx context.Context, workplaceID string, userID string) (*domain.EnvelopeSettings, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view envelope settings",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return s.findSettings(ctx, workplaceID)
+}
+
+// validateEnvelope checks that an account can hold envelope money: an EXPENSE account of the workplace
+// that is neither nested under nor a parent of another envelope, so spending is never counted twice
+func (s *envelopeService) validateEnvelope(ctx context.Context, workplaceID string, accountID string) (domain.Account, error) {
+	envelopeIDs, err := s.envelopeRepo.ListEnvelopeAccountIDs(ctx, workplaceID)
+	if err != nil {
+		return domain.Account{}, fmt.Errorf("failed to load envelopes: %w", err)
+	}
+	tree, err := loadAccountTree(ctx, s.accountRepo, append([]string{accountID}, envelopeIDs...))
+	if err != nil {
+		return domain.Account{}, fmt.Errorf("failed to load envelope accounts: %w", err)
+	}
+
+	account, ok := tree[accountID]
+	if !ok || account.WorkplaceID != workplaceID {
+		return domain.Account{}, fmt.Errorf("%w: account %s not found in workplace", apperrors.ErrValidation, accountID)
+	}
+	if account.AccountType != domain.Expense {
+		return domain.Account{}, fmt.Errorf("%w: account %s is a %s account; only EXPENSE accounts can be envelopes",
+			apperrors.ErrValidation, account.Name, account.AccountType)
+	}
+
+	envelopes := make(map[string]bool, len(envelopeIDs))
+	for _, id := range envelopeIDs {
+		envelopes[id] = true
+	}
+	if envelopes[accountID] {
+		return account, nil
+	}
+	if !account.IsActive {
+		return domain.Account{}, fmt.Errorf("%w: account %s is inactive", apperrors.ErrValidation, account.Name)
+	}
+	for id := account.ParentAccountID; id != ""; id = tree[id].ParentAccountID {
+		if envelopes[id] {
+			return domain.Account{}, fmt.Errorf("%w: account %s is inside envelope %s", apperrors.ErrValidation, account.Name, tree[id].Name)
+		}
+	}
+	for _, envelopeID := range envelopeIDs {
+		for id := tree[envelopeID].ParentAccountID; id != ""; id = tree[id].ParentAccountID {
+			if id == accountID {
+				return domain.Account{}, fmt.Errorf("%w: account %s contains envelope %s", apperrors.ErrValidation, account.Name, tree[envelopeID].Name)
+			}
+		}
+	}
+	return account, nil
+}
+
+// newAllocation builds an allocation after checking the envelope and the month
+func (s *envelopeService) newAllocation(ctx context.Context, workplaceID string, settings *domain.EnvelopeSettings, accountID string, month time.Time, amount decimal.Decimal, note string, userID string, now time.Time) (domain.EnvelopeAllocation, error) {
+	if _, err := s.validateEnvelope(ctx, workplaceID, accountID); err != nil {
+		return domain.EnvelopeAllocation{}, err
+	}
+	if month.Before(settings.StartMonth) {
+		return domain.EnvelopeAllocation{}, fmt.Errorf("%w: month %s is before the envelope start month %s",
+			apperrors.ErrValidation, month.Format("2006-01"), settings.StartMonth.Format("2006-01"))
+	}
+	return domain.EnvelopeAllocation{
+		AllocationID: uuid.NewString(),
+		WorkplaceID:  workplaceID,
+		AccountID:    accountID,
+		Month:        month,
+		Amount:       amount,
+		Note:         note,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}, nil
+}
+
+func (s *envelopeService) AssignToEnvelope(ctx context.Context, workplaceID string, req dto.AssignEnvelopeRequest, userID string) (*domain.EnvelopeAllocation, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to assign envelope money",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if req.Amount.IsZero() {
+		return nil, fmt.Errorf("%w: amount cannot be zero", apperrors.ErrValidation)
+	}
+	month, err := parseEnvelopeMonth(req.Month)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.findSettings(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	allocation, err := s.newAllocation(ctx, workplaceID, settings, req.AccountID, month, req.Amount, req.Note, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.envelopeRepo.SaveEnvelopeAllocations(ctx, []domain.EnvelopeAllocation{allocation}); err != nil {
+		s.LogError(ctx, err, "Failed to save envelope allocation",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", req.AccountID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Money assigned to envelope",
+		slog.String("workplace_id", workplaceID),
+		slog.String("account_id", req.AccountID),
+		slog.String("month", req.Month),
+		slog.String("amount", req.Amount.String()))
+	return &allocation, nil
+}
+
+func (s *envelopeService) MoveBetweenEnvelopes(ctx context.Context, workplaceID string, req dto.MoveEnvelopeRequest, userID string) ([]domain.EnvelopeAllocation, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to move envelope money",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount to move must be positive", apperrors.ErrValidation)
+	}
+	if req.FromAccountID == req.ToAccountID {
+		return nil, fmt.Errorf("%w: cannot move money to the same envelope", apperrors.ErrValidation)
+	}
+	month, err := parseEnvelopeMonth(req.Month)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.findSettings(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from, err := s.newAllocation(ctx, workplaceID, settings, req.FromAccountID, month, req.Amount.Neg(), req.Note, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.newAllocation(ctx, workplaceID, settings, req.ToAccountID, month, req.Amount, req.Note, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	transferID := uuid.NewString()
+	from.TransferID = transferID
+	to.TransferID = transferID
+
+	allocations := []domain.EnvelopeAllocation{from, to}
+	if err := s.envelopeRepo.SaveEnvelopeAllocations(ctx, allocations); err != nil {
+		s.LogError(ctx, err, "Failed to save envelope move",
+			slog.String("workplace_id", workplaceID),
+			slog.String("transfer_id", transferID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Money moved between envelopes",
+		slog.String("workplace_id", workplaceID),
+		slog.String("transfer_id", transferID),
+		slog.String("from_account_id", req.FromAccountID),
+		slog.String("to_account_id", req.ToAccountID),
+		slog.String("amount", req.Amount.String()))
+	return allocations, nil
+}
+
+func (s *envelopeService) ListEnvelopeAllocations(ctx context.Context, workplaceID string, month time.Time, userID string) ([]domain.EnvelopeAllocation, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list envelope allocations",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	month = firstOfMonth(month)
+	allocations, err := s.envelopeRepo.ListEnvelopeAllocations(ctx, workplaceID, month, month)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list envelope allocations",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to list envelope allocations: %w", err)
+	}
+	return allocations, nil
+}
+
+// GetEnvelopeMonth replays every month from the start month to derive the state of the requested month.
+// Spending on an account counts towards the envelope it belongs to (the account itself or its nearest
+// envelope ancestor); spending outside any envelope reduces available-to-budget directly.
+func (s *envelopeService) GetEnvelopeMonth(ctx context.Context, workplaceID string, month time.Time, userID string) (*domain.EnvelopeMonth, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view envelopes",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	settings, err := s.findSettings(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	month = firstOfMonth(month)
+	if month.Before(settings.StartMonth) {
+		return nil, fmt.Errorf("%w: month %s is before the envelope start month %s",
+			apperrors.ErrValidation, month.Format("2006-01"), settings.StartMonth.Format("2006-01"))
+	}
+	periods := domain.MonthlyPeriods(settings.StartMonth, month.AddDate(0, 1, -1))
+	if len(periods) > maxEnvelopeMonths {
+		return nil, fmt.Errorf("%w: month %s is more than %d months after the envelope start month",
+			apperrors.ErrValidation, month.Format("2006-01"), maxEnvelopeMonths)
+	}
+
+	amounts, err := s.reportingRepo.GetProfitAndLossByPeriods(ctx, workplaceID, periods, nil)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve income and spending for envelopes",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to retrieve income and spending: %w", err)
+	}
+	allocations, err := s.envelopeRepo.ListEnvelopeAllocations(ctx, workplaceID, settings.StartMonth, month)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve envelope allocations",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to retrieve envelope allocations: %w", err)
+	}
+	envelopeIDs, err := s.envelopeRepo.ListEnvelopeAccountIDs(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve envelopes",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to retrieve envelopes: %w", err)
+	}
+
+	accountIDs := append([]string{}, envelopeIDs...)
+	for _, amount := range amounts {
+		if amount.AccountType == domain.Expense {
+			accountIDs = append(accountIDs, amount.AccountID)
+		}
+	}
+	tree, err := loadAccountTree(ctx, s.accountRepo, accountIDs)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load account hierarchy for envelopes",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to load account hierarchy: %w", err)
+	}
+
+	envelopes := make(map[string]bool, len(envelopeIDs))
+	for _, id := range envelopeIDs {
+		envelopes[id] = true
+	}
+	// envelopeOf returns the envelope covering an account, or "" when it is outside every envelope
+	envelopeOf := func(accountID string) string {
+		visited := make(map[string]bool)
+		for id := accountID; id != "" && !visited[id]; id = tree[id].ParentAccountID {
+			visited[id] = true
+			if envelopes[id] {
+				return id
+			}
+		}
+		return ""
+	}
+
+	last := len(periods) - 1
+	income := make([]decimal.Decimal, len(periods))
+	unenveloped := make([]decimal.Decimal, len(periods))
+	activity := make([]map[string]decimal.Decimal, len(periods))
+	assigned := make([]map[string]decimal.Decimal, len(periods))
+	for i := range periods {
+		activity[i] = make(map[string]decimal.Decimal)
+		assigned[i] = make(map[string]decimal.Decimal)
+	}
+	for _, amount := range amounts {
+		i := amount.PeriodIndex
+		if i < 0 || i > last {
+			continue
+		}
+		switch amount.AccountType {
+		case domain.Revenue:
+			income[i] = income[i].Add(amount.NetAmount)
+		case domain.Expense:
+			if envelope := envelopeOf(amount.AccountID); envelope != "" {
+				activity[i][envelope] = activity[i][envelope].Add(amount.NetAmount)
+			} else {
+				unenveloped[i] = unenveloped[i].Add(amount.NetAmount)
+			}
+		}
+	}
+	monthIndex := make(map[string]int, len(periods))
+	for i, period := range periods {
+		monthIndex[period.Label] = i
+	}
+	for _, allocation := range allocations {
+		if i, ok := monthIndex[allocation.Month.Format("2006-01")]; ok {
+			assigned[i][allocation.AccountID] = assigned[i][allocation.AccountID].Add(allocation.Amount)
+		}
+	}
+
+	// Replay the months; available money, including overspending, rolls into the next month
+	available := make(map[string]decimal.Decimal, len(envelopeIDs))
+	carried := make(map[string]decimal.Decimal, len(envelopeIDs))
+	availableToBudget := decimal.Zero
+	for i := range periods {
+		for _, id := range envelopeIDs {
+			carried[id] = available[id]
+			available[id] = available[id].Add(assigned[i][id]).Sub(activity[i][id])
+			availableToBudget = availableToBudget.Sub(assigned[i][id])
+		}
+		availableToBudget = availableToBudget.Add(income[i]).Sub(unenveloped[i])
+	}
+
+	result := &domain.EnvelopeMonth{
+		Month:               periods[last],
+		StartMonth:          settings.StartMonth,
+		Income:              income[last],
+		AvailableToBudget:   availableToBudget,
+		UnenvelopedActivity: unenveloped[last],
+		Envelopes:           make([]domain.EnvelopeLine, 0, len(envelopeIDs)),
+		Total:               domain.EnvelopeLine{Name: "Total"},
+	}
+	for _, id := range envelopeIDs {
+		line := domain.EnvelopeLine{
+			AccountID:   id,
+			Name:        tree[id].Name,
+			CarriedOver: carried[id],
+			Assigned:    assigned[last][id],
+			Activity:    activity[last][id],
+			Available:   available[id],
+		}
+		result.Envelopes = append(result.Envelopes, line)
+		result.Total.CarriedOver = result.Total.CarriedOver.Add(line.CarriedOver)
+		result.Total.Assigned = result.Total.Assigned.Add(line.Assigned)
+		result.Total.Activity = result.Total.Activity.Add(line.Activity)
+		result.Total.Available = result.Total.Available.Add(line.Available)
+	}
+	sort.Slice(result.Envelopes, func(i, j int) bool {
+		if result.Envelopes[i].Name != result.Envelopes[j].Name {
+			return result.Envelopes[i].Name < result.Envelopes[j].Name
+		}
+		return result.Envelopes[i].AccountID < result.Envelopes[j].AccountID
+	})
+
+	s.LogInfo(ctx, "Envelope month derived successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("month", result.Month.Label),
+		slog.Int("envelopes", len(result.Envelopes)))
+	return result, nil
+}
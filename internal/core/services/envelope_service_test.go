@@ -0,0 +1,207 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock EnvelopeRepository ---
+type MockEnvelopeRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.EnvelopeRepositoryFacade = (*MockEnvelopeRepository)(nil)
+
+func (m *MockEnvelopeRepository) FindEnvelopeSettings(ctx context.Context, workplaceID string) (*domain.EnvelopeSettings, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EnvelopeSettings), args.Error(1)
+}
+
+func (m *MockEnvelopeRepository) ListEnvelopeAllocations(ctx context.Context, workplaceID string, from, to time.Time) ([]domain.EnvelopeAllocation, error) {
+	args := m.Called(ctx, workplaceID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.EnvelopeAllocation), args.Error(1)
+}
+
+func (m *MockEnvelopeRepository) ListEnvelopeAccountIDs(ctx context.Context, workplaceID string) ([]string, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockEnvelopeRepository) SaveEnvelopeSettings(ctx context.Context, settings domain.EnvelopeSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *MockEnvelopeRepository) SaveEnvelopeAllocations(ctx context.Context, allocations []domain.EnvelopeAllocation) error {
+	args := m.Called(ctx, allocations)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type EnvelopeServiceTestSuite struct {
+	suite.Suite
+	mockEnvelopeRepo  *MockEnvelopeRepository
+	mockAccountRepo   *MockAccountRepositoryFacade
+	mockReportingRepo *MockReportingRepository
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.EnvelopeSvcFacade
+	workplaceID       string
+	userID            string
+	settings          *domain.EnvelopeSettings
+	accounts          map[string]domain.Account
+}
+
+func (suite *EnvelopeServiceTestSuite) SetupTest() {
+	suite.mockEnvelopeRepo = new(MockEnvelopeRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockReportingRepo = new(MockReportingRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewEnvelopeService(suite.mockEnvelopeRepo, suite.mockAccountRepo, suite.mockReportingRepo,
+		services.WithEnvelopeWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.settings = &domain.EnvelopeSettings{WorkplaceID: suite.workplaceID, StartMonth: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	suite.accounts = map[string]domain.Account{
+		"groceries": {AccountID: "groceries", WorkplaceID: suite.workplaceID, Name: "Groceries", AccountType: domain.Expense, IsActive: true},
+		"snacks":    {AccountID: "snacks", WorkplaceID: suite.workplaceID, Name: "Snacks", AccountType: domain.Expense, ParentAccountID: "groceries", IsActive: true},
+		"rent":      {AccountID: "rent", WorkplaceID: suite.workplaceID, Name: "Rent", AccountType: domain.Expense, IsActive: true},
+		"fun":       {AccountID: "fun", WorkplaceID: suite.workplaceID, Name: "Fun", AccountType: domain.Expense, IsActive: true},
+		"bank":      {AccountID: "bank", WorkplaceID: suite.workplaceID, Name: "Bank", AccountType: domain.Asset, IsActive: true},
+	}
+}
+
+func envelopeAllocation(accountID string, month time.Month, amount int64) domain.EnvelopeAllocation {
+	return domain.EnvelopeAllocation{AccountID: accountID, Month: time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC), Amount: decimal.NewFromInt(amount)}
+}
+
+// --- Test Cases ---
+
+func (suite *EnvelopeServiceTestSuite) TestGetEnvelopeMonth_CarriesOverspend() {
+	ctx := context.Background()
+	feb := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	amounts := []domain.PeriodAccountAmount{
+		{PeriodIndex: 0, AccountID: "salary", AccountType: domain.Revenue, NetAmount: decimal.NewFromInt(1000)},
+		{PeriodIndex: 0, AccountID: "groceries", AccountType: domain.Expense, NetAmount: decimal.NewFromInt(150)},
+		{PeriodIndex: 0, AccountID: "snacks", AccountType: domain.Expense, NetAmount: decimal.NewFromInt(20)},
+		{PeriodIndex: 0, AccountID: "fun", AccountType: domain.Expense, NetAmount: decimal.NewFromInt(30)},
+		{PeriodIndex: 1, AccountID: "salary", AccountType: domain.Revenue, NetAmount: decimal.NewFromInt(1000)},
+		{PeriodIndex: 1, AccountID: "groceries", AccountType: domain.Expense, NetAmount: decimal.NewFromInt(50)},
+		{PeriodIndex: 1, AccountID: "rent", AccountType: domain.Expense, NetAmount: decimal.NewFromInt(400)},
+	}
+	allocations := []domain.EnvelopeAllocation{
+		envelopeAllocation("groceries", time.January, 150),
+		envelopeAllocation("rent", time.January, 400),
+		envelopeAllocation("groceries", time.February, 100),
+		envelopeAllocation("rent", time.February, 400),
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockEnvelopeRepo.On("FindEnvelopeSettings", ctx, suite.workplaceID).Return(suite.settings, nil).Once()
+	suite.mockReportingRepo.On("GetProfitAndLossByPeriods", ctx, suite.workplaceID, mock.MatchedBy(func(periods []domain.ReportPeriod) bool {
+		return len(periods) == 2 && periods[1].Label == "2025-02"
+	}), mock.Anything).Return(amounts, nil).Once()
+	suite.mockEnvelopeRepo.On("ListEnvelopeAllocations", ctx, suite.workplaceID, suite.settings.StartMonth, feb).Return(allocations, nil).Once()
+	suite.mockEnvelopeRepo.On("ListEnvelopeAccountIDs", ctx, suite.workplaceID).Return([]string{"groceries", "rent"}, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(suite.accounts, nil)
+
+	result, err := suite.service.GetEnvelopeMonth(ctx, suite.workplaceID, feb.AddDate(0, 0, 9), suite.userID)
+	suite.Require().NoError(err)
+	suite.Equal("2025-02", result.Month.Label)
+	suite.True(result.Income.Equal(decimal.NewFromInt(1000)))
+	suite.True(result.AvailableToBudget.Equal(decimal.NewFromInt(920)))
+	suite.True(result.UnenvelopedActivity.IsZero())
+
+	suite.Require().Len(result.Envelopes, 2)
+	groceries := result.Envelopes[0]
+	suite.Equal("Groceries", groceries.Name)
+	suite.True(groceries.CarriedOver.Equal(decimal.NewFromInt(-20)))
+	suite.True(groceries.Assigned.Equal(decimal.NewFromInt(100)))
+	suite.True(groceries.Activity.Equal(decimal.NewFromInt(50)))
+	suite.True(groceries.Available.Equal(decimal.NewFromInt(30)))
+	rent := result.Envelopes[1]
+	suite.True(rent.CarriedOver.Equal(decimal.NewFromInt(400)))
+	suite.True(rent.Available.Equal(decimal.NewFromInt(400)))
+	suite.True(result.Total.Available.Equal(decimal.NewFromInt(430)))
+}
+
+func (suite *EnvelopeServiceTestSuite) TestGetEnvelopeMonth_NotEnabled() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockEnvelopeRepo.On("FindEnvelopeSettings", ctx, suite.workplaceID).Return(nil, apperrors.ErrNotFound).Once()
+
+	result, err := suite.service.GetEnvelopeMonth(ctx, suite.workplaceID, time.Now(), suite.userID)
+	suite.Require().ErrorIs(err, apperrors.ErrValidation)
+	suite.Nil(result)
+}
+
+func (suite *EnvelopeServiceTestSuite) TestMoveBetweenEnvelopes_WritesLinkedAllocations() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockEnvelopeRepo.On("FindEnvelopeSettings", ctx, suite.workplaceID).Return(suite.settings, nil).Once()
+	suite.mockEnvelopeRepo.On("ListEnvelopeAccountIDs", ctx, suite.workplaceID).Return([]string{"groceries", "rent"}, nil)
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(suite.accounts, nil)
+	suite.mockEnvelopeRepo.On("SaveEnvelopeAllocations", ctx, mock.MatchedBy(func(allocations []domain.EnvelopeAllocation) bool {
+		return len(allocations) == 2 &&
+			allocations[0].AccountID == "rent" && allocations[0].Amount.Equal(decimal.NewFromInt(-25)) &&
+			allocations[1].AccountID == "groceries" && allocations[1].Amount.Equal(decimal.NewFromInt(25)) &&
+			allocations[0].TransferID != "" && allocations[0].TransferID == allocations[1].TransferID
+	})).Return(nil).Once()
+
+	allocations, err := suite.service.MoveBetweenEnvelopes(ctx, suite.workplaceID, dto.MoveEnvelopeRequest{
+		FromAccountID: "rent", ToAccountID: "groceries", Month: "2025-03", Amount: decimal.NewFromInt(25),
+	}, suite.userID)
+	suite.Require().NoError(err)
+	suite.Len(allocations, 2)
+	suite.mockEnvelopeRepo.AssertExpectations(suite.T())
+}
+
+func (suite *EnvelopeServiceTestSuite) TestAssignToEnvelope_Validation() {
+	ctx := context.Background()
+	cases := map[string]dto.AssignEnvelopeRequest{
+		"nested in envelope":  {AccountID: "snacks", Month: "2025-02", Amount: decimal.NewFromInt(10)},
+		"not an expense":      {AccountID: "bank", Month: "2025-02", Amount: decimal.NewFromInt(10)},
+		"before start month":  {AccountID: "fun", Month: "2024-12", Amount: decimal.NewFromInt(10)},
+		"invalid month":       {AccountID: "fun", Month: "02/2025", Amount: decimal.NewFromInt(10)},
+		"zero amount":         {AccountID: "fun", Month: "2025-02"},
+		"account outside set": {AccountID: "other", Month: "2025-02", Amount: decimal.NewFromInt(10)},
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil)
+	suite.mockEnvelopeRepo.On("FindEnvelopeSettings", ctx, suite.workplaceID).Return(suite.settings, nil)
+	suite.mockEnvelopeRepo.On("ListEnvelopeAccountIDs", ctx, suite.workplaceID).Return([]string{"groceries"}, nil)
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(suite.accounts, nil)
+
+	for name, req := range cases {
+		suite.Run(name, func() {
+			allocation, err := suite.service.AssignToEnvelope(ctx, suite.workplaceID, req, suite.userID)
+			suite.Require().ErrorIs(err, apperrors.ErrValidation)
+			suite.Nil(allocation)
+		})
+	}
+	suite.mockEnvelopeRepo.AssertNotCalled(suite.T(), "SaveEnvelopeAllocations", mock.Anything, mock.Anything)
+}
+
+func TestEnvelopeService(t *testing.T) {
+	suite.Run(t, new(EnvelopeServiceTestSuite))
+}
@@ -44,6 +44,14 @@ func (m *MockExchangeRateRepository) FindExchangeRate(ctx context.Context, fromC
 	return args.Get(0).(*domain.ExchangeRate), args.Error(1)
 }
 
+func (m *MockExchangeRateRepository) FindExchangeRateAsOf(ctx context.Context, fromCode, toCode string, date time.Time) (*domain.ExchangeRate, error) {
+	args := m.Called(ctx, fromCode, toCode, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ExchangeRate), args.Error(1)
+}
+
 func (m *MockExchangeRateRepository) FindExchangeRateByID(ctx context.Context, rateID string) (*domain.ExchangeRate, error) {
 	args := m.Called(ctx, rateID)
 	if args.Get(0) == nil {
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/shopspring/decimal"
+)
+
+// netDebit returns the debits of the lines less their credits
+func netDebit(transactions []dto.CreateTransactionRequest) decimal.Decimal {
+	net := decimal.Zero
+	for _, txn := range transactions {
+		if txn.TransactionType == domain.Debit {
+			net = net.Add(txn.Amount)
+		} else {
+			net = net.Sub(txn.Amount)
+		}
+	}
+	return net
+}
+
+// balancingLine returns the line that brings lines netting to net debits back to zero
+func balancingLine(accountID string, net decimal.Decimal, description string) dto.CreateTransactionRequest {
+	txnType := domain.Credit
+	if net.IsNegative() {
+		txnType = domain.Debit
+	}
+	return dto.CreateTransactionRequest{
+		AccountID:       accountID,
+		Amount:          net.Abs(),
+		TransactionType: txnType,
+		Notes:           description,
+	}
+}
+
+// CreateIntercompanyJournal writes mirrored journals in the workplace and the counterparty workplace in one
+// transaction. The lines of the workplace must net to a credit, what it paid for the counterparty; they are
+// balanced on its due-from account and those of the counterparty on its due-to account. Both journals link to
+// each other. The user must be a member of both workplaces.
+func (s *journalService) CreateIntercompanyJournal(ctx context.Context, workplaceID string, req dto.CreateIntercompanyJournalRequest, creatorUserID string) (*domain.Journal, *domain.Journal, error) {
+	logger := middleware.GetLoggerFromCtx(ctx).With(
+		slog.String("workplace_id", workplaceID),
+		slog.String("counterparty_workplace_id", req.Counterparty.WorkplaceID))
+
+	if req.Counterparty.WorkplaceID == workplaceID {
+		return nil, nil, fmt.Errorf("%w: the counterparty must be another workplace", apperrors.ErrValidation)
+	}
+	for _, wp := range []string{workplaceID, req.Counterparty.WorkplaceID} {
+		if err := s.workplaceSvc.AuthorizeUserAction(ctx, creatorUserID, wp, domain.RoleMember); err != nil {
+			logger.Warn("Authorization failed for CreateIntercompanyJournal", slog.String("user_id", creatorUserID), slog.String("authorized_workplace_id", wp), slog.String("error", err.Error()))
+			return nil, nil, err
+		}
+	}
+
+	// What this workplace pays on behalf of the counterparty is due from it. A posting where the counterparty
+	// paid is recorded from the counterparty's side, so that the due-from account stays a receivable and the
+	// due-to account a payable.
+	net := netDebit(req.Transactions)
+	if net.IsZero() {
+		return nil, nil, fmt.Errorf("%w: the lines of the workplace already balance, so nothing is due between the workplaces", apperrors.ErrValidation)
+	}
+	if net.IsPositive() {
+		return nil, nil, fmt.Errorf("%w: the lines of the workplace net to a debit, so the workplace owes the counterparty; record the journal in the counterparty workplace instead", apperrors.ErrValidation)
+	}
+	if !netDebit(req.Counterparty.Transactions).Equal(net.Neg()) {
+		return nil, nil, fmt.Errorf("%w: the lines of the counterparty must mirror those of the workplace: they net to %s debit, expected %s",
+			apperrors.ErrValidation, netDebit(req.Counterparty.Transactions), net.Neg())
+	}
+
+	if err := s.validateDueAccount(ctx, workplaceID, req.DueFromAccountID, domain.Asset, req.CurrencyCode, req.Transactions, creatorUserID); err != nil {
+		return nil, nil, err
+	}
+	if err := s.validateDueAccount(ctx, req.Counterparty.WorkplaceID, req.Counterparty.DueToAccountID, domain.Liability, req.CurrencyCode, req.Counterparty.Transactions, creatorUserID); err != nil {
+		return nil, nil, err
+	}
+
+	sourceReq := dto.CreateJournalRequest{
+		Date:         req.Date,
+		Description:  req.Description,
+		CurrencyCode: req.CurrencyCode,
+		Transactions: append(append([]dto.CreateTransactionRequest{}, req.Transactions...), balancingLine(req.DueFromAccountID, net, req.Description)),
+	}
+	counterpartyReq := dto.CreateJournalRequest{
+		Date:         req.Date,
+		Description:  req.Description,
+		CurrencyCode: req.CurrencyCode,
+		Transactions: append(append([]dto.CreateTransactionRequest{}, req.Counterparty.Transactions...), balancingLine(req.Counterparty.DueToAccountID, net.Neg(), req.Description)),
+	}
+
+	source, err := s.prepareJournal(ctx, workplaceID, sourceReq, creatorUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	counterparty, err := s.prepareJournal(ctx, req.Counterparty.WorkplaceID, counterpartyReq, creatorUserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	linkTwins(source, counterparty)
+
+	if err := s.journalRepo.SaveTwinJournals(ctx, *source, *counterparty); err != nil {
+		logger.Error("Failed to save intercompany journals", slog.String("error", err.Error()))
+		return nil, nil, fmt.Errorf("failed to save intercompany journals: %w", err)
+	}
+
+	logger.Info("Intercompany journals created successfully",
+		slog.String("journal_id", source.Journal.JournalID),
+		slog.String("twin_journal_id", counterparty.Journal.JournalID))
+	return &source.Journal, &counterparty.Journal, nil
+}
+
+// validateDueAccount checks that the account balancing one side of an intercompany posting is an active account
+// of the workplace of the expected type, in the currency of the posting and not used by the lines themselves
+func (s *journalService) validateDueAccount(ctx context.Context, workplaceID string, accountID string, accountType domain.AccountType, currencyCode string, lines []dto.CreateTransactionRequest, userID string) error {
+	for _, txn := range lines {
+		if txn.AccountID == accountID {
+			return fmt.Errorf("%w: due account %s cannot also be used by the lines", apperrors.ErrValidation, accountID)
+		}
+	}
+	accounts, err := s.accountSvc.GetAccountByIDs(ctx, workplaceID, []string{accountID}, userID)
+	if err != nil && !errors.Is(err, apperrors.ErrNotFound) {
+		return err
+	}
+	account, ok := accounts[accountID]
+	if err != nil || !ok || account.WorkplaceID != workplaceID {
+		return fmt.Errorf("%w: due account %s not found in workplace %s", apperrors.ErrValidation, accountID, workplaceID)
+	}
+	if account.AccountType != accountType {
+		return fmt.Errorf("%w: due account %s must be a %s account", apperrors.ErrValidation, accountID, accountType)
+	}
+	if !account.IsActive {
+		return fmt.Errorf("%w: due account %s is inactive", apperrors.ErrValidation, accountID)
+	}
+	if account.CurrencyCode != currencyCode {
+		return fmt.Errorf("%w: due account %s currency %s does not match journal currency %s",
+			apperrors.ErrValidation, accountID, account.CurrencyCode, currencyCode)
+	}
+	return nil
+}
+
+// ReverseIntercompanyJournal reverses a journal of an intercompany posting and its twin in one transaction.
+// The reversing journals are twins of each other. The user must be a member of both workplaces.
+func (s *journalService) ReverseIntercompanyJournal(ctx context.Context, workplaceID string, journalID string, userID string) (*domain.Journal, *domain.Journal, error) {
+	logger := middleware.GetLoggerFromCtx(ctx).With(slog.String("workplace_id", workplaceID), slog.String("journal_id", journalID))
+
+	original, originalTransactions, err := s.validateReverseJournalActionAndGetOriginalJournal(ctx, journalID, userID, workplaceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if original.Twin == nil {
+		return nil, nil, fmt.Errorf("%w: journal %s is not part of an intercompany posting", apperrors.ErrValidation, journalID)
+	}
+	twin, twinTransactions, err := s.validateReverseJournalActionAndGetOriginalJournal(ctx, original.Twin.JournalID, userID, original.Twin.WorkplaceID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrConflict) {
+			return nil, nil, fmt.Errorf("%w: twin journal %s cannot be reversed; reverse this journal on its own", err, original.Twin.JournalID)
+		}
+		return nil, nil, err
+	}
+
+	reversal, err := s.prepareReversal(ctx, workplaceID, original, originalTransactions, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	twinReversal, err := s.prepareReversal(ctx, twin.WorkplaceID, twin, twinTransactions, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	linkTwins(reversal, twinReversal)
+
+	if err := s.journalRepo.SaveTwinJournals(ctx, *reversal, *twinReversal); err != nil {
+		logger.Error("Failed to save intercompany reversals", slog.String("error", err.Error()))
+		if errors.Is(err, apperrors.ErrConflict) {
+			return nil, nil, fmt.Errorf("%w: journal or twin is no longer posted", apperrors.ErrConflict)
+		}
+		return nil, nil, fmt.Errorf("failed to save intercompany reversals: %w", err)
+	}
+
+	logger.Info("Intercompany journals reversed successfully",
+		slog.String("reversing_journal_id", reversal.Journal.JournalID),
+		slog.String("twin_reversing_journal_id", twinReversal.Journal.JournalID))
+	return &reversal.Journal, &twinReversal.Journal, nil
+}
+
+// linkTwins links two journals about to be saved to each other
+func linkTwins(first *domain.JournalPosting, second *domain.JournalPosting) {
+	first.Journal.Twin = &domain.JournalTwin{JournalID: second.Journal.JournalID, WorkplaceID: second.Journal.WorkplaceID, Status: second.Journal.Status}
+	second.Journal.Twin = &domain.JournalTwin{JournalID: first.Journal.JournalID, WorkplaceID: first.Journal.WorkplaceID, Status: first.Journal.Status}
+}
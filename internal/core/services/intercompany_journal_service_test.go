@@ -0,0 +1,314 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Test Suite Setup ---
+type IntercompanyJournalServiceTestSuite struct {
+	suite.Suite
+	mockJournalRepo  *MockJournalRepository
+	mockAccountSvc   *MockAccountService2
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.JournalSvcFacade
+	parentID         string
+	subsidiaryID     string
+	userID           string
+	parentBank       domain.Account
+	dueFromSub       domain.Account
+	subExpense       domain.Account
+	dueToParent      domain.Account
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) SetupTest() {
+	suite.mockJournalRepo = new(MockJournalRepository)
+	suite.mockAccountSvc = new(MockAccountService2)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewJournalService(suite.mockJournalRepo, suite.mockAccountSvc, suite.mockWorkplaceSvc)
+	suite.parentID = uuid.NewString()
+	suite.subsidiaryID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.parentBank = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.parentID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.dueFromSub = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.parentID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.subExpense = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.subsidiaryID, AccountType: domain.Expense, CurrencyCode: "USD", IsActive: true}
+	suite.dueToParent = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.subsidiaryID, AccountType: domain.Liability, CurrencyCode: "USD", IsActive: true}
+}
+
+func TestIntercompanyJournalService(t *testing.T) {
+	suite.Run(t, new(IntercompanyJournalServiceTestSuite))
+}
+
+// paidOnBehalf returns a request in which the parent pays an expense of the subsidiary from its bank
+func (suite *IntercompanyJournalServiceTestSuite) paidOnBehalf(parentAmount, subsidiaryAmount int64) dto.CreateIntercompanyJournalRequest {
+	return dto.CreateIntercompanyJournalRequest{
+		Date:             time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC),
+		Description:      "Software licence paid for subsidiary",
+		CurrencyCode:     "USD",
+		DueFromAccountID: suite.dueFromSub.AccountID,
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: suite.parentBank.AccountID, Amount: decimal.NewFromInt(parentAmount), TransactionType: domain.Credit},
+		},
+		Counterparty: dto.IntercompanyCounterpartyRequest{
+			WorkplaceID:    suite.subsidiaryID,
+			DueToAccountID: suite.dueToParent.AccountID,
+			Transactions: []dto.CreateTransactionRequest{
+				{AccountID: suite.subExpense.AccountID, Amount: decimal.NewFromInt(subsidiaryAmount), TransactionType: domain.Debit},
+			},
+		},
+	}
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) expectAuthorized(ctx context.Context) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleMember).Return(nil)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleMember).Return(nil)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) expectAccounts(ctx context.Context) {
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.parentID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.parentBank.AccountID: suite.parentBank,
+		suite.dueFromSub.AccountID: suite.dueFromSub,
+	}, nil)
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.subsidiaryID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.subExpense.AccountID:  suite.subExpense,
+		suite.dueToParent.AccountID: suite.dueToParent,
+	}, nil)
+}
+
+// line returns the line of a posting on an account
+func line(posting domain.JournalPosting, accountID string) (domain.Transaction, bool) {
+	for _, txn := range posting.Transactions {
+		if txn.AccountID == accountID {
+			return txn, true
+		}
+	}
+	return domain.Transaction{}, false
+}
+
+// --- Test Cases ---
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_MirrorsThroughDueAccounts() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	suite.expectAccounts(ctx)
+	var first, second domain.JournalPosting
+	suite.mockJournalRepo.On("SaveTwinJournals", ctx, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		first = args.Get(1).(domain.JournalPosting)
+		second = args.Get(2).(domain.JournalPosting)
+	}).Return(nil).Once()
+
+	journal, twin, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, suite.paidOnBehalf(100, 100), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(suite.parentID, journal.WorkplaceID)
+	suite.Equal(suite.subsidiaryID, twin.WorkplaceID)
+	suite.Require().NotNil(journal.Twin)
+	suite.Equal(twin.JournalID, journal.Twin.JournalID)
+	suite.Equal(suite.subsidiaryID, journal.Twin.WorkplaceID)
+	suite.Equal(journal.JournalID, twin.Twin.JournalID)
+
+	dueFrom, found := line(first, suite.dueFromSub.AccountID)
+	suite.Require().True(found)
+	suite.Equal(domain.Debit, dueFrom.TransactionType)
+	suite.True(dueFrom.Amount.Equal(decimal.NewFromInt(100)))
+	suite.True(first.BalanceChanges[suite.parentBank.AccountID].Equal(decimal.NewFromInt(-100)))
+	dueTo, found := line(second, suite.dueToParent.AccountID)
+	suite.Require().True(found)
+	suite.Equal(domain.Credit, dueTo.TransactionType)
+	suite.True(second.BalanceChanges[suite.dueToParent.AccountID].Equal(decimal.NewFromInt(100)))
+	suite.True(second.BalanceChanges[suite.subExpense.AccountID].Equal(decimal.NewFromInt(100)))
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_ForbiddenInCounterparty() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.parentID, domain.RoleMember).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleMember).Return(apperrors.ErrForbidden).Once()
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, suite.paidOnBehalf(100, 100), suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrForbidden)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_SidesDoNotMirror() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, suite.paidOnBehalf(100, 90), suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_WorkplaceLinesNetToDebit() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	// The subsidiary paid the parent's expense: the parent owes, so the journal belongs to the subsidiary
+	req := suite.paidOnBehalf(100, 100)
+	req.Transactions[0].TransactionType = domain.Debit
+	req.Counterparty.Transactions[0].TransactionType = domain.Credit
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, req, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockAccountSvc.AssertNotCalled(suite.T(), "GetAccountByIDs", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_SameWorkplace() {
+	ctx := context.Background()
+	req := suite.paidOnBehalf(100, 100)
+	req.Counterparty.WorkplaceID = suite.parentID
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, req, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+// postedTwins returns the posted journals of a payment on behalf of the subsidiary, linked to each other
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_DueAccountUsedByLines() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	req := suite.paidOnBehalf(100, 100)
+	req.DueFromAccountID = suite.parentBank.AccountID
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, req, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_DueToMustBeLiability() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	subBank := domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.subsidiaryID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.parentID, []string{suite.dueFromSub.AccountID}, suite.userID).
+		Return(map[string]domain.Account{suite.dueFromSub.AccountID: suite.dueFromSub}, nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.subsidiaryID, []string{subBank.AccountID}, suite.userID).
+		Return(map[string]domain.Account{subBank.AccountID: subBank}, nil).Once()
+	req := suite.paidOnBehalf(100, 100)
+	req.Counterparty.DueToAccountID = subBank.AccountID
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, req, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_DueFromInOtherCurrency() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	suite.dueFromSub.CurrencyCode = "EUR"
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.parentID, []string{suite.dueFromSub.AccountID}, suite.userID).
+		Return(map[string]domain.Account{suite.dueFromSub.AccountID: suite.dueFromSub}, nil).Once()
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, suite.paidOnBehalf(100, 100), suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestCreateIntercompanyJournal_DueToOfOtherWorkplace() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.parentID, []string{suite.dueFromSub.AccountID}, suite.userID).
+		Return(map[string]domain.Account{suite.dueFromSub.AccountID: suite.dueFromSub}, nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.subsidiaryID, []string{suite.dueFromSub.AccountID}, suite.userID).
+		Return(nil, apperrors.ErrNotFound).Once()
+	req := suite.paidOnBehalf(100, 100)
+	req.Counterparty.DueToAccountID = suite.dueFromSub.AccountID
+
+	_, _, err := suite.service.CreateIntercompanyJournal(ctx, suite.parentID, req, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) postedTwins(ctx context.Context, twinStatus domain.JournalStatus) (*domain.Journal, *domain.Journal) {
+	journal := &domain.Journal{JournalID: uuid.NewString(), WorkplaceID: suite.parentID, CurrencyCode: "USD", Status: domain.Posted, Amount: decimal.NewFromInt(100)}
+	twin := &domain.Journal{JournalID: uuid.NewString(), WorkplaceID: suite.subsidiaryID, CurrencyCode: "USD", Status: twinStatus, Amount: decimal.NewFromInt(100)}
+	journal.Twin = &domain.JournalTwin{JournalID: twin.JournalID, WorkplaceID: suite.subsidiaryID, Status: twinStatus}
+	twin.Twin = &domain.JournalTwin{JournalID: journal.JournalID, WorkplaceID: suite.parentID, Status: domain.Posted}
+	suite.mockJournalRepo.On("FindJournalByID", ctx, journal.JournalID).Return(journal, nil)
+	suite.mockJournalRepo.On("FindJournalByID", ctx, twin.JournalID).Return(twin, nil)
+	suite.mockJournalRepo.On("FindTransactionsByJournalID", ctx, journal.JournalID).Return([]domain.Transaction{
+		{TransactionID: uuid.NewString(), AccountID: suite.parentBank.AccountID, Amount: decimal.NewFromInt(100), TransactionType: domain.Credit},
+		{TransactionID: uuid.NewString(), AccountID: suite.dueFromSub.AccountID, Amount: decimal.NewFromInt(100), TransactionType: domain.Debit},
+	}, nil)
+	suite.mockJournalRepo.On("FindTransactionsByJournalID", ctx, twin.JournalID).Return([]domain.Transaction{
+		{TransactionID: uuid.NewString(), AccountID: suite.subExpense.AccountID, Amount: decimal.NewFromInt(100), TransactionType: domain.Debit},
+		{TransactionID: uuid.NewString(), AccountID: suite.dueToParent.AccountID, Amount: decimal.NewFromInt(100), TransactionType: domain.Credit},
+	}, nil)
+	return journal, twin
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestReverseIntercompanyJournal_ReversesBoth() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	suite.expectAccounts(ctx)
+	journal, twin := suite.postedTwins(ctx, domain.Posted)
+	suite.mockJournalRepo.On("SaveTwinJournals", ctx, mock.MatchedBy(func(p domain.JournalPosting) bool {
+		return *p.Journal.OriginalJournalID == journal.JournalID && p.BalanceChanges[suite.parentBank.AccountID].Equal(decimal.NewFromInt(100))
+	}), mock.MatchedBy(func(p domain.JournalPosting) bool {
+		return *p.Journal.OriginalJournalID == twin.JournalID && p.Journal.WorkplaceID == suite.subsidiaryID
+	})).Return(nil).Once()
+
+	reversal, twinReversal, err := suite.service.ReverseIntercompanyJournal(ctx, suite.parentID, journal.JournalID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(twinReversal.JournalID, reversal.Twin.JournalID)
+	suite.Equal(reversal.JournalID, twinReversal.Twin.JournalID)
+	suite.mockJournalRepo.AssertExpectations(suite.T())
+	suite.mockWorkplaceSvc.AssertCalled(suite.T(), "AuthorizeUserAction", ctx, suite.userID, suite.subsidiaryID, domain.RoleMember)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestReverseIntercompanyJournal_TwinAlreadyReversed() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	journal, _ := suite.postedTwins(ctx, domain.Reversed)
+
+	_, _, err := suite.service.ReverseIntercompanyJournal(ctx, suite.parentID, journal.JournalID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveTwinJournals", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestReverseIntercompanyJournal_NoTwin() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	journal := &domain.Journal{JournalID: uuid.NewString(), WorkplaceID: suite.parentID, Status: domain.Posted}
+	suite.mockJournalRepo.On("FindJournalByID", ctx, journal.JournalID).Return(journal, nil).Once()
+	suite.mockJournalRepo.On("FindTransactionsByJournalID", ctx, journal.JournalID).Return([]domain.Transaction{}, nil).Once()
+
+	_, _, err := suite.service.ReverseIntercompanyJournal(ctx, suite.parentID, journal.JournalID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
+
+func (suite *IntercompanyJournalServiceTestSuite) TestReverseJournal_OffersTwin() {
+	ctx := context.Background()
+	suite.expectAuthorized(ctx)
+	suite.expectAccounts(ctx)
+	journal, twin := suite.postedTwins(ctx, domain.Posted)
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	suite.mockJournalRepo.On("UpdateJournalStatusAndLinks", ctx, journal.JournalID, domain.Reversed, mock.Anything, mock.Anything, suite.userID, mock.Anything).Return(nil).Once()
+
+	reversal, err := suite.service.ReverseJournal(ctx, suite.parentID, journal.JournalID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().NotNil(reversal.TwinToReverse)
+	suite.Equal(twin.JournalID, reversal.TwinToReverse.JournalID)
+	suite.Equal(suite.subsidiaryID, reversal.TwinToReverse.WorkplaceID)
+	suite.Nil(reversal.Twin, "a one-sided reversal has no twin")
+}
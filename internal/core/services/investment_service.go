@@ -0,0 +1,849 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// investmentService implements the InvestmentSvcFacade interface
+type investmentService struct {
+	BaseService
+	investmentRepo portsrepo.InvestmentRepositoryFacade
+	priceRepo      portsrepo.PriceRepositoryFacade
+	accountRepo    portsrepo.AccountReader
+	currencyRepo   portsrepo.CurrencyReader
+	journalSvc     portssvc.JournalWriterSvc
+}
+
+// InvestmentServiceOption is a functional option for configuring the investment service
+type InvestmentServiceOption func(*investmentService)
+
+// WithInvestmentWorkplaceAuthorizer adds workplace authorizer dependency
+func WithInvestmentWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) InvestmentServiceOption {
+	return func(s *investmentService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewInvestmentService creates a new service for securities and trades. Trades are posted through the journal
+// service; lots are not stored but rebuilt from the posted investment lines, so reversing a trade journal
+// also undoes its effect on the lots. Holdings are valued with the commodity prices of each security's symbol
+// in its currency.
+func NewInvestmentService(investmentRepo portsrepo.InvestmentRepositoryFacade, priceRepo portsrepo.PriceRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, journalSvc portssvc.JournalWriterSvc, options ...InvestmentServiceOption) portssvc.InvestmentSvcFacade {
+	svc := &investmentService{
+		investmentRepo: investmentRepo,
+		priceRepo:      priceRepo,
+		accountRepo:    accountRepo,
+		currencyRepo:   currencyRepo,
+		journalSvc:     journalSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure investmentService implements the InvestmentSvcFacade interface
+var _ portssvc.InvestmentSvcFacade = (*investmentService)(nil)
+
+// lotKey identifies the position in one security held in one account
+type lotKey struct {
+	accountID  string
+	securityID string
+}
+
+// consumeLots removes quantity units from the lots according to the cost method and returns the remaining
+// lots and the cost of the units removed, rounded to the currency precision. Consuming a whole lot takes its
+// whole cost so that no rounding residue is left behind. Average cost takes units oldest first and then
+// spreads the remaining cost evenly over the remaining units.
+func consumeLots(lots []domain.Lot, quantity decimal.Decimal, method domain.CostMethod, precision int32) ([]domain.Lot, decimal.Decimal) {
+	held, pool := decimal.Zero, decimal.Zero
+	for _, lot := range lots {
+		held = held.Add(lot.Quantity)
+		pool = pool.Add(lot.CostBasis)
+	}
+	if quantity.GreaterThanOrEqual(held) {
+		return []domain.Lot{}, pool
+	}
+
+	remaining := append([]domain.Lot{}, lots...)
+	cost := decimal.Zero
+	left := quantity
+	for left.IsPositive() {
+		i := 0
+		if method == domain.CostLIFO {
+			i = len(remaining) - 1
+		}
+		lot := remaining[i]
+		if left.GreaterThanOrEqual(lot.Quantity) {
+			cost = cost.Add(lot.CostBasis)
+			left = left.Sub(lot.Quantity)
+			remaining = append(remaining[:i], remaining[i+1:]...)
+			continue
+		}
+		partial := lot.CostBasis.Mul(left).Div(lot.Quantity).Round(precision)
+		cost = cost.Add(partial)
+		remaining[i].Quantity = lot.Quantity.Sub(left)
+		remaining[i].CostBasis = lot.CostBasis.Sub(partial)
+		left = decimal.Zero
+	}
+
+	if method != domain.CostAverage {
+		return remaining, cost
+	}
+	cost = pool.Mul(quantity).Div(held).Round(precision)
+	rest := pool.Sub(cost)
+	restHeld := held.Sub(quantity)
+	spread := decimal.Zero
+	for i := range remaining {
+		if i == len(remaining)-1 {
+			remaining[i].CostBasis = rest.Sub(spread)
+			break
+		}
+		remaining[i].CostBasis = rest.Mul(remaining[i].Quantity).Div(restHeld).Round(precision)
+		spread = spread.Add(remaining[i].CostBasis)
+	}
+	return remaining, cost
+}
+
+// replayLots rebuilds the open lots of each position from investment lines in booking order: debit lines
+// open lots and credit lines consume them
+func replayLots(lines []domain.SecurityLine, methods map[string]domain.CostMethod, precision func(securityID string) int32) map[lotKey][]domain.Lot {
+	lots := make(map[lotKey][]domain.Lot)
+	for _, line := range lines {
+		key := lotKey{accountID: line.AccountID, securityID: line.SecurityID}
+		if line.TransactionType == domain.Debit {
+			lots[key] = append(lots[key], domain.Lot{
+				AccountID:     line.AccountID,
+				SecurityID:    line.SecurityID,
+				TransactionID: line.TransactionID,
+				JournalID:     line.JournalID,
+				AcquiredOn:    line.JournalDate,
+				Quantity:      line.Quantity,
+				CostBasis:     line.Amount,
+				UnitPrice:     line.UnitPrice,
+			})
+			continue
+		}
+		lots[key], _ = consumeLots(lots[key], line.Quantity, methods[line.SecurityID], precision(line.SecurityID))
+	}
+	return lots
+}
+
+// latestLineDate bounds listings of security lines that must include every line, whatever its date
+var latestLineDate = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// oversoldOn reports the first date on which a sale of quantity on saleDate, booked after the lines before it,
+// would leave the position short, checking the sale itself and every line booked after it
+func oversoldOn(before []domain.SecurityLine, after []domain.SecurityLine, saleDate time.Time, quantity decimal.Decimal) (time.Time, bool) {
+	held := decimal.Zero
+	for _, line := range before {
+		held = held.Add(signedQuantity(line))
+	}
+	held = held.Sub(quantity)
+	if held.IsNegative() {
+		return saleDate, true
+	}
+	for _, line := range after {
+		held = held.Add(signedQuantity(line))
+		if held.IsNegative() {
+			return line.JournalDate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// signedQuantity returns the units a line adds to its position: debits buy units and credits sell them
+func signedQuantity(line domain.SecurityLine) decimal.Decimal {
+	if line.TransactionType == domain.Debit {
+		return line.Quantity
+	}
+	return line.Quantity.Neg()
+}
+
+// endOfDay returns the last instant of the day of t, so that an as-of date includes journals dated later that day
+func endOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+// securityPrecisions returns a lookup of the currency precision of each security
+func (s *investmentService) securityPrecisions(ctx context.Context, securities map[string]domain.Security) func(string) int32 {
+	byCurrency := make(map[string]int32)
+	for _, security := range securities {
+		if _, ok := byCurrency[security.CurrencyCode]; !ok {
+			byCurrency[security.CurrencyCode] = currencyPrecision(ctx, s.currencyRepo, security.CurrencyCode)
+		}
+	}
+	return func(securityID string) int32 {
+		if precision, ok := byCurrency[securities[securityID].CurrencyCode]; ok {
+			return precision
+		}
+		return 2
+	}
+}
+
+// findSecurity loads a security and verifies that it belongs to the workplace
+func (s *investmentService) findSecurity(ctx context.Context, workplaceID string, securityID string) (*domain.Security, error) {
+	security, err := s.investmentRepo.FindSecurityByID(ctx, securityID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find security by ID",
+			slog.String("security_id", securityID))
+		return nil, fmt.Errorf("failed to find security: %w", err)
+	}
+	if security.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Security found but belongs to different workplace",
+			slog.String("security_id", securityID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return security, nil
+}
+
+// workplaceSecurities returns the securities of a workplace keyed by ID
+func (s *investmentService) workplaceSecurities(ctx context.Context, workplaceID string) (map[string]domain.Security, error) {
+	securities, err := s.investmentRepo.ListSecurities(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list securities",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	byID := make(map[string]domain.Security, len(securities))
+	for _, security := range securities {
+		byID[security.SecurityID] = security
+	}
+	return byID, nil
+}
+
+// openLots rebuilds the open lots of the workplace as of a date. Empty accountID or securityID match any.
+func (s *investmentService) openLots(ctx context.Context, workplaceID string, accountID string, securityID string, asOf time.Time, securities map[string]domain.Security) (map[lotKey][]domain.Lot, error) {
+	lines, err := s.investmentRepo.ListSecurityLines(ctx, workplaceID, accountID, securityID, asOf)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list security lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	methods := make(map[string]domain.CostMethod, len(securities))
+	for id, security := range securities {
+		methods[id] = security.CostMethod
+	}
+	return replayLots(lines, methods, s.securityPrecisions(ctx, securities)), nil
+}
+
+// investmentAccounts loads the accounts of a trade and verifies that the investment account is an ASSET
+// account in the currency of the security. The journal service validates the remaining accounts.
+func (s *investmentService) investmentAccounts(ctx context.Context, workplaceID string, security *domain.Security, investmentAccountID string, accountIDs ...string) (map[string]domain.Account, error) {
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, append([]string{investmentAccountID}, accountIDs...))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load accounts of trade",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	investment, ok := accounts[investmentAccountID]
+	if !ok || investment.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: investment account %s not found", apperrors.ErrValidation, investmentAccountID)
+	}
+	if investment.AccountType != domain.Asset {
+		return nil, fmt.Errorf("%w: investment account must be an ASSET account", apperrors.ErrValidation)
+	}
+	if investment.CurrencyCode != security.CurrencyCode {
+		return nil, fmt.Errorf("%w: investment account currency %s does not match security currency %s",
+			apperrors.ErrValidation, investment.CurrencyCode, security.CurrencyCode)
+	}
+	for _, id := range accountIDs {
+		if id == investmentAccountID {
+			return nil, fmt.Errorf("%w: the investment account cannot also be the other side of the trade", apperrors.ErrValidation)
+		}
+	}
+	return accounts, nil
+}
+
+// tradeSecurity loads the traded security and verifies that it can be traded
+func (s *investmentService) tradeSecurity(ctx context.Context, workplaceID string, securityID string) (*domain.Security, error) {
+	security, err := s.findSecurity(ctx, workplaceID, securityID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: security %s not found", apperrors.ErrValidation, securityID)
+		}
+		return nil, err
+	}
+	if !security.IsActive {
+		return nil, fmt.Errorf("%w: security %s is inactive", apperrors.ErrValidation, security.Symbol)
+	}
+	return security, nil
+}
+
+// buildSecurity applies a security request to a security
+func buildSecurity(security *domain.Security, req dto.SecurityRequest) error {
+	symbol := strings.TrimSpace(req.Symbol)
+	name := strings.TrimSpace(req.Name)
+	if symbol == "" || name == "" {
+		return fmt.Errorf("%w: symbol and name must not be blank", apperrors.ErrValidation)
+	}
+	if security.CurrencyCode != "" && security.CurrencyCode != req.CurrencyCode {
+		return fmt.Errorf("%w: the currency of a security cannot change", apperrors.ErrValidation)
+	}
+	security.Symbol = strings.ToUpper(symbol)
+	security.Name = name
+	security.SecurityType = req.SecurityType
+	security.CurrencyCode = req.CurrencyCode
+	security.CostMethod = req.CostMethod
+	if security.CostMethod == "" {
+		security.CostMethod = domain.CostFIFO
+	}
+	security.IsActive = req.IsActive == nil || *req.IsActive
+	return nil
+}
+
+// saveSecurityError converts repository errors from saving a security into service errors
+func saveSecurityError(err error) error {
+	if errors.Is(err, apperrors.ErrDuplicate) {
+		return fmt.Errorf("%w: a security with this symbol already exists", apperrors.ErrConflict)
+	}
+	return err
+}
+
+// journalValidationError reports the journal validation failures of a posting built by a service, such as a
+// counter account in another currency, as validation errors
+func journalValidationError(err error) error {
+	if errors.Is(err, ErrCurrencyMismatch) || errors.Is(err, ErrAccountNotFound) || errors.Is(err, ErrJournalMinAccounts) {
+		return fmt.Errorf("%w: %v", apperrors.ErrValidation, err)
+	}
+	return err
+}
+
+func (s *investmentService) CreateSecurity(ctx context.Context, workplaceID string, req dto.SecurityRequest, userID string) (*domain.Security, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create security",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	security := &domain.Security{
+		SecurityID:  uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := buildSecurity(security, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.investmentRepo.SaveSecurity(ctx, *security); err != nil {
+		s.LogError(ctx, err, "Failed to save security",
+			slog.String("workplace_id", workplaceID),
+			slog.String("symbol", security.Symbol))
+		return nil, saveSecurityError(err)
+	}
+
+	s.LogInfo(ctx, "Security created successfully",
+		slog.String("security_id", security.SecurityID),
+		slog.String("workplace_id", workplaceID))
+	return security, nil
+}
+
+func (s *investmentService) ListSecurities(ctx context.Context, workplaceID string, userID string) ([]domain.Security, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list securities",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	securities, err := s.investmentRepo.ListSecurities(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list securities",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return securities, nil
+}
+
+func (s *investmentService) GetSecurity(ctx context.Context, workplaceID string, securityID string, userID string) (*domain.Security, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view security",
+			slog.String("workplace_id", workplaceID),
+			slog.String("security_id", securityID))
+		return nil, err
+	}
+	return s.findSecurity(ctx, workplaceID, securityID)
+}
+
+func (s *investmentService) UpdateSecurity(ctx context.Context, workplaceID string, securityID string, req dto.SecurityRequest, userID string) (*domain.Security, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update security",
+			slog.String("workplace_id", workplaceID),
+			slog.String("security_id", securityID))
+		return nil, err
+	}
+
+	security, err := s.findSecurity(ctx, workplaceID, securityID)
+	if err != nil {
+		return nil, err
+	}
+	if err := buildSecurity(security, req); err != nil {
+		return nil, err
+	}
+	security.LastUpdatedAt = time.Now()
+	security.LastUpdatedBy = userID
+
+	if err := s.investmentRepo.UpdateSecurity(ctx, *security); err != nil {
+		s.LogError(ctx, err, "Failed to update security",
+			slog.String("security_id", securityID))
+		return nil, saveSecurityError(err)
+	}
+
+	s.LogInfo(ctx, "Security updated successfully",
+		slog.String("security_id", securityID),
+		slog.String("workplace_id", workplaceID))
+	return security, nil
+}
+
+func (s *investmentService) DeleteSecurity(ctx context.Context, workplaceID string, securityID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete security",
+			slog.String("workplace_id", workplaceID),
+			slog.String("security_id", securityID))
+		return err
+	}
+
+	if _, err := s.findSecurity(ctx, workplaceID, securityID); err != nil {
+		return err
+	}
+	if err := s.investmentRepo.DeleteSecurity(ctx, securityID); err != nil {
+		s.LogError(ctx, err, "Failed to delete security",
+			slog.String("security_id", securityID))
+		if errors.Is(err, apperrors.ErrConflict) {
+			return fmt.Errorf("%w: security has been traded; deactivate it instead", apperrors.ErrConflict)
+		}
+		return err
+	}
+
+	s.LogInfo(ctx, "Security deleted successfully",
+		slog.String("security_id", securityID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+// securityPriceKey identifies the commodity price series a security is valued with
+func securityPriceKey(security *domain.Security) domain.PriceKey {
+	return domain.PriceKey{Commodity: domain.NormalizeCommodity(security.Symbol), QuoteCurrency: security.CurrencyCode}
+}
+
+func (s *investmentService) RecordSecurityPrice(ctx context.Context, workplaceID string, securityID string, req dto.SecurityPriceRequest, userID string) (*domain.CommodityPrice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to record security price",
+			slog.String("workplace_id", workplaceID),
+			slog.String("security_id", securityID))
+		return nil, err
+	}
+
+	security, err := s.findSecurity(ctx, workplaceID, securityID)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Price.IsPositive() {
+		return nil, fmt.Errorf("%w: price must be positive", apperrors.ErrValidation)
+	}
+
+	key := securityPriceKey(security)
+	price := &domain.CommodityPrice{
+		Commodity:     key.Commodity,
+		QuoteCurrency: key.QuoteCurrency,
+		PriceDate:     domain.PriceDay(req.Date),
+		Price:         req.Price,
+		Source:        domain.PriceSourceManual,
+		CreatedAt:     time.Now(),
+		CreatedBy:     userID,
+	}
+	if err := s.priceRepo.SavePrices(ctx, []domain.CommodityPrice{*price}); err != nil {
+		s.LogError(ctx, err, "Failed to save security price",
+			slog.String("security_id", securityID))
+		return nil, err
+	}
+	return price, nil
+}
+
+func (s *investmentService) ListSecurityPrices(ctx context.Context, workplaceID string, securityID string, userID string) ([]domain.CommodityPrice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list security prices",
+			slog.String("workplace_id", workplaceID),
+			slog.String("security_id", securityID))
+		return nil, err
+	}
+
+	security, err := s.findSecurity(ctx, workplaceID, securityID)
+	if err != nil {
+		return nil, err
+	}
+	prices, err := s.priceRepo.ListPrices(ctx, securityPriceKey(security), time.Time{}, time.Time{})
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list security prices",
+			slog.String("security_id", securityID))
+		return nil, err
+	}
+	return prices, nil
+}
+
+func (s *investmentService) BuySecurity(ctx context.Context, workplaceID string, req dto.BuySecurityRequest, userID string) (*domain.Trade, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to buy security",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	security, err := s.tradeSecurity(ctx, workplaceID, req.SecurityID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.investmentAccounts(ctx, workplaceID, security, req.InvestmentAccountID, req.CashAccountID); err != nil {
+		return nil, err
+	}
+	if req.Fees.IsNegative() {
+		return nil, fmt.Errorf("%w: fees must not be negative", apperrors.ErrValidation)
+	}
+
+	cost := req.Quantity.Mul(req.UnitPrice).Add(req.Fees).Round(currencyPrecision(ctx, s.currencyRepo, security.CurrencyCode))
+	if !cost.IsPositive() {
+		return nil, fmt.Errorf("%w: purchase cost rounds to zero", apperrors.ErrValidation)
+	}
+	description := req.Description
+	if description == "" {
+		description = "Buy " + req.Quantity.String() + " " + security.Symbol
+	}
+
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         req.Date,
+		Description:  description,
+		CurrencyCode: security.CurrencyCode,
+		Transactions: []dto.CreateTransactionRequest{
+			{
+				AccountID:       req.InvestmentAccountID,
+				Amount:          cost,
+				TransactionType: domain.Debit,
+				Notes:           security.Symbol + " @ " + req.UnitPrice.String(),
+				SecurityID:      security.SecurityID,
+				Quantity:        req.Quantity,
+				UnitPrice:       req.UnitPrice,
+			},
+			{
+				AccountID:       req.CashAccountID,
+				Amount:          cost,
+				TransactionType: domain.Credit,
+				Notes:           "Purchase of " + security.Symbol,
+			},
+		},
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post purchase journal",
+			slog.String("security_id", security.SecurityID),
+			slog.String("workplace_id", workplaceID))
+		return nil, journalValidationError(err)
+	}
+
+	s.LogInfo(ctx, "Security bought successfully",
+		slog.String("security_id", security.SecurityID),
+		slog.String("journal_id", journal.JournalID),
+		slog.String("workplace_id", workplaceID))
+	return &domain.Trade{Journal: *journal, CostBasis: cost}, nil
+}
+
+func (s *investmentService) SellSecurity(ctx context.Context, workplaceID string, req dto.SellSecurityRequest, userID string) (*domain.Trade, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to sell security",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	security, err := s.tradeSecurity(ctx, workplaceID, req.SecurityID)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := s.investmentAccounts(ctx, workplaceID, security, req.InvestmentAccountID, req.CashAccountID, req.GainLossAccountID)
+	if err != nil {
+		return nil, err
+	}
+	gainLoss, ok := accounts[req.GainLossAccountID]
+	if !ok || (gainLoss.AccountType != domain.Revenue && gainLoss.AccountType != domain.Expense) {
+		return nil, fmt.Errorf("%w: gain/loss account must be a REVENUE or EXPENSE account", apperrors.ErrValidation)
+	}
+	if req.CashAccountID == req.GainLossAccountID {
+		return nil, fmt.Errorf("%w: cash and gain/loss accounts must differ", apperrors.ErrValidation)
+	}
+	if req.Fees.IsNegative() {
+		return nil, fmt.Errorf("%w: fees must not be negative", apperrors.ErrValidation)
+	}
+
+	// Lines dated after the sale are loaded too: a backdated sale must not oversell the sales booked after it
+	lines, err := s.investmentRepo.ListSecurityLines(ctx, workplaceID, req.InvestmentAccountID, security.SecurityID, latestLineDate)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list security lines",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	before := 0
+	for before < len(lines) && !lines[before].JournalDate.After(req.Date) {
+		before++
+	}
+	if short, ok := oversoldOn(lines[:before], lines[before:], req.Date, req.Quantity); ok {
+		return nil, fmt.Errorf("%w: selling %s units of %s on %s leaves a negative position on %s", apperrors.ErrValidation,
+			req.Quantity, security.Symbol, req.Date.Format("2006-01-02"), short.Format("2006-01-02"))
+	}
+
+	precision := currencyPrecision(ctx, s.currencyRepo, security.CurrencyCode)
+	methods := map[string]domain.CostMethod{security.SecurityID: security.CostMethod}
+	lots := replayLots(lines[:before], methods, func(string) int32 { return precision })
+	position := lots[lotKey{accountID: req.InvestmentAccountID, securityID: security.SecurityID}]
+	_, cost := consumeLots(position, req.Quantity, security.CostMethod, precision)
+	proceeds := req.Quantity.Mul(req.UnitPrice).Sub(req.Fees).Round(precision)
+	if !proceeds.IsPositive() {
+		return nil, fmt.Errorf("%w: fees exceed the sale proceeds", apperrors.ErrValidation)
+	}
+	if !cost.IsPositive() {
+		return nil, fmt.Errorf("%w: cost basis of the units sold rounds to zero", apperrors.ErrValidation)
+	}
+	gain := proceeds.Sub(cost)
+
+	transactions := []dto.CreateTransactionRequest{
+		{
+			AccountID:       req.CashAccountID,
+			Amount:          proceeds,
+			TransactionType: domain.Debit,
+			Notes:           "Sale of " + security.Symbol,
+		},
+		{
+			AccountID:       req.InvestmentAccountID,
+			Amount:          cost,
+			TransactionType: domain.Credit,
+			Notes:           security.Symbol + " @ " + req.UnitPrice.String(),
+			SecurityID:      security.SecurityID,
+			Quantity:        req.Quantity,
+			UnitPrice:       req.UnitPrice,
+		},
+	}
+	if gain.IsPositive() {
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID:       req.GainLossAccountID,
+			Amount:          gain,
+			TransactionType: domain.Credit,
+			Notes:           "Realized gain on " + security.Symbol,
+		})
+	} else if gain.IsNegative() {
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID:       req.GainLossAccountID,
+			Amount:          gain.Neg(),
+			TransactionType: domain.Debit,
+			Notes:           "Realized loss on " + security.Symbol,
+		})
+	}
+	description := req.Description
+	if description == "" {
+		description = "Sell " + req.Quantity.String() + " " + security.Symbol
+	}
+
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         req.Date,
+		Description:  description,
+		CurrencyCode: security.CurrencyCode,
+		Transactions: transactions,
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post sale journal",
+			slog.String("security_id", security.SecurityID),
+			slog.String("workplace_id", workplaceID))
+		return nil, journalValidationError(err)
+	}
+
+	s.LogInfo(ctx, "Security sold successfully",
+		slog.String("security_id", security.SecurityID),
+		slog.String("journal_id", journal.JournalID),
+		slog.String("realized_gain", gain.String()),
+		slog.String("workplace_id", workplaceID))
+	return &domain.Trade{Journal: *journal, CostBasis: cost, Proceeds: proceeds, RealizedGain: gain}, nil
+}
+
+func (s *investmentService) ListLots(ctx context.Context, workplaceID string, params dto.ListLotsParams, userID string) ([]domain.Lot, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list lots",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	asOf := time.Now()
+	if params.AsOf != nil {
+		asOf = endOfDay(*params.AsOf)
+	}
+	securities, err := s.workplaceSecurities(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+	byKey, err := s.openLots(ctx, workplaceID, params.AccountID, params.SecurityID, asOf, securities)
+	if err != nil {
+		return nil, err
+	}
+
+	lots := []domain.Lot{}
+	for _, position := range byKey {
+		lots = append(lots, position...)
+	}
+	sort.SliceStable(lots, func(i, j int) bool {
+		if !lots[i].AcquiredOn.Equal(lots[j].AcquiredOn) {
+			return lots[i].AcquiredOn.Before(lots[j].AcquiredOn)
+		}
+		return lots[i].TransactionID < lots[j].TransactionID
+	})
+	return lots, nil
+}
+
+// valueHoldings builds the positions of a workplace as of a date and values them with the latest commodity
+// price of each security on or before that date
+func (s *investmentService) valueHoldings(ctx context.Context, workplaceID string, params dto.HoldingsParams) (*domain.Holdings, error) {
+	asOf := time.Now()
+	if params.AsOf != nil {
+		asOf = endOfDay(*params.AsOf)
+	}
+	securities, err := s.workplaceSecurities(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+	byKey, err := s.openLots(ctx, workplaceID, params.AccountID, "", asOf, securities)
+	if err != nil {
+		return nil, err
+	}
+
+	holdings := []domain.Holding{}
+	keys := []domain.PriceKey{}
+	seen := map[domain.PriceKey]bool{}
+	for key, position := range byKey {
+		security := securities[key.securityID]
+		holding := domain.Holding{
+			AccountID:    key.accountID,
+			SecurityID:   key.securityID,
+			Symbol:       security.Symbol,
+			CurrencyCode: security.CurrencyCode,
+		}
+		for _, lot := range position {
+			holding.Quantity = holding.Quantity.Add(lot.Quantity)
+			holding.CostBasis = holding.CostBasis.Add(lot.CostBasis)
+		}
+		if holding.Quantity.IsPositive() {
+			holdings = append(holdings, holding)
+			if priceKey := securityPriceKey(&security); !seen[priceKey] {
+				seen[priceKey] = true
+				keys = append(keys, priceKey)
+			}
+		}
+	}
+
+	if len(keys) > 0 {
+		prices, err := s.priceRepo.FindPricesAsOf(ctx, keys, asOf)
+		if err != nil {
+			s.LogError(ctx, err, "Failed to find latest security prices",
+				slog.String("workplace_id", workplaceID))
+			return nil, err
+		}
+		latest := make(map[domain.PriceKey]domain.CommodityPrice, len(prices))
+		for _, price := range prices {
+			latest[domain.PriceKey{Commodity: price.Commodity, QuoteCurrency: price.QuoteCurrency}] = price
+		}
+		precision := s.securityPrecisions(ctx, securities)
+		for i := range holdings {
+			security := securities[holdings[i].SecurityID]
+			price, ok := latest[securityPriceKey(&security)]
+			if !ok {
+				continue
+			}
+			marketValue := holdings[i].Quantity.Mul(price.Price).Round(precision(holdings[i].SecurityID))
+			unrealized := marketValue.Sub(holdings[i].CostBasis)
+			holdings[i].Price = &price.Price
+			holdings[i].PriceDate = &price.PriceDate
+			holdings[i].MarketValue = &marketValue
+			holdings[i].UnrealizedGain = &unrealized
+		}
+	}
+
+	sort.SliceStable(holdings, func(i, j int) bool {
+		if holdings[i].AccountID != holdings[j].AccountID {
+			return holdings[i].AccountID < holdings[j].AccountID
+		}
+		return holdings[i].Symbol < holdings[j].Symbol
+	})
+	return &domain.Holdings{AsOf: asOf, Holdings: holdings}, nil
+}
+
+func (s *investmentService) GetHoldings(ctx context.Context, workplaceID string, params dto.HoldingsParams, userID string) (*domain.Holdings, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view holdings",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	return s.valueHoldings(ctx, workplaceID, params)
+}
+
+func (s *investmentService) GetUnrealizedGains(ctx context.Context, workplaceID string, params dto.HoldingsParams, userID string) (*domain.UnrealizedGainReport, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view unrealized gains",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	holdings, err := s.valueHoldings(ctx, workplaceID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]*domain.UnrealizedGainTotal{}
+	currencies := []string{}
+	for _, holding := range holdings.Holdings {
+		total, ok := totals[holding.CurrencyCode]
+		if !ok {
+			total = &domain.UnrealizedGainTotal{CurrencyCode: holding.CurrencyCode}
+			totals[holding.CurrencyCode] = total
+			currencies = append(currencies, holding.CurrencyCode)
+		}
+		if holding.MarketValue == nil {
+			total.UnpricedCostBasis = total.UnpricedCostBasis.Add(holding.CostBasis)
+			continue
+		}
+		total.CostBasis = total.CostBasis.Add(holding.CostBasis)
+		total.MarketValue = total.MarketValue.Add(*holding.MarketValue)
+		total.UnrealizedGain = total.UnrealizedGain.Add(*holding.UnrealizedGain)
+	}
+	sort.Strings(currencies)
+
+	report := &domain.UnrealizedGainReport{
+		AsOf:     holdings.AsOf,
+		Totals:   make([]domain.UnrealizedGainTotal, 0, len(currencies)),
+		Holdings: holdings.Holdings,
+	}
+	for _, currency := range currencies {
+		report.Totals = append(report.Totals, *totals[currency])
+	}
+	return report, nil
+}
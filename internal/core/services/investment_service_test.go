@@ -0,0 +1,425 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock InvestmentRepository ---
+type MockInvestmentRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.InvestmentRepositoryFacade = (*MockInvestmentRepository)(nil)
+
+func (m *MockInvestmentRepository) FindSecurityByID(ctx context.Context, securityID string) (*domain.Security, error) {
+	args := m.Called(ctx, securityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Security), args.Error(1)
+}
+
+func (m *MockInvestmentRepository) ListSecurities(ctx context.Context, workplaceID string) ([]domain.Security, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Security), args.Error(1)
+}
+
+func (m *MockInvestmentRepository) ListSecurityLines(ctx context.Context, workplaceID string, accountID string, securityID string, asOf time.Time) ([]domain.SecurityLine, error) {
+	args := m.Called(ctx, workplaceID, accountID, securityID, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.SecurityLine), args.Error(1)
+}
+
+func (m *MockInvestmentRepository) SaveSecurity(ctx context.Context, security domain.Security) error {
+	args := m.Called(ctx, security)
+	return args.Error(0)
+}
+
+func (m *MockInvestmentRepository) UpdateSecurity(ctx context.Context, security domain.Security) error {
+	args := m.Called(ctx, security)
+	return args.Error(0)
+}
+
+func (m *MockInvestmentRepository) DeleteSecurity(ctx context.Context, securityID string) error {
+	args := m.Called(ctx, securityID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type InvestmentServiceTestSuite struct {
+	suite.Suite
+	mockInvestmentRepo *MockInvestmentRepository
+	mockPriceRepo      *MockPriceRepository
+	mockAccountRepo    *MockAccountRepositoryFacade
+	mockCurrencyRepo   *MockCurrencyRepository
+	mockJournalSvc     *MockJournalWriterSvc
+	mockWorkplaceSvc   *MockWorkplaceService
+	service            portssvc.InvestmentSvcFacade
+	workplaceID        string
+	userID             string
+	security           domain.Security
+}
+
+func (suite *InvestmentServiceTestSuite) SetupTest() {
+	suite.mockInvestmentRepo = new(MockInvestmentRepository)
+	suite.mockPriceRepo = new(MockPriceRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockJournalSvc = new(MockJournalWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewInvestmentService(suite.mockInvestmentRepo, suite.mockPriceRepo, suite.mockAccountRepo, suite.mockCurrencyRepo,
+		suite.mockJournalSvc, services.WithInvestmentWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.security = domain.Security{
+		SecurityID:   uuid.NewString(),
+		WorkplaceID:  suite.workplaceID,
+		Symbol:       "ACME",
+		Name:         "Acme Corp",
+		SecurityType: domain.SecurityStock,
+		CurrencyCode: "USD",
+		CostMethod:   domain.CostFIFO,
+		IsActive:     true,
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", mock.Anything, suite.userID, suite.workplaceID, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func TestInvestmentService(t *testing.T) {
+	suite.Run(t, new(InvestmentServiceTestSuite))
+}
+
+// expectTradeAccounts mocks the brokerage ASSET account, the cash account and the gain/loss REVENUE account
+func (suite *InvestmentServiceTestSuite) expectTradeAccounts(ctx context.Context) {
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"brokerage": {AccountID: "brokerage", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD"},
+		"cash":      {AccountID: "cash", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD"},
+		"gains":     {AccountID: "gains", WorkplaceID: suite.workplaceID, AccountType: domain.Revenue, CurrencyCode: "USD"},
+	}, nil).Once()
+}
+
+// twoBuys returns purchases of 10 units at 100 and 10 units at 120 into the brokerage account
+func (suite *InvestmentServiceTestSuite) twoBuys() []domain.SecurityLine {
+	return []domain.SecurityLine{
+		{TransactionID: "buy-1", AccountID: "brokerage", SecurityID: suite.security.SecurityID, TransactionType: domain.Debit,
+			Amount: decimal.NewFromInt(1000), Quantity: decimal.NewFromInt(10), UnitPrice: decimal.NewFromInt(100),
+			JournalDate: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{TransactionID: "buy-2", AccountID: "brokerage", SecurityID: suite.security.SecurityID, TransactionType: domain.Debit,
+			Amount: decimal.NewFromInt(1200), Quantity: decimal.NewFromInt(10), UnitPrice: decimal.NewFromInt(120),
+			JournalDate: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)},
+	}
+}
+
+func (suite *InvestmentServiceTestSuite) sellRequest(quantity int64, unitPrice int64, fees int64) dto.SellSecurityRequest {
+	return dto.SellSecurityRequest{
+		SecurityID:          suite.security.SecurityID,
+		InvestmentAccountID: "brokerage",
+		CashAccountID:       "cash",
+		GainLossAccountID:   "gains",
+		Quantity:            decimal.NewFromInt(quantity),
+		UnitPrice:           decimal.NewFromInt(unitPrice),
+		Fees:                decimal.NewFromInt(fees),
+		Date:                time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// expectSale mocks the security, its lots and the sale journal, which must consist of the expected lines
+func (suite *InvestmentServiceTestSuite) expectSale(ctx context.Context, req dto.SellSecurityRequest, lines ...expectedLine) {
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.expectTradeAccounts(ctx)
+	suite.mockInvestmentRepo.On("ListSecurityLines", ctx, suite.workplaceID, "brokerage", suite.security.SecurityID, mock.Anything).
+		Return(suite.twoBuys(), nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return r.CurrencyCode == "USD" && hasLines(r, lines...) &&
+			r.Transactions[1].SecurityID == suite.security.SecurityID && r.Transactions[1].Quantity.Equal(req.Quantity)
+	}), suite.userID).Return(&domain.Journal{JournalID: uuid.NewString()}, nil).Once()
+}
+
+func (suite *InvestmentServiceTestSuite) TestCreateSecurity_NormalizesSymbolAndDefaultsToFIFO() {
+	ctx := context.Background()
+	suite.mockInvestmentRepo.On("SaveSecurity", ctx, mock.MatchedBy(func(s domain.Security) bool {
+		return s.Symbol == "VTI" && s.CostMethod == domain.CostFIFO && s.IsActive && s.WorkplaceID == suite.workplaceID
+	})).Return(nil).Once()
+
+	security, err := suite.service.CreateSecurity(ctx, suite.workplaceID, dto.SecurityRequest{
+		Symbol: " vti ", Name: "Total Market ETF", SecurityType: domain.SecurityETF, CurrencyCode: "USD",
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("VTI", security.Symbol)
+	suite.mockInvestmentRepo.AssertExpectations(suite.T())
+}
+
+func (suite *InvestmentServiceTestSuite) TestCreateSecurity_DuplicateSymbolIsConflict() {
+	ctx := context.Background()
+	suite.mockInvestmentRepo.On("SaveSecurity", ctx, mock.Anything).Return(apperrors.ErrDuplicate).Once()
+
+	_, err := suite.service.CreateSecurity(ctx, suite.workplaceID, dto.SecurityRequest{
+		Symbol: "ACME", Name: "Acme", SecurityType: domain.SecurityStock, CurrencyCode: "USD",
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+}
+
+func (suite *InvestmentServiceTestSuite) TestUpdateSecurity_CurrencyCannotChange() {
+	ctx := context.Background()
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+
+	_, err := suite.service.UpdateSecurity(ctx, suite.workplaceID, suite.security.SecurityID, dto.SecurityRequest{
+		Symbol: "ACME", Name: "Acme", SecurityType: domain.SecurityStock, CurrencyCode: "EUR",
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockInvestmentRepo.AssertNotCalled(suite.T(), "UpdateSecurity", mock.Anything, mock.Anything)
+}
+
+func (suite *InvestmentServiceTestSuite) TestBuySecurity_CapitalizesFeesOnSecurityLine() {
+	ctx := context.Background()
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.expectTradeAccounts(ctx)
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return r.Description == "Buy 3 ACME" && hasLines(r,
+			expectedLine{"brokerage", "305.5", domain.Debit},
+			expectedLine{"cash", "305.5", domain.Credit},
+		) && r.Transactions[0].SecurityID == suite.security.SecurityID && r.Transactions[0].Quantity.Equal(decimal.NewFromInt(3)) &&
+			r.Transactions[0].UnitPrice.Equal(decimal.RequireFromString("101.5")) && r.Transactions[1].SecurityID == ""
+	}), suite.userID).Return(&domain.Journal{JournalID: uuid.NewString()}, nil).Once()
+
+	trade, err := suite.service.BuySecurity(ctx, suite.workplaceID, dto.BuySecurityRequest{
+		SecurityID: suite.security.SecurityID, InvestmentAccountID: "brokerage", CashAccountID: "cash",
+		Quantity: decimal.NewFromInt(3), UnitPrice: decimal.RequireFromString("101.5"), Fees: decimal.RequireFromString("1"),
+		Date: time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(trade.CostBasis.Equal(decimal.RequireFromString("305.5")))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+}
+
+func (suite *InvestmentServiceTestSuite) TestBuySecurity_RequiresAssetInvestmentAccount() {
+	ctx := context.Background()
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.expectTradeAccounts(ctx)
+
+	_, err := suite.service.BuySecurity(ctx, suite.workplaceID, dto.BuySecurityRequest{
+		SecurityID: suite.security.SecurityID, InvestmentAccountID: "gains", CashAccountID: "cash",
+		Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(10), Date: time.Now(),
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *InvestmentServiceTestSuite) TestSellSecurity_FIFOPostsRealizedGain() {
+	ctx := context.Background()
+	req := suite.sellRequest(15, 130, 5)
+	// Cost: all of the first lot (1000) and half of the second (600); proceeds 15 x 130 - 5
+	suite.expectSale(ctx, req,
+		expectedLine{"cash", "1945", domain.Debit},
+		expectedLine{"brokerage", "1600", domain.Credit},
+		expectedLine{"gains", "345", domain.Credit},
+	)
+
+	trade, err := suite.service.SellSecurity(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(trade.RealizedGain.Equal(decimal.NewFromInt(345)))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+}
+
+func (suite *InvestmentServiceTestSuite) TestSellSecurity_LIFOPostsRealizedLoss() {
+	ctx := context.Background()
+	suite.security.CostMethod = domain.CostLIFO
+	req := suite.sellRequest(15, 100, 0)
+	// Cost: all of the second lot (1200) and half of the first (500)
+	suite.expectSale(ctx, req,
+		expectedLine{"cash", "1500", domain.Debit},
+		expectedLine{"brokerage", "1700", domain.Credit},
+		expectedLine{"gains", "200", domain.Debit},
+	)
+
+	trade, err := suite.service.SellSecurity(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(trade.RealizedGain.Equal(decimal.NewFromInt(-200)))
+}
+
+func (suite *InvestmentServiceTestSuite) TestSellSecurity_AverageCost() {
+	ctx := context.Background()
+	suite.security.CostMethod = domain.CostAverage
+	req := suite.sellRequest(15, 130, 0)
+	// Cost: 15 units at the average of 2200 / 20
+	suite.expectSale(ctx, req,
+		expectedLine{"cash", "1950", domain.Debit},
+		expectedLine{"brokerage", "1650", domain.Credit},
+		expectedLine{"gains", "300", domain.Credit},
+	)
+
+	_, err := suite.service.SellSecurity(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.Require().NoError(err)
+}
+
+func (suite *InvestmentServiceTestSuite) TestSellSecurity_MoreThanHeldIsRejected() {
+	ctx := context.Background()
+	req := suite.sellRequest(21, 130, 0)
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.expectTradeAccounts(ctx)
+	suite.mockInvestmentRepo.On("ListSecurityLines", ctx, suite.workplaceID, "brokerage", suite.security.SecurityID, mock.Anything).
+		Return(suite.twoBuys(), nil).Once()
+
+	_, err := suite.service.SellSecurity(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *InvestmentServiceTestSuite) TestSellSecurity_BackdatedSaleCannotOversellLaterSales() {
+	ctx := context.Background()
+	// 20 units are held on 2025-03-01, but 15 of them were already sold on 2025-04-01
+	lines := append(suite.twoBuys(), domain.SecurityLine{TransactionID: "sell-1", AccountID: "brokerage",
+		SecurityID: suite.security.SecurityID, TransactionType: domain.Credit, Amount: decimal.NewFromInt(1600),
+		Quantity: decimal.NewFromInt(15), UnitPrice: decimal.NewFromInt(140), JournalDate: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)})
+	req := suite.sellRequest(10, 130, 0)
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.expectTradeAccounts(ctx)
+	suite.mockInvestmentRepo.On("ListSecurityLines", ctx, suite.workplaceID, "brokerage", suite.security.SecurityID, mock.Anything).
+		Return(lines, nil).Once()
+
+	_, err := suite.service.SellSecurity(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.Contains(err.Error(), "2025-04-01")
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *InvestmentServiceTestSuite) TestSellSecurity_GainLossAccountMustBeIncomeStatement() {
+	ctx := context.Background()
+	req := suite.sellRequest(5, 130, 0)
+	req.GainLossAccountID = "cash"
+	req.CashAccountID = "brokerage-cash"
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.expectTradeAccounts(ctx)
+
+	_, err := suite.service.SellSecurity(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+}
+
+func (suite *InvestmentServiceTestSuite) TestGetHoldings_ValuesRemainingLotsAtLatestPrice() {
+	ctx := context.Background()
+	asOf := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	lines := append(suite.twoBuys(), domain.SecurityLine{
+		TransactionID: "sell-1", AccountID: "brokerage", SecurityID: suite.security.SecurityID, TransactionType: domain.Credit,
+		Amount: decimal.NewFromInt(1600), Quantity: decimal.NewFromInt(15), UnitPrice: decimal.NewFromInt(130),
+		JournalDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+	suite.mockInvestmentRepo.On("ListSecurities", ctx, suite.workplaceID).Return([]domain.Security{suite.security}, nil).Once()
+	suite.mockInvestmentRepo.On("ListSecurityLines", ctx, suite.workplaceID, "", "", mock.Anything).Return(lines, nil).Once()
+	suite.mockPriceRepo.On("FindPricesAsOf", ctx, []domain.PriceKey{{Commodity: "ACME", QuoteCurrency: "USD"}}, mock.Anything).Return([]domain.CommodityPrice{
+		{Commodity: "ACME", QuoteCurrency: "USD", PriceDate: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), Price: decimal.NewFromInt(140)},
+	}, nil).Once()
+
+	holdings, err := suite.service.GetHoldings(ctx, suite.workplaceID, dto.HoldingsParams{AsOf: &asOf}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(holdings.Holdings, 1)
+	holding := holdings.Holdings[0]
+	suite.Equal("ACME", holding.Symbol)
+	suite.True(holding.Quantity.Equal(decimal.NewFromInt(5)))
+	suite.True(holding.CostBasis.Equal(decimal.NewFromInt(600)))
+	suite.Require().NotNil(holding.MarketValue)
+	suite.True(holding.MarketValue.Equal(decimal.NewFromInt(700)))
+	suite.True(holding.UnrealizedGain.Equal(decimal.NewFromInt(100)))
+}
+
+func (suite *InvestmentServiceTestSuite) TestRecordSecurityPrice_StoresCommodityPriceOfSymbol() {
+	ctx := context.Background()
+	suite.security.Symbol = "acme"
+	suite.mockInvestmentRepo.On("FindSecurityByID", ctx, suite.security.SecurityID).Return(&suite.security, nil).Once()
+	suite.mockPriceRepo.On("SavePrices", ctx, mock.MatchedBy(func(prices []domain.CommodityPrice) bool {
+		return len(prices) == 1 && prices[0].Commodity == "ACME" && prices[0].QuoteCurrency == "USD" &&
+			prices[0].PriceDate.Equal(time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC)) && prices[0].Source == domain.PriceSourceManual
+	})).Return(nil).Once()
+
+	price, err := suite.service.RecordSecurityPrice(ctx, suite.workplaceID, suite.security.SecurityID, dto.SecurityPriceRequest{
+		Date: time.Date(2025, 3, 28, 15, 30, 0, 0, time.UTC), Price: decimal.NewFromInt(140),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("ACME", price.Commodity)
+	suite.mockPriceRepo.AssertExpectations(suite.T())
+}
+
+func (suite *InvestmentServiceTestSuite) TestGetUnrealizedGains_TotalsPricedAndUnpricedHoldings() {
+	ctx := context.Background()
+	unpriced := domain.Security{
+		SecurityID: uuid.NewString(), WorkplaceID: suite.workplaceID, Symbol: "NEWCO", CurrencyCode: "USD", CostMethod: domain.CostFIFO,
+	}
+	lines := append(suite.twoBuys(), domain.SecurityLine{
+		TransactionID: "buy-3", AccountID: "brokerage", SecurityID: unpriced.SecurityID, TransactionType: domain.Debit,
+		Amount: decimal.NewFromInt(300), Quantity: decimal.NewFromInt(3), UnitPrice: decimal.NewFromInt(100),
+		JournalDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+	suite.mockInvestmentRepo.On("ListSecurities", ctx, suite.workplaceID).Return([]domain.Security{suite.security, unpriced}, nil).Once()
+	suite.mockInvestmentRepo.On("ListSecurityLines", ctx, suite.workplaceID, "", "", mock.Anything).Return(lines, nil).Once()
+	suite.mockPriceRepo.On("FindPricesAsOf", ctx, mock.MatchedBy(func(keys []domain.PriceKey) bool { return len(keys) == 2 }), mock.Anything).
+		Return([]domain.CommodityPrice{
+			{Commodity: "ACME", QuoteCurrency: "USD", PriceDate: time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC), Price: decimal.NewFromInt(105)},
+		}, nil).Once()
+
+	report, err := suite.service.GetUnrealizedGains(ctx, suite.workplaceID, dto.HoldingsParams{}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Holdings, 2)
+	suite.Require().Len(report.Totals, 1)
+	total := report.Totals[0]
+	suite.Equal("USD", total.CurrencyCode)
+	// 20 ACME bought for 2200 are worth 2100; the 3 NEWCO have no price
+	suite.True(total.CostBasis.Equal(decimal.NewFromInt(2200)))
+	suite.True(total.MarketValue.Equal(decimal.NewFromInt(2100)))
+	suite.True(total.UnrealizedGain.Equal(decimal.NewFromInt(-100)))
+	suite.True(total.UnpricedCostBasis.Equal(decimal.NewFromInt(300)))
+}
+
+func (suite *InvestmentServiceTestSuite) TestListLots_AverageCostSpreadsRemainingCost() {
+	ctx := context.Background()
+	suite.security.CostMethod = domain.CostAverage
+	lines := append(suite.twoBuys(), domain.SecurityLine{
+		TransactionID: "sell-1", AccountID: "brokerage", SecurityID: suite.security.SecurityID, TransactionType: domain.Credit,
+		Amount: decimal.NewFromInt(550), Quantity: decimal.NewFromInt(5), UnitPrice: decimal.NewFromInt(130),
+		JournalDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+	suite.mockInvestmentRepo.On("ListSecurities", ctx, suite.workplaceID).Return([]domain.Security{suite.security}, nil).Once()
+	suite.mockInvestmentRepo.On("ListSecurityLines", ctx, suite.workplaceID, "", suite.security.SecurityID, mock.Anything).Return(lines, nil).Once()
+
+	lots, err := suite.service.ListLots(ctx, suite.workplaceID, dto.ListLotsParams{SecurityID: suite.security.SecurityID}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(lots, 2)
+	// 15 units remain at the average cost of 110: 5 from the first lot, 10 from the second
+	suite.True(lots[0].Quantity.Equal(decimal.NewFromInt(5)))
+	suite.True(lots[0].CostBasis.Equal(decimal.NewFromInt(550)))
+	suite.True(lots[1].Quantity.Equal(decimal.NewFromInt(10)))
+	suite.True(lots[1].CostBasis.Equal(decimal.NewFromInt(1100)))
+}
@@ -0,0 +1,646 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// invoiceService implements the InvoiceSvcFacade interface
+type invoiceService struct {
+	BaseService
+	invoiceRepo  portsrepo.InvoiceRepositoryFacade
+	accountRepo  portsrepo.AccountReader
+	currencyRepo portsrepo.CurrencyReader
+	taxCodeRepo  portsrepo.TaxCodeReader
+	payeeRepo    portsrepo.PayeeReader
+	journalSvc   portssvc.JournalWriterSvc
+}
+
+// InvoiceServiceOption is a functional option for configuring the invoice service
+type InvoiceServiceOption func(*invoiceService)
+
+// WithInvoiceWorkplaceAuthorizer adds workplace authorizer dependency
+func WithInvoiceWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) InvoiceServiceOption {
+	return func(s *invoiceService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewInvoiceService creates a new service for sales invoices. Issuing an invoice and recording its payments
+// post journals through the journal service; the status of an invoice and the receivables are derived from
+// the journals that are still posted, so reversing a payment journal makes the amount outstanding again.
+func NewInvoiceService(invoiceRepo portsrepo.InvoiceRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, taxCodeRepo portsrepo.TaxCodeReader, payeeRepo portsrepo.PayeeReader, journalSvc portssvc.JournalWriterSvc, options ...InvoiceServiceOption) portssvc.InvoiceSvcFacade {
+	svc := &invoiceService{
+		invoiceRepo:  invoiceRepo,
+		accountRepo:  accountRepo,
+		currencyRepo: currencyRepo,
+		taxCodeRepo:  taxCodeRepo,
+		payeeRepo:    payeeRepo,
+		journalSvc:   journalSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure invoiceService implements the InvoiceSvcFacade interface
+var _ portssvc.InvoiceSvcFacade = (*invoiceService)(nil)
+
+// defaultInvoiceSequence is the numbering used until a workplace configures its own
+func defaultInvoiceSequence(workplaceID string) domain.InvoiceSequence {
+	return domain.InvoiceSequence{WorkplaceID: workplaceID, Prefix: "INV-", NextNumber: 1, Padding: 5}
+}
+
+// findInvoice loads an invoice and verifies that it belongs to the workplace
+func (s *invoiceService) findInvoice(ctx context.Context, workplaceID string, invoiceID string) (*domain.Invoice, error) {
+	invoice, err := s.invoiceRepo.FindInvoiceByID(ctx, invoiceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find invoice by ID",
+			slog.String("invoice_id", invoiceID))
+		return nil, fmt.Errorf("failed to find invoice: %w", err)
+	}
+	if invoice.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Invoice found but belongs to different workplace",
+			slog.String("invoice_id", invoiceID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return invoice, nil
+}
+
+// findDraftInvoice loads an invoice that has not been issued yet
+func (s *invoiceService) findDraftInvoice(ctx context.Context, workplaceID string, invoiceID string) (*domain.Invoice, error) {
+	invoice, err := s.findInvoice(ctx, workplaceID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.IssueJournalID != "" {
+		return nil, fmt.Errorf("%w: invoice %s has been issued and can no longer be changed", apperrors.ErrConflict, invoice.InvoiceNumber)
+	}
+	return invoice, nil
+}
+
+// applyInvoiceRequest validates the content of a request and copies it onto a draft invoice. The receivable
+// account must be an ASSET account and sets the currency of the invoice; the revenue accounts must be REVENUE
+// accounts in that currency. Line amounts are rounded to the currency precision and split with their tax
+// codes exactly as the journal service will split them on issue.
+func (s *invoiceService) applyInvoiceRequest(ctx context.Context, workplaceID string, invoice *domain.Invoice, req dto.InvoiceRequest) error {
+	if _, err := loadWorkplacePayee(ctx, s.payeeRepo, workplaceID, req.CustomerID); err != nil {
+		return err
+	}
+
+	accountIDs := []string{req.ReceivableAccountID}
+	taxCodeIDs := []string{}
+	for _, line := range req.Lines {
+		accountIDs = append(accountIDs, line.RevenueAccountID)
+		if line.TaxCodeID != "" {
+			taxCodeIDs = append(taxCodeIDs, line.TaxCodeID)
+		}
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, uniqueStrings(accountIDs))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load accounts of invoice",
+			slog.String("workplace_id", workplaceID))
+		return err
+	}
+	receivable, ok := accounts[req.ReceivableAccountID]
+	if !ok || receivable.WorkplaceID != workplaceID {
+		return fmt.Errorf("%w: receivable account %s not found", apperrors.ErrValidation, req.ReceivableAccountID)
+	}
+	if receivable.AccountType != domain.Asset || !receivable.IsActive {
+		return fmt.Errorf("%w: receivable account must be an active ASSET account", apperrors.ErrValidation)
+	}
+
+	taxCodes := map[string]domain.TaxCode{}
+	if len(taxCodeIDs) > 0 {
+		taxCodes, err = s.taxCodeRepo.FindTaxCodesByIDs(ctx, uniqueStrings(taxCodeIDs))
+		if err != nil {
+			s.LogError(ctx, err, "Failed to load tax codes of invoice",
+				slog.String("workplace_id", workplaceID))
+			return err
+		}
+	}
+
+	issueDate := dateOnly(req.IssueDate)
+	dueDate := issueDate
+	if req.DueDate != nil {
+		dueDate = dateOnly(*req.DueDate)
+	}
+	if dueDate.Before(issueDate) {
+		return fmt.Errorf("%w: due date cannot be before the issue date", apperrors.ErrValidation)
+	}
+
+	precision := currencyPrecision(ctx, s.currencyRepo, receivable.CurrencyCode)
+	lines := make([]domain.InvoiceLine, 0, len(req.Lines))
+	subtotal, taxTotal := decimal.Zero, decimal.Zero
+	for i, lineReq := range req.Lines {
+		description := strings.TrimSpace(lineReq.Description)
+		if description == "" {
+			return fmt.Errorf("%w: line %d: description must not be blank", apperrors.ErrValidation, i+1)
+		}
+		if !lineReq.Quantity.IsPositive() || lineReq.UnitPrice.IsNegative() {
+			return fmt.Errorf("%w: line %d: quantity must be positive and unit price not negative", apperrors.ErrValidation, i+1)
+		}
+		revenue, ok := accounts[lineReq.RevenueAccountID]
+		if !ok || revenue.WorkplaceID != workplaceID {
+			return fmt.Errorf("%w: line %d: revenue account %s not found", apperrors.ErrValidation, i+1, lineReq.RevenueAccountID)
+		}
+		if revenue.AccountType != domain.Revenue || !revenue.IsActive {
+			return fmt.Errorf("%w: line %d: revenue account must be an active REVENUE account", apperrors.ErrValidation, i+1)
+		}
+		if revenue.CurrencyCode != receivable.CurrencyCode {
+			return fmt.Errorf("%w: line %d: revenue account currency %s does not match invoice currency %s",
+				apperrors.ErrValidation, i+1, revenue.CurrencyCode, receivable.CurrencyCode)
+		}
+
+		amount := lineReq.Quantity.Mul(lineReq.UnitPrice).Round(precision)
+		net, tax := amount, decimal.Zero
+		if lineReq.TaxCodeID != "" {
+			taxCode, ok := taxCodes[lineReq.TaxCodeID]
+			if !ok || taxCode.WorkplaceID != workplaceID {
+				return fmt.Errorf("%w: line %d: tax code %s not found", apperrors.ErrValidation, i+1, lineReq.TaxCodeID)
+			}
+			if !taxCode.IsActive {
+				return fmt.Errorf("%w: line %d: tax code %s is inactive", apperrors.ErrValidation, i+1, taxCode.Code)
+			}
+			net, tax = taxCode.Split(amount, precision)
+		}
+		subtotal = subtotal.Add(net)
+		taxTotal = taxTotal.Add(tax)
+		lines = append(lines, domain.InvoiceLine{
+			LineID:           uuid.NewString(),
+			InvoiceID:        invoice.InvoiceID,
+			LineNumber:       i + 1,
+			Description:      description,
+			Quantity:         lineReq.Quantity,
+			UnitPrice:        lineReq.UnitPrice,
+			RevenueAccountID: lineReq.RevenueAccountID,
+			TaxCodeID:        lineReq.TaxCodeID,
+			Amount:           amount,
+			NetAmount:        net,
+			TaxAmount:        tax,
+		})
+	}
+	total := subtotal.Add(taxTotal)
+	if !total.IsPositive() {
+		return fmt.Errorf("%w: invoice total must be positive", apperrors.ErrValidation)
+	}
+
+	invoice.CustomerID = req.CustomerID
+	invoice.ReceivableAccountID = req.ReceivableAccountID
+	invoice.CurrencyCode = receivable.CurrencyCode
+	invoice.IssueDate = issueDate
+	invoice.DueDate = dueDate
+	invoice.Notes = strings.TrimSpace(req.Notes)
+	invoice.Subtotal = subtotal
+	invoice.TaxTotal = taxTotal
+	invoice.Total = total
+	invoice.Lines = lines
+	return nil
+}
+
+func (s *invoiceService) CreateInvoice(ctx context.Context, workplaceID string, req dto.InvoiceRequest, userID string) (*domain.Invoice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create invoice",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	invoice := &domain.Invoice{
+		InvoiceID:   uuid.NewString(),
+		WorkplaceID: workplaceID,
+		Payments:    []domain.InvoicePayment{},
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.applyInvoiceRequest(ctx, workplaceID, invoice, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.invoiceRepo.SaveInvoice(ctx, *invoice); err != nil {
+		s.LogError(ctx, err, "Failed to save invoice",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Invoice created successfully",
+		slog.String("invoice_id", invoice.InvoiceID),
+		slog.String("workplace_id", workplaceID))
+	return invoice, nil
+}
+
+func (s *invoiceService) UpdateInvoice(ctx context.Context, workplaceID string, invoiceID string, req dto.InvoiceRequest, userID string) (*domain.Invoice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update invoice",
+			slog.String("workplace_id", workplaceID),
+			slog.String("invoice_id", invoiceID))
+		return nil, err
+	}
+
+	invoice, err := s.findDraftInvoice(ctx, workplaceID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyInvoiceRequest(ctx, workplaceID, invoice, req); err != nil {
+		return nil, err
+	}
+
+	invoice.LastUpdatedAt = time.Now()
+	invoice.LastUpdatedBy = userID
+	if err := s.invoiceRepo.UpdateInvoice(ctx, *invoice); err != nil {
+		s.LogError(ctx, err, "Failed to update invoice",
+			slog.String("invoice_id", invoiceID))
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: invoice has been issued and can no longer be changed", apperrors.ErrConflict)
+		}
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Invoice updated successfully",
+		slog.String("invoice_id", invoiceID),
+		slog.String("workplace_id", workplaceID))
+	return invoice, nil
+}
+
+func (s *invoiceService) DeleteInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete invoice",
+			slog.String("workplace_id", workplaceID),
+			slog.String("invoice_id", invoiceID))
+		return err
+	}
+
+	if _, err := s.findDraftInvoice(ctx, workplaceID, invoiceID); err != nil {
+		return err
+	}
+	if err := s.invoiceRepo.DeleteInvoice(ctx, invoiceID); err != nil {
+		s.LogError(ctx, err, "Failed to delete invoice",
+			slog.String("invoice_id", invoiceID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Invoice deleted successfully",
+		slog.String("invoice_id", invoiceID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+func (s *invoiceService) ListInvoices(ctx context.Context, workplaceID string, params dto.ListInvoicesParams, userID string) ([]domain.Invoice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list invoices",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	invoices, err := s.invoiceRepo.ListInvoices(ctx, workplaceID, params.CustomerID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list invoices",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if params.Status == "" {
+		return invoices, nil
+	}
+
+	asOf := todayUTC()
+	filtered := make([]domain.Invoice, 0, len(invoices))
+	for _, invoice := range invoices {
+		if invoice.Status(asOf) == params.Status {
+			filtered = append(filtered, invoice)
+		}
+	}
+	return filtered, nil
+}
+
+func (s *invoiceService) GetInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) (*domain.Invoice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view invoice",
+			slog.String("workplace_id", workplaceID),
+			slog.String("invoice_id", invoiceID))
+		return nil, err
+	}
+	return s.findInvoice(ctx, workplaceID, invoiceID)
+}
+
+func (s *invoiceService) IssueInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) (*domain.Invoice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to issue invoice",
+			slog.String("workplace_id", workplaceID),
+			slog.String("invoice_id", invoiceID))
+		return nil, err
+	}
+
+	invoice, err := s.findDraftInvoice(ctx, workplaceID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Numbers are taken before posting so concurrent issues never share one; a failed issue leaves a gap
+	number, err := s.invoiceRepo.NextInvoiceNumber(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to take invoice number",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	transactions := []dto.CreateTransactionRequest{
+		{AccountID: invoice.ReceivableAccountID, Amount: invoice.Total, TransactionType: domain.Debit, Notes: "Invoice " + number},
+	}
+	for _, line := range invoice.Lines {
+		if !line.Amount.IsPositive() {
+			continue
+		}
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID:       line.RevenueAccountID,
+			Amount:          line.Amount,
+			TransactionType: domain.Credit,
+			Notes:           line.Description,
+			TaxCodeID:       line.TaxCodeID,
+		})
+	}
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         invoice.IssueDate,
+		Description:  "Invoice " + number,
+		CurrencyCode: invoice.CurrencyCode,
+		PayeeID:      invoice.CustomerID,
+		Transactions: transactions,
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post invoice journal",
+			slog.String("invoice_id", invoiceID))
+		return nil, journalValidationError(err)
+	}
+
+	now := time.Now()
+	if err := s.invoiceRepo.MarkInvoiceIssued(ctx, invoiceID, number, journal.JournalID, userID, now); err != nil {
+		s.LogError(ctx, err, "Failed to mark invoice issued, reversing journal",
+			slog.String("invoice_id", invoiceID),
+			slog.String("journal_id", journal.JournalID))
+		if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, journal.JournalID, userID); reverseErr != nil {
+			s.LogError(ctx, reverseErr, "Failed to reverse invoice journal",
+				slog.String("journal_id", journal.JournalID))
+		}
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: invoice number %s is already in use; adjust the invoice numbering", apperrors.ErrConflict, number)
+		}
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: invoice has already been issued", apperrors.ErrConflict)
+		}
+		return nil, err
+	}
+
+	invoice.InvoiceNumber = number
+	invoice.IssueJournalID = journal.JournalID
+	invoice.IssueJournalStatus = domain.Posted
+	invoice.LastUpdatedAt = now
+	invoice.LastUpdatedBy = userID
+
+	s.LogInfo(ctx, "Invoice issued successfully",
+		slog.String("invoice_id", invoiceID),
+		slog.String("invoice_number", number),
+		slog.String("journal_id", journal.JournalID))
+	return invoice, nil
+}
+
+func (s *invoiceService) RecordPayment(ctx context.Context, workplaceID string, invoiceID string, req dto.RecordInvoicePaymentRequest, userID string) (*domain.InvoicePayment, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to record invoice payment",
+			slog.String("workplace_id", workplaceID),
+			slog.String("invoice_id", invoiceID))
+		return nil, err
+	}
+
+	invoice, err := s.findInvoice(ctx, workplaceID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	switch invoice.Status(todayUTC()) {
+	case domain.InvoiceDraft:
+		return nil, fmt.Errorf("%w: invoice must be issued before payments can be recorded", apperrors.ErrValidation)
+	case domain.InvoiceVoid:
+		return nil, fmt.Errorf("%w: invoice %s is void", apperrors.ErrValidation, invoice.InvoiceNumber)
+	}
+
+	date := dateOnly(req.Date)
+	if date.Before(invoice.IssueDate) {
+		return nil, fmt.Errorf("%w: payment date cannot be before the issue date", apperrors.ErrValidation)
+	}
+	amount := req.Amount.Round(currencyPrecision(ctx, s.currencyRepo, invoice.CurrencyCode))
+	outstanding := invoice.Unpaid()
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", apperrors.ErrValidation)
+	}
+	if amount.GreaterThan(outstanding) {
+		return nil, fmt.Errorf("%w: amount %s exceeds the outstanding %s", apperrors.ErrValidation, amount, outstanding)
+	}
+
+	account, err := s.accountRepo.FindAccountByID(ctx, req.PaymentAccountID)
+	if err != nil || account.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: payment account %s not found", apperrors.ErrValidation, req.PaymentAccountID)
+	}
+	if account.AccountType != domain.Asset || account.AccountID == invoice.ReceivableAccountID {
+		return nil, fmt.Errorf("%w: payment account must be an ASSET account other than the receivable account", apperrors.ErrValidation)
+	}
+
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         date,
+		Description:  "Payment for invoice " + invoice.InvoiceNumber,
+		CurrencyCode: invoice.CurrencyCode,
+		PayeeID:      invoice.CustomerID,
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: req.PaymentAccountID, Amount: amount, TransactionType: domain.Debit},
+			{AccountID: invoice.ReceivableAccountID, Amount: amount, TransactionType: domain.Credit},
+		},
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post invoice payment journal",
+			slog.String("invoice_id", invoiceID))
+		return nil, journalValidationError(err)
+	}
+
+	payment := &domain.InvoicePayment{
+		PaymentID:   uuid.NewString(),
+		InvoiceID:   invoiceID,
+		JournalID:   journal.JournalID,
+		PaymentDate: date,
+		Amount:      amount,
+		CreatedAt:   time.Now(),
+		CreatedBy:   userID,
+	}
+	if err := s.invoiceRepo.SaveInvoicePayment(ctx, *payment); err != nil {
+		s.LogError(ctx, err, "Failed to save invoice payment, reversing journal",
+			slog.String("invoice_id", invoiceID),
+			slog.String("journal_id", journal.JournalID))
+		if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, journal.JournalID, userID); reverseErr != nil {
+			s.LogError(ctx, reverseErr, "Failed to reverse invoice payment journal",
+				slog.String("journal_id", journal.JournalID))
+		}
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Invoice payment recorded successfully",
+		slog.String("invoice_id", invoiceID),
+		slog.String("journal_id", journal.JournalID))
+	return payment, nil
+}
+
+func (s *invoiceService) VoidInvoice(ctx context.Context, workplaceID string, invoiceID string, userID string) (*domain.Invoice, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to void invoice",
+			slog.String("workplace_id", workplaceID),
+			slog.String("invoice_id", invoiceID))
+		return nil, err
+	}
+
+	invoice, err := s.findInvoice(ctx, workplaceID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case invoice.IssueJournalID == "":
+		return nil, fmt.Errorf("%w: draft invoices are deleted rather than voided", apperrors.ErrConflict)
+	case invoice.IssueJournalStatus != domain.Posted:
+		return nil, fmt.Errorf("%w: invoice %s is already void", apperrors.ErrConflict, invoice.InvoiceNumber)
+	case len(invoice.Payments) > 0:
+		return nil, fmt.Errorf("%w: reverse the payments of invoice %s before voiding it", apperrors.ErrConflict, invoice.InvoiceNumber)
+	}
+
+	if _, err := s.journalSvc.ReverseJournal(ctx, workplaceID, invoice.IssueJournalID, userID); err != nil {
+		s.LogError(ctx, err, "Failed to reverse invoice journal",
+			slog.String("invoice_id", invoiceID),
+			slog.String("journal_id", invoice.IssueJournalID))
+		return nil, err
+	}
+	invoice.IssueJournalStatus = domain.Reversed
+
+	s.LogInfo(ctx, "Invoice voided successfully",
+		slog.String("invoice_id", invoiceID),
+		slog.String("journal_id", invoice.IssueJournalID))
+	return invoice, nil
+}
+
+func (s *invoiceService) GetInvoiceSequence(ctx context.Context, workplaceID string, userID string) (*domain.InvoiceSequence, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view invoice numbering",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	sequence, err := s.invoiceRepo.GetInvoiceSequence(ctx, workplaceID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			defaultSequence := defaultInvoiceSequence(workplaceID)
+			return &defaultSequence, nil
+		}
+		s.LogError(ctx, err, "Failed to get invoice sequence",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return sequence, nil
+}
+
+func (s *invoiceService) UpdateInvoiceSequence(ctx context.Context, workplaceID string, req dto.InvoiceSequenceRequest, userID string) (*domain.InvoiceSequence, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update invoice numbering",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if req.NextNumber < 1 || req.Padding < 0 || req.Padding > 12 {
+		return nil, fmt.Errorf("%w: next number must be positive and padding between 0 and 12", apperrors.ErrValidation)
+	}
+
+	sequence := &domain.InvoiceSequence{
+		WorkplaceID:   workplaceID,
+		Prefix:        strings.TrimSpace(req.Prefix),
+		NextNumber:    req.NextNumber,
+		Padding:       req.Padding,
+		LastUpdatedAt: time.Now(),
+		LastUpdatedBy: userID,
+	}
+	if err := s.invoiceRepo.SaveInvoiceSequence(ctx, *sequence); err != nil {
+		s.LogError(ctx, err, "Failed to save invoice sequence",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Invoice numbering updated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("next_number", sequence.Format(sequence.NextNumber)))
+	return sequence, nil
+}
+
+func (s *invoiceService) AgedReceivables(ctx context.Context, workplaceID string, asOf time.Time, userID string) (*domain.AgingReport, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view aged receivables",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	invoices, err := s.invoiceRepo.ListInvoices(ctx, workplaceID, "")
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list invoices for aged receivables",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	payees, err := s.payeeRepo.ListPayees(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list customers for aged receivables",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	names := make(map[string]string, len(payees))
+	for _, payee := range payees {
+		names[payee.PayeeID] = payee.Name
+	}
+
+	asOf = dateOnly(asOf)
+	items := []domain.AgedItem{}
+	for _, invoice := range invoices {
+		if invoice.IssueDate.After(asOf) {
+			continue
+		}
+		outstanding := invoice.Outstanding(asOf)
+		if !outstanding.IsPositive() {
+			continue
+		}
+		items = append(items, domain.AgedItem{
+			DocumentID:       invoice.InvoiceID,
+			DocumentNumber:   invoice.InvoiceNumber,
+			CounterpartyID:   invoice.CustomerID,
+			CounterpartyName: names[invoice.CustomerID],
+			CurrencyCode:     invoice.CurrencyCode,
+			IssueDate:        invoice.IssueDate,
+			DueDate:          invoice.DueDate,
+			Total:            invoice.Total,
+			Outstanding:      outstanding,
+		})
+	}
+
+	report := domain.NewAgingReport(asOf, items)
+	return &report, nil
+}
@@ -0,0 +1,358 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock InvoiceRepository ---
+type MockInvoiceRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.InvoiceRepositoryFacade = (*MockInvoiceRepository)(nil)
+
+func (m *MockInvoiceRepository) FindInvoiceByID(ctx context.Context, invoiceID string) (*domain.Invoice, error) {
+	args := m.Called(ctx, invoiceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListInvoices(ctx context.Context, workplaceID string, customerID string) ([]domain.Invoice, error) {
+	args := m.Called(ctx, workplaceID, customerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetInvoiceSequence(ctx context.Context, workplaceID string) (*domain.InvoiceSequence, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.InvoiceSequence), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) SaveInvoice(ctx context.Context, invoice domain.Invoice) error {
+	args := m.Called(ctx, invoice)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) UpdateInvoice(ctx context.Context, invoice domain.Invoice) error {
+	args := m.Called(ctx, invoice)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) DeleteInvoice(ctx context.Context, invoiceID string) error {
+	args := m.Called(ctx, invoiceID)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) MarkInvoiceIssued(ctx context.Context, invoiceID string, invoiceNumber string, journalID string, userID string, at time.Time) error {
+	args := m.Called(ctx, invoiceID, invoiceNumber, journalID, userID, at)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) SaveInvoicePayment(ctx context.Context, payment domain.InvoicePayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) SaveInvoiceSequence(ctx context.Context, sequence domain.InvoiceSequence) error {
+	args := m.Called(ctx, sequence)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) NextInvoiceNumber(ctx context.Context, workplaceID string) (string, error) {
+	args := m.Called(ctx, workplaceID)
+	return args.String(0), args.Error(1)
+}
+
+// --- Test Suite Setup ---
+type InvoiceServiceTestSuite struct {
+	suite.Suite
+	mockInvoiceRepo  *MockInvoiceRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockCurrencyRepo *MockCurrencyRepository
+	mockTaxCodeRepo  *MockTaxCodeRepository
+	mockPayeeRepo    *MockPayeeRepository
+	mockJournalSvc   *MockJournalWriterSvc
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.InvoiceSvcFacade
+	workplaceID      string
+	userID           string
+	customer         domain.Payee
+	vat              domain.TaxCode
+}
+
+func (suite *InvoiceServiceTestSuite) SetupTest() {
+	suite.mockInvoiceRepo = new(MockInvoiceRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockTaxCodeRepo = new(MockTaxCodeRepository)
+	suite.mockPayeeRepo = new(MockPayeeRepository)
+	suite.mockJournalSvc = new(MockJournalWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewInvoiceService(suite.mockInvoiceRepo, suite.mockAccountRepo, suite.mockCurrencyRepo,
+		suite.mockTaxCodeRepo, suite.mockPayeeRepo, suite.mockJournalSvc, services.WithInvoiceWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.customer = domain.Payee{PayeeID: uuid.NewString(), WorkplaceID: suite.workplaceID, Name: "Acme Ltd", IsActive: true}
+	suite.vat = domain.TaxCode{TaxCodeID: uuid.NewString(), WorkplaceID: suite.workplaceID, Code: "VAT10",
+		Rate: decimal.NewFromInt(10), TaxAccountID: "vat-payable", IsActive: true}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", mock.Anything, suite.userID, suite.workplaceID, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func TestInvoiceService(t *testing.T) {
+	suite.Run(t, new(InvoiceServiceTestSuite))
+}
+
+// expectInvoiceContent mocks the customer, the receivable and revenue accounts and the VAT code
+func (suite *InvoiceServiceTestSuite) expectInvoiceContent(ctx context.Context) {
+	suite.mockPayeeRepo.On("FindPayeeByID", ctx, suite.customer.PayeeID).Return(&suite.customer, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"receivable": {AccountID: "receivable", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true},
+		"sales":      {AccountID: "sales", WorkplaceID: suite.workplaceID, AccountType: domain.Revenue, CurrencyCode: "USD", IsActive: true},
+		"bank":       {AccountID: "bank", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true},
+	}, nil).Once()
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{suite.vat.TaxCodeID}).
+		Return(map[string]domain.TaxCode{suite.vat.TaxCodeID: suite.vat}, nil).Maybe()
+}
+
+func (suite *InvoiceServiceTestSuite) invoiceRequest(lines ...dto.InvoiceLineRequest) dto.InvoiceRequest {
+	dueDate := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	return dto.InvoiceRequest{
+		CustomerID:          suite.customer.PayeeID,
+		ReceivableAccountID: "receivable",
+		IssueDate:           time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		DueDate:             &dueDate,
+		Lines:               lines,
+	}
+}
+
+// issuedInvoice returns an invoice of 110 issued on 31 March and due on 30 April, with the given payments
+func (suite *InvoiceServiceTestSuite) issuedInvoice(payments ...domain.InvoicePayment) *domain.Invoice {
+	invoiceID := uuid.NewString()
+	for i := range payments {
+		payments[i].InvoiceID = invoiceID
+	}
+	return &domain.Invoice{
+		InvoiceID:           invoiceID,
+		WorkplaceID:         suite.workplaceID,
+		CustomerID:          suite.customer.PayeeID,
+		ReceivableAccountID: "receivable",
+		InvoiceNumber:       "INV-00007",
+		IssueDate:           time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		DueDate:             time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC),
+		CurrencyCode:        "USD",
+		Subtotal:            decimal.NewFromInt(100),
+		TaxTotal:            decimal.NewFromInt(10),
+		Total:               decimal.NewFromInt(110),
+		IssueJournalID:      "issue-journal",
+		IssueJournalStatus:  domain.Posted,
+		Payments:            payments,
+	}
+}
+
+func invoicePayment(amount string, date time.Time) domain.InvoicePayment {
+	return domain.InvoicePayment{PaymentID: uuid.NewString(), JournalID: uuid.NewString(), PaymentDate: date, Amount: decimal.RequireFromString(amount)}
+}
+
+func (suite *InvoiceServiceTestSuite) TestCreateInvoice_ComputesLineAmountsAndTotals() {
+	ctx := context.Background()
+	suite.expectInvoiceContent(ctx)
+	suite.mockInvoiceRepo.On("SaveInvoice", ctx, mock.AnythingOfType("domain.Invoice")).Return(nil).Once()
+
+	invoice, err := suite.service.CreateInvoice(ctx, suite.workplaceID, suite.invoiceRequest(
+		dto.InvoiceLineRequest{Description: "Consulting", Quantity: decimal.NewFromInt(2), UnitPrice: decimal.RequireFromString("37.5"), RevenueAccountID: "sales", TaxCodeID: suite.vat.TaxCodeID},
+		dto.InvoiceLineRequest{Description: "Travel", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(25), RevenueAccountID: "sales"},
+	), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("USD", invoice.CurrencyCode)
+	suite.Require().Len(invoice.Lines, 2)
+	suite.True(invoice.Lines[0].Amount.Equal(decimal.NewFromInt(75)))
+	suite.True(invoice.Lines[0].TaxAmount.Equal(decimal.RequireFromString("7.5")))
+	suite.True(invoice.Subtotal.Equal(decimal.NewFromInt(100)))
+	suite.True(invoice.TaxTotal.Equal(decimal.RequireFromString("7.5")))
+	suite.True(invoice.Total.Equal(decimal.RequireFromString("107.5")))
+	suite.Equal(domain.InvoiceDraft, invoice.Status(time.Now()))
+	suite.mockInvoiceRepo.AssertExpectations(suite.T())
+}
+
+func (suite *InvoiceServiceTestSuite) TestCreateInvoice_RejectsNonRevenueLineAccount() {
+	ctx := context.Background()
+	suite.expectInvoiceContent(ctx)
+
+	_, err := suite.service.CreateInvoice(ctx, suite.workplaceID, suite.invoiceRequest(
+		dto.InvoiceLineRequest{Description: "Consulting", Quantity: decimal.NewFromInt(1), UnitPrice: decimal.NewFromInt(100), RevenueAccountID: "bank"},
+	), suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockInvoiceRepo.AssertNotCalled(suite.T(), "SaveInvoice", mock.Anything, mock.Anything)
+}
+
+func (suite *InvoiceServiceTestSuite) TestIssueInvoice_PostsReceivableAndRevenue() {
+	ctx := context.Background()
+	invoice := suite.issuedInvoice()
+	invoice.InvoiceNumber, invoice.IssueJournalID, invoice.IssueJournalStatus = "", "", ""
+	invoice.Lines = []domain.InvoiceLine{
+		{LineNumber: 1, Description: "Consulting", RevenueAccountID: "sales", TaxCodeID: suite.vat.TaxCodeID,
+			Amount: decimal.NewFromInt(100), NetAmount: decimal.NewFromInt(100), TaxAmount: decimal.NewFromInt(10)},
+	}
+	suite.mockInvoiceRepo.On("FindInvoiceByID", ctx, invoice.InvoiceID).Return(invoice, nil).Once()
+	suite.mockInvoiceRepo.On("NextInvoiceNumber", ctx, suite.workplaceID).Return("INV-00042", nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		// The revenue line carries its tax code so the journal service credits the 10 of VAT separately
+		return r.Description == "Invoice INV-00042" && r.PayeeID == suite.customer.PayeeID && r.Date.Equal(invoice.IssueDate) &&
+			hasLines(r, expectedLine{"receivable", "110", domain.Debit}, expectedLine{"sales", "100", domain.Credit}) &&
+			r.Transactions[1].TaxCodeID == suite.vat.TaxCodeID
+	}), suite.userID).Return(&domain.Journal{JournalID: "issue-journal"}, nil).Once()
+	suite.mockInvoiceRepo.On("MarkInvoiceIssued", ctx, invoice.InvoiceID, "INV-00042", "issue-journal", suite.userID, mock.Anything).Return(nil).Once()
+
+	issued, err := suite.service.IssueInvoice(ctx, suite.workplaceID, invoice.InvoiceID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("INV-00042", issued.InvoiceNumber)
+	suite.Equal(domain.InvoiceIssued, issued.Status(invoice.IssueDate))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockInvoiceRepo.AssertExpectations(suite.T())
+}
+
+func (suite *InvoiceServiceTestSuite) TestIssueInvoice_ReversesJournalWhenNumberInUse() {
+	ctx := context.Background()
+	invoice := suite.issuedInvoice()
+	invoice.InvoiceNumber, invoice.IssueJournalID, invoice.IssueJournalStatus = "", "", ""
+	invoice.Lines = []domain.InvoiceLine{{LineNumber: 1, RevenueAccountID: "sales", Amount: decimal.NewFromInt(110)}}
+	suite.mockInvoiceRepo.On("FindInvoiceByID", ctx, invoice.InvoiceID).Return(invoice, nil).Once()
+	suite.mockInvoiceRepo.On("NextInvoiceNumber", ctx, suite.workplaceID).Return("INV-00001", nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(&domain.Journal{JournalID: "issue-journal"}, nil).Once()
+	suite.mockInvoiceRepo.On("MarkInvoiceIssued", ctx, invoice.InvoiceID, "INV-00001", "issue-journal", suite.userID, mock.Anything).Return(apperrors.ErrDuplicate).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "issue-journal", suite.userID).Return(&domain.Journal{}, nil).Once()
+
+	_, err := suite.service.IssueInvoice(ctx, suite.workplaceID, invoice.InvoiceID, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+}
+
+func (suite *InvoiceServiceTestSuite) TestUpdateInvoice_IssuedInvoiceConflicts() {
+	ctx := context.Background()
+	invoice := suite.issuedInvoice()
+	suite.mockInvoiceRepo.On("FindInvoiceByID", ctx, invoice.InvoiceID).Return(invoice, nil).Once()
+
+	_, err := suite.service.UpdateInvoice(ctx, suite.workplaceID, invoice.InvoiceID, suite.invoiceRequest(), suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+	suite.mockInvoiceRepo.AssertNotCalled(suite.T(), "UpdateInvoice", mock.Anything, mock.Anything)
+}
+
+func (suite *InvoiceServiceTestSuite) TestRecordPayment_PartialPaymentMovesCashFromReceivable() {
+	ctx := context.Background()
+	invoice := suite.issuedInvoice(invoicePayment("60", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	suite.mockInvoiceRepo.On("FindInvoiceByID", ctx, invoice.InvoiceID).Return(invoice, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "bank").Return(&domain.Account{AccountID: "bank", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD"}, nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return r.PayeeID == suite.customer.PayeeID && r.CurrencyCode == "USD" &&
+			hasLines(r, expectedLine{"bank", "30", domain.Debit}, expectedLine{"receivable", "30", domain.Credit})
+	}), suite.userID).Return(&domain.Journal{JournalID: "payment-journal"}, nil).Once()
+	suite.mockInvoiceRepo.On("SaveInvoicePayment", ctx, mock.MatchedBy(func(p domain.InvoicePayment) bool {
+		return p.InvoiceID == invoice.InvoiceID && p.JournalID == "payment-journal" && p.Amount.Equal(decimal.NewFromInt(30))
+	})).Return(nil).Once()
+
+	payment, err := suite.service.RecordPayment(ctx, suite.workplaceID, invoice.InvoiceID, dto.RecordInvoicePaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(30), Date: time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("payment-journal", payment.JournalID)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockInvoiceRepo.AssertExpectations(suite.T())
+}
+
+func (suite *InvoiceServiceTestSuite) TestRecordPayment_RejectsAmountAboveOutstanding() {
+	ctx := context.Background()
+	invoice := suite.issuedInvoice(invoicePayment("100", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	suite.mockInvoiceRepo.On("FindInvoiceByID", ctx, invoice.InvoiceID).Return(invoice, nil).Once()
+
+	_, err := suite.service.RecordPayment(ctx, suite.workplaceID, invoice.InvoiceID, dto.RecordInvoicePaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(20), Date: time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *InvoiceServiceTestSuite) TestVoidInvoice_WithPaymentsConflicts() {
+	ctx := context.Background()
+	invoice := suite.issuedInvoice(invoicePayment("10", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	suite.mockInvoiceRepo.On("FindInvoiceByID", ctx, invoice.InvoiceID).Return(invoice, nil).Once()
+
+	_, err := suite.service.VoidInvoice(ctx, suite.workplaceID, invoice.InvoiceID, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "ReverseJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *InvoiceServiceTestSuite) TestInvoiceStatus_DerivedFromJournals() {
+	paid := suite.issuedInvoice(invoicePayment("110", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	partial := suite.issuedInvoice(invoicePayment("50", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	void := suite.issuedInvoice()
+	void.IssueJournalStatus = domain.Reversed
+
+	suite.Equal(domain.InvoiceIssued, paid.Status(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)))
+	suite.Equal(domain.InvoicePaid, paid.Status(time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	suite.Equal(domain.InvoicePartiallyPaid, partial.Status(time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)))
+	suite.Equal(domain.InvoiceOverdue, partial.Status(time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)))
+	suite.Equal(domain.InvoiceVoid, void.Status(time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)))
+	suite.True(void.Outstanding(time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)).IsZero())
+}
+
+func (suite *InvoiceServiceTestSuite) TestAgedReceivables_BucketsOutstandingByDaysPastDue() {
+	ctx := context.Background()
+	current := suite.issuedInvoice()
+	current.DueDate = time.Date(2025, 7, 15, 0, 0, 0, 0, time.UTC)
+	// Due 30 April, 60 of 110 paid on 10 April and 50 paid after the report date: 50 is 61 days past due on 30 June
+	late := suite.issuedInvoice(
+		invoicePayment("60", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)),
+		invoicePayment("50", time.Date(2025, 7, 5, 0, 0, 0, 0, time.UTC)),
+	)
+	settled := suite.issuedInvoice(invoicePayment("110", time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)))
+	future := suite.issuedInvoice()
+	future.IssueDate = time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	draft := suite.issuedInvoice()
+	draft.IssueJournalID, draft.IssueJournalStatus = "", ""
+	suite.mockInvoiceRepo.On("ListInvoices", ctx, suite.workplaceID, "").Return([]domain.Invoice{*current, *late, *settled, *future, *draft}, nil).Once()
+	suite.mockPayeeRepo.On("ListPayees", ctx, suite.workplaceID).Return([]domain.Payee{suite.customer}, nil).Once()
+
+	report, err := suite.service.AgedReceivables(ctx, suite.workplaceID, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Items, 2)
+	suite.Equal("Acme Ltd", report.Items[0].CounterpartyName)
+	suite.Require().Len(report.Balances, 1)
+	buckets := report.Balances[0].Buckets
+	suite.True(buckets.NotDue.Equal(decimal.NewFromInt(110)))
+	suite.True(buckets.Days61To90.Equal(decimal.NewFromInt(50)))
+	suite.True(buckets.Total.Equal(decimal.NewFromInt(160)))
+	suite.Require().Len(report.Totals, 1)
+	suite.True(report.Totals[0].Buckets.Total.Equal(decimal.NewFromInt(160)))
+}
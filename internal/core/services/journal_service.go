@@ -34,15 +34,68 @@ type journalService struct {
 	accountSvc   portssvc.AccountSvcFacade
 	journalRepo  portsrepo.JournalRepositoryWithTx
 	workplaceSvc portssvc.WorkplaceSvcFacade // Updated to use WorkplaceSvcFacade
+
+	duplicateRepo       portsrepo.DuplicateJournalRepositoryFacade // Optional: flags duplicates on CreateJournal
+	duplicateWindowDays int
+
+	payeeRepo portsrepo.PayeeReader // Optional: validates payees and resolves them from descriptions
+
+	taxCodeRepo  portsrepo.TaxCodeReader  // Optional: splits lines naming a tax code into net and tax lines
+	currencyRepo portsrepo.CurrencyReader // Optional: precision used to round tax amounts
+
+	dimensionRepo portsrepo.DimensionRepositoryFacade // Optional: tags journals and lines with dimension values
+}
+
+// JournalServiceOption is a functional option for configuring the journal service
+type JournalServiceOption func(*journalService)
+
+// WithJournalDuplicateDetection flags new journals that repeat a journal dated within windowDays of them.
+// Detection never blocks journal creation.
+func WithJournalDuplicateDetection(duplicateRepo portsrepo.DuplicateJournalRepositoryFacade, windowDays int) JournalServiceOption {
+	return func(s *journalService) {
+		s.duplicateRepo = duplicateRepo
+		s.duplicateWindowDays = windowDays
+	}
+}
+
+// WithJournalPayees validates the payees referenced by journals and, when a journal is created without one,
+// resolves its payee from the description using the workplace's payee names and aliases.
+func WithJournalPayees(payeeRepo portsrepo.PayeeReader) JournalServiceOption {
+	return func(s *journalService) {
+		s.payeeRepo = payeeRepo
+	}
+}
+
+// WithJournalTaxCodes splits every line of a new journal that names a tax code into a net line on the line's
+// account and a tax line on the tax account of the code. Tax is rounded to the precision of the journal currency.
+func WithJournalTaxCodes(taxCodeRepo portsrepo.TaxCodeReader, currencyRepo portsrepo.CurrencyReader) JournalServiceOption {
+	return func(s *journalService) {
+		s.taxCodeRepo = taxCodeRepo
+		s.currencyRepo = currencyRepo
+	}
+}
+
+// WithJournalDimensions lets journals and their lines be tagged with values of the workplace's dimensions.
+// Lines without a value of their own for a dimension take the journal's value in reports.
+func WithJournalDimensions(dimensionRepo portsrepo.DimensionRepositoryFacade) JournalServiceOption {
+	return func(s *journalService) {
+		s.dimensionRepo = dimensionRepo
+	}
 }
 
 // NewJournalService creates a new JournalService.
-func NewJournalService(journalRepo portsrepo.JournalRepositoryWithTx, accountSvc portssvc.AccountSvcFacade, workplaceSvc portssvc.WorkplaceSvcFacade) portssvc.JournalSvcFacade {
-	return &journalService{
+func NewJournalService(journalRepo portsrepo.JournalRepositoryWithTx, accountSvc portssvc.AccountSvcFacade, workplaceSvc portssvc.WorkplaceSvcFacade, options ...JournalServiceOption) portssvc.JournalSvcFacade {
+	svc := &journalService{
 		accountSvc:   accountSvc,
 		journalRepo:  journalRepo,
 		workplaceSvc: workplaceSvc,
 	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
 }
 
 // Ensure JournalService implements the portssvc.JournalSvcFacade interface
@@ -143,6 +196,44 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 		logger.Warn("WorkplaceService not available for authorization check in CreateJournal")
 	}
 
+	posting, err := s.prepareJournal(ctx, workplaceID, req, creatorUserID)
+	if err != nil {
+		return nil, err
+	}
+	domainJournal := posting.Journal
+	domainTransactions := posting.Transactions
+
+	// Pass balance changes to the repository method
+	err = s.journalRepo.SaveJournal(ctx, domainJournal, domainTransactions, posting.BalanceChanges)
+	if err != nil {
+		logger.Error("Failed to save journal", slog.String("error", err.Error()), slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to save journal: %w", err)
+	}
+
+	logger.Info("Journal created successfully", slog.String("journal_id", domainJournal.JournalID), slog.String("workplace_id", workplaceID))
+
+	// --- Duplicate Detection --- (non-blocking: the journal is already saved)
+	if s.duplicateRepo != nil {
+		warnings, err := detectDuplicateJournals(ctx, s.duplicateRepo, s.journalRepo, s.duplicateWindowDays, domainJournal, domainTransactions)
+		if err != nil {
+			logger.Warn("Duplicate detection failed", slog.String("error", err.Error()), slog.String("journal_id", domainJournal.JournalID))
+		} else if len(warnings) > 0 {
+			logger.Info("Journal flagged as possible duplicate", slog.String("journal_id", domainJournal.JournalID), slog.Int("candidates", len(warnings)))
+			domainJournal.DuplicateWarnings = warnings
+		}
+	}
+
+	// Return the journal without transactions populated by default (as per GetJournalByID)
+	// Caller can fetch transactions separately if needed.
+	domainJournal.Transactions = nil // Clear transactions before returning
+	return &domainJournal, nil
+}
+
+// prepareJournal validates a journal request and builds the journal, its transactions and the balance changes
+// to persist, without saving anything. The caller authorizes the user.
+func (s *journalService) prepareJournal(ctx context.Context, workplaceID string, req dto.CreateJournalRequest, creatorUserID string) (*domain.JournalPosting, error) {
+	logger := middleware.GetLoggerFromCtx(ctx)
+
 	// --- Basic Validation ---
 	if len(req.Transactions) < 2 {
 		return nil, ErrJournalMinEntries
@@ -167,7 +258,6 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 
 	// Prepare domain transactions from DTO
 	domainTransactions := make([]domain.Transaction, len(req.Transactions))
-	accountIDs := make([]string, 0, len(req.Transactions))
 	for i, txnReq := range req.Transactions {
 		// Validate positive amount (already done by binding, but good practice)
 		if txnReq.Amount.LessThanOrEqual(decimal.Zero) {
@@ -189,6 +279,11 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 			CurrencyCode:    req.CurrencyCode, // Use journal's currency
 			Notes:           txnReq.Notes,
 			TransactionDate: transactionDate, // Set the transaction date
+			PayeeID:         txnReq.PayeeID,
+			SecurityID:      txnReq.SecurityID,
+			Quantity:        txnReq.Quantity,
+			UnitPrice:       txnReq.UnitPrice,
+			TaxCodeID:       txnReq.TaxCodeID,
 			AuditFields: domain.AuditFields{
 				CreatedAt:     now,
 				CreatedBy:     creatorUserID,
@@ -197,7 +292,22 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 			},
 			// RunningBalance will be calculated and set by the repository
 		}
-		accountIDs = append(accountIDs, txnReq.AccountID)
+	}
+
+	// --- Dimensions --- (tags the lines first, so that tax lines inherit the tags of the line they split from)
+	journalTags, err := s.applyDimensions(ctx, workplaceID, req, domainTransactions)
+	if err != nil {
+		return nil, err
+	}
+
+	// --- Tax Codes --- (adds the tax lines, so it runs before the balance check)
+	domainTransactions, err = s.applyTaxCodes(ctx, workplaceID, req.CurrencyCode, domainTransactions)
+	if err != nil {
+		return nil, err
+	}
+	accountIDs := make([]string, 0, len(domainTransactions))
+	for _, txn := range domainTransactions {
+		accountIDs = append(accountIDs, txn.AccountID)
 	}
 
 	// Validate Balance (double-entry check)
@@ -250,6 +360,12 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 		}
 	}
 
+	// --- Payees ---
+	payeeID, err := s.journalPayee(ctx, workplaceID, req)
+	if err != nil {
+		return nil, err
+	}
+
 	// --- Persistence ---
 	domainJournal := domain.Journal{
 		JournalID:    journalID,
@@ -258,6 +374,8 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 		Description:  req.Description,
 		CurrencyCode: req.CurrencyCode,
 		Status:       domain.Posted, // Default status
+		PayeeID:      payeeID,
+		Dimensions:   journalTags,
 		AuditFields: domain.AuditFields{
 			CreatedAt:     now,
 			CreatedBy:     creatorUserID,
@@ -270,18 +388,142 @@ func (s *journalService) CreateJournal(ctx context.Context, workplaceID string,
 	totalAmount := s.calculateJournalAmount(domainTransactions)
 	domainJournal.Amount = totalAmount
 
-	// Pass balance changes to the repository method
-	err = s.journalRepo.SaveJournal(ctx, domainJournal, domainTransactions, balanceChanges)
+	return &domain.JournalPosting{Journal: domainJournal, Transactions: domainTransactions, BalanceChanges: balanceChanges}, nil
+}
+
+// journalPayee validates the payees referenced by a journal request and returns the journal's payee:
+// the requested one, or the payee recognised in the description when none was requested.
+// Without a payee repository, requested payees are stored as given and nothing is resolved.
+func (s *journalService) journalPayee(ctx context.Context, workplaceID string, req dto.CreateJournalRequest) (string, error) {
+	if s.payeeRepo == nil {
+		return req.PayeeID, nil
+	}
+
+	payeeIDs := []string{}
+	if req.PayeeID != "" {
+		payeeIDs = append(payeeIDs, req.PayeeID)
+	}
+	for _, txnReq := range req.Transactions {
+		if txnReq.PayeeID != "" {
+			payeeIDs = append(payeeIDs, txnReq.PayeeID)
+		}
+	}
+	for _, payeeID := range uniqueStrings(payeeIDs) {
+		if _, err := loadWorkplacePayee(ctx, s.payeeRepo, workplaceID, payeeID); err != nil {
+			return "", err
+		}
+	}
+	if req.PayeeID != "" {
+		return req.PayeeID, nil
+	}
+
+	match, err := resolvePayeeFromText(ctx, s.payeeRepo, workplaceID, req.Description)
 	if err != nil {
-		logger.Error("Failed to save journal", slog.String("error", err.Error()), slog.String("workplace_id", workplaceID))
-		return nil, fmt.Errorf("failed to save journal: %w", err)
+		return "", err
+	}
+	if match == nil {
+		return "", nil
 	}
+	return match.Payee.PayeeID, nil
+}
 
-	logger.Info("Journal created successfully", slog.String("journal_id", domainJournal.JournalID), slog.String("workplace_id", workplaceID))
-	// Return the journal without transactions populated by default (as per GetJournalByID)
-	// Caller can fetch transactions separately if needed.
-	domainJournal.Transactions = nil // Clear transactions before returning
-	return &domainJournal, nil
+// applyDimensions resolves the dimension values of a journal request, tags each line with its own values and
+// returns the journal's values. Without a dimension repository, naming dimension values is rejected.
+func (s *journalService) applyDimensions(ctx context.Context, workplaceID string, req dto.CreateJournalRequest, transactions []domain.Transaction) ([]domain.DimensionTag, error) {
+	tagged := len(req.DimensionValueIDs) > 0
+	for _, txnReq := range req.Transactions {
+		tagged = tagged || len(txnReq.DimensionValueIDs) > 0
+	}
+	if !tagged {
+		return nil, nil
+	}
+	if s.dimensionRepo == nil {
+		return nil, fmt.Errorf("%w: dimensions are not supported", apperrors.ErrValidation)
+	}
+
+	dimensions, err := s.dimensionRepo.ListDimensions(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+	journalTags, err := resolveDimensionTags(dimensions, req.DimensionValueIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i, txnReq := range req.Transactions {
+		if len(txnReq.DimensionValueIDs) == 0 {
+			continue
+		}
+		if transactions[i].Dimensions, err = resolveDimensionTags(dimensions, txnReq.DimensionValueIDs); err != nil {
+			return nil, err
+		}
+	}
+	return journalTags, nil
+}
+
+// applyTaxCodes splits the transactions naming a tax code into a net line, which keeps the account and side of
+// the transaction, and a tax line on the tax account of the code. Zero tax adds no tax line. The tax codes must
+// be active codes of the workplace; without a tax code repository, naming a tax code is rejected.
+func (s *journalService) applyTaxCodes(ctx context.Context, workplaceID string, currencyCode string, transactions []domain.Transaction) ([]domain.Transaction, error) {
+	taxCodeIDs := []string{}
+	for _, txn := range transactions {
+		if txn.TaxCodeID != "" {
+			taxCodeIDs = append(taxCodeIDs, txn.TaxCodeID)
+		}
+	}
+	if len(taxCodeIDs) == 0 {
+		return transactions, nil
+	}
+	if s.taxCodeRepo == nil {
+		return nil, fmt.Errorf("%w: tax codes are not supported", apperrors.ErrValidation)
+	}
+
+	taxCodes, err := s.taxCodeRepo.FindTaxCodesByIDs(ctx, uniqueStrings(taxCodeIDs))
+	if err != nil {
+		return nil, err
+	}
+	for _, taxCodeID := range uniqueStrings(taxCodeIDs) {
+		taxCode, found := taxCodes[taxCodeID]
+		if !found || taxCode.WorkplaceID != workplaceID {
+			return nil, fmt.Errorf("%w: tax code %s not found", apperrors.ErrValidation, taxCodeID)
+		}
+		if !taxCode.IsActive {
+			return nil, fmt.Errorf("%w: tax code %s is inactive", apperrors.ErrValidation, taxCode.Code)
+		}
+	}
+
+	precision := currencyPrecision(ctx, s.currencyRepo, currencyCode)
+
+	split := make([]domain.Transaction, 0, len(transactions)+len(taxCodeIDs))
+	for _, txn := range transactions {
+		if txn.TaxCodeID == "" {
+			split = append(split, txn)
+			continue
+		}
+		taxCode := taxCodes[txn.TaxCodeID]
+		net, tax := taxCode.Split(txn.Amount, precision)
+		if net.LessThanOrEqual(decimal.Zero) {
+			return nil, fmt.Errorf("%w: net amount of the line on account %s must be positive", apperrors.ErrValidation, txn.AccountID)
+		}
+
+		netLine := txn
+		netLine.Amount = net
+		netLine.TaxRole = domain.TaxRoleNet
+		split = append(split, netLine)
+
+		if tax.IsZero() {
+			continue
+		}
+		taxLine := txn
+		taxLine.TransactionID = uuid.NewString()
+		taxLine.AccountID = taxCode.TaxAccountID
+		taxLine.Amount = tax
+		taxLine.TaxRole = domain.TaxRoleTax
+		taxLine.SecurityID = ""
+		taxLine.Quantity = decimal.Zero
+		taxLine.UnitPrice = decimal.Zero
+		split = append(split, taxLine)
+	}
+	return split, nil
 }
 
 // GetJournalByID retrieves a specific journal entry (without transactions).
@@ -335,6 +577,18 @@ func (s *journalService) GetJournalByID(ctx context.Context, workplaceID string,
 	}
 	journal.Transactions = transactions
 
+	if s.dimensionRepo != nil {
+		journalTags, lineTags, err := s.dimensionRepo.FindJournalDimensions(ctx, journalID)
+		if err != nil {
+			logger.Error("Failed to fetch dimensions for journal", slog.String("error", err.Error()), slog.String("journal_id", journalID))
+			return nil, fmt.Errorf("failed to retrieve dimensions for journal %s: %w", journalID, apperrors.ErrInternal)
+		}
+		journal.Dimensions = journalTags
+		for i := range journal.Transactions {
+			journal.Transactions[i].Dimensions = lineTags[journal.Transactions[i].TransactionID]
+		}
+	}
+
 	logger.Debug("Journal and transactions retrieved successfully", slog.String("journal_id", journalID), slog.String("workplace_id", workplaceID), slog.Int("transaction_count", len(transactions)))
 	return journal, nil
 }
@@ -404,7 +658,7 @@ func (j *journalService) ListJournals(ctx context.Context, workplaceID string, u
 	return resp, nil
 }
 
-// UpdateJournal updates the description and date of a journal entry.
+// UpdateJournal updates the description, date and payee of a journal entry.
 // Implements portssvc.JournalSvcFacade
 func (s *journalService) UpdateJournal(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalRequest, requestingUserID string) (*domain.Journal, error) {
 	logger := middleware.GetLoggerFromCtx(ctx)
@@ -451,6 +705,15 @@ func (s *journalService) UpdateJournal(ctx context.Context, workplaceID string,
 		journal.Description = *req.Description
 		updated = true
 	}
+	if req.PayeeID != nil {
+		if *req.PayeeID != "" && s.payeeRepo != nil {
+			if _, err := loadWorkplacePayee(ctx, s.payeeRepo, workplaceID, *req.PayeeID); err != nil {
+				return nil, err
+			}
+		}
+		journal.PayeeID = *req.PayeeID
+		updated = true
+	}
 
 	if !updated {
 		logger.Debug("No fields provided for journal update", slog.String("journal_id", journalID))
@@ -475,6 +738,75 @@ func (s *journalService) UpdateJournal(ctx context.Context, workplaceID string,
 	return journal, nil
 }
 
+// UpdateJournalDimensions replaces the dimension values of a posted journal and of the lines listed in the
+// request, leaving amounts, accounts and dates untouched.
+// Implements portssvc.JournalSvcFacade
+func (s *journalService) UpdateJournalDimensions(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalDimensionsRequest, requestingUserID string) (*domain.Journal, error) {
+	logger := middleware.GetLoggerFromCtx(ctx)
+
+	if s.workplaceSvc != nil {
+		if err := s.workplaceSvc.AuthorizeUserAction(ctx, requestingUserID, workplaceID, domain.RoleMember); err != nil {
+			logger.Warn("Authorization failed for UpdateJournalDimensions", slog.String("user_id", requestingUserID), slog.String("workplace_id", workplaceID), slog.String("journal_id", journalID), slog.String("error", err.Error()))
+			return nil, err
+		}
+	} else {
+		logger.Warn("WorkplaceService not available for authorization check in UpdateJournalDimensions")
+	}
+	if s.dimensionRepo == nil {
+		return nil, fmt.Errorf("%w: dimensions are not supported", apperrors.ErrValidation)
+	}
+
+	journal, err := s.GetJournalByID(ctx, workplaceID, journalID, requestingUserID)
+	if err != nil {
+		return nil, err
+	}
+	if journal.Status != domain.Posted {
+		return nil, fmt.Errorf("%w: %w", apperrors.ErrConflict, ErrNotPosted)
+	}
+
+	dimensions, err := s.dimensionRepo.ListDimensions(ctx, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+	journalTags := journal.Dimensions
+	if req.DimensionValueIDs != nil {
+		if journalTags, err = resolveDimensionTags(dimensions, *req.DimensionValueIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	lines := make(map[string]bool, len(journal.Transactions))
+	for _, txn := range journal.Transactions {
+		lines[txn.TransactionID] = true
+	}
+	lineTags := make(map[string][]domain.DimensionTag, len(req.Transactions))
+	for _, txnReq := range req.Transactions {
+		if !lines[txnReq.TransactionID] {
+			return nil, fmt.Errorf("%w: transaction %s does not belong to journal %s", apperrors.ErrValidation, txnReq.TransactionID, journalID)
+		}
+		if _, repeated := lineTags[txnReq.TransactionID]; repeated {
+			return nil, fmt.Errorf("%w: transaction %s is listed more than once", apperrors.ErrValidation, txnReq.TransactionID)
+		}
+		if lineTags[txnReq.TransactionID], err = resolveDimensionTags(dimensions, txnReq.DimensionValueIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.dimensionRepo.ReplaceJournalDimensions(ctx, journalID, journalTags, lineTags); err != nil {
+		logger.Error("Failed to save journal dimensions", slog.String("error", err.Error()), slog.String("journal_id", journalID))
+		return nil, fmt.Errorf("failed to save journal dimensions: %w", err)
+	}
+
+	logger.Info("Journal dimensions updated successfully", slog.String("journal_id", journalID), slog.Int("lines", len(lineTags)))
+	journal.Dimensions = journalTags
+	for i := range journal.Transactions {
+		if tags, ok := lineTags[journal.Transactions[i].TransactionID]; ok {
+			journal.Transactions[i].Dimensions = tags
+		}
+	}
+	return journal, nil
+}
+
 // ListTransactionsByAccount retrieves transactions for a specific account within a workplace.
 func (j *journalService) ListTransactionsByAccount(ctx context.Context, workplaceID string, accountID string, userID string, params dto.ListTransactionsParams) (*dto.ListTransactionsResponse, error) {
 	logger := middleware.GetLoggerFromCtx(ctx)
@@ -566,6 +898,31 @@ func (s *journalService) validateReverseJournalActionAndGetOriginalJournal(ctx c
 		logger.Error("Failed to fetch original transactions for reversal", "error", err)
 		return nil, nil, fmt.Errorf("failed to retrieve original transactions: %w", err)
 	}
+	if s.dimensionRepo != nil {
+		journalTags, lineTags, err := s.dimensionRepo.FindJournalDimensions(ctx, journalID)
+		if err != nil {
+			logger.Error("Failed to fetch original dimensions for reversal", "error", err)
+			return nil, nil, fmt.Errorf("failed to retrieve original dimensions: %w", err)
+		}
+		originalJournal.Dimensions = journalTags
+		for i := range originalTransactions {
+			originalTransactions[i].Dimensions = lineTags[originalTransactions[i].TransactionID]
+		}
+	}
+
+	// 6. Lines locked by a completed reconciliation can only be reversed by an ADMIN.
+	// UpdateJournal cannot change amounts, so reversal is the only edit that needs this guard.
+	for _, txn := range originalTransactions {
+		if txn.ClearingStatus != domain.Reconciled {
+			continue
+		}
+		if err := s.workplaceSvc.AuthorizeUserAction(ctx, userID, workplaceID, domain.RoleAdmin); err != nil {
+			logger.Warn("Non-admin attempted to reverse a reconciled journal", "journalID", journalID, "transactionID", txn.TransactionID)
+			return nil, nil, fmt.Errorf("%w: journal has transactions locked by a completed reconciliation; only an ADMIN can reverse it", apperrors.ErrConflict)
+		}
+		logger.Warn("ADMIN override: reversing a reconciled journal", "journalID", journalID, "userID", userID)
+		break
+	}
 	return originalJournal, originalTransactions, nil
 }
 
@@ -597,83 +954,17 @@ func (s *journalService) ReverseJournal(ctx context.Context, workplaceID string,
 			return nil, err
 		}
 
-		now := time.Now()
-		newJournalID := uuid.NewString()
-
-		// Create the reversing journal domain object.
-		reversingJournal := domain.Journal{
-			JournalID:    newJournalID,
-			WorkplaceID:  workplaceID,
-			JournalDate:  originalJournal.JournalDate,
-			CurrencyCode: originalJournal.CurrencyCode,
-			Status:       domain.Posted,
-			AuditFields: domain.AuditFields{
-				CreatedAt:     now,
-				CreatedBy:     userID,
-				LastUpdatedAt: now,
-				LastUpdatedBy: userID,
-			},
-		}
-
-		isReversingAReversal := originalJournal.OriginalJournalID != nil
-		if isReversingAReversal {
-			reversingJournal.Description = strings.TrimPrefix(originalJournal.Description, "Reversal of Journal: ")
-		} else {
-			reversingJournal.OriginalJournalID = &originalJournal.JournalID
-			reversingJournal.Description = fmt.Sprintf("Reversal of Journal: %s", originalJournal.Description)
-		}
-
-		// Create reversed transaction domain objects.
-		reversingTransactions := make([]domain.Transaction, len(originalTransactions))
-		accIDList := make([]string, 0)
-		for i, origTx := range originalTransactions {
-			accIDList = append(accIDList, origTx.AccountID)
-			newTxType := domain.Credit
-			if origTx.TransactionType == domain.Credit {
-				newTxType = domain.Debit
-			}
-			reversingTransactions[i] = domain.Transaction{
-				TransactionID:   uuid.NewString(),
-				JournalID:       newJournalID,
-				AccountID:       origTx.AccountID,
-				Amount:          origTx.Amount,
-				TransactionType: newTxType,
-				CurrencyCode:    origTx.CurrencyCode,
-				Notes:           origTx.Notes,
-				AuditFields: domain.AuditFields{
-					CreatedAt:     now,
-					CreatedBy:     userID,
-					LastUpdatedAt: now,
-					LastUpdatedBy: userID,
-				},
-			}
-		}
-
-		accountsMap, err := s.accountSvc.GetAccountByIDs(ctx, workplaceID, accIDList, userID)
+		posting, err := s.prepareReversal(ctx, workplaceID, originalJournal, originalTransactions, userID)
 		if err != nil {
-			logger.Error("Failed to fetch accounts for reversal balance calculation", "error", err)
-			return nil, fmt.Errorf("failed to get account details for reversal: %w", err)
-		}
-
-		reversingJournal.Amount = originalJournal.Amount
-
-		balanceChanges := make(map[string]decimal.Decimal)
-		for _, revTx := range reversingTransactions {
-			acc, ok := accountsMap[revTx.AccountID]
-			if !ok {
-				logger.Error("Account missing from map during reversal balance calculation", "accountID", revTx.AccountID)
-				return nil, fmt.Errorf("internal error: account %s not found during balance calculation", revTx.AccountID)
-			}
-			signedAmount, err := s.getSignedAmount(revTx, acc.AccountType)
-			if err != nil {
-				logger.Error("Failed to calculate signed amount for reversal transaction", "transactionID", revTx.TransactionID, "error", err)
-				return nil, fmt.Errorf("failed to calculate signed amount for reversal: %w", err)
-			}
-			balanceChanges[revTx.AccountID] = balanceChanges[revTx.AccountID].Add(signedAmount)
+			return nil, err
 		}
+		reversingJournal := posting.Journal
+		newJournalID := reversingJournal.JournalID
+		now := reversingJournal.CreatedAt
+		isReversingAReversal := originalJournal.OriginalJournalID != nil
 
 		// Save the reversing journal and update the original journal's status atomically.
-		if err := txRepo.SaveJournal(ctx, reversingJournal, reversingTransactions, balanceChanges); err != nil {
+		if err := txRepo.SaveJournal(ctx, reversingJournal, posting.Transactions, posting.BalanceChanges); err != nil {
 			logger.Error("Failed to save reversing journal entry", "error", err)
 			return nil, fmt.Errorf("failed to save reversing journal: %w", err)
 		}
@@ -687,6 +978,10 @@ func (s *journalService) ReverseJournal(ctx context.Context, workplaceID string,
 
 		logger.Info("Journal reversed successfully", "reversingJournalID", newJournalID)
 		reversingJournal.Transactions = nil
+		// The twin of an intercompany journal stays posted; point the caller at it so it can be reversed too
+		if originalJournal.Twin != nil && originalJournal.Twin.Status == domain.Posted {
+			reversingJournal.TwinToReverse = originalJournal.Twin
+		}
 		return &reversingJournal, nil
 	})
 
@@ -696,3 +991,95 @@ func (s *journalService) ReverseJournal(ctx context.Context, workplaceID string,
 
 	return result.(*domain.Journal), nil
 }
+
+// prepareReversal builds the journal reversing a posted journal, with its transactions and balance changes,
+// without saving anything.
+func (s *journalService) prepareReversal(ctx context.Context, workplaceID string, originalJournal *domain.Journal, originalTransactions []domain.Transaction, userID string) (*domain.JournalPosting, error) {
+	logger := middleware.GetLoggerFromCtx(ctx)
+
+	now := time.Now()
+	newJournalID := uuid.NewString()
+
+	// Create the reversing journal domain object.
+	reversingJournal := domain.Journal{
+		JournalID:    newJournalID,
+		WorkplaceID:  workplaceID,
+		JournalDate:  originalJournal.JournalDate,
+		CurrencyCode: originalJournal.CurrencyCode,
+		Status:       domain.Posted,
+		PayeeID:      originalJournal.PayeeID,
+		Dimensions:   originalJournal.Dimensions,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+
+	isReversingAReversal := originalJournal.OriginalJournalID != nil
+	if isReversingAReversal {
+		reversingJournal.Description = strings.TrimPrefix(originalJournal.Description, "Reversal of Journal: ")
+	} else {
+		reversingJournal.OriginalJournalID = &originalJournal.JournalID
+		reversingJournal.Description = fmt.Sprintf("Reversal of Journal: %s", originalJournal.Description)
+	}
+
+	// Create reversed transaction domain objects.
+	reversingTransactions := make([]domain.Transaction, len(originalTransactions))
+	accIDList := make([]string, 0)
+	for i, origTx := range originalTransactions {
+		accIDList = append(accIDList, origTx.AccountID)
+		newTxType := domain.Credit
+		if origTx.TransactionType == domain.Credit {
+			newTxType = domain.Debit
+		}
+		reversingTransactions[i] = domain.Transaction{
+			TransactionID:   uuid.NewString(),
+			JournalID:       newJournalID,
+			AccountID:       origTx.AccountID,
+			Amount:          origTx.Amount,
+			TransactionType: newTxType,
+			CurrencyCode:    origTx.CurrencyCode,
+			Notes:           origTx.Notes,
+			PayeeID:         origTx.PayeeID,
+			SecurityID:      origTx.SecurityID,
+			Quantity:        origTx.Quantity,
+			UnitPrice:       origTx.UnitPrice,
+			TaxCodeID:       origTx.TaxCodeID,
+			TaxRole:         origTx.TaxRole,
+			Dimensions:      origTx.Dimensions,
+			AuditFields: domain.AuditFields{
+				CreatedAt:     now,
+				CreatedBy:     userID,
+				LastUpdatedAt: now,
+				LastUpdatedBy: userID,
+			},
+		}
+	}
+
+	accountsMap, err := s.accountSvc.GetAccountByIDs(ctx, workplaceID, accIDList, userID)
+	if err != nil {
+		logger.Error("Failed to fetch accounts for reversal balance calculation", "error", err)
+		return nil, fmt.Errorf("failed to get account details for reversal: %w", err)
+	}
+
+	reversingJournal.Amount = originalJournal.Amount
+
+	balanceChanges := make(map[string]decimal.Decimal)
+	for _, revTx := range reversingTransactions {
+		acc, ok := accountsMap[revTx.AccountID]
+		if !ok {
+			logger.Error("Account missing from map during reversal balance calculation", "accountID", revTx.AccountID)
+			return nil, fmt.Errorf("internal error: account %s not found during balance calculation", revTx.AccountID)
+		}
+		signedAmount, err := s.getSignedAmount(revTx, acc.AccountType)
+		if err != nil {
+			logger.Error("Failed to calculate signed amount for reversal transaction", "transactionID", revTx.TransactionID, "error", err)
+			return nil, fmt.Errorf("failed to calculate signed amount for reversal: %w", err)
+		}
+		balanceChanges[revTx.AccountID] = balanceChanges[revTx.AccountID].Add(signedAmount)
+	}
+
+	return &domain.JournalPosting{Journal: reversingJournal, Transactions: reversingTransactions, BalanceChanges: balanceChanges}, nil
+}
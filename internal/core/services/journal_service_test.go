@@ -49,6 +49,11 @@ func (m *MockJournalRepository) SaveJournal(ctx context.Context, journal domain.
 	return args.Error(0)
 }
 
+func (m *MockJournalRepository) SaveTwinJournals(ctx context.Context, first domain.JournalPosting, second domain.JournalPosting) error {
+	args := m.Called(ctx, first, second)
+	return args.Error(0)
+}
+
 func (m *MockJournalRepository) FindJournalByID(ctx context.Context, journalID string) (*domain.Journal, error) {
 	args := m.Called(ctx, journalID)
 	if args.Get(0) == nil {
@@ -788,6 +793,30 @@ func (suite *JournalServiceTestSuite) TestCreateJournal_Reversal() {
 	suite.Equal(domain.Posted, reversed.Status)
 }
 
+func (suite *JournalServiceTestSuite) TestReverseJournal_ReconciledRequiresAdmin() {
+	ctx := context.Background()
+	journalID := uuid.NewString()
+	journal := &domain.Journal{
+		JournalID:    journalID,
+		WorkplaceID:  suite.workplaceID,
+		CurrencyCode: "USD",
+		Status:       domain.Posted,
+	}
+	transactions := []domain.Transaction{
+		{TransactionID: uuid.NewString(), JournalID: journalID, AccountID: suite.assetAccount.AccountID, Amount: decimal.NewFromInt(100), TransactionType: domain.Debit, CurrencyCode: "USD", ClearingStatus: domain.Reconciled},
+		{TransactionID: uuid.NewString(), JournalID: journalID, AccountID: suite.liabilityAccount.AccountID, Amount: decimal.NewFromInt(100), TransactionType: domain.Credit, CurrencyCode: "USD", ClearingStatus: domain.Uncleared},
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleAdmin).Return(apperrors.ErrForbidden).Once()
+	suite.mockJournalRepo.On("FindJournalByID", ctx, journalID).Return(journal, nil).Once()
+	suite.mockJournalRepo.On("FindTransactionsByJournalID", ctx, journalID).Return(transactions, nil).Once()
+
+	_, err := suite.service.ReverseJournal(ctx, suite.workplaceID, journalID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 // More edge and stress tests can be added similarly for very large journals, high-precision decimals, etc.
 
 // --- Run Test Suite ---
@@ -0,0 +1,611 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// loanService implements the LoanSvcFacade interface
+type loanService struct {
+	BaseService
+	loanRepo     portsrepo.LoanRepositoryFacade
+	accountRepo  portsrepo.AccountReader
+	currencyRepo portsrepo.CurrencyReader
+	journalSvc   portssvc.JournalWriterSvc
+}
+
+// LoanServiceOption is a functional option for configuring the loan service
+type LoanServiceOption func(*loanService)
+
+// WithLoanWorkplaceAuthorizer adds workplace authorizer dependency
+func WithLoanWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) LoanServiceOption {
+	return func(s *loanService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewLoanService creates a new service for loans. Installments and prepayments are posted through the journal
+// service; the schedule is rebuilt from the loan terms and the payments whose journals are still posted, so
+// reversing an installment journal makes the installment due again.
+func NewLoanService(loanRepo portsrepo.LoanRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, journalSvc portssvc.JournalWriterSvc, options ...LoanServiceOption) portssvc.LoanSvcFacade {
+	svc := &loanService{
+		loanRepo:     loanRepo,
+		accountRepo:  accountRepo,
+		currencyRepo: currencyRepo,
+		journalSvc:   journalSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure loanService implements the LoanSvcFacade interface
+var _ portssvc.LoanSvcFacade = (*loanService)(nil)
+
+// annuityPayment returns the equal installment repaying balance over the given number of periods at the
+// periodic rate, rounded to the currency precision
+func annuityPayment(balance decimal.Decimal, rate decimal.Decimal, periods int, precision int32) decimal.Decimal {
+	if periods <= 1 {
+		return balance.Add(balance.Mul(rate)).Round(precision)
+	}
+	if rate.IsZero() {
+		return balance.Div(decimal.NewFromInt(int64(periods))).Round(precision)
+	}
+	factor := decimal.NewFromInt(1).Add(rate).Pow(decimal.NewFromInt(int64(periods)))
+	return balance.Mul(rate).Mul(factor).Div(factor.Sub(decimal.NewFromInt(1))).Round(precision)
+}
+
+// buildLoanSchedule replays the payments of a loan against its terms. Paid installments keep the amounts
+// posted; the others charge interest on the outstanding principal for the period, the final one clearing it.
+// Prepayments dated before an installment's due date reduce the principal it is computed on: REDUCE_PAYMENT
+// prepayments spread the rest over the remaining term, REDUCE_TERM prepayments keep the installment amount
+// so the loan ends earlier.
+func buildLoanSchedule(loan domain.Loan, payments []domain.LoanPayment, precision int32) []domain.Installment {
+	rate := loan.PeriodicRate()
+	paid := make(map[int]domain.LoanPayment)
+	prepayments := []domain.LoanPayment{}
+	lastPaid := 0
+	for _, payment := range payments {
+		switch payment.PaymentType {
+		case domain.LoanPaymentInstallment:
+			paid[payment.InstallmentNumber] = payment
+			if payment.InstallmentNumber > lastPaid {
+				lastPaid = payment.InstallmentNumber
+			}
+		case domain.LoanPaymentPrepayment:
+			prepayments = append(prepayments, payment)
+		}
+	}
+	sort.SliceStable(prepayments, func(i, j int) bool { return prepayments[i].PaymentDate.Before(prepayments[j].PaymentDate) })
+
+	balance := loan.Principal
+	amount := annuityPayment(balance, rate, loan.Term, precision)
+	installments := []domain.Installment{}
+	next := 0
+	for number := 1; ; number++ {
+		dueDate := loan.Frequency.DueDate(loan.StartDate, number)
+		for next < len(prepayments) && prepayments[next].PaymentDate.Before(dueDate) {
+			balance = balance.Sub(prepayments[next].Principal)
+			if prepayments[next].PrepaymentMode == domain.PrepaymentReducePayment && balance.IsPositive() {
+				amount = annuityPayment(balance, rate, loan.Term-number+1, precision)
+			}
+			next++
+		}
+
+		if payment, ok := paid[number]; ok {
+			balance = balance.Sub(payment.Principal)
+			installments = append(installments, domain.Installment{
+				Number:             number,
+				DueDate:            dueDate,
+				Payment:            payment.Principal.Add(payment.Interest),
+				Principal:          payment.Principal,
+				Interest:           payment.Interest,
+				RemainingPrincipal: balance,
+				Paid:               true,
+				JournalID:          payment.JournalID,
+			})
+			continue
+		}
+		if !balance.IsPositive() {
+			if number > lastPaid {
+				break
+			}
+			continue
+		}
+
+		interest := balance.Mul(rate).Round(precision)
+		principal := amount.Sub(interest)
+		if number >= loan.Term || principal.GreaterThan(balance) || !principal.IsPositive() {
+			principal = balance
+		}
+		balance = balance.Sub(principal)
+		installments = append(installments, domain.Installment{
+			Number:             number,
+			DueDate:            dueDate,
+			Payment:            principal.Add(interest),
+			Principal:          principal,
+			Interest:           interest,
+			RemainingPrincipal: balance,
+		})
+	}
+	return installments
+}
+
+// remainingPrincipal returns the principal of a loan less all principal repaid
+func remainingPrincipal(loan domain.Loan, payments []domain.LoanPayment) decimal.Decimal {
+	remaining := loan.Principal
+	for _, payment := range payments {
+		remaining = remaining.Sub(payment.Principal)
+	}
+	return remaining
+}
+
+// findLoan loads a loan and verifies that it belongs to the workplace
+func (s *loanService) findLoan(ctx context.Context, workplaceID string, loanID string) (*domain.Loan, error) {
+	loan, err := s.loanRepo.FindLoanByID(ctx, loanID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find loan by ID",
+			slog.String("loan_id", loanID))
+		return nil, fmt.Errorf("failed to find loan: %w", err)
+	}
+	if loan.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Loan found but belongs to different workplace",
+			slog.String("loan_id", loanID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return loan, nil
+}
+
+// loanAccounts loads the accounts of a loan and verifies that the loan account is a LIABILITY account and the
+// interest account an EXPENSE account of the workplace in the same currency. Other accounts only have to belong
+// to the workplace; the journal service checks their currency.
+func (s *loanService) loanAccounts(ctx context.Context, workplaceID string, accountID string, interestAccountID string, otherAccountIDs ...string) (map[string]domain.Account, error) {
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, append([]string{accountID, interestAccountID}, otherAccountIDs...))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load accounts of loan",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	loanAccount, ok := accounts[accountID]
+	if !ok || loanAccount.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: loan account %s not found", apperrors.ErrValidation, accountID)
+	}
+	if loanAccount.AccountType != domain.Liability {
+		return nil, fmt.Errorf("%w: loan account must be a LIABILITY account", apperrors.ErrValidation)
+	}
+	interestAccount, ok := accounts[interestAccountID]
+	if !ok || interestAccount.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: interest account %s not found", apperrors.ErrValidation, interestAccountID)
+	}
+	if interestAccount.AccountType != domain.Expense {
+		return nil, fmt.Errorf("%w: interest account must be an EXPENSE account", apperrors.ErrValidation)
+	}
+	if interestAccount.CurrencyCode != loanAccount.CurrencyCode {
+		return nil, fmt.Errorf("%w: interest account currency %s does not match loan account currency %s",
+			apperrors.ErrValidation, interestAccount.CurrencyCode, loanAccount.CurrencyCode)
+	}
+	for _, id := range otherAccountIDs {
+		account, ok := accounts[id]
+		if !ok || account.WorkplaceID != workplaceID {
+			return nil, fmt.Errorf("%w: account %s not found", apperrors.ErrValidation, id)
+		}
+		if id == accountID || id == interestAccountID {
+			return nil, fmt.Errorf("%w: the payment account must differ from the loan and interest accounts", apperrors.ErrValidation)
+		}
+	}
+	return accounts, nil
+}
+
+// loanState loads the payments of a loan and its currency precision
+func (s *loanService) loanState(ctx context.Context, loan *domain.Loan) ([]domain.LoanPayment, domain.Account, int32, error) {
+	payments, err := s.loanRepo.ListLoanPayments(ctx, loan.LoanID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list loan payments",
+			slog.String("loan_id", loan.LoanID))
+		return nil, domain.Account{}, 0, err
+	}
+	account, err := s.accountRepo.FindAccountByID(ctx, loan.AccountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find loan account",
+			slog.String("loan_id", loan.LoanID),
+			slog.String("account_id", loan.AccountID))
+		return nil, domain.Account{}, 0, err
+	}
+	return payments, *account, currencyPrecision(ctx, s.currencyRepo, account.CurrencyCode), nil
+}
+
+// postLoanJournal posts a journal for a loan and records the payment, reversing the journal when the payment
+// cannot be recorded
+func (s *loanService) postLoanJournal(ctx context.Context, workplaceID string, currencyCode string, payment *domain.LoanPayment, description string, transactions []dto.CreateTransactionRequest, userID string) error {
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         payment.PaymentDate,
+		Description:  description,
+		CurrencyCode: currencyCode,
+		Transactions: transactions,
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post loan journal",
+			slog.String("loan_id", payment.LoanID))
+		return journalValidationError(err)
+	}
+
+	payment.JournalID = journal.JournalID
+	if err := s.loanRepo.SaveLoanPayment(ctx, *payment); err != nil {
+		s.LogError(ctx, err, "Failed to save loan payment, reversing journal",
+			slog.String("loan_id", payment.LoanID),
+			slog.String("journal_id", journal.JournalID))
+		if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, journal.JournalID, userID); reverseErr != nil {
+			s.LogError(ctx, reverseErr, "Failed to reverse loan journal",
+				slog.String("journal_id", journal.JournalID))
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *loanService) CreateLoan(ctx context.Context, workplaceID string, req dto.CreateLoanRequest, userID string) (*domain.Loan, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create loan",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name must not be blank", apperrors.ErrValidation)
+	}
+	if req.AnnualRate.IsNegative() {
+		return nil, fmt.Errorf("%w: annual rate cannot be negative", apperrors.ErrValidation)
+	}
+	if req.Frequency.PeriodsPerYear() == 0 {
+		return nil, fmt.Errorf("%w: unknown frequency %s", apperrors.ErrValidation, req.Frequency)
+	}
+	otherAccounts := []string{}
+	if req.DisbursementAccountID != "" {
+		otherAccounts = append(otherAccounts, req.DisbursementAccountID)
+	}
+	accounts, err := s.loanAccounts(ctx, workplaceID, req.AccountID, req.InterestAccountID, otherAccounts...)
+	if err != nil {
+		return nil, err
+	}
+	currencyCode := accounts[req.AccountID].CurrencyCode
+	principal := req.Principal.Round(currencyPrecision(ctx, s.currencyRepo, currencyCode))
+	if !principal.IsPositive() {
+		return nil, fmt.Errorf("%w: principal must be positive", apperrors.ErrValidation)
+	}
+
+	now := time.Now()
+	loan := &domain.Loan{
+		LoanID:            uuid.NewString(),
+		WorkplaceID:       workplaceID,
+		AccountID:         req.AccountID,
+		InterestAccountID: req.InterestAccountID,
+		Name:              name,
+		Principal:         principal,
+		AnnualRate:        req.AnnualRate,
+		Term:              req.Term,
+		Frequency:         req.Frequency,
+		StartDate:         dateOnly(req.StartDate),
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+
+	if req.DisbursementAccountID != "" {
+		journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+			Date:         loan.StartDate,
+			Description:  name + " disbursement",
+			CurrencyCode: currencyCode,
+			Transactions: []dto.CreateTransactionRequest{
+				{AccountID: req.DisbursementAccountID, Amount: principal, TransactionType: domain.Debit},
+				{AccountID: req.AccountID, Amount: principal, TransactionType: domain.Credit},
+			},
+		}, userID)
+		if err != nil {
+			s.LogError(ctx, err, "Failed to post loan disbursement",
+				slog.String("workplace_id", workplaceID))
+			return nil, journalValidationError(err)
+		}
+		loan.DisbursementJournalID = journal.JournalID
+	}
+
+	if err := s.loanRepo.SaveLoan(ctx, *loan); err != nil {
+		s.LogError(ctx, err, "Failed to save loan",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", req.AccountID))
+		if loan.DisbursementJournalID != "" {
+			if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, loan.DisbursementJournalID, userID); reverseErr != nil {
+				s.LogError(ctx, reverseErr, "Failed to reverse loan disbursement",
+					slog.String("journal_id", loan.DisbursementJournalID))
+			}
+		}
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: account %s already backs a loan", apperrors.ErrConflict, req.AccountID)
+		}
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Loan created successfully",
+		slog.String("loan_id", loan.LoanID),
+		slog.String("workplace_id", workplaceID))
+	return loan, nil
+}
+
+func (s *loanService) ListLoans(ctx context.Context, workplaceID string, userID string) ([]domain.Loan, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list loans",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	loans, err := s.loanRepo.ListLoans(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list loans",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return loans, nil
+}
+
+func (s *loanService) GetLoan(ctx context.Context, workplaceID string, loanID string, userID string) (*domain.Loan, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view loan",
+			slog.String("workplace_id", workplaceID),
+			slog.String("loan_id", loanID))
+		return nil, err
+	}
+	return s.findLoan(ctx, workplaceID, loanID)
+}
+
+func (s *loanService) UpdateLoan(ctx context.Context, workplaceID string, loanID string, req dto.UpdateLoanRequest, userID string) (*domain.Loan, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update loan",
+			slog.String("workplace_id", workplaceID),
+			slog.String("loan_id", loanID))
+		return nil, err
+	}
+
+	loan, err := s.findLoan(ctx, workplaceID, loanID)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name must not be blank", apperrors.ErrValidation)
+	}
+	if _, err := s.loanAccounts(ctx, workplaceID, loan.AccountID, req.InterestAccountID); err != nil {
+		return nil, err
+	}
+
+	loan.Name = name
+	loan.InterestAccountID = req.InterestAccountID
+	loan.LastUpdatedAt = time.Now()
+	loan.LastUpdatedBy = userID
+	if err := s.loanRepo.UpdateLoan(ctx, *loan); err != nil {
+		s.LogError(ctx, err, "Failed to update loan",
+			slog.String("loan_id", loanID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Loan updated successfully",
+		slog.String("loan_id", loanID),
+		slog.String("workplace_id", workplaceID))
+	return loan, nil
+}
+
+func (s *loanService) DeleteLoan(ctx context.Context, workplaceID string, loanID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete loan",
+			slog.String("workplace_id", workplaceID),
+			slog.String("loan_id", loanID))
+		return err
+	}
+
+	if _, err := s.findLoan(ctx, workplaceID, loanID); err != nil {
+		return err
+	}
+	if err := s.loanRepo.DeleteLoan(ctx, loanID); err != nil {
+		s.LogError(ctx, err, "Failed to delete loan",
+			slog.String("loan_id", loanID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Loan deleted successfully",
+		slog.String("loan_id", loanID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+func (s *loanService) GetLoanSchedule(ctx context.Context, workplaceID string, loanID string, userID string) (*domain.LoanSchedule, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view loan schedule",
+			slog.String("workplace_id", workplaceID),
+			slog.String("loan_id", loanID))
+		return nil, err
+	}
+
+	loan, err := s.findLoan(ctx, workplaceID, loanID)
+	if err != nil {
+		return nil, err
+	}
+	payments, account, precision, err := s.loanState(ctx, loan)
+	if err != nil {
+		return nil, err
+	}
+
+	prepayments := []domain.LoanPayment{}
+	for _, payment := range payments {
+		if payment.PaymentType == domain.LoanPaymentPrepayment {
+			prepayments = append(prepayments, payment)
+		}
+	}
+	remaining := remainingPrincipal(*loan, payments)
+	return &domain.LoanSchedule{
+		Loan:               *loan,
+		CurrencyCode:       account.CurrencyCode,
+		Installments:       buildLoanSchedule(*loan, payments, precision),
+		Prepayments:        prepayments,
+		RemainingPrincipal: remaining,
+		AccountBalance:     account.Balance,
+		Difference:         account.Balance.Sub(remaining),
+	}, nil
+}
+
+func (s *loanService) PostInstallment(ctx context.Context, workplaceID string, loanID string, req dto.PostInstallmentRequest, userID string) (*domain.LoanPayment, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to post loan installment",
+			slog.String("workplace_id", workplaceID),
+			slog.String("loan_id", loanID))
+		return nil, err
+	}
+
+	loan, err := s.findLoan(ctx, workplaceID, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.loanAccounts(ctx, workplaceID, loan.AccountID, loan.InterestAccountID, req.PaymentAccountID); err != nil {
+		return nil, err
+	}
+	payments, account, precision, err := s.loanState(ctx, loan)
+	if err != nil {
+		return nil, err
+	}
+
+	var due *domain.Installment
+	for _, installment := range buildLoanSchedule(*loan, payments, precision) {
+		if !installment.Paid {
+			due = &installment
+			break
+		}
+	}
+	if due == nil {
+		return nil, fmt.Errorf("%w: loan %s is fully repaid", apperrors.ErrValidation, loan.Name)
+	}
+
+	date := due.DueDate
+	if req.Date != nil {
+		date = dateOnly(*req.Date)
+	}
+	payment := &domain.LoanPayment{
+		PaymentID:         uuid.NewString(),
+		LoanID:            loanID,
+		PaymentType:       domain.LoanPaymentInstallment,
+		InstallmentNumber: due.Number,
+		PaymentDate:       date,
+		Principal:         due.Principal,
+		Interest:          due.Interest,
+		CreatedAt:         time.Now(),
+		CreatedBy:         userID,
+	}
+
+	transactions := []dto.CreateTransactionRequest{}
+	if due.Principal.IsPositive() {
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID: loan.AccountID, Amount: due.Principal, TransactionType: domain.Debit, Notes: "Principal",
+		})
+	}
+	if due.Interest.IsPositive() {
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID: loan.InterestAccountID, Amount: due.Interest, TransactionType: domain.Debit, Notes: "Interest",
+		})
+	}
+	transactions = append(transactions, dto.CreateTransactionRequest{
+		AccountID: req.PaymentAccountID, Amount: due.Payment, TransactionType: domain.Credit,
+	})
+
+	description := fmt.Sprintf("%s installment %d", loan.Name, due.Number)
+	if err := s.postLoanJournal(ctx, workplaceID, account.CurrencyCode, payment, description, transactions, userID); err != nil {
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Loan installment posted",
+		slog.String("loan_id", loanID),
+		slog.Int("installment", due.Number),
+		slog.String("journal_id", payment.JournalID))
+	return payment, nil
+}
+
+func (s *loanService) PostPrepayment(ctx context.Context, workplaceID string, loanID string, req dto.PrepaymentRequest, userID string) (*domain.LoanPayment, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to post loan prepayment",
+			slog.String("workplace_id", workplaceID),
+			slog.String("loan_id", loanID))
+		return nil, err
+	}
+
+	loan, err := s.findLoan(ctx, workplaceID, loanID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.loanAccounts(ctx, workplaceID, loan.AccountID, loan.InterestAccountID, req.PaymentAccountID); err != nil {
+		return nil, err
+	}
+	payments, account, precision, err := s.loanState(ctx, loan)
+	if err != nil {
+		return nil, err
+	}
+
+	date := dateOnly(req.Date)
+	if date.Before(loan.StartDate) {
+		return nil, fmt.Errorf("%w: a prepayment cannot be dated before the loan starts", apperrors.ErrValidation)
+	}
+	amount := req.Amount.Round(precision)
+	remaining := remainingPrincipal(*loan, payments)
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", apperrors.ErrValidation)
+	}
+	if amount.GreaterThan(remaining) {
+		return nil, fmt.Errorf("%w: prepayment of %s exceeds the remaining principal of %s",
+			apperrors.ErrValidation, amount.String(), remaining.String())
+	}
+	mode := req.Mode
+	if mode == "" {
+		mode = domain.PrepaymentReduceTerm
+	}
+
+	payment := &domain.LoanPayment{
+		PaymentID:      uuid.NewString(),
+		LoanID:         loanID,
+		PaymentType:    domain.LoanPaymentPrepayment,
+		PrepaymentMode: mode,
+		PaymentDate:    date,
+		Principal:      amount,
+		CreatedAt:      time.Now(),
+		CreatedBy:      userID,
+	}
+	transactions := []dto.CreateTransactionRequest{
+		{AccountID: loan.AccountID, Amount: amount, TransactionType: domain.Debit, Notes: "Prepayment"},
+		{AccountID: req.PaymentAccountID, Amount: amount, TransactionType: domain.Credit},
+	}
+	if err := s.postLoanJournal(ctx, workplaceID, account.CurrencyCode, payment, loan.Name+" prepayment", transactions, userID); err != nil {
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Loan prepayment posted",
+		slog.String("loan_id", loanID),
+		slog.String("amount", amount.String()),
+		slog.String("journal_id", payment.JournalID))
+	return payment, nil
+}
@@ -0,0 +1,325 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock LoanRepository ---
+type MockLoanRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.LoanRepositoryFacade = (*MockLoanRepository)(nil)
+
+func (m *MockLoanRepository) FindLoanByID(ctx context.Context, loanID string) (*domain.Loan, error) {
+	args := m.Called(ctx, loanID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Loan), args.Error(1)
+}
+
+func (m *MockLoanRepository) ListLoans(ctx context.Context, workplaceID string) ([]domain.Loan, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Loan), args.Error(1)
+}
+
+func (m *MockLoanRepository) ListLoanPayments(ctx context.Context, loanID string) ([]domain.LoanPayment, error) {
+	args := m.Called(ctx, loanID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.LoanPayment), args.Error(1)
+}
+
+func (m *MockLoanRepository) SaveLoan(ctx context.Context, loan domain.Loan) error {
+	args := m.Called(ctx, loan)
+	return args.Error(0)
+}
+
+func (m *MockLoanRepository) UpdateLoan(ctx context.Context, loan domain.Loan) error {
+	args := m.Called(ctx, loan)
+	return args.Error(0)
+}
+
+func (m *MockLoanRepository) DeleteLoan(ctx context.Context, loanID string) error {
+	args := m.Called(ctx, loanID)
+	return args.Error(0)
+}
+
+func (m *MockLoanRepository) SaveLoanPayment(ctx context.Context, payment domain.LoanPayment) error {
+	args := m.Called(ctx, payment)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type LoanServiceTestSuite struct {
+	suite.Suite
+	mockLoanRepo     *MockLoanRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockCurrencyRepo *MockCurrencyRepository
+	mockJournalSvc   *MockJournalWriterSvc
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.LoanSvcFacade
+	workplaceID      string
+	userID           string
+	loan             domain.Loan
+}
+
+func (suite *LoanServiceTestSuite) SetupTest() {
+	suite.mockLoanRepo = new(MockLoanRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockJournalSvc = new(MockJournalWriterSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewLoanService(suite.mockLoanRepo, suite.mockAccountRepo, suite.mockCurrencyRepo,
+		suite.mockJournalSvc, services.WithLoanWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	// 1200 at 12% a year over 12 monthly installments: 1% a month, installments of 106.62
+	suite.loan = domain.Loan{
+		LoanID:            uuid.NewString(),
+		WorkplaceID:       suite.workplaceID,
+		AccountID:         "loan",
+		InterestAccountID: "interest",
+		Name:              "Car loan",
+		Principal:         decimal.NewFromInt(1200),
+		AnnualRate:        decimal.NewFromInt(12),
+		Term:              12,
+		Frequency:         domain.LoanMonthly,
+		StartDate:         time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", mock.Anything, suite.userID, suite.workplaceID, mock.Anything).Return(nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func TestLoanService(t *testing.T) {
+	suite.Run(t, new(LoanServiceTestSuite))
+}
+
+// expectLoanAccounts mocks the LIABILITY loan account, the EXPENSE interest account and the ASSET bank account
+func (suite *LoanServiceTestSuite) expectLoanAccounts(ctx context.Context) {
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"loan":     {AccountID: "loan", WorkplaceID: suite.workplaceID, AccountType: domain.Liability, CurrencyCode: "USD"},
+		"interest": {AccountID: "interest", WorkplaceID: suite.workplaceID, AccountType: domain.Expense, CurrencyCode: "USD"},
+		"bank":     {AccountID: "bank", WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD"},
+	}, nil).Once()
+}
+
+// expectLoanState mocks the loan, its payments and the loan account with the given balance
+func (suite *LoanServiceTestSuite) expectLoanState(ctx context.Context, balance string, payments ...domain.LoanPayment) {
+	suite.mockLoanRepo.On("FindLoanByID", ctx, suite.loan.LoanID).Return(&suite.loan, nil).Once()
+	suite.mockLoanRepo.On("ListLoanPayments", ctx, suite.loan.LoanID).Return(payments, nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, "loan").Return(&domain.Account{
+		AccountID: "loan", WorkplaceID: suite.workplaceID, AccountType: domain.Liability, CurrencyCode: "USD",
+		Balance: decimal.RequireFromString(balance),
+	}, nil).Once()
+}
+
+func (suite *LoanServiceTestSuite) installment(number int, principal string, interest string) domain.LoanPayment {
+	return domain.LoanPayment{
+		PaymentID: uuid.NewString(), LoanID: suite.loan.LoanID, JournalID: uuid.NewString(),
+		PaymentType: domain.LoanPaymentInstallment, InstallmentNumber: number,
+		PaymentDate: suite.loan.Frequency.DueDate(suite.loan.StartDate, number),
+		Principal:   decimal.RequireFromString(principal), Interest: decimal.RequireFromString(interest),
+	}
+}
+
+func (suite *LoanServiceTestSuite) prepayment(amount string, mode domain.PrepaymentMode) domain.LoanPayment {
+	return domain.LoanPayment{
+		PaymentID: uuid.NewString(), LoanID: suite.loan.LoanID, JournalID: uuid.NewString(),
+		PaymentType: domain.LoanPaymentPrepayment, PrepaymentMode: mode,
+		PaymentDate: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC), Principal: decimal.RequireFromString(amount),
+	}
+}
+
+func (suite *LoanServiceTestSuite) TestGetLoanSchedule_AnnuityRepaysPrincipal() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "1200")
+
+	schedule, err := suite.service.GetLoanSchedule(ctx, suite.workplaceID, suite.loan.LoanID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(schedule.Installments, 12)
+	first := schedule.Installments[0]
+	suite.True(first.Payment.Equal(decimal.RequireFromString("106.62")))
+	suite.True(first.Interest.Equal(decimal.RequireFromString("12")))
+	suite.True(first.Principal.Equal(decimal.RequireFromString("94.62")))
+	suite.Equal(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), first.DueDate)
+	total := decimal.Zero
+	for _, installment := range schedule.Installments {
+		total = total.Add(installment.Principal)
+	}
+	suite.True(total.Equal(decimal.NewFromInt(1200)))
+	suite.True(schedule.Installments[11].RemainingPrincipal.IsZero())
+	suite.True(schedule.Difference.IsZero())
+}
+
+func (suite *LoanServiceTestSuite) TestGetLoanSchedule_ComparesRemainingPrincipalWithBalance() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "1150", suite.installment(1, "94.62", "12"))
+
+	schedule, err := suite.service.GetLoanSchedule(ctx, suite.workplaceID, suite.loan.LoanID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(schedule.Installments[0].Paid)
+	suite.True(schedule.RemainingPrincipal.Equal(decimal.RequireFromString("1105.38")))
+	suite.True(schedule.Difference.Equal(decimal.RequireFromString("44.62")))
+}
+
+func (suite *LoanServiceTestSuite) TestGetLoanSchedule_ReduceTermPrepaymentShortensLoan() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "600", suite.prepayment("600", domain.PrepaymentReduceTerm))
+
+	schedule, err := suite.service.GetLoanSchedule(ctx, suite.workplaceID, suite.loan.LoanID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Less(len(schedule.Installments), 12)
+	suite.True(schedule.Installments[0].Payment.Equal(decimal.RequireFromString("106.62")))
+	suite.True(schedule.Installments[len(schedule.Installments)-1].RemainingPrincipal.IsZero())
+	suite.Len(schedule.Prepayments, 1)
+}
+
+func (suite *LoanServiceTestSuite) TestGetLoanSchedule_ReducePaymentPrepaymentLowersInstallments() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "600", suite.prepayment("600", domain.PrepaymentReducePayment))
+
+	schedule, err := suite.service.GetLoanSchedule(ctx, suite.workplaceID, suite.loan.LoanID, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Len(schedule.Installments, 12)
+	suite.True(schedule.Installments[0].Payment.Equal(decimal.RequireFromString("53.31")))
+	suite.True(schedule.Installments[11].RemainingPrincipal.IsZero())
+}
+
+func (suite *LoanServiceTestSuite) TestPostInstallment_SplitsPrincipalAndInterest() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "1105.38", suite.installment(1, "94.62", "12"))
+	suite.expectLoanAccounts(ctx)
+	// Interest on 1105.38 at 1% is 11.05, leaving 95.57 of the 106.62 installment for principal
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return r.CurrencyCode == "USD" && r.Description == "Car loan installment 2" &&
+			r.Date.Equal(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)) && hasLines(r,
+			expectedLine{"loan", "95.57", domain.Debit},
+			expectedLine{"interest", "11.05", domain.Debit},
+			expectedLine{"bank", "106.62", domain.Credit},
+		)
+	}), suite.userID).Return(&domain.Journal{JournalID: "journal-2"}, nil).Once()
+	suite.mockLoanRepo.On("SaveLoanPayment", ctx, mock.MatchedBy(func(p domain.LoanPayment) bool {
+		return p.InstallmentNumber == 2 && p.JournalID == "journal-2" && p.PaymentType == domain.LoanPaymentInstallment
+	})).Return(nil).Once()
+
+	payment, err := suite.service.PostInstallment(ctx, suite.workplaceID, suite.loan.LoanID, dto.PostInstallmentRequest{PaymentAccountID: "bank"}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.True(payment.Principal.Equal(decimal.RequireFromString("95.57")))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockLoanRepo.AssertExpectations(suite.T())
+}
+
+func (suite *LoanServiceTestSuite) TestPostInstallment_ReversesJournalWhenPaymentNotSaved() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "1200")
+	suite.expectLoanAccounts(ctx)
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(&domain.Journal{JournalID: "journal-1"}, nil).Once()
+	suite.mockLoanRepo.On("SaveLoanPayment", ctx, mock.Anything).Return(errors.New("db down")).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "journal-1", suite.userID).Return(&domain.Journal{}, nil).Once()
+
+	_, err := suite.service.PostInstallment(ctx, suite.workplaceID, suite.loan.LoanID, dto.PostInstallmentRequest{PaymentAccountID: "bank"}, suite.userID)
+
+	suite.Error(err)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+}
+
+func (suite *LoanServiceTestSuite) TestPostInstallment_FullyRepaidLoan() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "0", suite.prepayment("1200", domain.PrepaymentReduceTerm))
+	suite.expectLoanAccounts(ctx)
+
+	_, err := suite.service.PostInstallment(ctx, suite.workplaceID, suite.loan.LoanID, dto.PostInstallmentRequest{PaymentAccountID: "bank"}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *LoanServiceTestSuite) TestPostPrepayment_ExceedingRemainingPrincipalIsRejected() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "1105.38", suite.installment(1, "94.62", "12"))
+	suite.expectLoanAccounts(ctx)
+
+	_, err := suite.service.PostPrepayment(ctx, suite.workplaceID, suite.loan.LoanID, dto.PrepaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(1200), Date: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *LoanServiceTestSuite) TestPostPrepayment_DefaultsToReduceTerm() {
+	ctx := context.Background()
+	suite.expectLoanState(ctx, "1200")
+	suite.expectLoanAccounts(ctx)
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return hasLines(r, expectedLine{"loan", "300", domain.Debit}, expectedLine{"bank", "300", domain.Credit})
+	}), suite.userID).Return(&domain.Journal{JournalID: "journal-p"}, nil).Once()
+	suite.mockLoanRepo.On("SaveLoanPayment", ctx, mock.MatchedBy(func(p domain.LoanPayment) bool {
+		return p.PaymentType == domain.LoanPaymentPrepayment && p.PrepaymentMode == domain.PrepaymentReduceTerm
+	})).Return(nil).Once()
+
+	_, err := suite.service.PostPrepayment(ctx, suite.workplaceID, suite.loan.LoanID, dto.PrepaymentRequest{
+		PaymentAccountID: "bank", Amount: decimal.NewFromInt(300), Date: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.mockLoanRepo.AssertExpectations(suite.T())
+}
+
+func (suite *LoanServiceTestSuite) TestCreateLoan_RequiresLiabilityAccount() {
+	ctx := context.Background()
+	suite.expectLoanAccounts(ctx)
+
+	_, err := suite.service.CreateLoan(ctx, suite.workplaceID, dto.CreateLoanRequest{
+		Name: "Car loan", AccountID: "bank", InterestAccountID: "interest", Principal: decimal.NewFromInt(1200),
+		AnnualRate: decimal.NewFromInt(12), Term: 12, Frequency: domain.LoanMonthly, StartDate: suite.loan.StartDate,
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockLoanRepo.AssertNotCalled(suite.T(), "SaveLoan", mock.Anything, mock.Anything)
+}
+
+func (suite *LoanServiceTestSuite) TestCreateLoan_DuplicateAccountReversesDisbursement() {
+	ctx := context.Background()
+	suite.expectLoanAccounts(ctx)
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(r dto.CreateJournalRequest) bool {
+		return hasLines(r, expectedLine{"bank", "1200", domain.Debit}, expectedLine{"loan", "1200", domain.Credit})
+	}), suite.userID).Return(&domain.Journal{JournalID: "journal-d"}, nil).Once()
+	suite.mockLoanRepo.On("SaveLoan", ctx, mock.Anything).Return(apperrors.ErrDuplicate).Once()
+	suite.mockJournalSvc.On("ReverseJournal", ctx, suite.workplaceID, "journal-d", suite.userID).Return(&domain.Journal{}, nil).Once()
+
+	_, err := suite.service.CreateLoan(ctx, suite.workplaceID, dto.CreateLoanRequest{
+		Name: "Car loan", AccountID: "loan", InterestAccountID: "interest", Principal: decimal.NewFromInt(1200),
+		AnnualRate: decimal.NewFromInt(12), Term: 12, Frequency: domain.LoanMonthly, StartDate: suite.loan.StartDate,
+		DisbursementAccountID: "bank",
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrConflict))
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+}
@@ -0,0 +1,362 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+)
+
+// payeeService implements the PayeeSvcFacade interface
+type payeeService struct {
+	BaseService
+	payeeRepo   portsrepo.PayeeRepositoryFacade
+	accountRepo portsrepo.AccountReader
+}
+
+// PayeeServiceOption is a functional option for configuring the payee service
+type PayeeServiceOption func(*payeeService)
+
+// WithPayeeWorkplaceAuthorizer adds workplace authorizer dependency
+func WithPayeeWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) PayeeServiceOption {
+	return func(s *payeeService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewPayeeService creates a new payee service
+func NewPayeeService(payeeRepo portsrepo.PayeeRepositoryFacade, accountRepo portsrepo.AccountReader, options ...PayeeServiceOption) portssvc.PayeeSvcFacade {
+	svc := &payeeService{
+		payeeRepo:   payeeRepo,
+		accountRepo: accountRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure payeeService implements the PayeeSvcFacade interface
+var _ portssvc.PayeeSvcFacade = (*payeeService)(nil)
+
+// payeeTokens lower-cases text and splits it into words, treating any punctuation as a separator
+// so that "AMAZON.COM*MKTPLACE" yields amazon, com and mktplace
+func payeeTokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// containsTokens reports whether words contains term as a contiguous run of whole words
+func containsTokens(words, term []string) bool {
+	for start := 0; start+len(term) <= len(words); start++ {
+		matched := true
+		for i := range term {
+			if words[start+i] != term[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePayee finds the active payee whose name or an alias occurs in text as whole words. When several
+// do, the longest name or alias wins, so "Amazon Prime" beats "Amazon"; ties go to the earlier payee.
+func resolvePayee(payees []domain.Payee, text string) *domain.PayeeMatch {
+	words := payeeTokens(text)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var best *domain.PayeeMatch
+	bestLength := 0
+	for i := range payees {
+		if !payees[i].IsActive {
+			continue
+		}
+		for _, term := range append([]string{payees[i].Name}, payees[i].Aliases...) {
+			termWords := payeeTokens(term)
+			length := len(strings.Join(termWords, " "))
+			if length == 0 || length <= bestLength || !containsTokens(words, termWords) {
+				continue
+			}
+			best = &domain.PayeeMatch{Payee: payees[i], MatchedTerm: term}
+			bestLength = length
+		}
+	}
+	return best
+}
+
+// resolvePayeeFromText loads the workplace's payees and resolves text against them. It is used when
+// journals and statement lines are created; a nil repository or empty text resolves to nothing.
+func resolvePayeeFromText(ctx context.Context, payeeRepo portsrepo.PayeeReader, workplaceID string, text string) (*domain.PayeeMatch, error) {
+	if payeeRepo == nil || strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	payees, err := payeeRepo.ListPayees(ctx, workplaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load payees: %w", err)
+	}
+	return resolvePayee(payees, text), nil
+}
+
+// loadWorkplacePayee loads a payee referenced by a journal or transaction and checks that it is an
+// active payee of the workplace
+func loadWorkplacePayee(ctx context.Context, payeeRepo portsrepo.PayeeReader, workplaceID string, payeeID string) (*domain.Payee, error) {
+	payee, err := payeeRepo.FindPayeeByID(ctx, payeeID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, fmt.Errorf("%w: payee %s not found in workplace", apperrors.ErrValidation, payeeID)
+		}
+		return nil, fmt.Errorf("failed to load payee: %w", err)
+	}
+	if payee.WorkplaceID != workplaceID {
+		return nil, fmt.Errorf("%w: payee %s not found in workplace", apperrors.ErrValidation, payeeID)
+	}
+	if !payee.IsActive {
+		return nil, fmt.Errorf("%w: payee %s is inactive", apperrors.ErrValidation, payee.Name)
+	}
+	return payee, nil
+}
+
+// findPayee loads a payee and verifies that it belongs to the workplace
+func (s *payeeService) findPayee(ctx context.Context, workplaceID string, payeeID string) (*domain.Payee, error) {
+	payee, err := s.payeeRepo.FindPayeeByID(ctx, payeeID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find payee by ID",
+			slog.String("payee_id", payeeID))
+		return nil, fmt.Errorf("failed to find payee: %w", err)
+	}
+	if payee.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Payee found but belongs to different workplace",
+			slog.String("payee_id", payeeID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return payee, nil
+}
+
+// buildPayee validates a payee request and applies it to the payee. Names and aliases must be unique
+// across the workplace's payees so that a description never resolves ambiguously.
+func (s *payeeService) buildPayee(ctx context.Context, workplaceID string, payee *domain.Payee, req dto.PayeeRequest) error {
+	payee.Name = strings.Join(strings.Fields(req.Name), " ")
+	if payee.Name == "" {
+		return fmt.Errorf("%w: payee name cannot be empty", apperrors.ErrValidation)
+	}
+	payee.IsActive = req.IsActive == nil || *req.IsActive
+	payee.DefaultCounterAccountID = req.DefaultCounterAccountID
+
+	terms := map[string]bool{domain.NormalizePayee(payee.Name): true}
+	payee.Aliases = []string{}
+	for _, alias := range req.Aliases {
+		alias = strings.Join(strings.Fields(alias), " ")
+		normalized := domain.NormalizePayee(alias)
+		if normalized == "" || terms[normalized] {
+			continue
+		}
+		terms[normalized] = true
+		payee.Aliases = append(payee.Aliases, alias)
+	}
+
+	existing, err := s.payeeRepo.ListPayees(ctx, workplaceID)
+	if err != nil {
+		return fmt.Errorf("failed to load payees: %w", err)
+	}
+	for _, other := range existing {
+		if other.PayeeID == payee.PayeeID {
+			continue
+		}
+		for _, term := range append([]string{other.Name}, other.Aliases...) {
+			if terms[domain.NormalizePayee(term)] {
+				return fmt.Errorf("%w: %q is already used by payee %s", apperrors.ErrConflict, term, other.Name)
+			}
+		}
+	}
+
+	if payee.DefaultCounterAccountID != "" {
+		account, err := s.accountRepo.FindAccountByID(ctx, payee.DefaultCounterAccountID)
+		if err != nil {
+			if errors.Is(err, apperrors.ErrNotFound) {
+				return fmt.Errorf("%w: default counter-account %s not found in workplace", apperrors.ErrValidation, payee.DefaultCounterAccountID)
+			}
+			return fmt.Errorf("failed to load default counter-account: %w", err)
+		}
+		if account.WorkplaceID != workplaceID {
+			return fmt.Errorf("%w: default counter-account %s not found in workplace", apperrors.ErrValidation, payee.DefaultCounterAccountID)
+		}
+		if !account.IsActive {
+			return fmt.Errorf("%w: default counter-account %s is inactive", apperrors.ErrValidation, account.Name)
+		}
+	}
+	return nil
+}
+
+// savePayeeError translates repository errors raised when saving a payee
+func savePayeeError(err error) error {
+	if errors.Is(err, apperrors.ErrDuplicate) {
+		return fmt.Errorf("%w: a payee with this name or alias already exists", apperrors.ErrConflict)
+	}
+	return err
+}
+
+func (s *payeeService) CreatePayee(ctx context.Context, workplaceID string, req dto.PayeeRequest, userID string) (*domain.Payee, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create payee",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	payee := domain.Payee{
+		PayeeID:     uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.buildPayee(ctx, workplaceID, &payee, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.payeeRepo.SavePayee(ctx, payee); err != nil {
+		s.LogError(ctx, err, "Failed to save payee",
+			slog.String("payee_id", payee.PayeeID),
+			slog.String("workplace_id", workplaceID))
+		return nil, savePayeeError(err)
+	}
+
+	s.LogInfo(ctx, "Payee created successfully",
+		slog.String("payee_id", payee.PayeeID),
+		slog.String("workplace_id", workplaceID))
+	return &payee, nil
+}
+
+func (s *payeeService) ListPayees(ctx context.Context, workplaceID string, params dto.ListPayeesParams, userID string) ([]domain.Payee, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list payees",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	payees, err := s.payeeRepo.ListPayees(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list payees",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if params.IncludeInactive {
+		return payees, nil
+	}
+
+	active := make([]domain.Payee, 0, len(payees))
+	for _, payee := range payees {
+		if payee.IsActive {
+			active = append(active, payee)
+		}
+	}
+	return active, nil
+}
+
+func (s *payeeService) GetPayee(ctx context.Context, workplaceID string, payeeID string, userID string) (*domain.Payee, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view payee",
+			slog.String("workplace_id", workplaceID),
+			slog.String("payee_id", payeeID))
+		return nil, err
+	}
+	return s.findPayee(ctx, workplaceID, payeeID)
+}
+
+func (s *payeeService) ResolvePayee(ctx context.Context, workplaceID string, text string, userID string) (*domain.PayeeMatch, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to resolve payee",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	match, err := resolvePayeeFromText(ctx, s.payeeRepo, workplaceID, text)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to resolve payee",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return match, nil
+}
+
+func (s *payeeService) UpdatePayee(ctx context.Context, workplaceID string, payeeID string, req dto.PayeeRequest, userID string) (*domain.Payee, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update payee",
+			slog.String("workplace_id", workplaceID),
+			slog.String("payee_id", payeeID))
+		return nil, err
+	}
+
+	payee, err := s.findPayee(ctx, workplaceID, payeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.buildPayee(ctx, workplaceID, payee, req); err != nil {
+		return nil, err
+	}
+	payee.LastUpdatedAt = time.Now()
+	payee.LastUpdatedBy = userID
+
+	if err := s.payeeRepo.UpdatePayee(ctx, *payee); err != nil {
+		s.LogError(ctx, err, "Failed to update payee",
+			slog.String("payee_id", payeeID))
+		return nil, savePayeeError(err)
+	}
+
+	s.LogInfo(ctx, "Payee updated successfully",
+		slog.String("payee_id", payeeID),
+		slog.String("workplace_id", workplaceID))
+	return payee, nil
+}
+
+func (s *payeeService) DeletePayee(ctx context.Context, workplaceID string, payeeID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete payee",
+			slog.String("workplace_id", workplaceID),
+			slog.String("payee_id", payeeID))
+		return err
+	}
+
+	if _, err := s.findPayee(ctx, workplaceID, payeeID); err != nil {
+		return err
+	}
+	if err := s.payeeRepo.DeletePayee(ctx, payeeID); err != nil {
+		s.LogError(ctx, err, "Failed to delete payee",
+			slog.String("payee_id", payeeID))
+		if errors.Is(err, apperrors.ErrConflict) {
+			return fmt.Errorf("%w: payee is referenced by journals; deactivate it instead", apperrors.ErrConflict)
+		}
+		return err
+	}
+
+	s.LogInfo(ctx, "Payee deleted successfully",
+		slog.String("payee_id", payeeID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
@@ -0,0 +1,222 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock PayeeRepository ---
+type MockPayeeRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.PayeeRepositoryFacade = (*MockPayeeRepository)(nil)
+
+func (m *MockPayeeRepository) FindPayeeByID(ctx context.Context, payeeID string) (*domain.Payee, error) {
+	args := m.Called(ctx, payeeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Payee), args.Error(1)
+}
+
+func (m *MockPayeeRepository) ListPayees(ctx context.Context, workplaceID string) ([]domain.Payee, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Payee), args.Error(1)
+}
+
+func (m *MockPayeeRepository) SavePayee(ctx context.Context, payee domain.Payee) error {
+	args := m.Called(ctx, payee)
+	return args.Error(0)
+}
+
+func (m *MockPayeeRepository) UpdatePayee(ctx context.Context, payee domain.Payee) error {
+	args := m.Called(ctx, payee)
+	return args.Error(0)
+}
+
+func (m *MockPayeeRepository) DeletePayee(ctx context.Context, payeeID string) error {
+	args := m.Called(ctx, payeeID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type PayeeServiceTestSuite struct {
+	suite.Suite
+	mockPayeeRepo    *MockPayeeRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockJournalRepo  *MockJournalRepository
+	mockAccountSvc   *MockAccountService2
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.PayeeSvcFacade
+	journalService   portssvc.JournalSvcFacade
+	workplaceID      string
+	userID           string
+	bankAccount      domain.Account
+	expenseAccount   domain.Account
+}
+
+func (suite *PayeeServiceTestSuite) SetupTest() {
+	suite.mockPayeeRepo = new(MockPayeeRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockJournalRepo = new(MockJournalRepository)
+	suite.mockAccountSvc = new(MockAccountService2)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewPayeeService(suite.mockPayeeRepo, suite.mockAccountRepo,
+		services.WithPayeeWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.journalService = services.NewJournalService(suite.mockJournalRepo, suite.mockAccountSvc, suite.mockWorkplaceSvc,
+		services.WithJournalPayees(suite.mockPayeeRepo))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.expenseAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Expense, CurrencyCode: "USD", IsActive: true}
+}
+
+func TestPayeeService(t *testing.T) {
+	suite.Run(t, new(PayeeServiceTestSuite))
+}
+
+// payees returns the workplace payees ordered by name: Amazon, Amazon Prime and an inactive Starbucks
+func (suite *PayeeServiceTestSuite) payees() []domain.Payee {
+	return []domain.Payee{
+		{PayeeID: "amazon", WorkplaceID: suite.workplaceID, Name: "Amazon", Aliases: []string{"AMZN Mktp"}, IsActive: true},
+		{PayeeID: "prime", WorkplaceID: suite.workplaceID, Name: "Amazon Prime", Aliases: []string{}, IsActive: true},
+		{PayeeID: "starbucks", WorkplaceID: suite.workplaceID, Name: "Starbucks", Aliases: []string{"SBUX"}, IsActive: false},
+	}
+}
+
+func (suite *PayeeServiceTestSuite) TestResolvePayee_LongestWholeWordMatchWins() {
+	ctx := context.Background()
+	cases := map[string]string{
+		"AMAZON PRIME*2X3 membership": "prime",
+		"amzn  mktp us*1a2b":          "amazon",
+		"Refund from amazon.com":      "amazon",
+		"Amazonia river tours":        "",
+		"SBUX 1234 Seattle":           "", // Inactive payees are never resolved
+	}
+	for text, expected := range cases {
+		suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+		suite.mockPayeeRepo.On("ListPayees", ctx, suite.workplaceID).Return(suite.payees(), nil).Once()
+
+		match, err := suite.service.ResolvePayee(ctx, suite.workplaceID, text, suite.userID)
+
+		suite.Require().NoError(err, text)
+		if expected == "" {
+			suite.Nil(match, text)
+			continue
+		}
+		suite.Require().NotNil(match, text)
+		suite.Equal(expected, match.Payee.PayeeID, text)
+	}
+}
+
+func (suite *PayeeServiceTestSuite) TestCreatePayee_CleansAliasesAndChecksCounterAccount() {
+	ctx := context.Background()
+	counterAccountID := uuid.NewString()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockPayeeRepo.On("ListPayees", ctx, suite.workplaceID).Return(suite.payees(), nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, counterAccountID).
+		Return(&domain.Account{AccountID: counterAccountID, WorkplaceID: suite.workplaceID, Name: "Utilities", IsActive: true}, nil).Once()
+	suite.mockPayeeRepo.On("SavePayee", ctx, mock.MatchedBy(func(p domain.Payee) bool {
+		return p.Name == "City Power" && len(p.Aliases) == 1 && p.Aliases[0] == "CITYPWR ELEC" &&
+			p.IsActive && p.DefaultCounterAccountID == counterAccountID
+	})).Return(nil).Once()
+
+	payee, err := suite.service.CreatePayee(ctx, suite.workplaceID, dto.PayeeRequest{
+		Name:                    " City   Power ",
+		Aliases:                 []string{"CITYPWR  ELEC", "citypwr elec", " ", "city power"},
+		DefaultCounterAccountID: counterAccountID,
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal([]string{"CITYPWR ELEC"}, payee.Aliases, "blank, repeated and name-equal aliases are dropped")
+	suite.mockPayeeRepo.AssertExpectations(suite.T())
+}
+
+func (suite *PayeeServiceTestSuite) TestCreatePayee_AliasUsedByAnotherPayeeConflicts() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockPayeeRepo.On("ListPayees", ctx, suite.workplaceID).Return(suite.payees(), nil).Once()
+
+	_, err := suite.service.CreatePayee(ctx, suite.workplaceID, dto.PayeeRequest{Name: "Amazon Marketplace", Aliases: []string{"amzn mktp"}}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+	suite.mockPayeeRepo.AssertNotCalled(suite.T(), "SavePayee", mock.Anything, mock.Anything)
+}
+
+func (suite *PayeeServiceTestSuite) TestDeletePayee_ReferencedPayeeConflicts() {
+	ctx := context.Background()
+	payee := suite.payees()[0]
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockPayeeRepo.On("FindPayeeByID", ctx, "amazon").Return(&payee, nil).Once()
+	suite.mockPayeeRepo.On("DeletePayee", ctx, "amazon").Return(apperrors.ErrConflict).Once()
+
+	err := suite.service.DeletePayee(ctx, suite.workplaceID, "amazon", suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+// expectCreateJournal mocks the authorization and account lookup of a CreateJournal call
+func (suite *PayeeServiceTestSuite) expectCreateJournal(ctx context.Context) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.bankAccount.AccountID:    suite.bankAccount,
+		suite.expenseAccount.AccountID: suite.expenseAccount,
+	}, nil).Once()
+}
+
+// purchaseRequest returns a request paying 25 from the bank account for the given description
+func (suite *PayeeServiceTestSuite) purchaseRequest(description string) dto.CreateJournalRequest {
+	return dto.CreateJournalRequest{
+		Date:         time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC),
+		Description:  description,
+		CurrencyCode: "USD",
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: suite.bankAccount.AccountID, Amount: decimal.NewFromInt(25), TransactionType: domain.Credit},
+			{AccountID: suite.expenseAccount.AccountID, Amount: decimal.NewFromInt(25), TransactionType: domain.Debit},
+		},
+	}
+}
+
+func (suite *PayeeServiceTestSuite) TestCreateJournal_ResolvesPayeeFromDescription() {
+	ctx := context.Background()
+	suite.expectCreateJournal(ctx)
+	suite.mockPayeeRepo.On("ListPayees", ctx, suite.workplaceID).Return(suite.payees(), nil).Once()
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.MatchedBy(func(j domain.Journal) bool { return j.PayeeID == "prime" }),
+		mock.Anything, mock.Anything).Return(nil).Once()
+
+	journal, err := suite.journalService.CreateJournal(ctx, suite.workplaceID, suite.purchaseRequest("Amazon Prime yearly"), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("prime", journal.PayeeID)
+	suite.mockJournalRepo.AssertExpectations(suite.T())
+}
+
+func (suite *PayeeServiceTestSuite) TestCreateJournal_RejectsPayeeOfAnotherWorkplace() {
+	ctx := context.Background()
+	suite.expectCreateJournal(ctx)
+	foreign := domain.Payee{PayeeID: uuid.NewString(), WorkplaceID: uuid.NewString(), Name: "Elsewhere", IsActive: true}
+	suite.mockPayeeRepo.On("FindPayeeByID", ctx, foreign.PayeeID).Return(&foreign, nil).Once()
+	req := suite.purchaseRequest("Groceries")
+	req.Transactions[1].PayeeID = foreign.PayeeID
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
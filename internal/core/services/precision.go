@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+)
+
+// currencyPrecision returns the number of decimal places of a currency, defaulting to 2 when the currency is
+// unknown or no currency repository is configured
+func currencyPrecision(ctx context.Context, currencyRepo portsrepo.CurrencyReader, currencyCode string) int32 {
+	if currencyRepo == nil {
+		return 2
+	}
+	currency, err := currencyRepo.FindCurrencyByCode(ctx, currencyCode)
+	if err != nil {
+		middleware.GetLoggerFromCtx(ctx).Debug("Currency not found, using default precision",
+			slog.String("currency_code", currencyCode))
+		return 2
+	}
+	return int32(currency.Precision)
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/utils/pricecsv"
+)
+
+// priceService implements the PriceSvcFacade interface. Like exchange rates, commodity prices are shared by
+// all workplaces.
+type priceService struct {
+	BaseService
+	priceRepo    portsrepo.PriceRepositoryFacade
+	currencyRepo portsrepo.CurrencyReader
+	provider     portsrepo.PriceProvider
+}
+
+// PriceServiceOption is a functional option for configuring the price service
+type PriceServiceOption func(*priceService)
+
+// WithPriceProvider sets the external source SyncPrices loads prices from; nil leaves syncing disabled
+func WithPriceProvider(provider portsrepo.PriceProvider) PriceServiceOption {
+	return func(s *priceService) {
+		s.provider = provider
+	}
+}
+
+// NewPriceService creates a new service for commodity prices
+func NewPriceService(priceRepo portsrepo.PriceRepositoryFacade, currencyRepo portsrepo.CurrencyReader, options ...PriceServiceOption) portssvc.PriceSvcFacade {
+	svc := &priceService{
+		priceRepo:    priceRepo,
+		currencyRepo: currencyRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure priceService implements the PriceSvcFacade interface
+var _ portssvc.PriceSvcFacade = (*priceService)(nil)
+
+// checkQuoteCurrency verifies that a quote currency exists, remembering the answer in known
+func (s *priceService) checkQuoteCurrency(ctx context.Context, currencyCode string, known map[string]error) error {
+	if err, ok := known[currencyCode]; ok {
+		return err
+	}
+	_, err := s.currencyRepo.FindCurrencyByCode(ctx, currencyCode)
+	if err != nil {
+		if !errors.Is(err, apperrors.ErrNotFound) {
+			s.LogError(ctx, err, "Failed to find quote currency",
+				slog.String("currency_code", currencyCode))
+			return err
+		}
+		err = fmt.Errorf("%w: quote currency %s not found", apperrors.ErrValidation, currencyCode)
+	}
+	known[currencyCode] = err
+	return err
+}
+
+// storePrices validates and saves prices from a file or a provider. Invalid prices are reported by their
+// position in rowNumbers rather than failing the whole load; the latest price for a day wins.
+func (s *priceService) storePrices(ctx context.Context, source string, prices []domain.CommodityPrice, rowNumbers []int, rowErrors []domain.PriceRowError, userID string) (*domain.PriceImportResult, error) {
+	now := time.Now()
+	known := map[string]error{}
+	byKey := map[string]int{}
+	valid := []domain.CommodityPrice{}
+	for i, price := range prices {
+		price.Commodity = domain.NormalizeCommodity(price.Commodity)
+		price.PriceDate = domain.PriceDay(price.PriceDate)
+		price.Source = source
+		price.CreatedAt = now
+		price.CreatedBy = userID
+
+		var rowErr error
+		switch {
+		case price.Commodity == "":
+			rowErr = errors.New("commodity is required")
+		case !price.Price.IsPositive():
+			rowErr = errors.New("price must be positive")
+		default:
+			if err := s.checkQuoteCurrency(ctx, price.QuoteCurrency, known); err != nil {
+				if !errors.Is(err, apperrors.ErrValidation) {
+					return nil, err
+				}
+				rowErr = fmt.Errorf("quote currency %s not found", price.QuoteCurrency)
+			}
+		}
+		if rowErr != nil {
+			rowErrors = append(rowErrors, domain.PriceRowError{RowNumber: rowNumbers[i], Error: rowErr.Error()})
+			continue
+		}
+
+		key := price.Commodity + "|" + price.QuoteCurrency + "|" + price.PriceDate.Format(pricecsv.DateLayout)
+		if at, ok := byKey[key]; ok {
+			valid[at] = price
+			continue
+		}
+		byKey[key] = len(valid)
+		valid = append(valid, price)
+	}
+
+	if err := s.priceRepo.SavePrices(ctx, valid); err != nil {
+		s.LogError(ctx, err, "Failed to save commodity prices",
+			slog.String("source", source),
+			slog.Int("count", len(valid)))
+		return nil, err
+	}
+	sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].RowNumber < rowErrors[j].RowNumber })
+	return &domain.PriceImportResult{Source: source, Imported: len(valid), Errors: rowErrors}, nil
+}
+
+func (s *priceService) RecordPrice(ctx context.Context, req dto.RecordPriceRequest, userID string) (*domain.CommodityPrice, error) {
+	commodity := domain.NormalizeCommodity(req.Commodity)
+	if commodity == "" {
+		return nil, fmt.Errorf("%w: commodity is required", apperrors.ErrValidation)
+	}
+	if !req.Price.IsPositive() {
+		return nil, fmt.Errorf("%w: price must be positive", apperrors.ErrValidation)
+	}
+	if err := s.checkQuoteCurrency(ctx, req.QuoteCurrency, map[string]error{}); err != nil {
+		return nil, err
+	}
+
+	price := &domain.CommodityPrice{
+		Commodity:     commodity,
+		QuoteCurrency: req.QuoteCurrency,
+		PriceDate:     domain.PriceDay(req.Date),
+		Price:         req.Price,
+		Source:        domain.PriceSourceManual,
+		CreatedAt:     time.Now(),
+		CreatedBy:     userID,
+	}
+	if err := s.priceRepo.SavePrices(ctx, []domain.CommodityPrice{*price}); err != nil {
+		s.LogError(ctx, err, "Failed to save commodity price",
+			slog.String("commodity", commodity),
+			slog.String("quote_currency", req.QuoteCurrency))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Commodity price recorded",
+		slog.String("commodity", commodity),
+		slog.String("quote_currency", req.QuoteCurrency),
+		slog.Time("price_date", price.PriceDate))
+	return price, nil
+}
+
+func (s *priceService) ImportPrices(ctx context.Context, data []byte, userID string) (*domain.PriceImportResult, error) {
+	rows, err := pricecsv.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apperrors.ErrValidation, err)
+	}
+
+	prices := []domain.CommodityPrice{}
+	rowNumbers := []int{}
+	rowErrors := []domain.PriceRowError{}
+	for _, row := range rows {
+		if row.Err != nil {
+			rowErrors = append(rowErrors, domain.PriceRowError{RowNumber: row.RowNumber, Error: row.Err.Error()})
+			continue
+		}
+		prices = append(prices, domain.CommodityPrice{
+			Commodity:     row.Commodity,
+			QuoteCurrency: row.QuoteCurrency,
+			PriceDate:     row.Date,
+			Price:         row.Price,
+		})
+		rowNumbers = append(rowNumbers, row.RowNumber)
+	}
+
+	result, err := s.storePrices(ctx, domain.PriceSourceImport, prices, rowNumbers, rowErrors, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.LogInfo(ctx, "Commodity prices imported",
+		slog.Int("imported", result.Imported),
+		slog.Int("skipped", len(result.Errors)))
+	return result, nil
+}
+
+func (s *priceService) SyncPrices(ctx context.Context, req dto.SyncPricesRequest, userID string) (*domain.PriceImportResult, error) {
+	if s.provider == nil {
+		return nil, fmt.Errorf("%w: no price provider is configured", apperrors.ErrValidation)
+	}
+
+	var from, to time.Time
+	if req.From != nil {
+		from = domain.PriceDay(*req.From)
+	}
+	if req.To != nil {
+		to = domain.PriceDay(*req.To)
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, fmt.Errorf("%w: to date cannot be before from date", apperrors.ErrValidation)
+	}
+
+	prices, err := s.provider.FetchPrices(ctx, domain.NormalizeCommodity(req.Commodity), req.QuoteCurrency, from, to)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to fetch prices from provider",
+			slog.String("provider", s.provider.Name()))
+		return nil, apperrors.NewAppError(500, "failed to fetch prices from "+s.provider.Name(), err)
+	}
+
+	// Provider prices have no file rows; number them by their position in the provider's response
+	rowNumbers := make([]int, len(prices))
+	for i := range prices {
+		rowNumbers[i] = i + 1
+	}
+	result, err := s.storePrices(ctx, s.provider.Name(), prices, rowNumbers, []domain.PriceRowError{}, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.LogInfo(ctx, "Commodity prices synced",
+		slog.String("provider", s.provider.Name()),
+		slog.Int("imported", result.Imported),
+		slog.Int("skipped", len(result.Errors)))
+	return result, nil
+}
+
+func (s *priceService) ListPrices(ctx context.Context, params dto.ListPricesParams) ([]domain.CommodityPrice, error) {
+	key := domain.PriceKey{Commodity: domain.NormalizeCommodity(params.Commodity), QuoteCurrency: params.QuoteCurrency}
+	var from, to time.Time
+	if params.From != nil {
+		from = domain.PriceDay(*params.From)
+	}
+	if params.To != nil {
+		to = domain.PriceDay(*params.To)
+	}
+
+	prices, err := s.priceRepo.ListPrices(ctx, key, from, to)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list commodity prices",
+			slog.String("commodity", key.Commodity),
+			slog.String("quote_currency", key.QuoteCurrency))
+		return nil, err
+	}
+	return prices, nil
+}
+
+func (s *priceService) GetPriceAsOf(ctx context.Context, params dto.PriceAsOfParams) (*domain.CommodityPrice, error) {
+	key := domain.PriceKey{Commodity: domain.NormalizeCommodity(params.Commodity), QuoteCurrency: params.QuoteCurrency}
+	asOf := domain.PriceDay(time.Now())
+	if params.AsOf != nil {
+		asOf = domain.PriceDay(*params.AsOf)
+	}
+
+	prices, err := s.priceRepo.FindPricesAsOf(ctx, []domain.PriceKey{key}, asOf)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find commodity price",
+			slog.String("commodity", key.Commodity),
+			slog.String("quote_currency", key.QuoteCurrency))
+		return nil, err
+	}
+	if len(prices) == 0 {
+		return nil, apperrors.ErrNotFound
+	}
+	return &prices[0], nil
+}
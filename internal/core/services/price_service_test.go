@@ -0,0 +1,185 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock PriceRepository ---
+type MockPriceRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.PriceRepositoryFacade = (*MockPriceRepository)(nil)
+
+func (m *MockPriceRepository) ListPrices(ctx context.Context, key domain.PriceKey, from time.Time, to time.Time) ([]domain.CommodityPrice, error) {
+	args := m.Called(ctx, key, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CommodityPrice), args.Error(1)
+}
+
+func (m *MockPriceRepository) FindPricesAsOf(ctx context.Context, keys []domain.PriceKey, asOf time.Time) ([]domain.CommodityPrice, error) {
+	args := m.Called(ctx, keys, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CommodityPrice), args.Error(1)
+}
+
+func (m *MockPriceRepository) SavePrices(ctx context.Context, prices []domain.CommodityPrice) error {
+	args := m.Called(ctx, prices)
+	return args.Error(0)
+}
+
+// --- Mock PriceProvider ---
+type MockPriceProvider struct {
+	mock.Mock
+}
+
+var _ portsrepo.PriceProvider = (*MockPriceProvider)(nil)
+
+func (m *MockPriceProvider) Name() string {
+	return "TEST_FEED"
+}
+
+func (m *MockPriceProvider) FetchPrices(ctx context.Context, commodity string, quoteCurrency string, from time.Time, to time.Time) ([]domain.CommodityPrice, error) {
+	args := m.Called(ctx, commodity, quoteCurrency, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.CommodityPrice), args.Error(1)
+}
+
+// --- Test Suite Setup ---
+type PriceServiceTestSuite struct {
+	suite.Suite
+	mockPriceRepo    *MockPriceRepository
+	mockCurrencyRepo *MockCurrencyRepository
+	mockProvider     *MockPriceProvider
+	userID           string
+}
+
+func (suite *PriceServiceTestSuite) SetupTest() {
+	suite.mockPriceRepo = new(MockPriceRepository)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockProvider = new(MockPriceProvider)
+	suite.userID = "user-1"
+
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "XYZ").Return(nil, apperrors.ErrNotFound)
+}
+
+func TestPriceService(t *testing.T) {
+	suite.Run(t, new(PriceServiceTestSuite))
+}
+
+func (suite *PriceServiceTestSuite) TestRecordPrice_NormalizesCommodityAndDate() {
+	ctx := context.Background()
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo)
+	suite.mockPriceRepo.On("SavePrices", ctx, mock.MatchedBy(func(prices []domain.CommodityPrice) bool {
+		return len(prices) == 1 && prices[0].Commodity == "BTC" &&
+			prices[0].PriceDate.Equal(time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)) && prices[0].Source == domain.PriceSourceManual
+	})).Return(nil).Once()
+
+	price, err := svc.RecordPrice(ctx, dto.RecordPriceRequest{
+		Commodity: " btc ", QuoteCurrency: "USD", Date: time.Date(2025, 3, 3, 18, 0, 0, 0, time.UTC), Price: decimal.NewFromInt(60000),
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("BTC", price.Commodity)
+	suite.mockPriceRepo.AssertExpectations(suite.T())
+}
+
+func (suite *PriceServiceTestSuite) TestRecordPrice_RejectsUnknownQuoteCurrency() {
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo)
+
+	_, err := svc.RecordPrice(context.Background(), dto.RecordPriceRequest{
+		Commodity: "BTC", QuoteCurrency: "XYZ", Date: time.Now(), Price: decimal.NewFromInt(1),
+	}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+	suite.mockPriceRepo.AssertNotCalled(suite.T(), "SavePrices", mock.Anything, mock.Anything)
+}
+
+func (suite *PriceServiceTestSuite) TestImportPrices_StoresValidRowsAndReportsTheRest() {
+	ctx := context.Background()
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo)
+	data := "symbol,currency,date,price\n" +
+		"ACME,USD,2025-03-03,10\n" +
+		"ACME,USD,2025-03-03,11\n" +
+		"ACME,XYZ,2025-03-03,10\n" +
+		"ACME,USD,not-a-date,10\n" +
+		"BTC,USD,2025-03-03,60000\n"
+	suite.mockPriceRepo.On("SavePrices", ctx, mock.MatchedBy(func(prices []domain.CommodityPrice) bool {
+		// The later ACME row for the same day replaces the earlier one
+		return len(prices) == 2 && prices[0].Commodity == "ACME" && prices[0].Price.Equal(decimal.NewFromInt(11)) &&
+			prices[1].Commodity == "BTC" && prices[0].Source == domain.PriceSourceImport
+	})).Return(nil).Once()
+
+	result, err := svc.ImportPrices(ctx, []byte(data), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(2, result.Imported)
+	suite.Require().Len(result.Errors, 2)
+	suite.Equal(4, result.Errors[0].RowNumber)
+	suite.Contains(result.Errors[0].Error, "XYZ")
+	suite.Equal(5, result.Errors[1].RowNumber)
+}
+
+func (suite *PriceServiceTestSuite) TestImportPrices_RejectsFileWithoutHeader() {
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo)
+
+	_, err := svc.ImportPrices(context.Background(), []byte("ACME,USD,2025-03-03,10\n"), suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+}
+
+func (suite *PriceServiceTestSuite) TestSyncPrices_RequiresProvider() {
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo)
+
+	_, err := svc.SyncPrices(context.Background(), dto.SyncPricesRequest{}, suite.userID)
+
+	suite.True(errors.Is(err, apperrors.ErrValidation))
+}
+
+func (suite *PriceServiceTestSuite) TestSyncPrices_RecordsProviderAsSource() {
+	ctx := context.Background()
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo, services.WithPriceProvider(suite.mockProvider))
+	suite.mockProvider.On("FetchPrices", ctx, "ACME", "", time.Time{}, time.Time{}).Return([]domain.CommodityPrice{
+		{Commodity: "ACME", QuoteCurrency: "USD", PriceDate: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), Price: decimal.NewFromInt(10)},
+	}, nil).Once()
+	suite.mockPriceRepo.On("SavePrices", ctx, mock.MatchedBy(func(prices []domain.CommodityPrice) bool {
+		return len(prices) == 1 && prices[0].Source == "TEST_FEED" && prices[0].CreatedBy == suite.userID
+	})).Return(nil).Once()
+
+	result, err := svc.SyncPrices(ctx, dto.SyncPricesRequest{Commodity: "acme"}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("TEST_FEED", result.Source)
+	suite.Equal(1, result.Imported)
+}
+
+func (suite *PriceServiceTestSuite) TestGetPriceAsOf_NotFoundWithoutEarlierPrice() {
+	ctx := context.Background()
+	svc := services.NewPriceService(suite.mockPriceRepo, suite.mockCurrencyRepo)
+	asOf := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	suite.mockPriceRepo.On("FindPricesAsOf", ctx, []domain.PriceKey{{Commodity: "ACME", QuoteCurrency: "USD"}}, asOf).
+		Return([]domain.CommodityPrice{}, nil).Once()
+
+	_, err := svc.GetPriceAsOf(ctx, dto.PriceAsOfParams{Commodity: "acme", QuoteCurrency: "USD", AsOf: &asOf})
+
+	suite.True(errors.Is(err, apperrors.ErrNotFound))
+}
@@ -0,0 +1,397 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+)
+
+// reconciliationService implements the ReconciliationSvcFacade interface
+type reconciliationService struct {
+	BaseService
+	reconRepo   portsrepo.ReconciliationRepositoryFacade
+	accountRepo portsrepo.AccountReader
+}
+
+// ReconciliationServiceOption is a functional option for configuring the reconciliation service
+type ReconciliationServiceOption func(*reconciliationService)
+
+// WithReconciliationWorkplaceAuthorizer adds workplace authorizer dependency
+func WithReconciliationWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) ReconciliationServiceOption {
+	return func(s *reconciliationService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewReconciliationService creates a new reconciliation service. Sessions only tick existing transaction
+// lines; completing one locks its lines against reversal unless an ADMIN overrides.
+func NewReconciliationService(reconRepo portsrepo.ReconciliationRepositoryFacade, accountRepo portsrepo.AccountReader, options ...ReconciliationServiceOption) portssvc.ReconciliationSvcFacade {
+	svc := &reconciliationService{
+		reconRepo:   reconRepo,
+		accountRepo: accountRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure reconciliationService implements the ReconciliationSvcFacade interface
+var _ portssvc.ReconciliationSvcFacade = (*reconciliationService)(nil)
+
+// findReconciliation loads a session and verifies that it belongs to the workplace
+func (s *reconciliationService) findReconciliation(ctx context.Context, workplaceID string, reconciliationID string) (*domain.Reconciliation, error) {
+	reconciliation, err := s.reconRepo.FindReconciliationByID(ctx, reconciliationID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find reconciliation by ID",
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, fmt.Errorf("failed to find reconciliation: %w", err)
+	}
+	if reconciliation.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Reconciliation found but belongs to different workplace",
+			slog.String("reconciliation_id", reconciliationID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return reconciliation, nil
+}
+
+// findOpenReconciliation loads a session that can still be changed
+func (s *reconciliationService) findOpenReconciliation(ctx context.Context, workplaceID string, reconciliationID string) (*domain.Reconciliation, error) {
+	reconciliation, err := s.findReconciliation(ctx, workplaceID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	if reconciliation.Status != domain.ReconciliationInProgress {
+		return nil, fmt.Errorf("%w: reconciliation %s is already completed", apperrors.ErrConflict, reconciliationID)
+	}
+	return reconciliation, nil
+}
+
+// summarize computes the live difference of a session in the account's balance convention
+func (s *reconciliationService) summarize(ctx context.Context, reconciliation *domain.Reconciliation) (*domain.ReconciliationSummary, error) {
+	account, err := s.accountRepo.FindAccountByID(ctx, reconciliation.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reconciliation account: %w", err)
+	}
+	totals, err := s.reconRepo.GetReconciliationTotals(ctx, reconciliation.AccountID, reconciliation.ReconciliationID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to get reconciliation totals",
+			slog.String("reconciliation_id", reconciliation.ReconciliationID))
+		return nil, err
+	}
+
+	opening, cleared := totals.ReconciledTotal, totals.ClearedTotal
+	if account.AccountType == domain.Liability {
+		opening, cleared = opening.Neg(), cleared.Neg()
+	}
+	clearedBalance := opening.Add(cleared)
+	return &domain.ReconciliationSummary{
+		Reconciliation: *reconciliation,
+		OpeningBalance: opening,
+		ClearedAmount:  cleared,
+		ClearedCount:   totals.ClearedCount,
+		ClearedBalance: clearedBalance,
+		Difference:     reconciliation.EndingBalance.Sub(clearedBalance),
+	}, nil
+}
+
+// loadRequestedTransactions de-duplicates the requested IDs and loads the matching lines of the session's account
+func (s *reconciliationService) loadRequestedTransactions(ctx context.Context, reconciliation *domain.Reconciliation, transactionIDs []string) ([]domain.Transaction, []string, error) {
+	seen := make(map[string]bool, len(transactionIDs))
+	ids := make([]string, 0, len(transactionIDs))
+	for _, id := range transactionIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	transactions, err := s.reconRepo.FindAccountTransactionsByIDs(ctx, reconciliation.WorkplaceID, reconciliation.AccountID, ids)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load transactions for reconciliation",
+			slog.String("reconciliation_id", reconciliation.ReconciliationID))
+		return nil, nil, err
+	}
+	if len(transactions) != len(ids) {
+		found := make(map[string]bool, len(transactions))
+		for _, txn := range transactions {
+			found[txn.TransactionID] = true
+		}
+		for _, id := range ids {
+			if !found[id] {
+				return nil, nil, fmt.Errorf("%w: transaction %s is not a posted line of account %s", apperrors.ErrValidation, id, reconciliation.AccountID)
+			}
+		}
+	}
+	return transactions, ids, nil
+}
+
+func (s *reconciliationService) CreateReconciliation(ctx context.Context, workplaceID string, req dto.CreateReconciliationRequest, userID string) (*domain.ReconciliationSummary, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create reconciliation",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if _, err := loadStatementAccount(ctx, s.accountRepo, workplaceID, req.AccountID); err != nil {
+		return nil, err
+	}
+
+	statementDate := dateOnly(req.StatementDate)
+	existing, err := s.reconRepo.ListReconciliations(ctx, workplaceID, req.AccountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list reconciliations of account",
+			slog.String("account_id", req.AccountID))
+		return nil, err
+	}
+	for _, previous := range existing {
+		if previous.Status == domain.ReconciliationCompleted && statementDate.Before(previous.StatementDate) {
+			return nil, fmt.Errorf("%w: statement date %s is before the last completed reconciliation on %s",
+				apperrors.ErrValidation, statementDate.Format("2006-01-02"), previous.StatementDate.Format("2006-01-02"))
+		}
+	}
+
+	now := time.Now()
+	reconciliation := domain.Reconciliation{
+		ReconciliationID: uuid.NewString(),
+		WorkplaceID:      workplaceID,
+		AccountID:        req.AccountID,
+		StatementDate:    statementDate,
+		EndingBalance:    req.EndingBalance,
+		Status:           domain.ReconciliationInProgress,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.reconRepo.SaveReconciliation(ctx, reconciliation); err != nil {
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: account %s already has a reconciliation in progress", apperrors.ErrConflict, req.AccountID)
+		}
+		s.LogError(ctx, err, "Failed to save reconciliation",
+			slog.String("reconciliation_id", reconciliation.ReconciliationID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Reconciliation created successfully",
+		slog.String("reconciliation_id", reconciliation.ReconciliationID),
+		slog.String("account_id", req.AccountID))
+	return s.summarize(ctx, &reconciliation)
+}
+
+func (s *reconciliationService) ListReconciliations(ctx context.Context, workplaceID string, params dto.ListReconciliationsParams, userID string) ([]domain.Reconciliation, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list reconciliations",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	reconciliations, err := s.reconRepo.ListReconciliations(ctx, workplaceID, params.AccountID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list reconciliations",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return reconciliations, nil
+}
+
+func (s *reconciliationService) GetReconciliation(ctx context.Context, workplaceID string, reconciliationID string, userID string) (*domain.ReconciliationSummary, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view reconciliation",
+			slog.String("workplace_id", workplaceID),
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	reconciliation, err := s.findReconciliation(ctx, workplaceID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	return s.summarize(ctx, reconciliation)
+}
+
+func (s *reconciliationService) ListReconciliationTransactions(ctx context.Context, workplaceID string, reconciliationID string, userID string) ([]domain.Transaction, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view reconciliation transactions",
+			slog.String("workplace_id", workplaceID),
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	reconciliation, err := s.findReconciliation(ctx, workplaceID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := s.reconRepo.ListReconciliationCandidates(ctx, workplaceID, reconciliation.AccountID, reconciliationID, reconciliation.StatementDate)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list reconciliation candidates",
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+	if reconciliation.Status == domain.ReconciliationCompleted {
+		// Show what the completed session locked rather than what is still open
+		locked := make([]domain.Transaction, 0)
+		for _, txn := range transactions {
+			if txn.ReconciliationID == reconciliationID {
+				locked = append(locked, txn)
+			}
+		}
+		return locked, nil
+	}
+	return transactions, nil
+}
+
+func (s *reconciliationService) ClearTransactions(ctx context.Context, workplaceID string, reconciliationID string, req dto.ReconciliationClearingRequest, userID string) (*domain.ReconciliationSummary, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to clear transactions",
+			slog.String("workplace_id", workplaceID),
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	reconciliation, err := s.findOpenReconciliation(ctx, workplaceID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, ids, err := s.loadRequestedTransactions(ctx, reconciliation, req.TransactionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lastDay := reconciliation.StatementDate.AddDate(0, 0, 1)
+	for _, txn := range transactions {
+		switch {
+		case txn.ClearingStatus == domain.Reconciled:
+			return nil, fmt.Errorf("%w: transaction %s is already reconciled", apperrors.ErrConflict, txn.TransactionID)
+		case txn.ClearingStatus == domain.Cleared && txn.ReconciliationID != reconciliationID:
+			return nil, fmt.Errorf("%w: transaction %s is cleared in another reconciliation", apperrors.ErrConflict, txn.TransactionID)
+		case !txn.TransactionDate.Before(lastDay):
+			return nil, fmt.Errorf("%w: transaction %s is dated after the statement date %s",
+				apperrors.ErrValidation, txn.TransactionID, reconciliation.StatementDate.Format("2006-01-02"))
+		}
+	}
+
+	if err := s.reconRepo.SetTransactionsClearing(ctx, ids, domain.Cleared, reconciliationID, userID, time.Now()); err != nil {
+		s.LogError(ctx, err, "Failed to clear transactions",
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Transactions cleared",
+		slog.String("reconciliation_id", reconciliationID),
+		slog.Int("count", len(ids)))
+	return s.summarize(ctx, reconciliation)
+}
+
+func (s *reconciliationService) UnclearTransactions(ctx context.Context, workplaceID string, reconciliationID string, req dto.ReconciliationClearingRequest, userID string) (*domain.ReconciliationSummary, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to unclear transactions",
+			slog.String("workplace_id", workplaceID),
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	reconciliation, err := s.findOpenReconciliation(ctx, workplaceID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	transactions, ids, err := s.loadRequestedTransactions(ctx, reconciliation, req.TransactionIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, txn := range transactions {
+		if txn.ClearingStatus != domain.Cleared || txn.ReconciliationID != reconciliationID {
+			return nil, fmt.Errorf("%w: transaction %s is not cleared in this reconciliation", apperrors.ErrValidation, txn.TransactionID)
+		}
+	}
+
+	if err := s.reconRepo.SetTransactionsClearing(ctx, ids, domain.Uncleared, "", userID, time.Now()); err != nil {
+		s.LogError(ctx, err, "Failed to unclear transactions",
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Transactions uncleared",
+		slog.String("reconciliation_id", reconciliationID),
+		slog.Int("count", len(ids)))
+	return s.summarize(ctx, reconciliation)
+}
+
+func (s *reconciliationService) CompleteReconciliation(ctx context.Context, workplaceID string, reconciliationID string, userID string) (*domain.ReconciliationSummary, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to complete reconciliation",
+			slog.String("workplace_id", workplaceID),
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	reconciliation, err := s.findOpenReconciliation(ctx, workplaceID, reconciliationID)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.summarize(ctx, reconciliation)
+	if err != nil {
+		return nil, err
+	}
+	if !summary.Difference.IsZero() {
+		return nil, fmt.Errorf("%w: reconciliation difference is %s; it must be zero to complete",
+			apperrors.ErrValidation, summary.Difference.String())
+	}
+
+	now := time.Now()
+	reconciliation.Status = domain.ReconciliationCompleted
+	reconciliation.CompletedAt = &now
+	reconciliation.CompletedBy = userID
+	reconciliation.LastUpdatedAt = now
+	reconciliation.LastUpdatedBy = userID
+	if err := s.reconRepo.CompleteReconciliation(ctx, *reconciliation); err != nil {
+		s.LogError(ctx, err, "Failed to complete reconciliation",
+			slog.String("reconciliation_id", reconciliationID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Reconciliation completed",
+		slog.String("reconciliation_id", reconciliationID),
+		slog.Int("locked_transactions", summary.ClearedCount))
+	summary.Reconciliation = *reconciliation
+	return summary, nil
+}
+
+func (s *reconciliationService) DeleteReconciliation(ctx context.Context, workplaceID string, reconciliationID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete reconciliation",
+			slog.String("workplace_id", workplaceID),
+			slog.String("reconciliation_id", reconciliationID))
+		return err
+	}
+
+	if _, err := s.findOpenReconciliation(ctx, workplaceID, reconciliationID); err != nil {
+		return err
+	}
+	if err := s.reconRepo.DeleteReconciliation(ctx, reconciliationID, userID, time.Now()); err != nil {
+		s.LogError(ctx, err, "Failed to delete reconciliation",
+			slog.String("reconciliation_id", reconciliationID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Reconciliation deleted",
+		slog.String("reconciliation_id", reconciliationID))
+	return nil
+}
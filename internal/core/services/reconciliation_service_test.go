@@ -0,0 +1,224 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock ReconciliationRepository ---
+type MockReconciliationRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.ReconciliationRepositoryFacade = (*MockReconciliationRepository)(nil)
+
+func (m *MockReconciliationRepository) FindReconciliationByID(ctx context.Context, reconciliationID string) (*domain.Reconciliation, error) {
+	args := m.Called(ctx, reconciliationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Reconciliation), args.Error(1)
+}
+
+func (m *MockReconciliationRepository) ListReconciliations(ctx context.Context, workplaceID string, accountID string) ([]domain.Reconciliation, error) {
+	args := m.Called(ctx, workplaceID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Reconciliation), args.Error(1)
+}
+
+func (m *MockReconciliationRepository) GetReconciliationTotals(ctx context.Context, accountID string, reconciliationID string) (*domain.ReconciliationTotals, error) {
+	args := m.Called(ctx, accountID, reconciliationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ReconciliationTotals), args.Error(1)
+}
+
+func (m *MockReconciliationRepository) ListReconciliationCandidates(ctx context.Context, workplaceID string, accountID string, reconciliationID string, statementDate time.Time) ([]domain.Transaction, error) {
+	args := m.Called(ctx, workplaceID, accountID, reconciliationID, statementDate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Transaction), args.Error(1)
+}
+
+func (m *MockReconciliationRepository) FindAccountTransactionsByIDs(ctx context.Context, workplaceID string, accountID string, transactionIDs []string) ([]domain.Transaction, error) {
+	args := m.Called(ctx, workplaceID, accountID, transactionIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Transaction), args.Error(1)
+}
+
+func (m *MockReconciliationRepository) SaveReconciliation(ctx context.Context, reconciliation domain.Reconciliation) error {
+	args := m.Called(ctx, reconciliation)
+	return args.Error(0)
+}
+
+func (m *MockReconciliationRepository) SetTransactionsClearing(ctx context.Context, transactionIDs []string, status domain.ClearingStatus, reconciliationID string, userID string, now time.Time) error {
+	args := m.Called(ctx, transactionIDs, status, reconciliationID, userID, now)
+	return args.Error(0)
+}
+
+func (m *MockReconciliationRepository) CompleteReconciliation(ctx context.Context, reconciliation domain.Reconciliation) error {
+	args := m.Called(ctx, reconciliation)
+	return args.Error(0)
+}
+
+func (m *MockReconciliationRepository) DeleteReconciliation(ctx context.Context, reconciliationID string, userID string, now time.Time) error {
+	args := m.Called(ctx, reconciliationID, userID, now)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type ReconciliationServiceTestSuite struct {
+	suite.Suite
+	mockReconRepo    *MockReconciliationRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.ReconciliationSvcFacade
+	workplaceID      string
+	userID           string
+	accountID        string
+	statementDate    time.Time
+}
+
+func (suite *ReconciliationServiceTestSuite) SetupTest() {
+	suite.mockReconRepo = new(MockReconciliationRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewReconciliationService(suite.mockReconRepo, suite.mockAccountRepo,
+		services.WithReconciliationWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.accountID = uuid.NewString()
+	suite.statementDate = time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+}
+
+func TestReconciliationService(t *testing.T) {
+	suite.Run(t, new(ReconciliationServiceTestSuite))
+}
+
+// account returns the reconciled account with the given type
+func (suite *ReconciliationServiceTestSuite) account(accountType domain.AccountType) *domain.Account {
+	return &domain.Account{AccountID: suite.accountID, WorkplaceID: suite.workplaceID, Name: "Checking",
+		AccountType: accountType, IsActive: true}
+}
+
+// session returns an open session ending at the statement date with the given ending balance
+func (suite *ReconciliationServiceTestSuite) session(endingBalance string) *domain.Reconciliation {
+	return &domain.Reconciliation{ReconciliationID: "rec-1", WorkplaceID: suite.workplaceID, AccountID: suite.accountID,
+		StatementDate: suite.statementDate, EndingBalance: decimal.RequireFromString(endingBalance),
+		Status: domain.ReconciliationInProgress}
+}
+
+func (suite *ReconciliationServiceTestSuite) TestGetReconciliation_LiabilityDifference() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReconRepo.On("FindReconciliationByID", ctx, "rec-1").Return(suite.session("500"), nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.accountID).Return(suite.account(domain.Liability), nil).Once()
+	// Credits increase a card balance, so the raw debit-minus-credit totals are negated
+	suite.mockReconRepo.On("GetReconciliationTotals", ctx, suite.accountID, "rec-1").
+		Return(&domain.ReconciliationTotals{ReconciledTotal: decimal.RequireFromString("-300"), ClearedTotal: decimal.RequireFromString("-150"), ClearedCount: 2}, nil).Once()
+
+	summary, err := suite.service.GetReconciliation(ctx, suite.workplaceID, "rec-1", suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("300", summary.OpeningBalance.String())
+	suite.Equal("150", summary.ClearedAmount.String())
+	suite.Equal("450", summary.ClearedBalance.String())
+	suite.Equal("50", summary.Difference.String())
+	suite.Equal(2, summary.ClearedCount)
+	suite.mockReconRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReconciliationServiceTestSuite) TestClearTransactions_RejectsLineAfterStatementDate() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockReconRepo.On("FindReconciliationByID", ctx, "rec-1").Return(suite.session("100"), nil).Once()
+	suite.mockReconRepo.On("FindAccountTransactionsByIDs", ctx, suite.workplaceID, suite.accountID, []string{"t1", "t2"}).
+		Return([]domain.Transaction{
+			{TransactionID: "t1", TransactionDate: suite.statementDate.Add(18 * time.Hour), ClearingStatus: domain.Uncleared},
+			{TransactionID: "t2", TransactionDate: suite.statementDate.AddDate(0, 0, 1), ClearingStatus: domain.Uncleared},
+		}, nil).Once()
+
+	_, err := suite.service.ClearTransactions(ctx, suite.workplaceID, "rec-1",
+		dto.ReconciliationClearingRequest{TransactionIDs: []string{"t1", "t2", "t1"}}, suite.userID)
+
+	suite.Require().Error(err)
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.Contains(err.Error(), "t2")
+	suite.mockReconRepo.AssertNotCalled(suite.T(), "SetTransactionsClearing", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReconciliationServiceTestSuite) TestClearTransactions_RejectsReconciledLine() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockReconRepo.On("FindReconciliationByID", ctx, "rec-1").Return(suite.session("100"), nil).Once()
+	suite.mockReconRepo.On("FindAccountTransactionsByIDs", ctx, suite.workplaceID, suite.accountID, []string{"t1"}).
+		Return([]domain.Transaction{
+			{TransactionID: "t1", TransactionDate: suite.statementDate, ClearingStatus: domain.Reconciled, ReconciliationID: "rec-0"},
+		}, nil).Once()
+
+	_, err := suite.service.ClearTransactions(ctx, suite.workplaceID, "rec-1",
+		dto.ReconciliationClearingRequest{TransactionIDs: []string{"t1"}}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+func (suite *ReconciliationServiceTestSuite) TestCompleteReconciliation_RequiresZeroDifference() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Twice()
+	suite.mockReconRepo.On("FindReconciliationByID", ctx, "rec-1").Return(suite.session("1000"), nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.accountID).Return(suite.account(domain.Asset), nil)
+	suite.mockReconRepo.On("GetReconciliationTotals", ctx, suite.accountID, "rec-1").
+		Return(&domain.ReconciliationTotals{ReconciledTotal: decimal.RequireFromString("800"), ClearedTotal: decimal.RequireFromString("150"), ClearedCount: 3}, nil)
+
+	_, err := suite.service.CompleteReconciliation(ctx, suite.workplaceID, "rec-1", suite.userID)
+
+	suite.Require().Error(err)
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.Contains(err.Error(), "50")
+	suite.mockReconRepo.AssertNotCalled(suite.T(), "CompleteReconciliation", mock.Anything, mock.Anything)
+
+	// Once the statement balance matches, the session locks its cleared lines
+	suite.mockReconRepo.On("FindReconciliationByID", ctx, "rec-1").Return(suite.session("950"), nil).Once()
+	suite.mockReconRepo.On("CompleteReconciliation", ctx, mock.MatchedBy(func(r domain.Reconciliation) bool {
+		return r.Status == domain.ReconciliationCompleted && r.CompletedBy == suite.userID && r.CompletedAt != nil
+	})).Return(nil).Once()
+
+	summary, err := suite.service.CompleteReconciliation(ctx, suite.workplaceID, "rec-1", suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal(domain.ReconciliationCompleted, summary.Status)
+	suite.True(summary.Difference.IsZero())
+	suite.mockReconRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReconciliationServiceTestSuite) TestCreateReconciliation_OpenSessionConflict() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.accountID).Return(suite.account(domain.Asset), nil).Once()
+	suite.mockReconRepo.On("ListReconciliations", ctx, suite.workplaceID, suite.accountID).Return([]domain.Reconciliation{}, nil).Once()
+	suite.mockReconRepo.On("SaveReconciliation", ctx, mock.AnythingOfType("domain.Reconciliation")).Return(apperrors.ErrDuplicate).Once()
+
+	_, err := suite.service.CreateReconciliation(ctx, suite.workplaceID, dto.CreateReconciliationRequest{
+		AccountID: suite.accountID, StatementDate: suite.statementDate, EndingBalance: decimal.RequireFromString("100"),
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sort"
 	"time"
 
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
 	"github.com/SscSPs/money_managemet_app/internal/core/domain"
 	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
 	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
@@ -16,6 +18,7 @@ import (
 type reportingService struct {
 	BaseService
 	reportingRepo portsrepo.ReportingRepository
+	dimensionRepo portsrepo.DimensionReader // Optional: resolves dimension filters and groupings
 }
 
 // ReportingServiceOption is a functional option for configuring the reporting service
@@ -28,6 +31,13 @@ func WithReportingWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc
 	}
 }
 
+// WithReportingDimensions lets reports be filtered and grouped by the workplace's dimensions.
+func WithReportingDimensions(dimensionRepo portsrepo.DimensionReader) ReportingServiceOption {
+	return func(s *reportingService) {
+		s.dimensionRepo = dimensionRepo
+	}
+}
+
 // NewReportingService creates a new reporting service with the provided options
 func NewReportingService(repo portsrepo.ReportingRepository, options ...ReportingServiceOption) portssvc.ReportingService {
 	svc := &reportingService{
@@ -45,8 +55,67 @@ func NewReportingService(repo portsrepo.ReportingRepository, options ...Reportin
 // Ensure reportingService implements the ReportingService interface
 var _ portssvc.ReportingService = (*reportingService)(nil)
 
+// ReportDimensions resolves dimension value IDs into report filters and, when groupBy names a dimension, groups.
+// UntaggedValueID is not accepted as a filter value; grouping reports the untagged lines in their own group.
+func (s *reportingService) ReportDimensions(ctx context.Context, workplaceID string, valueIDs []string, groupBy string, userID string) (*domain.ReportDimensions, error) {
+	result := &domain.ReportDimensions{Filters: []domain.DimensionFilter{}}
+	if len(valueIDs) == 0 && groupBy == "" {
+		return result, nil
+	}
+
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view report dimensions",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if s.dimensionRepo == nil {
+		return nil, fmt.Errorf("%w: dimensions are not supported", apperrors.ErrValidation)
+	}
+
+	dimensions, err := s.dimensionRepo.ListDimensions(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list dimensions for report",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to list dimensions: %w", err)
+	}
+
+	requested := make(map[string]bool, len(valueIDs))
+	for _, valueID := range valueIDs {
+		requested[valueID] = true
+	}
+	for _, dimension := range dimensions {
+		filter := domain.DimensionFilter{DimensionID: dimension.DimensionID}
+		for _, value := range dimension.Values {
+			if requested[value.ValueID] {
+				delete(requested, value.ValueID)
+				filter.ValueIDs = append(filter.ValueIDs, value.ValueID)
+			}
+		}
+		if len(filter.ValueIDs) > 0 {
+			result.Filters = append(result.Filters, filter)
+		}
+		if groupBy != "" && dimension.DimensionID == groupBy {
+			groupDimension := dimension
+			result.GroupBy = &groupDimension
+		}
+	}
+	for _, valueID := range valueIDs {
+		if requested[valueID] {
+			return nil, fmt.Errorf("%w: dimension value %s not found", apperrors.ErrValidation, valueID)
+		}
+	}
+	if groupBy != "" {
+		if result.GroupBy == nil {
+			return nil, fmt.Errorf("%w: dimension %s not found", apperrors.ErrValidation, groupBy)
+		}
+		result.Groups = result.GroupBy.Groups(result.Filters)
+	}
+	return result, nil
+}
+
 // TrialBalance generates a trial balance report as of a specific date
-func (s *reportingService) TrialBalance(ctx context.Context, workplaceID string, asOf time.Time, userID string) ([]domain.TrialBalanceRow, error) {
+func (s *reportingService) TrialBalance(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter, userID string) ([]domain.TrialBalanceRow, error) {
 	// Authorize user action (ReadOnly is sufficient for viewing reports)
 	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
 		s.LogError(ctx, err, "User not authorized to view trial balance report",
@@ -56,7 +125,7 @@ func (s *reportingService) TrialBalance(ctx context.Context, workplaceID string,
 	}
 
 	// Get trial balance data from repository
-	trialBalanceRows, err := s.reportingRepo.GetTrialBalanceData(ctx, workplaceID, asOf)
+	trialBalanceRows, err := s.reportingRepo.GetTrialBalanceData(ctx, workplaceID, asOf, filters)
 	if err != nil {
 		s.LogError(ctx, err, "Failed to retrieve trial balance data",
 			slog.String("workplace_id", workplaceID),
@@ -72,7 +141,7 @@ func (s *reportingService) TrialBalance(ctx context.Context, workplaceID string,
 }
 
 // ProfitAndLoss generates a profit and loss report for a specific period
-func (s *reportingService) ProfitAndLoss(ctx context.Context, workplaceID string, from, to time.Time, userID string) (*domain.PAndLReport, error) {
+func (s *reportingService) ProfitAndLoss(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.PAndLReport, error) {
 	// Authorize user action (ReadOnly is sufficient for viewing reports)
 	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
 		s.LogError(ctx, err, "User not authorized to view profit and loss report",
@@ -82,7 +151,7 @@ func (s *reportingService) ProfitAndLoss(ctx context.Context, workplaceID string
 	}
 
 	// Get profit and loss data from repository
-	revenue, expenses, err := s.reportingRepo.GetProfitAndLossData(ctx, workplaceID, from, to)
+	revenue, expenses, err := s.reportingRepo.GetProfitAndLossData(ctx, workplaceID, from, to, filters)
 	if err != nil {
 		s.LogError(ctx, err, "Failed to retrieve profit and loss data",
 			slog.String("workplace_id", workplaceID),
@@ -120,7 +189,7 @@ func (s *reportingService) ProfitAndLoss(ctx context.Context, workplaceID string
 }
 
 // BalanceSheet generates a balance sheet report as of a specific date
-func (s *reportingService) BalanceSheet(ctx context.Context, workplaceID string, asOf time.Time, userID string) (*domain.BalanceSheetReport, error) {
+func (s *reportingService) BalanceSheet(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter, userID string) (*domain.BalanceSheetReport, error) {
 
 	// Authorize user action (ReadOnly is sufficient for viewing reports)
 	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
@@ -131,7 +200,7 @@ func (s *reportingService) BalanceSheet(ctx context.Context, workplaceID string,
 	}
 
 	// Get balance sheet data from repository
-	assets, liabilities, equity, err := s.reportingRepo.GetBalanceSheetData(ctx, workplaceID, asOf)
+	assets, liabilities, equity, err := s.reportingRepo.GetBalanceSheetData(ctx, workplaceID, asOf, filters)
 	if err != nil {
 		s.LogError(ctx, err, "Failed to retrieve balance sheet data",
 			slog.String("workplace_id", workplaceID),
@@ -172,3 +241,617 @@ func (s *reportingService) BalanceSheet(ctx context.Context, workplaceID string,
 		slog.Int("equity_accounts", len(equity)))
 	return report, nil
 }
+
+// CashFlow generates an indirect-method cash flow statement for a specific period.
+// Net profit is adjusted by the movement of working-capital accounts; investing and financing
+// sections hold the movement of accounts classified accordingly. The result is reconciled
+// against the actual movement of CASH accounts.
+func (s *reportingService) CashFlow(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.CashFlowReport, error) {
+	// Authorize user action (ReadOnly is sufficient for viewing reports)
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view cash flow report",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	revenue, expenses, err := s.reportingRepo.GetProfitAndLossData(ctx, workplaceID, from, to, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve profit and loss data for cash flow",
+			slog.String("workplace_id", workplaceID),
+			slog.String("from", from.Format(time.RFC3339)),
+			slog.String("to", to.Format(time.RFC3339)))
+		return nil, fmt.Errorf("failed to retrieve profit and loss data: %w", err)
+	}
+
+	movements, err := s.reportingRepo.GetBalanceSheetMovements(ctx, workplaceID, from, to, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve balance sheet movements",
+			slog.String("workplace_id", workplaceID),
+			slog.String("from", from.Format(time.RFC3339)),
+			slog.String("to", to.Format(time.RFC3339)))
+		return nil, fmt.Errorf("failed to retrieve balance sheet movements: %w", err)
+	}
+
+	netProfit := decimal.Zero
+	for _, r := range revenue {
+		netProfit = netProfit.Add(r.NetAmount)
+	}
+	for _, e := range expenses {
+		netProfit = netProfit.Sub(e.NetAmount)
+	}
+
+	report := &domain.CashFlowReport{
+		NetProfit: netProfit,
+		Operating: domain.CashFlowSection{Lines: []domain.AccountAmount{}, Total: decimal.Zero},
+		Investing: domain.CashFlowSection{Lines: []domain.AccountAmount{}, Total: decimal.Zero},
+		Financing: domain.CashFlowSection{Lines: []domain.AccountAmount{}, Total: decimal.Zero},
+	}
+
+	for _, m := range movements {
+		if m.CashFlowCategory == domain.CashFlowCash {
+			report.OpeningCash = report.OpeningCash.Add(m.OpeningBalance)
+			report.ActualChangeInCash = report.ActualChangeInCash.Add(m.Movement)
+			continue
+		}
+
+		if m.Movement.IsZero() {
+			continue
+		}
+
+		// An increase in a non-cash debit balance consumes cash, a decrease releases it
+		line := domain.AccountAmount{
+			AccountID: m.AccountID,
+			Name:      m.Name,
+			NetAmount: m.Movement.Neg(),
+		}
+
+		var section *domain.CashFlowSection
+		switch m.CashFlowCategory {
+		case domain.CashFlowInvesting:
+			section = &report.Investing
+		case domain.CashFlowFinancing:
+			section = &report.Financing
+		default:
+			section = &report.Operating
+		}
+		section.Lines = append(section.Lines, line)
+		section.Total = section.Total.Add(line.NetAmount)
+	}
+
+	report.NetOperating = netProfit.Add(report.Operating.Total)
+	report.NetChangeInCash = report.NetOperating.Add(report.Investing.Total).Add(report.Financing.Total)
+	report.ClosingCash = report.OpeningCash.Add(report.ActualChangeInCash)
+	report.Difference = report.NetChangeInCash.Sub(report.ActualChangeInCash)
+	report.Reconciled = report.Difference.IsZero()
+
+	if !report.Reconciled {
+		s.LogInfo(ctx, "Cash flow report does not reconcile with cash accounts",
+			slog.String("workplace_id", workplaceID),
+			slog.String("difference", report.Difference.String()))
+	}
+
+	s.LogInfo(ctx, "Cash flow report generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("from", from.Format(time.RFC3339)),
+		slog.String("to", to.Format(time.RFC3339)),
+		slog.Int("operating_lines", len(report.Operating.Lines)),
+		slog.Int("investing_lines", len(report.Investing.Lines)),
+		slog.Int("financing_lines", len(report.Financing.Lines)))
+	return report, nil
+}
+
+// normalBalance converts a debit-positive amount into the normal sign of the account type
+// (debit-positive for assets and expenses, credit-positive for liabilities, equity and revenue)
+func normalBalance(accountType domain.AccountType, debitNet decimal.Decimal) decimal.Decimal {
+	if accountType == domain.Asset || accountType == domain.Expense {
+		return debitNet
+	}
+	return debitNet.Neg()
+}
+
+// buildLedgers loads the opening balances and postings of the requested accounts and
+// assembles them into ledgers with running balances in the account's normal sign
+func (s *reportingService) buildLedgers(ctx context.Context, workplaceID string, accountIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountLedger, error) {
+	ledgers, err := s.reportingRepo.GetLedgerAccounts(ctx, workplaceID, accountIDs, from, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ledger accounts: %w", err)
+	}
+
+	entries, err := s.reportingRepo.GetLedgerEntries(ctx, workplaceID, accountIDs, from, to, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve ledger entries: %w", err)
+	}
+
+	entriesByAccount := make(map[string][]domain.LedgerEntry)
+	for _, entry := range entries {
+		entriesByAccount[entry.AccountID] = append(entriesByAccount[entry.AccountID], entry)
+	}
+
+	for i := range ledgers {
+		ledger := &ledgers[i]
+		ledger.OpeningBalance = normalBalance(ledger.AccountType, ledger.OpeningBalance)
+		ledger.TotalDebit = decimal.Zero
+		ledger.TotalCredit = decimal.Zero
+		ledger.Entries = entriesByAccount[ledger.AccountID]
+		if ledger.Entries == nil {
+			ledger.Entries = []domain.LedgerEntry{}
+		}
+
+		running := ledger.OpeningBalance
+		for j := range ledger.Entries {
+			entry := &ledger.Entries[j]
+			debitNet := entry.Amount
+			if entry.TransactionType == domain.Debit {
+				ledger.TotalDebit = ledger.TotalDebit.Add(entry.Amount)
+			} else {
+				ledger.TotalCredit = ledger.TotalCredit.Add(entry.Amount)
+				debitNet = debitNet.Neg()
+			}
+			running = running.Add(normalBalance(ledger.AccountType, debitNet))
+			entry.RunningBalance = running
+		}
+		ledger.ClosingBalance = running
+	}
+
+	return ledgers, nil
+}
+
+// GeneralLedger generates a general ledger for all accounts, or the given subset, for a specific period.
+// When no subset is given, accounts without an opening balance or postings in the period are omitted.
+func (s *reportingService) GeneralLedger(ctx context.Context, workplaceID string, accountIDs []string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.GeneralLedgerReport, error) {
+	// Authorize user action (ReadOnly is sufficient for viewing reports)
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view general ledger report",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	ledgers, err := s.buildLedgers(ctx, workplaceID, accountIDs, from, to, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to build general ledger",
+			slog.String("workplace_id", workplaceID),
+			slog.String("from", from.Format(time.RFC3339)),
+			slog.String("to", to.Format(time.RFC3339)))
+		return nil, err
+	}
+
+	report := &domain.GeneralLedgerReport{Accounts: make([]domain.AccountLedger, 0, len(ledgers))}
+	for _, ledger := range ledgers {
+		if len(accountIDs) == 0 && ledger.OpeningBalance.IsZero() && len(ledger.Entries) == 0 {
+			continue
+		}
+		report.Accounts = append(report.Accounts, ledger)
+	}
+
+	s.LogInfo(ctx, "General ledger report generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("from", from.Format(time.RFC3339)),
+		slog.String("to", to.Format(time.RFC3339)),
+		slog.Int("account_count", len(report.Accounts)))
+	return report, nil
+}
+
+// AccountStatement generates the ledger of a single account for a specific period
+func (s *reportingService) AccountStatement(ctx context.Context, workplaceID string, accountID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.AccountLedger, error) {
+	// Authorize user action (ReadOnly is sufficient for viewing reports)
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view account statement",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	ledgers, err := s.buildLedgers(ctx, workplaceID, []string{accountID}, from, to, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to build account statement",
+			slog.String("workplace_id", workplaceID),
+			slog.String("account_id", accountID))
+		return nil, err
+	}
+
+	if len(ledgers) == 0 {
+		return nil, fmt.Errorf("%w: account %s not found in workplace %s", apperrors.ErrNotFound, accountID, workplaceID)
+	}
+
+	s.LogInfo(ctx, "Account statement generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("account_id", accountID),
+		slog.Int("entry_count", len(ledgers[0].Entries)))
+	return &ledgers[0], nil
+}
+
+// maxComparativePeriods caps the number of columns of a comparative report
+const maxComparativePeriods = 36
+
+// validateReportPeriods checks that a comparative report has a sensible number of well-formed periods
+func validateReportPeriods(periods []domain.ReportPeriod) error {
+	if len(periods) == 0 {
+		return fmt.Errorf("%w: at least one period is required", apperrors.ErrValidation)
+	}
+	if len(periods) > maxComparativePeriods {
+		return fmt.Errorf("%w: at most %d periods can be compared", apperrors.ErrValidation, maxComparativePeriods)
+	}
+	for _, p := range periods {
+		if p.From.After(p.To) {
+			return fmt.Errorf("%w: period %q starts after it ends", apperrors.ErrValidation, p.Label)
+		}
+	}
+	return nil
+}
+
+// comparativeValues turns per-period amounts into values carrying the variance against the previous period
+func comparativeValues(amounts []decimal.Decimal) []domain.ComparativeValue {
+	hundred := decimal.NewFromInt(100)
+	values := make([]domain.ComparativeValue, len(amounts))
+	for i, amount := range amounts {
+		values[i] = domain.ComparativeValue{Amount: amount, Variance: decimal.Zero}
+		if i == 0 {
+			continue
+		}
+		previous := amounts[i-1]
+		values[i].Variance = amount.Sub(previous)
+		if !previous.IsZero() {
+			percent := values[i].Variance.Div(previous.Abs()).Mul(hundred).Round(2)
+			values[i].VariancePercent = &percent
+		}
+	}
+	return values
+}
+
+// buildComparativeSections pivots per-period account amounts into one section per account type.
+// Lines are ordered by account name; accounts missing from a period get a zero amount in that column.
+func buildComparativeSections(periodCount int, amounts []domain.PeriodAccountAmount) (map[domain.AccountType]*domain.ComparativeSection, map[domain.AccountType][]decimal.Decimal) {
+	type accountColumns struct {
+		accountType domain.AccountType
+		name        string
+		amounts     []decimal.Decimal
+	}
+
+	byAccount := make(map[string]*accountColumns)
+	order := []string{}
+	for _, a := range amounts {
+		columns, ok := byAccount[a.AccountID]
+		if !ok {
+			columns = &accountColumns{accountType: a.AccountType, name: a.Name, amounts: make([]decimal.Decimal, periodCount)}
+			for i := range columns.amounts {
+				columns.amounts[i] = decimal.Zero
+			}
+			byAccount[a.AccountID] = columns
+			order = append(order, a.AccountID)
+		}
+		if a.PeriodIndex >= 0 && a.PeriodIndex < periodCount {
+			columns.amounts[a.PeriodIndex] = columns.amounts[a.PeriodIndex].Add(a.NetAmount)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return byAccount[order[i]].name < byAccount[order[j]].name
+	})
+
+	sections := make(map[domain.AccountType]*domain.ComparativeSection)
+	totals := make(map[domain.AccountType][]decimal.Decimal)
+	for _, accountID := range order {
+		columns := byAccount[accountID]
+		section, ok := sections[columns.accountType]
+		if !ok {
+			section = &domain.ComparativeSection{Lines: []domain.ComparativeLine{}}
+			sections[columns.accountType] = section
+			totals[columns.accountType] = make([]decimal.Decimal, periodCount)
+			for i := range totals[columns.accountType] {
+				totals[columns.accountType][i] = decimal.Zero
+			}
+		}
+		section.Lines = append(section.Lines, domain.ComparativeLine{
+			AccountID: accountID,
+			Name:      columns.name,
+			Values:    comparativeValues(columns.amounts),
+		})
+		for i, amount := range columns.amounts {
+			totals[columns.accountType][i] = totals[columns.accountType][i].Add(amount)
+		}
+	}
+
+	return sections, totals
+}
+
+// comparativeSection returns the section of an account type, or an empty one with zero totals
+func comparativeSection(periodCount int, accountType domain.AccountType, sections map[domain.AccountType]*domain.ComparativeSection, totals map[domain.AccountType][]decimal.Decimal) (domain.ComparativeSection, []decimal.Decimal) {
+	sectionTotals, ok := totals[accountType]
+	if !ok {
+		sectionTotals = make([]decimal.Decimal, periodCount)
+		for i := range sectionTotals {
+			sectionTotals[i] = decimal.Zero
+		}
+	}
+
+	section := domain.ComparativeSection{Lines: []domain.ComparativeLine{}}
+	if s, ok := sections[accountType]; ok {
+		section = *s
+	}
+	section.Totals = comparativeValues(sectionTotals)
+	return section, sectionTotals
+}
+
+// ComparativeProfitAndLoss generates a profit and loss report with one column per period and period-over-period variance
+func (s *reportingService) ComparativeProfitAndLoss(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter, userID string) (*domain.ComparativePAndLReport, error) {
+	// Authorize user action (ReadOnly is sufficient for viewing reports)
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view comparative profit and loss report",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if err := validateReportPeriods(periods); err != nil {
+		return nil, err
+	}
+
+	amounts, err := s.reportingRepo.GetProfitAndLossByPeriods(ctx, workplaceID, periods, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve profit and loss data by period",
+			slog.String("workplace_id", workplaceID),
+			slog.Int("period_count", len(periods)))
+		return nil, fmt.Errorf("failed to retrieve profit and loss data by period: %w", err)
+	}
+
+	sections, totals := buildComparativeSections(len(periods), amounts)
+	revenue, revenueTotals := comparativeSection(len(periods), domain.Revenue, sections, totals)
+	expenses, expenseTotals := comparativeSection(len(periods), domain.Expense, sections, totals)
+
+	netProfit := make([]decimal.Decimal, len(periods))
+	for i := range periods {
+		netProfit[i] = revenueTotals[i].Sub(expenseTotals[i])
+	}
+
+	report := &domain.ComparativePAndLReport{
+		Periods:   periods,
+		Revenue:   revenue,
+		Expenses:  expenses,
+		NetProfit: comparativeValues(netProfit),
+	}
+
+	s.LogInfo(ctx, "Comparative profit and loss report generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.Int("period_count", len(periods)),
+		slog.Int("revenue_accounts", len(revenue.Lines)),
+		slog.Int("expense_accounts", len(expenses.Lines)))
+	return report, nil
+}
+
+// ComparativeBalanceSheet generates a balance sheet as of the end of each period with period-over-period variance
+func (s *reportingService) ComparativeBalanceSheet(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter, userID string) (*domain.ComparativeBalanceSheetReport, error) {
+	// Authorize user action (ReadOnly is sufficient for viewing reports)
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view comparative balance sheet report",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if err := validateReportPeriods(periods); err != nil {
+		return nil, err
+	}
+
+	amounts, err := s.reportingRepo.GetBalanceSheetByPeriods(ctx, workplaceID, periods, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve balance sheet data by period",
+			slog.String("workplace_id", workplaceID),
+			slog.Int("period_count", len(periods)))
+		return nil, fmt.Errorf("failed to retrieve balance sheet data by period: %w", err)
+	}
+
+	sections, totals := buildComparativeSections(len(periods), amounts)
+	assets, _ := comparativeSection(len(periods), domain.Asset, sections, totals)
+	liabilities, _ := comparativeSection(len(periods), domain.Liability, sections, totals)
+	equity, _ := comparativeSection(len(periods), domain.Equity, sections, totals)
+
+	report := &domain.ComparativeBalanceSheetReport{
+		Periods:     periods,
+		Assets:      assets,
+		Liabilities: liabilities,
+		Equity:      equity,
+	}
+
+	s.LogInfo(ctx, "Comparative balance sheet report generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.Int("period_count", len(periods)),
+		slog.Int("asset_accounts", len(assets.Lines)),
+		slog.Int("liability_accounts", len(liabilities.Lines)),
+		slog.Int("equity_accounts", len(equity.Lines)))
+	return report, nil
+}
+
+// maxTimeSeriesBuckets caps the number of buckets of a time series (a little over three years of days)
+const maxTimeSeriesBuckets = 1100
+
+// validateTimeSeriesRequest checks the interval, mode and account selection of a time series request
+func validateTimeSeriesRequest(selection domain.TimeSeriesSelection, interval domain.TimeSeriesInterval, mode domain.TimeSeriesMode) error {
+	switch interval {
+	case domain.IntervalDay, domain.IntervalWeek, domain.IntervalMonth:
+	default:
+		return fmt.Errorf("%w: invalid interval %q, use day, week or month", apperrors.ErrValidation, interval)
+	}
+	switch mode {
+	case domain.TimeSeriesCumulative, domain.TimeSeriesPeriodic:
+	default:
+		return fmt.Errorf("%w: invalid mode %q, use cumulative or periodic", apperrors.ErrValidation, mode)
+	}
+	if selection.IsEmpty() {
+		return fmt.Errorf("%w: select at least one account, account type or parent account", apperrors.ErrValidation)
+	}
+	for _, accountType := range selection.AccountTypes {
+		switch accountType {
+		case domain.Asset, domain.Liability, domain.Equity, domain.Revenue, domain.Expense:
+		default:
+			return fmt.Errorf("%w: invalid account type %q", apperrors.ErrValidation, accountType)
+		}
+	}
+	return nil
+}
+
+// TimeSeries generates per-bucket balances (cumulative mode) or flows (periodic mode) of the selected accounts over a range
+func (s *reportingService) TimeSeries(ctx context.Context, workplaceID string, selection domain.TimeSeriesSelection, from, to time.Time, interval domain.TimeSeriesInterval, mode domain.TimeSeriesMode, filters []domain.DimensionFilter, userID string) (*domain.TimeSeriesReport, error) {
+	// Authorize user action (ReadOnly is sufficient for viewing reports)
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view time series report",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if err := validateTimeSeriesRequest(selection, interval, mode); err != nil {
+		return nil, err
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: from date must be before or equal to to date", apperrors.ErrValidation)
+	}
+
+	buckets := domain.TimeSeriesBuckets(from, to, interval)
+	if len(buckets) > maxTimeSeriesBuckets {
+		return nil, fmt.Errorf("%w: the range produces %d buckets, at most %d are allowed; use a wider interval",
+			apperrors.ErrValidation, len(buckets), maxTimeSeriesBuckets)
+	}
+
+	points, err := s.reportingRepo.GetTimeSeries(ctx, workplaceID, selection, buckets, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve time series data",
+			slog.String("workplace_id", workplaceID),
+			slog.Int("bucket_count", len(buckets)))
+		return nil, fmt.Errorf("failed to retrieve time series data: %w", err)
+	}
+
+	report := &domain.TimeSeriesReport{
+		Interval: interval,
+		Mode:     mode,
+		Buckets:  buckets,
+		Lines:    []domain.TimeSeriesLine{},
+		Totals:   make([]decimal.Decimal, len(buckets)),
+	}
+	for i := range report.Totals {
+		report.Totals[i] = decimal.Zero
+	}
+
+	lineIndex := make(map[string]int)
+	allCreditNormal := true
+	for _, point := range points {
+		idx, ok := lineIndex[point.AccountID]
+		if !ok {
+			values := make([]decimal.Decimal, len(buckets))
+			for i := range values {
+				values[i] = decimal.Zero
+			}
+			report.Lines = append(report.Lines, domain.TimeSeriesLine{
+				AccountID:   point.AccountID,
+				Name:        point.Name,
+				AccountType: point.AccountType,
+				Values:      values,
+			})
+			idx = len(report.Lines) - 1
+			lineIndex[point.AccountID] = idx
+			if point.AccountType == domain.Asset || point.AccountType == domain.Expense {
+				allCreditNormal = false
+			}
+		}
+		if point.BucketIndex < 0 || point.BucketIndex >= len(buckets) {
+			continue
+		}
+
+		debitNet := point.Balance
+		if mode == domain.TimeSeriesPeriodic {
+			debitNet = point.Flow
+		}
+		report.Lines[idx].Values[point.BucketIndex] = normalBalance(point.AccountType, debitNet)
+		report.Totals[point.BucketIndex] = report.Totals[point.BucketIndex].Add(debitNet)
+	}
+
+	if allCreditNormal && len(report.Lines) > 0 {
+		for i := range report.Totals {
+			report.Totals[i] = report.Totals[i].Neg()
+		}
+	}
+
+	s.LogInfo(ctx, "Time series report generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("interval", string(interval)),
+		slog.String("mode", string(mode)),
+		slog.Int("bucket_count", len(buckets)),
+		slog.Int("account_count", len(report.Lines)))
+	return report, nil
+}
+
+// PayeeReport rolls up spend and income per payee for a specific period
+func (s *reportingService) PayeeReport(ctx context.Context, workplaceID string, payeeIDs []string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.PayeeReport, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view payee report",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	amounts, err := s.reportingRepo.GetPayeeAmounts(ctx, workplaceID, payeeIDs, from, to, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve payee amounts",
+			slog.String("workplace_id", workplaceID),
+			slog.String("from", from.Format(time.RFC3339)),
+			slog.String("to", to.Format(time.RFC3339)))
+		return nil, fmt.Errorf("failed to retrieve payee amounts: %w", err)
+	}
+
+	s.LogInfo(ctx, "Payee report generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("from", from.Format(time.RFC3339)),
+		slog.String("to", to.Format(time.RFC3339)),
+		slog.Int("rows", len(amounts)))
+	return &domain.PayeeReport{Payees: amounts}, nil
+}
+
+// TaxReturnReport summarizes taxable amounts and tax collected and paid per tax code for a specific period,
+// with the output tax, input tax and net tax totalled per currency
+func (s *reportingService) TaxReturnReport(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter, userID string) (*domain.TaxReturn, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view tax return",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	lines, err := s.reportingRepo.GetTaxAmounts(ctx, workplaceID, from, to, filters)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve tax amounts",
+			slog.String("workplace_id", workplaceID),
+			slog.String("from", from.Format(time.RFC3339)),
+			slog.String("to", to.Format(time.RFC3339)))
+		return nil, fmt.Errorf("failed to retrieve tax amounts: %w", err)
+	}
+
+	report := &domain.TaxReturn{Lines: lines, Totals: []domain.TaxReturnTotal{}}
+	totals := make(map[string]*domain.TaxReturnTotal)
+	for i := range report.Lines {
+		line := &report.Lines[i]
+		line.NetTax = line.OutputTax.Sub(line.InputTax)
+
+		total, ok := totals[line.CurrencyCode]
+		if !ok {
+			total = &domain.TaxReturnTotal{CurrencyCode: line.CurrencyCode}
+			totals[line.CurrencyCode] = total
+		}
+		total.OutputTax = total.OutputTax.Add(line.OutputTax)
+		total.InputTax = total.InputTax.Add(line.InputTax)
+		total.NetTax = total.NetTax.Add(line.NetTax)
+	}
+	for _, total := range totals {
+		report.Totals = append(report.Totals, *total)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		return report.Totals[i].CurrencyCode < report.Totals[j].CurrencyCode
+	})
+
+	s.LogInfo(ctx, "Tax return generated successfully",
+		slog.String("workplace_id", workplaceID),
+		slog.String("from", from.Format(time.RFC3339)),
+		slog.String("to", to.Format(time.RFC3339)),
+		slog.Int("rows", len(report.Lines)))
+	return report, nil
+}
@@ -0,0 +1,390 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock ReportingRepository ---
+type MockReportingRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.ReportingRepository = (*MockReportingRepository)(nil)
+
+func (m *MockReportingRepository) GetTrialBalanceData(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter) ([]domain.TrialBalanceRow, error) {
+	args := m.Called(ctx, workplaceID, asOf, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.TrialBalanceRow), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetProfitAndLossData(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountAmount, []domain.AccountAmount, error) {
+	args := m.Called(ctx, workplaceID, from, to, filters)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]domain.AccountAmount), args.Get(1).([]domain.AccountAmount), args.Error(2)
+}
+
+func (m *MockReportingRepository) GetBalanceSheetData(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter) ([]domain.AccountAmount, []domain.AccountAmount, []domain.AccountAmount, error) {
+	args := m.Called(ctx, workplaceID, asOf, filters)
+	if args.Get(0) == nil {
+		return nil, nil, nil, args.Error(3)
+	}
+	return args.Get(0).([]domain.AccountAmount), args.Get(1).([]domain.AccountAmount), args.Get(2).([]domain.AccountAmount), args.Error(3)
+}
+
+func (m *MockReportingRepository) GetBalanceSheetMovements(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountMovement, error) {
+	args := m.Called(ctx, workplaceID, from, to, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.AccountMovement), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetLedgerAccounts(ctx context.Context, workplaceID string, accountIDs []string, before time.Time, filters []domain.DimensionFilter) ([]domain.AccountLedger, error) {
+	args := m.Called(ctx, workplaceID, accountIDs, before, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.AccountLedger), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetLedgerEntries(ctx context.Context, workplaceID string, accountIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.LedgerEntry, error) {
+	args := m.Called(ctx, workplaceID, accountIDs, from, to, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.LedgerEntry), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetProfitAndLossByPeriods(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.PeriodAccountAmount, error) {
+	args := m.Called(ctx, workplaceID, periods, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.PeriodAccountAmount), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetBalanceSheetByPeriods(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.PeriodAccountAmount, error) {
+	args := m.Called(ctx, workplaceID, periods, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.PeriodAccountAmount), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetTimeSeries(ctx context.Context, workplaceID string, selection domain.TimeSeriesSelection, buckets []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.TimeSeriesPoint, error) {
+	args := m.Called(ctx, workplaceID, selection, buckets, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.TimeSeriesPoint), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetPayeeAmounts(ctx context.Context, workplaceID string, payeeIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.PayeeAmount, error) {
+	args := m.Called(ctx, workplaceID, payeeIDs, from, to, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.PayeeAmount), args.Error(1)
+}
+
+func (m *MockReportingRepository) GetTaxAmounts(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.TaxReturnLine, error) {
+	args := m.Called(ctx, workplaceID, from, to, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.TaxReturnLine), args.Error(1)
+}
+
+// --- Test Suite Setup ---
+type ReportingServiceTestSuite struct {
+	suite.Suite
+	mockReportingRepo *MockReportingRepository
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.ReportingService
+	workplaceID       string
+	userID            string
+	from              time.Time
+	to                time.Time
+}
+
+func (suite *ReportingServiceTestSuite) SetupTest() {
+	suite.mockReportingRepo = new(MockReportingRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewReportingService(suite.mockReportingRepo, services.WithReportingWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.from = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.to = time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+}
+
+// --- Test Cases ---
+
+func (suite *ReportingServiceTestSuite) TestCashFlow_Reconciles() {
+	ctx := context.Background()
+	revenue := []domain.AccountAmount{{AccountID: "rev", Name: "Sales", NetAmount: decimal.NewFromInt(500)}}
+	expenses := []domain.AccountAmount{{AccountID: "exp", Name: "Rent", NetAmount: decimal.NewFromInt(200)}}
+	movements := []domain.AccountMovement{
+		{AccountID: "cash", Name: "Bank", AccountType: domain.Asset, CashFlowCategory: domain.CashFlowCash, OpeningBalance: decimal.NewFromInt(1000), Movement: decimal.NewFromInt(250)},
+		{AccountID: "ar", Name: "Receivables", AccountType: domain.Asset, CashFlowCategory: domain.CashFlowOperating, Movement: decimal.NewFromInt(100)},
+		{AccountID: "ap", Name: "Payables", AccountType: domain.Liability, CashFlowCategory: domain.CashFlowOperating, Movement: decimal.NewFromInt(-50)},
+		{AccountID: "equip", Name: "Equipment", AccountType: domain.Asset, CashFlowCategory: domain.CashFlowInvesting, Movement: decimal.NewFromInt(200)},
+		{AccountID: "loan", Name: "Loan", AccountType: domain.Liability, CashFlowCategory: domain.CashFlowFinancing, Movement: decimal.NewFromInt(-200)},
+		{AccountID: "idle", Name: "Idle", AccountType: domain.Asset, CashFlowCategory: domain.CashFlowOperating, OpeningBalance: decimal.NewFromInt(10)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetProfitAndLossData", ctx, suite.workplaceID, suite.from, suite.to, mock.Anything).Return(revenue, expenses, nil).Once()
+	suite.mockReportingRepo.On("GetBalanceSheetMovements", ctx, suite.workplaceID, suite.from, suite.to, mock.Anything).Return(movements, nil).Once()
+
+	report, err := suite.service.CashFlow(ctx, suite.workplaceID, suite.from, suite.to, nil, suite.userID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(report)
+
+	suite.True(report.NetProfit.Equal(decimal.NewFromInt(300)))
+	suite.Len(report.Operating.Lines, 2)
+	suite.True(report.Operating.Total.Equal(decimal.NewFromInt(-50)))
+	suite.True(report.NetOperating.Equal(decimal.NewFromInt(250)))
+	suite.True(report.Investing.Total.Equal(decimal.NewFromInt(-200)))
+	suite.True(report.Financing.Total.Equal(decimal.NewFromInt(200)))
+	suite.True(report.NetChangeInCash.Equal(decimal.NewFromInt(250)))
+	suite.True(report.OpeningCash.Equal(decimal.NewFromInt(1000)))
+	suite.True(report.ClosingCash.Equal(decimal.NewFromInt(1250)))
+	suite.True(report.Difference.IsZero())
+	suite.True(report.Reconciled)
+	suite.mockReportingRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReportingServiceTestSuite) TestCashFlow_NotReconciled() {
+	ctx := context.Background()
+	movements := []domain.AccountMovement{
+		{AccountID: "cash", Name: "Bank", AccountType: domain.Asset, CashFlowCategory: domain.CashFlowCash, Movement: decimal.NewFromInt(40)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetProfitAndLossData", ctx, suite.workplaceID, suite.from, suite.to, mock.Anything).Return([]domain.AccountAmount{}, []domain.AccountAmount{}, nil).Once()
+	suite.mockReportingRepo.On("GetBalanceSheetMovements", ctx, suite.workplaceID, suite.from, suite.to, mock.Anything).Return(movements, nil).Once()
+
+	report, err := suite.service.CashFlow(ctx, suite.workplaceID, suite.from, suite.to, nil, suite.userID)
+	suite.Require().NoError(err)
+	suite.False(report.Reconciled)
+	suite.True(report.Difference.Equal(decimal.NewFromInt(-40)))
+}
+
+func (suite *ReportingServiceTestSuite) TestCashFlow_AuthorizationFail() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(apperrors.ErrForbidden).Once()
+
+	report, err := suite.service.CashFlow(ctx, suite.workplaceID, suite.from, suite.to, nil, suite.userID)
+	suite.Require().ErrorIs(err, apperrors.ErrForbidden)
+	suite.Nil(report)
+	suite.mockReportingRepo.AssertNotCalled(suite.T(), "GetBalanceSheetMovements", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReportingServiceTestSuite) TestGeneralLedger_RunningBalances() {
+	ctx := context.Background()
+	accounts := []domain.AccountLedger{
+		{AccountID: "bank", Name: "Bank", AccountType: domain.Asset, CurrencyCode: "USD", OpeningBalance: decimal.NewFromInt(100)},
+		{AccountID: "card", Name: "Card", AccountType: domain.Liability, CurrencyCode: "USD", OpeningBalance: decimal.NewFromInt(-40)},
+		{AccountID: "unused", Name: "Unused", AccountType: domain.Expense, CurrencyCode: "USD"},
+	}
+	entries := []domain.LedgerEntry{
+		{AccountID: "bank", TransactionID: "t1", TransactionType: domain.Debit, Amount: decimal.NewFromInt(50)},
+		{AccountID: "bank", TransactionID: "t2", TransactionType: domain.Credit, Amount: decimal.NewFromInt(30),
+			CounterAccounts: []domain.LedgerCounterAccount{{AccountID: "card", Name: "Card"}}},
+		{AccountID: "card", TransactionID: "t3", TransactionType: domain.Debit, Amount: decimal.NewFromInt(30)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetLedgerAccounts", ctx, suite.workplaceID, []string(nil), suite.from, mock.Anything).Return(accounts, nil).Once()
+	suite.mockReportingRepo.On("GetLedgerEntries", ctx, suite.workplaceID, []string(nil), suite.from, suite.to, mock.Anything).Return(entries, nil).Once()
+
+	report, err := suite.service.GeneralLedger(ctx, suite.workplaceID, nil, suite.from, suite.to, nil, suite.userID)
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Accounts, 2, "accounts without balance or activity are omitted")
+
+	bank := report.Accounts[0]
+	suite.True(bank.OpeningBalance.Equal(decimal.NewFromInt(100)))
+	suite.True(bank.Entries[0].RunningBalance.Equal(decimal.NewFromInt(150)))
+	suite.True(bank.Entries[1].RunningBalance.Equal(decimal.NewFromInt(120)))
+	suite.True(bank.TotalDebit.Equal(decimal.NewFromInt(50)))
+	suite.True(bank.TotalCredit.Equal(decimal.NewFromInt(30)))
+	suite.True(bank.ClosingBalance.Equal(decimal.NewFromInt(120)))
+
+	card := report.Accounts[1]
+	suite.True(card.OpeningBalance.Equal(decimal.NewFromInt(40)), "liability balances are credit-positive")
+	suite.True(card.ClosingBalance.Equal(decimal.NewFromInt(10)))
+}
+
+func (suite *ReportingServiceTestSuite) TestAccountStatement_NotFound() {
+	ctx := context.Background()
+	accountIDs := []string{"missing"}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetLedgerAccounts", ctx, suite.workplaceID, accountIDs, suite.from, mock.Anything).Return([]domain.AccountLedger{}, nil).Once()
+	suite.mockReportingRepo.On("GetLedgerEntries", ctx, suite.workplaceID, accountIDs, suite.from, suite.to, mock.Anything).Return([]domain.LedgerEntry{}, nil).Once()
+
+	ledger, err := suite.service.AccountStatement(ctx, suite.workplaceID, "missing", suite.from, suite.to, nil, suite.userID)
+	suite.Require().ErrorIs(err, apperrors.ErrNotFound)
+	suite.Nil(ledger)
+}
+
+func (suite *ReportingServiceTestSuite) TestComparativeProfitAndLoss_Variance() {
+	ctx := context.Background()
+	periods := domain.MonthlyPeriods(suite.from, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC))
+	suite.Require().Len(periods, 3)
+	suite.Equal(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), periods[1].To)
+
+	amounts := []domain.PeriodAccountAmount{
+		{PeriodIndex: 0, AccountID: "sal", Name: "Salary", AccountType: domain.Revenue, NetAmount: decimal.NewFromInt(1000)},
+		{PeriodIndex: 1, AccountID: "sal", Name: "Salary", AccountType: domain.Revenue, NetAmount: decimal.NewFromInt(1200)},
+		{PeriodIndex: 2, AccountID: "sal", Name: "Salary", AccountType: domain.Revenue, NetAmount: decimal.NewFromInt(900)},
+		{PeriodIndex: 1, AccountID: "food", Name: "Food", AccountType: domain.Expense, NetAmount: decimal.NewFromInt(200)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetProfitAndLossByPeriods", ctx, suite.workplaceID, periods, mock.Anything).Return(amounts, nil).Once()
+
+	report, err := suite.service.ComparativeProfitAndLoss(ctx, suite.workplaceID, periods, nil, suite.userID)
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Revenue.Lines, 1)
+	suite.Require().Len(report.Expenses.Lines, 1)
+
+	salary := report.Revenue.Lines[0].Values
+	suite.Nil(salary[0].VariancePercent)
+	suite.True(salary[1].Variance.Equal(decimal.NewFromInt(200)))
+	suite.True(salary[1].VariancePercent.Equal(decimal.NewFromInt(20)))
+	suite.True(salary[2].VariancePercent.Equal(decimal.NewFromInt(-25)))
+
+	food := report.Expenses.Lines[0].Values
+	suite.True(food[0].Amount.IsZero())
+	suite.Nil(food[1].VariancePercent, "no percentage against a zero base")
+
+	suite.True(report.NetProfit[1].Amount.Equal(decimal.NewFromInt(1000)))
+	suite.True(report.NetProfit[2].Variance.Equal(decimal.NewFromInt(-100)))
+}
+
+func (suite *ReportingServiceTestSuite) TestComparativeBalanceSheet_TooManyPeriods() {
+	ctx := context.Background()
+	periods := domain.MonthlyPeriods(suite.from, suite.from.AddDate(4, 0, 0))
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+
+	report, err := suite.service.ComparativeBalanceSheet(ctx, suite.workplaceID, periods, nil, suite.userID)
+	suite.Require().ErrorIs(err, apperrors.ErrValidation)
+	suite.Nil(report)
+	suite.mockReportingRepo.AssertNotCalled(suite.T(), "GetBalanceSheetByPeriods", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReportingServiceTestSuite) TestTimeSeries_NetWorth() {
+	ctx := context.Background()
+	selection := domain.TimeSeriesSelection{AccountTypes: []domain.AccountType{domain.Asset, domain.Liability}}
+	from := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	buckets := domain.TimeSeriesBuckets(from, to, domain.IntervalMonth)
+	points := []domain.TimeSeriesPoint{
+		{BucketIndex: 0, AccountID: "bank", Name: "Bank", AccountType: domain.Asset, Flow: decimal.NewFromInt(100), Balance: decimal.NewFromInt(1100)},
+		{BucketIndex: 1, AccountID: "bank", Name: "Bank", AccountType: domain.Asset, Flow: decimal.NewFromInt(-50), Balance: decimal.NewFromInt(1050)},
+		{BucketIndex: 2, AccountID: "bank", Name: "Bank", AccountType: domain.Asset, Flow: decimal.Zero, Balance: decimal.NewFromInt(1050)},
+		{BucketIndex: 0, AccountID: "card", Name: "Card", AccountType: domain.Liability, Flow: decimal.NewFromInt(-200), Balance: decimal.NewFromInt(-200)},
+		{BucketIndex: 1, AccountID: "card", Name: "Card", AccountType: domain.Liability, Flow: decimal.NewFromInt(50), Balance: decimal.NewFromInt(-150)},
+		{BucketIndex: 2, AccountID: "card", Name: "Card", AccountType: domain.Liability, Flow: decimal.Zero, Balance: decimal.NewFromInt(-150)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetTimeSeries", ctx, suite.workplaceID, selection, buckets, mock.Anything).Return(points, nil).Once()
+
+	report, err := suite.service.TimeSeries(ctx, suite.workplaceID, selection, from, to, domain.IntervalMonth, domain.TimeSeriesCumulative, nil, suite.userID)
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Buckets, 3)
+	suite.Equal("2025-01", report.Buckets[0].Label)
+	suite.Equal(from, report.Buckets[0].From, "the first bucket is clipped to the range")
+	suite.Equal(to, report.Buckets[2].To, "the last bucket is clipped to the range")
+
+	suite.Require().Len(report.Lines, 2)
+	suite.True(report.Lines[1].Values[0].Equal(decimal.NewFromInt(200)), "liabilities are reported credit-positive")
+	suite.True(report.Totals[0].Equal(decimal.NewFromInt(900)), "net worth is assets minus liabilities")
+	suite.True(report.Totals[1].Equal(decimal.NewFromInt(900)))
+}
+
+func (suite *ReportingServiceTestSuite) TestTimeSeries_PeriodicRevenue() {
+	ctx := context.Background()
+	selection := domain.TimeSeriesSelection{ParentAccountIDs: []string{"income"}}
+	buckets := domain.TimeSeriesBuckets(suite.from, suite.to, domain.IntervalWeek)
+	points := []domain.TimeSeriesPoint{
+		{BucketIndex: 1, AccountID: "salary", Name: "Salary", AccountType: domain.Revenue, Flow: decimal.NewFromInt(-300), Balance: decimal.NewFromInt(-300)},
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetTimeSeries", ctx, suite.workplaceID, selection, buckets, mock.Anything).Return(points, nil).Once()
+
+	report, err := suite.service.TimeSeries(ctx, suite.workplaceID, selection, suite.from, suite.to, domain.IntervalWeek, domain.TimeSeriesPeriodic, nil, suite.userID)
+	suite.Require().NoError(err)
+	suite.Require().Len(report.Buckets, 5)
+	suite.Equal("2025-W01", report.Buckets[0].Label)
+	suite.Equal(time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC), report.Buckets[0].To, "weeks end on Sunday")
+	suite.True(report.Lines[0].Values[1].Equal(decimal.NewFromInt(300)))
+	suite.True(report.Totals[1].Equal(decimal.NewFromInt(300)), "totals of credit-normal accounts only are credit-positive")
+	suite.True(report.Totals[0].IsZero())
+}
+
+func (suite *ReportingServiceTestSuite) TestTimeSeries_Validation() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil)
+
+	_, err := suite.service.TimeSeries(ctx, suite.workplaceID, domain.TimeSeriesSelection{}, suite.from, suite.to, domain.IntervalDay, domain.TimeSeriesCumulative, nil, suite.userID)
+	suite.ErrorIs(err, apperrors.ErrValidation, "an account selection is required")
+
+	selection := domain.TimeSeriesSelection{AccountIDs: []string{"bank"}}
+	_, err = suite.service.TimeSeries(ctx, suite.workplaceID, selection, suite.from, suite.to, "hour", domain.TimeSeriesCumulative, nil, suite.userID)
+	suite.ErrorIs(err, apperrors.ErrValidation)
+
+	_, err = suite.service.TimeSeries(ctx, suite.workplaceID, selection, suite.from, suite.from.AddDate(5, 0, 0), domain.IntervalDay, domain.TimeSeriesCumulative, nil, suite.userID)
+	suite.ErrorIs(err, apperrors.ErrValidation, "too many buckets")
+
+	suite.mockReportingRepo.AssertNotCalled(suite.T(), "GetTimeSeries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ReportingServiceTestSuite) TestTaxReturnReport_NetTaxAndTotals() {
+	ctx := context.Background()
+	lines := []domain.TaxReturnLine{
+		{TaxCodeID: "std", Code: "VAT20", CurrencyCode: "EUR", TaxableSales: decimal.NewFromInt(1000), OutputTax: decimal.NewFromInt(200),
+			TaxablePurchases: decimal.NewFromInt(400), InputTax: decimal.NewFromInt(80)},
+		{TaxCodeID: "std", Code: "VAT20", CurrencyCode: "USD", TaxablePurchases: decimal.NewFromInt(50), InputTax: decimal.NewFromInt(10)},
+		{TaxCodeID: "red", Code: "VAT5", CurrencyCode: "EUR", TaxableSales: decimal.NewFromInt(100), OutputTax: decimal.NewFromInt(5)},
+	}
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockReportingRepo.On("GetTaxAmounts", ctx, suite.workplaceID, suite.from, suite.to, mock.Anything).Return(lines, nil).Once()
+
+	report, err := suite.service.TaxReturnReport(ctx, suite.workplaceID, suite.from, suite.to, nil, suite.userID)
+	suite.Require().NoError(err)
+
+	suite.True(report.Lines[0].NetTax.Equal(decimal.NewFromInt(120)))
+	suite.True(report.Lines[1].NetTax.Equal(decimal.NewFromInt(-10)), "input tax exceeding output tax is reclaimable")
+	suite.Require().Len(report.Totals, 2)
+	suite.Equal("EUR", report.Totals[0].CurrencyCode)
+	suite.True(report.Totals[0].OutputTax.Equal(decimal.NewFromInt(205)))
+	suite.True(report.Totals[0].NetTax.Equal(decimal.NewFromInt(125)))
+	suite.True(report.Totals[1].NetTax.Equal(decimal.NewFromInt(-10)))
+}
+
+// --- Run Test Suite ---
+func TestReportingService(t *testing.T) {
+	suite.Run(t, new(ReportingServiceTestSuite))
+}
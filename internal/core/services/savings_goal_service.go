@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// defaultGoalTrailingMonths is the number of complete months averaged for the projected completion date
+	defaultGoalTrailingMonths = 3
+	// maxGoalTrailingMonths limits the averaging window
+	maxGoalTrailingMonths = 24
+)
+
+// savingsGoalService implements the SavingsGoalSvcFacade interface
+type savingsGoalService struct {
+	BaseService
+	goalRepo      portsrepo.SavingsGoalRepositoryFacade
+	accountRepo   portsrepo.AccountReader
+	reportingRepo portsrepo.ReportingRepository
+	currencyRepo  portsrepo.CurrencyReader
+}
+
+// SavingsGoalServiceOption is a functional option for configuring the savings goal service
+type SavingsGoalServiceOption func(*savingsGoalService)
+
+// WithSavingsGoalWorkplaceAuthorizer adds workplace authorizer dependency
+func WithSavingsGoalWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) SavingsGoalServiceOption {
+	return func(s *savingsGoalService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewSavingsGoalService creates a new savings goal service. Contributions are read through the reporting repository.
+func NewSavingsGoalService(goalRepo portsrepo.SavingsGoalRepositoryFacade, accountRepo portsrepo.AccountReader, reportingRepo portsrepo.ReportingRepository, currencyRepo portsrepo.CurrencyReader, options ...SavingsGoalServiceOption) portssvc.SavingsGoalSvcFacade {
+	svc := &savingsGoalService{
+		goalRepo:      goalRepo,
+		accountRepo:   accountRepo,
+		reportingRepo: reportingRepo,
+		currencyRepo:  currencyRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure savingsGoalService implements the SavingsGoalSvcFacade interface
+var _ portssvc.SavingsGoalSvcFacade = (*savingsGoalService)(nil)
+
+// validateGoalAccounts checks that the linked accounts are distinct ASSET accounts of the workplace
+// sharing one currency, and returns that currency
+func (s *savingsGoalService) validateGoalAccounts(ctx context.Context, workplaceID string, accountIDs []string) (string, error) {
+	if len(accountIDs) == 0 {
+		return "", fmt.Errorf("%w: at least one account is required", apperrors.ErrValidation)
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, accountIDs)
+	if err != nil {
+		return "", fmt.Errorf("failed to load goal accounts: %w", err)
+	}
+
+	currencyCode := ""
+	seen := make(map[string]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		if seen[id] {
+			return "", fmt.Errorf("%w: account %s is linked more than once", apperrors.ErrValidation, id)
+		}
+		seen[id] = true
+
+		account, ok := accounts[id]
+		if !ok || account.WorkplaceID != workplaceID {
+			return "", fmt.Errorf("%w: account %s not found in workplace", apperrors.ErrValidation, id)
+		}
+		if account.AccountType != domain.Asset {
+			return "", fmt.Errorf("%w: account %s is a %s account; only ASSET accounts can back a savings goal",
+				apperrors.ErrValidation, account.Name, account.AccountType)
+		}
+		if currencyCode == "" {
+			currencyCode = account.CurrencyCode
+		} else if account.CurrencyCode != currencyCode {
+			return "", fmt.Errorf("%w: all goal accounts must use the same currency (%s and %s found)",
+				apperrors.ErrValidation, currencyCode, account.CurrencyCode)
+		}
+	}
+	return currencyCode, nil
+}
+
+// findGoal loads a savings goal and verifies that it belongs to the workplace
+func (s *savingsGoalService) findGoal(ctx context.Context, workplaceID string, goalID string) (*domain.SavingsGoal, error) {
+	goal, err := s.goalRepo.FindSavingsGoalByID(ctx, goalID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find savings goal by ID",
+			slog.String("goal_id", goalID))
+		return nil, fmt.Errorf("failed to find savings goal: %w", err)
+	}
+	if goal.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Savings goal found but belongs to different workplace",
+			slog.String("goal_id", goalID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return goal, nil
+}
+
+func (s *savingsGoalService) CreateSavingsGoal(ctx context.Context, workplaceID string, req dto.CreateSavingsGoalRequest, userID string) (*domain.SavingsGoal, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create savings goal",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, fmt.Errorf("%w: goal name cannot be empty", apperrors.ErrValidation)
+	}
+	if !req.TargetAmount.IsPositive() {
+		return nil, fmt.Errorf("%w: target amount must be positive", apperrors.ErrValidation)
+	}
+	currencyCode, err := s.validateGoalAccounts(ctx, workplaceID, req.AccountIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	goal := domain.SavingsGoal{
+		GoalID:       uuid.NewString(),
+		WorkplaceID:  workplaceID,
+		Name:         req.Name,
+		Description:  req.Description,
+		TargetAmount: req.TargetAmount,
+		TargetDate:   dateOnly(req.TargetDate),
+		CurrencyCode: currencyCode,
+		AccountIDs:   req.AccountIDs,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+
+	if err := s.goalRepo.SaveSavingsGoal(ctx, goal); err != nil {
+		s.LogError(ctx, err, "Failed to save savings goal",
+			slog.String("goal_id", goal.GoalID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Savings goal created successfully",
+		slog.String("goal_id", goal.GoalID),
+		slog.String("workplace_id", workplaceID))
+	return &goal, nil
+}
+
+func (s *savingsGoalService) UpdateSavingsGoal(ctx context.Context, workplaceID string, goalID string, req dto.UpdateSavingsGoalRequest, userID string) (*domain.SavingsGoal, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update savings goal",
+			slog.String("workplace_id", workplaceID),
+			slog.String("goal_id", goalID))
+		return nil, err
+	}
+
+	goal, err := s.findGoal(ctx, workplaceID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, fmt.Errorf("%w: goal name cannot be empty", apperrors.ErrValidation)
+		}
+		goal.Name = *req.Name
+	}
+	if req.Description != nil {
+		goal.Description = *req.Description
+	}
+	if req.TargetAmount != nil {
+		if !req.TargetAmount.IsPositive() {
+			return nil, fmt.Errorf("%w: target amount must be positive", apperrors.ErrValidation)
+		}
+		goal.TargetAmount = *req.TargetAmount
+	}
+	if req.TargetDate != nil {
+		goal.TargetDate = dateOnly(*req.TargetDate)
+	}
+	if req.AccountIDs != nil {
+		currencyCode, err := s.validateGoalAccounts(ctx, workplaceID, req.AccountIDs)
+		if err != nil {
+			return nil, err
+		}
+		goal.AccountIDs = req.AccountIDs
+		goal.CurrencyCode = currencyCode
+	}
+	goal.LastUpdatedAt = time.Now()
+	goal.LastUpdatedBy = userID
+
+	if err := s.goalRepo.UpdateSavingsGoal(ctx, *goal); err != nil {
+		s.LogError(ctx, err, "Failed to update savings goal",
+			slog.String("goal_id", goalID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Savings goal updated successfully",
+		slog.String("goal_id", goalID),
+		slog.String("workplace_id", workplaceID))
+	return goal, nil
+}
+
+func (s *savingsGoalService) DeleteSavingsGoal(ctx context.Context, workplaceID string, goalID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete savings goal",
+			slog.String("workplace_id", workplaceID),
+			slog.String("goal_id", goalID))
+		return err
+	}
+
+	if _, err := s.findGoal(ctx, workplaceID, goalID); err != nil {
+		return err
+	}
+
+	if err := s.goalRepo.DeleteSavingsGoal(ctx, goalID); err != nil {
+		s.LogError(ctx, err, "Failed to delete savings goal",
+			slog.String("goal_id", goalID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Savings goal deleted successfully",
+		slog.String("goal_id", goalID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
+
+func (s *savingsGoalService) ListSavingsGoals(ctx context.Context, workplaceID string, userID string) ([]domain.SavingsGoal, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list savings goals",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	goals, err := s.goalRepo.ListSavingsGoals(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list savings goals",
+			slog.String("workplace_id", workplaceID))
+		return nil, fmt.Errorf("failed to list savings goals for workplace %s: %w", workplaceID, err)
+	}
+	return goals, nil
+}
+
+// GetSavingsGoalProgress derives the progress of a goal from the current balances of its accounts and their
+// net inflow over the trailing complete months.
+func (s *savingsGoalService) GetSavingsGoalProgress(ctx context.Context, workplaceID string, goalID string, trailingMonths int, userID string) (*domain.SavingsGoalProgress, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view savings goal",
+			slog.String("workplace_id", workplaceID),
+			slog.String("goal_id", goalID))
+		return nil, err
+	}
+
+	if trailingMonths == 0 {
+		trailingMonths = defaultGoalTrailingMonths
+	}
+	if trailingMonths < 1 || trailingMonths > maxGoalTrailingMonths {
+		return nil, fmt.Errorf("%w: trailing months must be between 1 and %d", apperrors.ErrValidation, maxGoalTrailingMonths)
+	}
+
+	goal, err := s.findGoal(ctx, workplaceID, goalID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, goal.AccountIDs)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load savings goal accounts",
+			slog.String("goal_id", goalID))
+		return nil, fmt.Errorf("failed to load goal accounts: %w", err)
+	}
+	current := decimal.Zero
+	for _, id := range goal.AccountIDs {
+		current = current.Add(accounts[id].Balance)
+	}
+
+	asOf := todayUTC()
+	thisMonth := firstOfMonth(asOf)
+	buckets := domain.MonthlyPeriods(thisMonth.AddDate(0, -trailingMonths, 0), thisMonth.AddDate(0, 0, -1))
+	points, err := s.reportingRepo.GetTimeSeries(ctx, workplaceID, domain.TimeSeriesSelection{AccountIDs: goal.AccountIDs}, buckets, nil)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to retrieve savings goal contributions",
+			slog.String("goal_id", goalID))
+		return nil, fmt.Errorf("failed to retrieve contributions: %w", err)
+	}
+	contributed := decimal.Zero
+	for _, point := range points {
+		contributed = contributed.Add(point.Flow)
+	}
+
+	precision := currencyPrecision(ctx, s.currencyRepo, goal.CurrencyCode)
+
+	progress := &domain.SavingsGoalProgress{
+		Goal:                       *goal,
+		AsOf:                       asOf,
+		CurrentAmount:              current,
+		RemainingAmount:            decimal.Max(goal.TargetAmount.Sub(current), decimal.Zero),
+		PercentComplete:            current.Div(goal.TargetAmount).Mul(decimal.NewFromInt(100)).Round(2),
+		AverageMonthlyContribution: contributed.Div(decimal.NewFromInt(int64(trailingMonths))).Round(precision),
+		TrailingMonths:             trailingMonths,
+	}
+
+	targetPassed := goal.TargetDate.Before(asOf)
+	if !targetPassed {
+		progress.MonthsRemaining = (goal.TargetDate.Year()-asOf.Year())*12 + int(goal.TargetDate.Month()-asOf.Month())
+	}
+
+	if progress.RemainingAmount.IsZero() {
+		progress.RequiredMonthlyContribution = decimal.Zero
+		progress.ProjectedCompletionDate = &asOf
+		progress.Status = domain.GoalAchieved
+	} else {
+		progress.RequiredMonthlyContribution = progress.RemainingAmount.
+			Div(decimal.NewFromInt(int64(max(progress.MonthsRemaining, 1)))).RoundUp(precision)
+		if progress.AverageMonthlyContribution.IsPositive() {
+			months := progress.RemainingAmount.Div(progress.AverageMonthlyContribution).Ceil().IntPart()
+			projected := asOf.AddDate(0, int(months), 0)
+			progress.ProjectedCompletionDate = &projected
+		}
+		progress.Status = domain.GoalBehind
+		if !targetPassed && progress.AverageMonthlyContribution.GreaterThanOrEqual(progress.RequiredMonthlyContribution) {
+			progress.Status = domain.GoalOnTrack
+		}
+	}
+
+	s.LogInfo(ctx, "Savings goal progress derived successfully",
+		slog.String("goal_id", goalID),
+		slog.String("status", string(progress.Status)))
+	return progress, nil
+}
@@ -0,0 +1,185 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock SavingsGoalRepository ---
+type MockSavingsGoalRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.SavingsGoalRepositoryFacade = (*MockSavingsGoalRepository)(nil)
+
+func (m *MockSavingsGoalRepository) FindSavingsGoalByID(ctx context.Context, goalID string) (*domain.SavingsGoal, error) {
+	args := m.Called(ctx, goalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SavingsGoal), args.Error(1)
+}
+
+func (m *MockSavingsGoalRepository) ListSavingsGoals(ctx context.Context, workplaceID string) ([]domain.SavingsGoal, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.SavingsGoal), args.Error(1)
+}
+
+func (m *MockSavingsGoalRepository) SaveSavingsGoal(ctx context.Context, goal domain.SavingsGoal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockSavingsGoalRepository) UpdateSavingsGoal(ctx context.Context, goal domain.SavingsGoal) error {
+	args := m.Called(ctx, goal)
+	return args.Error(0)
+}
+
+func (m *MockSavingsGoalRepository) DeleteSavingsGoal(ctx context.Context, goalID string) error {
+	args := m.Called(ctx, goalID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type SavingsGoalServiceTestSuite struct {
+	suite.Suite
+	mockGoalRepo      *MockSavingsGoalRepository
+	mockAccountRepo   *MockAccountRepositoryFacade
+	mockReportingRepo *MockReportingRepository
+	mockCurrencyRepo  *MockCurrencyRepository
+	mockWorkplaceSvc  *MockWorkplaceService
+	service           portssvc.SavingsGoalSvcFacade
+	workplaceID       string
+	userID            string
+	accounts          map[string]domain.Account
+}
+
+func (suite *SavingsGoalServiceTestSuite) SetupTest() {
+	suite.mockGoalRepo = new(MockSavingsGoalRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockReportingRepo = new(MockReportingRepository)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewSavingsGoalService(suite.mockGoalRepo, suite.mockAccountRepo, suite.mockReportingRepo, suite.mockCurrencyRepo,
+		services.WithSavingsGoalWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.accounts = map[string]domain.Account{
+		"savings":  {AccountID: "savings", WorkplaceID: suite.workplaceID, Name: "Savings", AccountType: domain.Asset, CurrencyCode: "USD", Balance: decimal.NewFromInt(2500)},
+		"deposit":  {AccountID: "deposit", WorkplaceID: suite.workplaceID, Name: "Deposit", AccountType: domain.Asset, CurrencyCode: "USD", Balance: decimal.NewFromInt(1500)},
+		"euro":     {AccountID: "euro", WorkplaceID: suite.workplaceID, Name: "Euro", AccountType: domain.Asset, CurrencyCode: "EUR"},
+		"mortgage": {AccountID: "mortgage", WorkplaceID: suite.workplaceID, Name: "Mortgage", AccountType: domain.Liability, CurrencyCode: "USD"},
+	}
+}
+
+// expectProgress sets up a goal of 10,000 due in twelve months, backed by 4,000 of balances,
+// whose accounts received the given flows over the last three months
+func (suite *SavingsGoalServiceTestSuite) expectProgress(ctx context.Context, flows ...int64) *domain.SavingsGoal {
+	now := time.Now().UTC()
+	goal := &domain.SavingsGoal{
+		GoalID:       "goal",
+		WorkplaceID:  suite.workplaceID,
+		Name:         "Emergency fund",
+		TargetAmount: decimal.NewFromInt(10000),
+		TargetDate:   time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 12, 0),
+		CurrencyCode: "USD",
+		AccountIDs:   []string{"savings", "deposit"},
+	}
+	points := make([]domain.TimeSeriesPoint, len(flows))
+	for i, flow := range flows {
+		points[i] = domain.TimeSeriesPoint{BucketIndex: i, AccountID: "savings", Flow: decimal.NewFromInt(flow)}
+	}
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+	suite.mockGoalRepo.On("FindSavingsGoalByID", ctx, "goal").Return(goal, nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, goal.AccountIDs).Return(suite.accounts, nil).Once()
+	suite.mockReportingRepo.On("GetTimeSeries", ctx, suite.workplaceID, domain.TimeSeriesSelection{AccountIDs: goal.AccountIDs},
+		mock.MatchedBy(func(buckets []domain.ReportPeriod) bool { return len(buckets) == 3 }), mock.Anything).Return(points, nil).Once()
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", ctx, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Once()
+	return goal
+}
+
+// --- Test Cases ---
+
+func (suite *SavingsGoalServiceTestSuite) TestGetSavingsGoalProgress_OnTrack() {
+	ctx := context.Background()
+	suite.expectProgress(ctx, 500, 600, 700)
+
+	progress, err := suite.service.GetSavingsGoalProgress(ctx, suite.workplaceID, "goal", 0, suite.userID)
+	suite.Require().NoError(err)
+	suite.True(progress.CurrentAmount.Equal(decimal.NewFromInt(4000)))
+	suite.True(progress.RemainingAmount.Equal(decimal.NewFromInt(6000)))
+	suite.True(progress.PercentComplete.Equal(decimal.NewFromInt(40)))
+	suite.Equal(12, progress.MonthsRemaining)
+	suite.True(progress.RequiredMonthlyContribution.Equal(decimal.NewFromInt(500)))
+	suite.True(progress.AverageMonthlyContribution.Equal(decimal.NewFromInt(600)))
+	suite.Equal(3, progress.TrailingMonths)
+	suite.Require().NotNil(progress.ProjectedCompletionDate)
+	suite.Equal(progress.AsOf.AddDate(0, 10, 0), *progress.ProjectedCompletionDate)
+	suite.Equal(domain.GoalOnTrack, progress.Status)
+}
+
+func (suite *SavingsGoalServiceTestSuite) TestGetSavingsGoalProgress_Behind() {
+	ctx := context.Background()
+	suite.expectProgress(ctx, 300, -100)
+
+	progress, err := suite.service.GetSavingsGoalProgress(ctx, suite.workplaceID, "goal", 0, suite.userID)
+	suite.Require().NoError(err)
+	suite.True(progress.AverageMonthlyContribution.Equal(decimal.RequireFromString("66.67")))
+	suite.Require().NotNil(progress.ProjectedCompletionDate)
+	suite.Equal(progress.AsOf.AddDate(0, 90, 0), *progress.ProjectedCompletionDate)
+	suite.Equal(domain.GoalBehind, progress.Status)
+}
+
+func (suite *SavingsGoalServiceTestSuite) TestGetSavingsGoalProgress_InvalidWindow() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleReadOnly).Return(nil).Once()
+
+	progress, err := suite.service.GetSavingsGoalProgress(ctx, suite.workplaceID, "goal", 25, suite.userID)
+	suite.Require().ErrorIs(err, apperrors.ErrValidation)
+	suite.Nil(progress)
+	suite.mockGoalRepo.AssertNotCalled(suite.T(), "FindSavingsGoalByID", mock.Anything, mock.Anything)
+}
+
+func (suite *SavingsGoalServiceTestSuite) TestCreateSavingsGoal_Validation() {
+	ctx := context.Background()
+	target := time.Date(2027, time.December, 31, 0, 0, 0, 0, time.UTC)
+	cases := map[string][]string{
+		"liability account": {"savings", "mortgage"},
+		"mixed currencies":  {"savings", "euro"},
+		"duplicate account": {"savings", "savings"},
+	}
+	for name, accountIDs := range cases {
+		suite.Run(name, func() {
+			suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+			suite.mockAccountRepo.On("FindAccountsByIDs", ctx, accountIDs).Return(suite.accounts, nil).Once()
+
+			goal, err := suite.service.CreateSavingsGoal(ctx, suite.workplaceID, dto.CreateSavingsGoalRequest{
+				Name: "Emergency fund", TargetAmount: decimal.NewFromInt(10000), TargetDate: target, AccountIDs: accountIDs,
+			}, suite.userID)
+			suite.Require().ErrorIs(err, apperrors.ErrValidation)
+			suite.Nil(goal)
+		})
+	}
+	suite.mockGoalRepo.AssertNotCalled(suite.T(), "SaveSavingsGoal", mock.Anything, mock.Anything)
+}
+
+func TestSavingsGoalService(t *testing.T) {
+	suite.Run(t, new(SavingsGoalServiceTestSuite))
+}
@@ -33,8 +33,27 @@ func NewServiceContainer(cfg *config.Config, repos portsrepo.RepositoryProvider)
 	container.Currency = NewCurrencyService(repos.CurrencyRepo)
 	container.User = NewUserService(repos.UserRepo)
 	container.ExchangeRate = NewExchangeRateService(repos.ExchangeRateRepo, container.Currency)
-	container.Journal = NewJournalService(repos.JournalRepo, container.Account, container.Workplace)
-	container.Reporting = NewReportingService(repos.ReportingRepo, WithReportingWorkplaceAuthorizer(container.Workplace))
+	container.Price = NewPriceService(repos.PriceRepo, repos.CurrencyRepo, WithPriceProvider(repos.PriceProvider))
+	container.Journal = NewJournalService(repos.JournalRepo, container.Account, container.Workplace, WithJournalDuplicateDetection(repos.DuplicateJournalRepo, DefaultDuplicateWindowDays), WithJournalPayees(repos.PayeeRepo), WithJournalTaxCodes(repos.TaxCodeRepo, repos.CurrencyRepo), WithJournalDimensions(repos.DimensionRepo))
+	container.Reporting = NewReportingService(repos.ReportingRepo, WithReportingWorkplaceAuthorizer(container.Workplace), WithReportingDimensions(repos.DimensionRepo))
+	container.Budget = NewBudgetService(repos.BudgetRepo, repos.AccountRepo, repos.ReportingRepo, WithBudgetWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Envelope = NewEnvelopeService(repos.EnvelopeRepo, repos.AccountRepo, repos.ReportingRepo, WithEnvelopeWorkplaceAuthorizer(workplaceAuthorizer))
+	container.SavingsGoal = NewSavingsGoalService(repos.SavingsGoalRepo, repos.AccountRepo, repos.ReportingRepo, repos.CurrencyRepo, WithSavingsGoalWorkplaceAuthorizer(workplaceAuthorizer))
+	container.BankStatement = NewBankStatementService(repos.BankStatementRepo, repos.AccountRepo, container.Journal, WithBankStatementWorkplaceAuthorizer(workplaceAuthorizer), WithBankStatementCategorizationRules(repos.CategorizationRuleRepo), WithBankStatementPayees(repos.PayeeRepo))
+	container.CSVImport = NewCSVImportService(repos.CSVImportProfileRepo, repos.AccountRepo, repos.CurrencyRepo, repos.BankStatementRepo, container.BankStatement, WithCSVImportWorkplaceAuthorizer(workplaceAuthorizer), WithCSVImportCategorizationRules(repos.CategorizationRuleRepo))
+	container.Categorization = NewCategorizationService(repos.CategorizationRuleRepo, repos.AccountRepo, repos.BankStatementRepo, WithCategorizationWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Reconciliation = NewReconciliationService(repos.ReconciliationRepo, repos.AccountRepo, WithReconciliationWorkplaceAuthorizer(workplaceAuthorizer))
+	container.DuplicateJournal = NewDuplicateJournalService(repos.DuplicateJournalRepo, container.Journal, WithDuplicateJournalWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Payee = NewPayeeService(repos.PayeeRepo, repos.AccountRepo, WithPayeeWorkplaceAuthorizer(workplaceAuthorizer))
+	container.SharedExpense = NewSharedExpenseService(repos.SharedExpenseRepo, repos.AccountRepo, repos.CurrencyRepo, container.Journal, container.Workplace, WithSharedExpenseWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Investment = NewInvestmentService(repos.InvestmentRepo, repos.PriceRepo, repos.AccountRepo, repos.CurrencyRepo, container.Journal, WithInvestmentWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Loan = NewLoanService(repos.LoanRepo, repos.AccountRepo, repos.CurrencyRepo, container.Journal, WithLoanWorkplaceAuthorizer(workplaceAuthorizer))
+	container.CreditCard = NewCreditCardService(repos.CreditCardRepo, repos.AccountRepo, repos.CurrencyRepo, WithCreditCardWorkplaceAuthorizer(workplaceAuthorizer))
+	container.TaxCode = NewTaxCodeService(repos.TaxCodeRepo, repos.AccountRepo, WithTaxCodeWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Invoice = NewInvoiceService(repos.InvoiceRepo, repos.AccountRepo, repos.CurrencyRepo, repos.TaxCodeRepo, repos.PayeeRepo, container.Journal, WithInvoiceWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Bill = NewBillService(repos.BillRepo, repos.AccountRepo, repos.CurrencyRepo, repos.TaxCodeRepo, repos.PayeeRepo, container.Journal, WithBillWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Dimension = NewDimensionService(repos.DimensionRepo, WithDimensionWorkplaceAuthorizer(workplaceAuthorizer))
+	container.Consolidation = NewConsolidationService(repos.ConsolidationRepo, repos.AccountRepo, repos.CurrencyRepo, repos.ExchangeRateRepo, WithConsolidationWorkplaceAuthorizer(workplaceAuthorizer))
 
 	// Initialize TokenService
 	container.TokenService = NewTokenService(cfg, container.User)
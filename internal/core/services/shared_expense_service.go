@@ -0,0 +1,723 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// sharedExpenseService implements the SharedExpenseSvcFacade interface
+type sharedExpenseService struct {
+	BaseService
+	expenseRepo  portsrepo.SharedExpenseRepositoryFacade
+	accountRepo  portsrepo.AccountReader
+	currencyRepo portsrepo.CurrencyReader
+	journalSvc   portssvc.JournalSvcFacade
+	workplaceSvc portssvc.WorkplaceReaderSvc
+}
+
+// SharedExpenseServiceOption is a functional option for configuring the shared expense service
+type SharedExpenseServiceOption func(*sharedExpenseService)
+
+// WithSharedExpenseWorkplaceAuthorizer adds workplace authorizer dependency
+func WithSharedExpenseWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) SharedExpenseServiceOption {
+	return func(s *sharedExpenseService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewSharedExpenseService creates a new service for splitting expenses between workplace members. Shares and
+// repayments are posted through the journal service to per-member receivable and payable sub-accounts.
+func NewSharedExpenseService(expenseRepo portsrepo.SharedExpenseRepositoryFacade, accountRepo portsrepo.AccountReader, currencyRepo portsrepo.CurrencyReader, journalSvc portssvc.JournalSvcFacade, workplaceSvc portssvc.WorkplaceReaderSvc, options ...SharedExpenseServiceOption) portssvc.SharedExpenseSvcFacade {
+	svc := &sharedExpenseService{
+		expenseRepo:  expenseRepo,
+		accountRepo:  accountRepo,
+		currencyRepo: currencyRepo,
+		journalSvc:   journalSvc,
+		workplaceSvc: workplaceSvc,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure sharedExpenseService implements the SharedExpenseSvcFacade interface
+var _ portssvc.SharedExpenseSvcFacade = (*sharedExpenseService)(nil)
+
+var oneHundred = decimal.NewFromInt(100)
+
+// allocateShares divides total in proportion to the weights, rounded to the currency precision. The units lost
+// to rounding go to the largest remainders, ties to the earliest share, so the amounts always add up to total.
+func allocateShares(total decimal.Decimal, weights []decimal.Decimal, precision int32) []decimal.Decimal {
+	sumWeights := decimal.Zero
+	for _, weight := range weights {
+		sumWeights = sumWeights.Add(weight)
+	}
+
+	amounts := make([]decimal.Decimal, len(weights))
+	remainders := make([]decimal.Decimal, len(weights))
+	allocated := decimal.Zero
+	for i, weight := range weights {
+		exact := total.Mul(weight).Div(sumWeights)
+		amounts[i] = exact.RoundFloor(precision)
+		remainders[i] = exact.Sub(amounts[i])
+		allocated = allocated.Add(amounts[i])
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]].GreaterThan(remainders[order[b]]) })
+
+	unit := decimal.New(1, -precision)
+	for i := 0; allocated.LessThan(total); i = (i + 1) % len(order) {
+		amounts[order[i]] = amounts[order[i]].Add(unit)
+		allocated = allocated.Add(unit)
+	}
+	return amounts
+}
+
+// splitExpense computes the amount each member bears of total. Percentages must add up to 100 and exact
+// amounts to total.
+func splitExpense(total decimal.Decimal, method domain.SplitMethod, requested []dto.ExpenseShareRequest, precision int32) ([]domain.ExpenseShare, error) {
+	seen := make(map[string]bool)
+	sum := decimal.Zero
+	for _, share := range requested {
+		if seen[share.UserID] {
+			return nil, fmt.Errorf("%w: member %s is listed more than once", apperrors.ErrValidation, share.UserID)
+		}
+		seen[share.UserID] = true
+		if method != domain.SplitEqual && share.Value.IsNegative() {
+			return nil, fmt.Errorf("%w: share of member %s must not be negative", apperrors.ErrValidation, share.UserID)
+		}
+		sum = sum.Add(share.Value)
+	}
+
+	shares := make([]domain.ExpenseShare, len(requested))
+	weights := make([]decimal.Decimal, len(requested))
+	for i, share := range requested {
+		shares[i] = domain.ExpenseShare{UserID: share.UserID, Value: share.Value}
+		weights[i] = share.Value
+	}
+
+	switch method {
+	case domain.SplitEqual:
+		for i := range shares {
+			shares[i].Value = decimal.Zero
+			weights[i] = decimal.NewFromInt(1)
+		}
+	case domain.SplitPercentage:
+		if !sum.Equal(oneHundred) {
+			return nil, fmt.Errorf("%w: percentages add up to %s, not 100", apperrors.ErrValidation, sum)
+		}
+	case domain.SplitExact:
+		if !sum.Equal(total) {
+			return nil, fmt.Errorf("%w: exact shares add up to %s, not the expense amount %s", apperrors.ErrValidation, sum, total)
+		}
+		for i := range shares {
+			if !shares[i].Value.Equal(shares[i].Value.Round(precision)) {
+				return nil, fmt.Errorf("%w: share %s has more than %d decimal places", apperrors.ErrValidation, shares[i].Value, precision)
+			}
+			shares[i].Amount = shares[i].Value
+		}
+		return shares, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown split method %s", apperrors.ErrValidation, method)
+	}
+
+	for i, amount := range allocateShares(total, weights, precision) {
+		shares[i].Amount = amount
+	}
+	return shares, nil
+}
+
+// settlingDebts pairs the members who owe with the members who are owed, largest balances first, giving the
+// repayments that bring every net balance to zero in each currency
+func settlingDebts(balances []domain.MemberBalance) []domain.MemberDebt {
+	type position struct {
+		userID string
+		amount decimal.Decimal
+	}
+	creditors := make(map[string][]position)
+	debtors := make(map[string][]position)
+	currencies := []string{}
+	for _, balance := range balances {
+		if _, ok := creditors[balance.CurrencyCode]; !ok {
+			creditors[balance.CurrencyCode] = nil
+			currencies = append(currencies, balance.CurrencyCode)
+		}
+		if balance.Net.IsPositive() {
+			creditors[balance.CurrencyCode] = append(creditors[balance.CurrencyCode], position{balance.UserID, balance.Net})
+		} else if balance.Net.IsNegative() {
+			debtors[balance.CurrencyCode] = append(debtors[balance.CurrencyCode], position{balance.UserID, balance.Net.Neg()})
+		}
+	}
+	byAmount := func(positions []position) {
+		sort.SliceStable(positions, func(a, b int) bool {
+			if !positions[a].amount.Equal(positions[b].amount) {
+				return positions[a].amount.GreaterThan(positions[b].amount)
+			}
+			return positions[a].userID < positions[b].userID
+		})
+	}
+
+	debts := []domain.MemberDebt{}
+	sort.Strings(currencies)
+	for _, currency := range currencies {
+		owed, owing := creditors[currency], debtors[currency]
+		byAmount(owed)
+		byAmount(owing)
+		for i, j := 0, 0; i < len(owing) && j < len(owed); {
+			amount := decimal.Min(owing[i].amount, owed[j].amount)
+			debts = append(debts, domain.MemberDebt{
+				FromUserID:   owing[i].userID,
+				ToUserID:     owed[j].userID,
+				CurrencyCode: currency,
+				Amount:       amount,
+			})
+			owing[i].amount = owing[i].amount.Sub(amount)
+			owed[j].amount = owed[j].amount.Sub(amount)
+			if owing[i].amount.IsZero() {
+				i++
+			}
+			if owed[j].amount.IsZero() {
+				j++
+			}
+		}
+	}
+	return debts
+}
+
+// workplaceMembers returns the memberships of the workplace keyed by user ID, including removed members
+func (s *sharedExpenseService) workplaceMembers(ctx context.Context, workplaceID string, userID string) (map[string]domain.UserWorkplace, error) {
+	users, err := s.workplaceSvc.ListWorkplaceUsers(ctx, workplaceID, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list workplace members",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	members := make(map[string]domain.UserWorkplace, len(users))
+	for _, user := range users {
+		members[user.UserID] = user
+	}
+	return members, nil
+}
+
+// requireMember verifies that the user is a current member of the workplace
+func requireMember(members map[string]domain.UserWorkplace, userID string) error {
+	member, ok := members[userID]
+	if !ok || member.Role == domain.RoleRemoved {
+		return fmt.Errorf("%w: user %s is not a member of the workplace", apperrors.ErrValidation, userID)
+	}
+	return nil
+}
+
+// memberName returns the display name of a member, falling back to the user ID
+func memberName(members map[string]domain.UserWorkplace, userID string) string {
+	if name := strings.TrimSpace(members[userID].UserName); name != "" {
+		return name
+	}
+	return userID
+}
+
+// newMemberAccount builds an account created for the member ledger
+func newMemberAccount(workplaceID string, name string, accountType domain.AccountType, currencyCode string, parentID string, description string, now time.Time, userID string) domain.Account {
+	return domain.Account{
+		AccountID:       uuid.NewString(),
+		WorkplaceID:     workplaceID,
+		Name:            name,
+		AccountType:     accountType,
+		CurrencyCode:    currencyCode,
+		ParentAccountID: parentID,
+		Description:     description,
+		IsActive:        true,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+}
+
+// ensureMemberAccounts returns the member accounts of the users in the currency, creating the parent accounts
+// and any missing member sub-accounts first
+func (s *sharedExpenseService) ensureMemberAccounts(ctx context.Context, workplaceID string, currencyCode string, userIDs []string, members map[string]domain.UserWorkplace, userID string) (map[string]domain.MemberAccounts, error) {
+	load := func() (map[string]domain.MemberAccounts, error) {
+		all, err := s.expenseRepo.ListMemberAccounts(ctx, workplaceID)
+		if err != nil {
+			s.LogError(ctx, err, "Failed to list member accounts",
+				slog.String("workplace_id", workplaceID))
+			return nil, err
+		}
+		byUser := make(map[string]domain.MemberAccounts)
+		for _, entry := range all {
+			if entry.CurrencyCode == currencyCode {
+				byUser[entry.UserID] = entry
+			}
+		}
+		return byUser, nil
+	}
+	// create saves new member accounts; losing a race to a concurrent request is not an error
+	create := func(entry domain.MemberAccounts, accounts []domain.Account) error {
+		err := s.expenseRepo.SaveMemberAccounts(ctx, entry, accounts)
+		if err != nil && !errors.Is(err, apperrors.ErrDuplicate) {
+			s.LogError(ctx, err, "Failed to create member accounts",
+				slog.String("workplace_id", workplaceID),
+				slog.String("member_id", entry.UserID),
+				slog.String("currency_code", currencyCode))
+			return err
+		}
+		return nil
+	}
+
+	byUser, err := load()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	if _, ok := byUser[""]; !ok {
+		receivable := newMemberAccount(workplaceID, "Member receivables ("+currencyCode+")", domain.Asset, currencyCode, "",
+			"Amounts members owe for shared expenses", now, userID)
+		payable := newMemberAccount(workplaceID, "Member payables ("+currencyCode+")", domain.Liability, currencyCode, "",
+			"Amounts owed to members who paid shared expenses", now, userID)
+		entry := domain.MemberAccounts{WorkplaceID: workplaceID, CurrencyCode: currencyCode,
+			ReceivableAccountID: receivable.AccountID, PayableAccountID: payable.AccountID, CreatedAt: now, CreatedBy: userID}
+		if err := create(entry, []domain.Account{receivable, payable}); err != nil {
+			return nil, err
+		}
+		if byUser, err = load(); err != nil {
+			return nil, err
+		}
+	}
+	parent := byUser[""]
+
+	created := false
+	for _, memberID := range userIDs {
+		if _, ok := byUser[memberID]; ok {
+			continue
+		}
+		name := memberName(members, memberID)
+		receivable := newMemberAccount(workplaceID, "Due from "+name+" ("+currencyCode+")", domain.Asset, currencyCode,
+			parent.ReceivableAccountID, "What "+name+" owes for shared expenses", now, userID)
+		payable := newMemberAccount(workplaceID, "Due to "+name+" ("+currencyCode+")", domain.Liability, currencyCode,
+			parent.PayableAccountID, "What is owed to "+name+" for shared expenses", now, userID)
+		entry := domain.MemberAccounts{WorkplaceID: workplaceID, UserID: memberID, CurrencyCode: currencyCode,
+			ReceivableAccountID: receivable.AccountID, PayableAccountID: payable.AccountID, CreatedAt: now, CreatedBy: userID}
+		if err := create(entry, []domain.Account{receivable, payable}); err != nil {
+			return nil, err
+		}
+		created = true
+	}
+	if created {
+		if byUser, err = load(); err != nil {
+			return nil, err
+		}
+	}
+	return byUser, nil
+}
+
+// expenseAmount returns the net amount debited to EXPENSE accounts by the journal's lines
+func (s *sharedExpenseService) expenseAmount(ctx context.Context, journal *domain.Journal) (decimal.Decimal, error) {
+	accountIDs := make([]string, 0, len(journal.Transactions))
+	for _, txn := range journal.Transactions {
+		accountIDs = append(accountIDs, txn.AccountID)
+	}
+	accounts, err := s.accountRepo.FindAccountsByIDs(ctx, uniqueStrings(accountIDs))
+	if err != nil {
+		s.LogError(ctx, err, "Failed to load accounts of expense journal",
+			slog.String("journal_id", journal.JournalID))
+		return decimal.Zero, err
+	}
+
+	total := decimal.Zero
+	for _, txn := range journal.Transactions {
+		if accounts[txn.AccountID].AccountType != domain.Expense {
+			continue
+		}
+		if txn.TransactionType == domain.Debit {
+			total = total.Add(txn.Amount)
+		} else {
+			total = total.Sub(txn.Amount)
+		}
+	}
+	return total, nil
+}
+
+// findSharedExpense loads a shared expense and verifies that it belongs to the workplace
+func (s *sharedExpenseService) findSharedExpense(ctx context.Context, workplaceID string, sharedExpenseID string) (*domain.SharedExpense, error) {
+	expense, err := s.expenseRepo.FindSharedExpenseByID(ctx, sharedExpenseID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find shared expense by ID",
+			slog.String("shared_expense_id", sharedExpenseID))
+		return nil, fmt.Errorf("failed to find shared expense: %w", err)
+	}
+	if expense.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Shared expense found but belongs to different workplace",
+			slog.String("shared_expense_id", sharedExpenseID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return expense, nil
+}
+
+func (s *sharedExpenseService) ListSharedExpenses(ctx context.Context, workplaceID string, userID string) ([]domain.SharedExpense, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list shared expenses",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	expenses, err := s.expenseRepo.ListSharedExpenses(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list shared expenses",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	return expenses, nil
+}
+
+func (s *sharedExpenseService) GetSharedExpense(ctx context.Context, workplaceID string, sharedExpenseID string, userID string) (*domain.SharedExpense, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view shared expense",
+			slog.String("workplace_id", workplaceID),
+			slog.String("shared_expense_id", sharedExpenseID))
+		return nil, err
+	}
+	return s.findSharedExpense(ctx, workplaceID, sharedExpenseID)
+}
+
+func (s *sharedExpenseService) GetMemberBalances(ctx context.Context, workplaceID string, userID string) (*domain.MemberBalances, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view member balances",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	members, err := s.workplaceMembers(ctx, workplaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	memberAccounts, err := s.expenseRepo.ListMemberAccounts(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list member accounts",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	accountIDs := make([]string, 0, 2*len(memberAccounts))
+	for _, entry := range memberAccounts {
+		if entry.UserID != "" {
+			accountIDs = append(accountIDs, entry.ReceivableAccountID, entry.PayableAccountID)
+		}
+	}
+	accounts := map[string]domain.Account{}
+	if len(accountIDs) > 0 {
+		if accounts, err = s.accountRepo.FindAccountsByIDs(ctx, accountIDs); err != nil {
+			s.LogError(ctx, err, "Failed to load member accounts",
+				slog.String("workplace_id", workplaceID))
+			return nil, err
+		}
+	}
+
+	balances := make([]domain.MemberBalance, 0, len(memberAccounts))
+	for _, entry := range memberAccounts {
+		if entry.UserID == "" {
+			continue
+		}
+		receivable := accounts[entry.ReceivableAccountID].Balance
+		payable := accounts[entry.PayableAccountID].Balance
+		balances = append(balances, domain.MemberBalance{
+			UserID:       entry.UserID,
+			UserName:     memberName(members, entry.UserID),
+			CurrencyCode: entry.CurrencyCode,
+			Receivable:   receivable,
+			Payable:      payable,
+			Net:          payable.Sub(receivable),
+		})
+	}
+	sort.SliceStable(balances, func(i, j int) bool {
+		if balances[i].CurrencyCode != balances[j].CurrencyCode {
+			return balances[i].CurrencyCode < balances[j].CurrencyCode
+		}
+		return strings.ToLower(balances[i].UserName) < strings.ToLower(balances[j].UserName)
+	})
+
+	return &domain.MemberBalances{
+		Balances: balances,
+		Debts:    settlingDebts(balances),
+	}, nil
+}
+
+func (s *sharedExpenseService) ShareExpense(ctx context.Context, workplaceID string, req dto.ShareExpenseRequest, userID string) (*domain.SharedExpense, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to share expense",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	journal, err := s.journalSvc.GetJournalByID(ctx, workplaceID, req.JournalID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if journal.Status != domain.Posted || journal.OriginalJournalID != nil {
+		return nil, fmt.Errorf("%w: only posted, non-reversal journals can be shared", apperrors.ErrValidation)
+	}
+	if _, err := s.expenseRepo.FindSharedExpenseByJournalID(ctx, journal.JournalID); err == nil {
+		return nil, fmt.Errorf("%w: journal %s is already shared", apperrors.ErrConflict, journal.JournalID)
+	} else if !errors.Is(err, apperrors.ErrNotFound) {
+		s.LogError(ctx, err, "Failed to check whether journal is shared",
+			slog.String("journal_id", journal.JournalID))
+		return nil, err
+	}
+
+	total, err := s.expenseAmount(ctx, journal)
+	if err != nil {
+		return nil, err
+	}
+	if !total.IsPositive() {
+		return nil, fmt.Errorf("%w: journal %s has no expense to share", apperrors.ErrValidation, journal.JournalID)
+	}
+
+	shares, err := splitExpense(total, req.SplitMethod, req.Shares, currencyPrecision(ctx, s.currencyRepo, journal.CurrencyCode))
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.workplaceMembers(ctx, workplaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	payerID := req.PaidByUserID
+	if payerID == "" {
+		payerID = journal.CreatedBy
+	}
+	if err := requireMember(members, payerID); err != nil {
+		return nil, err
+	}
+
+	// The payer is owed the shares of every other member
+	owedToPayer := decimal.Zero
+	debtorIDs := []string{}
+	for _, share := range shares {
+		if err := requireMember(members, share.UserID); err != nil {
+			return nil, err
+		}
+		if share.UserID != payerID && share.Amount.IsPositive() {
+			owedToPayer = owedToPayer.Add(share.Amount)
+			debtorIDs = append(debtorIDs, share.UserID)
+		}
+	}
+	if owedToPayer.IsZero() {
+		return nil, fmt.Errorf("%w: no other member bears a share of the expense", apperrors.ErrValidation)
+	}
+
+	memberAccounts, err := s.ensureMemberAccounts(ctx, workplaceID, journal.CurrencyCode, append([]string{payerID}, debtorIDs...), members, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]dto.CreateTransactionRequest, 0, len(debtorIDs)+1)
+	for _, share := range shares {
+		if share.UserID == payerID || !share.Amount.IsPositive() {
+			continue
+		}
+		transactions = append(transactions, dto.CreateTransactionRequest{
+			AccountID:       memberAccounts[share.UserID].ReceivableAccountID,
+			Amount:          share.Amount,
+			TransactionType: domain.Debit,
+			Notes:           "Share of " + memberName(members, share.UserID),
+		})
+	}
+	transactions = append(transactions, dto.CreateTransactionRequest{
+		AccountID:       memberAccounts[payerID].PayableAccountID,
+		Amount:          owedToPayer,
+		TransactionType: domain.Credit,
+		Notes:           "Paid by " + memberName(members, payerID),
+	})
+
+	shareJournal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         journal.JournalDate,
+		Description:  strings.TrimSpace("Shared expense: " + journal.Description),
+		CurrencyCode: journal.CurrencyCode,
+		Transactions: transactions,
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post share journal",
+			slog.String("journal_id", journal.JournalID))
+		return nil, err
+	}
+
+	now := time.Now()
+	expense := domain.SharedExpense{
+		SharedExpenseID: uuid.NewString(),
+		WorkplaceID:     workplaceID,
+		JournalID:       journal.JournalID,
+		PaidByUserID:    payerID,
+		SplitMethod:     req.SplitMethod,
+		CurrencyCode:    journal.CurrencyCode,
+		TotalAmount:     total,
+		ShareJournalID:  shareJournal.JournalID,
+		Shares:          shares,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.expenseRepo.SaveSharedExpense(ctx, expense); err != nil {
+		s.LogError(ctx, err, "Failed to save shared expense, reversing share journal",
+			slog.String("journal_id", journal.JournalID),
+			slog.String("share_journal_id", shareJournal.JournalID))
+		if _, reverseErr := s.journalSvc.ReverseJournal(ctx, workplaceID, shareJournal.JournalID, userID); reverseErr != nil {
+			s.LogError(ctx, reverseErr, "Failed to reverse share journal",
+				slog.String("share_journal_id", shareJournal.JournalID))
+		}
+		if errors.Is(err, apperrors.ErrDuplicate) {
+			return nil, fmt.Errorf("%w: journal %s is already shared", apperrors.ErrConflict, journal.JournalID)
+		}
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Expense shared",
+		slog.String("shared_expense_id", expense.SharedExpenseID),
+		slog.String("journal_id", journal.JournalID),
+		slog.String("share_journal_id", shareJournal.JournalID))
+	return &expense, nil
+}
+
+func (s *sharedExpenseService) UnshareExpense(ctx context.Context, workplaceID string, sharedExpenseID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to unshare expense",
+			slog.String("workplace_id", workplaceID),
+			slog.String("shared_expense_id", sharedExpenseID))
+		return err
+	}
+
+	expense, err := s.findSharedExpense(ctx, workplaceID, sharedExpenseID)
+	if err != nil {
+		return err
+	}
+
+	shareJournal, err := s.journalSvc.GetJournalByID(ctx, workplaceID, expense.ShareJournalID, userID)
+	if err != nil {
+		return err
+	}
+	if shareJournal.Status == domain.Posted {
+		if _, err := s.journalSvc.ReverseJournal(ctx, workplaceID, expense.ShareJournalID, userID); err != nil {
+			s.LogError(ctx, err, "Failed to reverse share journal",
+				slog.String("shared_expense_id", sharedExpenseID),
+				slog.String("share_journal_id", expense.ShareJournalID))
+			return err
+		}
+	}
+
+	if err := s.expenseRepo.DeleteSharedExpense(ctx, sharedExpenseID); err != nil {
+		s.LogError(ctx, err, "Failed to delete shared expense",
+			slog.String("shared_expense_id", sharedExpenseID))
+		return err
+	}
+
+	s.LogInfo(ctx, "Expense unshared",
+		slog.String("shared_expense_id", sharedExpenseID))
+	return nil
+}
+
+func (s *sharedExpenseService) SettleUp(ctx context.Context, workplaceID string, req dto.SettleUpRequest, userID string) (*domain.Journal, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to settle up",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	if req.FromUserID == req.ToUserID {
+		return nil, fmt.Errorf("%w: a member cannot settle up with themselves", apperrors.ErrValidation)
+	}
+	if !req.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", apperrors.ErrValidation)
+	}
+	if precision := currencyPrecision(ctx, s.currencyRepo, req.CurrencyCode); !req.Amount.Equal(req.Amount.Round(precision)) {
+		return nil, fmt.Errorf("%w: amount %s has more than %d decimal places", apperrors.ErrValidation, req.Amount, precision)
+	}
+
+	members, err := s.workplaceMembers(ctx, workplaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireMember(members, req.FromUserID); err != nil {
+		return nil, err
+	}
+	if err := requireMember(members, req.ToUserID); err != nil {
+		return nil, err
+	}
+
+	memberAccounts, err := s.ensureMemberAccounts(ctx, workplaceID, req.CurrencyCode, []string{req.FromUserID, req.ToUserID}, members, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if req.Date != nil {
+		date = *req.Date
+	}
+	fromName, toName := memberName(members, req.FromUserID), memberName(members, req.ToUserID)
+	description := strings.TrimSpace(req.Description)
+	if description == "" {
+		description = "Settle up: " + fromName + " paid " + toName
+	}
+
+	// Repaying reduces both what the payer owes and what the payee is owed
+	journal, err := s.journalSvc.CreateJournal(ctx, workplaceID, dto.CreateJournalRequest{
+		Date:         date,
+		Description:  description,
+		CurrencyCode: req.CurrencyCode,
+		Transactions: []dto.CreateTransactionRequest{
+			{
+				AccountID:       memberAccounts[req.ToUserID].PayableAccountID,
+				Amount:          req.Amount,
+				TransactionType: domain.Debit,
+				Notes:           "Repaid to " + toName,
+			},
+			{
+				AccountID:       memberAccounts[req.FromUserID].ReceivableAccountID,
+				Amount:          req.Amount,
+				TransactionType: domain.Credit,
+				Notes:           "Repaid by " + fromName,
+			},
+		},
+	}, userID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to post settle-up journal",
+			slog.String("from_user_id", req.FromUserID),
+			slog.String("to_user_id", req.ToUserID))
+		return nil, err
+	}
+
+	s.LogInfo(ctx, "Members settled up",
+		slog.String("journal_id", journal.JournalID),
+		slog.String("from_user_id", req.FromUserID),
+		slog.String("to_user_id", req.ToUserID))
+	return journal, nil
+}
@@ -0,0 +1,366 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock SharedExpenseRepository ---
+type MockSharedExpenseRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.SharedExpenseRepositoryFacade = (*MockSharedExpenseRepository)(nil)
+
+func (m *MockSharedExpenseRepository) ListMemberAccounts(ctx context.Context, workplaceID string) ([]domain.MemberAccounts, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.MemberAccounts), args.Error(1)
+}
+
+func (m *MockSharedExpenseRepository) FindSharedExpenseByID(ctx context.Context, sharedExpenseID string) (*domain.SharedExpense, error) {
+	args := m.Called(ctx, sharedExpenseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SharedExpense), args.Error(1)
+}
+
+func (m *MockSharedExpenseRepository) FindSharedExpenseByJournalID(ctx context.Context, journalID string) (*domain.SharedExpense, error) {
+	args := m.Called(ctx, journalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SharedExpense), args.Error(1)
+}
+
+func (m *MockSharedExpenseRepository) ListSharedExpenses(ctx context.Context, workplaceID string) ([]domain.SharedExpense, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.SharedExpense), args.Error(1)
+}
+
+func (m *MockSharedExpenseRepository) SaveMemberAccounts(ctx context.Context, memberAccounts domain.MemberAccounts, accounts []domain.Account) error {
+	args := m.Called(ctx, memberAccounts, accounts)
+	return args.Error(0)
+}
+
+func (m *MockSharedExpenseRepository) SaveSharedExpense(ctx context.Context, expense domain.SharedExpense) error {
+	args := m.Called(ctx, expense)
+	return args.Error(0)
+}
+
+func (m *MockSharedExpenseRepository) DeleteSharedExpense(ctx context.Context, sharedExpenseID string) error {
+	args := m.Called(ctx, sharedExpenseID)
+	return args.Error(0)
+}
+
+// --- Mock JournalSvc ---
+type MockJournalSvc struct {
+	MockJournalWriterSvc
+}
+
+var _ portssvc.JournalSvcFacade = (*MockJournalSvc)(nil)
+
+func (m *MockJournalSvc) GetJournalByID(ctx context.Context, workplaceID string, journalID string, requestingUserID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, requestingUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
+
+func (m *MockJournalSvc) ListJournals(ctx context.Context, workplaceID string, userID string, params dto.ListJournalsParams) (*dto.ListJournalsResponse, error) {
+	args := m.Called(ctx, workplaceID, userID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListJournalsResponse), args.Error(1)
+}
+
+func (m *MockJournalSvc) ListTransactionsByAccount(ctx context.Context, workplaceID string, accountID string, userID string, params dto.ListTransactionsParams) (*dto.ListTransactionsResponse, error) {
+	args := m.Called(ctx, workplaceID, accountID, userID, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ListTransactionsResponse), args.Error(1)
+}
+
+// --- Test Suite Setup ---
+type SharedExpenseServiceTestSuite struct {
+	suite.Suite
+	mockExpenseRepo  *MockSharedExpenseRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockCurrencyRepo *MockCurrencyRepository
+	mockJournalSvc   *MockJournalSvc
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.SharedExpenseSvcFacade
+	workplaceID      string
+	alice            string
+	bob              string
+	carol            string
+}
+
+func (suite *SharedExpenseServiceTestSuite) SetupTest() {
+	suite.mockExpenseRepo = new(MockSharedExpenseRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockJournalSvc = new(MockJournalSvc)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewSharedExpenseService(suite.mockExpenseRepo, suite.mockAccountRepo, suite.mockCurrencyRepo,
+		suite.mockJournalSvc, suite.mockWorkplaceSvc, services.WithSharedExpenseWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.workplaceID = uuid.NewString()
+	suite.alice = "alice"
+	suite.bob = "bob"
+	suite.carol = "carol"
+
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", mock.Anything, suite.alice, suite.workplaceID, mock.Anything).Return(nil)
+	suite.mockWorkplaceSvc.On("ListWorkplaceUsers", mock.Anything, suite.workplaceID, suite.alice).Return([]domain.UserWorkplace{
+		{UserID: suite.alice, UserName: "Alice", WorkplaceID: suite.workplaceID, Role: domain.RoleAdmin},
+		{UserID: suite.bob, UserName: "Bob", WorkplaceID: suite.workplaceID, Role: domain.RoleMember},
+		{UserID: suite.carol, UserName: "Carol", WorkplaceID: suite.workplaceID, Role: domain.RoleMember},
+		{UserID: "dave", UserName: "Dave", WorkplaceID: suite.workplaceID, Role: domain.RoleRemoved},
+	}, nil)
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil)
+}
+
+func TestSharedExpenseService(t *testing.T) {
+	suite.Run(t, new(SharedExpenseServiceTestSuite))
+}
+
+// memberAccounts returns the USD parent accounts and the sub-accounts of the given members
+func (suite *SharedExpenseServiceTestSuite) memberAccounts(userIDs ...string) []domain.MemberAccounts {
+	entries := []domain.MemberAccounts{{WorkplaceID: suite.workplaceID, CurrencyCode: "USD", ReceivableAccountID: "recv", PayableAccountID: "pay"}}
+	for _, userID := range userIDs {
+		entries = append(entries, domain.MemberAccounts{WorkplaceID: suite.workplaceID, UserID: userID, CurrencyCode: "USD",
+			ReceivableAccountID: "recv-" + userID, PayableAccountID: "pay-" + userID})
+	}
+	return entries
+}
+
+// expectExpenseJournal mocks a posted journal by Alice debiting groceries and crediting her card by amount
+func (suite *SharedExpenseServiceTestSuite) expectExpenseJournal(ctx context.Context, amount int64) *domain.Journal {
+	journal := &domain.Journal{
+		JournalID:    uuid.NewString(),
+		WorkplaceID:  suite.workplaceID,
+		JournalDate:  time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		Description:  "Groceries",
+		CurrencyCode: "USD",
+		Status:       domain.Posted,
+		Transactions: []domain.Transaction{
+			{AccountID: "groceries", Amount: decimal.NewFromInt(amount), TransactionType: domain.Debit},
+			{AccountID: "card", Amount: decimal.NewFromInt(amount), TransactionType: domain.Credit},
+		},
+		AuditFields: domain.AuditFields{CreatedBy: suite.alice},
+	}
+	suite.mockJournalSvc.On("GetJournalByID", ctx, suite.workplaceID, journal.JournalID, suite.alice).Return(journal, nil).Once()
+	suite.mockExpenseRepo.On("FindSharedExpenseByJournalID", ctx, journal.JournalID).Return(nil, apperrors.ErrNotFound).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"groceries": {AccountID: "groceries", AccountType: domain.Expense},
+		"card":      {AccountID: "card", AccountType: domain.Liability},
+	}, nil).Once()
+	return journal
+}
+
+// expectedLine describes an expected transaction of a posted journal
+type expectedLine struct {
+	accountID string
+	amount    string
+	side      domain.TransactionType
+}
+
+// hasLines reports whether the journal request consists of exactly the expected lines, in order
+func hasLines(req dto.CreateJournalRequest, expected ...expectedLine) bool {
+	if len(req.Transactions) != len(expected) {
+		return false
+	}
+	for i, txn := range req.Transactions {
+		if txn.AccountID != expected[i].accountID || txn.TransactionType != expected[i].side ||
+			!txn.Amount.Equal(decimal.RequireFromString(expected[i].amount)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestShareExpense_EqualSplitPostsSharesOfOtherMembers() {
+	ctx := context.Background()
+	journal := suite.expectExpenseJournal(ctx, 100)
+	suite.mockExpenseRepo.On("ListMemberAccounts", ctx, suite.workplaceID).Return(suite.memberAccounts(suite.alice, suite.bob, suite.carol), nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(req dto.CreateJournalRequest) bool {
+		// 100 split three ways: the extra cent goes to the first member, the payer
+		return req.CurrencyCode == "USD" && req.Date.Equal(journal.JournalDate) && hasLines(req,
+			expectedLine{"recv-bob", "33.33", domain.Debit},
+			expectedLine{"recv-carol", "33.33", domain.Debit},
+			expectedLine{"pay-alice", "66.66", domain.Credit})
+	}), suite.alice).Return(&domain.Journal{JournalID: "share-journal"}, nil).Once()
+	suite.mockExpenseRepo.On("SaveSharedExpense", ctx, mock.MatchedBy(func(e domain.SharedExpense) bool {
+		return e.JournalID == journal.JournalID && e.ShareJournalID == "share-journal" && e.PaidByUserID == suite.alice &&
+			e.TotalAmount.Equal(decimal.NewFromInt(100)) && len(e.Shares) == 3 && e.Shares[0].Amount.Equal(decimal.RequireFromString("33.34"))
+	})).Return(nil).Once()
+
+	expense, err := suite.service.ShareExpense(ctx, suite.workplaceID, dto.ShareExpenseRequest{
+		JournalID:   journal.JournalID,
+		SplitMethod: domain.SplitEqual,
+		Shares:      []dto.ExpenseShareRequest{{UserID: suite.alice}, {UserID: suite.bob}, {UserID: suite.carol}},
+	}, suite.alice)
+
+	suite.Require().NoError(err)
+	suite.Equal("share-journal", expense.ShareJournalID)
+	suite.mockJournalSvc.AssertExpectations(suite.T())
+	suite.mockExpenseRepo.AssertExpectations(suite.T())
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestShareExpense_CreatesMissingMemberAccounts() {
+	ctx := context.Background()
+	journal := suite.expectExpenseJournal(ctx, 50)
+	suite.mockExpenseRepo.On("ListMemberAccounts", ctx, suite.workplaceID).Return([]domain.MemberAccounts{}, nil).Once()
+	suite.mockExpenseRepo.On("SaveMemberAccounts", ctx, mock.MatchedBy(func(e domain.MemberAccounts) bool { return e.UserID == "" }),
+		mock.MatchedBy(func(accounts []domain.Account) bool {
+			return len(accounts) == 2 && accounts[0].AccountType == domain.Asset && accounts[1].AccountType == domain.Liability &&
+				accounts[0].ParentAccountID == "" && accounts[0].CurrencyCode == "USD"
+		})).Return(nil).Once()
+	suite.mockExpenseRepo.On("ListMemberAccounts", ctx, suite.workplaceID).Return(suite.memberAccounts(), nil).Once()
+	for _, member := range []string{suite.alice, suite.bob} {
+		suite.mockExpenseRepo.On("SaveMemberAccounts", ctx, mock.MatchedBy(func(e domain.MemberAccounts) bool { return e.UserID == member }),
+			mock.MatchedBy(func(accounts []domain.Account) bool {
+				return len(accounts) == 2 && accounts[0].ParentAccountID == "recv" && accounts[1].ParentAccountID == "pay"
+			})).Return(nil).Once()
+	}
+	suite.mockExpenseRepo.On("ListMemberAccounts", ctx, suite.workplaceID).Return(suite.memberAccounts(suite.alice, suite.bob), nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(req dto.CreateJournalRequest) bool {
+		return hasLines(req, expectedLine{"recv-bob", "20", domain.Debit}, expectedLine{"pay-alice", "20", domain.Credit})
+	}), suite.alice).Return(&domain.Journal{JournalID: "share-journal"}, nil).Once()
+	suite.mockExpenseRepo.On("SaveSharedExpense", ctx, mock.Anything).Return(nil).Once()
+
+	_, err := suite.service.ShareExpense(ctx, suite.workplaceID, dto.ShareExpenseRequest{
+		JournalID:   journal.JournalID,
+		SplitMethod: domain.SplitPercentage,
+		Shares: []dto.ExpenseShareRequest{
+			{UserID: suite.alice, Value: decimal.NewFromInt(60)},
+			{UserID: suite.bob, Value: decimal.NewFromInt(40)},
+		},
+	}, suite.alice)
+
+	suite.Require().NoError(err)
+	suite.mockExpenseRepo.AssertExpectations(suite.T())
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestShareExpense_RejectsInvalidShares() {
+	ctx := context.Background()
+	cases := map[string][]dto.ExpenseShareRequest{
+		"percentages not adding up to 100": {
+			{UserID: suite.alice, Value: decimal.NewFromInt(50)},
+			{UserID: suite.bob, Value: decimal.NewFromInt(40)},
+		},
+		"removed member": {
+			{UserID: suite.alice, Value: decimal.NewFromInt(50)},
+			{UserID: "dave", Value: decimal.NewFromInt(50)},
+		},
+		"only the payer": {
+			{UserID: suite.alice, Value: decimal.NewFromInt(100)},
+		},
+	}
+	for name, shares := range cases {
+		journal := suite.expectExpenseJournal(ctx, 80)
+
+		_, err := suite.service.ShareExpense(ctx, suite.workplaceID, dto.ShareExpenseRequest{
+			JournalID:   journal.JournalID,
+			SplitMethod: domain.SplitPercentage,
+			Shares:      shares,
+		}, suite.alice)
+
+		suite.ErrorIs(err, apperrors.ErrValidation, name)
+	}
+	suite.mockJournalSvc.AssertNotCalled(suite.T(), "CreateJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestShareExpense_AlreadySharedConflicts() {
+	ctx := context.Background()
+	journal := &domain.Journal{JournalID: uuid.NewString(), WorkplaceID: suite.workplaceID, Status: domain.Posted}
+	suite.mockJournalSvc.On("GetJournalByID", ctx, suite.workplaceID, journal.JournalID, suite.alice).Return(journal, nil).Once()
+	suite.mockExpenseRepo.On("FindSharedExpenseByJournalID", ctx, journal.JournalID).Return(&domain.SharedExpense{JournalID: journal.JournalID}, nil).Once()
+
+	_, err := suite.service.ShareExpense(ctx, suite.workplaceID, dto.ShareExpenseRequest{
+		JournalID:   journal.JournalID,
+		SplitMethod: domain.SplitEqual,
+		Shares:      []dto.ExpenseShareRequest{{UserID: suite.alice}, {UserID: suite.bob}},
+	}, suite.alice)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestGetMemberBalances_SuggestsSettlingDebts() {
+	ctx := context.Background()
+	suite.mockExpenseRepo.On("ListMemberAccounts", ctx, suite.workplaceID).Return(suite.memberAccounts(suite.alice, suite.bob, suite.carol), nil).Once()
+	suite.mockAccountRepo.On("FindAccountsByIDs", ctx, mock.Anything).Return(map[string]domain.Account{
+		"recv-alice": {Balance: decimal.NewFromInt(10)},
+		"pay-alice":  {Balance: decimal.NewFromInt(70)},
+		"recv-bob":   {Balance: decimal.NewFromInt(40)},
+		"pay-bob":    {Balance: decimal.NewFromInt(15)},
+		"recv-carol": {Balance: decimal.NewFromInt(35)},
+		"pay-carol":  {Balance: decimal.Zero},
+	}, nil).Once()
+
+	balances, err := suite.service.GetMemberBalances(ctx, suite.workplaceID, suite.alice)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(balances.Balances, 3)
+	suite.Equal("Alice", balances.Balances[0].UserName)
+	suite.True(balances.Balances[0].Net.Equal(decimal.NewFromInt(60)))
+	suite.True(balances.Balances[1].Net.Equal(decimal.NewFromInt(-25)))
+	suite.True(balances.Balances[2].Net.Equal(decimal.NewFromInt(-35)))
+
+	suite.Require().Len(balances.Debts, 2)
+	suite.Equal(suite.carol, balances.Debts[0].FromUserID)
+	suite.Equal(suite.alice, balances.Debts[0].ToUserID)
+	suite.True(balances.Debts[0].Amount.Equal(decimal.NewFromInt(35)))
+	suite.Equal(suite.bob, balances.Debts[1].FromUserID)
+	suite.True(balances.Debts[1].Amount.Equal(decimal.NewFromInt(25)))
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestSettleUp_ClearsMemberAccounts() {
+	ctx := context.Background()
+	suite.mockExpenseRepo.On("ListMemberAccounts", ctx, suite.workplaceID).Return(suite.memberAccounts(suite.alice, suite.bob), nil).Once()
+	suite.mockJournalSvc.On("CreateJournal", ctx, suite.workplaceID, mock.MatchedBy(func(req dto.CreateJournalRequest) bool {
+		return req.Description == "Settle up: Bob paid Alice" &&
+			hasLines(req, expectedLine{"pay-alice", "25", domain.Debit}, expectedLine{"recv-bob", "25", domain.Credit})
+	}), suite.alice).Return(&domain.Journal{JournalID: "settle-journal"}, nil).Once()
+
+	journal, err := suite.service.SettleUp(ctx, suite.workplaceID, dto.SettleUpRequest{
+		FromUserID:   suite.bob,
+		ToUserID:     suite.alice,
+		Amount:       decimal.NewFromInt(25),
+		CurrencyCode: "USD",
+	}, suite.alice)
+
+	suite.Require().NoError(err)
+	suite.Equal("settle-journal", journal.JournalID)
+}
+
+func (suite *SharedExpenseServiceTestSuite) TestSettleUp_RejectsSameMember() {
+	_, err := suite.service.SettleUp(context.Background(), suite.workplaceID, dto.SettleUpRequest{
+		FromUserID:   suite.bob,
+		ToUserID:     suite.bob,
+		Amount:       decimal.NewFromInt(25),
+		CurrencyCode: "USD",
+	}, suite.alice)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+}
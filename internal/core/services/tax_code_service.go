@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// taxCodeService implements the TaxCodeSvcFacade interface
+type taxCodeService struct {
+	BaseService
+	taxCodeRepo portsrepo.TaxCodeRepositoryFacade
+	accountRepo portsrepo.AccountReader
+}
+
+// TaxCodeServiceOption is a functional option for configuring the tax code service
+type TaxCodeServiceOption func(*taxCodeService)
+
+// WithTaxCodeWorkplaceAuthorizer adds workplace authorizer dependency
+func WithTaxCodeWorkplaceAuthorizer(authorizer portssvc.WorkplaceAuthorizerSvc) TaxCodeServiceOption {
+	return func(s *taxCodeService) {
+		s.WorkplaceAuthorizer = authorizer
+	}
+}
+
+// NewTaxCodeService creates a new tax code service
+func NewTaxCodeService(taxCodeRepo portsrepo.TaxCodeRepositoryFacade, accountRepo portsrepo.AccountReader, options ...TaxCodeServiceOption) portssvc.TaxCodeSvcFacade {
+	svc := &taxCodeService{
+		taxCodeRepo: taxCodeRepo,
+		accountRepo: accountRepo,
+	}
+
+	for _, option := range options {
+		option(svc)
+	}
+
+	return svc
+}
+
+// Ensure taxCodeService implements the TaxCodeSvcFacade interface
+var _ portssvc.TaxCodeSvcFacade = (*taxCodeService)(nil)
+
+// findTaxCode loads a tax code and verifies that it belongs to the workplace
+func (s *taxCodeService) findTaxCode(ctx context.Context, workplaceID string, taxCodeID string) (*domain.TaxCode, error) {
+	taxCode, err := s.taxCodeRepo.FindTaxCodeByID(ctx, taxCodeID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to find tax code by ID",
+			slog.String("tax_code_id", taxCodeID))
+		return nil, fmt.Errorf("failed to find tax code: %w", err)
+	}
+	if taxCode.WorkplaceID != workplaceID {
+		s.LogDebug(ctx, "Tax code found but belongs to different workplace",
+			slog.String("tax_code_id", taxCodeID),
+			slog.String("requested_workplace", workplaceID))
+		return nil, apperrors.ErrNotFound
+	}
+	return taxCode, nil
+}
+
+// buildTaxCode validates a tax code request and applies it to the tax code. Tax is posted to balance-sheet
+// accounts only: a LIABILITY for tax collected or an ASSET for tax reclaimable.
+func (s *taxCodeService) buildTaxCode(ctx context.Context, workplaceID string, taxCode *domain.TaxCode, req dto.TaxCodeRequest) error {
+	taxCode.Code = strings.ToUpper(strings.TrimSpace(req.Code))
+	if taxCode.Code == "" {
+		return fmt.Errorf("%w: tax code cannot be empty", apperrors.ErrValidation)
+	}
+	taxCode.Name = strings.TrimSpace(req.Name)
+	if taxCode.Name == "" {
+		return fmt.Errorf("%w: tax code name cannot be empty", apperrors.ErrValidation)
+	}
+	if req.Rate.IsNegative() || req.Rate.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w: tax rate must be between 0 and 100 percent", apperrors.ErrValidation)
+	}
+	taxCode.Rate = req.Rate
+	taxCode.Inclusive = req.Inclusive
+	taxCode.IsActive = req.IsActive == nil || *req.IsActive
+
+	account, err := s.accountRepo.FindAccountByID(ctx, req.TaxAccountID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return fmt.Errorf("%w: tax account %s not found in workplace", apperrors.ErrValidation, req.TaxAccountID)
+		}
+		return fmt.Errorf("failed to load tax account: %w", err)
+	}
+	if account.WorkplaceID != workplaceID {
+		return fmt.Errorf("%w: tax account %s not found in workplace", apperrors.ErrValidation, req.TaxAccountID)
+	}
+	if account.AccountType != domain.Liability && account.AccountType != domain.Asset {
+		return fmt.Errorf("%w: tax account must be a LIABILITY or ASSET account", apperrors.ErrValidation)
+	}
+	if !account.IsActive {
+		return fmt.Errorf("%w: tax account %s is inactive", apperrors.ErrValidation, account.Name)
+	}
+	taxCode.TaxAccountID = account.AccountID
+	return nil
+}
+
+// saveTaxCodeError translates repository errors raised when saving a tax code
+func saveTaxCodeError(err error) error {
+	if errors.Is(err, apperrors.ErrDuplicate) {
+		return fmt.Errorf("%w: a tax code with this code already exists", apperrors.ErrConflict)
+	}
+	return err
+}
+
+func (s *taxCodeService) CreateTaxCode(ctx context.Context, workplaceID string, req dto.TaxCodeRequest, userID string) (*domain.TaxCode, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to create tax code",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	now := time.Now()
+	taxCode := domain.TaxCode{
+		TaxCodeID:   uuid.NewString(),
+		WorkplaceID: workplaceID,
+		AuditFields: domain.AuditFields{
+			CreatedAt:     now,
+			CreatedBy:     userID,
+			LastUpdatedAt: now,
+			LastUpdatedBy: userID,
+		},
+	}
+	if err := s.buildTaxCode(ctx, workplaceID, &taxCode, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.taxCodeRepo.SaveTaxCode(ctx, taxCode); err != nil {
+		s.LogError(ctx, err, "Failed to save tax code",
+			slog.String("tax_code_id", taxCode.TaxCodeID),
+			slog.String("workplace_id", workplaceID))
+		return nil, saveTaxCodeError(err)
+	}
+
+	s.LogInfo(ctx, "Tax code created successfully",
+		slog.String("tax_code_id", taxCode.TaxCodeID),
+		slog.String("workplace_id", workplaceID))
+	return &taxCode, nil
+}
+
+func (s *taxCodeService) ListTaxCodes(ctx context.Context, workplaceID string, params dto.ListTaxCodesParams, userID string) ([]domain.TaxCode, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to list tax codes",
+			slog.String("user_id", userID),
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+
+	taxCodes, err := s.taxCodeRepo.ListTaxCodes(ctx, workplaceID)
+	if err != nil {
+		s.LogError(ctx, err, "Failed to list tax codes",
+			slog.String("workplace_id", workplaceID))
+		return nil, err
+	}
+	if params.IncludeInactive {
+		return taxCodes, nil
+	}
+
+	active := make([]domain.TaxCode, 0, len(taxCodes))
+	for _, taxCode := range taxCodes {
+		if taxCode.IsActive {
+			active = append(active, taxCode)
+		}
+	}
+	return active, nil
+}
+
+func (s *taxCodeService) GetTaxCode(ctx context.Context, workplaceID string, taxCodeID string, userID string) (*domain.TaxCode, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleReadOnly); err != nil {
+		s.LogError(ctx, err, "User not authorized to view tax code",
+			slog.String("workplace_id", workplaceID),
+			slog.String("tax_code_id", taxCodeID))
+		return nil, err
+	}
+	return s.findTaxCode(ctx, workplaceID, taxCodeID)
+}
+
+func (s *taxCodeService) UpdateTaxCode(ctx context.Context, workplaceID string, taxCodeID string, req dto.TaxCodeRequest, userID string) (*domain.TaxCode, error) {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to update tax code",
+			slog.String("workplace_id", workplaceID),
+			slog.String("tax_code_id", taxCodeID))
+		return nil, err
+	}
+
+	taxCode, err := s.findTaxCode(ctx, workplaceID, taxCodeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.buildTaxCode(ctx, workplaceID, taxCode, req); err != nil {
+		return nil, err
+	}
+	taxCode.LastUpdatedAt = time.Now()
+	taxCode.LastUpdatedBy = userID
+
+	if err := s.taxCodeRepo.UpdateTaxCode(ctx, *taxCode); err != nil {
+		s.LogError(ctx, err, "Failed to update tax code",
+			slog.String("tax_code_id", taxCodeID))
+		return nil, saveTaxCodeError(err)
+	}
+
+	s.LogInfo(ctx, "Tax code updated successfully",
+		slog.String("tax_code_id", taxCodeID),
+		slog.String("workplace_id", workplaceID))
+	return taxCode, nil
+}
+
+func (s *taxCodeService) DeleteTaxCode(ctx context.Context, workplaceID string, taxCodeID string, userID string) error {
+	if err := s.AuthorizeUser(ctx, userID, workplaceID, domain.RoleMember); err != nil {
+		s.LogError(ctx, err, "User not authorized to delete tax code",
+			slog.String("workplace_id", workplaceID),
+			slog.String("tax_code_id", taxCodeID))
+		return err
+	}
+
+	if _, err := s.findTaxCode(ctx, workplaceID, taxCodeID); err != nil {
+		return err
+	}
+	if err := s.taxCodeRepo.DeleteTaxCode(ctx, taxCodeID); err != nil {
+		s.LogError(ctx, err, "Failed to delete tax code",
+			slog.String("tax_code_id", taxCodeID))
+		if errors.Is(err, apperrors.ErrConflict) {
+			return fmt.Errorf("%w: tax code is used by transactions; deactivate it instead", apperrors.ErrConflict)
+		}
+		return err
+	}
+
+	s.LogInfo(ctx, "Tax code deleted successfully",
+		slog.String("tax_code_id", taxCodeID),
+		slog.String("workplace_id", workplaceID))
+	return nil
+}
@@ -0,0 +1,314 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/core/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// --- Mock TaxCodeRepository ---
+type MockTaxCodeRepository struct {
+	mock.Mock
+}
+
+var _ portsrepo.TaxCodeRepositoryFacade = (*MockTaxCodeRepository)(nil)
+
+func (m *MockTaxCodeRepository) FindTaxCodeByID(ctx context.Context, taxCodeID string) (*domain.TaxCode, error) {
+	args := m.Called(ctx, taxCodeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TaxCode), args.Error(1)
+}
+
+func (m *MockTaxCodeRepository) FindTaxCodesByIDs(ctx context.Context, taxCodeIDs []string) (map[string]domain.TaxCode, error) {
+	args := m.Called(ctx, taxCodeIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]domain.TaxCode), args.Error(1)
+}
+
+func (m *MockTaxCodeRepository) ListTaxCodes(ctx context.Context, workplaceID string) ([]domain.TaxCode, error) {
+	args := m.Called(ctx, workplaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.TaxCode), args.Error(1)
+}
+
+func (m *MockTaxCodeRepository) SaveTaxCode(ctx context.Context, taxCode domain.TaxCode) error {
+	args := m.Called(ctx, taxCode)
+	return args.Error(0)
+}
+
+func (m *MockTaxCodeRepository) UpdateTaxCode(ctx context.Context, taxCode domain.TaxCode) error {
+	args := m.Called(ctx, taxCode)
+	return args.Error(0)
+}
+
+func (m *MockTaxCodeRepository) DeleteTaxCode(ctx context.Context, taxCodeID string) error {
+	args := m.Called(ctx, taxCodeID)
+	return args.Error(0)
+}
+
+// --- Test Suite Setup ---
+type TaxCodeServiceTestSuite struct {
+	suite.Suite
+	mockTaxCodeRepo  *MockTaxCodeRepository
+	mockAccountRepo  *MockAccountRepositoryFacade
+	mockCurrencyRepo *MockCurrencyRepository
+	mockJournalRepo  *MockJournalRepository
+	mockAccountSvc   *MockAccountService2
+	mockWorkplaceSvc *MockWorkplaceService
+	service          portssvc.TaxCodeSvcFacade
+	journalService   portssvc.JournalSvcFacade
+	workplaceID      string
+	userID           string
+	bankAccount      domain.Account
+	revenueAccount   domain.Account
+	expenseAccount   domain.Account
+	vatAccount       domain.Account
+}
+
+func (suite *TaxCodeServiceTestSuite) SetupTest() {
+	suite.mockTaxCodeRepo = new(MockTaxCodeRepository)
+	suite.mockAccountRepo = new(MockAccountRepositoryFacade)
+	suite.mockCurrencyRepo = new(MockCurrencyRepository)
+	suite.mockJournalRepo = new(MockJournalRepository)
+	suite.mockAccountSvc = new(MockAccountService2)
+	suite.mockWorkplaceSvc = new(MockWorkplaceService)
+	suite.service = services.NewTaxCodeService(suite.mockTaxCodeRepo, suite.mockAccountRepo,
+		services.WithTaxCodeWorkplaceAuthorizer(suite.mockWorkplaceSvc))
+	suite.journalService = services.NewJournalService(suite.mockJournalRepo, suite.mockAccountSvc, suite.mockWorkplaceSvc,
+		services.WithJournalTaxCodes(suite.mockTaxCodeRepo, suite.mockCurrencyRepo))
+	suite.workplaceID = uuid.NewString()
+	suite.userID = uuid.NewString()
+	suite.bankAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Asset, CurrencyCode: "USD", IsActive: true}
+	suite.revenueAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Revenue, CurrencyCode: "USD", IsActive: true}
+	suite.expenseAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, AccountType: domain.Expense, CurrencyCode: "USD", IsActive: true}
+	suite.vatAccount = domain.Account{AccountID: uuid.NewString(), WorkplaceID: suite.workplaceID, Name: "VAT", AccountType: domain.Liability, CurrencyCode: "USD", IsActive: true}
+	suite.mockCurrencyRepo.On("FindCurrencyByCode", mock.Anything, "USD").Return(&domain.Currency{CurrencyCode: "USD", Precision: 2}, nil).Maybe()
+}
+
+func TestTaxCodeService(t *testing.T) {
+	suite.Run(t, new(TaxCodeServiceTestSuite))
+}
+
+// taxCode returns an active 20% code of the workplace posting to the VAT account
+func (suite *TaxCodeServiceTestSuite) taxCode(inclusive bool) domain.TaxCode {
+	return domain.TaxCode{
+		TaxCodeID:    uuid.NewString(),
+		WorkplaceID:  suite.workplaceID,
+		Code:         "VAT20",
+		Name:         "Standard rate",
+		Rate:         decimal.NewFromInt(20),
+		TaxAccountID: suite.vatAccount.AccountID,
+		Inclusive:    inclusive,
+		IsActive:     true,
+	}
+}
+
+func (suite *TaxCodeServiceTestSuite) TestSplit_InclusiveAndExclusive() {
+	inclusive := suite.taxCode(true)
+	net, tax := inclusive.Split(decimal.NewFromInt(120), 2)
+	suite.True(net.Equal(decimal.NewFromInt(100)))
+	suite.True(tax.Equal(decimal.NewFromInt(20)))
+
+	exclusive := suite.taxCode(false)
+	net, tax = exclusive.Split(decimal.NewFromInt(120), 2)
+	suite.True(net.Equal(decimal.NewFromInt(120)))
+	suite.True(tax.Equal(decimal.NewFromInt(24)))
+
+	inclusive.Rate = decimal.RequireFromString("7.5")
+	net, tax = inclusive.Split(decimal.NewFromInt(10), 2)
+	suite.Equal("0.7", tax.String(), "10 x 7.5/107.5 = 0.6977 rounds to 0.70")
+	suite.Equal("9.3", net.String(), "net and tax add up to the gross amount")
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateTaxCode_NormalizesCode() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.vatAccount.AccountID).Return(&suite.vatAccount, nil).Once()
+	suite.mockTaxCodeRepo.On("SaveTaxCode", ctx, mock.MatchedBy(func(t domain.TaxCode) bool {
+		return t.Code == "GST5" && t.Rate.Equal(decimal.NewFromInt(5)) && t.IsActive && t.Inclusive
+	})).Return(nil).Once()
+
+	taxCode, err := suite.service.CreateTaxCode(ctx, suite.workplaceID, dto.TaxCodeRequest{
+		Code: " gst5 ", Name: "GST", Rate: decimal.NewFromInt(5), TaxAccountID: suite.vatAccount.AccountID, Inclusive: true,
+	}, suite.userID)
+
+	suite.Require().NoError(err)
+	suite.Equal("GST5", taxCode.Code)
+	suite.mockTaxCodeRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateTaxCode_Validation() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil)
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.revenueAccount.AccountID).Return(&suite.revenueAccount, nil).Once()
+
+	_, err := suite.service.CreateTaxCode(ctx, suite.workplaceID, dto.TaxCodeRequest{
+		Code: "VAT", Name: "VAT", Rate: decimal.NewFromInt(101), TaxAccountID: suite.vatAccount.AccountID,
+	}, suite.userID)
+	suite.ErrorIs(err, apperrors.ErrValidation, "rates above 100 percent are rejected")
+
+	_, err = suite.service.CreateTaxCode(ctx, suite.workplaceID, dto.TaxCodeRequest{
+		Code: "VAT", Name: "VAT", Rate: decimal.NewFromInt(20), TaxAccountID: suite.revenueAccount.AccountID,
+	}, suite.userID)
+	suite.ErrorIs(err, apperrors.ErrValidation, "tax is posted to balance-sheet accounts only")
+
+	suite.mockTaxCodeRepo.AssertNotCalled(suite.T(), "SaveTaxCode", mock.Anything, mock.Anything)
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateTaxCode_DuplicateCodeConflicts() {
+	ctx := context.Background()
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountRepo.On("FindAccountByID", ctx, suite.vatAccount.AccountID).Return(&suite.vatAccount, nil).Once()
+	suite.mockTaxCodeRepo.On("SaveTaxCode", ctx, mock.Anything).Return(apperrors.ErrDuplicate).Once()
+
+	_, err := suite.service.CreateTaxCode(ctx, suite.workplaceID, dto.TaxCodeRequest{
+		Code: "VAT20", Name: "VAT", Rate: decimal.NewFromInt(20), TaxAccountID: suite.vatAccount.AccountID,
+	}, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+func (suite *TaxCodeServiceTestSuite) TestDeleteTaxCode_UsedCodeConflicts() {
+	ctx := context.Background()
+	taxCode := suite.taxCode(false)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockTaxCodeRepo.On("FindTaxCodeByID", ctx, taxCode.TaxCodeID).Return(&taxCode, nil).Once()
+	suite.mockTaxCodeRepo.On("DeleteTaxCode", ctx, taxCode.TaxCodeID).Return(apperrors.ErrConflict).Once()
+
+	err := suite.service.DeleteTaxCode(ctx, suite.workplaceID, taxCode.TaxCodeID, suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrConflict)
+}
+
+// expectCreateJournal mocks the authorization and account lookup of a CreateJournal call
+func (suite *TaxCodeServiceTestSuite) expectCreateJournal(ctx context.Context) {
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockAccountSvc.On("GetAccountByIDs", ctx, suite.workplaceID, mock.Anything, suite.userID).Return(map[string]domain.Account{
+		suite.bankAccount.AccountID:    suite.bankAccount,
+		suite.revenueAccount.AccountID: suite.revenueAccount,
+		suite.expenseAccount.AccountID: suite.expenseAccount,
+		suite.vatAccount.AccountID:     suite.vatAccount,
+	}, nil).Once()
+}
+
+// taxedRequest returns a journal between the bank and another account where the other line names a tax code
+func (suite *TaxCodeServiceTestSuite) taxedRequest(bankSide domain.TransactionType, bankAmount int64, accountID string, amount int64, taxCodeID string) dto.CreateJournalRequest {
+	otherSide := domain.Credit
+	if bankSide == domain.Credit {
+		otherSide = domain.Debit
+	}
+	return dto.CreateJournalRequest{
+		Date:         time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC),
+		Description:  "Taxed journal",
+		CurrencyCode: "USD",
+		Transactions: []dto.CreateTransactionRequest{
+			{AccountID: suite.bankAccount.AccountID, Amount: decimal.NewFromInt(bankAmount), TransactionType: bankSide},
+			{AccountID: accountID, Amount: decimal.NewFromInt(amount), TransactionType: otherSide, TaxCodeID: taxCodeID},
+		},
+	}
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateJournal_InclusiveSaleSplitsGross() {
+	ctx := context.Background()
+	taxCode := suite.taxCode(true)
+	suite.expectCreateJournal(ctx)
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{taxCode.TaxCodeID}).
+		Return(map[string]domain.TaxCode{taxCode.TaxCodeID: taxCode}, nil).Once()
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.Anything, mock.MatchedBy(func(txns []domain.Transaction) bool {
+		return len(txns) == 3 &&
+			txns[1].AccountID == suite.revenueAccount.AccountID && txns[1].Amount.Equal(decimal.NewFromInt(100)) &&
+			txns[1].TaxRole == domain.TaxRoleNet && txns[1].TaxCodeID == taxCode.TaxCodeID &&
+			txns[2].AccountID == suite.vatAccount.AccountID && txns[2].Amount.Equal(decimal.NewFromInt(20)) &&
+			txns[2].TransactionType == domain.Credit && txns[2].TaxRole == domain.TaxRoleTax && txns[2].TaxCodeID == taxCode.TaxCodeID
+	}), mock.MatchedBy(func(changes map[string]decimal.Decimal) bool {
+		return changes[suite.vatAccount.AccountID].Equal(decimal.NewFromInt(20))
+	})).Return(nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taxedRequest(domain.Debit, 120, suite.revenueAccount.AccountID, 120, taxCode.TaxCodeID), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.mockJournalRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateJournal_ExclusivePurchaseAddsTax() {
+	ctx := context.Background()
+	taxCode := suite.taxCode(false)
+	suite.expectCreateJournal(ctx)
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{taxCode.TaxCodeID}).
+		Return(map[string]domain.TaxCode{taxCode.TaxCodeID: taxCode}, nil).Once()
+	suite.mockJournalRepo.On("SaveJournal", ctx, mock.Anything, mock.MatchedBy(func(txns []domain.Transaction) bool {
+		return len(txns) == 3 &&
+			txns[1].Amount.Equal(decimal.NewFromInt(100)) && txns[1].TaxRole == domain.TaxRoleNet &&
+			txns[2].AccountID == suite.vatAccount.AccountID && txns[2].Amount.Equal(decimal.NewFromInt(20)) &&
+			txns[2].TransactionType == domain.Debit
+	}), mock.Anything).Return(nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taxedRequest(domain.Credit, 120, suite.expenseAccount.AccountID, 100, taxCode.TaxCodeID), suite.userID)
+
+	suite.Require().NoError(err)
+	suite.mockJournalRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateJournal_ExclusiveTaxMustBeFunded() {
+	ctx := context.Background()
+	taxCode := suite.taxCode(false)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{taxCode.TaxCodeID}).
+		Return(map[string]domain.TaxCode{taxCode.TaxCodeID: taxCode}, nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taxedRequest(domain.Credit, 100, suite.expenseAccount.AccountID, 100, taxCode.TaxCodeID), suite.userID)
+
+	suite.ErrorIs(err, services.ErrJournalUnbalanced, "the other side has to include the tax added on top")
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateJournal_ExclusiveTaxMustBeFundedWithRoundedTax() {
+	ctx := context.Background()
+	taxCode := suite.taxCode(false)
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{taxCode.TaxCodeID}).
+		Return(map[string]domain.TaxCode{taxCode.TaxCodeID: taxCode}, nil).Once()
+	req := suite.taxedRequest(domain.Credit, 0, suite.expenseAccount.AccountID, 0, taxCode.TaxCodeID)
+	req.Transactions[0].Amount = decimal.RequireFromString("39.99")
+	req.Transactions[1].Amount = decimal.RequireFromString("33.33")
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID, req, suite.userID)
+
+	suite.ErrorIs(err, services.ErrJournalUnbalanced, "33.33 x 20% = 6.666 rounds to 6.67, so the other side has to be 40.00")
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *TaxCodeServiceTestSuite) TestCreateJournal_RejectsInactiveTaxCode() {
+	ctx := context.Background()
+	taxCode := suite.taxCode(true)
+	taxCode.IsActive = false
+	suite.mockWorkplaceSvc.On("AuthorizeUserAction", ctx, suite.userID, suite.workplaceID, domain.RoleMember).Return(nil).Once()
+	suite.mockTaxCodeRepo.On("FindTaxCodesByIDs", ctx, []string{taxCode.TaxCodeID}).
+		Return(map[string]domain.TaxCode{taxCode.TaxCodeID: taxCode}, nil).Once()
+
+	_, err := suite.journalService.CreateJournal(ctx, suite.workplaceID,
+		suite.taxedRequest(domain.Debit, 120, suite.revenueAccount.AccountID, 120, taxCode.TaxCodeID), suite.userID)
+
+	suite.ErrorIs(err, apperrors.ErrValidation)
+	suite.mockJournalRepo.AssertNotCalled(suite.T(), "SaveJournal", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
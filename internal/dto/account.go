@@ -15,6 +15,8 @@ type CreateAccountRequest struct {
 	CurrencyCode    string             `json:"currencyCode" binding:"required,iso4217"`
 	Description     string             `json:"description"`
 	ParentAccountID *string            `json:"parentAccountID,omitempty" binding:"omitempty,uuid"` // Optional, must be UUID if provided
+	// CashFlowCategory classifies the account for the cash flow statement (optional)
+	CashFlowCategory domain.CashFlowCategory `json:"cashFlowCategory,omitempty" binding:"omitempty,oneof=CASH OPERATING INVESTING FINANCING"`
 	// UserID is extracted from the context, not part of the request body
 	// UserID string `json:"userID" binding:"required"` // Removed from here
 }
@@ -36,7 +38,8 @@ type AccountResponse struct {
 	LastUpdatedAt   time.Time          `json:"lastUpdatedAt"`
 	LastUpdatedBy   string             `json:"lastUpdatedBy"` // UserID
 	// Balance is not typically included directly; might be a separate endpoint or calculation
-	Balance decimal.Decimal `json:"balance"`
+	Balance          decimal.Decimal         `json:"balance"`
+	CashFlowCategory domain.CashFlowCategory `json:"cashFlowCategory,omitempty"`
 }
 
 // UpdateAccountRequest defines the data allowed for updating an account.
@@ -46,26 +49,29 @@ type UpdateAccountRequest struct {
 	Description *string `json:"description,omitempty"`
 	IsActive    *bool   `json:"isActive,omitempty"`
 	CFID        *string `json:"cfid,omitempty"`
+	// CashFlowCategory changes the cash flow classification; an empty string clears it.
+	CashFlowCategory *domain.CashFlowCategory `json:"cashFlowCategory,omitempty" binding:"omitempty,oneof=CASH OPERATING INVESTING FINANCING ''"`
 	// Note: AccountType, CurrencyCode, ParentAccountID are usually not updatable.
 }
 
 // ToAccountResponse converts a domain.Account to AccountResponse DTO
 func ToAccountResponse(acc *domain.Account) AccountResponse {
 	return AccountResponse{
-		AccountID:       acc.AccountID,
-		WorkplaceID:     acc.WorkplaceID,
-		CFID:            acc.CFID,
-		Name:            acc.Name,
-		AccountType:     acc.AccountType,
-		CurrencyCode:    acc.CurrencyCode,
-		ParentAccountID: acc.ParentAccountID,
-		Description:     acc.Description,
-		IsActive:        acc.IsActive,
-		CreatedAt:       acc.CreatedAt,
-		CreatedBy:       acc.CreatedBy,
-		LastUpdatedAt:   acc.LastUpdatedAt,
-		LastUpdatedBy:   acc.LastUpdatedBy,
-		Balance:         acc.Balance,
+		AccountID:        acc.AccountID,
+		WorkplaceID:      acc.WorkplaceID,
+		CFID:             acc.CFID,
+		Name:             acc.Name,
+		AccountType:      acc.AccountType,
+		CurrencyCode:     acc.CurrencyCode,
+		ParentAccountID:  acc.ParentAccountID,
+		Description:      acc.Description,
+		IsActive:         acc.IsActive,
+		CreatedAt:        acc.CreatedAt,
+		CreatedBy:        acc.CreatedBy,
+		LastUpdatedAt:    acc.LastUpdatedAt,
+		LastUpdatedBy:    acc.LastUpdatedBy,
+		Balance:          acc.Balance,
+		CashFlowCategory: acc.CashFlowCategory,
 	}
 }
 
@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Aging DTOs ---
+
+// AgingParams defines query parameters for an aged receivables or payables report
+type AgingParams struct {
+	AsOf *time.Time `form:"asOf" time_format:"2006-01-02"` // Defaults to today
+}
+
+// AgingBucketsResponse splits outstanding amounts by the number of days they are past due
+type AgingBucketsResponse struct {
+	NotDue     decimal.Decimal `json:"notDue"`
+	Days0To30  decimal.Decimal `json:"days0To30"`
+	Days31To60 decimal.Decimal `json:"days31To60"`
+	Days61To90 decimal.Decimal `json:"days61To90"`
+	Over90     decimal.Decimal `json:"over90"`
+	Total      decimal.Decimal `json:"total"`
+}
+
+// AgedItemResponse defines an open document of an aging report
+type AgedItemResponse struct {
+	DocumentID       string          `json:"documentID"`
+	DocumentNumber   string          `json:"documentNumber"`
+	CounterpartyID   string          `json:"counterpartyID"`
+	CounterpartyName string          `json:"counterpartyName"`
+	CurrencyCode     string          `json:"currencyCode"`
+	IssueDate        time.Time       `json:"issueDate"`
+	DueDate          time.Time       `json:"dueDate"`
+	DaysPastDue      int             `json:"daysPastDue"`
+	Total            decimal.Decimal `json:"total"`
+	Outstanding      decimal.Decimal `json:"outstanding"`
+}
+
+// AgedBalanceResponse defines the open balance of one counterparty in one currency
+type AgedBalanceResponse struct {
+	CounterpartyID   string               `json:"counterpartyID"`
+	CounterpartyName string               `json:"counterpartyName"`
+	CurrencyCode     string               `json:"currencyCode"`
+	Buckets          AgingBucketsResponse `json:"buckets"`
+}
+
+// AgedTotalResponse defines the open balance of all counterparties in one currency
+type AgedTotalResponse struct {
+	CurrencyCode string               `json:"currencyCode"`
+	Buckets      AgingBucketsResponse `json:"buckets"`
+}
+
+// AgingReportResponse defines an aged receivables or payables report
+type AgingReportResponse struct {
+	AsOf     time.Time             `json:"asOf"`
+	Items    []AgedItemResponse    `json:"items"`
+	Balances []AgedBalanceResponse `json:"balances"`
+	Totals   []AgedTotalResponse   `json:"totals"`
+}
+
+// toAgingBucketsResponse converts domain aging buckets to their response DTO
+func toAgingBucketsResponse(b domain.AgingBuckets) AgingBucketsResponse {
+	return AgingBucketsResponse{
+		NotDue:     b.NotDue,
+		Days0To30:  b.Days0To30,
+		Days31To60: b.Days31To60,
+		Days61To90: b.Days61To90,
+		Over90:     b.Over90,
+		Total:      b.Total,
+	}
+}
+
+// ToAgingReportResponse converts a domain AgingReport to the response DTO
+func ToAgingReportResponse(r *domain.AgingReport) AgingReportResponse {
+	resp := AgingReportResponse{
+		AsOf:     r.AsOf,
+		Items:    make([]AgedItemResponse, 0, len(r.Items)),
+		Balances: make([]AgedBalanceResponse, 0, len(r.Balances)),
+		Totals:   make([]AgedTotalResponse, 0, len(r.Totals)),
+	}
+	for _, item := range r.Items {
+		resp.Items = append(resp.Items, AgedItemResponse{
+			DocumentID:       item.DocumentID,
+			DocumentNumber:   item.DocumentNumber,
+			CounterpartyID:   item.CounterpartyID,
+			CounterpartyName: item.CounterpartyName,
+			CurrencyCode:     item.CurrencyCode,
+			IssueDate:        item.IssueDate,
+			DueDate:          item.DueDate,
+			DaysPastDue:      item.DaysPastDue,
+			Total:            item.Total,
+			Outstanding:      item.Outstanding,
+		})
+	}
+	for _, balance := range r.Balances {
+		resp.Balances = append(resp.Balances, AgedBalanceResponse{
+			CounterpartyID:   balance.CounterpartyID,
+			CounterpartyName: balance.CounterpartyName,
+			CurrencyCode:     balance.CurrencyCode,
+			Buckets:          toAgingBucketsResponse(balance.Buckets),
+		})
+	}
+	for _, total := range r.Totals {
+		resp.Totals = append(resp.Totals, AgedTotalResponse{
+			CurrencyCode: total.CurrencyCode,
+			Buckets:      toAgingBucketsResponse(total.Buckets),
+		})
+	}
+	return resp
+}
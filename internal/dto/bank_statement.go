@@ -0,0 +1,125 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Bank statement import DTOs ---
+
+// ImportBankStatementRequest carries an uploaded statement file. AccountID and Format are bound from the
+// multipart form; FileName and Data are filled in by the handler from the uploaded file.
+type ImportBankStatementRequest struct {
+	AccountID string `form:"accountID" binding:"required,uuid"` // ASSET or LIABILITY account the statement belongs to
+	Format    string `form:"format"`                            // OFX, QFX, CAMT053 or MT940; detected from the content when empty
+	FileName  string `form:"-"`
+	Data      []byte `form:"-"`
+}
+
+// ListStatementLinesParams defines query parameters for listing staged statement lines
+type ListStatementLinesParams struct {
+	AccountID string `form:"accountID" binding:"required,uuid"`
+	Status    string `form:"status" binding:"omitempty,oneof=PENDING POSTED IGNORED"` // All statuses when empty
+	Limit     int    `form:"limit" binding:"omitempty,gte=1,lte=500"`                 // Default 100
+	Offset    int    `form:"offset" binding:"omitempty,gte=0"`
+}
+
+// PostStatementLineRequest defines how a staged line is turned into a journal
+type PostStatementLineRequest struct {
+	CounterAccountID string `json:"counterAccountID" binding:"omitempty,uuid"` // Account taking the other side; defaults to the one assigned by a rule
+	Description      string `json:"description"`                               // Defaults to the rule's description, then the payee and memo
+}
+
+// BankStatementImportResponse summarises an import
+type BankStatementImportResponse struct {
+	ImportID       string    `json:"importID"`
+	WorkplaceID    string    `json:"workplaceID"`
+	AccountID      string    `json:"accountID"`
+	Format         string    `json:"format"`
+	FileName       string    `json:"fileName"`
+	TotalLines     int       `json:"totalLines"`
+	NewLines       int       `json:"newLines"`
+	DuplicateLines int       `json:"duplicateLines"`
+	CreatedAt      time.Time `json:"createdAt"`
+	CreatedBy      string    `json:"createdBy"`
+}
+
+// BankStatementLineResponse defines the data returned for a staged statement line
+type BankStatementLineResponse struct {
+	LineID           string                     `json:"lineID"`
+	AccountID        string                     `json:"accountID"`
+	ImportID         string                     `json:"importID"`
+	BankReference    string                     `json:"bankReference"`
+	Date             string                     `json:"date"`
+	Amount           decimal.Decimal            `json:"amount"` // Positive is money in, negative money out
+	Payee            string                     `json:"payee"`
+	Memo             string                     `json:"memo"`
+	Status           domain.StatementLineStatus `json:"status"`
+	JournalID        string                     `json:"journalID,omitempty"`
+	CounterAccountID string                     `json:"counterAccountID,omitempty"` // Assigned by a rule, or used when posting
+	Description      string                     `json:"description,omitempty"`
+	Notes            string                     `json:"notes,omitempty"`
+	MatchedRuleID    string                     `json:"matchedRuleID,omitempty"` // Rule that categorized the line
+	LastUpdatedAt    time.Time                  `json:"lastUpdatedAt"`
+	LastUpdatedBy    string                     `json:"lastUpdatedBy"`
+}
+
+// ListStatementLinesResponse wraps a list of staged statement lines
+type ListStatementLinesResponse struct {
+	Lines []BankStatementLineResponse `json:"lines"`
+}
+
+// PostStatementLineResponse returns the posted line together with the journal created for it
+type PostStatementLineResponse struct {
+	Line    BankStatementLineResponse `json:"line"`
+	Journal JournalResponse           `json:"journal"`
+}
+
+// ToBankStatementImportResponse converts a domain BankStatementImport to its response DTO
+func ToBankStatementImportResponse(i *domain.BankStatementImport) BankStatementImportResponse {
+	return BankStatementImportResponse{
+		ImportID:       i.ImportID,
+		WorkplaceID:    i.WorkplaceID,
+		AccountID:      i.AccountID,
+		Format:         i.Format,
+		FileName:       i.FileName,
+		TotalLines:     i.TotalLines,
+		NewLines:       i.NewLines,
+		DuplicateLines: i.DuplicateLines,
+		CreatedAt:      i.CreatedAt,
+		CreatedBy:      i.CreatedBy,
+	}
+}
+
+// ToBankStatementLineResponse converts a domain BankStatementLine to its response DTO
+func ToBankStatementLineResponse(l *domain.BankStatementLine) BankStatementLineResponse {
+	return BankStatementLineResponse{
+		LineID:           l.LineID,
+		AccountID:        l.AccountID,
+		ImportID:         l.ImportID,
+		BankReference:    l.BankReference,
+		Date:             l.Date.Format("2006-01-02"),
+		Amount:           l.Amount,
+		Payee:            l.Payee,
+		Memo:             l.Memo,
+		Status:           l.Status,
+		JournalID:        l.JournalID,
+		CounterAccountID: l.CounterAccountID,
+		Description:      l.Description,
+		Notes:            l.Notes,
+		MatchedRuleID:    l.MatchedRuleID,
+		LastUpdatedAt:    l.LastUpdatedAt,
+		LastUpdatedBy:    l.LastUpdatedBy,
+	}
+}
+
+// ToListStatementLinesResponse converts staged statement lines to a list response
+func ToListStatementLinesResponse(lines []domain.BankStatementLine) ListStatementLinesResponse {
+	resp := ListStatementLinesResponse{Lines: make([]BankStatementLineResponse, 0, len(lines))}
+	for i := range lines {
+		resp.Lines = append(resp.Lines, ToBankStatementLineResponse(&lines[i]))
+	}
+	return resp
+}
@@ -0,0 +1,224 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Bill DTOs ---
+
+// BillLineRequest defines a line of a vendor bill
+type BillLineRequest struct {
+	Description      string          `json:"description" binding:"required,max=500"`
+	ExpenseAccountID string          `json:"expenseAccountID" binding:"required,uuid"` // EXPENSE account debited with the line
+	Amount           decimal.Decimal `json:"amount" binding:"required,decimal_gtz"`
+	TaxCodeID        string          `json:"taxCodeID" binding:"omitempty,uuid"` // Optional; the tax is debited to the account of the code
+}
+
+// BillRequest defines the content of a draft bill
+type BillRequest struct {
+	VendorID         string            `json:"vendorID" binding:"required,uuid"`         // Payee owed
+	PayableAccountID string            `json:"payableAccountID" binding:"required,uuid"` // LIABILITY account credited on posting; sets the bill currency
+	Reference        string            `json:"reference" binding:"max=100"`              // The vendor's bill number
+	BillDate         time.Time         `json:"billDate" binding:"required"`
+	DueDate          *time.Time        `json:"dueDate"` // Defaults to the bill date
+	Notes            string            `json:"notes" binding:"max=1000"`
+	Lines            []BillLineRequest `json:"lines" binding:"required,min=1,max=200,dive"`
+}
+
+// BillPaymentRequest pays a bill now or schedules a payment for a later date
+type BillPaymentRequest struct {
+	PaymentAccountID string          `json:"paymentAccountID" binding:"required,uuid"` // ASSET or LIABILITY account the payment is made from
+	Amount           decimal.Decimal `json:"amount" binding:"required,decimal_gtz"`    // At most what is still unpaid
+	Date             time.Time       `json:"date" binding:"required"`
+}
+
+// ListBillsParams defines query parameters for listing bills
+type ListBillsParams struct {
+	VendorID string            `form:"vendorID" binding:"omitempty,uuid"`
+	Status   domain.BillStatus `form:"status" binding:"omitempty,oneof=DRAFT OPEN PARTIALLY_PAID PAID OVERDUE VOID"`
+}
+
+// PostDueBillPaymentsParams defines query parameters for posting due scheduled payments
+type PostDueBillPaymentsParams struct {
+	AsOf *time.Time `form:"asOf" time_format:"2006-01-02"` // Defaults to today
+}
+
+// AgedPayablesParams defines query parameters for the aged payables report
+type AgedPayablesParams struct {
+	AsOf      *time.Time `form:"asOf" time_format:"2006-01-02"`      // Defaults to today
+	AccountID string     `form:"accountID" binding:"omitempty,uuid"` // Only bills payable to this account or its sub-accounts
+}
+
+// BillLineResponse defines the data returned for a bill line
+type BillLineResponse struct {
+	LineID           string          `json:"lineID"`
+	LineNumber       int             `json:"lineNumber"`
+	Description      string          `json:"description"`
+	ExpenseAccountID string          `json:"expenseAccountID"`
+	TaxCodeID        string          `json:"taxCodeID,omitempty"`
+	Amount           decimal.Decimal `json:"amount"`
+	NetAmount        decimal.Decimal `json:"netAmount"`
+	TaxAmount        decimal.Decimal `json:"taxAmount"`
+}
+
+// BillPaymentResponse defines a payment made against a bill
+type BillPaymentResponse struct {
+	PaymentID          string          `json:"paymentID"`
+	BillID             string          `json:"billID"`
+	JournalID          string          `json:"journalID"`
+	PaymentAccountID   string          `json:"paymentAccountID"`
+	ScheduledPaymentID string          `json:"scheduledPaymentID,omitempty"`
+	PaymentDate        time.Time       `json:"paymentDate"`
+	Amount             decimal.Decimal `json:"amount"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	CreatedBy          string          `json:"createdBy"`
+}
+
+// ScheduledBillPaymentResponse defines a payment planned against a bill
+type ScheduledBillPaymentResponse struct {
+	ScheduledPaymentID string          `json:"scheduledPaymentID"`
+	BillID             string          `json:"billID"`
+	PaymentAccountID   string          `json:"paymentAccountID"`
+	ScheduledDate      time.Time       `json:"scheduledDate"`
+	Amount             decimal.Decimal `json:"amount"`
+	Pending            bool            `json:"pending"`
+	PaymentID          string          `json:"paymentID,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	CreatedBy          string          `json:"createdBy"`
+}
+
+// BillResponse defines the data returned for a bill. Status, amount paid and outstanding are derived from the
+// journals as of today.
+type BillResponse struct {
+	BillID            string                         `json:"billID"`
+	WorkplaceID       string                         `json:"workplaceID"`
+	VendorID          string                         `json:"vendorID"`
+	PayableAccountID  string                         `json:"payableAccountID"`
+	Reference         string                         `json:"reference,omitempty"`
+	BillDate          time.Time                      `json:"billDate"`
+	DueDate           time.Time                      `json:"dueDate"`
+	CurrencyCode      string                         `json:"currencyCode"`
+	Notes             string                         `json:"notes,omitempty"`
+	Subtotal          decimal.Decimal                `json:"subtotal"`
+	TaxTotal          decimal.Decimal                `json:"taxTotal"`
+	Total             decimal.Decimal                `json:"total"`
+	Status            domain.BillStatus              `json:"status"`
+	AmountPaid        decimal.Decimal                `json:"amountPaid"`
+	Outstanding       decimal.Decimal                `json:"outstanding"`
+	PostJournalID     string                         `json:"postJournalID,omitempty"`
+	Lines             []BillLineResponse             `json:"lines,omitempty"` // Omitted from lists
+	Payments          []BillPaymentResponse          `json:"payments"`
+	ScheduledPayments []ScheduledBillPaymentResponse `json:"scheduledPayments"`
+	CreatedAt         time.Time                      `json:"createdAt"`
+	CreatedBy         string                         `json:"createdBy"`
+	LastUpdatedAt     time.Time                      `json:"lastUpdatedAt"`
+	LastUpdatedBy     string                         `json:"lastUpdatedBy"`
+}
+
+// ListBillsResponse wraps bills, newest first
+type ListBillsResponse struct {
+	Bills []BillResponse `json:"bills"`
+}
+
+// ListBillPaymentsResponse wraps the payments posted for due scheduled payments
+type ListBillPaymentsResponse struct {
+	Payments []BillPaymentResponse `json:"payments"`
+}
+
+// ToBillPaymentResponse converts a domain BillPayment to its response DTO
+func ToBillPaymentResponse(p *domain.BillPayment) BillPaymentResponse {
+	return BillPaymentResponse{
+		PaymentID:          p.PaymentID,
+		BillID:             p.BillID,
+		JournalID:          p.JournalID,
+		PaymentAccountID:   p.PaymentAccountID,
+		ScheduledPaymentID: p.ScheduledPaymentID,
+		PaymentDate:        p.PaymentDate,
+		Amount:             p.Amount,
+		CreatedAt:          p.CreatedAt,
+		CreatedBy:          p.CreatedBy,
+	}
+}
+
+// ToListBillPaymentsResponse converts domain bill payments to the list response DTO
+func ToListBillPaymentsResponse(payments []domain.BillPayment) ListBillPaymentsResponse {
+	resp := ListBillPaymentsResponse{Payments: make([]BillPaymentResponse, 0, len(payments))}
+	for i := range payments {
+		resp.Payments = append(resp.Payments, ToBillPaymentResponse(&payments[i]))
+	}
+	return resp
+}
+
+// ToScheduledBillPaymentResponse converts a domain ScheduledBillPayment to its response DTO
+func ToScheduledBillPaymentResponse(p *domain.ScheduledBillPayment) ScheduledBillPaymentResponse {
+	return ScheduledBillPaymentResponse{
+		ScheduledPaymentID: p.ScheduledPaymentID,
+		BillID:             p.BillID,
+		PaymentAccountID:   p.PaymentAccountID,
+		ScheduledDate:      p.ScheduledDate,
+		Amount:             p.Amount,
+		Pending:            p.Pending(),
+		PaymentID:          p.PaymentID,
+		CreatedAt:          p.CreatedAt,
+		CreatedBy:          p.CreatedBy,
+	}
+}
+
+// ToBillResponse converts a domain Bill to its response DTO, deriving its status on the given date
+func ToBillResponse(b *domain.Bill, asOf time.Time) BillResponse {
+	resp := BillResponse{
+		BillID:            b.BillID,
+		WorkplaceID:       b.WorkplaceID,
+		VendorID:          b.VendorID,
+		PayableAccountID:  b.PayableAccountID,
+		Reference:         b.Reference,
+		BillDate:          b.BillDate,
+		DueDate:           b.DueDate,
+		CurrencyCode:      b.CurrencyCode,
+		Notes:             b.Notes,
+		Subtotal:          b.Subtotal,
+		TaxTotal:          b.TaxTotal,
+		Total:             b.Total,
+		Status:            b.Status(asOf),
+		AmountPaid:        b.AmountPaid(asOf),
+		Outstanding:       b.Outstanding(asOf),
+		PostJournalID:     b.PostJournalID,
+		Payments:          make([]BillPaymentResponse, 0, len(b.Payments)),
+		ScheduledPayments: make([]ScheduledBillPaymentResponse, 0, len(b.ScheduledPayments)),
+		CreatedAt:         b.CreatedAt,
+		CreatedBy:         b.CreatedBy,
+		LastUpdatedAt:     b.LastUpdatedAt,
+		LastUpdatedBy:     b.LastUpdatedBy,
+	}
+	for _, line := range b.Lines {
+		resp.Lines = append(resp.Lines, BillLineResponse{
+			LineID:           line.LineID,
+			LineNumber:       line.LineNumber,
+			Description:      line.Description,
+			ExpenseAccountID: line.ExpenseAccountID,
+			TaxCodeID:        line.TaxCodeID,
+			Amount:           line.Amount,
+			NetAmount:        line.NetAmount,
+			TaxAmount:        line.TaxAmount,
+		})
+	}
+	for i := range b.Payments {
+		resp.Payments = append(resp.Payments, ToBillPaymentResponse(&b.Payments[i]))
+	}
+	for i := range b.ScheduledPayments {
+		resp.ScheduledPayments = append(resp.ScheduledPayments, ToScheduledBillPaymentResponse(&b.ScheduledPayments[i]))
+	}
+	return resp
+}
+
+// ToListBillsResponse converts domain bills to the list response DTO
+func ToListBillsResponse(bills []domain.Bill, asOf time.Time) ListBillsResponse {
+	resp := ListBillsResponse{Bills: make([]BillResponse, 0, len(bills))}
+	for i := range bills {
+		resp.Bills = append(resp.Bills, ToBillResponse(&bills[i], asOf))
+	}
+	return resp
+}
@@ -0,0 +1,174 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Budget DTOs ---
+
+// BudgetLineRequest defines the planned amount of one account for one period.
+// Month (1-12) is required for monthly budgets and must be omitted for yearly budgets.
+// A zero amount removes the line.
+type BudgetLineRequest struct {
+	AccountID string          `json:"accountID" binding:"required,uuid"`
+	Month     int             `json:"month,omitempty" binding:"omitempty,min=1,max=12"`
+	Amount    decimal.Decimal `json:"amount"`
+}
+
+// CreateBudgetRequest defines the data needed to create a budget.
+type CreateBudgetRequest struct {
+	Name        string                  `json:"name" binding:"required"`
+	Description string                  `json:"description"`
+	PeriodType  domain.BudgetPeriodType `json:"periodType" binding:"required,oneof=MONTHLY YEARLY"`
+	Year        int                     `json:"year" binding:"required,min=1900,max=9999"`
+	Lines       []BudgetLineRequest     `json:"lines,omitempty" binding:"omitempty,dive"`
+}
+
+// UpdateBudgetRequest defines the data allowed for updating a budget.
+type UpdateBudgetRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// SetBudgetLinesRequest inserts or replaces budget lines; lines not mentioned are kept.
+type SetBudgetLinesRequest struct {
+	Lines []BudgetLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// CopyBudgetActualsRequest fills a budget from the actual revenue and expenses of another year.
+type CopyBudgetActualsRequest struct {
+	// SourceYear defaults to the year before the budget year
+	SourceYear int `json:"sourceYear,omitempty" binding:"omitempty,min=1900,max=9999"`
+	// AdjustmentPercent is applied to every copied amount (e.g. 5 for +5%, -10 for -10%)
+	AdjustmentPercent decimal.Decimal `json:"adjustmentPercent"`
+}
+
+// BudgetLineResponse represents one budget line
+type BudgetLineResponse struct {
+	AccountID   string          `json:"accountID"`
+	Month       int             `json:"month,omitempty"` // Omitted for yearly budgets
+	PeriodStart string          `json:"periodStart"`
+	Amount      decimal.Decimal `json:"amount"`
+}
+
+// BudgetResponse defines the data returned for a budget
+type BudgetResponse struct {
+	BudgetID      string                  `json:"budgetID"`
+	WorkplaceID   string                  `json:"workplaceID"`
+	Name          string                  `json:"name"`
+	Description   string                  `json:"description"`
+	PeriodType    domain.BudgetPeriodType `json:"periodType"`
+	Year          int                     `json:"year"`
+	Lines         []BudgetLineResponse    `json:"lines,omitempty"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	CreatedBy     string                  `json:"createdBy"`
+	LastUpdatedAt time.Time               `json:"lastUpdatedAt"`
+	LastUpdatedBy string                  `json:"lastUpdatedBy"`
+}
+
+// ListBudgetsResponse wraps a list of budgets
+type ListBudgetsResponse struct {
+	Budgets []BudgetResponse `json:"budgets"`
+}
+
+// ToBudgetResponse converts a domain.Budget to a BudgetResponse DTO
+func ToBudgetResponse(b *domain.Budget) BudgetResponse {
+	response := BudgetResponse{
+		BudgetID:      b.BudgetID,
+		WorkplaceID:   b.WorkplaceID,
+		Name:          b.Name,
+		Description:   b.Description,
+		PeriodType:    b.PeriodType,
+		Year:          b.Year,
+		Lines:         make([]BudgetLineResponse, len(b.Lines)),
+		CreatedAt:     b.CreatedAt,
+		CreatedBy:     b.CreatedBy,
+		LastUpdatedAt: b.LastUpdatedAt,
+		LastUpdatedBy: b.LastUpdatedBy,
+	}
+	for i, line := range b.Lines {
+		response.Lines[i] = BudgetLineResponse{
+			AccountID:   line.AccountID,
+			PeriodStart: line.PeriodStart.Format("2006-01-02"),
+			Amount:      line.Amount,
+		}
+		if b.PeriodType == domain.BudgetMonthly {
+			response.Lines[i].Month = int(line.PeriodStart.Month())
+		}
+	}
+	return response
+}
+
+// ToListBudgetsResponse converts a slice of domain.Budget to a ListBudgetsResponse DTO
+func ToListBudgetsResponse(budgets []domain.Budget) ListBudgetsResponse {
+	response := ListBudgetsResponse{Budgets: make([]BudgetResponse, len(budgets))}
+	for i := range budgets {
+		response.Budgets[i] = ToBudgetResponse(&budgets[i])
+	}
+	return response
+}
+
+// BudgetVsActualLineResponse compares the budget of one account with its actual movement
+type BudgetVsActualLineResponse struct {
+	AccountID   string             `json:"accountID,omitempty"`
+	Name        string             `json:"name"`
+	AccountType domain.AccountType `json:"accountType,omitempty"`
+	Budgeted    decimal.Decimal    `json:"budgeted"`
+	Actual      decimal.Decimal    `json:"actual"`
+	Remaining   decimal.Decimal    `json:"remaining"`
+	PercentUsed *decimal.Decimal   `json:"percentUsed"`
+}
+
+// BudgetVsActualSectionResponse groups the revenue or expense lines of a budget-vs-actual report
+type BudgetVsActualSectionResponse struct {
+	Lines      []BudgetVsActualLineResponse `json:"lines"`
+	Total      BudgetVsActualLineResponse   `json:"total"`
+	Unbudgeted decimal.Decimal              `json:"unbudgeted"`
+}
+
+// BudgetVsActualResponse represents the budget-vs-actual report response
+type BudgetVsActualResponse struct {
+	BudgetID string                        `json:"budgetID"`
+	Name     string                        `json:"name"`
+	Period   ReportPeriodResponse          `json:"period"`
+	Revenue  BudgetVsActualSectionResponse `json:"revenue"`
+	Expenses BudgetVsActualSectionResponse `json:"expenses"`
+}
+
+func toBudgetVsActualLineResponse(line domain.BudgetVsActualLine) BudgetVsActualLineResponse {
+	return BudgetVsActualLineResponse{
+		AccountID:   line.AccountID,
+		Name:        line.Name,
+		AccountType: line.AccountType,
+		Budgeted:    line.Budgeted,
+		Actual:      line.Actual,
+		Remaining:   line.Remaining,
+		PercentUsed: line.PercentUsed,
+	}
+}
+
+func toBudgetVsActualSectionResponse(section domain.BudgetVsActualSection) BudgetVsActualSectionResponse {
+	response := BudgetVsActualSectionResponse{
+		Lines:      make([]BudgetVsActualLineResponse, len(section.Lines)),
+		Total:      toBudgetVsActualLineResponse(section.Total),
+		Unbudgeted: section.Unbudgeted,
+	}
+	for i, line := range section.Lines {
+		response.Lines[i] = toBudgetVsActualLineResponse(line)
+	}
+	return response
+}
+
+// ToBudgetVsActualResponse converts a domain budget-vs-actual report to a response DTO
+func ToBudgetVsActualResponse(report *domain.BudgetVsActualReport) BudgetVsActualResponse {
+	return BudgetVsActualResponse{
+		BudgetID: report.BudgetID,
+		Name:     report.Name,
+		Period:   toReportPeriodResponses([]domain.ReportPeriod{report.Period})[0],
+		Revenue:  toBudgetVsActualSectionResponse(report.Revenue),
+		Expenses: toBudgetVsActualSectionResponse(report.Expenses),
+	}
+}
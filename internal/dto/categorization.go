@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Categorization rule DTOs ---
+
+// CategorizationRuleRequest defines the conditions and actions of a rule. It is used to create a rule and,
+// with PUT semantics, to replace all fields of an existing one.
+type CategorizationRuleRequest struct {
+	Name               string           `json:"name" binding:"required"`
+	Priority           *int             `json:"priority" binding:"omitempty,gte=0"`        // Lower runs first; defaults to 100
+	IsActive           *bool            `json:"isActive"`                                  // Defaults to true
+	PayeePattern       string           `json:"payeePattern"`                              // Case-insensitive regular expression
+	DescriptionPattern string           `json:"descriptionPattern"`                        // Case-insensitive regular expression on the memo
+	MinAmount          *decimal.Decimal `json:"minAmount"`                                 // Inclusive, compared with the absolute amount
+	MaxAmount          *decimal.Decimal `json:"maxAmount"`                                 // Inclusive, compared with the absolute amount
+	SourceAccountID    string           `json:"sourceAccountID" binding:"omitempty,uuid"`  // Restricts the rule to one statement account
+	Sign               domain.RuleSign  `json:"sign" binding:"omitempty,oneof=ANY IN OUT"` // Defaults to ANY
+	CounterAccountID   string           `json:"counterAccountID" binding:"required,uuid"`  // Account assigned to matching lines
+	JournalDescription string           `json:"journalDescription"`                        // Defaults to the payee and memo of the line
+	Notes              string           `json:"notes"`                                     // Notes of the counter-account transaction
+}
+
+// ApplyCategorizationRulesRequest selects the pending lines to categorize
+type ApplyCategorizationRulesRequest struct {
+	AccountID string `json:"accountID" binding:"omitempty,uuid"` // All statement accounts when empty
+}
+
+// CategorizationRuleResponse defines the data returned for a categorization rule
+type CategorizationRuleResponse struct {
+	RuleID             string           `json:"ruleID"`
+	WorkplaceID        string           `json:"workplaceID"`
+	Name               string           `json:"name"`
+	Priority           int              `json:"priority"`
+	IsActive           bool             `json:"isActive"`
+	PayeePattern       string           `json:"payeePattern,omitempty"`
+	DescriptionPattern string           `json:"descriptionPattern,omitempty"`
+	MinAmount          *decimal.Decimal `json:"minAmount,omitempty"`
+	MaxAmount          *decimal.Decimal `json:"maxAmount,omitempty"`
+	SourceAccountID    string           `json:"sourceAccountID,omitempty"`
+	Sign               domain.RuleSign  `json:"sign"`
+	CounterAccountID   string           `json:"counterAccountID"`
+	JournalDescription string           `json:"journalDescription,omitempty"`
+	Notes              string           `json:"notes,omitempty"`
+	CreatedAt          time.Time        `json:"createdAt"`
+	CreatedBy          string           `json:"createdBy"`
+	LastUpdatedAt      time.Time        `json:"lastUpdatedAt"`
+	LastUpdatedBy      string           `json:"lastUpdatedBy"`
+}
+
+// ListCategorizationRulesResponse wraps categorization rules in the order they are applied
+type ListCategorizationRulesResponse struct {
+	Rules []CategorizationRuleResponse `json:"rules"`
+}
+
+// ApplyCategorizationRulesResponse reports which rule matched each categorized line
+type ApplyCategorizationRulesResponse struct {
+	ExaminedLines  int                          `json:"examinedLines"`
+	MatchedLines   int                          `json:"matchedLines"`
+	UnmatchedLines int                          `json:"unmatchedLines"`
+	Matches        []domain.CategorizationMatch `json:"matches"`
+}
+
+// ListCategorizationRuleSuggestionsResponse wraps the rules proposed from past manual categorizations
+type ListCategorizationRuleSuggestionsResponse struct {
+	Suggestions []domain.CategorizationRuleSuggestion `json:"suggestions"`
+}
+
+// ToCategorizationRuleResponse converts a domain CategorizationRule to its response DTO
+func ToCategorizationRuleResponse(r *domain.CategorizationRule) CategorizationRuleResponse {
+	return CategorizationRuleResponse{
+		RuleID:             r.RuleID,
+		WorkplaceID:        r.WorkplaceID,
+		Name:               r.Name,
+		Priority:           r.Priority,
+		IsActive:           r.IsActive,
+		PayeePattern:       r.PayeePattern,
+		DescriptionPattern: r.DescriptionPattern,
+		MinAmount:          r.MinAmount,
+		MaxAmount:          r.MaxAmount,
+		SourceAccountID:    r.SourceAccountID,
+		Sign:               r.Sign,
+		CounterAccountID:   r.CounterAccountID,
+		JournalDescription: r.JournalDescription,
+		Notes:              r.Notes,
+		CreatedAt:          r.CreatedAt,
+		CreatedBy:          r.CreatedBy,
+		LastUpdatedAt:      r.LastUpdatedAt,
+		LastUpdatedBy:      r.LastUpdatedBy,
+	}
+}
+
+// ToListCategorizationRulesResponse converts categorization rules to a list response
+func ToListCategorizationRulesResponse(rules []domain.CategorizationRule) ListCategorizationRulesResponse {
+	resp := ListCategorizationRulesResponse{Rules: make([]CategorizationRuleResponse, 0, len(rules))}
+	for i := range rules {
+		resp.Rules = append(resp.Rules, ToCategorizationRuleResponse(&rules[i]))
+	}
+	return resp
+}
+
+// ToApplyCategorizationRulesResponse converts a categorization run to its response DTO
+func ToApplyCategorizationRulesResponse(result *domain.CategorizationResult) ApplyCategorizationRulesResponse {
+	return ApplyCategorizationRulesResponse{
+		ExaminedLines:  result.ExaminedLines,
+		MatchedLines:   len(result.Matches),
+		UnmatchedLines: result.ExaminedLines - len(result.Matches),
+		Matches:        result.Matches,
+	}
+}
@@ -0,0 +1,244 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Consolidation DTOs ---
+
+// ConsolidationGroupRequest defines the details of a consolidation group. It is used to create a group and,
+// with PUT semantics, to replace all fields, members and account mappings of an existing one.
+type ConsolidationGroupRequest struct {
+	Name         string                        `json:"name" binding:"required,max=255"`
+	Description  string                        `json:"description"`
+	CurrencyCode string                        `json:"currencyCode" binding:"required,iso4217"`      // Group currency the entities are translated into
+	MemberIDs    []string                      `json:"memberIDs" binding:"required,min=1,dive,uuid"` // Workplaces of the entities, in report order
+	Accounts     []ConsolidationAccountRequest `json:"accounts" binding:"omitempty,dive"`            // Accounts without settings are matched by CFID
+}
+
+// ConsolidationAccountRequest defines the group settings of one account of a member workplace
+type ConsolidationAccountRequest struct {
+	AccountID    string `json:"accountID" binding:"required,uuid"`
+	GroupCode    string `json:"groupCode" binding:"max=50"` // Matches accounts across entities instead of their CFID; stored upper-case
+	Intercompany bool   `json:"intercompany"`               // Eliminate the balance of the account on consolidation
+}
+
+// ConsolidationAccountResponse defines the data returned for the group settings of an account
+type ConsolidationAccountResponse struct {
+	AccountID    string `json:"accountID"`
+	GroupCode    string `json:"groupCode,omitempty"`
+	Intercompany bool   `json:"intercompany"`
+}
+
+// ConsolidationGroupResponse defines the data returned for a consolidation group
+type ConsolidationGroupResponse struct {
+	GroupID       string                         `json:"groupID"`
+	WorkplaceID   string                         `json:"workplaceID"`
+	Name          string                         `json:"name"`
+	Description   string                         `json:"description,omitempty"`
+	CurrencyCode  string                         `json:"currencyCode"`
+	MemberIDs     []string                       `json:"memberIDs"`
+	Accounts      []ConsolidationAccountResponse `json:"accounts"`
+	CreatedAt     time.Time                      `json:"createdAt"`
+	CreatedBy     string                         `json:"createdBy"`
+	LastUpdatedAt time.Time                      `json:"lastUpdatedAt"`
+	LastUpdatedBy string                         `json:"lastUpdatedBy"`
+}
+
+// ListConsolidationGroupsResponse wraps consolidation groups ordered by name
+type ListConsolidationGroupsResponse struct {
+	Groups []ConsolidationGroupResponse `json:"groups"`
+}
+
+// ToConsolidationGroupResponse converts a domain ConsolidationGroup to its response DTO
+func ToConsolidationGroupResponse(g *domain.ConsolidationGroup) ConsolidationGroupResponse {
+	accounts := make([]ConsolidationAccountResponse, len(g.Accounts))
+	for i, account := range g.Accounts {
+		accounts[i] = ConsolidationAccountResponse{
+			AccountID:    account.AccountID,
+			GroupCode:    account.GroupCode,
+			Intercompany: account.Intercompany,
+		}
+	}
+	return ConsolidationGroupResponse{
+		GroupID:       g.GroupID,
+		WorkplaceID:   g.WorkplaceID,
+		Name:          g.Name,
+		Description:   g.Description,
+		CurrencyCode:  g.CurrencyCode,
+		MemberIDs:     g.MemberIDs,
+		Accounts:      accounts,
+		CreatedAt:     g.CreatedAt,
+		CreatedBy:     g.CreatedBy,
+		LastUpdatedAt: g.LastUpdatedAt,
+		LastUpdatedBy: g.LastUpdatedBy,
+	}
+}
+
+// ToListConsolidationGroupsResponse converts domain consolidation groups to the list response DTO
+func ToListConsolidationGroupsResponse(groups []domain.ConsolidationGroup) ListConsolidationGroupsResponse {
+	list := make([]ConsolidationGroupResponse, len(groups))
+	for i := range groups {
+		list[i] = ToConsolidationGroupResponse(&groups[i])
+	}
+	return ListConsolidationGroupsResponse{Groups: list}
+}
+
+// ConsolidatedEntityAmountResponse defines the contribution of one account of an entity to a consolidated line
+type ConsolidatedEntityAmountResponse struct {
+	WorkplaceID  string          `json:"workplaceID"`
+	AccountID    string          `json:"accountID"`
+	Name         string          `json:"name"`
+	CurrencyCode string          `json:"currencyCode"`
+	Amount       decimal.Decimal `json:"amount"`     // In the currency of the account
+	Rate         decimal.Decimal `json:"rate"`       // Rate into the group currency
+	Translated   decimal.Decimal `json:"translated"` // In the group currency
+	Intercompany bool            `json:"intercompany"`
+}
+
+// ConsolidatedLineResponse defines one line of a consolidated report
+type ConsolidatedLineResponse struct {
+	Code         string                             `json:"code,omitempty"`
+	Name         string                             `json:"name"`
+	AccountType  domain.AccountType                 `json:"accountType"`
+	Entities     []ConsolidatedEntityAmountResponse `json:"entities"`
+	Combined     decimal.Decimal                    `json:"combined"`     // Sum of the translated amounts
+	Elimination  decimal.Decimal                    `json:"elimination"`  // Eliminated intercompany amounts
+	Consolidated decimal.Decimal                    `json:"consolidated"` // Combined plus elimination
+}
+
+// ConsolidationRateResponse defines an exchange rate used to translate the accounts of one currency
+type ConsolidationRateResponse struct {
+	FromCurrencyCode string          `json:"fromCurrencyCode"`
+	ToCurrencyCode   string          `json:"toCurrencyCode"`
+	Rate             decimal.Decimal `json:"rate"`
+	DateEffective    time.Time       `json:"dateEffective"`
+}
+
+// ConsolidatedTrialBalanceResponse defines the consolidated trial balance of a group; amounts are debit-positive
+type ConsolidatedTrialBalanceResponse struct {
+	GroupID                string                      `json:"groupID"`
+	CurrencyCode           string                      `json:"currencyCode"`
+	AsOf                   time.Time                   `json:"asOf"`
+	Lines                  []ConsolidatedLineResponse  `json:"lines"`
+	TotalDebit             decimal.Decimal             `json:"totalDebit"`
+	TotalCredit            decimal.Decimal             `json:"totalCredit"`
+	IntercompanyDifference decimal.Decimal             `json:"intercompanyDifference"` // Zero when intercompany balances offset each other
+	Rates                  []ConsolidationRateResponse `json:"rates"`
+}
+
+// ConsolidatedProfitAndLossResponse defines the consolidated profit and loss report of a group
+type ConsolidatedProfitAndLossResponse struct {
+	GroupID                string                      `json:"groupID"`
+	CurrencyCode           string                      `json:"currencyCode"`
+	From                   time.Time                   `json:"from"`
+	To                     time.Time                   `json:"to"`
+	Revenue                []ConsolidatedLineResponse  `json:"revenue"`
+	Expenses               []ConsolidatedLineResponse  `json:"expenses"`
+	NetProfit              decimal.Decimal             `json:"netProfit"`
+	IntercompanyDifference decimal.Decimal             `json:"intercompanyDifference"` // Net profit removed by the eliminations
+	Rates                  []ConsolidationRateResponse `json:"rates"`
+}
+
+// ConsolidatedBalanceSheetResponse defines the consolidated balance sheet of a group
+type ConsolidatedBalanceSheetResponse struct {
+	GroupID                string                      `json:"groupID"`
+	CurrencyCode           string                      `json:"currencyCode"`
+	AsOf                   time.Time                   `json:"asOf"`
+	Assets                 []ConsolidatedLineResponse  `json:"assets"`
+	Liabilities            []ConsolidatedLineResponse  `json:"liabilities"`
+	Equity                 []ConsolidatedLineResponse  `json:"equity"`
+	TotalAssets            decimal.Decimal             `json:"totalAssets"`
+	TotalLiabilities       decimal.Decimal             `json:"totalLiabilities"`
+	TotalEquity            decimal.Decimal             `json:"totalEquity"`
+	IntercompanyDifference decimal.Decimal             `json:"intercompanyDifference"` // Zero when intercompany balances offset each other
+	Rates                  []ConsolidationRateResponse `json:"rates"`
+}
+
+// toConsolidatedLineResponses converts consolidated lines to their response DTOs
+func toConsolidatedLineResponses(lines []domain.ConsolidatedLine) []ConsolidatedLineResponse {
+	list := make([]ConsolidatedLineResponse, len(lines))
+	for i, line := range lines {
+		entities := make([]ConsolidatedEntityAmountResponse, len(line.Entities))
+		for j, entity := range line.Entities {
+			entities[j] = ConsolidatedEntityAmountResponse{
+				WorkplaceID:  entity.WorkplaceID,
+				AccountID:    entity.AccountID,
+				Name:         entity.Name,
+				CurrencyCode: entity.CurrencyCode,
+				Amount:       entity.Amount,
+				Rate:         entity.Rate,
+				Translated:   entity.Translated,
+				Intercompany: entity.Intercompany,
+			}
+		}
+		list[i] = ConsolidatedLineResponse{
+			Code:         line.Code,
+			Name:         line.Name,
+			AccountType:  line.AccountType,
+			Entities:     entities,
+			Combined:     line.Combined,
+			Elimination:  line.Elimination,
+			Consolidated: line.Consolidated,
+		}
+	}
+	return list
+}
+
+// toConsolidationRateResponses converts the rates used by a consolidated report to their response DTOs
+func toConsolidationRateResponses(rates []domain.ConsolidationRate) []ConsolidationRateResponse {
+	list := make([]ConsolidationRateResponse, len(rates))
+	for i, rate := range rates {
+		list[i] = ConsolidationRateResponse(rate)
+	}
+	return list
+}
+
+// ToConsolidatedTrialBalanceResponse converts a consolidated trial balance to its response DTO
+func ToConsolidatedTrialBalanceResponse(report *domain.ConsolidatedTrialBalance) ConsolidatedTrialBalanceResponse {
+	return ConsolidatedTrialBalanceResponse{
+		GroupID:                report.GroupID,
+		CurrencyCode:           report.CurrencyCode,
+		AsOf:                   report.AsOf,
+		Lines:                  toConsolidatedLineResponses(report.Lines),
+		TotalDebit:             report.TotalDebit,
+		TotalCredit:            report.TotalCredit,
+		IntercompanyDifference: report.IntercompanyDifference,
+		Rates:                  toConsolidationRateResponses(report.Rates),
+	}
+}
+
+// ToConsolidatedProfitAndLossResponse converts a consolidated profit and loss report to its response DTO
+func ToConsolidatedProfitAndLossResponse(report *domain.ConsolidatedPAndLReport) ConsolidatedProfitAndLossResponse {
+	return ConsolidatedProfitAndLossResponse{
+		GroupID:                report.GroupID,
+		CurrencyCode:           report.CurrencyCode,
+		From:                   report.From,
+		To:                     report.To,
+		Revenue:                toConsolidatedLineResponses(report.Revenue),
+		Expenses:               toConsolidatedLineResponses(report.Expenses),
+		NetProfit:              report.NetProfit,
+		IntercompanyDifference: report.IntercompanyDifference,
+		Rates:                  toConsolidationRateResponses(report.Rates),
+	}
+}
+
+// ToConsolidatedBalanceSheetResponse converts a consolidated balance sheet to its response DTO
+func ToConsolidatedBalanceSheetResponse(report *domain.ConsolidatedBalanceSheetReport) ConsolidatedBalanceSheetResponse {
+	return ConsolidatedBalanceSheetResponse{
+		GroupID:                report.GroupID,
+		CurrencyCode:           report.CurrencyCode,
+		AsOf:                   report.AsOf,
+		Assets:                 toConsolidatedLineResponses(report.Assets),
+		Liabilities:            toConsolidatedLineResponses(report.Liabilities),
+		Equity:                 toConsolidatedLineResponses(report.Equity),
+		TotalAssets:            report.TotalAssets,
+		TotalLiabilities:       report.TotalLiabilities,
+		TotalEquity:            report.TotalEquity,
+		IntercompanyDifference: report.IntercompanyDifference,
+		Rates:                  toConsolidationRateResponses(report.Rates),
+	}
+}
@@ -0,0 +1,198 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Credit Card DTOs ---
+
+// CreditCardRequest defines the billing-cycle settings of a credit card. It is used with PUT semantics to
+// replace the settings of an existing card.
+type CreditCardRequest struct {
+	ClosingDay            int             `json:"closingDay" binding:"required,min=1,max=31"` // Day of month the statement closes
+	DueDay                int             `json:"dueDay" binding:"required,min=1,max=31"`     // Day of month payment is due; the next month when not after the closing day
+	CreditLimit           decimal.Decimal `json:"creditLimit"`
+	MinimumPaymentPercent decimal.Decimal `json:"minimumPaymentPercent"` // Percent of the statement balance; defaults to 0
+	MinimumPaymentAmount  decimal.Decimal `json:"minimumPaymentAmount"`  // Floor of the minimum payment; defaults to 0
+}
+
+// CreateCreditCardRequest turns a LIABILITY account into a credit card with billing cycles
+type CreateCreditCardRequest struct {
+	AccountID string `json:"accountID" binding:"required,uuid"`
+	CreditCardRequest
+}
+
+// StatementParams defines query parameters for a credit card statement
+type StatementParams struct {
+	Date *time.Time `form:"date" time_format:"2006-01-02"` // Any date in the cycle; defaults to the last closed cycle
+}
+
+// UpcomingDuesParams defines query parameters for the upcoming dues
+type UpcomingDuesParams struct {
+	AsOf *time.Time `form:"asOf" time_format:"2006-01-02"`          // Defaults to today
+	Days int        `form:"days" binding:"omitempty,min=1,max=366"` // Defaults to 30
+}
+
+// CreditCardResponse defines the data returned for a credit card
+type CreditCardResponse struct {
+	AccountID             string          `json:"accountID"`
+	WorkplaceID           string          `json:"workplaceID"`
+	ClosingDay            int             `json:"closingDay"`
+	DueDay                int             `json:"dueDay"`
+	CreditLimit           decimal.Decimal `json:"creditLimit"`
+	MinimumPaymentPercent decimal.Decimal `json:"minimumPaymentPercent"`
+	MinimumPaymentAmount  decimal.Decimal `json:"minimumPaymentAmount"`
+	CreatedAt             time.Time       `json:"createdAt"`
+	CreatedBy             string          `json:"createdBy"`
+	LastUpdatedAt         time.Time       `json:"lastUpdatedAt"`
+	LastUpdatedBy         string          `json:"lastUpdatedBy"`
+}
+
+// ListCreditCardsResponse wraps credit cards, ordered by account name
+type ListCreditCardsResponse struct {
+	CreditCards []CreditCardResponse `json:"creditCards"`
+}
+
+// StatementCycleResponse defines a billing cycle
+type StatementCycleResponse struct {
+	StartDate   time.Time `json:"startDate"`
+	ClosingDate time.Time `json:"closingDate"`
+	DueDate     time.Time `json:"dueDate"`
+}
+
+// CreditCardStatementResponse defines the statement of one billing cycle
+type CreditCardStatementResponse struct {
+	AccountID           string                 `json:"accountID"`
+	CurrencyCode        string                 `json:"currencyCode"`
+	Cycle               StatementCycleResponse `json:"cycle"`
+	Closed              bool                   `json:"closed"`
+	OpeningBalance      decimal.Decimal        `json:"openingBalance"`
+	Charges             decimal.Decimal        `json:"charges"`
+	Payments            decimal.Decimal        `json:"payments"`
+	StatementBalance    decimal.Decimal        `json:"statementBalance"`
+	MinimumDue          decimal.Decimal        `json:"minimumDue"`
+	PaidSinceClosing    decimal.Decimal        `json:"paidSinceClosing"`
+	RemainingDue        decimal.Decimal        `json:"remainingDue"`
+	RemainingMinimumDue decimal.Decimal        `json:"remainingMinimumDue"`
+	Transactions        []TransactionResponse  `json:"transactions"`
+}
+
+// CreditCardSummaryResponse defines the current position of a credit card
+type CreditCardSummaryResponse struct {
+	CreditCard      CreditCardResponse           `json:"creditCard"`
+	AccountName     string                       `json:"accountName"`
+	CurrencyCode    string                       `json:"currencyCode"`
+	CurrentBalance  decimal.Decimal              `json:"currentBalance"`
+	AvailableCredit decimal.Decimal              `json:"availableCredit"`
+	CurrentCycle    StatementCycleResponse       `json:"currentCycle"`
+	LastStatement   *CreditCardStatementResponse `json:"lastStatement,omitempty"`
+}
+
+// UpcomingDueResponse defines a statement payment falling due soon
+type UpcomingDueResponse struct {
+	AccountID           string          `json:"accountID"`
+	AccountName         string          `json:"accountName"`
+	CurrencyCode        string          `json:"currencyCode"`
+	ClosingDate         time.Time       `json:"closingDate"`
+	DueDate             time.Time       `json:"dueDate"`
+	DaysUntilDue        int             `json:"daysUntilDue"`
+	StatementBalance    decimal.Decimal `json:"statementBalance"`
+	MinimumDue          decimal.Decimal `json:"minimumDue"`
+	RemainingDue        decimal.Decimal `json:"remainingDue"`
+	RemainingMinimumDue decimal.Decimal `json:"remainingMinimumDue"`
+}
+
+// UpcomingDuesResponse wraps the upcoming dues, soonest first
+type UpcomingDuesResponse struct {
+	Dues []UpcomingDueResponse `json:"dues"`
+}
+
+// ToCreditCardResponse converts a domain CreditCard to its response DTO
+func ToCreditCardResponse(c *domain.CreditCard) CreditCardResponse {
+	return CreditCardResponse{
+		AccountID:             c.AccountID,
+		WorkplaceID:           c.WorkplaceID,
+		ClosingDay:            c.ClosingDay,
+		DueDay:                c.DueDay,
+		CreditLimit:           c.CreditLimit,
+		MinimumPaymentPercent: c.MinimumPaymentPercent,
+		MinimumPaymentAmount:  c.MinimumPaymentAmount,
+		CreatedAt:             c.CreatedAt,
+		CreatedBy:             c.CreatedBy,
+		LastUpdatedAt:         c.LastUpdatedAt,
+		LastUpdatedBy:         c.LastUpdatedBy,
+	}
+}
+
+// ToListCreditCardsResponse converts domain credit cards to the list response DTO
+func ToListCreditCardsResponse(cards []domain.CreditCard) ListCreditCardsResponse {
+	resp := ListCreditCardsResponse{CreditCards: make([]CreditCardResponse, 0, len(cards))}
+	for i := range cards {
+		resp.CreditCards = append(resp.CreditCards, ToCreditCardResponse(&cards[i]))
+	}
+	return resp
+}
+
+// toStatementCycleResponse converts a domain StatementCycle to its response DTO
+func toStatementCycleResponse(c domain.StatementCycle) StatementCycleResponse {
+	return StatementCycleResponse{StartDate: c.StartDate, ClosingDate: c.ClosingDate, DueDate: c.DueDate}
+}
+
+// ToCreditCardStatementResponse converts a domain CreditCardStatement to its response DTO
+func ToCreditCardStatementResponse(s *domain.CreditCardStatement) CreditCardStatementResponse {
+	return CreditCardStatementResponse{
+		AccountID:           s.AccountID,
+		CurrencyCode:        s.CurrencyCode,
+		Cycle:               toStatementCycleResponse(s.Cycle),
+		Closed:              s.Closed,
+		OpeningBalance:      s.OpeningBalance,
+		Charges:             s.Charges,
+		Payments:            s.Payments,
+		StatementBalance:    s.StatementBalance,
+		MinimumDue:          s.MinimumDue,
+		PaidSinceClosing:    s.PaidSinceClosing,
+		RemainingDue:        s.RemainingDue,
+		RemainingMinimumDue: s.RemainingMinimumDue,
+		Transactions:        ToTransactionResponses(s.Transactions),
+	}
+}
+
+// ToCreditCardSummaryResponse converts a domain CreditCardSummary to its response DTO
+func ToCreditCardSummaryResponse(s *domain.CreditCardSummary) CreditCardSummaryResponse {
+	resp := CreditCardSummaryResponse{
+		CreditCard:      ToCreditCardResponse(&s.CreditCard),
+		AccountName:     s.AccountName,
+		CurrencyCode:    s.CurrencyCode,
+		CurrentBalance:  s.CurrentBalance,
+		AvailableCredit: s.AvailableCredit,
+		CurrentCycle:    toStatementCycleResponse(s.CurrentCycle),
+	}
+	if s.LastStatement != nil {
+		statement := ToCreditCardStatementResponse(s.LastStatement)
+		resp.LastStatement = &statement
+	}
+	return resp
+}
+
+// ToUpcomingDuesResponse converts domain upcoming dues to the response DTO
+func ToUpcomingDuesResponse(dues []domain.UpcomingDue) UpcomingDuesResponse {
+	resp := UpcomingDuesResponse{Dues: make([]UpcomingDueResponse, 0, len(dues))}
+	for _, due := range dues {
+		resp.Dues = append(resp.Dues, UpcomingDueResponse{
+			AccountID:           due.AccountID,
+			AccountName:         due.AccountName,
+			CurrencyCode:        due.CurrencyCode,
+			ClosingDate:         due.ClosingDate,
+			DueDate:             due.DueDate,
+			DaysUntilDue:        due.DaysUntilDue,
+			StatementBalance:    due.StatementBalance,
+			MinimumDue:          due.MinimumDue,
+			RemainingDue:        due.RemainingDue,
+			RemainingMinimumDue: due.RemainingMinimumDue,
+		})
+	}
+	return resp
+}
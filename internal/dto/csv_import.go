@@ -0,0 +1,191 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- CSV import DTOs ---
+
+// CreateCSVImportProfileRequest defines the data needed to save a CSV import profile.
+// Column numbers are 1-based; 0 means the column is not present.
+type CreateCSVImportProfileRequest struct {
+	AccountID        string               `json:"accountID" binding:"required,uuid"`
+	Name             string               `json:"name" binding:"required"`
+	Delimiter        string               `json:"delimiter"`                           // Defaults to ","
+	SkipRows         int                  `json:"skipRows" binding:"gte=0"`            // Rows before the header
+	HasHeader        *bool                `json:"hasHeader"`                           // Defaults to true
+	DateColumn       int                  `json:"dateColumn" binding:"required,gte=1"` // Column holding the booking date
+	DateFormat       string               `json:"dateFormat" binding:"required"`       // e.g. DD/MM/YYYY, MM/DD/YY or YYYY-MM-DD
+	AmountMode       domain.CSVAmountMode `json:"amountMode" binding:"required,oneof=SIGNED INVERTED DEBIT_CREDIT"`
+	AmountColumn     int                  `json:"amountColumn" binding:"gte=0"` // SIGNED and INVERTED
+	DebitColumn      int                  `json:"debitColumn" binding:"gte=0"`  // DEBIT_CREDIT: money out
+	CreditColumn     int                  `json:"creditColumn" binding:"gte=0"` // DEBIT_CREDIT: money in
+	DecimalSeparator string               `json:"decimalSeparator"`             // Defaults to "."
+	PayeeColumn      int                  `json:"payeeColumn" binding:"gte=0"`
+	MemoColumn       int                  `json:"memoColumn" binding:"gte=0"`
+	ReferenceColumn  int                  `json:"referenceColumn" binding:"gte=0"` // Used to skip rows imported before
+}
+
+// UpdateCSVImportProfileRequest defines the data allowed for updating a CSV import profile.
+type UpdateCSVImportProfileRequest struct {
+	Name             *string               `json:"name,omitempty"`
+	Delimiter        *string               `json:"delimiter,omitempty"`
+	SkipRows         *int                  `json:"skipRows,omitempty" binding:"omitempty,gte=0"`
+	HasHeader        *bool                 `json:"hasHeader,omitempty"`
+	DateColumn       *int                  `json:"dateColumn,omitempty" binding:"omitempty,gte=1"`
+	DateFormat       *string               `json:"dateFormat,omitempty"`
+	AmountMode       *domain.CSVAmountMode `json:"amountMode,omitempty" binding:"omitempty,oneof=SIGNED INVERTED DEBIT_CREDIT"`
+	AmountColumn     *int                  `json:"amountColumn,omitempty" binding:"omitempty,gte=0"`
+	DebitColumn      *int                  `json:"debitColumn,omitempty" binding:"omitempty,gte=0"`
+	CreditColumn     *int                  `json:"creditColumn,omitempty" binding:"omitempty,gte=0"`
+	DecimalSeparator *string               `json:"decimalSeparator,omitempty"`
+	PayeeColumn      *int                  `json:"payeeColumn,omitempty" binding:"omitempty,gte=0"`
+	MemoColumn       *int                  `json:"memoColumn,omitempty" binding:"omitempty,gte=0"`
+	ReferenceColumn  *int                  `json:"referenceColumn,omitempty" binding:"omitempty,gte=0"`
+}
+
+// ImportCSVRequest carries an uploaded CSV file. Mode and CounterAccountID are bound from the multipart
+// form; FileName and Data are filled in by the handler from the uploaded file.
+type ImportCSVRequest struct {
+	Mode             domain.CSVImportMode `form:"mode" binding:"omitempty,oneof=STAGE JOURNAL"` // Defaults to STAGE
+	CounterAccountID string               `form:"counterAccountID" binding:"omitempty,uuid"`    // JOURNAL mode: used for lines no rule matched
+	FileName         string               `form:"-"`
+	Data             []byte               `form:"-"`
+}
+
+// CSVImportProfileResponse defines the data returned for a CSV import profile
+type CSVImportProfileResponse struct {
+	ProfileID        string               `json:"profileID"`
+	WorkplaceID      string               `json:"workplaceID"`
+	AccountID        string               `json:"accountID"`
+	Name             string               `json:"name"`
+	Delimiter        string               `json:"delimiter"`
+	SkipRows         int                  `json:"skipRows"`
+	HasHeader        bool                 `json:"hasHeader"`
+	DateColumn       int                  `json:"dateColumn"`
+	DateFormat       string               `json:"dateFormat"`
+	AmountMode       domain.CSVAmountMode `json:"amountMode"`
+	AmountColumn     int                  `json:"amountColumn"`
+	DebitColumn      int                  `json:"debitColumn"`
+	CreditColumn     int                  `json:"creditColumn"`
+	DecimalSeparator string               `json:"decimalSeparator"`
+	PayeeColumn      int                  `json:"payeeColumn"`
+	MemoColumn       int                  `json:"memoColumn"`
+	ReferenceColumn  int                  `json:"referenceColumn"`
+	CreatedAt        time.Time            `json:"createdAt"`
+	CreatedBy        string               `json:"createdBy"`
+	LastUpdatedAt    time.Time            `json:"lastUpdatedAt"`
+	LastUpdatedBy    string               `json:"lastUpdatedBy"`
+}
+
+// ListCSVImportProfilesResponse wraps a list of CSV import profiles
+type ListCSVImportProfilesResponse struct {
+	Profiles []CSVImportProfileResponse `json:"profiles"`
+}
+
+// CSVPreviewRowResponse is one parsed row of a preview
+type CSVPreviewRowResponse struct {
+	RowNumber     int             `json:"rowNumber"`
+	Date          string          `json:"date,omitempty"`
+	Amount        decimal.Decimal `json:"amount"`
+	Payee         string          `json:"payee"`
+	Memo          string          `json:"memo"`
+	BankReference string          `json:"bankReference"`
+	Error         string          `json:"error,omitempty"` // Set when the row will be skipped
+}
+
+// CSVImportPreviewResponse shows how a CSV file is read with a profile
+type CSVImportPreviewResponse struct {
+	ProfileID    string                  `json:"profileID"`
+	AccountID    string                  `json:"accountID"`
+	CurrencyCode string                  `json:"currencyCode"`
+	ValidRows    int                     `json:"validRows"`
+	InvalidRows  int                     `json:"invalidRows"`
+	Rows         []CSVPreviewRowResponse `json:"rows"`
+}
+
+// CSVImportResultResponse summarises a CSV import
+type CSVImportResultResponse struct {
+	Import      BankStatementImportResponse `json:"import"`
+	Mode        domain.CSVImportMode        `json:"mode"`
+	SkippedRows int                         `json:"skippedRows"` // Rows that could not be read
+	PostedLines int                         `json:"postedLines"`
+	Failures    []domain.CSVImportFailure   `json:"failures"`
+}
+
+// ToCSVImportProfileResponse converts a domain CSVImportProfile to its response DTO
+func ToCSVImportProfileResponse(p *domain.CSVImportProfile) CSVImportProfileResponse {
+	return CSVImportProfileResponse{
+		ProfileID:        p.ProfileID,
+		WorkplaceID:      p.WorkplaceID,
+		AccountID:        p.AccountID,
+		Name:             p.Name,
+		Delimiter:        p.Delimiter,
+		SkipRows:         p.SkipRows,
+		HasHeader:        p.HasHeader,
+		DateColumn:       p.DateColumn,
+		DateFormat:       p.DateFormat,
+		AmountMode:       p.AmountMode,
+		AmountColumn:     p.AmountColumn,
+		DebitColumn:      p.DebitColumn,
+		CreditColumn:     p.CreditColumn,
+		DecimalSeparator: p.DecimalSeparator,
+		PayeeColumn:      p.PayeeColumn,
+		MemoColumn:       p.MemoColumn,
+		ReferenceColumn:  p.ReferenceColumn,
+		CreatedAt:        p.CreatedAt,
+		CreatedBy:        p.CreatedBy,
+		LastUpdatedAt:    p.LastUpdatedAt,
+		LastUpdatedBy:    p.LastUpdatedBy,
+	}
+}
+
+// ToListCSVImportProfilesResponse converts CSV import profiles to a list response
+func ToListCSVImportProfilesResponse(profiles []domain.CSVImportProfile) ListCSVImportProfilesResponse {
+	resp := ListCSVImportProfilesResponse{Profiles: make([]CSVImportProfileResponse, 0, len(profiles))}
+	for i := range profiles {
+		resp.Profiles = append(resp.Profiles, ToCSVImportProfileResponse(&profiles[i]))
+	}
+	return resp
+}
+
+// ToCSVImportPreviewResponse converts a domain CSVImportPreview to its response DTO
+func ToCSVImportPreviewResponse(p *domain.CSVImportPreview) CSVImportPreviewResponse {
+	resp := CSVImportPreviewResponse{
+		ProfileID:    p.ProfileID,
+		AccountID:    p.AccountID,
+		CurrencyCode: p.CurrencyCode,
+		ValidRows:    p.ValidRows,
+		InvalidRows:  p.InvalidRows,
+		Rows:         make([]CSVPreviewRowResponse, 0, len(p.Rows)),
+	}
+	for _, row := range p.Rows {
+		r := CSVPreviewRowResponse{
+			RowNumber:     row.RowNumber,
+			Amount:        row.Amount,
+			Payee:         row.Payee,
+			Memo:          row.Memo,
+			BankReference: row.BankReference,
+			Error:         row.Error,
+		}
+		if !row.Date.IsZero() {
+			r.Date = row.Date.Format("2006-01-02")
+		}
+		resp.Rows = append(resp.Rows, r)
+	}
+	return resp
+}
+
+// ToCSVImportResultResponse converts a domain CSVImportResult to its response DTO
+func ToCSVImportResultResponse(r *domain.CSVImportResult) CSVImportResultResponse {
+	return CSVImportResultResponse{
+		Import:      ToBankStatementImportResponse(&r.Import),
+		Mode:        r.Mode,
+		SkippedRows: r.SkippedRows,
+		PostedLines: r.PostedLines,
+		Failures:    r.Failures,
+	}
+}
@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// --- Dimension DTOs ---
+
+// DimensionRequest defines the details of a dimension. It is used to create a dimension and, with PUT semantics,
+// to replace all fields of an existing one.
+type DimensionRequest struct {
+	Code        string `json:"code" binding:"required,max=50"` // Short code, e.g. PROJECT; stored upper-case
+	Name        string `json:"name" binding:"required,max=255"`
+	Description string `json:"description"`
+	IsActive    *bool  `json:"isActive"` // Defaults to true
+}
+
+// DimensionValueRequest defines the details of a dimension value. It is used to create a value and, with PUT
+// semantics, to replace all fields of an existing one.
+type DimensionValueRequest struct {
+	Code     string `json:"code" binding:"required,max=50"` // Short code, e.g. WEBSITE; stored upper-case
+	Name     string `json:"name" binding:"required,max=255"`
+	IsActive *bool  `json:"isActive"` // Defaults to true; inactive values cannot be assigned anymore
+}
+
+// ListDimensionsParams defines query parameters for listing dimensions
+type ListDimensionsParams struct {
+	IncludeInactive bool `form:"includeInactive"` // Include deactivated dimensions and values
+}
+
+// DimensionValueResponse defines the data returned for a dimension value
+type DimensionValueResponse struct {
+	ValueID       string    `json:"valueID"`
+	DimensionID   string    `json:"dimensionID"`
+	Code          string    `json:"code"`
+	Name          string    `json:"name"`
+	IsActive      bool      `json:"isActive"`
+	CreatedAt     time.Time `json:"createdAt"`
+	CreatedBy     string    `json:"createdBy"`
+	LastUpdatedAt time.Time `json:"lastUpdatedAt"`
+	LastUpdatedBy string    `json:"lastUpdatedBy"`
+}
+
+// DimensionResponse defines the data returned for a dimension
+type DimensionResponse struct {
+	DimensionID   string                   `json:"dimensionID"`
+	WorkplaceID   string                   `json:"workplaceID"`
+	Code          string                   `json:"code"`
+	Name          string                   `json:"name"`
+	Description   string                   `json:"description,omitempty"`
+	IsActive      bool                     `json:"isActive"`
+	Values        []DimensionValueResponse `json:"values"` // Ordered by code
+	CreatedAt     time.Time                `json:"createdAt"`
+	CreatedBy     string                   `json:"createdBy"`
+	LastUpdatedAt time.Time                `json:"lastUpdatedAt"`
+	LastUpdatedBy string                   `json:"lastUpdatedBy"`
+}
+
+// ListDimensionsResponse wraps dimensions ordered by code
+type ListDimensionsResponse struct {
+	Dimensions []DimensionResponse `json:"dimensions"`
+}
+
+// ToDimensionValueResponse converts a domain DimensionValue to its response DTO
+func ToDimensionValueResponse(v *domain.DimensionValue) DimensionValueResponse {
+	return DimensionValueResponse{
+		ValueID:       v.ValueID,
+		DimensionID:   v.DimensionID,
+		Code:          v.Code,
+		Name:          v.Name,
+		IsActive:      v.IsActive,
+		CreatedAt:     v.CreatedAt,
+		CreatedBy:     v.CreatedBy,
+		LastUpdatedAt: v.LastUpdatedAt,
+		LastUpdatedBy: v.LastUpdatedBy,
+	}
+}
+
+// ToDimensionResponse converts a domain Dimension to its response DTO
+func ToDimensionResponse(d *domain.Dimension) DimensionResponse {
+	values := make([]DimensionValueResponse, len(d.Values))
+	for i := range d.Values {
+		values[i] = ToDimensionValueResponse(&d.Values[i])
+	}
+	return DimensionResponse{
+		DimensionID:   d.DimensionID,
+		WorkplaceID:   d.WorkplaceID,
+		Code:          d.Code,
+		Name:          d.Name,
+		Description:   d.Description,
+		IsActive:      d.IsActive,
+		Values:        values,
+		CreatedAt:     d.CreatedAt,
+		CreatedBy:     d.CreatedBy,
+		LastUpdatedAt: d.LastUpdatedAt,
+		LastUpdatedBy: d.LastUpdatedBy,
+	}
+}
+
+// ToListDimensionsResponse converts domain dimensions to a list response DTO
+func ToListDimensionsResponse(dimensions []domain.Dimension) ListDimensionsResponse {
+	list := make([]DimensionResponse, len(dimensions))
+	for i := range dimensions {
+		list[i] = ToDimensionResponse(&dimensions[i])
+	}
+	return ListDimensionsResponse{Dimensions: list}
+}
+
+// DimensionGroupByResponse identifies the dimension a report is grouped by
+type DimensionGroupByResponse struct {
+	DimensionID string `json:"dimensionID"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+}
+
+// DimensionGroupReportResponse is the report restricted to one value of the grouping dimension
+type DimensionGroupReportResponse struct {
+	ValueID string `json:"valueID,omitempty"` // Empty for the lines without a value
+	Code    string `json:"code,omitempty"`
+	Name    string `json:"name"`
+	Report  any    `json:"report"` // Same shape as the ungrouped report
+}
+
+// GroupedReportResponse is a report grouped by a dimension: one report per value, then one for the untagged lines
+type GroupedReportResponse struct {
+	GroupBy DimensionGroupByResponse       `json:"groupBy"`
+	Groups  []DimensionGroupReportResponse `json:"groups"`
+}
@@ -0,0 +1,131 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Duplicate Journal DTOs ---
+
+// ListDuplicatePairsParams defines query parameters for listing suspected duplicate journals
+type ListDuplicatePairsParams struct {
+	Status domain.DuplicatePairStatus `form:"status" binding:"omitempty,oneof=PENDING CONFIRMED DISMISSED"` // All statuses when empty
+}
+
+// ConfirmDuplicatePairRequest confirms a pair by reversing one of its journals
+type ConfirmDuplicatePairRequest struct {
+	ReverseJournalID string `json:"reverseJournalID" binding:"omitempty,uuid"` // Defaults to the journal that raised the flag
+	Note             string `json:"note"`
+}
+
+// DismissDuplicatePairRequest records that both journals of a pair are genuine
+type DismissDuplicatePairRequest struct {
+	Note string `json:"note"`
+}
+
+// DuplicateJournalInfoResponse summarizes one side of a duplicate pair
+type DuplicateJournalInfoResponse struct {
+	JournalID    string               `json:"journalID"`
+	JournalDate  time.Time            `json:"journalDate"`
+	Description  string               `json:"description"`
+	CurrencyCode string               `json:"currencyCode"`
+	Amount       decimal.Decimal      `json:"amount"`
+	Status       domain.JournalStatus `json:"status"`
+}
+
+// DuplicatePairResponse defines the data returned for a suspected duplicate pair
+type DuplicatePairResponse struct {
+	PairID            string                        `json:"pairID"`
+	WorkplaceID       string                        `json:"workplaceID"`
+	Journal           *DuplicateJournalInfoResponse `json:"journal,omitempty"`     // The journal that raised the flag
+	DuplicateOf       *DuplicateJournalInfoResponse `json:"duplicateOf,omitempty"` // The earlier journal it resembles
+	Similarity        float64                       `json:"similarity"`
+	Status            domain.DuplicatePairStatus    `json:"status"`
+	ReversedJournalID string                        `json:"reversedJournalID,omitempty"`
+	ResolutionNote    string                        `json:"resolutionNote,omitempty"`
+	ResolvedAt        *time.Time                    `json:"resolvedAt,omitempty"`
+	ResolvedBy        string                        `json:"resolvedBy,omitempty"`
+	CreatedAt         time.Time                     `json:"createdAt"`
+}
+
+// ListDuplicatePairsResponse wraps suspected duplicate pairs, newest first
+type ListDuplicatePairsResponse struct {
+	Pairs []DuplicatePairResponse `json:"pairs"`
+}
+
+// DuplicateWarningResponse is the non-blocking warning returned when a new journal resembles an earlier one
+type DuplicateWarningResponse struct {
+	PairID               string    `json:"pairID"`
+	DuplicateOfJournalID string    `json:"duplicateOfJournalID"`
+	JournalDate          time.Time `json:"journalDate"`
+	Description          string    `json:"description"`
+	Similarity           float64   `json:"similarity"`
+	Message              string    `json:"message"`
+}
+
+// toDuplicateJournalInfoResponse converts one side of a pair, if loaded
+func toDuplicateJournalInfoResponse(info *domain.DuplicateJournalInfo) *DuplicateJournalInfoResponse {
+	if info == nil {
+		return nil
+	}
+	return &DuplicateJournalInfoResponse{
+		JournalID:    info.JournalID,
+		JournalDate:  info.JournalDate,
+		Description:  info.Description,
+		CurrencyCode: info.CurrencyCode,
+		Amount:       info.Amount,
+		Status:       info.Status,
+	}
+}
+
+// ToDuplicatePairResponse converts a domain DuplicateJournalPair to its response DTO
+func ToDuplicatePairResponse(p *domain.DuplicateJournalPair) DuplicatePairResponse {
+	return DuplicatePairResponse{
+		PairID:            p.PairID,
+		WorkplaceID:       p.WorkplaceID,
+		Journal:           toDuplicateJournalInfoResponse(p.Journal),
+		DuplicateOf:       toDuplicateJournalInfoResponse(p.DuplicateOf),
+		Similarity:        p.Similarity,
+		Status:            p.Status,
+		ReversedJournalID: p.ReversedJournalID,
+		ResolutionNote:    p.ResolutionNote,
+		ResolvedAt:        p.ResolvedAt,
+		ResolvedBy:        p.ResolvedBy,
+		CreatedAt:         p.CreatedAt,
+	}
+}
+
+// ToListDuplicatePairsResponse converts suspected duplicate pairs to a list response
+func ToListDuplicatePairsResponse(pairs []domain.DuplicateJournalPair) ListDuplicatePairsResponse {
+	resp := ListDuplicatePairsResponse{Pairs: make([]DuplicatePairResponse, 0, len(pairs))}
+	for i := range pairs {
+		resp.Pairs = append(resp.Pairs, ToDuplicatePairResponse(&pairs[i]))
+	}
+	return resp
+}
+
+// ToDuplicateWarningResponses converts the pairs raised by CreateJournal to warnings
+func ToDuplicateWarningResponses(pairs []domain.DuplicateJournalPair) []DuplicateWarningResponse {
+	if len(pairs) == 0 {
+		return nil
+	}
+	warnings := make([]DuplicateWarningResponse, 0, len(pairs))
+	for _, p := range pairs {
+		w := DuplicateWarningResponse{
+			PairID:               p.PairID,
+			DuplicateOfJournalID: p.DuplicateOfJournalID,
+			Similarity:           p.Similarity,
+		}
+		if p.DuplicateOf != nil {
+			w.JournalDate = p.DuplicateOf.JournalDate
+			w.Description = p.DuplicateOf.Description
+		}
+		w.Message = fmt.Sprintf("Possible duplicate of journal %q dated %s; review it under duplicate journals",
+			w.Description, w.JournalDate.Format("2006-01-02"))
+		warnings = append(warnings, w)
+	}
+	return warnings
+}
@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Envelope budgeting DTOs ---
+
+// ConfigureEnvelopesRequest enables envelope budgeting for a workplace or changes its start month.
+type ConfigureEnvelopesRequest struct {
+	StartMonth string `json:"startMonth" binding:"required" example:"2025-01"` // YYYY-MM
+}
+
+// AssignEnvelopeRequest assigns money to an expense envelope for a month; a negative amount returns money to available-to-budget.
+type AssignEnvelopeRequest struct {
+	AccountID string          `json:"accountID" binding:"required,uuid"`
+	Month     string          `json:"month" binding:"required" example:"2025-01"` // YYYY-MM
+	Amount    decimal.Decimal `json:"amount"`
+	Note      string          `json:"note,omitempty"`
+}
+
+// MoveEnvelopeRequest moves money from one envelope to another within a month.
+type MoveEnvelopeRequest struct {
+	FromAccountID string          `json:"fromAccountID" binding:"required,uuid"`
+	ToAccountID   string          `json:"toAccountID" binding:"required,uuid"`
+	Month         string          `json:"month" binding:"required" example:"2025-01"` // YYYY-MM
+	Amount        decimal.Decimal `json:"amount"`
+	Note          string          `json:"note,omitempty"`
+}
+
+// EnvelopeSettingsResponse defines the envelope settings returned for a workplace
+type EnvelopeSettingsResponse struct {
+	WorkplaceID   string    `json:"workplaceID"`
+	StartMonth    string    `json:"startMonth"`
+	LastUpdatedAt time.Time `json:"lastUpdatedAt"`
+	LastUpdatedBy string    `json:"lastUpdatedBy"`
+}
+
+// ToEnvelopeSettingsResponse converts domain.EnvelopeSettings to an EnvelopeSettingsResponse DTO
+func ToEnvelopeSettingsResponse(s *domain.EnvelopeSettings) EnvelopeSettingsResponse {
+	return EnvelopeSettingsResponse{
+		WorkplaceID:   s.WorkplaceID,
+		StartMonth:    s.StartMonth.Format("2006-01"),
+		LastUpdatedAt: s.LastUpdatedAt,
+		LastUpdatedBy: s.LastUpdatedBy,
+	}
+}
+
+// EnvelopeAllocationResponse defines the data returned for an allocation
+type EnvelopeAllocationResponse struct {
+	AllocationID string          `json:"allocationID"`
+	AccountID    string          `json:"accountID"`
+	Month        string          `json:"month"`
+	Amount       decimal.Decimal `json:"amount"`
+	TransferID   string          `json:"transferID,omitempty"`
+	Note         string          `json:"note,omitempty"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	CreatedBy    string          `json:"createdBy"`
+}
+
+// ListEnvelopeAllocationsResponse wraps a list of allocations
+type ListEnvelopeAllocationsResponse struct {
+	Allocations []EnvelopeAllocationResponse `json:"allocations"`
+}
+
+// ToEnvelopeAllocationResponse converts a domain.EnvelopeAllocation to an EnvelopeAllocationResponse DTO
+func ToEnvelopeAllocationResponse(a domain.EnvelopeAllocation) EnvelopeAllocationResponse {
+	return EnvelopeAllocationResponse{
+		AllocationID: a.AllocationID,
+		AccountID:    a.AccountID,
+		Month:        a.Month.Format("2006-01"),
+		Amount:       a.Amount,
+		TransferID:   a.TransferID,
+		Note:         a.Note,
+		CreatedAt:    a.CreatedAt,
+		CreatedBy:    a.CreatedBy,
+	}
+}
+
+// ToListEnvelopeAllocationsResponse converts a slice of allocations to a ListEnvelopeAllocationsResponse DTO
+func ToListEnvelopeAllocationsResponse(allocations []domain.EnvelopeAllocation) ListEnvelopeAllocationsResponse {
+	response := ListEnvelopeAllocationsResponse{Allocations: make([]EnvelopeAllocationResponse, len(allocations))}
+	for i, allocation := range allocations {
+		response.Allocations[i] = ToEnvelopeAllocationResponse(allocation)
+	}
+	return response
+}
+
+// EnvelopeLineResponse is the state of one envelope in a month
+type EnvelopeLineResponse struct {
+	AccountID   string          `json:"accountID,omitempty"`
+	Name        string          `json:"name"`
+	CarriedOver decimal.Decimal `json:"carriedOver"`
+	Assigned    decimal.Decimal `json:"assigned"`
+	Activity    decimal.Decimal `json:"activity"`
+	Available   decimal.Decimal `json:"available"`
+}
+
+// EnvelopeMonthResponse represents the envelope budget state of one month
+type EnvelopeMonthResponse struct {
+	Month               string                 `json:"month"`
+	StartMonth          string                 `json:"startMonth"`
+	Income              decimal.Decimal        `json:"income"`
+	AvailableToBudget   decimal.Decimal        `json:"availableToBudget"`
+	UnenvelopedActivity decimal.Decimal        `json:"unenvelopedActivity"`
+	Envelopes           []EnvelopeLineResponse `json:"envelopes"`
+	Total               EnvelopeLineResponse   `json:"total"`
+}
+
+func toEnvelopeLineResponse(line domain.EnvelopeLine) EnvelopeLineResponse {
+	return EnvelopeLineResponse{
+		AccountID:   line.AccountID,
+		Name:        line.Name,
+		CarriedOver: line.CarriedOver,
+		Assigned:    line.Assigned,
+		Activity:    line.Activity,
+		Available:   line.Available,
+	}
+}
+
+// ToEnvelopeMonthResponse converts a domain.EnvelopeMonth to an EnvelopeMonthResponse DTO
+func ToEnvelopeMonthResponse(m *domain.EnvelopeMonth) EnvelopeMonthResponse {
+	response := EnvelopeMonthResponse{
+		Month:               m.Month.Label,
+		StartMonth:          m.StartMonth.Format("2006-01"),
+		Income:              m.Income,
+		AvailableToBudget:   m.AvailableToBudget,
+		UnenvelopedActivity: m.UnenvelopedActivity,
+		Envelopes:           make([]EnvelopeLineResponse, len(m.Envelopes)),
+		Total:               toEnvelopeLineResponse(m.Total),
+	}
+	for i, line := range m.Envelopes {
+		response.Envelopes[i] = toEnvelopeLineResponse(line)
+	}
+	return response
+}
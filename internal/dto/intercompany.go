@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// --- Intercompany Journal DTOs ---
+
+// CreateIntercompanyJournalRequest defines an intercompany posting: mirrored journals in this workplace and in a
+// counterparty workplace, written together. Each side lists its own lines; the service balances this side with a
+// due-from line and the counterparty with a due-to line of the same amount, so the lines of both sides must net to
+// opposite amounts.
+type CreateIntercompanyJournalRequest struct {
+	Date             time.Time                       `json:"date" binding:"required"`
+	Description      string                          `json:"description" binding:"required"`
+	CurrencyCode     string                          `json:"currencyCode" binding:"required,iso4217"`
+	DueFromAccountID string                          `json:"dueFromAccountID" binding:"required,uuid"` // ASSET account in this workplace; what the counterparty owes it
+	Transactions     []CreateTransactionRequest      `json:"transactions" binding:"required,min=1,dive"`
+	Counterparty     IntercompanyCounterpartyRequest `json:"counterparty" binding:"required"`
+}
+
+// IntercompanyCounterpartyRequest defines the counterparty side of an intercompany posting
+type IntercompanyCounterpartyRequest struct {
+	WorkplaceID    string                     `json:"workplaceID" binding:"required,uuid"`
+	DueToAccountID string                     `json:"dueToAccountID" binding:"required,uuid"` // LIABILITY account in the counterparty workplace; what it owes this workplace
+	Transactions   []CreateTransactionRequest `json:"transactions" binding:"required,min=1,dive"`
+}
+
+// IntercompanyJournalResponse defines the two journals of an intercompany posting or of its reversal
+type IntercompanyJournalResponse struct {
+	Journal JournalResponse `json:"journal"` // In the workplace of the request
+	Twin    JournalResponse `json:"twin"`    // In the counterparty workplace
+}
+
+// ToIntercompanyJournalResponse converts the two journals of an intercompany posting to the response DTO
+func ToIntercompanyJournalResponse(journal *domain.Journal, twin *domain.Journal) IntercompanyJournalResponse {
+	return IntercompanyJournalResponse{
+		Journal: ToJournalResponse(journal),
+		Twin:    ToJournalResponse(twin),
+	}
+}
@@ -0,0 +1,247 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Investment DTOs ---
+
+// SecurityRequest defines the details of a security. It is used to create a security and, with PUT semantics,
+// to replace the fields of an existing one; the currency cannot change once set.
+type SecurityRequest struct {
+	Symbol       string              `json:"symbol" binding:"required,max=50"`
+	Name         string              `json:"name" binding:"required"`
+	SecurityType domain.SecurityType `json:"securityType" binding:"required,oneof=STOCK MUTUAL_FUND ETF BOND CRYPTO OTHER"`
+	CurrencyCode string              `json:"currencyCode" binding:"required,iso4217"`
+	CostMethod   domain.CostMethod   `json:"costMethod" binding:"omitempty,oneof=FIFO LIFO AVERAGE"` // Defaults to FIFO
+	IsActive     *bool               `json:"isActive"`                                               // Defaults to true
+}
+
+// SecurityPriceRequest records the price of a security on a date. The price is stored as the commodity price
+// of the security's symbol in its currency.
+type SecurityPriceRequest struct {
+	Date  time.Time       `json:"date" binding:"required"`
+	Price decimal.Decimal `json:"price" binding:"required,decimal_gtz"`
+}
+
+// BuySecurityRequest records a purchase of a security into an investment account
+type BuySecurityRequest struct {
+	SecurityID          string          `json:"securityID" binding:"required,uuid"`
+	InvestmentAccountID string          `json:"investmentAccountID" binding:"required,uuid"` // ASSET account holding the security
+	CashAccountID       string          `json:"cashAccountID" binding:"required,uuid"`       // Account paying for the purchase
+	Quantity            decimal.Decimal `json:"quantity" binding:"required,decimal_gtz"`
+	UnitPrice           decimal.Decimal `json:"unitPrice" binding:"required,decimal_gtz"`
+	Fees                decimal.Decimal `json:"fees"` // Added to the cost basis
+	Date                time.Time       `json:"date" binding:"required"`
+	Description         string          `json:"description"` // Defaults to "Buy <quantity> <symbol>"
+}
+
+// SellSecurityRequest records a sale of a security out of an investment account
+type SellSecurityRequest struct {
+	SecurityID          string          `json:"securityID" binding:"required,uuid"`
+	InvestmentAccountID string          `json:"investmentAccountID" binding:"required,uuid"` // ASSET account holding the security
+	CashAccountID       string          `json:"cashAccountID" binding:"required,uuid"`       // Account receiving the proceeds
+	GainLossAccountID   string          `json:"gainLossAccountID" binding:"required,uuid"`   // REVENUE or EXPENSE account for the realized gain or loss
+	Quantity            decimal.Decimal `json:"quantity" binding:"required,decimal_gtz"`
+	UnitPrice           decimal.Decimal `json:"unitPrice" binding:"required,decimal_gtz"`
+	Fees                decimal.Decimal `json:"fees"` // Deducted from the proceeds
+	Date                time.Time       `json:"date" binding:"required"`
+	Description         string          `json:"description"` // Defaults to "Sell <quantity> <symbol>"
+}
+
+// ListLotsParams defines query parameters for listing open lots
+type ListLotsParams struct {
+	AccountID  string     `form:"accountID" binding:"omitempty,uuid"`
+	SecurityID string     `form:"securityID" binding:"omitempty,uuid"`
+	AsOf       *time.Time `form:"asOf" time_format:"2006-01-02"` // Defaults to now
+}
+
+// HoldingsParams defines query parameters for the holdings report
+type HoldingsParams struct {
+	AccountID string     `form:"accountID" binding:"omitempty,uuid"`
+	AsOf      *time.Time `form:"asOf" time_format:"2006-01-02"` // Defaults to now
+}
+
+// SecurityResponse defines the data returned for a security
+type SecurityResponse struct {
+	SecurityID    string              `json:"securityID"`
+	WorkplaceID   string              `json:"workplaceID"`
+	Symbol        string              `json:"symbol"`
+	Name          string              `json:"name"`
+	SecurityType  domain.SecurityType `json:"securityType"`
+	CurrencyCode  string              `json:"currencyCode"`
+	CostMethod    domain.CostMethod   `json:"costMethod"`
+	IsActive      bool                `json:"isActive"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	CreatedBy     string              `json:"createdBy"`
+	LastUpdatedAt time.Time           `json:"lastUpdatedAt"`
+	LastUpdatedBy string              `json:"lastUpdatedBy"`
+}
+
+// ListSecuritiesResponse wraps securities, ordered by symbol
+type ListSecuritiesResponse struct {
+	Securities []SecurityResponse `json:"securities"`
+}
+
+// TradeResponse defines the outcome of a buy or sell
+type TradeResponse struct {
+	Journal      JournalResponse `json:"journal"`
+	CostBasis    decimal.Decimal `json:"costBasis"`
+	Proceeds     decimal.Decimal `json:"proceeds"`
+	RealizedGain decimal.Decimal `json:"realizedGain"` // Negative for a loss
+}
+
+// LotResponse defines an open lot
+type LotResponse struct {
+	AccountID     string          `json:"accountID"`
+	SecurityID    string          `json:"securityID"`
+	TransactionID string          `json:"transactionID"`
+	JournalID     string          `json:"journalID"`
+	AcquiredOn    time.Time       `json:"acquiredOn"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	CostBasis     decimal.Decimal `json:"costBasis"`
+	UnitPrice     decimal.Decimal `json:"unitPrice"`
+}
+
+// ListLotsResponse wraps open lots, oldest first
+type ListLotsResponse struct {
+	Lots []LotResponse `json:"lots"`
+}
+
+// HoldingResponse defines the position in one security held in one account
+type HoldingResponse struct {
+	AccountID      string           `json:"accountID"`
+	SecurityID     string           `json:"securityID"`
+	Symbol         string           `json:"symbol"`
+	CurrencyCode   string           `json:"currencyCode"`
+	Quantity       decimal.Decimal  `json:"quantity"`
+	CostBasis      decimal.Decimal  `json:"costBasis"`
+	Price          *decimal.Decimal `json:"price,omitempty"`
+	PriceDate      *time.Time       `json:"priceDate,omitempty"`
+	MarketValue    *decimal.Decimal `json:"marketValue,omitempty"`
+	UnrealizedGain *decimal.Decimal `json:"unrealizedGain,omitempty"`
+}
+
+// HoldingsResponse defines the holdings report
+type HoldingsResponse struct {
+	AsOf     time.Time         `json:"asOf"`
+	Holdings []HoldingResponse `json:"holdings"`
+}
+
+// UnrealizedGainTotalResponse sums the holdings in one currency
+type UnrealizedGainTotalResponse struct {
+	CurrencyCode      string          `json:"currencyCode"`
+	CostBasis         decimal.Decimal `json:"costBasis"`
+	MarketValue       decimal.Decimal `json:"marketValue"`
+	UnrealizedGain    decimal.Decimal `json:"unrealizedGain"`
+	UnpricedCostBasis decimal.Decimal `json:"unpricedCostBasis"` // Cost basis of holdings without a price
+}
+
+// UnrealizedGainReportResponse defines the unrealized gain report
+type UnrealizedGainReportResponse struct {
+	AsOf     time.Time                     `json:"asOf"`
+	Totals   []UnrealizedGainTotalResponse `json:"totals"`
+	Holdings []HoldingResponse             `json:"holdings"`
+}
+
+// ToSecurityResponse converts a domain Security to its response DTO
+func ToSecurityResponse(s *domain.Security) SecurityResponse {
+	return SecurityResponse{
+		SecurityID:    s.SecurityID,
+		WorkplaceID:   s.WorkplaceID,
+		Symbol:        s.Symbol,
+		Name:          s.Name,
+		SecurityType:  s.SecurityType,
+		CurrencyCode:  s.CurrencyCode,
+		CostMethod:    s.CostMethod,
+		IsActive:      s.IsActive,
+		CreatedAt:     s.CreatedAt,
+		CreatedBy:     s.CreatedBy,
+		LastUpdatedAt: s.LastUpdatedAt,
+		LastUpdatedBy: s.LastUpdatedBy,
+	}
+}
+
+// ToListSecuritiesResponse converts domain securities to the list response DTO
+func ToListSecuritiesResponse(securities []domain.Security) ListSecuritiesResponse {
+	resp := ListSecuritiesResponse{Securities: make([]SecurityResponse, 0, len(securities))}
+	for i := range securities {
+		resp.Securities = append(resp.Securities, ToSecurityResponse(&securities[i]))
+	}
+	return resp
+}
+
+// ToTradeResponse converts a domain Trade to its response DTO
+func ToTradeResponse(t *domain.Trade) TradeResponse {
+	return TradeResponse{
+		Journal:      ToJournalResponse(&t.Journal),
+		CostBasis:    t.CostBasis,
+		Proceeds:     t.Proceeds,
+		RealizedGain: t.RealizedGain,
+	}
+}
+
+// ToListLotsResponse converts domain lots to the list response DTO
+func ToListLotsResponse(lots []domain.Lot) ListLotsResponse {
+	resp := ListLotsResponse{Lots: make([]LotResponse, 0, len(lots))}
+	for _, lot := range lots {
+		resp.Lots = append(resp.Lots, LotResponse{
+			AccountID:     lot.AccountID,
+			SecurityID:    lot.SecurityID,
+			TransactionID: lot.TransactionID,
+			JournalID:     lot.JournalID,
+			AcquiredOn:    lot.AcquiredOn,
+			Quantity:      lot.Quantity,
+			CostBasis:     lot.CostBasis,
+			UnitPrice:     lot.UnitPrice,
+		})
+	}
+	return resp
+}
+
+// ToHoldingsResponse converts domain Holdings to the response DTO
+func ToHoldingsResponse(h *domain.Holdings) HoldingsResponse {
+	return HoldingsResponse{AsOf: h.AsOf, Holdings: toHoldingResponses(h.Holdings)}
+}
+
+// toHoldingResponses converts domain holdings to their response DTOs
+func toHoldingResponses(holdings []domain.Holding) []HoldingResponse {
+	resp := make([]HoldingResponse, 0, len(holdings))
+	for _, holding := range holdings {
+		resp = append(resp, HoldingResponse{
+			AccountID:      holding.AccountID,
+			SecurityID:     holding.SecurityID,
+			Symbol:         holding.Symbol,
+			CurrencyCode:   holding.CurrencyCode,
+			Quantity:       holding.Quantity,
+			CostBasis:      holding.CostBasis,
+			Price:          holding.Price,
+			PriceDate:      holding.PriceDate,
+			MarketValue:    holding.MarketValue,
+			UnrealizedGain: holding.UnrealizedGain,
+		})
+	}
+	return resp
+}
+
+// ToUnrealizedGainReportResponse converts a domain UnrealizedGainReport to the response DTO
+func ToUnrealizedGainReportResponse(r *domain.UnrealizedGainReport) UnrealizedGainReportResponse {
+	resp := UnrealizedGainReportResponse{
+		AsOf:     r.AsOf,
+		Totals:   make([]UnrealizedGainTotalResponse, 0, len(r.Totals)),
+		Holdings: toHoldingResponses(r.Holdings),
+	}
+	for _, total := range r.Totals {
+		resp.Totals = append(resp.Totals, UnrealizedGainTotalResponse{
+			CurrencyCode:      total.CurrencyCode,
+			CostBasis:         total.CostBasis,
+			MarketValue:       total.MarketValue,
+			UnrealizedGain:    total.UnrealizedGain,
+			UnpricedCostBasis: total.UnpricedCostBasis,
+		})
+	}
+	return resp
+}
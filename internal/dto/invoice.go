@@ -0,0 +1,191 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Invoice DTOs ---
+
+// InvoiceLineRequest defines a line item of an invoice
+type InvoiceLineRequest struct {
+	Description      string          `json:"description" binding:"required,max=500"`
+	Quantity         decimal.Decimal `json:"quantity" binding:"required,decimal_gtz"`
+	UnitPrice        decimal.Decimal `json:"unitPrice"`                                // Must not be negative
+	RevenueAccountID string          `json:"revenueAccountID" binding:"required,uuid"` // REVENUE account credited with the line
+	TaxCodeID        string          `json:"taxCodeID" binding:"omitempty,uuid"`       // Optional; the tax is credited to the account of the code
+}
+
+// InvoiceRequest defines the content of a draft invoice
+type InvoiceRequest struct {
+	CustomerID          string               `json:"customerID" binding:"required,uuid"`          // Payee billed
+	ReceivableAccountID string               `json:"receivableAccountID" binding:"required,uuid"` // ASSET account debited on issue; sets the invoice currency
+	IssueDate           time.Time            `json:"issueDate" binding:"required"`
+	DueDate             *time.Time           `json:"dueDate"` // Defaults to the issue date
+	Notes               string               `json:"notes" binding:"max=1000"`
+	Lines               []InvoiceLineRequest `json:"lines" binding:"required,min=1,max=200,dive"`
+}
+
+// RecordInvoicePaymentRequest records a payment received against an issued invoice
+type RecordInvoicePaymentRequest struct {
+	PaymentAccountID string          `json:"paymentAccountID" binding:"required,uuid"` // ASSET account receiving the payment
+	Amount           decimal.Decimal `json:"amount" binding:"required,decimal_gtz"`    // At most the outstanding amount
+	Date             time.Time       `json:"date" binding:"required"`
+}
+
+// ListInvoicesParams defines query parameters for listing invoices
+type ListInvoicesParams struct {
+	CustomerID string               `form:"customerID" binding:"omitempty,uuid"`
+	Status     domain.InvoiceStatus `form:"status" binding:"omitempty,oneof=DRAFT ISSUED PARTIALLY_PAID PAID OVERDUE VOID"`
+}
+
+// InvoiceSequenceRequest configures the numbering of issued invoices
+type InvoiceSequenceRequest struct {
+	Prefix     string `json:"prefix" binding:"max=20"`
+	NextNumber int64  `json:"nextNumber" binding:"required,min=1"`
+	Padding    int    `json:"padding" binding:"min=0,max=12"`
+}
+
+// InvoiceLineResponse defines the data returned for an invoice line
+type InvoiceLineResponse struct {
+	LineID           string          `json:"lineID"`
+	LineNumber       int             `json:"lineNumber"`
+	Description      string          `json:"description"`
+	Quantity         decimal.Decimal `json:"quantity"`
+	UnitPrice        decimal.Decimal `json:"unitPrice"`
+	RevenueAccountID string          `json:"revenueAccountID"`
+	TaxCodeID        string          `json:"taxCodeID,omitempty"`
+	Amount           decimal.Decimal `json:"amount"`
+	NetAmount        decimal.Decimal `json:"netAmount"`
+	TaxAmount        decimal.Decimal `json:"taxAmount"`
+}
+
+// InvoicePaymentResponse defines a payment received against an invoice
+type InvoicePaymentResponse struct {
+	PaymentID   string          `json:"paymentID"`
+	InvoiceID   string          `json:"invoiceID"`
+	JournalID   string          `json:"journalID"`
+	PaymentDate time.Time       `json:"paymentDate"`
+	Amount      decimal.Decimal `json:"amount"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy"`
+}
+
+// InvoiceResponse defines the data returned for an invoice. Status, amount paid and outstanding are derived
+// from the journals as of today.
+type InvoiceResponse struct {
+	InvoiceID           string                   `json:"invoiceID"`
+	WorkplaceID         string                   `json:"workplaceID"`
+	CustomerID          string                   `json:"customerID"`
+	ReceivableAccountID string                   `json:"receivableAccountID"`
+	InvoiceNumber       string                   `json:"invoiceNumber,omitempty"`
+	IssueDate           time.Time                `json:"issueDate"`
+	DueDate             time.Time                `json:"dueDate"`
+	CurrencyCode        string                   `json:"currencyCode"`
+	Notes               string                   `json:"notes,omitempty"`
+	Subtotal            decimal.Decimal          `json:"subtotal"`
+	TaxTotal            decimal.Decimal          `json:"taxTotal"`
+	Total               decimal.Decimal          `json:"total"`
+	Status              domain.InvoiceStatus     `json:"status"`
+	AmountPaid          decimal.Decimal          `json:"amountPaid"`
+	Outstanding         decimal.Decimal          `json:"outstanding"`
+	IssueJournalID      string                   `json:"issueJournalID,omitempty"`
+	Lines               []InvoiceLineResponse    `json:"lines,omitempty"` // Omitted from lists
+	Payments            []InvoicePaymentResponse `json:"payments"`
+	CreatedAt           time.Time                `json:"createdAt"`
+	CreatedBy           string                   `json:"createdBy"`
+	LastUpdatedAt       time.Time                `json:"lastUpdatedAt"`
+	LastUpdatedBy       string                   `json:"lastUpdatedBy"`
+}
+
+// ListInvoicesResponse wraps invoices, newest first
+type ListInvoicesResponse struct {
+	Invoices []InvoiceResponse `json:"invoices"`
+}
+
+// InvoiceSequenceResponse defines the numbering of issued invoices
+type InvoiceSequenceResponse struct {
+	Prefix     string `json:"prefix"`
+	NextNumber int64  `json:"nextNumber"`
+	Padding    int    `json:"padding"`
+	Example    string `json:"example"` // Number the next issued invoice will get
+}
+
+// ToInvoicePaymentResponse converts a domain InvoicePayment to its response DTO
+func ToInvoicePaymentResponse(p *domain.InvoicePayment) InvoicePaymentResponse {
+	return InvoicePaymentResponse{
+		PaymentID:   p.PaymentID,
+		InvoiceID:   p.InvoiceID,
+		JournalID:   p.JournalID,
+		PaymentDate: p.PaymentDate,
+		Amount:      p.Amount,
+		CreatedAt:   p.CreatedAt,
+		CreatedBy:   p.CreatedBy,
+	}
+}
+
+// ToInvoiceResponse converts a domain Invoice to its response DTO, deriving its status on the given date
+func ToInvoiceResponse(i *domain.Invoice, asOf time.Time) InvoiceResponse {
+	resp := InvoiceResponse{
+		InvoiceID:           i.InvoiceID,
+		WorkplaceID:         i.WorkplaceID,
+		CustomerID:          i.CustomerID,
+		ReceivableAccountID: i.ReceivableAccountID,
+		InvoiceNumber:       i.InvoiceNumber,
+		IssueDate:           i.IssueDate,
+		DueDate:             i.DueDate,
+		CurrencyCode:        i.CurrencyCode,
+		Notes:               i.Notes,
+		Subtotal:            i.Subtotal,
+		TaxTotal:            i.TaxTotal,
+		Total:               i.Total,
+		Status:              i.Status(asOf),
+		AmountPaid:          i.AmountPaid(asOf),
+		Outstanding:         i.Outstanding(asOf),
+		IssueJournalID:      i.IssueJournalID,
+		Payments:            make([]InvoicePaymentResponse, 0, len(i.Payments)),
+		CreatedAt:           i.CreatedAt,
+		CreatedBy:           i.CreatedBy,
+		LastUpdatedAt:       i.LastUpdatedAt,
+		LastUpdatedBy:       i.LastUpdatedBy,
+	}
+	for _, line := range i.Lines {
+		resp.Lines = append(resp.Lines, InvoiceLineResponse{
+			LineID:           line.LineID,
+			LineNumber:       line.LineNumber,
+			Description:      line.Description,
+			Quantity:         line.Quantity,
+			UnitPrice:        line.UnitPrice,
+			RevenueAccountID: line.RevenueAccountID,
+			TaxCodeID:        line.TaxCodeID,
+			Amount:           line.Amount,
+			NetAmount:        line.NetAmount,
+			TaxAmount:        line.TaxAmount,
+		})
+	}
+	for j := range i.Payments {
+		resp.Payments = append(resp.Payments, ToInvoicePaymentResponse(&i.Payments[j]))
+	}
+	return resp
+}
+
+// ToListInvoicesResponse converts domain invoices to the list response DTO
+func ToListInvoicesResponse(invoices []domain.Invoice, asOf time.Time) ListInvoicesResponse {
+	resp := ListInvoicesResponse{Invoices: make([]InvoiceResponse, 0, len(invoices))}
+	for i := range invoices {
+		resp.Invoices = append(resp.Invoices, ToInvoiceResponse(&invoices[i], asOf))
+	}
+	return resp
+}
+
+// ToInvoiceSequenceResponse converts a domain InvoiceSequence to its response DTO
+func ToInvoiceSequenceResponse(s *domain.InvoiceSequence) InvoiceSequenceResponse {
+	return InvoiceSequenceResponse{
+		Prefix:     s.Prefix,
+		NextNumber: s.NextNumber,
+		Padding:    s.Padding,
+		Example:    s.Format(s.NextNumber),
+	}
+}
@@ -11,19 +11,28 @@ import (
 
 // CreateJournalRequest defines data for creating a journal entry (without transactions).
 type CreateJournalRequest struct {
-	Date         time.Time                  `json:"date" binding:"required"`
-	Description  string                     `json:"description"`
-	CurrencyCode string                     `json:"currencyCode" binding:"required,iso4217"`    // Enforce valid currency code
-	Transactions []CreateTransactionRequest `json:"transactions" binding:"required,min=2,dive"` // Embed transactions
+	Date              time.Time                  `json:"date" binding:"required"`
+	Description       string                     `json:"description"`
+	CurrencyCode      string                     `json:"currencyCode" binding:"required,iso4217"`         // Enforce valid currency code
+	PayeeID           string                     `json:"payeeID" binding:"omitempty,uuid"`                // Optional; resolved from the description when omitted
+	DimensionValueIDs []string                   `json:"dimensionValueIDs" binding:"omitempty,dive,uuid"` // Optional; at most one value per dimension, inherited by lines without their own
+	Transactions      []CreateTransactionRequest `json:"transactions" binding:"required,min=2,dive"`      // Embed transactions
 }
 
 // CreateTransactionRequest defines data for a single transaction within a journal creation request.
 type CreateTransactionRequest struct {
-	AccountID       string                 `json:"accountID" binding:"required,uuid"`
-	Amount          decimal.Decimal        `json:"amount" binding:"required,decimal_gtz"` // Use custom validator
-	TransactionType domain.TransactionType `json:"transactionType" binding:"required,oneof=DEBIT CREDIT"`
-	TransactionDate *time.Time             `json:"transactionDate,omitempty"` // Optional, defaults to journal date if not provided
-	Notes           string                 `json:"notes"`
+	AccountID         string                 `json:"accountID" binding:"required,uuid"`
+	Amount            decimal.Decimal        `json:"amount" binding:"required,decimal_gtz"` // Use custom validator
+	TransactionType   domain.TransactionType `json:"transactionType" binding:"required,oneof=DEBIT CREDIT"`
+	TransactionDate   *time.Time             `json:"transactionDate,omitempty"` // Optional, defaults to journal date if not provided
+	Notes             string                 `json:"notes"`
+	PayeeID           string                 `json:"payeeID" binding:"omitempty,uuid"`                // Optional; overrides the journal payee for this line
+	TaxCodeID         string                 `json:"taxCodeID" binding:"omitempty,uuid"`              // Optional; splits the line into net and tax lines. An exclusive code adds the tax, rounded to the currency precision, on top, so the other lines must include it
+	DimensionValueIDs []string               `json:"dimensionValueIDs" binding:"omitempty,dive,uuid"` // Optional; overrides the journal's values for these dimensions
+	// Security fields are set by investment trades and cannot be supplied by clients
+	SecurityID string          `json:"-"`
+	Quantity   decimal.Decimal `json:"-"`
+	UnitPrice  decimal.Decimal `json:"-"`
 	// CurrencyCode is inherited from the Journal
 }
 
@@ -38,11 +47,17 @@ type JournalResponse struct {
 	OriginalJournalID  *string               `json:"originalJournalID,omitempty"`
 	ReversingJournalID *string               `json:"reversingJournalID,omitempty"`
 	Amount             decimal.Decimal       `json:"amount,omitempty"` // Total movement amount in the journal
+	PayeeID            string                `json:"payeeID,omitempty"`
 	CreatedAt          time.Time             `json:"createdAt"`
 	CreatedBy          string                `json:"createdBy"`
 	LastUpdatedAt      time.Time             `json:"lastUpdatedAt"`
 	LastUpdatedBy      string                `json:"lastUpdatedBy"`
 	Transactions       []TransactionResponse `json:"transactions,omitempty"` // Added transactions
+	Dimensions         []domain.DimensionTag `json:"dimensions,omitempty"`
+	Twin               *domain.JournalTwin   `json:"twin,omitempty"` // Mirrored journal of an intercompany posting
+
+	DuplicateWarnings []DuplicateWarningResponse `json:"duplicateWarnings,omitempty"` // Non-blocking: returned on create only
+	TwinToReverse     *domain.JournalTwin        `json:"twinToReverse,omitempty"`     // Returned on reversal while the reversed journal's twin is still posted
 }
 
 // ToJournalResponse converts domain.Journal to JournalResponse DTO.
@@ -57,11 +72,16 @@ func ToJournalResponse(j *domain.Journal) JournalResponse {
 		OriginalJournalID:  j.OriginalJournalID,  // Map link
 		ReversingJournalID: j.ReversingJournalID, // Map link
 		Amount:             j.Amount,             // Map amount
+		PayeeID:            j.PayeeID,
 		CreatedAt:          j.CreatedAt,
 		CreatedBy:          j.CreatedBy,
 		LastUpdatedAt:      j.LastUpdatedAt,
 		LastUpdatedBy:      j.LastUpdatedBy,
 		Transactions:       ToTransactionResponses(j.Transactions), // Map transactions
+		Dimensions:         j.Dimensions,
+		Twin:               j.Twin,
+		DuplicateWarnings:  ToDuplicateWarningResponses(j.DuplicateWarnings),
+		TwinToReverse:      j.TwinToReverse,
 	}
 }
 
@@ -85,6 +105,20 @@ type ListJournalsResponse struct {
 type UpdateJournalRequest struct {
 	Date        *time.Time `json:"date"`        // Pointer to allow optional update
 	Description *string    `json:"description"` // Pointer to allow optional update
+	PayeeID     *string    `json:"payeeID"`     // Pointer to allow optional update; an empty string clears the payee
+}
+
+// UpdateJournalDimensionsRequest defines the dimension values to assign to a posted journal and its lines.
+// Amounts, accounts and dates are never touched.
+type UpdateJournalDimensionsRequest struct {
+	DimensionValueIDs *[]string                            `json:"dimensionValueIDs" binding:"omitempty,dive,uuid"` // Replaces the journal's values when present; an empty list clears them
+	Transactions      []UpdateTransactionDimensionsRequest `json:"transactions" binding:"omitempty,dive"`           // Lines not listed keep their values
+}
+
+// UpdateTransactionDimensionsRequest defines the dimension values to assign to a single journal line.
+type UpdateTransactionDimensionsRequest struct {
+	TransactionID     string   `json:"transactionID" binding:"required,uuid"`
+	DimensionValueIDs []string `json:"dimensionValueIDs" binding:"omitempty,dive,uuid"` // Replaces the line's values; an empty list clears them
 }
 
 // --- Transaction DTOs (Separate for potential future use) ---
@@ -99,6 +133,15 @@ type TransactionResponse struct {
 	CurrencyCode       string                 `json:"currencyCode"`
 	Notes              string                 `json:"notes"`
 	TransactionDate    time.Time              `json:"transactionDate"` // Date of the actual transaction
+	ClearingStatus     domain.ClearingStatus  `json:"clearingStatus"`  // UNCLEARED, CLEARED or RECONCILED
+	ReconciliationID   string                 `json:"reconciliationID,omitempty"`
+	PayeeID            string                 `json:"payeeID,omitempty"`
+	SecurityID         string                 `json:"securityID,omitempty"`
+	Quantity           *decimal.Decimal       `json:"quantity,omitempty"`  // Units of the security on investment lines
+	UnitPrice          *decimal.Decimal       `json:"unitPrice,omitempty"` // Trade price per unit on investment lines
+	TaxCodeID          string                 `json:"taxCodeID,omitempty"`
+	TaxRole            domain.TaxRole         `json:"taxRole,omitempty"`    // NET or TAX on lines split with a tax code
+	Dimensions         []domain.DimensionTag  `json:"dimensions,omitempty"` // Values assigned to the line itself
 	CreatedAt          time.Time              `json:"createdAt"`
 	CreatedBy          string                 `json:"createdBy"`
 	RunningBalance     decimal.Decimal        `json:"runningBalance,omitempty"` // Added running balance
@@ -108,7 +151,7 @@ type TransactionResponse struct {
 
 // ToTransactionResponse converts domain.Transaction to TransactionResponse DTO.
 func ToTransactionResponse(t *domain.Transaction) TransactionResponse {
-	return TransactionResponse{
+	resp := TransactionResponse{
 		TransactionID:      t.TransactionID,
 		JournalID:          t.JournalID,
 		AccountID:          t.AccountID,
@@ -117,12 +160,24 @@ func ToTransactionResponse(t *domain.Transaction) TransactionResponse {
 		CurrencyCode:       t.CurrencyCode,
 		Notes:              t.Notes,
 		TransactionDate:    t.TransactionDate,
+		ClearingStatus:     t.ClearingStatus,
+		ReconciliationID:   t.ReconciliationID,
+		PayeeID:            t.PayeeID,
+		TaxCodeID:          t.TaxCodeID,
+		TaxRole:            t.TaxRole,
+		Dimensions:         t.Dimensions,
 		CreatedAt:          t.CreatedAt,
 		CreatedBy:          t.CreatedBy,
 		RunningBalance:     t.RunningBalance, // Added running balance
 		JournalDate:        t.JournalDate,
 		JournalDescription: t.JournalDescription,
 	}
+	if t.SecurityID != "" {
+		resp.SecurityID = t.SecurityID
+		resp.Quantity = &t.Quantity
+		resp.UnitPrice = &t.UnitPrice
+	}
+	return resp
 }
 
 // ToTransactionResponses converts a slice of domain.Transaction to DTOs.
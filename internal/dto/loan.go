@@ -0,0 +1,181 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Loan DTOs ---
+
+// CreateLoanRequest defines the terms of a loan held in a LIABILITY account
+type CreateLoanRequest struct {
+	Name                  string               `json:"name" binding:"required,max=255"`
+	AccountID             string               `json:"accountID" binding:"required,uuid"`         // LIABILITY account of the loan
+	InterestAccountID     string               `json:"interestAccountID" binding:"required,uuid"` // EXPENSE account charged with interest
+	Principal             decimal.Decimal      `json:"principal" binding:"required,decimal_gtz"`
+	AnnualRate            decimal.Decimal      `json:"annualRate"` // Nominal annual rate in percent, e.g. 6.5
+	Term                  int                  `json:"term" binding:"required,min=1,max=3000"`
+	Frequency             domain.LoanFrequency `json:"frequency" binding:"required,oneof=WEEKLY BIWEEKLY MONTHLY QUARTERLY SEMI_ANNUAL ANNUAL"`
+	StartDate             time.Time            `json:"startDate" binding:"required"`
+	DisbursementAccountID string               `json:"disbursementAccountID" binding:"omitempty,uuid"` // When set, a journal crediting the loan account with the principal is posted
+}
+
+// UpdateLoanRequest replaces the name and interest account of a loan; its terms cannot change
+type UpdateLoanRequest struct {
+	Name              string `json:"name" binding:"required,max=255"`
+	InterestAccountID string `json:"interestAccountID" binding:"required,uuid"`
+}
+
+// PostInstallmentRequest pays the next installment of a loan
+type PostInstallmentRequest struct {
+	PaymentAccountID string     `json:"paymentAccountID" binding:"required,uuid"` // Account the installment is paid from
+	Date             *time.Time `json:"date"`                                     // Defaults to the due date
+}
+
+// PrepaymentRequest pays down principal ahead of schedule
+type PrepaymentRequest struct {
+	PaymentAccountID string                `json:"paymentAccountID" binding:"required,uuid"`
+	Amount           decimal.Decimal       `json:"amount" binding:"required,decimal_gtz"`
+	Date             time.Time             `json:"date" binding:"required"`
+	Mode             domain.PrepaymentMode `json:"mode" binding:"omitempty,oneof=REDUCE_PAYMENT REDUCE_TERM"` // Defaults to REDUCE_TERM
+}
+
+// LoanResponse defines the data returned for a loan
+type LoanResponse struct {
+	LoanID                string               `json:"loanID"`
+	WorkplaceID           string               `json:"workplaceID"`
+	AccountID             string               `json:"accountID"`
+	InterestAccountID     string               `json:"interestAccountID"`
+	Name                  string               `json:"name"`
+	Principal             decimal.Decimal      `json:"principal"`
+	AnnualRate            decimal.Decimal      `json:"annualRate"`
+	Term                  int                  `json:"term"`
+	Frequency             domain.LoanFrequency `json:"frequency"`
+	StartDate             time.Time            `json:"startDate"`
+	DisbursementJournalID string               `json:"disbursementJournalID,omitempty"`
+	CreatedAt             time.Time            `json:"createdAt"`
+	CreatedBy             string               `json:"createdBy"`
+	LastUpdatedAt         time.Time            `json:"lastUpdatedAt"`
+	LastUpdatedBy         string               `json:"lastUpdatedBy"`
+}
+
+// ListLoansResponse wraps loans, ordered by name
+type ListLoansResponse struct {
+	Loans []LoanResponse `json:"loans"`
+}
+
+// LoanPaymentResponse defines an installment or prepayment posted against a loan
+type LoanPaymentResponse struct {
+	PaymentID         string                 `json:"paymentID"`
+	LoanID            string                 `json:"loanID"`
+	JournalID         string                 `json:"journalID"`
+	PaymentType       domain.LoanPaymentType `json:"paymentType"`
+	InstallmentNumber int                    `json:"installmentNumber,omitempty"`
+	PrepaymentMode    domain.PrepaymentMode  `json:"prepaymentMode,omitempty"`
+	PaymentDate       time.Time              `json:"paymentDate"`
+	Principal         decimal.Decimal        `json:"principal"`
+	Interest          decimal.Decimal        `json:"interest"`
+	CreatedAt         time.Time              `json:"createdAt"`
+	CreatedBy         string                 `json:"createdBy"`
+}
+
+// InstallmentResponse defines one line of an amortization schedule
+type InstallmentResponse struct {
+	Number             int             `json:"number"`
+	DueDate            time.Time       `json:"dueDate"`
+	Payment            decimal.Decimal `json:"payment"`
+	Principal          decimal.Decimal `json:"principal"`
+	Interest           decimal.Decimal `json:"interest"`
+	RemainingPrincipal decimal.Decimal `json:"remainingPrincipal"`
+	Paid               bool            `json:"paid"`
+	JournalID          string          `json:"journalID,omitempty"`
+}
+
+// LoanScheduleResponse defines the amortization schedule of a loan
+type LoanScheduleResponse struct {
+	Loan               LoanResponse          `json:"loan"`
+	CurrencyCode       string                `json:"currencyCode"`
+	Installments       []InstallmentResponse `json:"installments"`
+	Prepayments        []LoanPaymentResponse `json:"prepayments"`
+	RemainingPrincipal decimal.Decimal       `json:"remainingPrincipal"`
+	AccountBalance     decimal.Decimal       `json:"accountBalance"`
+	Difference         decimal.Decimal       `json:"difference"` // Account balance less remaining principal; non-zero when the account has other postings
+}
+
+// ToLoanResponse converts a domain Loan to its response DTO
+func ToLoanResponse(l *domain.Loan) LoanResponse {
+	return LoanResponse{
+		LoanID:                l.LoanID,
+		WorkplaceID:           l.WorkplaceID,
+		AccountID:             l.AccountID,
+		InterestAccountID:     l.InterestAccountID,
+		Name:                  l.Name,
+		Principal:             l.Principal,
+		AnnualRate:            l.AnnualRate,
+		Term:                  l.Term,
+		Frequency:             l.Frequency,
+		StartDate:             l.StartDate,
+		DisbursementJournalID: l.DisbursementJournalID,
+		CreatedAt:             l.CreatedAt,
+		CreatedBy:             l.CreatedBy,
+		LastUpdatedAt:         l.LastUpdatedAt,
+		LastUpdatedBy:         l.LastUpdatedBy,
+	}
+}
+
+// ToListLoansResponse converts domain loans to the list response DTO
+func ToListLoansResponse(loans []domain.Loan) ListLoansResponse {
+	resp := ListLoansResponse{Loans: make([]LoanResponse, 0, len(loans))}
+	for i := range loans {
+		resp.Loans = append(resp.Loans, ToLoanResponse(&loans[i]))
+	}
+	return resp
+}
+
+// ToLoanPaymentResponse converts a domain LoanPayment to its response DTO
+func ToLoanPaymentResponse(p *domain.LoanPayment) LoanPaymentResponse {
+	return LoanPaymentResponse{
+		PaymentID:         p.PaymentID,
+		LoanID:            p.LoanID,
+		JournalID:         p.JournalID,
+		PaymentType:       p.PaymentType,
+		InstallmentNumber: p.InstallmentNumber,
+		PrepaymentMode:    p.PrepaymentMode,
+		PaymentDate:       p.PaymentDate,
+		Principal:         p.Principal,
+		Interest:          p.Interest,
+		CreatedAt:         p.CreatedAt,
+		CreatedBy:         p.CreatedBy,
+	}
+}
+
+// ToLoanScheduleResponse converts a domain LoanSchedule to the response DTO
+func ToLoanScheduleResponse(s *domain.LoanSchedule) LoanScheduleResponse {
+	resp := LoanScheduleResponse{
+		Loan:               ToLoanResponse(&s.Loan),
+		CurrencyCode:       s.CurrencyCode,
+		Installments:       make([]InstallmentResponse, 0, len(s.Installments)),
+		Prepayments:        make([]LoanPaymentResponse, 0, len(s.Prepayments)),
+		RemainingPrincipal: s.RemainingPrincipal,
+		AccountBalance:     s.AccountBalance,
+		Difference:         s.Difference,
+	}
+	for _, installment := range s.Installments {
+		resp.Installments = append(resp.Installments, InstallmentResponse{
+			Number:             installment.Number,
+			DueDate:            installment.DueDate,
+			Payment:            installment.Payment,
+			Principal:          installment.Principal,
+			Interest:           installment.Interest,
+			RemainingPrincipal: installment.RemainingPrincipal,
+			Paid:               installment.Paid,
+			JournalID:          installment.JournalID,
+		})
+	}
+	for i := range s.Prepayments {
+		resp.Prepayments = append(resp.Prepayments, ToLoanPaymentResponse(&s.Prepayments[i]))
+	}
+	return resp
+}
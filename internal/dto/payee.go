@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+)
+
+// --- Payee DTOs ---
+
+// PayeeRequest defines the details of a payee. It is used to create a payee and, with PUT semantics,
+// to replace all fields of an existing one.
+type PayeeRequest struct {
+	Name                    string   `json:"name" binding:"required"`
+	Aliases                 []string `json:"aliases"`                                          // Alternative spellings matched in descriptions
+	DefaultCounterAccountID string   `json:"defaultCounterAccountID" binding:"omitempty,uuid"` // Suggested other side of journals with the payee
+	IsActive                *bool    `json:"isActive"`                                         // Defaults to true
+}
+
+// ListPayeesParams defines query parameters for listing payees
+type ListPayeesParams struct {
+	IncludeInactive bool `form:"includeInactive"`
+}
+
+// ResolvePayeeParams defines the free text to resolve to a payee
+type ResolvePayeeParams struct {
+	Text string `form:"text" binding:"required"`
+}
+
+// PayeeResponse defines the data returned for a payee
+type PayeeResponse struct {
+	PayeeID                 string    `json:"payeeID"`
+	WorkplaceID             string    `json:"workplaceID"`
+	Name                    string    `json:"name"`
+	Aliases                 []string  `json:"aliases"`
+	DefaultCounterAccountID string    `json:"defaultCounterAccountID,omitempty"`
+	IsActive                bool      `json:"isActive"`
+	CreatedAt               time.Time `json:"createdAt"`
+	CreatedBy               string    `json:"createdBy"`
+	LastUpdatedAt           time.Time `json:"lastUpdatedAt"`
+	LastUpdatedBy           string    `json:"lastUpdatedBy"`
+}
+
+// ListPayeesResponse wraps payees ordered by name
+type ListPayeesResponse struct {
+	Payees []PayeeResponse `json:"payees"`
+}
+
+// ResolvePayeeResponse reports the payee recognised in a free-text description, if any
+type ResolvePayeeResponse struct {
+	Text        string         `json:"text"`
+	Matched     bool           `json:"matched"`
+	MatchedTerm string         `json:"matchedTerm,omitempty"` // Name or alias found in the text
+	Payee       *PayeeResponse `json:"payee,omitempty"`
+}
+
+// ToPayeeResponse converts a domain Payee to its response DTO
+func ToPayeeResponse(p *domain.Payee) PayeeResponse {
+	aliases := p.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return PayeeResponse{
+		PayeeID:                 p.PayeeID,
+		WorkplaceID:             p.WorkplaceID,
+		Name:                    p.Name,
+		Aliases:                 aliases,
+		DefaultCounterAccountID: p.DefaultCounterAccountID,
+		IsActive:                p.IsActive,
+		CreatedAt:               p.CreatedAt,
+		CreatedBy:               p.CreatedBy,
+		LastUpdatedAt:           p.LastUpdatedAt,
+		LastUpdatedBy:           p.LastUpdatedBy,
+	}
+}
+
+// ToListPayeesResponse converts domain payees to a list response DTO
+func ToListPayeesResponse(payees []domain.Payee) ListPayeesResponse {
+	list := make([]PayeeResponse, len(payees))
+	for i := range payees {
+		list[i] = ToPayeeResponse(&payees[i])
+	}
+	return ListPayeesResponse{Payees: list}
+}
+
+// ToResolvePayeeResponse converts a payee match, which may be nil, to its response DTO
+func ToResolvePayeeResponse(text string, match *domain.PayeeMatch) ResolvePayeeResponse {
+	response := ResolvePayeeResponse{Text: text}
+	if match != nil {
+		payee := ToPayeeResponse(&match.Payee)
+		response.Matched = true
+		response.MatchedTerm = match.MatchedTerm
+		response.Payee = &payee
+	}
+	return response
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Commodity Price DTOs ---
+
+// RecordPriceRequest records the price of a commodity in a quote currency on a date
+type RecordPriceRequest struct {
+	Commodity     string          `json:"commodity" binding:"required,max=50"`
+	QuoteCurrency string          `json:"quoteCurrency" binding:"required,iso4217"`
+	Date          time.Time       `json:"date" binding:"required"`
+	Price         decimal.Decimal `json:"price" binding:"required,decimal_gtz"`
+}
+
+// SyncPricesRequest limits a sync from the price provider; empty fields load everything the provider has
+type SyncPricesRequest struct {
+	Commodity     string     `json:"commodity" binding:"omitempty,max=50"`
+	QuoteCurrency string     `json:"quoteCurrency" binding:"omitempty,iso4217"`
+	From          *time.Time `json:"from"`
+	To            *time.Time `json:"to"`
+}
+
+// ListPricesParams defines query parameters for listing the prices of a commodity
+type ListPricesParams struct {
+	Commodity     string     `form:"commodity" binding:"required,max=50"`
+	QuoteCurrency string     `form:"quoteCurrency" binding:"required,iso4217"`
+	From          *time.Time `form:"from" time_format:"2006-01-02"`
+	To            *time.Time `form:"to" time_format:"2006-01-02"`
+}
+
+// PriceAsOfParams defines query parameters for looking up the price of a commodity on a date
+type PriceAsOfParams struct {
+	Commodity     string     `form:"commodity" binding:"required,max=50"`
+	QuoteCurrency string     `form:"quoteCurrency" binding:"required,iso4217"`
+	AsOf          *time.Time `form:"asOf" time_format:"2006-01-02"` // Defaults to today
+}
+
+// PriceResponse defines the data returned for a commodity price
+type PriceResponse struct {
+	Commodity     string          `json:"commodity"`
+	QuoteCurrency string          `json:"quoteCurrency"`
+	Date          time.Time       `json:"date"`
+	Price         decimal.Decimal `json:"price"`
+	Source        string          `json:"source"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CreatedBy     string          `json:"createdBy,omitempty"`
+}
+
+// ListPricesResponse wraps commodity prices, newest first
+type ListPricesResponse struct {
+	Prices []PriceResponse `json:"prices"`
+}
+
+// PriceRowErrorResponse explains why a row of a price file was skipped
+type PriceRowErrorResponse struct {
+	RowNumber int    `json:"rowNumber"`
+	Error     string `json:"error"`
+}
+
+// PriceImportResponse summarises a price import or sync
+type PriceImportResponse struct {
+	Source   string                  `json:"source"`
+	Imported int                     `json:"imported"`
+	Errors   []PriceRowErrorResponse `json:"errors"`
+}
+
+// ToPriceResponse converts a domain CommodityPrice to its response DTO
+func ToPriceResponse(p *domain.CommodityPrice) PriceResponse {
+	return PriceResponse{
+		Commodity:     p.Commodity,
+		QuoteCurrency: p.QuoteCurrency,
+		Date:          p.PriceDate,
+		Price:         p.Price,
+		Source:        p.Source,
+		CreatedAt:     p.CreatedAt,
+		CreatedBy:     p.CreatedBy,
+	}
+}
+
+// ToListPricesResponse converts domain commodity prices to the list response DTO
+func ToListPricesResponse(prices []domain.CommodityPrice) ListPricesResponse {
+	resp := ListPricesResponse{Prices: make([]PriceResponse, 0, len(prices))}
+	for i := range prices {
+		resp.Prices = append(resp.Prices, ToPriceResponse(&prices[i]))
+	}
+	return resp
+}
+
+// ToPriceImportResponse converts a domain PriceImportResult to its response DTO
+func ToPriceImportResponse(r *domain.PriceImportResult) PriceImportResponse {
+	resp := PriceImportResponse{Source: r.Source, Imported: r.Imported, Errors: make([]PriceRowErrorResponse, 0, len(r.Errors))}
+	for _, rowErr := range r.Errors {
+		resp.Errors = append(resp.Errors, PriceRowErrorResponse{RowNumber: rowErr.RowNumber, Error: rowErr.Error})
+	}
+	return resp
+}
@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Reconciliation DTOs ---
+
+// CreateReconciliationRequest opens a reconciliation session for an account
+type CreateReconciliationRequest struct {
+	AccountID     string          `json:"accountID" binding:"required,uuid"` // ASSET or LIABILITY account
+	StatementDate time.Time       `json:"statementDate" binding:"required"`
+	EndingBalance decimal.Decimal `json:"endingBalance"` // Statement ending balance; amount owed for LIABILITY accounts
+}
+
+// ListReconciliationsParams defines query parameters for listing reconciliation sessions
+type ListReconciliationsParams struct {
+	AccountID string `form:"accountID" binding:"omitempty,uuid"` // All accounts when empty
+}
+
+// ReconciliationClearingRequest lists the transactions to tick or untick in a session
+type ReconciliationClearingRequest struct {
+	TransactionIDs []string `json:"transactionIDs" binding:"required,min=1,dive,uuid"`
+}
+
+// ReconciliationResponse defines the data returned for a reconciliation session
+type ReconciliationResponse struct {
+	ReconciliationID string                      `json:"reconciliationID"`
+	WorkplaceID      string                      `json:"workplaceID"`
+	AccountID        string                      `json:"accountID"`
+	StatementDate    string                      `json:"statementDate"`
+	EndingBalance    decimal.Decimal             `json:"endingBalance"`
+	Status           domain.ReconciliationStatus `json:"status"`
+	CompletedAt      *time.Time                  `json:"completedAt,omitempty"`
+	CompletedBy      string                      `json:"completedBy,omitempty"`
+	CreatedAt        time.Time                   `json:"createdAt"`
+	CreatedBy        string                      `json:"createdBy"`
+	LastUpdatedAt    time.Time                   `json:"lastUpdatedAt"`
+	LastUpdatedBy    string                      `json:"lastUpdatedBy"`
+}
+
+// ReconciliationSummaryResponse returns a session with its live difference
+type ReconciliationSummaryResponse struct {
+	ReconciliationResponse
+	OpeningBalance decimal.Decimal `json:"openingBalance"` // Reconciled by earlier sessions
+	ClearedAmount  decimal.Decimal `json:"clearedAmount"`  // Net effect of the transactions cleared in this session
+	ClearedCount   int             `json:"clearedCount"`
+	ClearedBalance decimal.Decimal `json:"clearedBalance"`
+	Difference     decimal.Decimal `json:"difference"` // Ending balance minus cleared balance; must be zero to complete
+}
+
+// ListReconciliationsResponse wraps reconciliation sessions, latest statement first
+type ListReconciliationsResponse struct {
+	Reconciliations []ReconciliationResponse `json:"reconciliations"`
+}
+
+// ReconciliationTransactionsResponse wraps the transactions that can be ticked in a session
+type ReconciliationTransactionsResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+}
+
+// ToReconciliationResponse converts a domain Reconciliation to its response DTO
+func ToReconciliationResponse(r *domain.Reconciliation) ReconciliationResponse {
+	return ReconciliationResponse{
+		ReconciliationID: r.ReconciliationID,
+		WorkplaceID:      r.WorkplaceID,
+		AccountID:        r.AccountID,
+		StatementDate:    r.StatementDate.Format("2006-01-02"),
+		EndingBalance:    r.EndingBalance,
+		Status:           r.Status,
+		CompletedAt:      r.CompletedAt,
+		CompletedBy:      r.CompletedBy,
+		CreatedAt:        r.CreatedAt,
+		CreatedBy:        r.CreatedBy,
+		LastUpdatedAt:    r.LastUpdatedAt,
+		LastUpdatedBy:    r.LastUpdatedBy,
+	}
+}
+
+// ToReconciliationSummaryResponse converts a domain ReconciliationSummary to its response DTO
+func ToReconciliationSummaryResponse(s *domain.ReconciliationSummary) ReconciliationSummaryResponse {
+	return ReconciliationSummaryResponse{
+		ReconciliationResponse: ToReconciliationResponse(&s.Reconciliation),
+		OpeningBalance:         s.OpeningBalance,
+		ClearedAmount:          s.ClearedAmount,
+		ClearedCount:           s.ClearedCount,
+		ClearedBalance:         s.ClearedBalance,
+		Difference:             s.Difference,
+	}
+}
+
+// ToListReconciliationsResponse converts reconciliation sessions to a list response
+func ToListReconciliationsResponse(reconciliations []domain.Reconciliation) ListReconciliationsResponse {
+	resp := ListReconciliationsResponse{Reconciliations: make([]ReconciliationResponse, 0, len(reconciliations))}
+	for i := range reconciliations {
+		resp.Reconciliations = append(resp.Reconciliations, ToReconciliationResponse(&reconciliations[i]))
+	}
+	return resp
+}
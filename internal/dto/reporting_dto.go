@@ -163,3 +163,416 @@ func ToBalanceSheetResponse(report *domain.BalanceSheetReport, asOf time.Time) B
 
 	return response
 }
+
+// CashFlowSectionResponse represents one activity section of the cash flow report response
+type CashFlowSectionResponse struct {
+	Lines []AccountAmountResponse `json:"lines"`
+	Total decimal.Decimal         `json:"total"`
+}
+
+// CashFlowResponse represents the cash flow report response
+type CashFlowResponse struct {
+	FromDate       string                  `json:"fromDate"`
+	ToDate         string                  `json:"toDate"`
+	NetProfit      decimal.Decimal         `json:"netProfit"`
+	Operating      CashFlowSectionResponse `json:"operating"`
+	NetOperating   decimal.Decimal         `json:"netOperating"`
+	Investing      CashFlowSectionResponse `json:"investing"`
+	Financing      CashFlowSectionResponse `json:"financing"`
+	Reconciliation struct {
+		NetChangeInCash    decimal.Decimal `json:"netChangeInCash"`
+		OpeningCash        decimal.Decimal `json:"openingCash"`
+		ClosingCash        decimal.Decimal `json:"closingCash"`
+		ActualChangeInCash decimal.Decimal `json:"actualChangeInCash"`
+		Difference         decimal.Decimal `json:"difference"`
+		Reconciled         bool            `json:"reconciled"`
+	} `json:"reconciliation"`
+}
+
+// toCashFlowSectionResponse converts a domain cash flow section to a DTO response
+func toCashFlowSectionResponse(section domain.CashFlowSection) CashFlowSectionResponse {
+	response := CashFlowSectionResponse{
+		Lines: make([]AccountAmountResponse, len(section.Lines)),
+		Total: section.Total,
+	}
+
+	for i, line := range section.Lines {
+		response.Lines[i] = AccountAmountResponse{
+			AccountID: line.AccountID,
+			Name:      line.Name,
+			Amount:    line.NetAmount,
+		}
+	}
+
+	return response
+}
+
+// ToCashFlowResponse converts a domain cash flow report to a DTO response
+func ToCashFlowResponse(report *domain.CashFlowReport, from, to time.Time) CashFlowResponse {
+	response := CashFlowResponse{
+		FromDate:     from.Format("2006-01-02"),
+		ToDate:       to.Format("2006-01-02"),
+		NetProfit:    report.NetProfit,
+		Operating:    toCashFlowSectionResponse(report.Operating),
+		NetOperating: report.NetOperating,
+		Investing:    toCashFlowSectionResponse(report.Investing),
+		Financing:    toCashFlowSectionResponse(report.Financing),
+	}
+
+	response.Reconciliation.NetChangeInCash = report.NetChangeInCash
+	response.Reconciliation.OpeningCash = report.OpeningCash
+	response.Reconciliation.ClosingCash = report.ClosingCash
+	response.Reconciliation.ActualChangeInCash = report.ActualChangeInCash
+	response.Reconciliation.Difference = report.Difference
+	response.Reconciliation.Reconciled = report.Reconciled
+
+	return response
+}
+
+// LedgerCounterAccountResponse represents a counter-account of a ledger entry
+type LedgerCounterAccountResponse struct {
+	AccountID string `json:"accountID"`
+	Name      string `json:"name"`
+}
+
+// LedgerEntryResponse represents a single posting in a ledger response
+type LedgerEntryResponse struct {
+	TransactionID      string                         `json:"transactionID"`
+	JournalID          string                         `json:"journalID"`
+	JournalDate        string                         `json:"journalDate"`
+	TransactionDate    string                         `json:"transactionDate"`
+	JournalDescription string                         `json:"journalDescription"`
+	Notes              string                         `json:"notes"`
+	Debit              decimal.Decimal                `json:"debit"`
+	Credit             decimal.Decimal                `json:"credit"`
+	RunningBalance     decimal.Decimal                `json:"runningBalance"`
+	CounterAccounts    []LedgerCounterAccountResponse `json:"counterAccounts"`
+}
+
+// AccountLedgerResponse represents the ledger of a single account, also used as the account statement response
+type AccountLedgerResponse struct {
+	AccountID      string                `json:"accountID"`
+	Name           string                `json:"name"`
+	AccountType    string                `json:"accountType"`
+	CurrencyCode   string                `json:"currencyCode"`
+	FromDate       string                `json:"fromDate"`
+	ToDate         string                `json:"toDate"`
+	OpeningBalance decimal.Decimal       `json:"openingBalance"`
+	TotalDebit     decimal.Decimal       `json:"totalDebit"`
+	TotalCredit    decimal.Decimal       `json:"totalCredit"`
+	ClosingBalance decimal.Decimal       `json:"closingBalance"`
+	Entries        []LedgerEntryResponse `json:"entries"`
+}
+
+// GeneralLedgerResponse represents the general ledger report response
+type GeneralLedgerResponse struct {
+	FromDate string                  `json:"fromDate"`
+	ToDate   string                  `json:"toDate"`
+	Accounts []AccountLedgerResponse `json:"accounts"`
+}
+
+// ToAccountLedgerResponse converts a domain account ledger to a DTO response
+func ToAccountLedgerResponse(ledger domain.AccountLedger, from, to time.Time) AccountLedgerResponse {
+	response := AccountLedgerResponse{
+		AccountID:      ledger.AccountID,
+		Name:           ledger.Name,
+		AccountType:    string(ledger.AccountType),
+		CurrencyCode:   ledger.CurrencyCode,
+		FromDate:       from.Format("2006-01-02"),
+		ToDate:         to.Format("2006-01-02"),
+		OpeningBalance: ledger.OpeningBalance,
+		TotalDebit:     ledger.TotalDebit,
+		TotalCredit:    ledger.TotalCredit,
+		ClosingBalance: ledger.ClosingBalance,
+		Entries:        make([]LedgerEntryResponse, len(ledger.Entries)),
+	}
+
+	for i, entry := range ledger.Entries {
+		entryResponse := LedgerEntryResponse{
+			TransactionID:      entry.TransactionID,
+			JournalID:          entry.JournalID,
+			JournalDate:        entry.JournalDate.Format("2006-01-02"),
+			TransactionDate:    entry.TransactionDate.Format("2006-01-02"),
+			JournalDescription: entry.JournalDescription,
+			Notes:              entry.Notes,
+			Debit:              decimal.Zero,
+			Credit:             decimal.Zero,
+			RunningBalance:     entry.RunningBalance,
+			CounterAccounts:    make([]LedgerCounterAccountResponse, len(entry.CounterAccounts)),
+		}
+		if entry.TransactionType == domain.Debit {
+			entryResponse.Debit = entry.Amount
+		} else {
+			entryResponse.Credit = entry.Amount
+		}
+		for j, counter := range entry.CounterAccounts {
+			entryResponse.CounterAccounts[j] = LedgerCounterAccountResponse{
+				AccountID: counter.AccountID,
+				Name:      counter.Name,
+			}
+		}
+		response.Entries[i] = entryResponse
+	}
+
+	return response
+}
+
+// ToGeneralLedgerResponse converts a domain general ledger report to a DTO response
+func ToGeneralLedgerResponse(report *domain.GeneralLedgerReport, from, to time.Time) GeneralLedgerResponse {
+	response := GeneralLedgerResponse{
+		FromDate: from.Format("2006-01-02"),
+		ToDate:   to.Format("2006-01-02"),
+		Accounts: make([]AccountLedgerResponse, len(report.Accounts)),
+	}
+
+	for i, ledger := range report.Accounts {
+		response.Accounts[i] = ToAccountLedgerResponse(ledger, from, to)
+	}
+
+	return response
+}
+
+// ReportPeriodResponse represents one column of a comparative report response
+type ReportPeriodResponse struct {
+	Label    string `json:"label"`
+	FromDate string `json:"fromDate"`
+	ToDate   string `json:"toDate"`
+}
+
+// ComparativeValueResponse represents an amount in one period column with its change from the previous column
+type ComparativeValueResponse struct {
+	Amount          decimal.Decimal  `json:"amount"`
+	Variance        decimal.Decimal  `json:"variance"`
+	VariancePercent *decimal.Decimal `json:"variancePercent"`
+}
+
+// ComparativeLineResponse represents one account across all period columns
+type ComparativeLineResponse struct {
+	AccountID string                     `json:"accountID"`
+	Name      string                     `json:"name"`
+	Values    []ComparativeValueResponse `json:"values"`
+}
+
+// ComparativeSectionResponse represents a section of a comparative report with per-period totals
+type ComparativeSectionResponse struct {
+	Lines  []ComparativeLineResponse  `json:"lines"`
+	Totals []ComparativeValueResponse `json:"totals"`
+}
+
+// ComparativeProfitAndLossResponse represents the comparative profit and loss report response
+type ComparativeProfitAndLossResponse struct {
+	Periods   []ReportPeriodResponse     `json:"periods"`
+	Revenue   ComparativeSectionResponse `json:"revenue"`
+	Expenses  ComparativeSectionResponse `json:"expenses"`
+	NetProfit []ComparativeValueResponse `json:"netProfit"`
+}
+
+// ComparativeBalanceSheetResponse represents the comparative balance sheet report response.
+// Each column is the balance sheet as of the period's toDate.
+type ComparativeBalanceSheetResponse struct {
+	Periods     []ReportPeriodResponse     `json:"periods"`
+	Assets      ComparativeSectionResponse `json:"assets"`
+	Liabilities ComparativeSectionResponse `json:"liabilities"`
+	Equity      ComparativeSectionResponse `json:"equity"`
+}
+
+func toReportPeriodResponses(periods []domain.ReportPeriod) []ReportPeriodResponse {
+	response := make([]ReportPeriodResponse, len(periods))
+	for i, p := range periods {
+		response[i] = ReportPeriodResponse{
+			Label:    p.Label,
+			FromDate: p.From.Format("2006-01-02"),
+			ToDate:   p.To.Format("2006-01-02"),
+		}
+	}
+	return response
+}
+
+func toComparativeValueResponses(values []domain.ComparativeValue) []ComparativeValueResponse {
+	response := make([]ComparativeValueResponse, len(values))
+	for i, v := range values {
+		response[i] = ComparativeValueResponse{
+			Amount:          v.Amount,
+			Variance:        v.Variance,
+			VariancePercent: v.VariancePercent,
+		}
+	}
+	return response
+}
+
+func toComparativeSectionResponse(section domain.ComparativeSection) ComparativeSectionResponse {
+	response := ComparativeSectionResponse{
+		Lines:  make([]ComparativeLineResponse, len(section.Lines)),
+		Totals: toComparativeValueResponses(section.Totals),
+	}
+	for i, line := range section.Lines {
+		response.Lines[i] = ComparativeLineResponse{
+			AccountID: line.AccountID,
+			Name:      line.Name,
+			Values:    toComparativeValueResponses(line.Values),
+		}
+	}
+	return response
+}
+
+// ToComparativeProfitAndLossResponse converts a domain comparative P&L report to a DTO response
+func ToComparativeProfitAndLossResponse(report *domain.ComparativePAndLReport) ComparativeProfitAndLossResponse {
+	return ComparativeProfitAndLossResponse{
+		Periods:   toReportPeriodResponses(report.Periods),
+		Revenue:   toComparativeSectionResponse(report.Revenue),
+		Expenses:  toComparativeSectionResponse(report.Expenses),
+		NetProfit: toComparativeValueResponses(report.NetProfit),
+	}
+}
+
+// ToComparativeBalanceSheetResponse converts a domain comparative balance sheet report to a DTO response
+func ToComparativeBalanceSheetResponse(report *domain.ComparativeBalanceSheetReport) ComparativeBalanceSheetResponse {
+	return ComparativeBalanceSheetResponse{
+		Periods:     toReportPeriodResponses(report.Periods),
+		Assets:      toComparativeSectionResponse(report.Assets),
+		Liabilities: toComparativeSectionResponse(report.Liabilities),
+		Equity:      toComparativeSectionResponse(report.Equity),
+	}
+}
+
+// TimeSeriesLineResponse represents one account of a time series with one value per bucket
+type TimeSeriesLineResponse struct {
+	AccountID   string             `json:"accountID"`
+	Name        string             `json:"name"`
+	AccountType domain.AccountType `json:"accountType"`
+	Values      []decimal.Decimal  `json:"values"`
+}
+
+// TimeSeriesResponse represents the time series report response.
+// In cumulative mode values are balances at each bucket's toDate; in periodic mode they are flows within the bucket.
+type TimeSeriesResponse struct {
+	Interval domain.TimeSeriesInterval `json:"interval"`
+	Mode     domain.TimeSeriesMode     `json:"mode"`
+	Buckets  []ReportPeriodResponse    `json:"buckets"`
+	Lines    []TimeSeriesLineResponse  `json:"lines"`
+	Totals   []decimal.Decimal         `json:"totals"`
+}
+
+// ToTimeSeriesResponse converts a domain time series report to a response DTO
+func ToTimeSeriesResponse(report *domain.TimeSeriesReport) TimeSeriesResponse {
+	response := TimeSeriesResponse{
+		Interval: report.Interval,
+		Mode:     report.Mode,
+		Buckets:  toReportPeriodResponses(report.Buckets),
+		Lines:    make([]TimeSeriesLineResponse, len(report.Lines)),
+		Totals:   report.Totals,
+	}
+	for i, line := range report.Lines {
+		response.Lines[i] = TimeSeriesLineResponse{
+			AccountID:   line.AccountID,
+			Name:        line.Name,
+			AccountType: line.AccountType,
+			Values:      line.Values,
+		}
+	}
+	return response
+}
+
+// PayeeAmountResponse represents the spend and income of one payee in one currency
+type PayeeAmountResponse struct {
+	PayeeID      string          `json:"payeeID,omitempty"` // Omitted for lines without a payee
+	Name         string          `json:"name"`
+	CurrencyCode string          `json:"currencyCode"`
+	Spend        decimal.Decimal `json:"spend"`
+	Income       decimal.Decimal `json:"income"`
+	Net          decimal.Decimal `json:"net"` // Income minus spend
+}
+
+// PayeeReportResponse represents the payee report response
+type PayeeReportResponse struct {
+	FromDate string                `json:"fromDate"`
+	ToDate   string                `json:"toDate"`
+	Payees   []PayeeAmountResponse `json:"payees"`
+}
+
+// ToPayeeReportResponse converts a domain payee report to a DTO response
+func ToPayeeReportResponse(report *domain.PayeeReport, from, to time.Time) PayeeReportResponse {
+	response := PayeeReportResponse{
+		FromDate: from.Format("2006-01-02"),
+		ToDate:   to.Format("2006-01-02"),
+		Payees:   make([]PayeeAmountResponse, len(report.Payees)),
+	}
+	for i, amount := range report.Payees {
+		name := amount.Name
+		if amount.PayeeID == "" {
+			name = "Unassigned"
+		}
+		response.Payees[i] = PayeeAmountResponse{
+			PayeeID:      amount.PayeeID,
+			Name:         name,
+			CurrencyCode: amount.CurrencyCode,
+			Spend:        amount.Spend,
+			Income:       amount.Income,
+			Net:          amount.Income.Sub(amount.Spend),
+		}
+	}
+	return response
+}
+
+// TaxReturnLineResponse represents the amounts of one tax code and currency in a tax return
+type TaxReturnLineResponse struct {
+	TaxCodeID        string          `json:"taxCodeID"`
+	Code             string          `json:"code"`
+	Name             string          `json:"name"`
+	Rate             decimal.Decimal `json:"rate"`
+	CurrencyCode     string          `json:"currencyCode"`
+	TaxableSales     decimal.Decimal `json:"taxableSales"`     // Net amount of credit lines
+	OutputTax        decimal.Decimal `json:"outputTax"`        // Tax collected on sales
+	TaxablePurchases decimal.Decimal `json:"taxablePurchases"` // Net amount of debit lines
+	InputTax         decimal.Decimal `json:"inputTax"`         // Tax paid on purchases
+	NetTax           decimal.Decimal `json:"netTax"`           // Output tax minus input tax
+}
+
+// TaxReturnTotalResponse represents the tax totals of a tax return in one currency
+type TaxReturnTotalResponse struct {
+	CurrencyCode string          `json:"currencyCode"`
+	OutputTax    decimal.Decimal `json:"outputTax"`
+	InputTax     decimal.Decimal `json:"inputTax"`
+	NetTax       decimal.Decimal `json:"netTax"` // Positive when tax is payable, negative when reclaimable
+}
+
+// TaxReturnResponse represents the tax return report response
+type TaxReturnResponse struct {
+	FromDate string                   `json:"fromDate"`
+	ToDate   string                   `json:"toDate"`
+	Lines    []TaxReturnLineResponse  `json:"lines"`
+	Totals   []TaxReturnTotalResponse `json:"totals"`
+}
+
+// ToTaxReturnResponse converts a domain tax return to a DTO response
+func ToTaxReturnResponse(report *domain.TaxReturn, from, to time.Time) TaxReturnResponse {
+	response := TaxReturnResponse{
+		FromDate: from.Format("2006-01-02"),
+		ToDate:   to.Format("2006-01-02"),
+		Lines:    make([]TaxReturnLineResponse, len(report.Lines)),
+		Totals:   make([]TaxReturnTotalResponse, len(report.Totals)),
+	}
+	for i, line := range report.Lines {
+		response.Lines[i] = TaxReturnLineResponse{
+			TaxCodeID:        line.TaxCodeID,
+			Code:             line.Code,
+			Name:             line.Name,
+			Rate:             line.Rate,
+			CurrencyCode:     line.CurrencyCode,
+			TaxableSales:     line.TaxableSales,
+			OutputTax:        line.OutputTax,
+			TaxablePurchases: line.TaxablePurchases,
+			InputTax:         line.InputTax,
+			NetTax:           line.NetTax,
+		}
+	}
+	for i, total := range report.Totals {
+		response.Totals[i] = TaxReturnTotalResponse{
+			CurrencyCode: total.CurrencyCode,
+			OutputTax:    total.OutputTax,
+			InputTax:     total.InputTax,
+			NetTax:       total.NetTax,
+		}
+	}
+	return response
+}
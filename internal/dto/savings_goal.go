@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Savings goal DTOs ---
+
+// CreateSavingsGoalRequest defines the data needed to create a savings goal.
+type CreateSavingsGoalRequest struct {
+	Name         string          `json:"name" binding:"required"`
+	Description  string          `json:"description"`
+	TargetAmount decimal.Decimal `json:"targetAmount" binding:"required"`
+	TargetDate   time.Time       `json:"targetDate" binding:"required"`
+	AccountIDs   []string        `json:"accountIDs" binding:"required,min=1,dive,uuid"` // ASSET accounts sharing one currency
+}
+
+// UpdateSavingsGoalRequest defines the data allowed for updating a savings goal.
+type UpdateSavingsGoalRequest struct {
+	Name         *string          `json:"name,omitempty"`
+	Description  *string          `json:"description,omitempty"`
+	TargetAmount *decimal.Decimal `json:"targetAmount,omitempty"`
+	TargetDate   *time.Time       `json:"targetDate,omitempty"`
+	AccountIDs   []string         `json:"accountIDs,omitempty" binding:"omitempty,min=1,dive,uuid"` // Replaces the linked accounts when set
+}
+
+// SavingsGoalResponse defines the data returned for a savings goal
+type SavingsGoalResponse struct {
+	GoalID        string          `json:"goalID"`
+	WorkplaceID   string          `json:"workplaceID"`
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	TargetAmount  decimal.Decimal `json:"targetAmount"`
+	TargetDate    string          `json:"targetDate"`
+	CurrencyCode  string          `json:"currencyCode"`
+	AccountIDs    []string        `json:"accountIDs"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CreatedBy     string          `json:"createdBy"`
+	LastUpdatedAt time.Time       `json:"lastUpdatedAt"`
+	LastUpdatedBy string          `json:"lastUpdatedBy"`
+}
+
+// ListSavingsGoalsResponse wraps a list of savings goals
+type ListSavingsGoalsResponse struct {
+	Goals []SavingsGoalResponse `json:"goals"`
+}
+
+// SavingsGoalProgressResponse combines a savings goal with its derived progress
+type SavingsGoalProgressResponse struct {
+	Goal                        SavingsGoalResponse      `json:"goal"`
+	AsOf                        string                   `json:"asOf"`
+	CurrentAmount               decimal.Decimal          `json:"currentAmount"`
+	RemainingAmount             decimal.Decimal          `json:"remainingAmount"`
+	PercentComplete             decimal.Decimal          `json:"percentComplete"`
+	MonthsRemaining             int                      `json:"monthsRemaining"`
+	RequiredMonthlyContribution decimal.Decimal          `json:"requiredMonthlyContribution"`
+	AverageMonthlyContribution  decimal.Decimal          `json:"averageMonthlyContribution"`
+	TrailingMonths              int                      `json:"trailingMonths"`
+	ProjectedCompletionDate     *string                  `json:"projectedCompletionDate"`
+	Status                      domain.SavingsGoalStatus `json:"status"`
+}
+
+// ToSavingsGoalResponse converts a domain.SavingsGoal to a SavingsGoalResponse DTO
+func ToSavingsGoalResponse(g *domain.SavingsGoal) SavingsGoalResponse {
+	return SavingsGoalResponse{
+		GoalID:        g.GoalID,
+		WorkplaceID:   g.WorkplaceID,
+		Name:          g.Name,
+		Description:   g.Description,
+		TargetAmount:  g.TargetAmount,
+		TargetDate:    g.TargetDate.Format("2006-01-02"),
+		CurrencyCode:  g.CurrencyCode,
+		AccountIDs:    g.AccountIDs,
+		CreatedAt:     g.CreatedAt,
+		CreatedBy:     g.CreatedBy,
+		LastUpdatedAt: g.LastUpdatedAt,
+		LastUpdatedBy: g.LastUpdatedBy,
+	}
+}
+
+// ToListSavingsGoalsResponse converts a slice of domain.SavingsGoal to a ListSavingsGoalsResponse DTO
+func ToListSavingsGoalsResponse(goals []domain.SavingsGoal) ListSavingsGoalsResponse {
+	response := ListSavingsGoalsResponse{Goals: make([]SavingsGoalResponse, len(goals))}
+	for i := range goals {
+		response.Goals[i] = ToSavingsGoalResponse(&goals[i])
+	}
+	return response
+}
+
+// ToSavingsGoalProgressResponse converts a domain.SavingsGoalProgress to a SavingsGoalProgressResponse DTO
+func ToSavingsGoalProgressResponse(p *domain.SavingsGoalProgress) SavingsGoalProgressResponse {
+	response := SavingsGoalProgressResponse{
+		Goal:                        ToSavingsGoalResponse(&p.Goal),
+		AsOf:                        p.AsOf.Format("2006-01-02"),
+		CurrentAmount:               p.CurrentAmount,
+		RemainingAmount:             p.RemainingAmount,
+		PercentComplete:             p.PercentComplete,
+		MonthsRemaining:             p.MonthsRemaining,
+		RequiredMonthlyContribution: p.RequiredMonthlyContribution,
+		AverageMonthlyContribution:  p.AverageMonthlyContribution,
+		TrailingMonths:              p.TrailingMonths,
+		Status:                      p.Status,
+	}
+	if p.ProjectedCompletionDate != nil {
+		projected := p.ProjectedCompletionDate.Format("2006-01-02")
+		response.ProjectedCompletionDate = &projected
+	}
+	return response
+}
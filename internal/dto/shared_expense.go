@@ -0,0 +1,146 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Shared Expense DTOs ---
+
+// ExpenseShareRequest names a member sharing an expense
+type ExpenseShareRequest struct {
+	UserID string          `json:"userID" binding:"required"`
+	Value  decimal.Decimal `json:"value"` // Percentage for PERCENTAGE splits, amount for EXACT splits; ignored for EQUAL splits
+}
+
+// ShareExpenseRequest marks an expense journal as shared between workplace members
+type ShareExpenseRequest struct {
+	JournalID    string                `json:"journalID" binding:"required,uuid"`
+	PaidByUserID string                `json:"paidByUserID"` // Defaults to the member who created the journal
+	SplitMethod  domain.SplitMethod    `json:"splitMethod" binding:"required,oneof=EQUAL PERCENTAGE EXACT"`
+	Shares       []ExpenseShareRequest `json:"shares" binding:"required,min=1,dive"`
+}
+
+// SettleUpRequest records a repayment between two members
+type SettleUpRequest struct {
+	FromUserID   string          `json:"fromUserID" binding:"required"` // Member repaying
+	ToUserID     string          `json:"toUserID" binding:"required"`   // Member being repaid
+	Amount       decimal.Decimal `json:"amount" binding:"required,decimal_gtz"`
+	CurrencyCode string          `json:"currencyCode" binding:"required,iso4217"`
+	Date         *time.Time      `json:"date"` // Defaults to now
+	Description  string          `json:"description"`
+}
+
+// ExpenseShareResponse defines the part of a shared expense borne by one member
+type ExpenseShareResponse struct {
+	UserID string          `json:"userID"`
+	Value  decimal.Decimal `json:"value"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// SharedExpenseResponse defines the data returned for a shared expense
+type SharedExpenseResponse struct {
+	SharedExpenseID string                 `json:"sharedExpenseID"`
+	WorkplaceID     string                 `json:"workplaceID"`
+	JournalID       string                 `json:"journalID"`
+	PaidByUserID    string                 `json:"paidByUserID"`
+	SplitMethod     domain.SplitMethod     `json:"splitMethod"`
+	CurrencyCode    string                 `json:"currencyCode"`
+	TotalAmount     decimal.Decimal        `json:"totalAmount"`
+	ShareJournalID  string                 `json:"shareJournalID"`
+	Shares          []ExpenseShareResponse `json:"shares"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	CreatedBy       string                 `json:"createdBy"`
+}
+
+// ListSharedExpensesResponse wraps shared expenses, newest first
+type ListSharedExpensesResponse struct {
+	SharedExpenses []SharedExpenseResponse `json:"sharedExpenses"`
+}
+
+// MemberBalanceResponse defines the position of a member in one currency
+type MemberBalanceResponse struct {
+	UserID       string          `json:"userID"`
+	UserName     string          `json:"userName"`
+	CurrencyCode string          `json:"currencyCode"`
+	Owes         decimal.Decimal `json:"owes"`   // Receivable sub-account balance
+	IsOwed       decimal.Decimal `json:"isOwed"` // Payable sub-account balance
+	Net          decimal.Decimal `json:"net"`    // Positive when the other members owe this member
+}
+
+// MemberDebtResponse defines a repayment that settles the balances
+type MemberDebtResponse struct {
+	FromUserID   string          `json:"fromUserID"`
+	ToUserID     string          `json:"toUserID"`
+	CurrencyCode string          `json:"currencyCode"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+// MemberBalancesResponse lists member positions and the repayments that settle them
+type MemberBalancesResponse struct {
+	Balances []MemberBalanceResponse `json:"balances"`
+	Debts    []MemberDebtResponse    `json:"debts"`
+}
+
+// ToSharedExpenseResponse converts a domain SharedExpense to its response DTO
+func ToSharedExpenseResponse(e *domain.SharedExpense) SharedExpenseResponse {
+	shares := make([]ExpenseShareResponse, 0, len(e.Shares))
+	for _, share := range e.Shares {
+		shares = append(shares, ExpenseShareResponse{
+			UserID: share.UserID,
+			Value:  share.Value,
+			Amount: share.Amount,
+		})
+	}
+	return SharedExpenseResponse{
+		SharedExpenseID: e.SharedExpenseID,
+		WorkplaceID:     e.WorkplaceID,
+		JournalID:       e.JournalID,
+		PaidByUserID:    e.PaidByUserID,
+		SplitMethod:     e.SplitMethod,
+		CurrencyCode:    e.CurrencyCode,
+		TotalAmount:     e.TotalAmount,
+		ShareJournalID:  e.ShareJournalID,
+		Shares:          shares,
+		CreatedAt:       e.CreatedAt,
+		CreatedBy:       e.CreatedBy,
+	}
+}
+
+// ToListSharedExpensesResponse converts domain shared expenses to the list response DTO
+func ToListSharedExpensesResponse(expenses []domain.SharedExpense) ListSharedExpensesResponse {
+	resp := ListSharedExpensesResponse{SharedExpenses: make([]SharedExpenseResponse, 0, len(expenses))}
+	for i := range expenses {
+		resp.SharedExpenses = append(resp.SharedExpenses, ToSharedExpenseResponse(&expenses[i]))
+	}
+	return resp
+}
+
+// ToMemberBalancesResponse converts domain MemberBalances to the response DTO
+func ToMemberBalancesResponse(b *domain.MemberBalances) MemberBalancesResponse {
+	resp := MemberBalancesResponse{
+		Balances: make([]MemberBalanceResponse, 0, len(b.Balances)),
+		Debts:    make([]MemberDebtResponse, 0, len(b.Debts)),
+	}
+	for _, balance := range b.Balances {
+		resp.Balances = append(resp.Balances, MemberBalanceResponse{
+			UserID:       balance.UserID,
+			UserName:     balance.UserName,
+			CurrencyCode: balance.CurrencyCode,
+			Owes:         balance.Receivable,
+			IsOwed:       balance.Payable,
+			Net:          balance.Net,
+		})
+	}
+	for _, debt := range b.Debts {
+		resp.Debts = append(resp.Debts, MemberDebtResponse{
+			FromUserID:   debt.FromUserID,
+			ToUserID:     debt.ToUserID,
+			CurrencyCode: debt.CurrencyCode,
+			Amount:       debt.Amount,
+		})
+	}
+	return resp
+}
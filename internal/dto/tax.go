@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/shopspring/decimal"
+)
+
+// --- Tax Code DTOs ---
+
+// TaxCodeRequest defines the details of a tax code. It is used to create a tax code and, with PUT semantics,
+// to replace all fields of an existing one. Changing the rate only affects journals created afterwards.
+type TaxCodeRequest struct {
+	Code         string          `json:"code" binding:"required,max=50"` // Short code, e.g. VAT20; stored upper-case
+	Name         string          `json:"name" binding:"required,max=255"`
+	Rate         decimal.Decimal `json:"rate"`                                 // Percent between 0 and 100, e.g. 20 for 20%
+	TaxAccountID string          `json:"taxAccountID" binding:"required,uuid"` // ASSET or LIABILITY account tax is posted to
+	Inclusive    bool            `json:"inclusive"`                            // Amounts entered against the code already include the tax
+	IsActive     *bool           `json:"isActive"`                             // Defaults to true
+}
+
+// ListTaxCodesParams defines query parameters for listing tax codes
+type ListTaxCodesParams struct {
+	IncludeInactive bool `form:"includeInactive"`
+}
+
+// TaxCodeResponse defines the data returned for a tax code
+type TaxCodeResponse struct {
+	TaxCodeID     string          `json:"taxCodeID"`
+	WorkplaceID   string          `json:"workplaceID"`
+	Code          string          `json:"code"`
+	Name          string          `json:"name"`
+	Rate          decimal.Decimal `json:"rate"`
+	TaxAccountID  string          `json:"taxAccountID"`
+	Inclusive     bool            `json:"inclusive"`
+	IsActive      bool            `json:"isActive"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	CreatedBy     string          `json:"createdBy"`
+	LastUpdatedAt time.Time       `json:"lastUpdatedAt"`
+	LastUpdatedBy string          `json:"lastUpdatedBy"`
+}
+
+// ListTaxCodesResponse wraps tax codes ordered by code
+type ListTaxCodesResponse struct {
+	TaxCodes []TaxCodeResponse `json:"taxCodes"`
+}
+
+// ToTaxCodeResponse converts a domain TaxCode to its response DTO
+func ToTaxCodeResponse(t *domain.TaxCode) TaxCodeResponse {
+	return TaxCodeResponse{
+		TaxCodeID:     t.TaxCodeID,
+		WorkplaceID:   t.WorkplaceID,
+		Code:          t.Code,
+		Name:          t.Name,
+		Rate:          t.Rate,
+		TaxAccountID:  t.TaxAccountID,
+		Inclusive:     t.Inclusive,
+		IsActive:      t.IsActive,
+		CreatedAt:     t.CreatedAt,
+		CreatedBy:     t.CreatedBy,
+		LastUpdatedAt: t.LastUpdatedAt,
+		LastUpdatedBy: t.LastUpdatedBy,
+	}
+}
+
+// ToListTaxCodesResponse converts domain tax codes to a list response DTO
+func ToListTaxCodesResponse(taxCodes []domain.TaxCode) ListTaxCodesResponse {
+	list := make([]TaxCodeResponse, len(taxCodes))
+	for i := range taxCodes {
+		list[i] = ToTaxCodeResponse(&taxCodes[i])
+	}
+	return ListTaxCodesResponse{TaxCodes: list}
+}
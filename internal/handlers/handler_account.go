@@ -9,6 +9,7 @@ import (
 	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services" // Use ports services
 	"github.com/SscSPs/money_managemet_app/internal/dto"
 	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/SscSPs/money_managemet_app/internal/utils/export"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,19 +17,22 @@ import (
 type accountHandler struct {
 	accountService portssvc.AccountSvcFacade     // Updated to use AccountSvcFacade
 	journalService portssvc.TransactionReaderSvc // Updated to use TransactionReaderSvc
+	exporter       *reportExporter
 }
 
 // newAccountHandler creates a new accountHandler.
-func newAccountHandler(as portssvc.AccountSvcFacade, js portssvc.TransactionReaderSvc) *accountHandler { // Updated interfaces
+func newAccountHandler(as portssvc.AccountSvcFacade, js portssvc.TransactionReaderSvc, exporter *reportExporter) *accountHandler { // Updated interfaces
 	return &accountHandler{
 		accountService: as,
 		journalService: js,
+		exporter:       exporter,
 	}
 }
 
 // RegisterAccountRoutes registers routes related to accounts WITHIN a workplace.
-func RegisterAccountRoutes(rg *gin.RouterGroup, accountService portssvc.AccountSvcFacade, transactionReaderSvc portssvc.TransactionReaderSvc) { // Updated interfaces
-	h := newAccountHandler(accountService, transactionReaderSvc)
+// The workplace and currency services are only used to label and format exported files.
+func RegisterAccountRoutes(rg *gin.RouterGroup, accountService portssvc.AccountSvcFacade, transactionReaderSvc portssvc.TransactionReaderSvc, workplaceService portssvc.WorkplaceReaderSvc, currencyService portssvc.CurrencyReaderSvc) { // Updated interfaces
+	h := newAccountHandler(accountService, transactionReaderSvc, newReportExporter(workplaceService, currencyService))
 
 	// Routes are now relative to /workplaces/{workplace_id}/
 	accounts := rg.Group("/accounts")
@@ -365,6 +369,7 @@ func (h *accountHandler) deleteAccount(c *gin.Context) {
 // @Param   id path string true "Account ID"
 // @Param   limit query int false "Limit number of results" default(20)
 // @Param   offset query int false "Offset for pagination" default(0)
+// @Param   format query string false "Response format; csv, xlsx and pdf export every transaction instead of one page" Enums(json, csv, xlsx, pdf) default(json)
 // @Success 200 {object} dto.ListTransactionsResponse
 // @Failure 400 {object} map[string]string "Missing Workplace/Account ID or invalid query params"
 // @Failure 401 {object} map[string]string "Unauthorized"
@@ -399,6 +404,16 @@ func (h *accountHandler) listTransactionsByAccount(c *gin.Context) {
 		return
 	}
 
+	format, ok := requestedExportFormat(c, logger)
+	if !ok {
+		return
+	}
+	if format != export.FormatJSON {
+		// Exports cover every transaction, so always start from the first page
+		params.Limit = exportPageSize
+		params.NextToken = nil
+	}
+
 	logger = logger.With(slog.String("user_id", loggedInUserID), slog.String("workplace_id", workplaceID), slog.String("account_id", accountID))
 	logger.Info("Received request to list transactions for account", slog.Int("limit", params.Limit), slog.String("nextToken", safeStringDeref(params.NextToken)))
 
@@ -419,6 +434,18 @@ func (h *accountHandler) listTransactionsByAccount(c *gin.Context) {
 		return
 	}
 
+	if format != export.FormatJSON {
+		accountLabel := accountID
+		if account, err := h.accountService.GetAccountByID(c.Request.Context(), workplaceID, accountID, loggedInUserID); err == nil {
+			accountLabel = account.Name
+		}
+		h.exporter.exportAccountTransactions(c, logger, format, workplaceID, accountLabel, resp, func(nextToken *string) (*dto.ListTransactionsResponse, error) {
+			params.NextToken = nextToken
+			return h.journalService.ListTransactionsByAccount(c.Request.Context(), workplaceID, accountID, loggedInUserID, params)
+		})
+		return
+	}
+
 	logger.Info("Transactions listed successfully for account", slog.Int("count", len(resp.Transactions)))
 	c.JSON(http.StatusOK, resp)
 }
@@ -115,10 +115,31 @@ func (m *MockJournalService) UpdateJournal(ctx context.Context, workplaceID stri
 	}
 	return args.Get(0).(*domain.Journal), args.Error(1)
 }
+func (m *MockJournalService) UpdateJournalDimensions(ctx context.Context, workplaceID string, journalID string, req dto.UpdateJournalDimensionsRequest, requestingUserID string) (*domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, req, requestingUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Journal), args.Error(1)
+}
 func (m *MockJournalService) DeactivateJournal(ctx context.Context, workplaceID string, journalID string, requestingUserID string) error {
 	args := m.Called(ctx, workplaceID, journalID, requestingUserID)
 	return args.Error(0)
 }
+func (m *MockJournalService) CreateIntercompanyJournal(ctx context.Context, workplaceID string, req dto.CreateIntercompanyJournalRequest, creatorUserID string) (*domain.Journal, *domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, req, creatorUserID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Journal), args.Get(1).(*domain.Journal), args.Error(2)
+}
+func (m *MockJournalService) ReverseIntercompanyJournal(ctx context.Context, workplaceID string, journalID string, userID string) (*domain.Journal, *domain.Journal, error) {
+	args := m.Called(ctx, workplaceID, journalID, userID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Journal), args.Get(1).(*domain.Journal), args.Error(2)
+}
 func (m *MockJournalService) ListTransactionsByAccount(ctx context.Context, workplaceID string, accountID string, userID string, params dto.ListTransactionsParams) (*dto.ListTransactionsResponse, error) {
 	args := m.Called(ctx, workplaceID, accountID, userID, params)
 	if args.Get(0) == nil {
@@ -188,8 +209,8 @@ func (suite *AccountHandlerTestSuite) SetupTest() {
 	suite.mockJournalService = new(MockJournalService)
 
 	// Register routes - requires the actual registration function
-	v1 := suite.router.Group("/api/v1/workplaces/:workplace_id")                           // Mimic grouping
-	handlers.RegisterAccountRoutes(v1, suite.mockAccountService, suite.mockJournalService) // Use exported name
+	v1 := suite.router.Group("/api/v1/workplaces/:workplace_id")                                     // Mimic grouping
+	handlers.RegisterAccountRoutes(v1, suite.mockAccountService, suite.mockJournalService, nil, nil) // Use exported name
 }
 
 // --- Test Cases ---
@@ -253,6 +274,48 @@ func (suite *AccountHandlerTestSuite) TestListTransactionsByAccount_Success() {
 	suite.mockAccountService.AssertNotCalled(suite.T(), "ListAccounts") // Ensure unrelated service methods not called
 }
 
+func (suite *AccountHandlerTestSuite) TestListTransactionsByAccount_CSVExportPagesThroughAll() {
+	workplaceID := uuid.NewString()
+	accountID := uuid.NewString()
+	requestingUserID := uuid.NewString()
+	nextToken := "page-2"
+
+	firstPage := &dto.ListTransactionsResponse{
+		Transactions: []dto.TransactionResponse{
+			{TransactionID: uuid.NewString(), Amount: decimal.RequireFromString("100.456"), TransactionType: domain.Debit, CurrencyCode: "USD", JournalDescription: "Salary"},
+		},
+		NextToken: &nextToken,
+	}
+	secondPage := &dto.ListTransactionsResponse{
+		Transactions: []dto.TransactionResponse{
+			{TransactionID: uuid.NewString(), Amount: decimal.NewFromInt(50), TransactionType: domain.Credit, CurrencyCode: "USD", JournalDescription: "Groceries"},
+		},
+	}
+
+	suite.mockJournalService.On("ListTransactionsByAccount", mock.Anything, workplaceID, accountID, requestingUserID,
+		mock.MatchedBy(func(p dto.ListTransactionsParams) bool { return p.NextToken == nil })).Return(firstPage, nil).Once()
+	suite.mockJournalService.On("ListTransactionsByAccount", mock.Anything, workplaceID, accountID, requestingUserID,
+		mock.MatchedBy(func(p dto.ListTransactionsParams) bool { return p.NextToken != nil && *p.NextToken == nextToken })).Return(secondPage, nil).Once()
+	suite.mockAccountService.On("GetAccountByID", mock.Anything, workplaceID, accountID, requestingUserID).
+		Return(&domain.Account{AccountID: accountID, Name: "Checking"}, nil).Once()
+
+	url := fmt.Sprintf("/api/v1/workplaces/%s/accounts/%s/transactions?format=csv", workplaceID, accountID)
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+suite.generateTestToken(requestingUserID))
+
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, req)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("text/csv", w.Header().Get("Content-Type"))
+	suite.Contains(w.Header().Get("Content-Disposition"), "attachment;")
+	body := w.Body.String()
+	suite.Contains(body, "Account Transactions - Checking")
+	suite.Contains(body, "Salary,,100.46,,0", "amounts are rounded to the default precision when no currency service is configured")
+	suite.Contains(body, "Groceries,,,50,0")
+	suite.mockJournalService.AssertExpectations(suite.T())
+}
+
 // TODO: Add tests for other scenarios:
 // - Service returns ErrNotFound
 // - Service returns ErrForbidden
@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// maxStatementFileSize limits the size of an uploaded bank statement file
+const maxStatementFileSize = 10 << 20
+
+// bankStatementHandler handles HTTP requests related to bank statement imports.
+type bankStatementHandler struct {
+	statementService portssvc.BankStatementSvcFacade
+}
+
+// newBankStatementHandler creates a new bankStatementHandler.
+func newBankStatementHandler(bs portssvc.BankStatementSvcFacade) *bankStatementHandler {
+	return &bankStatementHandler{
+		statementService: bs,
+	}
+}
+
+// registerBankStatementRoutes registers routes related to bank statement imports WITHIN a workplace.
+func registerBankStatementRoutes(rg *gin.RouterGroup, statementService portssvc.BankStatementSvcFacade) {
+	h := newBankStatementHandler(statementService)
+
+	imports := rg.Group("/bank-imports")
+	{
+		imports.POST("", h.importStatement)
+		imports.GET("/lines", h.listStatementLines)
+		imports.POST("/lines/:line_id/post", h.postStatementLine)
+		imports.POST("/lines/:line_id/ignore", h.ignoreStatementLine)
+		imports.POST("/lines/:line_id/restore", h.restoreStatementLine)
+	}
+}
+
+// statementPathParams reads the workplace and line IDs and the calling user, writing an error response when missing
+func statementPathParams(c *gin.Context, logger *slog.Logger, needLine bool) (workplaceID, lineID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	lineID = c.Param("line_id")
+	if workplaceID == "" || (needLine && lineID == "") {
+		logger.Error("Workplace ID or Line ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Line ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, lineID, userID, true
+}
+
+// writeBankStatementError maps a bank statement service error to an HTTP response
+func writeBankStatementError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Bank statement line not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Bank statement line not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// importStatement godoc
+// @Summary Import bank statement
+// @Description Uploads an OFX/QFX, CAMT.053 or MT940 statement for an ASSET or LIABILITY account. Lines are staged for review; lines already imported (same FITID or bank reference) are skipped.
+// @Tags bank-imports
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID formData string true "Account the statement belongs to"
+// @Param   format formData string false "OFX, QFX, CAMT053 or MT940 (detected when omitted)"
+// @Param   file formData file true "Statement file"
+// @Success 201 {object} dto.BankStatementImportResponse
+// @Failure 400 {object} map[string]string "Invalid input or unreadable statement"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to import bank statement"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bank-imports [post]
+func (h *bankStatementHandler) importStatement(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := statementPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.ImportBankStatementRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Warn("Failed to bind form for ImportStatement", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Statement file is required"})
+		return
+	}
+	if fileHeader.Size > maxStatementFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Statement file is too large"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.Error("Failed to open uploaded statement", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read statement file"})
+		return
+	}
+	defer file.Close()
+	if req.Data, err = io.ReadAll(io.LimitReader(file, maxStatementFileSize)); err != nil {
+		logger.Error("Failed to read uploaded statement", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read statement file"})
+		return
+	}
+	req.FileName = fileHeader.Filename
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to import bank statement", slog.String("account_id", req.AccountID), slog.String("file_name", req.FileName))
+
+	statementImport, err := h.statementService.ImportStatement(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeBankStatementError(c, logger, err, "import bank statement")
+		return
+	}
+
+	logger.Info("Bank statement imported successfully", slog.String("import_id", statementImport.ImportID))
+	c.JSON(http.StatusCreated, dto.ToBankStatementImportResponse(statementImport))
+}
+
+// listStatementLines godoc
+// @Summary List staged statement lines
+// @Description Lists the staged bank statement lines of an account, newest first
+// @Tags bank-imports
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID query string true "Account ID"
+// @Param   status query string false "PENDING, POSTED or IGNORED (all when omitted)"
+// @Param   limit query int false "Maximum number of lines (default 100, max 500)"
+// @Param   offset query int false "Number of lines to skip"
+// @Success 200 {object} dto.ListStatementLinesResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list statement lines"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bank-imports/lines [get]
+func (h *bankStatementHandler) listStatementLines(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := statementPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListStatementLinesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query for ListStatementLines", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	lines, err := h.statementService.ListStatementLines(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeBankStatementError(c, logger, err, "list statement lines")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListStatementLinesResponse(lines))
+}
+
+// postStatementLine godoc
+// @Summary Post statement line
+// @Description Creates a journal for a pending statement line between the statement account and a counter-account. The counter-account defaults to the one set by a categorization rule, then to the default counter-account of the payee recognised in the line.
+// @Tags bank-imports
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   line_id path string true "Statement line ID"
+// @Param   request body dto.PostStatementLineRequest true "Counter-account and description"
+// @Success 201 {object} dto.PostStatementLineResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bank statement line not found"
+// @Failure 409 {object} map[string]string "Line is not pending"
+// @Failure 500 {object} map[string]string "Failed to post statement line"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bank-imports/lines/{line_id}/post [post]
+func (h *bankStatementHandler) postStatementLine(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, lineID, userID, ok := statementPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.PostStatementLineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for PostStatementLine", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("line_id", lineID))
+	logger.Info("Received request to post statement line", slog.String("counter_account_id", req.CounterAccountID))
+
+	line, journal, err := h.statementService.PostStatementLine(c.Request.Context(), workplaceID, lineID, req, userID)
+	if err != nil {
+		writeBankStatementError(c, logger, err, "post statement line")
+		return
+	}
+
+	logger.Info("Statement line posted successfully", slog.String("journal_id", journal.JournalID))
+	c.JSON(http.StatusCreated, dto.PostStatementLineResponse{
+		Line:    dto.ToBankStatementLineResponse(line),
+		Journal: dto.ToJournalResponse(journal),
+	})
+}
+
+// ignoreStatementLine godoc
+// @Summary Ignore statement line
+// @Description Dismisses a pending statement line so it is not turned into a journal
+// @Tags bank-imports
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   line_id path string true "Statement line ID"
+// @Success 200 {object} dto.BankStatementLineResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bank statement line not found"
+// @Failure 409 {object} map[string]string "Line is not pending"
+// @Failure 500 {object} map[string]string "Failed to ignore statement line"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bank-imports/lines/{line_id}/ignore [post]
+func (h *bankStatementHandler) ignoreStatementLine(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, lineID, userID, ok := statementPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("line_id", lineID))
+
+	line, err := h.statementService.IgnoreStatementLine(c.Request.Context(), workplaceID, lineID, userID)
+	if err != nil {
+		writeBankStatementError(c, logger, err, "ignore statement line")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBankStatementLineResponse(line))
+}
+
+// restoreStatementLine godoc
+// @Summary Restore statement line
+// @Description Moves an ignored statement line back to pending
+// @Tags bank-imports
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   line_id path string true "Statement line ID"
+// @Success 200 {object} dto.BankStatementLineResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bank statement line not found"
+// @Failure 409 {object} map[string]string "Line is not ignored"
+// @Failure 500 {object} map[string]string "Failed to restore statement line"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bank-imports/lines/{line_id}/restore [post]
+func (h *bankStatementHandler) restoreStatementLine(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, lineID, userID, ok := statementPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("line_id", lineID))
+
+	line, err := h.statementService.RestoreStatementLine(c.Request.Context(), workplaceID, lineID, userID)
+	if err != nil {
+		writeBankStatementError(c, logger, err, "restore statement line")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBankStatementLineResponse(line))
+}
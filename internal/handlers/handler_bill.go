@@ -0,0 +1,560 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// billHandler handles HTTP requests for vendor bills, their payments and payables.
+type billHandler struct {
+	billService portssvc.BillSvcFacade
+}
+
+// newBillHandler creates a new billHandler.
+func newBillHandler(bs portssvc.BillSvcFacade) *billHandler {
+	return &billHandler{
+		billService: bs,
+	}
+}
+
+// registerBillRoutes registers routes for bills WITHIN a workplace.
+func registerBillRoutes(rg *gin.RouterGroup, billService portssvc.BillSvcFacade) {
+	h := newBillHandler(billService)
+
+	bills := rg.Group("/bills")
+	{
+		bills.POST("", h.createBill)
+		bills.GET("", h.listBills)
+		bills.GET("/aged-payables", h.getAgedPayables)
+		bills.POST("/scheduled-payments/post-due", h.postDueBillPayments)
+		bills.GET("/:bill_id", h.getBill)
+		bills.PUT("/:bill_id", h.updateBill)
+		bills.DELETE("/:bill_id", h.deleteBill)
+		bills.POST("/:bill_id/post", h.postBill)
+		bills.POST("/:bill_id/payments", h.recordBillPayment)
+		bills.POST("/:bill_id/scheduled-payments", h.scheduleBillPayment)
+		bills.DELETE("/:bill_id/scheduled-payments/:scheduled_payment_id", h.cancelScheduledBillPayment)
+		bills.POST("/:bill_id/void", h.voidBill)
+	}
+}
+
+// billPathParams reads the workplace and bill IDs and the calling user, writing an error response when missing
+func billPathParams(c *gin.Context, logger *slog.Logger, needBill bool) (workplaceID, billID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	billID = c.Param("bill_id")
+	if workplaceID == "" || (needBill && billID == "") {
+		logger.Error("Workplace ID or Bill ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Bill ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, billID, userID, true
+}
+
+// writeBillError maps a bill service error to an HTTP response
+func writeBillError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Bill not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Bill not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createBill godoc
+// @Summary Create a draft bill
+// @Description Creates a draft bill from a vendor (a payee of the workplace). The payable account must be a LIABILITY account and sets the bill currency; each line is debited to an EXPENSE account in that currency, optionally with a tax code. The due date defaults to the bill date; drafts post nothing until posted.
+// @Tags bills
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill body dto.BillRequest true "Bill content"
+// @Success 201 {object} dto.BillResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to create bill"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills [post]
+func (h *billHandler) createBill(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := billPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.BillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateBill", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create bill", slog.String("vendor_id", req.VendorID))
+
+	bill, err := h.billService.CreateBill(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "create bill")
+		return
+	}
+
+	logger.Info("Bill created successfully", slog.String("bill_id", bill.BillID))
+	c.JSON(http.StatusCreated, dto.ToBillResponse(bill, invoiceStatusDate()))
+}
+
+// listBills godoc
+// @Summary List bills
+// @Description Lists the bills of a workplace, newest first, without their lines. Status, amount paid and outstanding are derived from the posted journals as of today.
+// @Tags bills
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   vendorID query string false "Only bills of this vendor"
+// @Param   status query string false "Only bills with this status" Enums(DRAFT, OPEN, PARTIALLY_PAID, PAID, OVERDUE, VOID)
+// @Success 200 {object} dto.ListBillsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list bills"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills [get]
+func (h *billHandler) listBills(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := billPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListBillsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for ListBills", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	bills, err := h.billService.ListBills(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "list bills")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListBillsResponse(bills, invoiceStatusDate()))
+}
+
+// getBill godoc
+// @Summary Get bill
+// @Description Retrieves a bill with its lines, the payments whose journals are still posted and its scheduled payments
+// @Tags bills
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Success 200 {object} dto.BillResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve bill"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id} [get]
+func (h *billHandler) getBill(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+
+	bill, err := h.billService.GetBill(c.Request.Context(), workplaceID, billID, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "retrieve bill")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBillResponse(bill, invoiceStatusDate()))
+}
+
+// updateBill godoc
+// @Summary Update a draft bill
+// @Description Replaces the vendor, accounts, dates, notes and lines of a draft bill. Posted bills cannot change; void and re-create them instead.
+// @Tags bills
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Param   bill body dto.BillRequest true "Bill content"
+// @Success 200 {object} dto.BillResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 409 {object} map[string]string "Bill already posted"
+// @Failure 500 {object} map[string]string "Failed to update bill"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id} [put]
+func (h *billHandler) updateBill(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.BillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateBill", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+	logger.Info("Received request to update bill")
+
+	bill, err := h.billService.UpdateBill(c.Request.Context(), workplaceID, billID, req, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "update bill")
+		return
+	}
+
+	logger.Info("Bill updated successfully")
+	c.JSON(http.StatusOK, dto.ToBillResponse(bill, invoiceStatusDate()))
+}
+
+// deleteBill godoc
+// @Summary Delete a draft bill
+// @Description Deletes a draft bill. Posted bills are voided instead.
+// @Tags bills
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 409 {object} map[string]string "Bill already posted"
+// @Failure 500 {object} map[string]string "Failed to delete bill"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id} [delete]
+func (h *billHandler) deleteBill(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+	logger.Info("Received request to delete bill")
+
+	if err := h.billService.DeleteBill(c.Request.Context(), workplaceID, billID, userID); err != nil {
+		writeBillError(c, logger, err, "delete bill")
+		return
+	}
+
+	logger.Info("Bill deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+// postBill godoc
+// @Summary Post a bill
+// @Description Posts a journal on the bill date debiting the expense accounts of the lines and crediting the payable account with the total. Lines with a tax code debit the tax to the account of the code.
+// @Tags bills
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Success 200 {object} dto.BillResponse
+// @Failure 400 {object} map[string]string "Bill cannot be posted"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 409 {object} map[string]string "Bill already posted"
+// @Failure 500 {object} map[string]string "Failed to post bill"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id}/post [post]
+func (h *billHandler) postBill(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+	logger.Info("Received request to post bill")
+
+	bill, err := h.billService.PostBill(c.Request.Context(), workplaceID, billID, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "post bill")
+		return
+	}
+
+	logger.Info("Bill posted successfully", slog.String("journal_id", bill.PostJournalID))
+	c.JSON(http.StatusOK, dto.ToBillResponse(bill, invoiceStatusDate()))
+}
+
+// recordBillPayment godoc
+// @Summary Record a bill payment
+// @Description Posts a payment of a posted bill as a journal debiting the payable account and crediting the payment account. Partial payments are allowed; the amount cannot exceed what is still unpaid. Reversing the payment journal makes the amount owed again.
+// @Tags bills
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Param   payment body dto.BillPaymentRequest true "Payment details"
+// @Success 201 {object} dto.BillPaymentResponse
+// @Failure 400 {object} map[string]string "Invalid input, bill not posted or amount exceeds the unpaid"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 500 {object} map[string]string "Failed to record payment"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id}/payments [post]
+func (h *billHandler) recordBillPayment(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.BillPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for RecordBillPayment", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+	logger.Info("Received request to record bill payment", slog.String("amount", req.Amount.String()))
+
+	payment, err := h.billService.RecordBillPayment(c.Request.Context(), workplaceID, billID, req, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "record payment")
+		return
+	}
+
+	logger.Info("Bill payment recorded", slog.String("journal_id", payment.JournalID))
+	c.JSON(http.StatusCreated, dto.ToBillPaymentResponse(payment))
+}
+
+// scheduleBillPayment godoc
+// @Summary Schedule a bill payment
+// @Description Plans a payment of a posted bill from a payment account on a date. Nothing is posted until the scheduled payments due are posted; the scheduled amounts together cannot exceed what is still unpaid.
+// @Tags bills
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Param   payment body dto.BillPaymentRequest true "Scheduled payment details"
+// @Success 201 {object} dto.ScheduledBillPaymentResponse
+// @Failure 400 {object} map[string]string "Invalid input, bill not posted or amount exceeds the unscheduled"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 500 {object} map[string]string "Failed to schedule payment"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id}/scheduled-payments [post]
+func (h *billHandler) scheduleBillPayment(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.BillPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for ScheduleBillPayment", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+	logger.Info("Received request to schedule bill payment", slog.String("amount", req.Amount.String()))
+
+	scheduled, err := h.billService.ScheduleBillPayment(c.Request.Context(), workplaceID, billID, req, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "schedule payment")
+		return
+	}
+
+	logger.Info("Bill payment scheduled", slog.String("scheduled_payment_id", scheduled.ScheduledPaymentID))
+	c.JSON(http.StatusCreated, dto.ToScheduledBillPaymentResponse(scheduled))
+}
+
+// cancelScheduledBillPayment godoc
+// @Summary Cancel a scheduled bill payment
+// @Description Removes a scheduled payment that has not been posted yet. Posted scheduled payments are undone by reversing their journal.
+// @Tags bills
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Param   scheduled_payment_id path string true "Scheduled payment ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill or scheduled payment not found"
+// @Failure 409 {object} map[string]string "Scheduled payment already posted"
+// @Failure 500 {object} map[string]string "Failed to cancel scheduled payment"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id}/scheduled-payments/{scheduled_payment_id} [delete]
+func (h *billHandler) cancelScheduledBillPayment(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+	scheduledPaymentID := c.Param("scheduled_payment_id")
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID), slog.String("scheduled_payment_id", scheduledPaymentID))
+	logger.Info("Received request to cancel scheduled bill payment")
+
+	if err := h.billService.CancelScheduledBillPayment(c.Request.Context(), workplaceID, billID, scheduledPaymentID, userID); err != nil {
+		writeBillError(c, logger, err, "cancel scheduled payment")
+		return
+	}
+
+	logger.Info("Scheduled bill payment cancelled")
+	c.Status(http.StatusNoContent)
+}
+
+// postDueBillPayments godoc
+// @Summary Post due bill payments
+// @Description Posts the settlement journals of all pending scheduled payments dated on or before the given date, each on its scheduled date. Payments of bills paid in the meantime are skipped, and the amount is capped at what is still unpaid.
+// @Tags bills
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   asOf query string false "Post payments scheduled up to this date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} dto.ListBillPaymentsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters or a payment cannot be posted"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to post due payments"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/scheduled-payments/post-due [post]
+func (h *billHandler) postDueBillPayments(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := billPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.PostDueBillPaymentsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for PostDueBillPayments", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+	asOf := invoiceStatusDate()
+	if params.AsOf != nil {
+		asOf = *params.AsOf
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to post due bill payments")
+
+	payments, err := h.billService.PostDueBillPayments(c.Request.Context(), workplaceID, asOf, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "post due payments")
+		return
+	}
+
+	logger.Info("Due bill payments posted", slog.Int("count", len(payments)))
+	c.JSON(http.StatusOK, dto.ToListBillPaymentsResponse(payments))
+}
+
+// voidBill godoc
+// @Summary Void a bill
+// @Description Reverses the posting journal of a bill. Bills with payments cannot be voided until the payment journals are reversed.
+// @Tags bills
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   bill_id path string true "Bill ID"
+// @Success 200 {object} dto.BillResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Bill not found"
+// @Failure 409 {object} map[string]string "Bill is a draft, already void or has payments"
+// @Failure 500 {object} map[string]string "Failed to void bill"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/{bill_id}/void [post]
+func (h *billHandler) voidBill(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, billID, userID, ok := billPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("bill_id", billID))
+	logger.Info("Received request to void bill")
+
+	bill, err := h.billService.VoidBill(c.Request.Context(), workplaceID, billID, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "void bill")
+		return
+	}
+
+	logger.Info("Bill voided successfully")
+	c.JSON(http.StatusOK, dto.ToBillResponse(bill, invoiceStatusDate()))
+}
+
+// getAgedPayables godoc
+// @Summary Aged payables
+// @Description Buckets the amounts owed on posted bills by how long they are past due on the given date (not yet due, 0-30, 31-60, 61-90 and over 90 days), per vendor and currency. Only payments dated on or before the date count. Optionally limited to bills payable to an account or any of its sub-accounts.
+// @Tags bills
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to today"
+// @Param   accountID query string false "Only bills payable to this account or its sub-accounts"
+// @Success 200 {object} dto.AgingReportResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to generate aged payables"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/bills/aged-payables [get]
+func (h *billHandler) getAgedPayables(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := billPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.AgedPayablesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for AgedPayables", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+	asOf := invoiceStatusDate()
+	if params.AsOf != nil {
+		asOf = *params.AsOf
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	report, err := h.billService.AgedPayables(c.Request.Context(), workplaceID, asOf, params.AccountID, userID)
+	if err != nil {
+		writeBillError(c, logger, err, "generate aged payables")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToAgingReportResponse(report))
+}
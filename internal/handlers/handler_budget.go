@@ -0,0 +1,396 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// budgetHandler handles HTTP requests related to budgets.
+type budgetHandler struct {
+	budgetService portssvc.BudgetSvcFacade
+}
+
+// newBudgetHandler creates a new budgetHandler.
+func newBudgetHandler(bs portssvc.BudgetSvcFacade) *budgetHandler {
+	return &budgetHandler{
+		budgetService: bs,
+	}
+}
+
+// registerBudgetRoutes registers routes related to budgets WITHIN a workplace.
+func registerBudgetRoutes(rg *gin.RouterGroup, budgetService portssvc.BudgetSvcFacade) {
+	h := newBudgetHandler(budgetService)
+
+	budgets := rg.Group("/budgets")
+	{
+		budgets.POST("", h.createBudget)
+		budgets.GET("", h.listBudgets)
+		budgets.GET("/:budget_id", h.getBudget)
+		budgets.PUT("/:budget_id", h.updateBudget)
+		budgets.DELETE("/:budget_id", h.deleteBudget)
+		budgets.PUT("/:budget_id/lines", h.setBudgetLines)
+		budgets.POST("/:budget_id/copy-actuals", h.copyActualsToBudget)
+		budgets.GET("/:budget_id/vs-actual", h.getBudgetVsActual)
+	}
+}
+
+// budgetPathParams reads the workplace and budget IDs and the calling user, writing an error response when missing
+func budgetPathParams(c *gin.Context, logger *slog.Logger, needBudget bool) (workplaceID, budgetID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	budgetID = c.Param("budget_id")
+	if workplaceID == "" || (needBudget && budgetID == "") {
+		logger.Error("Workplace ID or Budget ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Budget ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, budgetID, userID, true
+}
+
+// writeBudgetError maps a budget service error to an HTTP response
+func writeBudgetError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Budget not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Budget not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createBudget godoc
+// @Summary Create budget in workplace
+// @Description Creates a monthly or yearly budget for a calendar year, optionally with initial lines
+// @Tags budgets
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget body dto.CreateBudgetRequest true "Budget details"
+// @Success 201 {object} dto.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to create budget"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets [post]
+func (h *budgetHandler) createBudget(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := budgetPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateBudget", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create budget", slog.String("name", req.Name), slog.Int("year", req.Year))
+
+	budget, err := h.budgetService.CreateBudget(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "create budget")
+		return
+	}
+
+	logger.Info("Budget created successfully", slog.String("budget_id", budget.BudgetID))
+	c.JSON(http.StatusCreated, dto.ToBudgetResponse(budget))
+}
+
+// listBudgets godoc
+// @Summary List budgets in workplace
+// @Description Lists the budgets of a workplace without their lines
+// @Tags budgets
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListBudgetsResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list budgets"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets [get]
+func (h *budgetHandler) listBudgets(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := budgetPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to list budgets")
+
+	budgets, err := h.budgetService.ListBudgets(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "list budgets")
+		return
+	}
+
+	logger.Info("Budgets listed successfully", slog.Int("count", len(budgets)))
+	c.JSON(http.StatusOK, dto.ToListBudgetsResponse(budgets))
+}
+
+// getBudget godoc
+// @Summary Get budget
+// @Description Retrieves a budget with its lines
+// @Tags budgets
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget_id path string true "Budget ID"
+// @Success 200 {object} dto.BudgetResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve budget"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets/{budget_id} [get]
+func (h *budgetHandler) getBudget(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, budgetID, userID, ok := budgetPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("budget_id", budgetID))
+	logger.Info("Received request to get budget")
+
+	budget, err := h.budgetService.GetBudgetByID(c.Request.Context(), workplaceID, budgetID, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "retrieve budget")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToBudgetResponse(budget))
+}
+
+// updateBudget godoc
+// @Summary Update budget
+// @Description Updates a budget's name and description
+// @Tags budgets
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget_id path string true "Budget ID"
+// @Param   budget body dto.UpdateBudgetRequest true "Fields to update"
+// @Success 200 {object} dto.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget not found"
+// @Failure 500 {object} map[string]string "Failed to update budget"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets/{budget_id} [put]
+func (h *budgetHandler) updateBudget(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, budgetID, userID, ok := budgetPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateBudget", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("budget_id", budgetID))
+	logger.Info("Received request to update budget")
+
+	budget, err := h.budgetService.UpdateBudget(c.Request.Context(), workplaceID, budgetID, req, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "update budget")
+		return
+	}
+
+	logger.Info("Budget updated successfully")
+	c.JSON(http.StatusOK, dto.ToBudgetResponse(budget))
+}
+
+// deleteBudget godoc
+// @Summary Delete budget
+// @Description Deletes a budget and its lines
+// @Tags budgets
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget_id path string true "Budget ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget not found"
+// @Failure 500 {object} map[string]string "Failed to delete budget"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets/{budget_id} [delete]
+func (h *budgetHandler) deleteBudget(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, budgetID, userID, ok := budgetPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("budget_id", budgetID))
+	logger.Info("Received request to delete budget")
+
+	if err := h.budgetService.DeleteBudget(c.Request.Context(), workplaceID, budgetID, userID); err != nil {
+		writeBudgetError(c, logger, err, "delete budget")
+		return
+	}
+
+	logger.Info("Budget deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+// setBudgetLines godoc
+// @Summary Set budget lines
+// @Description Inserts or replaces budget amounts per account and period; a zero amount removes the line and lines not mentioned are kept
+// @Tags budgets
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget_id path string true "Budget ID"
+// @Param   lines body dto.SetBudgetLinesRequest true "Budget lines"
+// @Success 200 {object} dto.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget not found"
+// @Failure 500 {object} map[string]string "Failed to save budget lines"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets/{budget_id}/lines [put]
+func (h *budgetHandler) setBudgetLines(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, budgetID, userID, ok := budgetPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.SetBudgetLinesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for SetBudgetLines", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("budget_id", budgetID))
+	logger.Info("Received request to set budget lines", slog.Int("line_count", len(req.Lines)))
+
+	budget, err := h.budgetService.SetBudgetLines(c.Request.Context(), workplaceID, budgetID, req, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "save budget lines")
+		return
+	}
+
+	logger.Info("Budget lines saved successfully")
+	c.JSON(http.StatusOK, dto.ToBudgetResponse(budget))
+}
+
+// copyActualsToBudget godoc
+// @Summary Copy actuals into budget
+// @Description Fills a budget from another year's actual revenue and expenses (the previous year by default), adjusted by a percentage
+// @Tags budgets
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget_id path string true "Budget ID"
+// @Param   request body dto.CopyBudgetActualsRequest true "Source year and adjustment"
+// @Success 200 {object} dto.BudgetResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget not found"
+// @Failure 500 {object} map[string]string "Failed to copy actuals"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets/{budget_id}/copy-actuals [post]
+func (h *budgetHandler) copyActualsToBudget(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, budgetID, userID, ok := budgetPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.CopyBudgetActualsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CopyBudgetActuals", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("budget_id", budgetID))
+	logger.Info("Received request to copy actuals into budget", slog.Int("source_year", req.SourceYear))
+
+	budget, err := h.budgetService.CopyActualsToBudget(c.Request.Context(), workplaceID, budgetID, req, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "copy actuals into budget")
+		return
+	}
+
+	logger.Info("Actuals copied into budget successfully", slog.Int("line_count", len(budget.Lines)))
+	c.JSON(http.StatusOK, dto.ToBudgetResponse(budget))
+}
+
+// getBudgetVsActual godoc
+// @Summary Budget vs actual report
+// @Description Compares budgeted amounts with actual revenue and expenses, with remaining amount and percentage used, for one month or the whole budget year
+// @Tags budgets
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   budget_id path string true "Budget ID"
+// @Param   month query int false "Month (1-12) of a monthly budget; omit for the whole year"
+// @Success 200 {object} dto.BudgetVsActualResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Budget not found"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/budgets/{budget_id}/vs-actual [get]
+func (h *budgetHandler) getBudgetVsActual(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, budgetID, userID, ok := budgetPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	month := 0
+	if monthStr := c.Query("month"); monthStr != "" {
+		var err error
+		if month, err = strconv.Atoi(monthStr); err != nil {
+			logger.Warn("Invalid month parameter", slog.String("month", monthStr))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid month. Use a number between 1 and 12"})
+			return
+		}
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("budget_id", budgetID), slog.Int("month", month))
+	logger.Info("Received request to generate budget vs actual report")
+
+	report, err := h.budgetService.BudgetVsActual(c.Request.Context(), workplaceID, budgetID, month, userID)
+	if err != nil {
+		writeBudgetError(c, logger, err, "generate budget vs actual report")
+		return
+	}
+
+	logger.Info("Budget vs actual report generated successfully")
+	c.JSON(http.StatusOK, dto.ToBudgetVsActualResponse(report))
+}
@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// categorizationHandler handles HTTP requests related to categorization rules.
+type categorizationHandler struct {
+	categorizationService portssvc.CategorizationSvcFacade
+}
+
+// newCategorizationHandler creates a new categorizationHandler.
+func newCategorizationHandler(cs portssvc.CategorizationSvcFacade) *categorizationHandler {
+	return &categorizationHandler{
+		categorizationService: cs,
+	}
+}
+
+// registerCategorizationRoutes registers routes related to categorization rules WITHIN a workplace.
+func registerCategorizationRoutes(rg *gin.RouterGroup, categorizationService portssvc.CategorizationSvcFacade) {
+	h := newCategorizationHandler(categorizationService)
+
+	rules := rg.Group("/categorization-rules")
+	{
+		rules.POST("", h.createCategorizationRule)
+		rules.GET("", h.listCategorizationRules)
+		rules.POST("/apply", h.applyCategorizationRules)
+		rules.GET("/suggestions", h.suggestCategorizationRules)
+		rules.GET("/:rule_id", h.getCategorizationRule)
+		rules.PUT("/:rule_id", h.updateCategorizationRule)
+		rules.DELETE("/:rule_id", h.deleteCategorizationRule)
+	}
+}
+
+// rulePathParams reads the workplace and rule IDs and the calling user, writing an error response when missing
+func rulePathParams(c *gin.Context, logger *slog.Logger, needRule bool) (workplaceID, ruleID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	ruleID = c.Param("rule_id")
+	if workplaceID == "" || (needRule && ruleID == "") {
+		logger.Error("Workplace ID or Rule ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Rule ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, ruleID, userID, true
+}
+
+// writeCategorizationError maps a categorization service error to an HTTP response
+func writeCategorizationError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Categorization rule not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Categorization rule not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createCategorizationRule godoc
+// @Summary Create categorization rule
+// @Description Creates a rule that assigns a counter-account, description and notes to staged bank lines matching its payee or description pattern, amount range, source account and sign
+// @Tags categorization
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   rule body dto.CategorizationRuleRequest true "Rule details"
+// @Success 201 {object} dto.CategorizationRuleResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to create categorization rule"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules [post]
+func (h *categorizationHandler) createCategorizationRule(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := rulePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CategorizationRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateCategorizationRule", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create categorization rule", slog.String("name", req.Name))
+
+	rule, err := h.categorizationService.CreateCategorizationRule(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeCategorizationError(c, logger, err, "create categorization rule")
+		return
+	}
+
+	logger.Info("Categorization rule created successfully", slog.String("rule_id", rule.RuleID))
+	c.JSON(http.StatusCreated, dto.ToCategorizationRuleResponse(rule))
+}
+
+// listCategorizationRules godoc
+// @Summary List categorization rules
+// @Description Lists the categorization rules of a workplace in the order they are applied (ascending priority)
+// @Tags categorization
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListCategorizationRulesResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list categorization rules"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules [get]
+func (h *categorizationHandler) listCategorizationRules(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := rulePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	rules, err := h.categorizationService.ListCategorizationRules(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeCategorizationError(c, logger, err, "list categorization rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListCategorizationRulesResponse(rules))
+}
+
+// getCategorizationRule godoc
+// @Summary Get categorization rule
+// @Description Retrieves a categorization rule
+// @Tags categorization
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   rule_id path string true "Rule ID"
+// @Success 200 {object} dto.CategorizationRuleResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Categorization rule not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve categorization rule"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules/{rule_id} [get]
+func (h *categorizationHandler) getCategorizationRule(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, ruleID, userID, ok := rulePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("rule_id", ruleID))
+
+	rule, err := h.categorizationService.GetCategorizationRule(c.Request.Context(), workplaceID, ruleID, userID)
+	if err != nil {
+		writeCategorizationError(c, logger, err, "retrieve categorization rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCategorizationRuleResponse(rule))
+}
+
+// updateCategorizationRule godoc
+// @Summary Update categorization rule
+// @Description Replaces the conditions and actions of a categorization rule. Pending lines are re-categorized on the next apply.
+// @Tags categorization
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   rule_id path string true "Rule ID"
+// @Param   rule body dto.CategorizationRuleRequest true "Rule details"
+// @Success 200 {object} dto.CategorizationRuleResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Categorization rule not found"
+// @Failure 500 {object} map[string]string "Failed to update categorization rule"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules/{rule_id} [put]
+func (h *categorizationHandler) updateCategorizationRule(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, ruleID, userID, ok := rulePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.CategorizationRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateCategorizationRule", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("rule_id", ruleID))
+	logger.Info("Received request to update categorization rule")
+
+	rule, err := h.categorizationService.UpdateCategorizationRule(c.Request.Context(), workplaceID, ruleID, req, userID)
+	if err != nil {
+		writeCategorizationError(c, logger, err, "update categorization rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCategorizationRuleResponse(rule))
+}
+
+// deleteCategorizationRule godoc
+// @Summary Delete categorization rule
+// @Description Deletes a categorization rule; lines it already categorized keep their counter-account
+// @Tags categorization
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   rule_id path string true "Rule ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Categorization rule not found"
+// @Failure 500 {object} map[string]string "Failed to delete categorization rule"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules/{rule_id} [delete]
+func (h *categorizationHandler) deleteCategorizationRule(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, ruleID, userID, ok := rulePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("rule_id", ruleID))
+	logger.Info("Received request to delete categorization rule")
+
+	if err := h.categorizationService.DeleteCategorizationRule(c.Request.Context(), workplaceID, ruleID, userID); err != nil {
+		writeCategorizationError(c, logger, err, "delete categorization rule")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// applyCategorizationRules godoc
+// @Summary Apply categorization rules
+// @Description Runs the active rules in priority order over pending bank statement lines and reports which rule matched each line. Lines no rule matches any more lose their rule categorization.
+// @Tags categorization
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   apply body dto.ApplyCategorizationRulesRequest false "Optional statement account filter"
+// @Success 200 {object} dto.ApplyCategorizationRulesResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to apply categorization rules"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules/apply [post]
+func (h *categorizationHandler) applyCategorizationRules(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := rulePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.ApplyCategorizationRulesRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Warn("Failed to bind JSON for ApplyCategorizationRules", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+			return
+		}
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to apply categorization rules", slog.String("account_id", req.AccountID))
+
+	result, err := h.categorizationService.ApplyCategorizationRules(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeCategorizationError(c, logger, err, "apply categorization rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToApplyCategorizationRulesResponse(result))
+}
+
+// suggestCategorizationRules godoc
+// @Summary Suggest categorization rules
+// @Description Proposes rules for payees that were posted to the same counter-account by hand at least twice in the last 12 months and that no existing rule matches
+// @Tags categorization
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListCategorizationRuleSuggestionsResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to suggest categorization rules"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/categorization-rules/suggestions [get]
+func (h *categorizationHandler) suggestCategorizationRules(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := rulePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	suggestions, err := h.categorizationService.SuggestCategorizationRules(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeCategorizationError(c, logger, err, "suggest categorization rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ListCategorizationRuleSuggestionsResponse{Suggestions: suggestions})
+}
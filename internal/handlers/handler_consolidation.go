@@ -0,0 +1,405 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// consolidationHandler handles HTTP requests for consolidation groups and their consolidated reports.
+type consolidationHandler struct {
+	consolidationService portssvc.ConsolidationSvcFacade
+}
+
+// newConsolidationHandler creates a new consolidationHandler.
+func newConsolidationHandler(cs portssvc.ConsolidationSvcFacade) *consolidationHandler {
+	return &consolidationHandler{
+		consolidationService: cs,
+	}
+}
+
+// registerConsolidationRoutes registers routes for consolidation groups managed from a workplace.
+func registerConsolidationRoutes(rg *gin.RouterGroup, consolidationService portssvc.ConsolidationSvcFacade) {
+	h := newConsolidationHandler(consolidationService)
+
+	groups := rg.Group("/consolidation-groups")
+	{
+		groups.POST("", h.createConsolidationGroup)
+		groups.GET("", h.listConsolidationGroups)
+		groups.GET("/:group_id", h.getConsolidationGroup)
+		groups.PUT("/:group_id", h.updateConsolidationGroup)
+		groups.DELETE("/:group_id", h.deleteConsolidationGroup)
+		groups.GET("/:group_id/trial-balance", h.getConsolidatedTrialBalance)
+		groups.GET("/:group_id/profit-and-loss", h.getConsolidatedProfitAndLoss)
+		groups.GET("/:group_id/balance-sheet", h.getConsolidatedBalanceSheet)
+	}
+}
+
+// consolidationPathParams reads the workplace and group IDs and the calling user, writing an error response when missing
+func consolidationPathParams(c *gin.Context, logger *slog.Logger, needGroup bool) (workplaceID, groupID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	groupID = c.Param("group_id")
+	if workplaceID == "" || (needGroup && groupID == "") {
+		logger.Error("Workplace ID or Group ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Group ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, groupID, userID, true
+}
+
+// writeConsolidationError maps a consolidation service error to an HTTP response
+func writeConsolidationError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Consolidation group not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Consolidation group not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createConsolidationGroup godoc
+// @Summary Create consolidation group
+// @Description Creates a group combining the books of several workplaces, one per entity, into consolidated reports in a group currency. The caller needs read access to every member workplace.
+// @Tags consolidation
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group body dto.ConsolidationGroupRequest true "Group details"
+// @Success 201 {object} dto.ConsolidationGroupResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Name already used by another group"
+// @Failure 500 {object} map[string]string "Failed to create consolidation group"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups [post]
+func (h *consolidationHandler) createConsolidationGroup(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := consolidationPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.ConsolidationGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateConsolidationGroup", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create consolidation group", slog.String("name", req.Name))
+
+	group, err := h.consolidationService.CreateConsolidationGroup(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "create consolidation group")
+		return
+	}
+
+	logger.Info("Consolidation group created successfully", slog.String("group_id", group.GroupID))
+	c.JSON(http.StatusCreated, dto.ToConsolidationGroupResponse(group))
+}
+
+// listConsolidationGroups godoc
+// @Summary List consolidation groups
+// @Description Lists the consolidation groups managed from a workplace, ordered by name
+// @Tags consolidation
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListConsolidationGroupsResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list consolidation groups"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups [get]
+func (h *consolidationHandler) listConsolidationGroups(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := consolidationPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	groups, err := h.consolidationService.ListConsolidationGroups(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "list consolidation groups")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListConsolidationGroupsResponse(groups))
+}
+
+// getConsolidationGroup godoc
+// @Summary Get consolidation group
+// @Description Retrieves a consolidation group with its members and account settings
+// @Tags consolidation
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group_id path string true "Group ID"
+// @Success 200 {object} dto.ConsolidationGroupResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Consolidation group not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve consolidation group"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups/{group_id} [get]
+func (h *consolidationHandler) getConsolidationGroup(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, groupID, userID, ok := consolidationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("group_id", groupID))
+
+	group, err := h.consolidationService.GetConsolidationGroup(c.Request.Context(), workplaceID, groupID, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "retrieve consolidation group")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToConsolidationGroupResponse(group))
+}
+
+// updateConsolidationGroup godoc
+// @Summary Update consolidation group
+// @Description Replaces the name, description, currency, members and account settings of a consolidation group
+// @Tags consolidation
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group_id path string true "Group ID"
+// @Param   group body dto.ConsolidationGroupRequest true "Group details"
+// @Success 200 {object} dto.ConsolidationGroupResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Consolidation group not found"
+// @Failure 409 {object} map[string]string "Name already used by another group"
+// @Failure 500 {object} map[string]string "Failed to update consolidation group"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups/{group_id} [put]
+func (h *consolidationHandler) updateConsolidationGroup(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, groupID, userID, ok := consolidationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.ConsolidationGroupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateConsolidationGroup", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("group_id", groupID))
+	logger.Info("Received request to update consolidation group")
+
+	group, err := h.consolidationService.UpdateConsolidationGroup(c.Request.Context(), workplaceID, groupID, req, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "update consolidation group")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToConsolidationGroupResponse(group))
+}
+
+// deleteConsolidationGroup godoc
+// @Summary Delete consolidation group
+// @Description Deletes a consolidation group; the books of its members are not affected
+// @Tags consolidation
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group_id path string true "Group ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Consolidation group not found"
+// @Failure 500 {object} map[string]string "Failed to delete consolidation group"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups/{group_id} [delete]
+func (h *consolidationHandler) deleteConsolidationGroup(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, groupID, userID, ok := consolidationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("group_id", groupID))
+	logger.Info("Received request to delete consolidation group")
+
+	if err := h.consolidationService.DeleteConsolidationGroup(c.Request.Context(), workplaceID, groupID, userID); err != nil {
+		writeConsolidationError(c, logger, err, "delete consolidation group")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getConsolidatedTrialBalance godoc
+// @Summary Get consolidated trial balance
+// @Description Generates the trial balance of a consolidation group: the balances of all members, matched by group code or CFID, translated into the group currency at the latest rates effective on or before the as-of date, with intercompany balances eliminated
+// @Tags consolidation
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group_id path string true "Group ID"
+// @Param   asOf query string false "Report date (YYYY-MM-DD)" default(current date)
+// @Success 200 {object} dto.ConsolidatedTrialBalanceResponse
+// @Failure 400 {object} map[string]string "Invalid date or missing exchange rate"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Consolidation group not found"
+// @Failure 500 {object} map[string]string "Failed to generate consolidated trial balance"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups/{group_id}/trial-balance [get]
+func (h *consolidationHandler) getConsolidatedTrialBalance(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, groupID, userID, ok := consolidationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	asOfStr := c.DefaultQuery("asOf", time.Now().Format("2006-01-02"))
+	asOf, err := time.Parse("2006-01-02", asOfStr)
+	if err != nil {
+		logger.Warn("Invalid asOf date format", slog.String("asOf", asOfStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("group_id", groupID), slog.String("asOf", asOfStr))
+
+	report, err := h.consolidationService.ConsolidatedTrialBalance(c.Request.Context(), workplaceID, groupID, asOf, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "generate consolidated trial balance")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToConsolidatedTrialBalanceResponse(report))
+}
+
+// getConsolidatedProfitAndLoss godoc
+// @Summary Get consolidated profit and loss report
+// @Description Generates the profit and loss report of a consolidation group for a period, with intercompany revenue and expenses eliminated
+// @Tags consolidation
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group_id path string true "Group ID"
+// @Param   fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param   toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Success 200 {object} dto.ConsolidatedProfitAndLossResponse
+// @Failure 400 {object} map[string]string "Invalid dates or missing exchange rate"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Consolidation group not found"
+// @Failure 500 {object} map[string]string "Failed to generate consolidated profit and loss report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups/{group_id}/profit-and-loss [get]
+func (h *consolidationHandler) getConsolidatedProfitAndLoss(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, groupID, userID, ok := consolidationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	fromStr := c.DefaultQuery("fromDate", firstDayOfMonth.Format("2006-01-02"))
+	from, err := time.Parse("2006-01-02", fromStr)
+	if err != nil {
+		logger.Warn("Invalid from date format", slog.String("fromDate", fromStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid fromDate format. Use YYYY-MM-DD"})
+		return
+	}
+
+	toStr := c.DefaultQuery("toDate", now.Format("2006-01-02"))
+	to, err := time.Parse("2006-01-02", toStr)
+	if err != nil {
+		logger.Warn("Invalid to date format", slog.String("toDate", toStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid toDate format. Use YYYY-MM-DD"})
+		return
+	}
+
+	if from.After(to) {
+		logger.Warn("Invalid date range", slog.String("fromDate", fromStr), slog.String("toDate", toStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "fromDate must be before or equal to toDate"})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("group_id", groupID))
+
+	report, err := h.consolidationService.ConsolidatedProfitAndLoss(c.Request.Context(), workplaceID, groupID, from, to, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "generate consolidated profit and loss report")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToConsolidatedProfitAndLossResponse(report))
+}
+
+// getConsolidatedBalanceSheet godoc
+// @Summary Get consolidated balance sheet
+// @Description Generates the balance sheet of a consolidation group, with intercompany receivables and payables eliminated
+// @Tags consolidation
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   group_id path string true "Group ID"
+// @Param   asOf query string false "Report date (YYYY-MM-DD)" default(current date)
+// @Success 200 {object} dto.ConsolidatedBalanceSheetResponse
+// @Failure 400 {object} map[string]string "Invalid date or missing exchange rate"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Consolidation group not found"
+// @Failure 500 {object} map[string]string "Failed to generate consolidated balance sheet"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/consolidation-groups/{group_id}/balance-sheet [get]
+func (h *consolidationHandler) getConsolidatedBalanceSheet(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, groupID, userID, ok := consolidationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	asOfStr := c.DefaultQuery("asOf", time.Now().Format("2006-01-02"))
+	asOf, err := time.Parse("2006-01-02", asOfStr)
+	if err != nil {
+		logger.Warn("Invalid asOf date format", slog.String("asOf", asOfStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date format. Use YYYY-MM-DD"})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("group_id", groupID), slog.String("asOf", asOfStr))
+
+	report, err := h.consolidationService.ConsolidatedBalanceSheet(c.Request.Context(), workplaceID, groupID, asOf, userID)
+	if err != nil {
+		writeConsolidationError(c, logger, err, "generate consolidated balance sheet")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToConsolidatedBalanceSheetResponse(report))
+}
@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// creditCardHandler handles HTTP requests for credit card cycles, statements and dues.
+type creditCardHandler struct {
+	creditCardService portssvc.CreditCardSvcFacade
+}
+
+// newCreditCardHandler creates a new creditCardHandler.
+func newCreditCardHandler(cs portssvc.CreditCardSvcFacade) *creditCardHandler {
+	return &creditCardHandler{
+		creditCardService: cs,
+	}
+}
+
+// registerCreditCardRoutes registers routes for credit cards WITHIN a workplace.
+func registerCreditCardRoutes(rg *gin.RouterGroup, creditCardService portssvc.CreditCardSvcFacade) {
+	h := newCreditCardHandler(creditCardService)
+
+	cards := rg.Group("/credit-cards")
+	{
+		cards.POST("", h.createCreditCard)
+		cards.GET("", h.listCreditCards)
+		cards.GET("/upcoming-dues", h.listUpcomingDues)
+		cards.GET("/:account_id", h.getCreditCard)
+		cards.PUT("/:account_id", h.updateCreditCard)
+		cards.DELETE("/:account_id", h.deleteCreditCard)
+		cards.GET("/:account_id/statement", h.getStatement)
+	}
+}
+
+// creditCardPathParams reads the workplace and account IDs and the calling user, writing an error response when missing
+func creditCardPathParams(c *gin.Context, logger *slog.Logger, needAccount bool) (workplaceID, accountID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	accountID = c.Param("account_id")
+	if workplaceID == "" || (needAccount && accountID == "") {
+		logger.Error("Workplace ID or Account ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Account ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, accountID, userID, true
+}
+
+// writeCreditCardError maps a credit card service error to an HTTP response
+func writeCreditCardError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Credit card not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Credit card not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createCreditCard godoc
+// @Summary Set up a credit card
+// @Description Turns a LIABILITY account into a credit card with monthly billing cycles. Statements close on the closing day and are due on the due day, in the following month when the due day is not after the closing day; days past the end of a month fall on its last day. The minimum due is the larger of the percentage of the statement balance and the minimum amount, capped at the balance.
+// @Tags credit-cards
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   creditCard body dto.CreateCreditCardRequest true "Account and billing-cycle settings"
+// @Success 201 {object} dto.CreditCardResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Account is already a credit card"
+// @Failure 500 {object} map[string]string "Failed to create credit card"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards [post]
+func (h *creditCardHandler) createCreditCard(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := creditCardPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateCreditCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateCreditCard", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create credit card", slog.String("account_id", req.AccountID))
+
+	card, err := h.creditCardService.CreateCreditCard(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeCreditCardError(c, logger, err, "create credit card")
+		return
+	}
+
+	logger.Info("Credit card created successfully", slog.String("account_id", card.AccountID))
+	c.JSON(http.StatusCreated, dto.ToCreditCardResponse(card))
+}
+
+// listCreditCards godoc
+// @Summary List credit cards
+// @Description Lists the credit cards of a workplace, ordered by account name
+// @Tags credit-cards
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListCreditCardsResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list credit cards"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards [get]
+func (h *creditCardHandler) listCreditCards(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := creditCardPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	cards, err := h.creditCardService.ListCreditCards(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeCreditCardError(c, logger, err, "list credit cards")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListCreditCardsResponse(cards))
+}
+
+// listUpcomingDues godoc
+// @Summary List upcoming credit card dues
+// @Description Lists the closed statements of the workplace's credit cards that fall due within the given number of days and are not fully paid, soonest first. Payments dated after the closing date count towards the amounts due.
+// @Tags credit-cards
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to today"
+// @Param   days query int false "Window in days, defaults to 30"
+// @Success 200 {object} dto.UpcomingDuesResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list upcoming dues"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards/upcoming-dues [get]
+func (h *creditCardHandler) listUpcomingDues(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := creditCardPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.UpcomingDuesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for ListUpcomingDues", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	dues, err := h.creditCardService.ListUpcomingDues(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeCreditCardError(c, logger, err, "list upcoming dues")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToUpcomingDuesResponse(dues))
+}
+
+// getCreditCard godoc
+// @Summary Get credit card
+// @Description Retrieves the current position of a credit card: its settings, current balance, available credit, current billing cycle and the statement of the last closed cycle
+// @Tags credit-cards
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   account_id path string true "Account ID"
+// @Success 200 {object} dto.CreditCardSummaryResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Credit card not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve credit card"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards/{account_id} [get]
+func (h *creditCardHandler) getCreditCard(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, accountID, userID, ok := creditCardPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("account_id", accountID))
+
+	summary, err := h.creditCardService.GetCreditCard(c.Request.Context(), workplaceID, accountID, userID)
+	if err != nil {
+		writeCreditCardError(c, logger, err, "retrieve credit card")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCreditCardSummaryResponse(summary))
+}
+
+// updateCreditCard godoc
+// @Summary Update a credit card
+// @Description Replaces the billing-cycle settings of a credit card. Statements are derived, so past cycles follow the new settings.
+// @Tags credit-cards
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   account_id path string true "Account ID"
+// @Param   creditCard body dto.CreditCardRequest true "Billing-cycle settings"
+// @Success 200 {object} dto.CreditCardResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Credit card not found"
+// @Failure 500 {object} map[string]string "Failed to update credit card"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards/{account_id} [put]
+func (h *creditCardHandler) updateCreditCard(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, accountID, userID, ok := creditCardPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.CreditCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateCreditCard", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("account_id", accountID))
+	logger.Info("Received request to update credit card")
+
+	card, err := h.creditCardService.UpdateCreditCard(c.Request.Context(), workplaceID, accountID, req, userID)
+	if err != nil {
+		writeCreditCardError(c, logger, err, "update credit card")
+		return
+	}
+
+	logger.Info("Credit card updated successfully")
+	c.JSON(http.StatusOK, dto.ToCreditCardResponse(card))
+}
+
+// deleteCreditCard godoc
+// @Summary Delete a credit card
+// @Description Removes the billing-cycle settings of an account. The account and its journals are kept.
+// @Tags credit-cards
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   account_id path string true "Account ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Credit card not found"
+// @Failure 500 {object} map[string]string "Failed to delete credit card"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards/{account_id} [delete]
+func (h *creditCardHandler) deleteCreditCard(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, accountID, userID, ok := creditCardPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("account_id", accountID))
+	logger.Info("Received request to delete credit card")
+
+	if err := h.creditCardService.DeleteCreditCard(c.Request.Context(), workplaceID, accountID, userID); err != nil {
+		writeCreditCardError(c, logger, err, "delete credit card")
+		return
+	}
+
+	logger.Info("Credit card deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+// getStatement godoc
+// @Summary Get credit card statement
+// @Description Builds the statement of the billing cycle containing the given date, or of the last closed cycle: opening balance, charges, payments, statement balance, minimum due and the transactions of the cycle. For closed cycles, payments dated after the closing date through the due date are applied to the amounts due.
+// @Tags credit-cards
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   account_id path string true "Account ID"
+// @Param   date query string false "Any date in the cycle (YYYY-MM-DD), defaults to the last closed cycle"
+// @Success 200 {object} dto.CreditCardStatementResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Credit card not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve statement"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/credit-cards/{account_id}/statement [get]
+func (h *creditCardHandler) getStatement(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, accountID, userID, ok := creditCardPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var params dto.StatementParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for GetStatement", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("account_id", accountID))
+
+	statement, err := h.creditCardService.GetStatement(c.Request.Context(), workplaceID, accountID, params, userID)
+	if err != nil {
+		writeCreditCardError(c, logger, err, "retrieve statement")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCreditCardStatementResponse(statement))
+}
@@ -0,0 +1,371 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// csvImportHandler handles HTTP requests related to CSV import profiles and imports.
+type csvImportHandler struct {
+	csvImportService portssvc.CSVImportSvcFacade
+}
+
+// newCSVImportHandler creates a new csvImportHandler.
+func newCSVImportHandler(cs portssvc.CSVImportSvcFacade) *csvImportHandler {
+	return &csvImportHandler{
+		csvImportService: cs,
+	}
+}
+
+// registerCSVImportRoutes registers routes related to CSV imports WITHIN a workplace.
+func registerCSVImportRoutes(rg *gin.RouterGroup, csvImportService portssvc.CSVImportSvcFacade) {
+	h := newCSVImportHandler(csvImportService)
+
+	profiles := rg.Group("/csv-import-profiles")
+	{
+		profiles.POST("", h.createCSVImportProfile)
+		profiles.GET("", h.listCSVImportProfiles)
+		profiles.GET("/:profile_id", h.getCSVImportProfile)
+		profiles.PUT("/:profile_id", h.updateCSVImportProfile)
+		profiles.DELETE("/:profile_id", h.deleteCSVImportProfile)
+		profiles.POST("/:profile_id/preview", h.previewCSVImport)
+		profiles.POST("/:profile_id/import", h.importCSV)
+	}
+}
+
+// csvProfilePathParams reads the workplace and profile IDs and the calling user, writing an error response when missing
+func csvProfilePathParams(c *gin.Context, logger *slog.Logger, needProfile bool) (workplaceID, profileID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	profileID = c.Param("profile_id")
+	if workplaceID == "" || (needProfile && profileID == "") {
+		logger.Error("Workplace ID or Profile ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Profile ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, profileID, userID, true
+}
+
+// readUploadedCSV reads the "file" form field, writing an error response when it is missing or too large
+func readUploadedCSV(c *gin.Context, logger *slog.Logger) (fileName string, data []byte, ok bool) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "CSV file is required"})
+		return "", nil, false
+	}
+	if fileHeader.Size > maxStatementFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "CSV file is too large"})
+		return "", nil, false
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.Error("Failed to open uploaded CSV", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read CSV file"})
+		return "", nil, false
+	}
+	defer file.Close()
+	if data, err = io.ReadAll(io.LimitReader(file, maxStatementFileSize)); err != nil {
+		logger.Error("Failed to read uploaded CSV", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read CSV file"})
+		return "", nil, false
+	}
+	return fileHeader.Filename, data, true
+}
+
+// writeCSVImportError maps a CSV import service error to an HTTP response
+func writeCSVImportError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("CSV import profile not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "CSV import profile not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createCSVImportProfile godoc
+// @Summary Create CSV import profile
+// @Description Saves how a bank's CSV export is read for an ASSET or LIABILITY account: column numbers, date format, decimal separator, amount sign convention or debit/credit columns, and rows to skip
+// @Tags csv-imports
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   profile body dto.CreateCSVImportProfileRequest true "Profile details"
+// @Success 201 {object} dto.CSVImportProfileResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to create CSV import profile"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles [post]
+func (h *csvImportHandler) createCSVImportProfile(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := csvProfilePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateCSVImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateCSVImportProfile", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create CSV import profile", slog.String("name", req.Name))
+
+	profile, err := h.csvImportService.CreateCSVImportProfile(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeCSVImportError(c, logger, err, "create CSV import profile")
+		return
+	}
+
+	logger.Info("CSV import profile created successfully", slog.String("profile_id", profile.ProfileID))
+	c.JSON(http.StatusCreated, dto.ToCSVImportProfileResponse(profile))
+}
+
+// listCSVImportProfiles godoc
+// @Summary List CSV import profiles
+// @Description Lists the CSV import profiles of a workplace by name
+// @Tags csv-imports
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID query string false "Only profiles of this account"
+// @Success 200 {object} dto.ListCSVImportProfilesResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list CSV import profiles"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles [get]
+func (h *csvImportHandler) listCSVImportProfiles(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := csvProfilePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	profiles, err := h.csvImportService.ListCSVImportProfiles(c.Request.Context(), workplaceID, c.Query("accountID"), userID)
+	if err != nil {
+		writeCSVImportError(c, logger, err, "list CSV import profiles")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListCSVImportProfilesResponse(profiles))
+}
+
+// getCSVImportProfile godoc
+// @Summary Get CSV import profile
+// @Description Retrieves a CSV import profile
+// @Tags csv-imports
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   profile_id path string true "Profile ID"
+// @Success 200 {object} dto.CSVImportProfileResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "CSV import profile not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve CSV import profile"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles/{profile_id} [get]
+func (h *csvImportHandler) getCSVImportProfile(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, profileID, userID, ok := csvProfilePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("profile_id", profileID))
+
+	profile, err := h.csvImportService.GetCSVImportProfile(c.Request.Context(), workplaceID, profileID, userID)
+	if err != nil {
+		writeCSVImportError(c, logger, err, "retrieve CSV import profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCSVImportProfileResponse(profile))
+}
+
+// updateCSVImportProfile godoc
+// @Summary Update CSV import profile
+// @Description Updates a CSV import profile; the account cannot be changed
+// @Tags csv-imports
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   profile_id path string true "Profile ID"
+// @Param   profile body dto.UpdateCSVImportProfileRequest true "Fields to update"
+// @Success 200 {object} dto.CSVImportProfileResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "CSV import profile not found"
+// @Failure 500 {object} map[string]string "Failed to update CSV import profile"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles/{profile_id} [put]
+func (h *csvImportHandler) updateCSVImportProfile(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, profileID, userID, ok := csvProfilePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateCSVImportProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateCSVImportProfile", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("profile_id", profileID))
+	logger.Info("Received request to update CSV import profile")
+
+	profile, err := h.csvImportService.UpdateCSVImportProfile(c.Request.Context(), workplaceID, profileID, req, userID)
+	if err != nil {
+		writeCSVImportError(c, logger, err, "update CSV import profile")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCSVImportProfileResponse(profile))
+}
+
+// deleteCSVImportProfile godoc
+// @Summary Delete CSV import profile
+// @Description Deletes a CSV import profile; lines already imported with it are kept
+// @Tags csv-imports
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   profile_id path string true "Profile ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "CSV import profile not found"
+// @Failure 500 {object} map[string]string "Failed to delete CSV import profile"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles/{profile_id} [delete]
+func (h *csvImportHandler) deleteCSVImportProfile(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, profileID, userID, ok := csvProfilePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("profile_id", profileID))
+	logger.Info("Received request to delete CSV import profile")
+
+	if err := h.csvImportService.DeleteCSVImportProfile(c.Request.Context(), workplaceID, profileID, userID); err != nil {
+		writeCSVImportError(c, logger, err, "delete CSV import profile")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// previewCSVImport godoc
+// @Summary Preview CSV import
+// @Description Reads an uploaded CSV file with a profile and returns every row with its parsed values or the reason it cannot be imported. Nothing is saved.
+// @Tags csv-imports
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   profile_id path string true "Profile ID"
+// @Param   file formData file true "CSV file"
+// @Success 200 {object} dto.CSVImportPreviewResponse
+// @Failure 400 {object} map[string]string "Invalid input or unreadable file"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "CSV import profile not found"
+// @Failure 500 {object} map[string]string "Failed to preview CSV import"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles/{profile_id}/preview [post]
+func (h *csvImportHandler) previewCSVImport(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, profileID, userID, ok := csvProfilePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+	_, data, ok := readUploadedCSV(c, logger)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("profile_id", profileID))
+
+	preview, err := h.csvImportService.PreviewCSVImport(c.Request.Context(), workplaceID, profileID, data, userID)
+	if err != nil {
+		writeCSVImportError(c, logger, err, "preview CSV import")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToCSVImportPreviewResponse(preview))
+}
+
+// importCSV godoc
+// @Summary Import CSV
+// @Description Stages the readable rows of an uploaded CSV file as bank statement lines, skipping rows imported before. In JOURNAL mode each new line is also posted against the counter-account; lines that fail stay pending.
+// @Tags csv-imports
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   profile_id path string true "Profile ID"
+// @Param   mode formData string false "STAGE (default) or JOURNAL"
+// @Param   counterAccountID formData string false "Counter-account for JOURNAL mode"
+// @Param   file formData file true "CSV file"
+// @Success 201 {object} dto.CSVImportResultResponse
+// @Failure 400 {object} map[string]string "Invalid input or unreadable file"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "CSV import profile not found"
+// @Failure 500 {object} map[string]string "Failed to import CSV"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/csv-import-profiles/{profile_id}/import [post]
+func (h *csvImportHandler) importCSV(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, profileID, userID, ok := csvProfilePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.ImportCSVRequest
+	if err := c.ShouldBind(&req); err != nil {
+		logger.Warn("Failed to bind form for ImportCSV", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+	if req.FileName, req.Data, ok = readUploadedCSV(c, logger); !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("profile_id", profileID))
+	logger.Info("Received request to import CSV", slog.String("mode", string(req.Mode)), slog.String("file_name", req.FileName))
+
+	result, err := h.csvImportService.ImportCSV(c.Request.Context(), workplaceID, profileID, req, userID)
+	if err != nil {
+		writeCSVImportError(c, logger, err, "import CSV")
+		return
+	}
+
+	logger.Info("CSV imported successfully", slog.String("import_id", result.Import.ImportID))
+	c.JSON(http.StatusCreated, dto.ToCSVImportResultResponse(result))
+}
@@ -0,0 +1,396 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// dimensionHandler handles HTTP requests for analytic dimensions and their values.
+type dimensionHandler struct {
+	dimensionService portssvc.DimensionSvcFacade
+}
+
+// newDimensionHandler creates a new dimensionHandler.
+func newDimensionHandler(ds portssvc.DimensionSvcFacade) *dimensionHandler {
+	return &dimensionHandler{
+		dimensionService: ds,
+	}
+}
+
+// registerDimensionRoutes registers routes for dimensions WITHIN a workplace.
+func registerDimensionRoutes(rg *gin.RouterGroup, dimensionService portssvc.DimensionSvcFacade) {
+	h := newDimensionHandler(dimensionService)
+
+	dimensions := rg.Group("/dimensions")
+	{
+		dimensions.POST("", h.createDimension)
+		dimensions.GET("", h.listDimensions)
+		dimensions.GET("/:dimension_id", h.getDimension)
+		dimensions.PUT("/:dimension_id", h.updateDimension)
+		dimensions.DELETE("/:dimension_id", h.deleteDimension)
+		dimensions.POST("/:dimension_id/values", h.createDimensionValue)
+		dimensions.PUT("/:dimension_id/values/:value_id", h.updateDimensionValue)
+		dimensions.DELETE("/:dimension_id/values/:value_id", h.deleteDimensionValue)
+	}
+}
+
+// dimensionPathParams reads the workplace and dimension IDs and the calling user, writing an error response when missing
+func dimensionPathParams(c *gin.Context, logger *slog.Logger, needDimension bool) (workplaceID, dimensionID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	dimensionID = c.Param("dimension_id")
+	if workplaceID == "" || (needDimension && dimensionID == "") {
+		logger.Error("Workplace ID or Dimension ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Dimension ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, dimensionID, userID, true
+}
+
+// writeDimensionError maps a dimension service error to an HTTP response
+func writeDimensionError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Dimension or value not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Dimension not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createDimension godoc
+// @Summary Create dimension
+// @Description Creates an analytic dimension, such as a project, a cost center or a free tag, whose values journals and their lines can be tagged with. Reports can be filtered and grouped by dimension values.
+// @Tags dimensions
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension body dto.DimensionRequest true "Dimension details"
+// @Success 201 {object} dto.DimensionResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Code already used by another dimension"
+// @Failure 500 {object} map[string]string "Failed to create dimension"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions [post]
+func (h *dimensionHandler) createDimension(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := dimensionPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.DimensionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateDimension", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create dimension", slog.String("code", req.Code))
+
+	dimension, err := h.dimensionService.CreateDimension(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeDimensionError(c, logger, err, "create dimension")
+		return
+	}
+
+	logger.Info("Dimension created successfully", slog.String("dimension_id", dimension.DimensionID))
+	c.JSON(http.StatusCreated, dto.ToDimensionResponse(dimension))
+}
+
+// listDimensions godoc
+// @Summary List dimensions
+// @Description Lists the dimensions of a workplace with their values, ordered by code
+// @Tags dimensions
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   includeInactive query bool false "Include deactivated dimensions and values"
+// @Success 200 {object} dto.ListDimensionsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list dimensions"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions [get]
+func (h *dimensionHandler) listDimensions(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := dimensionPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListDimensionsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query params for ListDimensions", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	dimensions, err := h.dimensionService.ListDimensions(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeDimensionError(c, logger, err, "list dimensions")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListDimensionsResponse(dimensions))
+}
+
+// getDimension godoc
+// @Summary Get dimension
+// @Description Retrieves a dimension with all its values, active or not
+// @Tags dimensions
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension_id path string true "Dimension ID"
+// @Success 200 {object} dto.DimensionResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Dimension not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve dimension"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions/{dimension_id} [get]
+func (h *dimensionHandler) getDimension(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, dimensionID, userID, ok := dimensionPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("dimension_id", dimensionID))
+
+	dimension, err := h.dimensionService.GetDimension(c.Request.Context(), workplaceID, dimensionID, userID)
+	if err != nil {
+		writeDimensionError(c, logger, err, "retrieve dimension")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToDimensionResponse(dimension))
+}
+
+// updateDimension godoc
+// @Summary Update dimension
+// @Description Replaces the code, name, description and active flag of a dimension. Values of an inactive dimension cannot be assigned anymore; existing tags are kept.
+// @Tags dimensions
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension_id path string true "Dimension ID"
+// @Param   dimension body dto.DimensionRequest true "Dimension details"
+// @Success 200 {object} dto.DimensionResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Dimension not found"
+// @Failure 409 {object} map[string]string "Code already used by another dimension"
+// @Failure 500 {object} map[string]string "Failed to update dimension"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions/{dimension_id} [put]
+func (h *dimensionHandler) updateDimension(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, dimensionID, userID, ok := dimensionPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.DimensionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateDimension", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("dimension_id", dimensionID))
+	logger.Info("Received request to update dimension")
+
+	dimension, err := h.dimensionService.UpdateDimension(c.Request.Context(), workplaceID, dimensionID, req, userID)
+	if err != nil {
+		writeDimensionError(c, logger, err, "update dimension")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToDimensionResponse(dimension))
+}
+
+// deleteDimension godoc
+// @Summary Delete dimension
+// @Description Deletes a dimension and its values when no journal or line is tagged with them; deactivate used dimensions instead
+// @Tags dimensions
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension_id path string true "Dimension ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Dimension not found"
+// @Failure 409 {object} map[string]string "Values of the dimension are in use"
+// @Failure 500 {object} map[string]string "Failed to delete dimension"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions/{dimension_id} [delete]
+func (h *dimensionHandler) deleteDimension(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, dimensionID, userID, ok := dimensionPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("dimension_id", dimensionID))
+	logger.Info("Received request to delete dimension")
+
+	if err := h.dimensionService.DeleteDimension(c.Request.Context(), workplaceID, dimensionID, userID); err != nil {
+		writeDimensionError(c, logger, err, "delete dimension")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// createDimensionValue godoc
+// @Summary Add dimension value
+// @Description Adds a value, such as a single project, to a dimension
+// @Tags dimensions
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension_id path string true "Dimension ID"
+// @Param   value body dto.DimensionValueRequest true "Value details"
+// @Success 201 {object} dto.DimensionValueResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Dimension not found"
+// @Failure 409 {object} map[string]string "Code already used by another value of the dimension"
+// @Failure 500 {object} map[string]string "Failed to create dimension value"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions/{dimension_id}/values [post]
+func (h *dimensionHandler) createDimensionValue(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, dimensionID, userID, ok := dimensionPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.DimensionValueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateDimensionValue", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("dimension_id", dimensionID))
+	logger.Info("Received request to create dimension value", slog.String("code", req.Code))
+
+	value, err := h.dimensionService.CreateDimensionValue(c.Request.Context(), workplaceID, dimensionID, req, userID)
+	if err != nil {
+		writeDimensionError(c, logger, err, "create dimension value")
+		return
+	}
+
+	logger.Info("Dimension value created successfully", slog.String("value_id", value.ValueID))
+	c.JSON(http.StatusCreated, dto.ToDimensionValueResponse(value))
+}
+
+// updateDimensionValue godoc
+// @Summary Update dimension value
+// @Description Replaces the code, name and active flag of a dimension value. Inactive values cannot be assigned anymore; existing tags are kept.
+// @Tags dimensions
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension_id path string true "Dimension ID"
+// @Param   value_id path string true "Value ID"
+// @Param   value body dto.DimensionValueRequest true "Value details"
+// @Success 200 {object} dto.DimensionValueResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Dimension or value not found"
+// @Failure 409 {object} map[string]string "Code already used by another value of the dimension"
+// @Failure 500 {object} map[string]string "Failed to update dimension value"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions/{dimension_id}/values/{value_id} [put]
+func (h *dimensionHandler) updateDimensionValue(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, dimensionID, userID, ok := dimensionPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+	valueID := c.Param("value_id")
+
+	var req dto.DimensionValueRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateDimensionValue", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("value_id", valueID))
+	logger.Info("Received request to update dimension value")
+
+	value, err := h.dimensionService.UpdateDimensionValue(c.Request.Context(), workplaceID, dimensionID, valueID, req, userID)
+	if err != nil {
+		writeDimensionError(c, logger, err, "update dimension value")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToDimensionValueResponse(value))
+}
+
+// deleteDimensionValue godoc
+// @Summary Delete dimension value
+// @Description Deletes a dimension value no journal or line is tagged with; deactivate used values instead
+// @Tags dimensions
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   dimension_id path string true "Dimension ID"
+// @Param   value_id path string true "Value ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Dimension or value not found"
+// @Failure 409 {object} map[string]string "Value is in use"
+// @Failure 500 {object} map[string]string "Failed to delete dimension value"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/dimensions/{dimension_id}/values/{value_id} [delete]
+func (h *dimensionHandler) deleteDimensionValue(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, dimensionID, userID, ok := dimensionPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+	valueID := c.Param("value_id")
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("value_id", valueID))
+	logger.Info("Received request to delete dimension value")
+
+	if err := h.dimensionService.DeleteDimensionValue(c.Request.Context(), workplaceID, dimensionID, valueID, userID); err != nil {
+		writeDimensionError(c, logger, err, "delete dimension value")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// duplicateJournalHandler handles HTTP requests for reviewing suspected duplicate journals.
+type duplicateJournalHandler struct {
+	duplicateService portssvc.DuplicateJournalSvcFacade
+}
+
+// newDuplicateJournalHandler creates a new duplicateJournalHandler.
+func newDuplicateJournalHandler(ds portssvc.DuplicateJournalSvcFacade) *duplicateJournalHandler {
+	return &duplicateJournalHandler{
+		duplicateService: ds,
+	}
+}
+
+// registerDuplicateJournalRoutes registers routes for reviewing suspected duplicate journals WITHIN a workplace.
+func registerDuplicateJournalRoutes(rg *gin.RouterGroup, duplicateService portssvc.DuplicateJournalSvcFacade) {
+	h := newDuplicateJournalHandler(duplicateService)
+
+	duplicates := rg.Group("/duplicate-journals")
+	{
+		duplicates.GET("", h.listDuplicatePairs)
+		duplicates.GET("/:pair_id", h.getDuplicatePair)
+		duplicates.POST("/:pair_id/confirm", h.confirmDuplicatePair)
+		duplicates.POST("/:pair_id/dismiss", h.dismissDuplicatePair)
+	}
+}
+
+// duplicatePairPathParams reads the workplace and pair IDs and the calling user, writing an error response when missing
+func duplicatePairPathParams(c *gin.Context, logger *slog.Logger, needPair bool) (workplaceID, pairID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	pairID = c.Param("pair_id")
+	if workplaceID == "" || (needPair && pairID == "") {
+		logger.Error("Workplace ID or Pair ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Pair ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, pairID, userID, true
+}
+
+// writeDuplicateJournalError maps a duplicate journal service error to an HTTP response
+func writeDuplicateJournalError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Duplicate pair or journal not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Duplicate pair not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// listDuplicatePairs godoc
+// @Summary List suspected duplicate journals
+// @Description Lists journal pairs flagged as possible duplicates when the later journal was created: same accounts, equal amounts, dates within a few days and a similar description
+// @Tags duplicate-journals
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   status query string false "Filter by status (PENDING, CONFIRMED or DISMISSED)"
+// @Success 200 {object} dto.ListDuplicatePairsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list duplicate pairs"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/duplicate-journals [get]
+func (h *duplicateJournalHandler) listDuplicatePairs(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := duplicatePairPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListDuplicatePairsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query params for ListDuplicatePairs", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	pairs, err := h.duplicateService.ListDuplicatePairs(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeDuplicateJournalError(c, logger, err, "list duplicate pairs")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListDuplicatePairsResponse(pairs))
+}
+
+// getDuplicatePair godoc
+// @Summary Get suspected duplicate journal pair
+// @Description Retrieves a suspected duplicate pair with both journals
+// @Tags duplicate-journals
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   pair_id path string true "Pair ID"
+// @Success 200 {object} dto.DuplicatePairResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Duplicate pair not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve duplicate pair"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/duplicate-journals/{pair_id} [get]
+func (h *duplicateJournalHandler) getDuplicatePair(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, pairID, userID, ok := duplicatePairPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("pair_id", pairID))
+
+	pair, err := h.duplicateService.GetDuplicatePair(c.Request.Context(), workplaceID, pairID, userID)
+	if err != nil {
+		writeDuplicateJournalError(c, logger, err, "retrieve duplicate pair")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToDuplicatePairResponse(pair))
+}
+
+// confirmDuplicatePair godoc
+// @Summary Confirm duplicate journal
+// @Description Confirms a pending pair by reversing one of its journals (by default the one that raised the flag) and records the decision
+// @Tags duplicate-journals
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   pair_id path string true "Pair ID"
+// @Param   confirm body dto.ConfirmDuplicatePairRequest false "Journal to reverse and an optional note"
+// @Success 200 {object} dto.DuplicatePairResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Duplicate pair not found"
+// @Failure 409 {object} map[string]string "Pair already reviewed or journal locked"
+// @Failure 500 {object} map[string]string "Failed to confirm duplicate pair"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/duplicate-journals/{pair_id}/confirm [post]
+func (h *duplicateJournalHandler) confirmDuplicatePair(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, pairID, userID, ok := duplicatePairPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.ConfirmDuplicatePairRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Warn("Failed to bind JSON for ConfirmDuplicatePair", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+			return
+		}
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("pair_id", pairID))
+	logger.Info("Received request to confirm duplicate pair")
+
+	pair, err := h.duplicateService.ConfirmDuplicatePair(c.Request.Context(), workplaceID, pairID, req, userID)
+	if err != nil {
+		writeDuplicateJournalError(c, logger, err, "confirm duplicate pair")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToDuplicatePairResponse(pair))
+}
+
+// dismissDuplicatePair godoc
+// @Summary Dismiss duplicate journal
+// @Description Records that both journals of a pending pair are genuine
+// @Tags duplicate-journals
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   pair_id path string true "Pair ID"
+// @Param   dismiss body dto.DismissDuplicatePairRequest false "Optional note"
+// @Success 200 {object} dto.DuplicatePairResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Duplicate pair not found"
+// @Failure 409 {object} map[string]string "Pair already reviewed"
+// @Failure 500 {object} map[string]string "Failed to dismiss duplicate pair"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/duplicate-journals/{pair_id}/dismiss [post]
+func (h *duplicateJournalHandler) dismissDuplicatePair(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, pairID, userID, ok := duplicatePairPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.DismissDuplicatePairRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Warn("Failed to bind JSON for DismissDuplicatePair", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+			return
+		}
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("pair_id", pairID))
+	logger.Info("Received request to dismiss duplicate pair")
+
+	pair, err := h.duplicateService.DismissDuplicatePair(c.Request.Context(), workplaceID, pairID, req, userID)
+	if err != nil {
+		writeDuplicateJournalError(c, logger, err, "dismiss duplicate pair")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToDuplicatePairResponse(pair))
+}
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// envelopeHandler handles HTTP requests related to envelope budgeting.
+type envelopeHandler struct {
+	envelopeService portssvc.EnvelopeSvcFacade
+}
+
+// newEnvelopeHandler creates a new envelopeHandler.
+func newEnvelopeHandler(es portssvc.EnvelopeSvcFacade) *envelopeHandler {
+	return &envelopeHandler{
+		envelopeService: es,
+	}
+}
+
+// registerEnvelopeRoutes registers routes related to envelope budgeting WITHIN a workplace.
+func registerEnvelopeRoutes(rg *gin.RouterGroup, envelopeService portssvc.EnvelopeSvcFacade) {
+	h := newEnvelopeHandler(envelopeService)
+
+	envelopes := rg.Group("/envelopes")
+	{
+		envelopes.GET("", h.getEnvelopeMonth)
+		envelopes.GET("/settings", h.getEnvelopeSettings)
+		envelopes.PUT("/settings", h.configureEnvelopes)
+		envelopes.GET("/allocations", h.listEnvelopeAllocations)
+		envelopes.POST("/allocations", h.assignToEnvelope)
+		envelopes.POST("/moves", h.moveBetweenEnvelopes)
+	}
+}
+
+// envelopeRequestContext reads the workplace ID and the calling user, writing an error response when missing
+func envelopeRequestContext(c *gin.Context, logger *slog.Logger) (workplaceID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", false
+	}
+	return workplaceID, userID, true
+}
+
+// parseEnvelopeMonthQuery reads the optional month (YYYY-MM) query parameter, defaulting to the current month
+func parseEnvelopeMonthQuery(c *gin.Context) (time.Time, bool) {
+	monthStr := c.Query("month")
+	if monthStr == "" {
+		return time.Now().UTC(), true
+	}
+	month, err := time.Parse("2006-01", monthStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid month format. Use YYYY-MM"})
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+// writeEnvelopeError maps an envelope service error to an HTTP response
+func writeEnvelopeError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Envelope data not found", slog.String("error", err.Error()))
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// getEnvelopeMonth godoc
+// @Summary Get envelope budget for a month
+// @Description Derives available-to-budget and each envelope's carried-over, assigned, activity and available amounts from posted journals and allocations
+// @Tags envelopes
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   month query string false "Month (YYYY-MM), defaults to the current month"
+// @Success 200 {object} dto.EnvelopeMonthResponse
+// @Failure 400 {object} map[string]string "Invalid input or envelope budgeting not enabled"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to derive envelope month"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/envelopes [get]
+func (h *envelopeHandler) getEnvelopeMonth(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, userID, ok := envelopeRequestContext(c, logger)
+	if !ok {
+		return
+	}
+	month, ok := parseEnvelopeMonthQuery(c)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("month", month.Format("2006-01")))
+	logger.Info("Received request to get envelope month")
+
+	result, err := h.envelopeService.GetEnvelopeMonth(c.Request.Context(), workplaceID, month, userID)
+	if err != nil {
+		writeEnvelopeError(c, logger, err, "derive envelope month")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToEnvelopeMonthResponse(result))
+}
+
+// getEnvelopeSettings godoc
+// @Summary Get envelope settings
+// @Description Retrieves the envelope budgeting start month of a workplace
+// @Tags envelopes
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.EnvelopeSettingsResponse
+// @Failure 400 {object} map[string]string "Envelope budgeting not enabled"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to retrieve envelope settings"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/envelopes/settings [get]
+func (h *envelopeHandler) getEnvelopeSettings(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, userID, ok := envelopeRequestContext(c, logger)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	settings, err := h.envelopeService.GetEnvelopeSettings(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeEnvelopeError(c, logger, err, "retrieve envelope settings")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToEnvelopeSettingsResponse(settings))
+}
+
+// configureEnvelopes godoc
+// @Summary Configure envelope budgeting
+// @Description Enables envelope budgeting for a workplace or changes its start month; income and spending before the start month are ignored
+// @Tags envelopes
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   settings body dto.ConfigureEnvelopesRequest true "Envelope settings"
+// @Success 200 {object} dto.EnvelopeSettingsResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to configure envelopes"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/envelopes/settings [put]
+func (h *envelopeHandler) configureEnvelopes(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, userID, ok := envelopeRequestContext(c, logger)
+	if !ok {
+		return
+	}
+
+	var req dto.ConfigureEnvelopesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for ConfigureEnvelopes", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to configure envelopes", slog.String("start_month", req.StartMonth))
+
+	settings, err := h.envelopeService.ConfigureEnvelopes(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeEnvelopeError(c, logger, err, "configure envelopes")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToEnvelopeSettingsResponse(settings))
+}
+
+// listEnvelopeAllocations godoc
+// @Summary List envelope allocations
+// @Description Lists the allocations and moves recorded for a month
+// @Tags envelopes
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   month query string false "Month (YYYY-MM), defaults to the current month"
+// @Success 200 {object} dto.ListEnvelopeAllocationsResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list envelope allocations"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/envelopes/allocations [get]
+func (h *envelopeHandler) listEnvelopeAllocations(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, userID, ok := envelopeRequestContext(c, logger)
+	if !ok {
+		return
+	}
+	month, ok := parseEnvelopeMonthQuery(c)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	allocations, err := h.envelopeService.ListEnvelopeAllocations(c.Request.Context(), workplaceID, month, userID)
+	if err != nil {
+		writeEnvelopeError(c, logger, err, "list envelope allocations")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListEnvelopeAllocationsResponse(allocations))
+}
+
+// assignToEnvelope godoc
+// @Summary Assign money to an envelope
+// @Description Assigns money from available-to-budget to an expense envelope for a month; a negative amount returns money
+// @Tags envelopes
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   allocation body dto.AssignEnvelopeRequest true "Allocation"
+// @Success 201 {object} dto.EnvelopeAllocationResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to assign money"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/envelopes/allocations [post]
+func (h *envelopeHandler) assignToEnvelope(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, userID, ok := envelopeRequestContext(c, logger)
+	if !ok {
+		return
+	}
+
+	var req dto.AssignEnvelopeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for AssignToEnvelope", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("account_id", req.AccountID))
+	logger.Info("Received request to assign money to envelope", slog.String("month", req.Month))
+
+	allocation, err := h.envelopeService.AssignToEnvelope(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeEnvelopeError(c, logger, err, "assign money")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToEnvelopeAllocationResponse(*allocation))
+}
+
+// moveBetweenEnvelopes godoc
+// @Summary Move money between envelopes
+// @Description Moves money from one envelope to another within a month
+// @Tags envelopes
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   move body dto.MoveEnvelopeRequest true "Move"
+// @Success 201 {object} dto.ListEnvelopeAllocationsResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to move money"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/envelopes/moves [post]
+func (h *envelopeHandler) moveBetweenEnvelopes(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, userID, ok := envelopeRequestContext(c, logger)
+	if !ok {
+		return
+	}
+
+	var req dto.MoveEnvelopeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for MoveBetweenEnvelopes", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to move money between envelopes",
+		slog.String("from_account_id", req.FromAccountID),
+		slog.String("to_account_id", req.ToAccountID))
+
+	allocations, err := h.envelopeService.MoveBetweenEnvelopes(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeEnvelopeError(c, logger, err, "move money")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToListEnvelopeAllocationsResponse(allocations))
+}
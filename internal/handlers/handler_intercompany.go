@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// intercompanyJournalHandler handles HTTP requests for journals mirrored across two workplaces.
+type intercompanyJournalHandler struct {
+	journalService portssvc.JournalSvcFacade
+}
+
+// newIntercompanyJournalHandler creates a new intercompanyJournalHandler.
+func newIntercompanyJournalHandler(js portssvc.JournalSvcFacade) *intercompanyJournalHandler {
+	return &intercompanyJournalHandler{
+		journalService: js,
+	}
+}
+
+// registerIntercompanyJournalRoutes registers routes for intercompany journals WITHIN a workplace.
+func registerIntercompanyJournalRoutes(rg *gin.RouterGroup, journalService portssvc.JournalSvcFacade) {
+	h := newIntercompanyJournalHandler(journalService)
+
+	intercompany := rg.Group("/intercompany-journals")
+	{
+		intercompany.POST("", h.createIntercompanyJournal)
+		intercompany.POST("/:id/reverse", h.reverseIntercompanyJournal)
+	}
+}
+
+// createIntercompanyJournal godoc
+// @Summary Create intercompany journal
+// @Description Writes mirrored journals in this workplace and a counterparty workplace within one database transaction, when this entity pays on behalf of the counterparty. The lines of this workplace must net to a credit and are balanced on its due-from account and the lines of the counterparty on its due-to account, so both sides must net to opposite amounts. The journals are linked as twins. The caller must be a member of both workplaces.
+// @Tags journals
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   journal body dto.CreateIntercompanyJournalRequest true "Intercompany journal details"
+// @Success 201 {object} dto.IntercompanyJournalResponse
+// @Failure 400 {object} map[string]string "Invalid input or sides that do not mirror each other"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden in either workplace"
+// @Failure 500 {object} map[string]string "Failed to create intercompany journal"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/intercompany-journals [post]
+func (h *intercompanyJournalHandler) createIntercompanyJournal(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for createIntercompanyJournal")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	var req dto.CreateIntercompanyJournalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateIntercompanyJournal", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("counterparty_workplace_id", req.Counterparty.WorkplaceID))
+	logger.Info("Received request to create intercompany journal", slog.Time("date", req.Date))
+
+	journal, twin, err := h.journalService.CreateIntercompanyJournal(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to create intercompany journal")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		} else if errors.Is(err, apperrors.ErrValidation) || errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Validation/NotFound error creating intercompany journal", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else {
+			logger.Error("Failed to create intercompany journal in service", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create intercompany journal"})
+		}
+		return
+	}
+
+	logger.Info("Intercompany journal created successfully", slog.String("journal_id", journal.JournalID), slog.String("twin_journal_id", twin.JournalID))
+	c.JSON(http.StatusCreated, dto.ToIntercompanyJournalResponse(journal, twin))
+}
+
+// reverseIntercompanyJournal godoc
+// @Summary Reverse intercompany journal with its twin
+// @Description Reverses a journal of an intercompany posting and its twin in the counterparty workplace within one database transaction. The reversing journals are linked as twins. The caller must be a member of both workplaces. Use the journal reverse endpoint to reverse one side only.
+// @Tags journals
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   id path string true "Journal ID to reverse"
+// @Success 200 {object} dto.IntercompanyJournalResponse "The reversing journal and the reversing journal of the twin"
+// @Failure 400 {object} map[string]string "Journal is not part of an intercompany posting"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden in either workplace"
+// @Failure 404 {object} map[string]string "Journal not found in this workplace"
+// @Failure 409 {object} map[string]string "Journal or twin already reversed"
+// @Failure 500 {object} map[string]string "Failed to reverse intercompany journal"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/intercompany-journals/{id}/reverse [post]
+func (h *intercompanyJournalHandler) reverseIntercompanyJournal(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	journalID := c.Param("id")
+	if workplaceID == "" || journalID == "" {
+		logger.Error("Workplace ID or Journal ID missing from path for reverseIntercompanyJournal")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Journal ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	logger = logger.With(slog.String("target_journal_id", journalID), slog.String("workplace_id", workplaceID), slog.String("reverser_user_id", userID))
+	logger.Info("Received request to reverse intercompany journal")
+
+	reversal, twinReversal, err := h.journalService.ReverseIntercompanyJournal(c.Request.Context(), workplaceID, journalID, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Journal not found for intercompany reversal (or in wrong workplace)")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Journal not found"})
+		} else if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to reverse intercompany journal")
+			c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		} else if errors.Is(err, apperrors.ErrValidation) {
+			logger.Warn("Validation error reversing intercompany journal", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, apperrors.ErrConflict) {
+			logger.Warn("Conflict reversing intercompany journal", slog.String("error", err.Error()))
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		} else {
+			logger.Error("Failed to reverse intercompany journal in service", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reverse intercompany journal"})
+		}
+		return
+	}
+
+	logger.Info("Intercompany journal reversed successfully", slog.String("reversing_journal_id", reversal.JournalID), slog.String("twin_reversing_journal_id", twinReversal.JournalID))
+	c.JSON(http.StatusOK, dto.ToIntercompanyJournalResponse(reversal, twinReversal))
+}
@@ -0,0 +1,551 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// investmentHandler handles HTTP requests for securities, trades and holdings.
+type investmentHandler struct {
+	investmentService portssvc.InvestmentSvcFacade
+}
+
+// newInvestmentHandler creates a new investmentHandler.
+func newInvestmentHandler(is portssvc.InvestmentSvcFacade) *investmentHandler {
+	return &investmentHandler{
+		investmentService: is,
+	}
+}
+
+// registerInvestmentRoutes registers routes for securities and investments WITHIN a workplace.
+func registerInvestmentRoutes(rg *gin.RouterGroup, investmentService portssvc.InvestmentSvcFacade) {
+	h := newInvestmentHandler(investmentService)
+
+	securities := rg.Group("/securities")
+	{
+		securities.POST("", h.createSecurity)
+		securities.GET("", h.listSecurities)
+		securities.GET("/:security_id", h.getSecurity)
+		securities.PUT("/:security_id", h.updateSecurity)
+		securities.DELETE("/:security_id", h.deleteSecurity)
+		securities.POST("/:security_id/prices", h.recordSecurityPrice)
+		securities.GET("/:security_id/prices", h.listSecurityPrices)
+	}
+
+	investments := rg.Group("/investments")
+	{
+		investments.POST("/buy", h.buySecurity)
+		investments.POST("/sell", h.sellSecurity)
+		investments.GET("/lots", h.listLots)
+		investments.GET("/holdings", h.getHoldings)
+		investments.GET("/unrealized-gains", h.getUnrealizedGains)
+	}
+}
+
+// investmentPathParams reads the workplace and security IDs and the calling user, writing an error response when missing
+func investmentPathParams(c *gin.Context, logger *slog.Logger, needSecurity bool) (workplaceID, securityID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	securityID = c.Param("security_id")
+	if workplaceID == "" || (needSecurity && securityID == "") {
+		logger.Error("Workplace ID or Security ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Security ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, securityID, userID, true
+}
+
+// writeInvestmentError maps an investment service error to an HTTP response
+func writeInvestmentError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Security not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Security not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createSecurity godoc
+// @Summary Create a security
+// @Description Adds a stock, fund or other instrument to the security master. Symbols are upper-cased and unique within the workplace; the cost method (FIFO, LIFO or AVERAGE) defaults to FIFO.
+// @Tags investments
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   security body dto.SecurityRequest true "Security details"
+// @Success 201 {object} dto.SecurityResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Symbol already used"
+// @Failure 500 {object} map[string]string "Failed to create security"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities [post]
+func (h *investmentHandler) createSecurity(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.SecurityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateSecurity", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create security", slog.String("symbol", req.Symbol))
+
+	security, err := h.investmentService.CreateSecurity(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "create security")
+		return
+	}
+
+	logger.Info("Security created successfully", slog.String("security_id", security.SecurityID))
+	c.JSON(http.StatusCreated, dto.ToSecurityResponse(security))
+}
+
+// listSecurities godoc
+// @Summary List securities
+// @Description Lists the securities of a workplace, ordered by symbol
+// @Tags investments
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListSecuritiesResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list securities"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities [get]
+func (h *investmentHandler) listSecurities(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	securities, err := h.investmentService.ListSecurities(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "list securities")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListSecuritiesResponse(securities))
+}
+
+// getSecurity godoc
+// @Summary Get security
+// @Description Retrieves a security
+// @Tags investments
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   security_id path string true "Security ID"
+// @Success 200 {object} dto.SecurityResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Security not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve security"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities/{security_id} [get]
+func (h *investmentHandler) getSecurity(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, securityID, userID, ok := investmentPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("security_id", securityID))
+
+	security, err := h.investmentService.GetSecurity(c.Request.Context(), workplaceID, securityID, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "retrieve security")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSecurityResponse(security))
+}
+
+// updateSecurity godoc
+// @Summary Update a security
+// @Description Replaces the details of a security. The currency cannot change; changing the cost method re-prices the open lots.
+// @Tags investments
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   security_id path string true "Security ID"
+// @Param   security body dto.SecurityRequest true "Security details"
+// @Success 200 {object} dto.SecurityResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Security not found"
+// @Failure 409 {object} map[string]string "Symbol already used"
+// @Failure 500 {object} map[string]string "Failed to update security"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities/{security_id} [put]
+func (h *investmentHandler) updateSecurity(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, securityID, userID, ok := investmentPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.SecurityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateSecurity", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("security_id", securityID))
+	logger.Info("Received request to update security")
+
+	security, err := h.investmentService.UpdateSecurity(c.Request.Context(), workplaceID, securityID, req, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "update security")
+		return
+	}
+
+	logger.Info("Security updated successfully")
+	c.JSON(http.StatusOK, dto.ToSecurityResponse(security))
+}
+
+// deleteSecurity godoc
+// @Summary Delete a security
+// @Description Removes a security and its prices. Securities that have been traded cannot be deleted; deactivate them instead.
+// @Tags investments
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   security_id path string true "Security ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Security not found"
+// @Failure 409 {object} map[string]string "Security has been traded"
+// @Failure 500 {object} map[string]string "Failed to delete security"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities/{security_id} [delete]
+func (h *investmentHandler) deleteSecurity(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, securityID, userID, ok := investmentPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("security_id", securityID))
+	logger.Info("Received request to delete security")
+
+	if err := h.investmentService.DeleteSecurity(c.Request.Context(), workplaceID, securityID, userID); err != nil {
+		writeInvestmentError(c, logger, err, "delete security")
+		return
+	}
+
+	logger.Info("Security deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+// recordSecurityPrice godoc
+// @Summary Record a security price
+// @Description Records the price of one unit of a security on a date, in the currency of the security, replacing any price already recorded for that date. The price is stored in the shared commodity price table under the symbol of the security.
+// @Tags investments
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   security_id path string true "Security ID"
+// @Param   price body dto.SecurityPriceRequest true "Date and price"
+// @Success 201 {object} dto.PriceResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Security not found"
+// @Failure 500 {object} map[string]string "Failed to record security price"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities/{security_id}/prices [post]
+func (h *investmentHandler) recordSecurityPrice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, securityID, userID, ok := investmentPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.SecurityPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for RecordSecurityPrice", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("security_id", securityID))
+
+	price, err := h.investmentService.RecordSecurityPrice(c.Request.Context(), workplaceID, securityID, req, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "record security price")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToPriceResponse(price))
+}
+
+// listSecurityPrices godoc
+// @Summary List security prices
+// @Description Lists the commodity prices of the symbol of a security in its currency, newest first
+// @Tags investments
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   security_id path string true "Security ID"
+// @Success 200 {object} dto.ListPricesResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Security not found"
+// @Failure 500 {object} map[string]string "Failed to list security prices"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/securities/{security_id}/prices [get]
+func (h *investmentHandler) listSecurityPrices(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, securityID, userID, ok := investmentPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("security_id", securityID))
+
+	prices, err := h.investmentService.ListSecurityPrices(c.Request.Context(), workplaceID, securityID, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "list security prices")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListPricesResponse(prices))
+}
+
+// buySecurity godoc
+// @Summary Buy a security
+// @Description Posts a journal debiting the investment ASSET account with the cost (quantity x unit price + fees) and crediting the cash account. The investment line records the security, quantity and unit price and opens a lot.
+// @Tags investments
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   trade body dto.BuySecurityRequest true "Security, accounts, quantity and price"
+// @Success 201 {object} dto.TradeResponse
+// @Failure 400 {object} map[string]string "Invalid input or accounts"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to buy security"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/investments/buy [post]
+func (h *investmentHandler) buySecurity(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.BuySecurityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for BuySecurity", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to buy security", slog.String("security_id", req.SecurityID))
+
+	trade, err := h.investmentService.BuySecurity(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "buy security")
+		return
+	}
+
+	logger.Info("Security bought successfully", slog.String("journal_id", trade.Journal.JournalID))
+	c.JSON(http.StatusCreated, dto.ToTradeResponse(trade))
+}
+
+// sellSecurity godoc
+// @Summary Sell a security
+// @Description Posts a journal debiting the cash account with the net proceeds (quantity x unit price - fees), crediting the investment account with the cost basis of the lots sold under the security's cost method, and posting the difference as a realized gain (credit) or loss (debit) to the gain/loss account
+// @Tags investments
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   trade body dto.SellSecurityRequest true "Security, accounts, quantity and price"
+// @Success 201 {object} dto.TradeResponse
+// @Failure 400 {object} map[string]string "Invalid input or accounts, or more units than held"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to sell security"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/investments/sell [post]
+func (h *investmentHandler) sellSecurity(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.SellSecurityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for SellSecurity", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to sell security", slog.String("security_id", req.SecurityID))
+
+	trade, err := h.investmentService.SellSecurity(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "sell security")
+		return
+	}
+
+	logger.Info("Security sold successfully", slog.String("journal_id", trade.Journal.JournalID))
+	c.JSON(http.StatusCreated, dto.ToTradeResponse(trade))
+}
+
+// listLots godoc
+// @Summary List open lots
+// @Description Lists the open lots as of a date, oldest first, rebuilt from the posted buy and sell lines
+// @Tags investments
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID query string false "Investment account ID"
+// @Param   securityID query string false "Security ID"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to now"
+// @Success 200 {object} dto.ListLotsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list lots"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/investments/lots [get]
+func (h *investmentHandler) listLots(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListLotsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for ListLots", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	lots, err := h.investmentService.ListLots(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "list lots")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListLotsResponse(lots))
+}
+
+// getHoldings godoc
+// @Summary Get investment holdings
+// @Description Reports, per investment account and security, the quantity held and its cost basis as of a date, and its market value and unrealized gain at the latest price on or before that date
+// @Tags investments
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID query string false "Investment account ID"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to now"
+// @Success 200 {object} dto.HoldingsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to retrieve holdings"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/investments/holdings [get]
+func (h *investmentHandler) getHoldings(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.HoldingsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for GetHoldings", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	holdings, err := h.investmentService.GetHoldings(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "retrieve holdings")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToHoldingsResponse(holdings))
+}
+
+// getUnrealizedGains godoc
+// @Summary Get unrealized gains
+// @Description Reports the unrealized gain of each holding as of a date, valued at the latest commodity price on or before that date, with totals per currency. Holdings without a price are left out of the gain and counted in unpricedCostBasis.
+// @Tags investments
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID query string false "Investment account ID"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to now"
+// @Success 200 {object} dto.UnrealizedGainReportResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to retrieve unrealized gains"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/investments/unrealized-gains [get]
+func (h *investmentHandler) getUnrealizedGains(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := investmentPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.HoldingsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for GetUnrealizedGains", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	report, err := h.investmentService.GetUnrealizedGains(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeInvestmentError(c, logger, err, "retrieve unrealized gains")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToUnrealizedGainReportResponse(report))
+}
@@ -0,0 +1,511 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// invoiceHandler handles HTTP requests for sales invoices, their payments and receivables.
+type invoiceHandler struct {
+	invoiceService portssvc.InvoiceSvcFacade
+}
+
+// newInvoiceHandler creates a new invoiceHandler.
+func newInvoiceHandler(is portssvc.InvoiceSvcFacade) *invoiceHandler {
+	return &invoiceHandler{
+		invoiceService: is,
+	}
+}
+
+// registerInvoiceRoutes registers routes for invoices WITHIN a workplace.
+func registerInvoiceRoutes(rg *gin.RouterGroup, invoiceService portssvc.InvoiceSvcFacade) {
+	h := newInvoiceHandler(invoiceService)
+
+	invoices := rg.Group("/invoices")
+	{
+		invoices.POST("", h.createInvoice)
+		invoices.GET("", h.listInvoices)
+		invoices.GET("/numbering", h.getInvoiceSequence)
+		invoices.PUT("/numbering", h.updateInvoiceSequence)
+		invoices.GET("/aged-receivables", h.getAgedReceivables)
+		invoices.GET("/:invoice_id", h.getInvoice)
+		invoices.PUT("/:invoice_id", h.updateInvoice)
+		invoices.DELETE("/:invoice_id", h.deleteInvoice)
+		invoices.POST("/:invoice_id/issue", h.issueInvoice)
+		invoices.POST("/:invoice_id/payments", h.recordInvoicePayment)
+		invoices.POST("/:invoice_id/void", h.voidInvoice)
+	}
+}
+
+// invoiceStatusDate is the date invoice statuses are derived on in responses: today, at midnight UTC
+func invoiceStatusDate() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// invoicePathParams reads the workplace and invoice IDs and the calling user, writing an error response when missing
+func invoicePathParams(c *gin.Context, logger *slog.Logger, needInvoice bool) (workplaceID, invoiceID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	invoiceID = c.Param("invoice_id")
+	if workplaceID == "" || (needInvoice && invoiceID == "") {
+		logger.Error("Workplace ID or Invoice ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Invoice ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, invoiceID, userID, true
+}
+
+// writeInvoiceError maps an invoice service error to an HTTP response
+func writeInvoiceError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Invoice not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Invoice not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createInvoice godoc
+// @Summary Create a draft invoice
+// @Description Creates a draft sales invoice billed to a customer (a payee of the workplace). The receivable account must be an ASSET account and sets the invoice currency; each line is credited to a REVENUE account in that currency, optionally with a tax code. Line amounts are quantity times unit price; drafts post nothing until issued.
+// @Tags invoices
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice body dto.InvoiceRequest true "Invoice content"
+// @Success 201 {object} dto.InvoiceResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to create invoice"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices [post]
+func (h *invoiceHandler) createInvoice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := invoicePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.InvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateInvoice", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create invoice", slog.String("customer_id", req.CustomerID))
+
+	invoice, err := h.invoiceService.CreateInvoice(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "create invoice")
+		return
+	}
+
+	logger.Info("Invoice created successfully", slog.String("invoice_id", invoice.InvoiceID))
+	c.JSON(http.StatusCreated, dto.ToInvoiceResponse(invoice, invoiceStatusDate()))
+}
+
+// listInvoices godoc
+// @Summary List invoices
+// @Description Lists the invoices of a workplace, newest first, without their lines. Status, amount paid and outstanding are derived from the posted journals as of today.
+// @Tags invoices
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   customerID query string false "Only invoices of this customer"
+// @Param   status query string false "Only invoices with this status" Enums(DRAFT, ISSUED, PARTIALLY_PAID, PAID, OVERDUE, VOID)
+// @Success 200 {object} dto.ListInvoicesResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list invoices"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices [get]
+func (h *invoiceHandler) listInvoices(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := invoicePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListInvoicesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for ListInvoices", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	invoices, err := h.invoiceService.ListInvoices(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "list invoices")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListInvoicesResponse(invoices, invoiceStatusDate()))
+}
+
+// getInvoice godoc
+// @Summary Get invoice
+// @Description Retrieves an invoice with its lines and the payments whose journals are still posted
+// @Tags invoices
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice_id path string true "Invoice ID"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Invoice not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve invoice"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/{invoice_id} [get]
+func (h *invoiceHandler) getInvoice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, invoiceID, userID, ok := invoicePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("invoice_id", invoiceID))
+
+	invoice, err := h.invoiceService.GetInvoice(c.Request.Context(), workplaceID, invoiceID, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "retrieve invoice")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToInvoiceResponse(invoice, invoiceStatusDate()))
+}
+
+// updateInvoice godoc
+// @Summary Update a draft invoice
+// @Description Replaces the customer, accounts, dates, notes and lines of a draft invoice. Issued invoices cannot change; void and re-create them instead.
+// @Tags invoices
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice_id path string true "Invoice ID"
+// @Param   invoice body dto.InvoiceRequest true "Invoice content"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Invoice not found"
+// @Failure 409 {object} map[string]string "Invoice already issued"
+// @Failure 500 {object} map[string]string "Failed to update invoice"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/{invoice_id} [put]
+func (h *invoiceHandler) updateInvoice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, invoiceID, userID, ok := invoicePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.InvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateInvoice", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("invoice_id", invoiceID))
+	logger.Info("Received request to update invoice")
+
+	invoice, err := h.invoiceService.UpdateInvoice(c.Request.Context(), workplaceID, invoiceID, req, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "update invoice")
+		return
+	}
+
+	logger.Info("Invoice updated successfully")
+	c.JSON(http.StatusOK, dto.ToInvoiceResponse(invoice, invoiceStatusDate()))
+}
+
+// deleteInvoice godoc
+// @Summary Delete a draft invoice
+// @Description Deletes a draft invoice. Issued invoices are voided instead.
+// @Tags invoices
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice_id path string true "Invoice ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Invoice not found"
+// @Failure 409 {object} map[string]string "Invoice already issued"
+// @Failure 500 {object} map[string]string "Failed to delete invoice"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/{invoice_id} [delete]
+func (h *invoiceHandler) deleteInvoice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, invoiceID, userID, ok := invoicePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("invoice_id", invoiceID))
+	logger.Info("Received request to delete invoice")
+
+	if err := h.invoiceService.DeleteInvoice(c.Request.Context(), workplaceID, invoiceID, userID); err != nil {
+		writeInvoiceError(c, logger, err, "delete invoice")
+		return
+	}
+
+	logger.Info("Invoice deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+// issueInvoice godoc
+// @Summary Issue an invoice
+// @Description Gives a draft invoice the next number of the workplace's invoice numbering and posts a journal on the issue date debiting the receivable account with the total and crediting the revenue accounts of its lines. Lines with a tax code credit the tax to the account of the code.
+// @Tags invoices
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice_id path string true "Invoice ID"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 400 {object} map[string]string "Invoice cannot be posted"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Invoice not found"
+// @Failure 409 {object} map[string]string "Invoice already issued or number in use"
+// @Failure 500 {object} map[string]string "Failed to issue invoice"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/{invoice_id}/issue [post]
+func (h *invoiceHandler) issueInvoice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, invoiceID, userID, ok := invoicePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("invoice_id", invoiceID))
+	logger.Info("Received request to issue invoice")
+
+	invoice, err := h.invoiceService.IssueInvoice(c.Request.Context(), workplaceID, invoiceID, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "issue invoice")
+		return
+	}
+
+	logger.Info("Invoice issued successfully", slog.String("invoice_number", invoice.InvoiceNumber))
+	c.JSON(http.StatusOK, dto.ToInvoiceResponse(invoice, invoiceStatusDate()))
+}
+
+// recordInvoicePayment godoc
+// @Summary Record an invoice payment
+// @Description Posts a payment received against an issued invoice as a journal debiting the payment account and crediting the receivable account. Partial payments are allowed; the amount cannot exceed what is still unpaid. Reversing the payment journal makes the amount outstanding again.
+// @Tags invoices
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice_id path string true "Invoice ID"
+// @Param   payment body dto.RecordInvoicePaymentRequest true "Payment details"
+// @Success 201 {object} dto.InvoicePaymentResponse
+// @Failure 400 {object} map[string]string "Invalid input, invoice not issued or amount exceeds the outstanding"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Invoice not found"
+// @Failure 500 {object} map[string]string "Failed to record payment"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/{invoice_id}/payments [post]
+func (h *invoiceHandler) recordInvoicePayment(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, invoiceID, userID, ok := invoicePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.RecordInvoicePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for RecordInvoicePayment", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("invoice_id", invoiceID))
+	logger.Info("Received request to record invoice payment", slog.String("amount", req.Amount.String()))
+
+	payment, err := h.invoiceService.RecordPayment(c.Request.Context(), workplaceID, invoiceID, req, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "record payment")
+		return
+	}
+
+	logger.Info("Invoice payment recorded", slog.String("journal_id", payment.JournalID))
+	c.JSON(http.StatusCreated, dto.ToInvoicePaymentResponse(payment))
+}
+
+// voidInvoice godoc
+// @Summary Void an invoice
+// @Description Reverses the issue journal of an invoice. Invoices with payments cannot be voided until the payment journals are reversed. The invoice number stays used.
+// @Tags invoices
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   invoice_id path string true "Invoice ID"
+// @Success 200 {object} dto.InvoiceResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Invoice not found"
+// @Failure 409 {object} map[string]string "Invoice is a draft, already void or has payments"
+// @Failure 500 {object} map[string]string "Failed to void invoice"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/{invoice_id}/void [post]
+func (h *invoiceHandler) voidInvoice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, invoiceID, userID, ok := invoicePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("invoice_id", invoiceID))
+	logger.Info("Received request to void invoice")
+
+	invoice, err := h.invoiceService.VoidInvoice(c.Request.Context(), workplaceID, invoiceID, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "void invoice")
+		return
+	}
+
+	logger.Info("Invoice voided successfully")
+	c.JSON(http.StatusOK, dto.ToInvoiceResponse(invoice, invoiceStatusDate()))
+}
+
+// getInvoiceSequence godoc
+// @Summary Get invoice numbering
+// @Description Retrieves the prefix, next number and zero-padding used to number issued invoices. Workplaces that never configured it number invoices INV-00001 onwards.
+// @Tags invoices
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.InvoiceSequenceResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to retrieve invoice numbering"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/numbering [get]
+func (h *invoiceHandler) getInvoiceSequence(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := invoicePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	sequence, err := h.invoiceService.GetInvoiceSequence(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "retrieve invoice numbering")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToInvoiceSequenceResponse(sequence))
+}
+
+// updateInvoiceSequence godoc
+// @Summary Update invoice numbering
+// @Description Sets the prefix, next number and zero-padding used to number issued invoices. Issuing fails with a conflict when the resulting number is already in use.
+// @Tags invoices
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   numbering body dto.InvoiceSequenceRequest true "Invoice numbering"
+// @Success 200 {object} dto.InvoiceSequenceResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to update invoice numbering"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/numbering [put]
+func (h *invoiceHandler) updateInvoiceSequence(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := invoicePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.InvoiceSequenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateInvoiceSequence", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to update invoice numbering")
+
+	sequence, err := h.invoiceService.UpdateInvoiceSequence(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "update invoice numbering")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToInvoiceSequenceResponse(sequence))
+}
+
+// getAgedReceivables godoc
+// @Summary Aged receivables
+// @Description Buckets the amounts outstanding on issued invoices by how long they are past due on the given date (not yet due, 0-30, 31-60, 61-90 and over 90 days), per customer and currency. Only payments dated on or before the date count.
+// @Tags invoices
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} dto.AgingReportResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to generate aged receivables"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/invoices/aged-receivables [get]
+func (h *invoiceHandler) getAgedReceivables(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := invoicePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.AgingParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for AgedReceivables", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+	asOf := invoiceStatusDate()
+	if params.AsOf != nil {
+		asOf = *params.AsOf
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	report, err := h.invoiceService.AgedReceivables(c.Request.Context(), workplaceID, asOf, userID)
+	if err != nil {
+		writeInvoiceError(c, logger, err, "generate aged receivables")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToAgingReportResponse(report))
+}
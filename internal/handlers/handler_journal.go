@@ -11,6 +11,7 @@ import (
 	// "github.com/SscSPs/money_managemet_app/internal/core/services" // Remove concrete services
 	"github.com/SscSPs/money_managemet_app/internal/dto"
 	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/SscSPs/money_managemet_app/internal/utils/export"
 	"github.com/gin-gonic/gin"
 	// For balance calculation
 )
@@ -26,18 +27,20 @@ func safeStringDeref(s *string) string {
 // journalHandler handles HTTP requests related to journals.
 type journalHandler struct {
 	journalService portssvc.JournalSvcFacade // Updated to use JournalSvcFacade
+	exporter       *reportExporter
 }
 
 // newJournalHandler creates a new journalHandler.
-func newJournalHandler(js portssvc.JournalSvcFacade) *journalHandler { // Updated interface
+func newJournalHandler(js portssvc.JournalSvcFacade, exporter *reportExporter) *journalHandler { // Updated interface
 	return &journalHandler{
 		journalService: js,
+		exporter:       exporter,
 	}
 }
 
 // registerJournalRoutes registers all routes related to journals.
-func registerJournalRoutes(rg *gin.RouterGroup, journalService portssvc.JournalSvcFacade) { // Updated interface
-	h := newJournalHandler(journalService)
+func registerJournalRoutes(rg *gin.RouterGroup, journalService portssvc.JournalSvcFacade, exporter *reportExporter) { // Updated interface
+	h := newJournalHandler(journalService, exporter)
 
 	journals := rg.Group("/journals")
 	{
@@ -45,6 +48,7 @@ func registerJournalRoutes(rg *gin.RouterGroup, journalService portssvc.JournalS
 		journals.GET("/:id", h.getJournal)
 		journals.GET("", h.listJournals)
 		journals.PUT("/:id", h.updateJournal)
+		journals.PUT("/:id/dimensions", h.updateJournalDimensions)
 		
 		journals.POST("/:id/reverse", h.reverseJournal)
 	}
@@ -52,7 +56,7 @@ func registerJournalRoutes(rg *gin.RouterGroup, journalService portssvc.JournalS
 
 // createJournal godoc
 // @Summary Create a new journal in workplace
-// @Description Creates a new journal entry within the specified workplace.
+// @Description Creates a new journal entry within the specified workplace. If the journal resembles an earlier one (same accounts and amounts, close dates, similar description), the response carries non-blocking duplicateWarnings and the pair is queued for review under duplicate-journals. When no payeeID is given, the payee is resolved from the description using the workplace's payee names and aliases. A line with an exclusive tax code gets the tax, rounded to the currency precision, added on top, so the other lines must include it or the journal is rejected as unbalanced.
 // @Tags journals
 // @Accept  json
 // @Produce  json
@@ -175,6 +179,7 @@ func (h *journalHandler) getJournal(c *gin.Context) {
 // @Param   offset query int false "Offset for pagination" default(0)
 // @Param   includeReversals query boolean false "Whether to include reversed and reversing journals" default(false)
 // @Param   includeTxn query boolean false "Whether to include transactions in the response" default(false)
+// @Param   format query string false "Response format; csv, xlsx and pdf export every journal instead of one page" Enums(json, csv, xlsx, pdf) default(json)
 // @Success 200 {object} dto.ListJournalsResponse
 // @Failure 400 {object} map[string]string "Missing Workplace ID"
 // @Failure 401 {object} map[string]string "Unauthorized"
@@ -206,6 +211,16 @@ func (h *journalHandler) listJournals(c *gin.Context) {
 		return
 	}
 
+	format, ok := requestedExportFormat(c, logger)
+	if !ok {
+		return
+	}
+	if format != export.FormatJSON {
+		// Exports cover every journal, so always start from the first page
+		params.Limit = exportPageSize
+		params.NextToken = nil
+	}
+
 	logger = logger.With(slog.String("user_id", loggedInUserID), slog.String("workplace_id", workplaceID))
 	logger.Info("Received request to list journals", slog.Int("limit", params.Limit), slog.String("nextToken", safeStringDeref(params.NextToken)))
 
@@ -224,13 +239,21 @@ func (h *journalHandler) listJournals(c *gin.Context) {
 		return
 	}
 
+	if format != export.FormatJSON {
+		h.exporter.exportJournals(c, logger, format, workplaceID, resp, func(nextToken *string) (*dto.ListJournalsResponse, error) {
+			params.NextToken = nextToken
+			return h.journalService.ListJournals(c.Request.Context(), workplaceID, loggedInUserID, params)
+		})
+		return
+	}
+
 	logger.Info("Journals listed successfully", slog.Int("count", len(resp.Journals)))
 	c.JSON(http.StatusOK, resp)
 }
 
 // updateJournal godoc
 // @Summary Update a journal entry in workplace
-// @Description Updates details (like description, date) for a specific journal entry within a workplace.
+// @Description Updates details (like description, date, payee) for a specific journal entry within a workplace.
 // @Tags journals
 // @Accept  json
 // @Produce  json
@@ -296,9 +319,79 @@ func (h *journalHandler) updateJournal(c *gin.Context) {
 
 
 
+// updateJournalDimensions godoc
+// @Summary Retag a posted journal with dimension values
+// @Description Replaces the dimension values (projects, cost centers, tags) of a posted journal and of the listed lines without touching amounts, accounts or dates. The journal's values are only replaced when dimensionValueIDs is present; lines not listed keep their values. An empty list clears the values.
+// @Tags journals
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   id path string true "Journal ID"
+// @Param   dimensions body dto.UpdateJournalDimensionsRequest true "Dimension values to assign"
+// @Success 200 {object} dto.JournalResponse
+// @Failure 400 {object} map[string]string "Invalid input, unknown or inactive value, or several values of one dimension"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User cannot update)"
+// @Failure 404 {object} map[string]string "Journal not found in this workplace"
+// @Failure 409 {object} map[string]string "Journal is not posted"
+// @Failure 500 {object} map[string]string "Failed to update journal dimensions"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/journals/{id}/dimensions [put]
+func (h *journalHandler) updateJournalDimensions(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	journalID := c.Param("id")
+	if workplaceID == "" || journalID == "" {
+		logger.Error("Workplace ID or Journal ID missing from path for updateJournalDimensions")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Journal ID required in path"})
+		return
+	}
+
+	var req dto.UpdateJournalDimensionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateJournalDimensions", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	loggedInUserID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("Logged-in user ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	logger = logger.With(slog.String("target_journal_id", journalID), slog.String("workplace_id", workplaceID), slog.String("updater_user_id", loggedInUserID))
+	logger.Info("Received request to update journal dimensions")
+
+	updatedJournal, err := h.journalService.UpdateJournalDimensions(c.Request.Context(), workplaceID, journalID, req, loggedInUserID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Journal not found for dimension update (or in wrong workplace)")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Journal not found"})
+		} else if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to update journal dimensions", slog.String("user_id", loggedInUserID), slog.String("journal_id", journalID))
+			c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		} else if errors.Is(err, apperrors.ErrValidation) {
+			logger.Warn("Validation error updating journal dimensions", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, apperrors.ErrConflict) {
+			logger.Warn("Conflict updating journal dimensions", slog.String("error", err.Error()))
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		} else {
+			logger.Error("Failed to update journal dimensions in service", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update journal dimensions"})
+		}
+		return
+	}
+
+	logger.Info("Journal dimensions updated successfully")
+	c.JSON(http.StatusOK, dto.ToJournalResponse(updatedJournal))
+}
+
 // reverseJournal godoc
 // @Summary Reverse a journal entry in workplace
-// @Description Reverses a specific journal entry by creating a new journal with opposite transaction types.
+// @Description Reverses a specific journal entry by creating a new journal with opposite transaction types. When the journal is one side of an intercompany posting whose twin is still posted, the response carries twinToReverse so the twin can be reversed too; reverse both at once through the intercompany journal reverse endpoint.
 // @Tags journals
 // @Produce  json
 // @Param   workplace_id path string true "Workplace ID"
@@ -0,0 +1,382 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// loanHandler handles HTTP requests for loans, their schedules and payments.
+type loanHandler struct {
+	loanService portssvc.LoanSvcFacade
+}
+
+// newLoanHandler creates a new loanHandler.
+func newLoanHandler(ls portssvc.LoanSvcFacade) *loanHandler {
+	return &loanHandler{
+		loanService: ls,
+	}
+}
+
+// registerLoanRoutes registers routes for loans WITHIN a workplace.
+func registerLoanRoutes(rg *gin.RouterGroup, loanService portssvc.LoanSvcFacade) {
+	h := newLoanHandler(loanService)
+
+	loans := rg.Group("/loans")
+	{
+		loans.POST("", h.createLoan)
+		loans.GET("", h.listLoans)
+		loans.GET("/:loan_id", h.getLoan)
+		loans.PUT("/:loan_id", h.updateLoan)
+		loans.DELETE("/:loan_id", h.deleteLoan)
+		loans.GET("/:loan_id/schedule", h.getLoanSchedule)
+		loans.POST("/:loan_id/installments", h.postInstallment)
+		loans.POST("/:loan_id/prepayments", h.postPrepayment)
+	}
+}
+
+// loanPathParams reads the workplace and loan IDs and the calling user, writing an error response when missing
+func loanPathParams(c *gin.Context, logger *slog.Logger, needLoan bool) (workplaceID, loanID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	loanID = c.Param("loan_id")
+	if workplaceID == "" || (needLoan && loanID == "") {
+		logger.Error("Workplace ID or Loan ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Loan ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, loanID, userID, true
+}
+
+// writeLoanError maps a loan service error to an HTTP response
+func writeLoanError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Loan not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Loan not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createLoan godoc
+// @Summary Create a loan
+// @Description Defines an amortizing loan on a LIABILITY account: principal, nominal annual rate, number of installments, frequency and start date. Interest is charged to the given EXPENSE account. When a disbursement account is given, a journal crediting the loan account with the principal is posted on the start date.
+// @Tags loans
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan body dto.CreateLoanRequest true "Loan terms"
+// @Success 201 {object} dto.LoanResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Account already backs a loan"
+// @Failure 500 {object} map[string]string "Failed to create loan"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans [post]
+func (h *loanHandler) createLoan(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := loanPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateLoanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateLoan", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create loan", slog.String("account_id", req.AccountID))
+
+	loan, err := h.loanService.CreateLoan(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "create loan")
+		return
+	}
+
+	logger.Info("Loan created successfully", slog.String("loan_id", loan.LoanID))
+	c.JSON(http.StatusCreated, dto.ToLoanResponse(loan))
+}
+
+// listLoans godoc
+// @Summary List loans
+// @Description Lists the loans of a workplace, ordered by name
+// @Tags loans
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListLoansResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list loans"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans [get]
+func (h *loanHandler) listLoans(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := loanPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	loans, err := h.loanService.ListLoans(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "list loans")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListLoansResponse(loans))
+}
+
+// getLoan godoc
+// @Summary Get loan
+// @Description Retrieves the terms of a loan
+// @Tags loans
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan_id path string true "Loan ID"
+// @Success 200 {object} dto.LoanResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Loan not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve loan"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans/{loan_id} [get]
+func (h *loanHandler) getLoan(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, loanID, userID, ok := loanPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("loan_id", loanID))
+
+	loan, err := h.loanService.GetLoan(c.Request.Context(), workplaceID, loanID, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "retrieve loan")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToLoanResponse(loan))
+}
+
+// updateLoan godoc
+// @Summary Update a loan
+// @Description Replaces the name and interest account of a loan. The terms of a loan cannot change; pay it down with prepayments instead.
+// @Tags loans
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan_id path string true "Loan ID"
+// @Param   loan body dto.UpdateLoanRequest true "Loan details"
+// @Success 200 {object} dto.LoanResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Loan not found"
+// @Failure 500 {object} map[string]string "Failed to update loan"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans/{loan_id} [put]
+func (h *loanHandler) updateLoan(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, loanID, userID, ok := loanPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateLoanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateLoan", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("loan_id", loanID))
+	logger.Info("Received request to update loan")
+
+	loan, err := h.loanService.UpdateLoan(c.Request.Context(), workplaceID, loanID, req, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "update loan")
+		return
+	}
+
+	logger.Info("Loan updated successfully")
+	c.JSON(http.StatusOK, dto.ToLoanResponse(loan))
+}
+
+// deleteLoan godoc
+// @Summary Delete a loan
+// @Description Stops tracking a loan. Journals already posted for the loan are kept.
+// @Tags loans
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan_id path string true "Loan ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Loan not found"
+// @Failure 500 {object} map[string]string "Failed to delete loan"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans/{loan_id} [delete]
+func (h *loanHandler) deleteLoan(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, loanID, userID, ok := loanPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("loan_id", loanID))
+	logger.Info("Received request to delete loan")
+
+	if err := h.loanService.DeleteLoan(c.Request.Context(), workplaceID, loanID, userID); err != nil {
+		writeLoanError(c, logger, err, "delete loan")
+		return
+	}
+
+	logger.Info("Loan deleted successfully")
+	c.Status(http.StatusNoContent)
+}
+
+// getLoanSchedule godoc
+// @Summary Get loan schedule
+// @Description Builds the amortization schedule of a loan. Paid installments show the amounts posted; the others are projected from the remaining principal, taking prepayments into account. The remaining principal is compared with the balance of the loan account; a non-zero difference points at postings to the account outside the loan.
+// @Tags loans
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan_id path string true "Loan ID"
+// @Success 200 {object} dto.LoanScheduleResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Loan not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve loan schedule"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans/{loan_id}/schedule [get]
+func (h *loanHandler) getLoanSchedule(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, loanID, userID, ok := loanPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("loan_id", loanID))
+
+	schedule, err := h.loanService.GetLoanSchedule(c.Request.Context(), workplaceID, loanID, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "retrieve loan schedule")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToLoanScheduleResponse(schedule))
+}
+
+// postInstallment godoc
+// @Summary Pay the next installment
+// @Description Posts the next unpaid installment of a loan as a journal debiting the loan account with the principal and the interest account with the interest, and crediting the payment account with the total. The date defaults to the due date of the installment.
+// @Tags loans
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan_id path string true "Loan ID"
+// @Param   installment body dto.PostInstallmentRequest true "Payment account and date"
+// @Success 201 {object} dto.LoanPaymentResponse
+// @Failure 400 {object} map[string]string "Invalid input or loan fully repaid"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Loan not found"
+// @Failure 500 {object} map[string]string "Failed to post installment"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans/{loan_id}/installments [post]
+func (h *loanHandler) postInstallment(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, loanID, userID, ok := loanPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.PostInstallmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for PostInstallment", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("loan_id", loanID))
+	logger.Info("Received request to post loan installment")
+
+	payment, err := h.loanService.PostInstallment(c.Request.Context(), workplaceID, loanID, req, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "post installment")
+		return
+	}
+
+	logger.Info("Loan installment posted", slog.String("journal_id", payment.JournalID))
+	c.JSON(http.StatusCreated, dto.ToLoanPaymentResponse(payment))
+}
+
+// postPrepayment godoc
+// @Summary Prepay principal
+// @Description Posts an extra principal payment as a journal debiting the loan account and crediting the payment account. The schedule is recalculated from the prepayment: REDUCE_TERM (the default) keeps the installment amount so the loan ends earlier, REDUCE_PAYMENT spreads the remaining principal over the remaining installments.
+// @Tags loans
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   loan_id path string true "Loan ID"
+// @Param   prepayment body dto.PrepaymentRequest true "Prepayment details"
+// @Success 201 {object} dto.LoanPaymentResponse
+// @Failure 400 {object} map[string]string "Invalid input or amount exceeds the remaining principal"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Loan not found"
+// @Failure 500 {object} map[string]string "Failed to post prepayment"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/loans/{loan_id}/prepayments [post]
+func (h *loanHandler) postPrepayment(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, loanID, userID, ok := loanPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.PrepaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for PostPrepayment", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("loan_id", loanID))
+	logger.Info("Received request to post loan prepayment", slog.String("amount", req.Amount.String()))
+
+	payment, err := h.loanService.PostPrepayment(c.Request.Context(), workplaceID, loanID, req, userID)
+	if err != nil {
+		writeLoanError(c, logger, err, "post prepayment")
+		return
+	}
+
+	logger.Info("Loan prepayment posted", slog.String("journal_id", payment.JournalID))
+	c.JSON(http.StatusCreated, dto.ToLoanPaymentResponse(payment))
+}
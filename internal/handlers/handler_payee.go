@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// payeeHandler handles HTTP requests for the payee directory.
+type payeeHandler struct {
+	payeeService portssvc.PayeeSvcFacade
+}
+
+// newPayeeHandler creates a new payeeHandler.
+func newPayeeHandler(ps portssvc.PayeeSvcFacade) *payeeHandler {
+	return &payeeHandler{
+		payeeService: ps,
+	}
+}
+
+// registerPayeeRoutes registers routes for payees WITHIN a workplace.
+func registerPayeeRoutes(rg *gin.RouterGroup, payeeService portssvc.PayeeSvcFacade) {
+	h := newPayeeHandler(payeeService)
+
+	payees := rg.Group("/payees")
+	{
+		payees.POST("", h.createPayee)
+		payees.GET("", h.listPayees)
+		payees.GET("/resolve", h.resolvePayee)
+		payees.GET("/:payee_id", h.getPayee)
+		payees.PUT("/:payee_id", h.updatePayee)
+		payees.DELETE("/:payee_id", h.deletePayee)
+	}
+}
+
+// payeePathParams reads the workplace and payee IDs and the calling user, writing an error response when missing
+func payeePathParams(c *gin.Context, logger *slog.Logger, needPayee bool) (workplaceID, payeeID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	payeeID = c.Param("payee_id")
+	if workplaceID == "" || (needPayee && payeeID == "") {
+		logger.Error("Workplace ID or Payee ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Payee ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, payeeID, userID, true
+}
+
+// writePayeeError maps a payee service error to an HTTP response
+func writePayeeError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Payee not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Payee not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createPayee godoc
+// @Summary Create payee
+// @Description Creates a payee (vendor, customer or other counterparty) with optional aliases used to recognise it in journal and bank statement descriptions, and an optional default counter-account
+// @Tags payees
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   payee body dto.PayeeRequest true "Payee details"
+// @Success 201 {object} dto.PayeeResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Name or alias already used by another payee"
+// @Failure 500 {object} map[string]string "Failed to create payee"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/payees [post]
+func (h *payeeHandler) createPayee(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := payeePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.PayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreatePayee", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create payee", slog.String("name", req.Name))
+
+	payee, err := h.payeeService.CreatePayee(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writePayeeError(c, logger, err, "create payee")
+		return
+	}
+
+	logger.Info("Payee created successfully", slog.String("payee_id", payee.PayeeID))
+	c.JSON(http.StatusCreated, dto.ToPayeeResponse(payee))
+}
+
+// listPayees godoc
+// @Summary List payees
+// @Description Lists the payees of a workplace ordered by name
+// @Tags payees
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   includeInactive query bool false "Include deactivated payees"
+// @Success 200 {object} dto.ListPayeesResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list payees"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/payees [get]
+func (h *payeeHandler) listPayees(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := payeePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListPayeesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query params for ListPayees", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	payees, err := h.payeeService.ListPayees(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writePayeeError(c, logger, err, "list payees")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListPayeesResponse(payees))
+}
+
+// resolvePayee godoc
+// @Summary Resolve payee from text
+// @Description Finds the active payee whose name or alias occurs as whole words in a free-text description, preferring the longest match. This is the resolution applied to journals created without a payee.
+// @Tags payees
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   text query string true "Free-text description"
+// @Success 200 {object} dto.ResolvePayeeResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to resolve payee"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/payees/resolve [get]
+func (h *payeeHandler) resolvePayee(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := payeePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ResolvePayeeParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query params for ResolvePayee", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	match, err := h.payeeService.ResolvePayee(c.Request.Context(), workplaceID, params.Text, userID)
+	if err != nil {
+		writePayeeError(c, logger, err, "resolve payee")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToResolvePayeeResponse(params.Text, match))
+}
+
+// getPayee godoc
+// @Summary Get payee
+// @Description Retrieves a payee with its aliases
+// @Tags payees
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   payee_id path string true "Payee ID"
+// @Success 200 {object} dto.PayeeResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Payee not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve payee"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/payees/{payee_id} [get]
+func (h *payeeHandler) getPayee(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, payeeID, userID, ok := payeePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("payee_id", payeeID))
+
+	payee, err := h.payeeService.GetPayee(c.Request.Context(), workplaceID, payeeID, userID)
+	if err != nil {
+		writePayeeError(c, logger, err, "retrieve payee")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPayeeResponse(payee))
+}
+
+// updatePayee godoc
+// @Summary Update payee
+// @Description Replaces the name, aliases, default counter-account and active flag of a payee. Journals keep referencing the payee.
+// @Tags payees
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   payee_id path string true "Payee ID"
+// @Param   payee body dto.PayeeRequest true "Payee details"
+// @Success 200 {object} dto.PayeeResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Payee not found"
+// @Failure 409 {object} map[string]string "Name or alias already used by another payee"
+// @Failure 500 {object} map[string]string "Failed to update payee"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/payees/{payee_id} [put]
+func (h *payeeHandler) updatePayee(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, payeeID, userID, ok := payeePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.PayeeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdatePayee", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("payee_id", payeeID))
+	logger.Info("Received request to update payee")
+
+	payee, err := h.payeeService.UpdatePayee(c.Request.Context(), workplaceID, payeeID, req, userID)
+	if err != nil {
+		writePayeeError(c, logger, err, "update payee")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPayeeResponse(payee))
+}
+
+// deletePayee godoc
+// @Summary Delete payee
+// @Description Deletes a payee that no journal or transaction references; deactivate referenced payees instead
+// @Tags payees
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   payee_id path string true "Payee ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Payee not found"
+// @Failure 409 {object} map[string]string "Payee is referenced by journals"
+// @Failure 500 {object} map[string]string "Failed to delete payee"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/payees/{payee_id} [delete]
+func (h *payeeHandler) deletePayee(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, payeeID, userID, ok := payeePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("payee_id", payeeID))
+	logger.Info("Received request to delete payee")
+
+	if err := h.payeeService.DeletePayee(c.Request.Context(), workplaceID, payeeID, userID); err != nil {
+		writePayeeError(c, logger, err, "delete payee")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
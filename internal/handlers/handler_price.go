@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// maxPriceFileSize caps the size of uploaded price files
+const maxPriceFileSize = 10 << 20
+
+// priceHandler handles HTTP requests for commodity prices.
+type priceHandler struct {
+	priceService portssvc.PriceSvcFacade
+}
+
+// newPriceHandler creates a new priceHandler.
+func newPriceHandler(ps portssvc.PriceSvcFacade) *priceHandler {
+	return &priceHandler{
+		priceService: ps,
+	}
+}
+
+// registerPriceRoutes registers routes for commodity prices. Like exchange rates, prices are shared by all workplaces.
+func registerPriceRoutes(rg *gin.RouterGroup, priceService portssvc.PriceSvcFacade) {
+	h := newPriceHandler(priceService)
+
+	prices := rg.Group("/prices")
+	{
+		prices.POST("", h.recordPrice)
+		prices.GET("", h.listPrices)
+		prices.GET("/as-of", h.getPriceAsOf)
+		prices.POST("/import", h.importPrices)
+		prices.POST("/sync", h.syncPrices)
+	}
+}
+
+// priceUserID reads the calling user, writing an error response when missing
+func priceUserID(c *gin.Context, logger *slog.Logger) (string, bool) {
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", false
+	}
+	return userID, true
+}
+
+// writePriceError maps a price service error to an HTTP response
+func writePriceError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Price not found")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Price not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// recordPrice godoc
+// @Summary Record a commodity price
+// @Description Records the price of one unit of a security, crypto asset or other commodity in a quote currency on a date, replacing any price already recorded for that date. Commodity symbols are stored upper-case. Like exchange rates, prices are shared by all workplaces and any authenticated user can record them.
+// @Tags prices
+// @Accept  json
+// @Produce  json
+// @Param   price body dto.RecordPriceRequest true "Commodity, quote currency, date and price"
+// @Success 201 {object} dto.PriceResponse
+// @Failure 400 {object} map[string]string "Invalid input or unknown quote currency"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Failed to record price"
+// @Security BearerAuth
+// @Router /prices [post]
+func (h *priceHandler) recordPrice(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	userID, ok := priceUserID(c, logger)
+	if !ok {
+		return
+	}
+
+	var req dto.RecordPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for RecordPrice", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID))
+
+	price, err := h.priceService.RecordPrice(c.Request.Context(), req, userID)
+	if err != nil {
+		writePriceError(c, logger, err, "record price")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.ToPriceResponse(price))
+}
+
+// listPrices godoc
+// @Summary List commodity prices
+// @Description Lists the prices of a commodity in a quote currency, newest first, optionally limited to a date range
+// @Tags prices
+// @Produce  json
+// @Param   commodity query string true "Commodity symbol"
+// @Param   quoteCurrency query string true "Quote currency code"
+// @Param   from query string false "First date (YYYY-MM-DD)"
+// @Param   to query string false "Last date (YYYY-MM-DD)"
+// @Success 200 {object} dto.ListPricesResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Failed to list prices"
+// @Security BearerAuth
+// @Router /prices [get]
+func (h *priceHandler) listPrices(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	if _, ok := priceUserID(c, logger); !ok {
+		return
+	}
+
+	var params dto.ListPricesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for ListPrices", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	prices, err := h.priceService.ListPrices(c.Request.Context(), params)
+	if err != nil {
+		writePriceError(c, logger, err, "list prices")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListPricesResponse(prices))
+}
+
+// getPriceAsOf godoc
+// @Summary Get a commodity price as of a date
+// @Description Returns the latest price of a commodity in a quote currency dated on or before the given date
+// @Tags prices
+// @Produce  json
+// @Param   commodity query string true "Commodity symbol"
+// @Param   quoteCurrency query string true "Quote currency code"
+// @Param   asOf query string false "As-of date (YYYY-MM-DD), defaults to today"
+// @Success 200 {object} dto.PriceResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 404 {object} map[string]string "No price on or before the date"
+// @Failure 500 {object} map[string]string "Failed to retrieve price"
+// @Security BearerAuth
+// @Router /prices/as-of [get]
+func (h *priceHandler) getPriceAsOf(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	if _, ok := priceUserID(c, logger); !ok {
+		return
+	}
+
+	var params dto.PriceAsOfParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query parameters for GetPriceAsOf", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	price, err := h.priceService.GetPriceAsOf(c.Request.Context(), params)
+	if err != nil {
+		writePriceError(c, logger, err, "retrieve price")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPriceResponse(price))
+}
+
+// importPrices godoc
+// @Summary Import commodity prices from CSV
+// @Description Loads prices from a CSV file with a header naming the commodity (or symbol), quote_currency (or currency), date (YYYY-MM-DD) and price columns in any order. Valid rows are stored, replacing prices already recorded for the same day; invalid rows are skipped and reported.
+// @Tags prices
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param   file formData file true "CSV price file"
+// @Success 200 {object} dto.PriceImportResponse
+// @Failure 400 {object} map[string]string "Missing, oversized or unreadable file"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Failed to import prices"
+// @Security BearerAuth
+// @Router /prices/import [post]
+func (h *priceHandler) importPrices(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	userID, ok := priceUserID(c, logger)
+	if !ok {
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Price file is required"})
+		return
+	}
+	if fileHeader.Size > maxPriceFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Price file is too large"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.Error("Failed to open uploaded price file", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read price file"})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxPriceFileSize))
+	if err != nil {
+		logger.Error("Failed to read uploaded price file", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Could not read price file"})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID))
+	logger.Info("Received request to import prices", slog.String("file_name", fileHeader.Filename))
+
+	result, err := h.priceService.ImportPrices(c.Request.Context(), data, userID)
+	if err != nil {
+		writePriceError(c, logger, err, "import prices")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPriceImportResponse(result))
+}
+
+// syncPrices godoc
+// @Summary Sync commodity prices from the price provider
+// @Description Loads prices from the configured price provider, optionally limited to a commodity, quote currency and date range. Prices are recorded with the provider name as their source.
+// @Tags prices
+// @Accept  json
+// @Produce  json
+// @Param   sync body dto.SyncPricesRequest false "Optional filters"
+// @Success 200 {object} dto.PriceImportResponse
+// @Failure 400 {object} map[string]string "Invalid input or no price provider configured"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 500 {object} map[string]string "Failed to sync prices"
+// @Security BearerAuth
+// @Router /prices/sync [post]
+func (h *priceHandler) syncPrices(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	userID, ok := priceUserID(c, logger)
+	if !ok {
+		return
+	}
+
+	var req dto.SyncPricesRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			logger.Warn("Failed to bind JSON for SyncPrices", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+			return
+		}
+	}
+
+	logger = logger.With(slog.String("user_id", userID))
+
+	result, err := h.priceService.SyncPrices(c.Request.Context(), req, userID)
+	if err != nil {
+		writePriceError(c, logger, err, "sync prices")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToPriceImportResponse(result))
+}
@@ -0,0 +1,370 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// reconciliationHandler handles HTTP requests related to reconciliation sessions.
+type reconciliationHandler struct {
+	reconciliationService portssvc.ReconciliationSvcFacade
+}
+
+// newReconciliationHandler creates a new reconciliationHandler.
+func newReconciliationHandler(rs portssvc.ReconciliationSvcFacade) *reconciliationHandler {
+	return &reconciliationHandler{
+		reconciliationService: rs,
+	}
+}
+
+// registerReconciliationRoutes registers routes related to reconciliation sessions WITHIN a workplace.
+func registerReconciliationRoutes(rg *gin.RouterGroup, reconciliationService portssvc.ReconciliationSvcFacade) {
+	h := newReconciliationHandler(reconciliationService)
+
+	reconciliations := rg.Group("/reconciliations")
+	{
+		reconciliations.POST("", h.createReconciliation)
+		reconciliations.GET("", h.listReconciliations)
+		reconciliations.GET("/:reconciliation_id", h.getReconciliation)
+		reconciliations.GET("/:reconciliation_id/transactions", h.listReconciliationTransactions)
+		reconciliations.POST("/:reconciliation_id/clear", h.clearTransactions)
+		reconciliations.POST("/:reconciliation_id/unclear", h.unclearTransactions)
+		reconciliations.POST("/:reconciliation_id/complete", h.completeReconciliation)
+		reconciliations.DELETE("/:reconciliation_id", h.deleteReconciliation)
+	}
+}
+
+// reconciliationPathParams reads the workplace and reconciliation IDs and the calling user, writing an error response when missing
+func reconciliationPathParams(c *gin.Context, logger *slog.Logger, needReconciliation bool) (workplaceID, reconciliationID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	reconciliationID = c.Param("reconciliation_id")
+	if workplaceID == "" || (needReconciliation && reconciliationID == "") {
+		logger.Error("Workplace ID or Reconciliation ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Reconciliation ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, reconciliationID, userID, true
+}
+
+// writeReconciliationError maps a reconciliation service error to an HTTP response
+func writeReconciliationError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Reconciliation not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Reconciliation not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createReconciliation godoc
+// @Summary Create reconciliation
+// @Description Opens a reconciliation session for an ASSET or LIABILITY account against a bank statement date and ending balance. An account can only have one session in progress.
+// @Tags reconciliations
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation body dto.CreateReconciliationRequest true "Statement details"
+// @Success 201 {object} dto.ReconciliationSummaryResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Account already has a reconciliation in progress"
+// @Failure 500 {object} map[string]string "Failed to create reconciliation"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations [post]
+func (h *reconciliationHandler) createReconciliation(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := reconciliationPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateReconciliationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateReconciliation", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create reconciliation", slog.String("account_id", req.AccountID))
+
+	summary, err := h.reconciliationService.CreateReconciliation(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeReconciliationError(c, logger, err, "create reconciliation")
+		return
+	}
+
+	logger.Info("Reconciliation created successfully", slog.String("reconciliation_id", summary.ReconciliationID))
+	c.JSON(http.StatusCreated, dto.ToReconciliationSummaryResponse(summary))
+}
+
+// listReconciliations godoc
+// @Summary List reconciliations
+// @Description Lists the reconciliation sessions of a workplace, latest statement first
+// @Tags reconciliations
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   accountID query string false "Only sessions of this account"
+// @Success 200 {object} dto.ListReconciliationsResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list reconciliations"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations [get]
+func (h *reconciliationHandler) listReconciliations(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := reconciliationPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListReconciliationsParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query params for ListReconciliations", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	reconciliations, err := h.reconciliationService.ListReconciliations(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeReconciliationError(c, logger, err, "list reconciliations")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListReconciliationsResponse(reconciliations))
+}
+
+// getReconciliation godoc
+// @Summary Get reconciliation
+// @Description Retrieves a reconciliation session with its opening, cleared and ending balances and the live difference
+// @Tags reconciliations
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation_id path string true "Reconciliation ID"
+// @Success 200 {object} dto.ReconciliationSummaryResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Reconciliation not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve reconciliation"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations/{reconciliation_id} [get]
+func (h *reconciliationHandler) getReconciliation(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, reconciliationID, userID, ok := reconciliationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("reconciliation_id", reconciliationID))
+
+	summary, err := h.reconciliationService.GetReconciliation(c.Request.Context(), workplaceID, reconciliationID, userID)
+	if err != nil {
+		writeReconciliationError(c, logger, err, "retrieve reconciliation")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToReconciliationSummaryResponse(summary))
+}
+
+// listReconciliationTransactions godoc
+// @Summary List reconciliation transactions
+// @Description Lists the account's unreconciled transactions dated on or before the statement date with their clearing status. For a completed session, lists the transactions it locked.
+// @Tags reconciliations
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation_id path string true "Reconciliation ID"
+// @Success 200 {object} dto.ReconciliationTransactionsResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Reconciliation not found"
+// @Failure 500 {object} map[string]string "Failed to list reconciliation transactions"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations/{reconciliation_id}/transactions [get]
+func (h *reconciliationHandler) listReconciliationTransactions(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, reconciliationID, userID, ok := reconciliationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("reconciliation_id", reconciliationID))
+
+	transactions, err := h.reconciliationService.ListReconciliationTransactions(c.Request.Context(), workplaceID, reconciliationID, userID)
+	if err != nil {
+		writeReconciliationError(c, logger, err, "list reconciliation transactions")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReconciliationTransactionsResponse{Transactions: dto.ToTransactionResponses(transactions)})
+}
+
+// clearTransactions godoc
+// @Summary Clear transactions
+// @Description Ticks transactions of the session's account as cleared. Transactions must be dated on or before the statement date and not reconciled or cleared in another session.
+// @Tags reconciliations
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation_id path string true "Reconciliation ID"
+// @Param   clearing body dto.ReconciliationClearingRequest true "Transactions to clear"
+// @Success 200 {object} dto.ReconciliationSummaryResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Reconciliation not found"
+// @Failure 409 {object} map[string]string "Reconciliation completed or transaction already reconciled"
+// @Failure 500 {object} map[string]string "Failed to clear transactions"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations/{reconciliation_id}/clear [post]
+func (h *reconciliationHandler) clearTransactions(c *gin.Context) {
+	h.changeClearing(c, h.reconciliationService.ClearTransactions, "clear transactions")
+}
+
+// unclearTransactions godoc
+// @Summary Unclear transactions
+// @Description Unticks transactions cleared in an open session
+// @Tags reconciliations
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation_id path string true "Reconciliation ID"
+// @Param   clearing body dto.ReconciliationClearingRequest true "Transactions to unclear"
+// @Success 200 {object} dto.ReconciliationSummaryResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Reconciliation not found"
+// @Failure 409 {object} map[string]string "Reconciliation completed"
+// @Failure 500 {object} map[string]string "Failed to unclear transactions"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations/{reconciliation_id}/unclear [post]
+func (h *reconciliationHandler) unclearTransactions(c *gin.Context) {
+	h.changeClearing(c, h.reconciliationService.UnclearTransactions, "unclear transactions")
+}
+
+// clearingFunc is the service call behind the clear and unclear endpoints
+type clearingFunc func(ctx context.Context, workplaceID string, reconciliationID string, req dto.ReconciliationClearingRequest, userID string) (*domain.ReconciliationSummary, error)
+
+// changeClearing handles both the clear and unclear endpoints
+func (h *reconciliationHandler) changeClearing(c *gin.Context, change clearingFunc, action string) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, reconciliationID, userID, ok := reconciliationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.ReconciliationClearingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("reconciliation_id", reconciliationID))
+	logger.Info("Received request to "+action, slog.Int("count", len(req.TransactionIDs)))
+
+	summary, err := change(c.Request.Context(), workplaceID, reconciliationID, req, userID)
+	if err != nil {
+		writeReconciliationError(c, logger, err, action)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToReconciliationSummaryResponse(summary))
+}
+
+// completeReconciliation godoc
+// @Summary Complete reconciliation
+// @Description Completes a session whose difference is zero. Its cleared transactions become RECONCILED and their journals can then only be reversed by an ADMIN.
+// @Tags reconciliations
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation_id path string true "Reconciliation ID"
+// @Success 200 {object} dto.ReconciliationSummaryResponse
+// @Failure 400 {object} map[string]string "Difference is not zero"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Reconciliation not found"
+// @Failure 409 {object} map[string]string "Reconciliation already completed"
+// @Failure 500 {object} map[string]string "Failed to complete reconciliation"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations/{reconciliation_id}/complete [post]
+func (h *reconciliationHandler) completeReconciliation(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, reconciliationID, userID, ok := reconciliationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("reconciliation_id", reconciliationID))
+	logger.Info("Received request to complete reconciliation")
+
+	summary, err := h.reconciliationService.CompleteReconciliation(c.Request.Context(), workplaceID, reconciliationID, userID)
+	if err != nil {
+		writeReconciliationError(c, logger, err, "complete reconciliation")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToReconciliationSummaryResponse(summary))
+}
+
+// deleteReconciliation godoc
+// @Summary Delete reconciliation
+// @Description Discards a session in progress and unticks its cleared transactions. Completed sessions cannot be deleted.
+// @Tags reconciliations
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   reconciliation_id path string true "Reconciliation ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Reconciliation not found"
+// @Failure 409 {object} map[string]string "Reconciliation already completed"
+// @Failure 500 {object} map[string]string "Failed to delete reconciliation"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reconciliations/{reconciliation_id} [delete]
+func (h *reconciliationHandler) deleteReconciliation(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, reconciliationID, userID, ok := reconciliationPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("reconciliation_id", reconciliationID))
+	logger.Info("Received request to delete reconciliation")
+
+	if err := h.reconciliationService.DeleteReconciliation(c.Request.Context(), workplaceID, reconciliationID, userID); err != nil {
+		writeReconciliationError(c, logger, err, "delete reconciliation")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
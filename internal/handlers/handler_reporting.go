@@ -1,40 +1,54 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
 	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
 	"github.com/SscSPs/money_managemet_app/internal/dto"
 	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/SscSPs/money_managemet_app/internal/utils/export"
 	"github.com/gin-gonic/gin"
 )
 
 // reportingHandler handles HTTP requests related to financial reports
 type reportingHandler struct {
 	reportingService portssvc.ReportingService
+	exporter         *reportExporter
 }
 
 // newReportingHandler creates a new reportingHandler
-func newReportingHandler(rs portssvc.ReportingService) *reportingHandler {
+func newReportingHandler(rs portssvc.ReportingService, exporter *reportExporter) *reportingHandler {
 	return &reportingHandler{
 		reportingService: rs,
+		exporter:         exporter,
 	}
 }
 
 // registerReportingRoutes registers routes related to financial reports
-func registerReportingRoutes(rg *gin.RouterGroup, reportingService portssvc.ReportingService) {
-	h := newReportingHandler(reportingService)
+func registerReportingRoutes(rg *gin.RouterGroup, reportingService portssvc.ReportingService, exporter *reportExporter) {
+	h := newReportingHandler(reportingService, exporter)
 
 	// Routes for reports are nested under a specific workplace
 	reportingGroup := rg.Group("/reports")
 	{
 		reportingGroup.GET("/trial-balance", h.getTrialBalance)
 		reportingGroup.GET("/profit-and-loss", h.getProfitAndLoss)
+		reportingGroup.GET("/profit-and-loss/comparative", h.getComparativeProfitAndLoss)
 		reportingGroup.GET("/balance-sheet", h.getBalanceSheet)
+		reportingGroup.GET("/balance-sheet/comparative", h.getComparativeBalanceSheet)
+		reportingGroup.GET("/cash-flow", h.getCashFlow)
+		reportingGroup.GET("/general-ledger", h.getGeneralLedger)
+		reportingGroup.GET("/account-statement/:account_id", h.getAccountStatement)
+		reportingGroup.GET("/time-series", h.getTimeSeries)
+		reportingGroup.GET("/payees", h.getPayeeReport)
+		reportingGroup.GET("/tax-return", h.getTaxReturnReport)
 	}
 }
 
@@ -42,9 +56,12 @@ func registerReportingRoutes(rg *gin.RouterGroup, reportingService portssvc.Repo
 // @Summary Generate trial balance report
 // @Description Generates a trial balance report as of a specific date
 // @Tags reports
-// @Produce json
+// @Produce json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,application/pdf
 // @Param workplace_id path string true "Workplace ID"
 // @Param asOf query string false "Report date (YYYY-MM-DD)" default(current date)
+// @Param format query string false "Response format; also negotiable via the Accept header" Enums(json, csv, xlsx, pdf) default(json)
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
 // @Success 200 {object} dto.TrialBalanceResponse
 // @Failure 400 {object} map[string]string "Invalid input"
 // @Failure 401 {object} map[string]string "Unauthorized"
@@ -77,6 +94,16 @@ func (h *reportingHandler) getTrialBalance(c *gin.Context) {
 		return
 	}
 
+	format, ok := requestedExportFormat(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, format)
+	if !ok {
+		return
+	}
+
 	logger = logger.With(
 		slog.String("user_id", userID),
 		slog.String("workplace_id", workplaceID),
@@ -84,8 +111,19 @@ func (h *reportingHandler) getTrialBalance(c *gin.Context) {
 	)
 	logger.Info("Received request to generate trial balance report")
 
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "trial balance report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.TrialBalance(ctx, workplaceID, asOf, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToTrialBalanceResponse(report, asOf), nil
+		})
+		return
+	}
+
 	// Call service to generate report
-	trialBalanceRows, err := h.reportingService.TrialBalance(c.Request.Context(), workplaceID, asOf, userID)
+	trialBalanceRows, err := h.reportingService.TrialBalance(c.Request.Context(), workplaceID, asOf, dimensions.Filters, userID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrForbidden) {
 			logger.Warn("User forbidden to access trial balance report")
@@ -102,6 +140,10 @@ func (h *reportingHandler) getTrialBalance(c *gin.Context) {
 
 	// Convert domain objects to DTO
 	response := dto.ToTrialBalanceResponse(trialBalanceRows, asOf)
+	if format != export.FormatJSON {
+		h.exporter.exportTrialBalance(c, logger, format, workplaceID, response)
+		return
+	}
 
 	logger.Info("Trial balance report generated successfully", slog.Int("row_count", len(trialBalanceRows)))
 	c.JSON(http.StatusOK, response)
@@ -111,10 +153,13 @@ func (h *reportingHandler) getTrialBalance(c *gin.Context) {
 // @Summary Generate profit and loss report
 // @Description Generates a profit and loss report for a specific period
 // @Tags reports
-// @Produce json
+// @Produce json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,application/pdf
 // @Param workplace_id path string true "Workplace ID"
 // @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
 // @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param format query string false "Response format; also negotiable via the Accept header" Enums(json, csv, xlsx, pdf) default(json)
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
 // @Success 200 {object} dto.ProfitAndLossResponse
 // @Failure 400 {object} map[string]string "Invalid input"
 // @Failure 401 {object} map[string]string "Unauthorized"
@@ -167,6 +212,16 @@ func (h *reportingHandler) getProfitAndLoss(c *gin.Context) {
 		return
 	}
 
+	format, ok := requestedExportFormat(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, format)
+	if !ok {
+		return
+	}
+
 	logger = logger.With(
 		slog.String("user_id", userID),
 		slog.String("workplace_id", workplaceID),
@@ -175,8 +230,19 @@ func (h *reportingHandler) getProfitAndLoss(c *gin.Context) {
 	)
 	logger.Info("Received request to generate profit and loss report")
 
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "profit and loss report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.ProfitAndLoss(ctx, workplaceID, from, to, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToProfitAndLossResponse(report, from, to), nil
+		})
+		return
+	}
+
 	// Call service to generate report
-	report, err := h.reportingService.ProfitAndLoss(c.Request.Context(), workplaceID, from, to, userID)
+	report, err := h.reportingService.ProfitAndLoss(c.Request.Context(), workplaceID, from, to, dimensions.Filters, userID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrForbidden) {
 			logger.Warn("User forbidden to access profit and loss report")
@@ -193,6 +259,10 @@ func (h *reportingHandler) getProfitAndLoss(c *gin.Context) {
 
 	// Convert domain objects to DTO
 	response := dto.ToProfitAndLossResponse(report, from, to)
+	if format != export.FormatJSON {
+		h.exporter.exportProfitAndLoss(c, logger, format, workplaceID, response)
+		return
+	}
 
 	logger.Info("Profit and loss report generated successfully",
 		slog.Int("revenue_accounts", len(report.Revenue)),
@@ -204,9 +274,12 @@ func (h *reportingHandler) getProfitAndLoss(c *gin.Context) {
 // @Summary Generate balance sheet report
 // @Description Generates a balance sheet report as of a specific date
 // @Tags reports
-// @Produce json
+// @Produce json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,application/pdf
 // @Param workplace_id path string true "Workplace ID"
 // @Param asOf query string false "Report date (YYYY-MM-DD)" default(current date)
+// @Param format query string false "Response format; also negotiable via the Accept header" Enums(json, csv, xlsx, pdf) default(json)
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
 // @Success 200 {object} dto.BalanceSheetResponse
 // @Failure 400 {object} map[string]string "Invalid input"
 // @Failure 401 {object} map[string]string "Unauthorized"
@@ -239,6 +312,16 @@ func (h *reportingHandler) getBalanceSheet(c *gin.Context) {
 		return
 	}
 
+	format, ok := requestedExportFormat(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, format)
+	if !ok {
+		return
+	}
+
 	logger = logger.With(
 		slog.String("user_id", userID),
 		slog.String("workplace_id", workplaceID),
@@ -246,8 +329,19 @@ func (h *reportingHandler) getBalanceSheet(c *gin.Context) {
 	)
 	logger.Info("Received request to generate balance sheet report")
 
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "balance sheet report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.BalanceSheet(ctx, workplaceID, asOf, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToBalanceSheetResponse(report, asOf), nil
+		})
+		return
+	}
+
 	// Call service to generate report
-	report, err := h.reportingService.BalanceSheet(c.Request.Context(), workplaceID, asOf, userID)
+	report, err := h.reportingService.BalanceSheet(c.Request.Context(), workplaceID, asOf, dimensions.Filters, userID)
 	if err != nil {
 		if errors.Is(err, apperrors.ErrForbidden) {
 			logger.Warn("User forbidden to access balance sheet report")
@@ -264,6 +358,10 @@ func (h *reportingHandler) getBalanceSheet(c *gin.Context) {
 
 	// Convert domain objects to DTO
 	response := dto.ToBalanceSheetResponse(report, asOf)
+	if format != export.FormatJSON {
+		h.exporter.exportBalanceSheet(c, logger, format, workplaceID, response)
+		return
+	}
 
 	logger.Info("Balance sheet report generated successfully",
 		slog.Int("asset_accounts", len(report.Assets)),
@@ -271,3 +369,882 @@ func (h *reportingHandler) getBalanceSheet(c *gin.Context) {
 		slog.Int("equity_accounts", len(report.Equity)))
 	c.JSON(http.StatusOK, response)
 }
+
+// getCashFlow godoc
+// @Summary Generate cash flow statement
+// @Description Generates an indirect-method cash flow statement for a specific period, reconciled against cash accounts
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.CashFlowResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/cash-flow [get]
+func (h *reportingHandler) getCashFlow(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getCashFlow")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	// Get current time for default date calculations
+	now := time.Now()
+
+	// Default from date is first day of current month
+	firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	fromStr := c.DefaultQuery("fromDate", firstDayOfMonth.Format("2006-01-02"))
+	from, err := time.Parse("2006-01-02", fromStr)
+	if err != nil {
+		logger.Warn("Invalid from date format", slog.String("fromDate", fromStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid fromDate format. Use YYYY-MM-DD"})
+		return
+	}
+
+	// Default to date is today
+	toStr := c.DefaultQuery("toDate", now.Format("2006-01-02"))
+	to, err := time.Parse("2006-01-02", toStr)
+	if err != nil {
+		logger.Warn("Invalid to date format", slog.String("toDate", toStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid toDate format. Use YYYY-MM-DD"})
+		return
+	}
+
+	// Validate date range
+	if from.After(to) {
+		logger.Warn("Invalid date range", slog.String("fromDate", fromStr), slog.String("toDate", toStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "fromDate must be before or equal to toDate"})
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.String("fromDate", fromStr),
+		slog.String("toDate", toStr),
+	)
+	logger.Info("Received request to generate cash flow report")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "cash flow report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.CashFlow(ctx, workplaceID, from, to, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToCashFlowResponse(report, from, to), nil
+		})
+		return
+	}
+
+	// Call service to generate report
+	report, err := h.reportingService.CashFlow(c.Request.Context(), workplaceID, from, to, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access cash flow report")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate cash flow report", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate cash flow report"})
+		}
+		return
+	}
+
+	// Convert domain objects to DTO
+	response := dto.ToCashFlowResponse(report, from, to)
+
+	logger.Info("Cash flow report generated successfully", slog.Bool("reconciled", report.Reconciled))
+	c.JSON(http.StatusOK, response)
+}
+
+// parseReportPeriod parses the fromDate and toDate query parameters, defaulting to the current month to date.
+// It writes a 400 response and returns false when the parameters are invalid.
+func parseReportPeriod(c *gin.Context, logger *slog.Logger) (time.Time, time.Time, bool) {
+	now := time.Now()
+	firstDayOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+
+	fromStr := c.DefaultQuery("fromDate", firstDayOfMonth.Format("2006-01-02"))
+	from, err := time.Parse("2006-01-02", fromStr)
+	if err != nil {
+		logger.Warn("Invalid from date format", slog.String("fromDate", fromStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid fromDate format. Use YYYY-MM-DD"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	toStr := c.DefaultQuery("toDate", now.Format("2006-01-02"))
+	to, err := time.Parse("2006-01-02", toStr)
+	if err != nil {
+		logger.Warn("Invalid to date format", slog.String("toDate", toStr), slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid toDate format. Use YYYY-MM-DD"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	if from.After(to) {
+		logger.Warn("Invalid date range", slog.String("fromDate", fromStr), slog.String("toDate", toStr))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "fromDate must be before or equal to toDate"})
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, true
+}
+
+// parseReportDimensions resolves the dimensionValueId and groupBy query parameters of a report request.
+// Grouped reports are only available as JSON. It writes an error response and returns false when the
+// parameters are invalid.
+func (h *reportingHandler) parseReportDimensions(c *gin.Context, logger *slog.Logger, workplaceID, userID string, format export.Format) (*domain.ReportDimensions, bool) {
+	groupBy := strings.TrimSpace(c.Query("groupBy"))
+	if groupBy != "" && format != export.FormatJSON {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Grouped reports can only be returned as JSON"})
+		return nil, false
+	}
+
+	dimensions, err := h.reportingService.ReportDimensions(c.Request.Context(), workplaceID, parseIDList(c, "dimensionValueId"), groupBy, userID)
+	if err != nil {
+		writeGroupedReportError(c, logger, err, "resolve report dimensions")
+		return nil, false
+	}
+	return dimensions, true
+}
+
+// writeGroupedReport runs a report once per group of a request grouped by a dimension and writes the reports
+// as a dto.GroupedReportResponse
+func (h *reportingHandler) writeGroupedReport(c *gin.Context, logger *slog.Logger, dimensions *domain.ReportDimensions, report string,
+	run func(ctx context.Context, filters []domain.DimensionFilter) (any, error)) {
+	response := dto.GroupedReportResponse{
+		GroupBy: dto.DimensionGroupByResponse{
+			DimensionID: dimensions.GroupBy.DimensionID,
+			Code:        dimensions.GroupBy.Code,
+			Name:        dimensions.GroupBy.Name,
+		},
+		Groups: make([]dto.DimensionGroupReportResponse, 0, len(dimensions.Groups)),
+	}
+	for _, group := range dimensions.Groups {
+		groupReport, err := run(c.Request.Context(), group.Filters)
+		if err != nil {
+			writeGroupedReportError(c, logger, err, "generate "+report)
+			return
+		}
+		response.Groups = append(response.Groups, dto.DimensionGroupReportResponse{
+			ValueID: group.ValueID,
+			Code:    group.Code,
+			Name:    group.Name,
+			Report:  groupReport,
+		})
+	}
+
+	logger.Info("Grouped report generated successfully", slog.String("report", report), slog.Int("group_count", len(response.Groups)))
+	c.JSON(http.StatusOK, response)
+}
+
+// writeGroupedReportError maps an error raised while resolving dimensions or running a grouped report to an HTTP response
+func writeGroupedReportError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Not found trying to " + action)
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// parseIDList collects IDs from a repeatable query parameter, also accepting comma-separated values
+func parseIDList(c *gin.Context, key string) []string {
+	var ids []string
+	for _, value := range c.QueryArray(key) {
+		for _, id := range strings.Split(value, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// getGeneralLedger godoc
+// @Summary Generate general ledger report
+// @Description Lists every posting per account for a period with opening and closing balances and counter-accounts
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param accountId query []string false "Restrict the report to these account IDs (repeatable or comma-separated)"
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.GeneralLedgerResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/general-ledger [get]
+func (h *reportingHandler) getGeneralLedger(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getGeneralLedger")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	from, to, ok := parseReportPeriod(c, logger)
+	if !ok {
+		return
+	}
+	accountIDs := parseIDList(c, "accountId")
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.Time("fromDate", from),
+		slog.Time("toDate", to),
+		slog.Int("requested_accounts", len(accountIDs)),
+	)
+	logger.Info("Received request to generate general ledger report")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "general ledger report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.GeneralLedger(ctx, workplaceID, accountIDs, from, to, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToGeneralLedgerResponse(report, from, to), nil
+		})
+		return
+	}
+
+	report, err := h.reportingService.GeneralLedger(c.Request.Context(), workplaceID, accountIDs, from, to, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access general ledger report")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate general ledger report", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate general ledger report"})
+		}
+		return
+	}
+
+	response := dto.ToGeneralLedgerResponse(report, from, to)
+
+	logger.Info("General ledger report generated successfully", slog.Int("account_count", len(report.Accounts)))
+	c.JSON(http.StatusOK, response)
+}
+
+// getAccountStatement godoc
+// @Summary Generate account statement
+// @Description Generates the statement of a single account for a period with opening balance, postings and closing balance
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param account_id path string true "Account ID"
+// @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.AccountLedgerResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 404 {object} map[string]string "Account not found"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/account-statement/{account_id} [get]
+func (h *reportingHandler) getAccountStatement(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	accountID := c.Param("account_id")
+	if workplaceID == "" || accountID == "" {
+		logger.Error("Workplace ID or Account ID missing from path for getAccountStatement")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID and Account ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	from, to, ok := parseReportPeriod(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.String("account_id", accountID),
+		slog.Time("fromDate", from),
+		slog.Time("toDate", to),
+	)
+	logger.Info("Received request to generate account statement")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "account statement", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.AccountStatement(ctx, workplaceID, accountID, from, to, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToAccountLedgerResponse(*report, from, to), nil
+		})
+		return
+	}
+
+	ledger, err := h.reportingService.AccountStatement(c.Request.Context(), workplaceID, accountID, from, to, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access account statement")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Account not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Account not found"})
+		} else {
+			logger.Error("Failed to generate account statement", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate account statement"})
+		}
+		return
+	}
+
+	response := dto.ToAccountLedgerResponse(*ledger, from, to)
+
+	logger.Info("Account statement generated successfully", slog.Int("entry_count", len(ledger.Entries)))
+	c.JSON(http.StatusOK, response)
+}
+
+// parseComparativePeriods builds the columns of a comparative report from the compare query parameter:
+// "monthly" (default) splits fromDate..toDate into months, "yoy" compares fromDate..toDate with the same range
+// a year earlier, and "custom" takes repeated period=YYYY-MM-DD..YYYY-MM-DD parameters.
+// It writes a 400 response and returns false when the parameters are invalid.
+func parseComparativePeriods(c *gin.Context, logger *slog.Logger) ([]domain.ReportPeriod, bool) {
+	compare := c.DefaultQuery("compare", "monthly")
+	switch compare {
+	case "monthly", "yoy":
+		from, to, ok := parseReportPeriod(c, logger)
+		if !ok {
+			return nil, false
+		}
+		if compare == "yoy" {
+			return domain.YearOverYearPeriods(from, to), true
+		}
+		return domain.MonthlyPeriods(from, to), true
+	case "custom":
+		values := c.QueryArray("period")
+		if len(values) == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "At least one period parameter is required for custom comparison"})
+			return nil, false
+		}
+		periods := make([]domain.ReportPeriod, 0, len(values))
+		for _, value := range values {
+			fromStr, toStr, found := strings.Cut(value, "..")
+			from, fromErr := time.Parse("2006-01-02", fromStr)
+			to, toErr := time.Parse("2006-01-02", toStr)
+			if !found || fromErr != nil || toErr != nil {
+				logger.Warn("Invalid period format", slog.String("period", value))
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid period format. Use YYYY-MM-DD..YYYY-MM-DD"})
+				return nil, false
+			}
+			periods = append(periods, domain.ReportPeriod{Label: value, From: from, To: to})
+		}
+		return periods, true
+	default:
+		logger.Warn("Invalid compare mode", slog.String("compare", compare))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid compare mode. Use monthly, yoy or custom"})
+		return nil, false
+	}
+}
+
+// getComparativeProfitAndLoss godoc
+// @Summary Generate comparative profit and loss report
+// @Description Generates a profit and loss report with one column per period and absolute and percentage variance against the previous column
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param compare query string false "Comparison mode" Enums(monthly, yoy, custom) default(monthly)
+// @Param fromDate query string false "Start date for monthly/yoy (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date for monthly/yoy (YYYY-MM-DD)" default(current date)
+// @Param period query []string false "Custom periods (YYYY-MM-DD..YYYY-MM-DD), repeatable"
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.ComparativeProfitAndLossResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/profit-and-loss/comparative [get]
+func (h *reportingHandler) getComparativeProfitAndLoss(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getComparativeProfitAndLoss")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	periods, ok := parseComparativePeriods(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.Int("period_count", len(periods)),
+	)
+	logger.Info("Received request to generate comparative profit and loss report")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "comparative profit and loss report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.ComparativeProfitAndLoss(ctx, workplaceID, periods, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToComparativeProfitAndLossResponse(report), nil
+		})
+		return
+	}
+
+	report, err := h.reportingService.ComparativeProfitAndLoss(c.Request.Context(), workplaceID, periods, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access comparative profit and loss report")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrValidation) {
+			logger.Warn("Invalid comparative periods", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate comparative profit and loss report", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate comparative profit and loss report"})
+		}
+		return
+	}
+
+	response := dto.ToComparativeProfitAndLossResponse(report)
+
+	logger.Info("Comparative profit and loss report generated successfully")
+	c.JSON(http.StatusOK, response)
+}
+
+// getComparativeBalanceSheet godoc
+// @Summary Generate comparative balance sheet report
+// @Description Generates a balance sheet as of the end of each period with absolute and percentage variance against the previous column
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param compare query string false "Comparison mode" Enums(monthly, yoy, custom) default(monthly)
+// @Param fromDate query string false "Start date for monthly/yoy (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date for monthly/yoy (YYYY-MM-DD)" default(current date)
+// @Param period query []string false "Custom periods (YYYY-MM-DD..YYYY-MM-DD), repeatable; the end date is the as-of date"
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.ComparativeBalanceSheetResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/balance-sheet/comparative [get]
+func (h *reportingHandler) getComparativeBalanceSheet(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getComparativeBalanceSheet")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	periods, ok := parseComparativePeriods(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.Int("period_count", len(periods)),
+	)
+	logger.Info("Received request to generate comparative balance sheet report")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "comparative balance sheet report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.ComparativeBalanceSheet(ctx, workplaceID, periods, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToComparativeBalanceSheetResponse(report), nil
+		})
+		return
+	}
+
+	report, err := h.reportingService.ComparativeBalanceSheet(c.Request.Context(), workplaceID, periods, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access comparative balance sheet report")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrValidation) {
+			logger.Warn("Invalid comparative periods", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate comparative balance sheet report", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate comparative balance sheet report"})
+		}
+		return
+	}
+
+	response := dto.ToComparativeBalanceSheetResponse(report)
+
+	logger.Info("Comparative balance sheet report generated successfully")
+	c.JSON(http.StatusOK, response)
+}
+
+// getTimeSeries godoc
+// @Summary Generate time series report
+// @Description Returns per-bucket balances (cumulative mode, e.g. net worth) or flows (periodic mode, e.g. category spending) for the selected accounts, account types or parent account subtrees
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param interval query string false "Bucket width" Enums(day, week, month) default(month)
+// @Param mode query string false "Balances at the end of each bucket or flows within it" Enums(cumulative, periodic) default(cumulative)
+// @Param accountId query []string false "Account IDs to include (repeatable or comma-separated)"
+// @Param accountType query []string false "Account types to include (repeatable or comma-separated)"
+// @Param parentId query []string false "Parent account IDs whose subtrees are included (repeatable or comma-separated)"
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.TimeSeriesResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/time-series [get]
+func (h *reportingHandler) getTimeSeries(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getTimeSeries")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	from, to, ok := parseReportPeriod(c, logger)
+	if !ok {
+		return
+	}
+
+	interval := domain.TimeSeriesInterval(c.DefaultQuery("interval", string(domain.IntervalMonth)))
+	mode := domain.TimeSeriesMode(c.DefaultQuery("mode", string(domain.TimeSeriesCumulative)))
+	selection := domain.TimeSeriesSelection{
+		AccountIDs:       parseIDList(c, "accountId"),
+		ParentAccountIDs: parseIDList(c, "parentId"),
+	}
+	for _, accountType := range parseIDList(c, "accountType") {
+		selection.AccountTypes = append(selection.AccountTypes, domain.AccountType(strings.ToUpper(accountType)))
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.Time("fromDate", from),
+		slog.Time("toDate", to),
+		slog.String("interval", string(interval)),
+		slog.String("mode", string(mode)),
+	)
+	logger.Info("Received request to generate time series report")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "time series report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.TimeSeries(ctx, workplaceID, selection, from, to, interval, mode, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToTimeSeriesResponse(report), nil
+		})
+		return
+	}
+
+	report, err := h.reportingService.TimeSeries(c.Request.Context(), workplaceID, selection, from, to, interval, mode, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access time series report")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrValidation) {
+			logger.Warn("Invalid time series request", slog.String("error", err.Error()))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate time series report", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate time series report"})
+		}
+		return
+	}
+
+	response := dto.ToTimeSeriesResponse(report)
+
+	logger.Info("Time series report generated successfully", slog.Int("account_count", len(report.Lines)), slog.Int("bucket_count", len(report.Buckets)))
+	c.JSON(http.StatusOK, response)
+}
+
+// getPayeeReport godoc
+// @Summary Generate payee report
+// @Description Rolls up spend (net debits to expense accounts) and income (net credits to revenue accounts) per payee and currency for a period. A transaction's own payee takes precedence over its journal's; lines without a payee are reported as Unassigned.
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param payeeId query []string false "Restrict the report to these payee IDs (repeatable or comma-separated)"
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.PayeeReportResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/payees [get]
+func (h *reportingHandler) getPayeeReport(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getPayeeReport")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	from, to, ok := parseReportPeriod(c, logger)
+	if !ok {
+		return
+	}
+	payeeIDs := parseIDList(c, "payeeId")
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.Time("fromDate", from),
+		slog.Time("toDate", to),
+		slog.Int("requested_payees", len(payeeIDs)),
+	)
+	logger.Info("Received request to generate payee report")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "payee report", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.PayeeReport(ctx, workplaceID, payeeIDs, from, to, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToPayeeReportResponse(report, from, to), nil
+		})
+		return
+	}
+
+	report, err := h.reportingService.PayeeReport(c.Request.Context(), workplaceID, payeeIDs, from, to, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access payee report")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate payee report", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate payee report"})
+		}
+		return
+	}
+
+	response := dto.ToPayeeReportResponse(report, from, to)
+
+	logger.Info("Payee report generated successfully", slog.Int("row_count", len(report.Payees)))
+	c.JSON(http.StatusOK, response)
+}
+
+// getTaxReturnReport godoc
+// @Summary Generate tax return
+// @Description Summarizes, per tax code and currency, the taxable amounts and the tax collected and paid by journal lines split with a tax code in a period. Credit lines count as sales and output tax, debit lines as purchases and input tax; net tax is output tax minus input tax.
+// @Tags reports
+// @Produce json
+// @Param workplace_id path string true "Workplace ID"
+// @Param fromDate query string false "Start date (YYYY-MM-DD)" default(first day of current month)
+// @Param toDate query string false "End date (YYYY-MM-DD)" default(current date)
+// @Param dimensionValueId query []string false "Only include lines tagged with these dimension values (repeatable or comma-separated); values of one dimension are alternatives, dimensions narrow each other"
+// @Param groupBy query string false "Dimension ID to run the report once per value of, plus once for untagged lines; the response is then a dto.GroupedReportResponse"
+// @Success 200 {object} dto.TaxReturnResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden (User not authorized)"
+// @Failure 500 {object} map[string]string "Failed to generate report"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/reports/tax-return [get]
+func (h *reportingHandler) getTaxReturnReport(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID := c.Param("workplace_id")
+	if workplaceID == "" {
+		logger.Error("Workplace ID missing from path for getTaxReturnReport")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace ID required in path"})
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return
+	}
+
+	from, to, ok := parseReportPeriod(c, logger)
+	if !ok {
+		return
+	}
+
+	dimensions, ok := h.parseReportDimensions(c, logger, workplaceID, userID, export.FormatJSON)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(
+		slog.String("user_id", userID),
+		slog.String("workplace_id", workplaceID),
+		slog.Time("fromDate", from),
+		slog.Time("toDate", to),
+	)
+	logger.Info("Received request to generate tax return")
+
+	if dimensions.GroupBy != nil {
+		h.writeGroupedReport(c, logger, dimensions, "tax return", func(ctx context.Context, filters []domain.DimensionFilter) (any, error) {
+			report, err := h.reportingService.TaxReturnReport(ctx, workplaceID, from, to, filters, userID)
+			if err != nil {
+				return nil, err
+			}
+			return dto.ToTaxReturnResponse(report, from, to), nil
+		})
+		return
+	}
+
+	report, err := h.reportingService.TaxReturnReport(c.Request.Context(), workplaceID, from, to, dimensions.Filters, userID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrForbidden) {
+			logger.Warn("User forbidden to access tax return")
+			c.JSON(http.StatusForbidden, gin.H{"error": "You do not have permission to access this report"})
+		} else if errors.Is(err, apperrors.ErrNotFound) {
+			logger.Warn("Workplace not found")
+			c.JSON(http.StatusNotFound, gin.H{"error": "Workplace not found"})
+		} else {
+			logger.Error("Failed to generate tax return", slog.String("error", err.Error()))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate tax return"})
+		}
+		return
+	}
+
+	response := dto.ToTaxReturnResponse(report, from, to)
+
+	logger.Info("Tax return generated successfully", slog.Int("row_count", len(report.Lines)))
+	c.JSON(http.StatusOK, response)
+}
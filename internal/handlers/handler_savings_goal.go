@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// savingsGoalHandler handles HTTP requests related to savings goals.
+type savingsGoalHandler struct {
+	goalService portssvc.SavingsGoalSvcFacade
+}
+
+// newSavingsGoalHandler creates a new savingsGoalHandler.
+func newSavingsGoalHandler(gs portssvc.SavingsGoalSvcFacade) *savingsGoalHandler {
+	return &savingsGoalHandler{
+		goalService: gs,
+	}
+}
+
+// registerSavingsGoalRoutes registers routes related to savings goals WITHIN a workplace.
+func registerSavingsGoalRoutes(rg *gin.RouterGroup, goalService portssvc.SavingsGoalSvcFacade) {
+	h := newSavingsGoalHandler(goalService)
+
+	goals := rg.Group("/goals")
+	{
+		goals.POST("", h.createSavingsGoal)
+		goals.GET("", h.listSavingsGoals)
+		goals.GET("/:goal_id", h.getSavingsGoalProgress)
+		goals.PUT("/:goal_id", h.updateSavingsGoal)
+		goals.DELETE("/:goal_id", h.deleteSavingsGoal)
+	}
+}
+
+// goalPathParams reads the workplace and goal IDs and the calling user, writing an error response when missing
+func goalPathParams(c *gin.Context, logger *slog.Logger, needGoal bool) (workplaceID, goalID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	goalID = c.Param("goal_id")
+	if workplaceID == "" || (needGoal && goalID == "") {
+		logger.Error("Workplace ID or Goal ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Goal ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, goalID, userID, true
+}
+
+// writeSavingsGoalError maps a savings goal service error to an HTTP response
+func writeSavingsGoalError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Savings goal not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Savings goal not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createSavingsGoal godoc
+// @Summary Create savings goal
+// @Description Creates a savings goal with a target amount and date, linked to one or more ASSET accounts in the same currency
+// @Tags goals
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   goal body dto.CreateSavingsGoalRequest true "Savings goal details"
+// @Success 201 {object} dto.SavingsGoalResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to create savings goal"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/goals [post]
+func (h *savingsGoalHandler) createSavingsGoal(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := goalPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.CreateSavingsGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateSavingsGoal", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create savings goal", slog.String("name", req.Name))
+
+	goal, err := h.goalService.CreateSavingsGoal(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeSavingsGoalError(c, logger, err, "create savings goal")
+		return
+	}
+
+	logger.Info("Savings goal created successfully", slog.String("goal_id", goal.GoalID))
+	c.JSON(http.StatusCreated, dto.ToSavingsGoalResponse(goal))
+}
+
+// listSavingsGoals godoc
+// @Summary List savings goals
+// @Description Lists the savings goals of a workplace, nearest target date first
+// @Tags goals
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListSavingsGoalsResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list savings goals"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/goals [get]
+func (h *savingsGoalHandler) listSavingsGoals(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := goalPathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	goals, err := h.goalService.ListSavingsGoals(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeSavingsGoalError(c, logger, err, "list savings goals")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListSavingsGoalsResponse(goals))
+}
+
+// getSavingsGoalProgress godoc
+// @Summary Get savings goal progress
+// @Description Retrieves a savings goal with its current amount, required monthly contribution, projected completion date and status
+// @Tags goals
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   goal_id path string true "Goal ID"
+// @Param   trailingMonths query int false "Complete months averaged for the projection (default 3, max 24)"
+// @Success 200 {object} dto.SavingsGoalProgressResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Savings goal not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve savings goal"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/goals/{goal_id} [get]
+func (h *savingsGoalHandler) getSavingsGoalProgress(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, goalID, userID, ok := goalPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	trailingMonths := 0
+	if value := c.Query("trailingMonths"); value != "" {
+		var err error
+		if trailingMonths, err = strconv.Atoi(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid trailingMonths. Use a whole number of months"})
+			return
+		}
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("goal_id", goalID))
+
+	progress, err := h.goalService.GetSavingsGoalProgress(c.Request.Context(), workplaceID, goalID, trailingMonths, userID)
+	if err != nil {
+		writeSavingsGoalError(c, logger, err, "retrieve savings goal")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSavingsGoalProgressResponse(progress))
+}
+
+// updateSavingsGoal godoc
+// @Summary Update savings goal
+// @Description Updates a savings goal; linked accounts are replaced when given
+// @Tags goals
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   goal_id path string true "Goal ID"
+// @Param   goal body dto.UpdateSavingsGoalRequest true "Fields to update"
+// @Success 200 {object} dto.SavingsGoalResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Savings goal not found"
+// @Failure 500 {object} map[string]string "Failed to update savings goal"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/goals/{goal_id} [put]
+func (h *savingsGoalHandler) updateSavingsGoal(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, goalID, userID, ok := goalPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateSavingsGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateSavingsGoal", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("goal_id", goalID))
+	logger.Info("Received request to update savings goal")
+
+	goal, err := h.goalService.UpdateSavingsGoal(c.Request.Context(), workplaceID, goalID, req, userID)
+	if err != nil {
+		writeSavingsGoalError(c, logger, err, "update savings goal")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSavingsGoalResponse(goal))
+}
+
+// deleteSavingsGoal godoc
+// @Summary Delete savings goal
+// @Description Deletes a savings goal; the linked accounts are not affected
+// @Tags goals
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   goal_id path string true "Goal ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Savings goal not found"
+// @Failure 500 {object} map[string]string "Failed to delete savings goal"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/goals/{goal_id} [delete]
+func (h *savingsGoalHandler) deleteSavingsGoal(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, goalID, userID, ok := goalPathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("goal_id", goalID))
+	logger.Info("Received request to delete savings goal")
+
+	if err := h.goalService.DeleteSavingsGoal(c.Request.Context(), workplaceID, goalID, userID); err != nil {
+		writeSavingsGoalError(c, logger, err, "delete savings goal")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
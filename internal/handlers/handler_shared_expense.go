@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// sharedExpenseHandler handles HTTP requests for expenses shared between workplace members.
+type sharedExpenseHandler struct {
+	sharedExpenseService portssvc.SharedExpenseSvcFacade
+}
+
+// newSharedExpenseHandler creates a new sharedExpenseHandler.
+func newSharedExpenseHandler(ss portssvc.SharedExpenseSvcFacade) *sharedExpenseHandler {
+	return &sharedExpenseHandler{
+		sharedExpenseService: ss,
+	}
+}
+
+// registerSharedExpenseRoutes registers routes for shared expenses WITHIN a workplace.
+func registerSharedExpenseRoutes(rg *gin.RouterGroup, sharedExpenseService portssvc.SharedExpenseSvcFacade) {
+	h := newSharedExpenseHandler(sharedExpenseService)
+
+	sharedExpenses := rg.Group("/shared-expenses")
+	{
+		sharedExpenses.POST("", h.shareExpense)
+		sharedExpenses.GET("", h.listSharedExpenses)
+		sharedExpenses.GET("/balances", h.getMemberBalances)
+		sharedExpenses.POST("/settle-up", h.settleUp)
+		sharedExpenses.GET("/:shared_expense_id", h.getSharedExpense)
+		sharedExpenses.DELETE("/:shared_expense_id", h.unshareExpense)
+	}
+}
+
+// sharedExpensePathParams reads the workplace and shared expense IDs and the calling user, writing an error response when missing
+func sharedExpensePathParams(c *gin.Context, logger *slog.Logger, needExpense bool) (workplaceID, sharedExpenseID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	sharedExpenseID = c.Param("shared_expense_id")
+	if workplaceID == "" || (needExpense && sharedExpenseID == "") {
+		logger.Error("Workplace ID or Shared Expense ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Shared Expense ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, sharedExpenseID, userID, true
+}
+
+// writeSharedExpenseError maps a shared expense service error to an HTTP response
+func writeSharedExpenseError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Shared expense or journal not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Shared expense or journal not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// shareExpense godoc
+// @Summary Share an expense between members
+// @Description Splits the net expense amount of a journal between workplace members (EQUAL, PERCENTAGE or EXACT) and posts a journal debiting each other member's receivable sub-account and crediting the payer's payable sub-account. Member sub-accounts are created on first use.
+// @Tags shared-expenses
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   share body dto.ShareExpenseRequest true "Expense journal, payer and shares"
+// @Success 201 {object} dto.SharedExpenseResponse
+// @Failure 400 {object} map[string]string "Invalid input, shares that do not add up or a non-member"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Journal not found"
+// @Failure 409 {object} map[string]string "Journal already shared"
+// @Failure 500 {object} map[string]string "Failed to share expense"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/shared-expenses [post]
+func (h *sharedExpenseHandler) shareExpense(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := sharedExpensePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.ShareExpenseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for ShareExpense", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to share expense", slog.String("journal_id", req.JournalID))
+
+	expense, err := h.sharedExpenseService.ShareExpense(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeSharedExpenseError(c, logger, err, "share expense")
+		return
+	}
+
+	logger.Info("Expense shared successfully", slog.String("shared_expense_id", expense.SharedExpenseID))
+	c.JSON(http.StatusCreated, dto.ToSharedExpenseResponse(expense))
+}
+
+// listSharedExpenses godoc
+// @Summary List shared expenses
+// @Description Lists the shared expenses of a workplace with their shares, newest first
+// @Tags shared-expenses
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.ListSharedExpensesResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list shared expenses"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/shared-expenses [get]
+func (h *sharedExpenseHandler) listSharedExpenses(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := sharedExpensePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	expenses, err := h.sharedExpenseService.ListSharedExpenses(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeSharedExpenseError(c, logger, err, "list shared expenses")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListSharedExpensesResponse(expenses))
+}
+
+// getMemberBalances godoc
+// @Summary Get member balances
+// @Description Shows what each member owes and is owed per currency, from their receivable and payable sub-accounts, and the repayments that would settle all balances
+// @Tags shared-expenses
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Success 200 {object} dto.MemberBalancesResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to retrieve member balances"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/shared-expenses/balances [get]
+func (h *sharedExpenseHandler) getMemberBalances(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := sharedExpensePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	balances, err := h.sharedExpenseService.GetMemberBalances(c.Request.Context(), workplaceID, userID)
+	if err != nil {
+		writeSharedExpenseError(c, logger, err, "retrieve member balances")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToMemberBalancesResponse(balances))
+}
+
+// settleUp godoc
+// @Summary Settle up between members
+// @Description Posts a journal recording a repayment from one member to another: it debits the payable sub-account of the member repaid and credits the receivable sub-account of the member repaying
+// @Tags shared-expenses
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   settlement body dto.SettleUpRequest true "Members, amount and currency of the repayment"
+// @Success 201 {object} dto.JournalResponse
+// @Failure 400 {object} map[string]string "Invalid input or a non-member"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to settle up"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/shared-expenses/settle-up [post]
+func (h *sharedExpenseHandler) settleUp(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := sharedExpensePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.SettleUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for SettleUp", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to settle up", slog.String("from_user_id", req.FromUserID), slog.String("to_user_id", req.ToUserID))
+
+	journal, err := h.sharedExpenseService.SettleUp(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeSharedExpenseError(c, logger, err, "settle up")
+		return
+	}
+
+	logger.Info("Settle-up journal posted", slog.String("journal_id", journal.JournalID))
+	c.JSON(http.StatusCreated, dto.ToJournalResponse(journal))
+}
+
+// getSharedExpense godoc
+// @Summary Get shared expense
+// @Description Retrieves a shared expense with its shares
+// @Tags shared-expenses
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   shared_expense_id path string true "Shared Expense ID"
+// @Success 200 {object} dto.SharedExpenseResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Shared expense not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve shared expense"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/shared-expenses/{shared_expense_id} [get]
+func (h *sharedExpenseHandler) getSharedExpense(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, sharedExpenseID, userID, ok := sharedExpensePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("shared_expense_id", sharedExpenseID))
+
+	expense, err := h.sharedExpenseService.GetSharedExpense(c.Request.Context(), workplaceID, sharedExpenseID, userID)
+	if err != nil {
+		writeSharedExpenseError(c, logger, err, "retrieve shared expense")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToSharedExpenseResponse(expense))
+}
+
+// unshareExpense godoc
+// @Summary Unshare an expense
+// @Description Reverses the share journal of a shared expense and removes it; the expense journal itself is untouched
+// @Tags shared-expenses
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   shared_expense_id path string true "Shared Expense ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Shared expense not found"
+// @Failure 500 {object} map[string]string "Failed to unshare expense"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/shared-expenses/{shared_expense_id} [delete]
+func (h *sharedExpenseHandler) unshareExpense(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, sharedExpenseID, userID, ok := sharedExpensePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("shared_expense_id", sharedExpenseID))
+	logger.Info("Received request to unshare expense")
+
+	if err := h.sharedExpenseService.UnshareExpense(c.Request.Context(), workplaceID, sharedExpenseID, userID); err != nil {
+		writeSharedExpenseError(c, logger, err, "unshare expense")
+		return
+	}
+
+	logger.Info("Expense unshared successfully")
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// taxCodeHandler handles HTTP requests for tax codes.
+type taxCodeHandler struct {
+	taxCodeService portssvc.TaxCodeSvcFacade
+}
+
+// newTaxCodeHandler creates a new taxCodeHandler.
+func newTaxCodeHandler(ts portssvc.TaxCodeSvcFacade) *taxCodeHandler {
+	return &taxCodeHandler{
+		taxCodeService: ts,
+	}
+}
+
+// registerTaxCodeRoutes registers routes for tax codes WITHIN a workplace.
+func registerTaxCodeRoutes(rg *gin.RouterGroup, taxCodeService portssvc.TaxCodeSvcFacade) {
+	h := newTaxCodeHandler(taxCodeService)
+
+	taxCodes := rg.Group("/tax-codes")
+	{
+		taxCodes.POST("", h.createTaxCode)
+		taxCodes.GET("", h.listTaxCodes)
+		taxCodes.GET("/:tax_code_id", h.getTaxCode)
+		taxCodes.PUT("/:tax_code_id", h.updateTaxCode)
+		taxCodes.DELETE("/:tax_code_id", h.deleteTaxCode)
+	}
+}
+
+// taxCodePathParams reads the workplace and tax code IDs and the calling user, writing an error response when missing
+func taxCodePathParams(c *gin.Context, logger *slog.Logger, needTaxCode bool) (workplaceID, taxCodeID, userID string, ok bool) {
+	workplaceID = c.Param("workplace_id")
+	taxCodeID = c.Param("tax_code_id")
+	if workplaceID == "" || (needTaxCode && taxCodeID == "") {
+		logger.Error("Workplace ID or Tax Code ID missing from path")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Workplace and Tax Code ID required in path"})
+		return "", "", "", false
+	}
+
+	userID, ok = middleware.GetUserIDFromContext(c)
+	if !ok {
+		logger.Error("User ID not found in context")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+		return "", "", "", false
+	}
+	return workplaceID, taxCodeID, userID, true
+}
+
+// writeTaxCodeError maps a tax code service error to an HTTP response
+func writeTaxCodeError(c *gin.Context, logger *slog.Logger, err error, action string) {
+	if errors.Is(err, apperrors.ErrForbidden) {
+		logger.Warn("User forbidden to " + action)
+		c.JSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+	} else if errors.Is(err, apperrors.ErrValidation) {
+		logger.Warn("Validation error trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrConflict) {
+		logger.Warn("Conflict trying to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	} else if errors.Is(err, apperrors.ErrNotFound) {
+		logger.Warn("Tax code not found (or in wrong workplace)")
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tax code not found"})
+	} else {
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to " + action})
+	}
+}
+
+// createTaxCode godoc
+// @Summary Create tax code
+// @Description Creates a VAT/GST tax code with a rate, the ASSET or LIABILITY account tax is posted to, and whether amounts entered against it include the tax. Journal lines naming the code are split into net and tax lines.
+// @Tags tax-codes
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   taxCode body dto.TaxCodeRequest true "Tax code details"
+// @Success 201 {object} dto.TaxCodeResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 409 {object} map[string]string "Code already used by another tax code"
+// @Failure 500 {object} map[string]string "Failed to create tax code"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/tax-codes [post]
+func (h *taxCodeHandler) createTaxCode(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := taxCodePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var req dto.TaxCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for CreateTaxCode", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+	logger.Info("Received request to create tax code", slog.String("code", req.Code))
+
+	taxCode, err := h.taxCodeService.CreateTaxCode(c.Request.Context(), workplaceID, req, userID)
+	if err != nil {
+		writeTaxCodeError(c, logger, err, "create tax code")
+		return
+	}
+
+	logger.Info("Tax code created successfully", slog.String("tax_code_id", taxCode.TaxCodeID))
+	c.JSON(http.StatusCreated, dto.ToTaxCodeResponse(taxCode))
+}
+
+// listTaxCodes godoc
+// @Summary List tax codes
+// @Description Lists the tax codes of a workplace ordered by code
+// @Tags tax-codes
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   includeInactive query bool false "Include deactivated tax codes"
+// @Success 200 {object} dto.ListTaxCodesResponse
+// @Failure 400 {object} map[string]string "Invalid query parameters"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 500 {object} map[string]string "Failed to list tax codes"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/tax-codes [get]
+func (h *taxCodeHandler) listTaxCodes(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, _, userID, ok := taxCodePathParams(c, logger, false)
+	if !ok {
+		return
+	}
+
+	var params dto.ListTaxCodesParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		logger.Warn("Failed to bind query params for ListTaxCodes", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid query parameters: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID))
+
+	taxCodes, err := h.taxCodeService.ListTaxCodes(c.Request.Context(), workplaceID, params, userID)
+	if err != nil {
+		writeTaxCodeError(c, logger, err, "list tax codes")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToListTaxCodesResponse(taxCodes))
+}
+
+// getTaxCode godoc
+// @Summary Get tax code
+// @Description Retrieves a tax code
+// @Tags tax-codes
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   tax_code_id path string true "Tax code ID"
+// @Success 200 {object} dto.TaxCodeResponse
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Tax code not found"
+// @Failure 500 {object} map[string]string "Failed to retrieve tax code"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/tax-codes/{tax_code_id} [get]
+func (h *taxCodeHandler) getTaxCode(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, taxCodeID, userID, ok := taxCodePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("tax_code_id", taxCodeID))
+
+	taxCode, err := h.taxCodeService.GetTaxCode(c.Request.Context(), workplaceID, taxCodeID, userID)
+	if err != nil {
+		writeTaxCodeError(c, logger, err, "retrieve tax code")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToTaxCodeResponse(taxCode))
+}
+
+// updateTaxCode godoc
+// @Summary Update tax code
+// @Description Replaces the code, name, rate, tax account, inclusive flag and active flag of a tax code. Journals already posted keep their tax lines.
+// @Tags tax-codes
+// @Accept  json
+// @Produce  json
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   tax_code_id path string true "Tax code ID"
+// @Param   taxCode body dto.TaxCodeRequest true "Tax code details"
+// @Success 200 {object} dto.TaxCodeResponse
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Tax code not found"
+// @Failure 409 {object} map[string]string "Code already used by another tax code"
+// @Failure 500 {object} map[string]string "Failed to update tax code"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/tax-codes/{tax_code_id} [put]
+func (h *taxCodeHandler) updateTaxCode(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, taxCodeID, userID, ok := taxCodePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	var req dto.TaxCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Failed to bind JSON for UpdateTaxCode", slog.String("error", err.Error()))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format: " + err.Error()})
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("tax_code_id", taxCodeID))
+	logger.Info("Received request to update tax code")
+
+	taxCode, err := h.taxCodeService.UpdateTaxCode(c.Request.Context(), workplaceID, taxCodeID, req, userID)
+	if err != nil {
+		writeTaxCodeError(c, logger, err, "update tax code")
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ToTaxCodeResponse(taxCode))
+}
+
+// deleteTaxCode godoc
+// @Summary Delete tax code
+// @Description Deletes a tax code that no transaction references; deactivate used tax codes instead
+// @Tags tax-codes
+// @Param   workplace_id path string true "Workplace ID"
+// @Param   tax_code_id path string true "Tax code ID"
+// @Success 204 "No Content"
+// @Failure 401 {object} map[string]string "Unauthorized"
+// @Failure 403 {object} map[string]string "Forbidden"
+// @Failure 404 {object} map[string]string "Tax code not found"
+// @Failure 409 {object} map[string]string "Tax code is used by transactions"
+// @Failure 500 {object} map[string]string "Failed to delete tax code"
+// @Security BearerAuth
+// @Router /workplaces/{workplace_id}/tax-codes/{tax_code_id} [delete]
+func (h *taxCodeHandler) deleteTaxCode(c *gin.Context) {
+	logger := middleware.GetLoggerFromCtx(c.Request.Context())
+	workplaceID, taxCodeID, userID, ok := taxCodePathParams(c, logger, true)
+	if !ok {
+		return
+	}
+
+	logger = logger.With(slog.String("user_id", userID), slog.String("workplace_id", workplaceID), slog.String("tax_code_id", taxCodeID))
+	logger.Info("Received request to delete tax code")
+
+	if err := h.taxCodeService.DeleteTaxCode(c.Request.Context(), workplaceID, taxCodeID, userID); err != nil {
+		writeTaxCodeError(c, logger, err, "delete tax code")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -26,14 +26,9 @@ func newWorkplaceHandler(ws portssvc.WorkplaceSvcFacade) *workplaceHandler {
 
 // registerWorkplaceRoutes registers routes related to workplaces and their members.
 // It now also registers JOURNAL and ACCOUNT routes nested under a specific workplace.
-func registerWorkplaceRoutes(
-	router *gin.RouterGroup,
-	workplaceService portssvc.WorkplaceSvcFacade,
-	journalService portssvc.JournalSvcFacade,
-	accountService portssvc.AccountSvcFacade,
-	reportingService portssvc.ReportingService,
-) {
-	h := newWorkplaceHandler(workplaceService)
+func registerWorkplaceRoutes(router *gin.RouterGroup, services *portssvc.ServiceContainer) {
+	h := newWorkplaceHandler(services.Workplace)
+	exporter := newReportExporter(services.Workplace, services.Currency)
 
 	// Routes for managing workplaces themselves (e.g., creating, listing user's workplaces)
 	workplacesTopLevel := router.Group("/workplaces")
@@ -60,15 +55,72 @@ func registerWorkplaceRoutes(
 
 		// -- NESTED JOURNAL ROUTES --
 		// Register journal routes relative to this specific workplace group
-		registerJournalRoutes(workplaceSpecific, journalService) // Pass the group and service
+		registerJournalRoutes(workplaceSpecific, services.Journal, exporter) // Pass the group and service
 
 		// -- NESTED ACCOUNT ROUTES --
 		// Register account routes relative to this specific workplace group
-		RegisterAccountRoutes(workplaceSpecific, accountService, journalService) // Use exported name (no package needed)
+		RegisterAccountRoutes(workplaceSpecific, services.Account, services.Journal, services.Workplace, services.Currency) // Use exported name (no package needed)
 
 		// -- NESTED REPORTING ROUTES --
 		// Register reporting routes relative to this specific workplace group
-		registerReportingRoutes(workplaceSpecific, reportingService)
+		registerReportingRoutes(workplaceSpecific, services.Reporting, exporter)
+
+		// -- NESTED BUDGET ROUTES --
+		registerBudgetRoutes(workplaceSpecific, services.Budget)
+
+		// -- NESTED ENVELOPE ROUTES --
+		registerEnvelopeRoutes(workplaceSpecific, services.Envelope)
+
+		// -- NESTED SAVINGS GOAL ROUTES --
+		registerSavingsGoalRoutes(workplaceSpecific, services.SavingsGoal)
+
+		// -- NESTED BANK IMPORT ROUTES --
+		registerBankStatementRoutes(workplaceSpecific, services.BankStatement)
+
+		// -- NESTED CSV IMPORT ROUTES --
+		registerCSVImportRoutes(workplaceSpecific, services.CSVImport)
+
+		// -- NESTED CATEGORIZATION RULE ROUTES --
+		registerCategorizationRoutes(workplaceSpecific, services.Categorization)
+
+		// -- NESTED RECONCILIATION ROUTES --
+		registerReconciliationRoutes(workplaceSpecific, services.Reconciliation)
+
+		// -- NESTED DUPLICATE JOURNAL ROUTES --
+		registerDuplicateJournalRoutes(workplaceSpecific, services.DuplicateJournal)
+
+		// -- NESTED PAYEE ROUTES --
+		registerPayeeRoutes(workplaceSpecific, services.Payee)
+
+		// -- NESTED SHARED EXPENSE ROUTES --
+		registerSharedExpenseRoutes(workplaceSpecific, services.SharedExpense)
+
+		// -- NESTED INVESTMENT ROUTES --
+		registerInvestmentRoutes(workplaceSpecific, services.Investment)
+
+		// -- NESTED LOAN ROUTES --
+		registerLoanRoutes(workplaceSpecific, services.Loan)
+
+		// -- NESTED CREDIT CARD ROUTES --
+		registerCreditCardRoutes(workplaceSpecific, services.CreditCard)
+
+		// -- NESTED TAX CODE ROUTES --
+		registerTaxCodeRoutes(workplaceSpecific, services.TaxCode)
+
+		// -- NESTED INVOICE ROUTES --
+		registerInvoiceRoutes(workplaceSpecific, services.Invoice)
+
+		// -- NESTED BILL ROUTES --
+		registerBillRoutes(workplaceSpecific, services.Bill)
+
+		// -- NESTED DIMENSION ROUTES --
+		registerDimensionRoutes(workplaceSpecific, services.Dimension)
+
+		// -- NESTED CONSOLIDATION ROUTES --
+		registerConsolidationRoutes(workplaceSpecific, services.Consolidation)
+
+		// -- NESTED INTERCOMPANY JOURNAL ROUTES --
+		registerIntercompanyJournalRoutes(workplaceSpecific, services.Journal)
 	}
 }
 
@@ -54,7 +54,8 @@ func setupAPIV1Routes(
 	registerUserRoutes(v1, service.User)
 	registerCurrencyRoutes(v1, service.Currency)
 	registerExchangeRateRoutes(v1, service.ExchangeRate)
-	registerWorkplaceRoutes(v1, service.Workplace, service.Journal, service.Account, service.Reporting)
+	registerPriceRoutes(v1, service.Price)
+	registerWorkplaceRoutes(v1, service)
 }
 
 // setupSwaggerRoutes configures the swagger documentation routes
@@ -0,0 +1,296 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portssvc "github.com/SscSPs/money_managemet_app/internal/core/ports/services"
+	"github.com/SscSPs/money_managemet_app/internal/dto"
+	"github.com/SscSPs/money_managemet_app/internal/utils"
+	"github.com/SscSPs/money_managemet_app/internal/utils/export"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// defaultExportPrecision is used when a currency cannot be resolved while exporting
+const defaultExportPrecision = 2
+
+// exportPageSize is the page size used when paging through lists for an export
+const exportPageSize = 100
+
+// reportExporter writes report and list data as downloadable CSV, XLSX or PDF files.
+// It resolves the workplace name and currency precisions the files need.
+type reportExporter struct {
+	workplaceService portssvc.WorkplaceReaderSvc
+	currencyService  portssvc.CurrencyReaderSvc
+}
+
+// newReportExporter creates a new reportExporter
+func newReportExporter(ws portssvc.WorkplaceReaderSvc, cs portssvc.CurrencyReaderSvc) *reportExporter {
+	return &reportExporter{
+		workplaceService: ws,
+		currencyService:  cs,
+	}
+}
+
+// requestedExportFormat resolves the export format from the format query parameter or the Accept header.
+// It writes a 400 response and returns false for unsupported formats.
+func requestedExportFormat(c *gin.Context, logger *slog.Logger) (export.Format, bool) {
+	format, err := export.ResolveFormat(c.Query("format"), c.GetHeader("Accept"))
+	if err != nil {
+		logger.Warn("Unsupported export format", slog.String("format", c.Query("format")))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported format. Use json, csv, xlsx or pdf"})
+		return "", false
+	}
+	return format, true
+}
+
+// amountFormatter formats amounts with the precision of their currency, caching currency lookups
+type amountFormatter struct {
+	ctx        context.Context
+	service    portssvc.CurrencyReaderSvc
+	currencies map[string]domain.Currency
+}
+
+// format formats an amount using utils.FormatWithCurrencyPrecision
+func (f *amountFormatter) format(amount decimal.Decimal, currencyCode string) string {
+	currency, ok := f.currencies[currencyCode]
+	if !ok {
+		currency = domain.Currency{CurrencyCode: currencyCode, Precision: defaultExportPrecision}
+		if f.service != nil && currencyCode != "" {
+			if found, err := f.service.GetCurrencyByCode(f.ctx, currencyCode); err == nil && found != nil {
+				currency = *found
+			}
+		}
+		f.currencies[currencyCode] = currency
+	}
+	return utils.FormatWithCurrencyPrecision(amount, currency)
+}
+
+// exportSession holds what is needed while streaming one export
+type exportSession struct {
+	amounts       *amountFormatter
+	workplaceName string
+	currencyCode  string // Workplace default currency, used for report amounts
+}
+
+// begin resolves the workplace details for an export. Lookup failures fall back to the workplace ID.
+func (e *reportExporter) begin(ctx context.Context, workplaceID string) *exportSession {
+	session := &exportSession{
+		amounts:       &amountFormatter{ctx: ctx, service: e.currencyService, currencies: make(map[string]domain.Currency)},
+		workplaceName: workplaceID,
+	}
+	if e.workplaceService != nil {
+		if workplace, err := e.workplaceService.FindWorkplaceByID(ctx, workplaceID); err == nil && workplace != nil {
+			session.workplaceName = workplace.Name
+			if workplace.DefaultCurrencyCode != nil {
+				session.currencyCode = *workplace.DefaultCurrencyCode
+			}
+		}
+	}
+	return session
+}
+
+// stream sends the file headers and streams rows produced by fill directly to the response
+func (e *reportExporter) stream(c *gin.Context, logger *slog.Logger, format export.Format, fileName string, meta export.Metadata, columns []export.Column, fill func(w export.Writer) error) {
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", `attachment; filename="`+format.FileName(fileName)+`"`)
+	c.Status(http.StatusOK)
+
+	meta.GeneratedAt = time.Now()
+	w, err := export.NewWriter(format, c.Writer, meta, columns)
+	if err == nil {
+		err = fill(w)
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil {
+		// Headers are already sent; the client receives a truncated file
+		logger.Error("Failed to stream export", slog.String("format", string(format)), slog.String("error", err.Error()))
+		return
+	}
+	logger.Info("Export streamed successfully", slog.String("format", string(format)), slog.String("file", fileName))
+}
+
+// exportTrialBalance streams a trial balance report
+func (e *reportExporter) exportTrialBalance(c *gin.Context, logger *slog.Logger, format export.Format, workplaceID string, report dto.TrialBalanceResponse) {
+	session := e.begin(c.Request.Context(), workplaceID)
+	money := func(amount decimal.Decimal) string { return session.amounts.format(amount, session.currencyCode) }
+
+	meta := export.Metadata{Title: "Trial Balance", Workplace: session.workplaceName, Period: "As of " + report.AsOf}
+	columns := []export.Column{
+		{Title: "Account ID", Width: 36},
+		{Title: "Account", Width: 40},
+		{Title: "Type", Width: 10},
+		{Title: "Debit", Numeric: true, Width: 20},
+		{Title: "Credit", Numeric: true, Width: 20},
+	}
+
+	e.stream(c, logger, format, "trial-balance-"+report.AsOf, meta, columns, func(w export.Writer) error {
+		for _, row := range report.Rows {
+			if err := w.WriteRow(row.AccountID, row.AccountName, row.AccountType, money(row.Debit), money(row.Credit)); err != nil {
+				return err
+			}
+		}
+		return w.WriteRow("", "Total", "", money(report.Totals.Debit), money(report.Totals.Credit))
+	})
+}
+
+// exportProfitAndLoss streams a profit and loss report
+func (e *reportExporter) exportProfitAndLoss(c *gin.Context, logger *slog.Logger, format export.Format, workplaceID string, report dto.ProfitAndLossResponse) {
+	session := e.begin(c.Request.Context(), workplaceID)
+	money := func(amount decimal.Decimal) string { return session.amounts.format(amount, session.currencyCode) }
+
+	meta := export.Metadata{Title: "Profit and Loss", Workplace: session.workplaceName, Period: report.FromDate + " to " + report.ToDate}
+	columns := []export.Column{
+		{Title: "Section", Width: 12},
+		{Title: "Account ID", Width: 36},
+		{Title: "Account", Width: 50},
+		{Title: "Amount", Numeric: true, Width: 20},
+	}
+
+	e.stream(c, logger, format, "profit-and-loss-"+report.FromDate+"-"+report.ToDate, meta, columns, func(w export.Writer) error {
+		sections := []struct {
+			name  string
+			lines []dto.AccountAmountResponse
+			total decimal.Decimal
+		}{
+			{"Revenue", report.Revenue, report.Summary.TotalRevenue},
+			{"Expenses", report.Expenses, report.Summary.TotalExpenses},
+		}
+		for _, section := range sections {
+			for _, line := range section.lines {
+				if err := w.WriteRow(section.name, line.AccountID, line.Name, money(line.Amount)); err != nil {
+					return err
+				}
+			}
+			if err := w.WriteRow(section.name, "", "Total "+section.name, money(section.total)); err != nil {
+				return err
+			}
+		}
+		return w.WriteRow("", "", "Net Profit", money(report.Summary.NetProfit))
+	})
+}
+
+// exportBalanceSheet streams a balance sheet report
+func (e *reportExporter) exportBalanceSheet(c *gin.Context, logger *slog.Logger, format export.Format, workplaceID string, report dto.BalanceSheetResponse) {
+	session := e.begin(c.Request.Context(), workplaceID)
+	money := func(amount decimal.Decimal) string { return session.amounts.format(amount, session.currencyCode) }
+
+	meta := export.Metadata{Title: "Balance Sheet", Workplace: session.workplaceName, Period: "As of " + report.AsOf}
+	columns := []export.Column{
+		{Title: "Section", Width: 12},
+		{Title: "Account ID", Width: 36},
+		{Title: "Account", Width: 50},
+		{Title: "Amount", Numeric: true, Width: 20},
+	}
+
+	e.stream(c, logger, format, "balance-sheet-"+report.AsOf, meta, columns, func(w export.Writer) error {
+		sections := []struct {
+			name  string
+			lines []dto.AccountAmountResponse
+			total decimal.Decimal
+		}{
+			{"Assets", report.Assets, report.Summary.TotalAssets},
+			{"Liabilities", report.Liabilities, report.Summary.TotalLiabilities},
+			{"Equity", report.Equity, report.Summary.TotalEquity},
+		}
+		for _, section := range sections {
+			for _, line := range section.lines {
+				if err := w.WriteRow(section.name, line.AccountID, line.Name, money(line.Amount)); err != nil {
+					return err
+				}
+			}
+			if err := w.WriteRow(section.name, "", "Total "+section.name, money(section.total)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// exportJournals streams every journal of a workplace, fetching them page by page
+func (e *reportExporter) exportJournals(c *gin.Context, logger *slog.Logger, format export.Format, workplaceID string, firstPage *dto.ListJournalsResponse, nextPage func(nextToken *string) (*dto.ListJournalsResponse, error)) {
+	session := e.begin(c.Request.Context(), workplaceID)
+
+	meta := export.Metadata{Title: "Journals", Workplace: session.workplaceName, Period: "All dates"}
+	columns := []export.Column{
+		{Title: "Date", Width: 10},
+		{Title: "Journal ID", Width: 36},
+		{Title: "Description", Width: 50},
+		{Title: "Status", Width: 9},
+		{Title: "Currency", Width: 8},
+		{Title: "Amount", Numeric: true, Width: 20},
+	}
+
+	e.stream(c, logger, format, "journals-"+time.Now().Format("2006-01-02"), meta, columns, func(w export.Writer) error {
+		page := firstPage
+		for {
+			for _, j := range page.Journals {
+				if err := w.WriteRow(j.Date.Format("2006-01-02"), j.JournalID, j.Description, string(j.Status), j.CurrencyCode, session.amounts.format(j.Amount, j.CurrencyCode)); err != nil {
+					return err
+				}
+			}
+			if page.NextToken == nil || *page.NextToken == "" {
+				return nil
+			}
+			var err error
+			if page, err = nextPage(page.NextToken); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// exportAccountTransactions streams every transaction of an account, fetching them page by page
+func (e *reportExporter) exportAccountTransactions(c *gin.Context, logger *slog.Logger, format export.Format, workplaceID, accountName string, firstPage *dto.ListTransactionsResponse, nextPage func(nextToken *string) (*dto.ListTransactionsResponse, error)) {
+	session := e.begin(c.Request.Context(), workplaceID)
+
+	meta := export.Metadata{Title: "Account Transactions - " + accountName, Workplace: session.workplaceName, Period: "All dates"}
+	columns := []export.Column{
+		{Title: "Date", Width: 10},
+		{Title: "Journal Date", Width: 12},
+		{Title: "Description", Width: 40},
+		{Title: "Notes", Width: 30},
+		{Title: "Debit", Numeric: true, Width: 18},
+		{Title: "Credit", Numeric: true, Width: 18},
+		{Title: "Balance", Numeric: true, Width: 18},
+	}
+
+	e.stream(c, logger, format, "account-transactions-"+time.Now().Format("2006-01-02"), meta, columns, func(w export.Writer) error {
+		page := firstPage
+		for {
+			for _, t := range page.Transactions {
+				debit, credit := "", ""
+				amount := session.amounts.format(t.Amount, t.CurrencyCode)
+				if t.TransactionType == domain.Debit {
+					debit = amount
+				} else {
+					credit = amount
+				}
+				if err := w.WriteRow(
+					t.TransactionDate.Format("2006-01-02"),
+					t.JournalDate.Format("2006-01-02"),
+					t.JournalDescription,
+					t.Notes,
+					debit,
+					credit,
+					session.amounts.format(t.RunningBalance, t.CurrencyCode),
+				); err != nil {
+					return err
+				}
+			}
+			if page.NextToken == nil || *page.NextToken == "" {
+				return nil
+			}
+			var err error
+			if page, err = nextPage(page.NextToken); err != nil {
+				return err
+			}
+		}
+	})
+}
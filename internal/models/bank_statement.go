@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BankStatementImport represents a row of the bank_statement_imports table
+type BankStatementImport struct {
+	ImportID    string `db:"import_id"`
+	WorkplaceID string `db:"workplace_id"`
+	AccountID   string `db:"account_id"`
+	Format      string `db:"file_format"`
+	FileName    string `db:"file_name"` // Nullable
+	TotalLines  int    `db:"total_lines"`
+	NewLines    int    `db:"new_lines"`
+	AuditFields
+}
+
+// BankStatementLine represents a row of the bank_statement_lines table
+type BankStatementLine struct {
+	LineID        string          `db:"line_id"`
+	WorkplaceID   string          `db:"workplace_id"`
+	AccountID     string          `db:"account_id"`
+	ImportID      string          `db:"import_id"`
+	BankReference string          `db:"bank_reference"`
+	Date          time.Time       `db:"line_date"`
+	Amount        decimal.Decimal `db:"amount"`
+	Payee         string          `db:"payee"` // Nullable
+	Memo          string          `db:"memo"`  // Nullable
+	Status        string          `db:"status"`
+	JournalID     string          `db:"journal_id"` // Nullable
+	// Categorization columns, all nullable
+	CounterAccountID string `db:"counter_account_id"`
+	Description      string `db:"journal_description"`
+	Notes            string `db:"notes"`
+	MatchedRuleID    string `db:"matched_rule_id"`
+	AuditFields
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Bill represents a row of the bills table
+type Bill struct {
+	BillID           string          `db:"bill_id"`
+	WorkplaceID      string          `db:"workplace_id"`
+	VendorID         string          `db:"vendor_id"`
+	PayableAccountID string          `db:"payable_account_id"`
+	Reference        string          `db:"reference"` // Nullable
+	BillDate         time.Time       `db:"bill_date"`
+	DueDate          time.Time       `db:"due_date"`
+	CurrencyCode     string          `db:"currency_code"`
+	Notes            string          `db:"notes"` // Nullable
+	Subtotal         decimal.Decimal `db:"subtotal"`
+	TaxTotal         decimal.Decimal `db:"tax_total"`
+	Total            decimal.Decimal `db:"total"`
+	PostJournalID    string          `db:"post_journal_id"` // Nullable
+	AuditFields
+}
+
+// BillLine represents a row of the bill_lines table
+type BillLine struct {
+	LineID           string          `db:"line_id"`
+	BillID           string          `db:"bill_id"`
+	LineNumber       int             `db:"line_number"`
+	Description      string          `db:"description"`
+	ExpenseAccountID string          `db:"expense_account_id"`
+	TaxCodeID        string          `db:"tax_code_id"` // Nullable
+	Amount           decimal.Decimal `db:"amount"`
+	NetAmount        decimal.Decimal `db:"net_amount"`
+	TaxAmount        decimal.Decimal `db:"tax_amount"`
+}
+
+// BillPayment represents a row of the bill_payments table
+type BillPayment struct {
+	PaymentID          string          `db:"payment_id"`
+	BillID             string          `db:"bill_id"`
+	JournalID          string          `db:"journal_id"`
+	PaymentAccountID   string          `db:"payment_account_id"`
+	ScheduledPaymentID string          `db:"scheduled_payment_id"` // Nullable
+	PaymentDate        time.Time       `db:"payment_date"`
+	Amount             decimal.Decimal `db:"amount"`
+	CreatedAt          time.Time       `db:"created_at"`
+	CreatedBy          string          `db:"created_by"`
+}
+
+// ScheduledBillPayment represents a row of the bill_scheduled_payments table
+type ScheduledBillPayment struct {
+	ScheduledPaymentID string          `db:"scheduled_payment_id"`
+	BillID             string          `db:"bill_id"`
+	PaymentAccountID   string          `db:"payment_account_id"`
+	ScheduledDate      time.Time       `db:"scheduled_date"`
+	Amount             decimal.Decimal `db:"amount"`
+	CreatedAt          time.Time       `db:"created_at"`
+	CreatedBy          string          `db:"created_by"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Budget represents a row of the budgets table
+type Budget struct {
+	BudgetID    string `db:"budget_id"`
+	WorkplaceID string `db:"workplace_id"`
+	Name        string `db:"name"`
+	Description string `db:"description"` // Nullable
+	PeriodType  string `db:"period_type"`
+	Year        int    `db:"budget_year"`
+	AuditFields
+}
+
+// BudgetLine represents a row of the budget_lines table
+type BudgetLine struct {
+	BudgetID    string          `db:"budget_id"`
+	AccountID   string          `db:"account_id"`
+	PeriodStart time.Time       `db:"period_start"`
+	Amount      decimal.Decimal `db:"amount"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// CategorizationRule represents a row of the categorization_rules table
+type CategorizationRule struct {
+	RuleID             string              `db:"rule_id"`
+	WorkplaceID        string              `db:"workplace_id"`
+	Name               string              `db:"name"`
+	Priority           int                 `db:"priority"`
+	IsActive           bool                `db:"is_active"`
+	PayeePattern       string              `db:"payee_pattern"`       // Nullable
+	DescriptionPattern string              `db:"description_pattern"` // Nullable
+	MinAmount          decimal.NullDecimal `db:"min_amount"`
+	MaxAmount          decimal.NullDecimal `db:"max_amount"`
+	SourceAccountID    string              `db:"source_account_id"` // Nullable
+	Sign               string              `db:"sign"`
+	CounterAccountID   string              `db:"counter_account_id"`
+	JournalDescription string              `db:"journal_description"` // Nullable
+	Notes              string              `db:"notes"`               // Nullable
+	AuditFields
+}
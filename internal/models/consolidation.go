@@ -0,0 +1,19 @@
+package models
+
+// ConsolidationGroup represents a row of the consolidation_groups table
+type ConsolidationGroup struct {
+	GroupID      string `db:"group_id"`
+	WorkplaceID  string `db:"workplace_id"`
+	Name         string `db:"name"`
+	Description  string `db:"description"` // Nullable
+	CurrencyCode string `db:"currency_code"`
+	AuditFields
+}
+
+// ConsolidationAccountMapping represents a row of the consolidation_account_mappings table
+type ConsolidationAccountMapping struct {
+	GroupID      string `db:"group_id"`
+	AccountID    string `db:"account_id"`
+	GroupCode    string `db:"group_code"` // Nullable
+	Intercompany bool   `db:"intercompany"`
+}
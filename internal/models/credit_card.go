@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// CreditCard represents a row of the credit_cards table
+type CreditCard struct {
+	AccountID             string          `db:"account_id"`
+	WorkplaceID           string          `db:"workplace_id"`
+	ClosingDay            int             `db:"closing_day"`
+	DueDay                int             `db:"due_day"`
+	CreditLimit           decimal.Decimal `db:"credit_limit"`
+	MinimumPaymentPercent decimal.Decimal `db:"minimum_payment_percent"`
+	MinimumPaymentAmount  decimal.Decimal `db:"minimum_payment_amount"`
+	AuditFields
+}
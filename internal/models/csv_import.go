@@ -0,0 +1,23 @@
+package models
+
+// CSVImportProfile represents a row of the csv_import_profiles table
+type CSVImportProfile struct {
+	ProfileID        string `db:"profile_id"`
+	WorkplaceID      string `db:"workplace_id"`
+	AccountID        string `db:"account_id"`
+	Name             string `db:"name"`
+	Delimiter        string `db:"delimiter"`
+	SkipRows         int    `db:"skip_rows"`
+	HasHeader        bool   `db:"has_header"`
+	DateColumn       int    `db:"date_column"`
+	DateFormat       string `db:"date_format"`
+	AmountMode       string `db:"amount_mode"`
+	AmountColumn     int    `db:"amount_column"`
+	DebitColumn      int    `db:"debit_column"`
+	CreditColumn     int    `db:"credit_column"`
+	DecimalSeparator string `db:"decimal_separator"`
+	PayeeColumn      int    `db:"payee_column"`
+	MemoColumn       int    `db:"memo_column"`
+	ReferenceColumn  int    `db:"reference_column"`
+	AuditFields
+}
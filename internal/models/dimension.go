@@ -0,0 +1,22 @@
+package models
+
+// Dimension represents a row of the dimensions table
+type Dimension struct {
+	DimensionID string `db:"dimension_id"`
+	WorkplaceID string `db:"workplace_id"`
+	Code        string `db:"code"`
+	Name        string `db:"name"`
+	Description string `db:"description"` // Nullable
+	IsActive    bool   `db:"is_active"`
+	AuditFields
+}
+
+// DimensionValue represents a row of the dimension_values table
+type DimensionValue struct {
+	ValueID     string `db:"value_id"`
+	DimensionID string `db:"dimension_id"`
+	Code        string `db:"code"`
+	Name        string `db:"name"`
+	IsActive    bool   `db:"is_active"`
+	AuditFields
+}
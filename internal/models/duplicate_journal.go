@@ -0,0 +1,20 @@
+package models
+
+import (
+	"database/sql"
+)
+
+// DuplicateJournalPair represents a row of the duplicate_journal_pairs table
+type DuplicateJournalPair struct {
+	PairID               string         `db:"pair_id"`
+	WorkplaceID          string         `db:"workplace_id"`
+	JournalID            string         `db:"journal_id"`
+	DuplicateOfJournalID string         `db:"duplicate_of_journal_id"`
+	Similarity           float64        `db:"similarity"`
+	Status               string         `db:"status"`
+	ReversedJournalID    sql.NullString `db:"reversed_journal_id"`
+	ResolutionNote       sql.NullString `db:"resolution_note"`
+	ResolvedAt           sql.NullTime   `db:"resolved_at"`
+	ResolvedBy           sql.NullString `db:"resolved_by"`
+	AuditFields
+}
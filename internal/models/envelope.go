@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// EnvelopeSettings represents a row of the envelope_settings table
+type EnvelopeSettings struct {
+	WorkplaceID string    `db:"workplace_id"`
+	StartMonth  time.Time `db:"start_month"`
+	AuditFields
+}
+
+// EnvelopeAllocation represents a row of the envelope_allocations table
+type EnvelopeAllocation struct {
+	AllocationID string          `db:"allocation_id"`
+	WorkplaceID  string          `db:"workplace_id"`
+	AccountID    string          `db:"account_id"`
+	Month        time.Time       `db:"allocation_month"`
+	Amount       decimal.Decimal `db:"amount"`
+	TransferID   string          `db:"transfer_id"` // Nullable
+	Note         string          `db:"note"`        // Nullable
+	AuditFields
+}
@@ -0,0 +1,14 @@
+package models
+
+// Security represents a row of the securities table
+type Security struct {
+	SecurityID   string `db:"security_id"`
+	WorkplaceID  string `db:"workplace_id"`
+	Symbol       string `db:"symbol"`
+	Name         string `db:"name"`
+	SecurityType string `db:"security_type"`
+	CurrencyCode string `db:"currency_code"`
+	CostMethod   string `db:"cost_method"`
+	IsActive     bool   `db:"is_active"`
+	AuditFields
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Invoice represents a row of the invoices table
+type Invoice struct {
+	InvoiceID           string          `db:"invoice_id"`
+	WorkplaceID         string          `db:"workplace_id"`
+	CustomerID          string          `db:"customer_id"`
+	ReceivableAccountID string          `db:"receivable_account_id"`
+	InvoiceNumber       string          `db:"invoice_number"` // Nullable
+	IssueDate           time.Time       `db:"issue_date"`
+	DueDate             time.Time       `db:"due_date"`
+	CurrencyCode        string          `db:"currency_code"`
+	Notes               string          `db:"notes"` // Nullable
+	Subtotal            decimal.Decimal `db:"subtotal"`
+	TaxTotal            decimal.Decimal `db:"tax_total"`
+	Total               decimal.Decimal `db:"total"`
+	IssueJournalID      string          `db:"issue_journal_id"` // Nullable
+	AuditFields
+}
+
+// InvoiceLine represents a row of the invoice_lines table
+type InvoiceLine struct {
+	LineID           string          `db:"line_id"`
+	InvoiceID        string          `db:"invoice_id"`
+	LineNumber       int             `db:"line_number"`
+	Description      string          `db:"description"`
+	Quantity         decimal.Decimal `db:"quantity"`
+	UnitPrice        decimal.Decimal `db:"unit_price"`
+	RevenueAccountID string          `db:"revenue_account_id"`
+	TaxCodeID        string          `db:"tax_code_id"` // Nullable
+	Amount           decimal.Decimal `db:"amount"`
+	NetAmount        decimal.Decimal `db:"net_amount"`
+	TaxAmount        decimal.Decimal `db:"tax_amount"`
+}
+
+// InvoicePayment represents a row of the invoice_payments table
+type InvoicePayment struct {
+	PaymentID   string          `db:"payment_id"`
+	InvoiceID   string          `db:"invoice_id"`
+	JournalID   string          `db:"journal_id"`
+	PaymentDate time.Time       `db:"payment_date"`
+	Amount      decimal.Decimal `db:"amount"`
+	CreatedAt   time.Time       `db:"created_at"`
+	CreatedBy   string          `db:"created_by"`
+}
+
+// InvoiceSequence represents a row of the invoice_sequences table
+type InvoiceSequence struct {
+	WorkplaceID   string    `db:"workplace_id"`
+	Prefix        string    `db:"prefix"`
+	NextNumber    int64     `db:"next_number"`
+	Padding       int       `db:"padding"`
+	LastUpdatedAt time.Time `db:"last_updated_at"`
+	LastUpdatedBy string    `db:"last_updated_by"` // Nullable
+}
@@ -26,5 +26,7 @@ type Journal struct {
 	OriginalJournalID  *string         `db:"original_journal_id"`  // Link to the journal this one reverses
 	ReversingJournalID *string         `db:"reversing_journal_id"` // Link to the journal that reverses this one
 	Amount             decimal.Decimal `db:"amount"`               // Total amount of the journal (sum of debits)
+	PayeeID            string          `db:"payee_id"`             // Nullable
+	TwinJournalID      *string         `db:"twin_journal_id"`      // Link to the mirrored journal of an intercompany posting
 	AuditFields                        // Embed common audit fields
 }
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Loan represents a row of the loans table
+type Loan struct {
+	LoanID                string          `db:"loan_id"`
+	WorkplaceID           string          `db:"workplace_id"`
+	AccountID             string          `db:"account_id"`
+	InterestAccountID     string          `db:"interest_account_id"`
+	Name                  string          `db:"name"`
+	Principal             decimal.Decimal `db:"principal"`
+	AnnualRate            decimal.Decimal `db:"annual_rate"`
+	Term                  int             `db:"term"`
+	Frequency             string          `db:"frequency"`
+	StartDate             time.Time       `db:"start_date"`
+	DisbursementJournalID string          `db:"disbursement_journal_id"` // Nullable
+	AuditFields
+}
+
+// LoanPayment represents a row of the loan_payments table
+type LoanPayment struct {
+	PaymentID         string          `db:"payment_id"`
+	LoanID            string          `db:"loan_id"`
+	JournalID         string          `db:"journal_id"`
+	PaymentType       string          `db:"payment_type"`
+	InstallmentNumber int             `db:"installment_number"` // Nullable
+	PrepaymentMode    string          `db:"prepayment_mode"`    // Nullable
+	PaymentDate       time.Time       `db:"payment_date"`
+	Principal         decimal.Decimal `db:"principal"`
+	Interest          decimal.Decimal `db:"interest"`
+	CreatedAt         time.Time       `db:"created_at"`
+	CreatedBy         string          `db:"created_by"`
+}
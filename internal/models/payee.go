@@ -0,0 +1,19 @@
+package models
+
+// Payee represents a row of the payees table
+type Payee struct {
+	PayeeID                 string `db:"payee_id"`
+	WorkplaceID             string `db:"workplace_id"`
+	Name                    string `db:"name"`
+	DefaultCounterAccountID string `db:"default_counter_account_id"` // Nullable
+	IsActive                bool   `db:"is_active"`
+	AuditFields
+}
+
+// PayeeAlias represents a row of the payee_aliases table
+type PayeeAlias struct {
+	PayeeID         string `db:"payee_id"`
+	WorkplaceID     string `db:"workplace_id"`
+	Alias           string `db:"alias"`
+	NormalizedAlias string `db:"normalized_alias"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CommodityPrice represents a row of the commodity_prices table
+type CommodityPrice struct {
+	Commodity     string          `db:"commodity"`
+	QuoteCurrency string          `db:"quote_currency"`
+	PriceDate     time.Time       `db:"price_date"`
+	Price         decimal.Decimal `db:"price"`
+	Source        string          `db:"source"`
+	CreatedAt     time.Time       `db:"created_at"`
+	CreatedBy     string          `db:"created_by"`
+}
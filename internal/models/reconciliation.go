@@ -0,0 +1,21 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Reconciliation represents a row of the reconciliations table
+type Reconciliation struct {
+	ReconciliationID string          `db:"reconciliation_id"`
+	WorkplaceID      string          `db:"workplace_id"`
+	AccountID        string          `db:"account_id"`
+	StatementDate    time.Time       `db:"statement_date"`
+	EndingBalance    decimal.Decimal `db:"ending_balance"`
+	Status           string          `db:"status"`
+	CompletedAt      sql.NullTime    `db:"completed_at"`
+	CompletedBy      sql.NullString  `db:"completed_by"`
+	AuditFields
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SavingsGoal represents a row of the savings_goals table
+type SavingsGoal struct {
+	GoalID       string          `db:"goal_id"`
+	WorkplaceID  string          `db:"workplace_id"`
+	Name         string          `db:"name"`
+	Description  string          `db:"description"` // Nullable
+	TargetAmount decimal.Decimal `db:"target_amount"`
+	TargetDate   time.Time       `db:"target_date"`
+	CurrencyCode string          `db:"currency_code"`
+	AuditFields
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MemberAccounts represents a row of the member_accounts table
+type MemberAccounts struct {
+	WorkplaceID         string         `db:"workplace_id"`
+	UserID              sql.NullString `db:"user_id"` // NULL for the parent accounts
+	CurrencyCode        string         `db:"currency_code"`
+	ReceivableAccountID string         `db:"receivable_account_id"`
+	PayableAccountID    string         `db:"payable_account_id"`
+	CreatedAt           time.Time      `db:"created_at"`
+	CreatedBy           string         `db:"created_by"`
+}
+
+// SharedExpense represents a row of the shared_expenses table
+type SharedExpense struct {
+	SharedExpenseID string          `db:"shared_expense_id"`
+	WorkplaceID     string          `db:"workplace_id"`
+	JournalID       string          `db:"journal_id"`
+	PaidByUserID    string          `db:"paid_by_user_id"`
+	SplitMethod     string          `db:"split_method"`
+	CurrencyCode    string          `db:"currency_code"`
+	TotalAmount     decimal.Decimal `db:"total_amount"`
+	ShareJournalID  string          `db:"share_journal_id"`
+	AuditFields
+}
+
+// SharedExpenseShare represents a row of the shared_expense_shares table
+type SharedExpenseShare struct {
+	SharedExpenseID string              `db:"shared_expense_id"`
+	UserID          string              `db:"user_id"`
+	ShareValue      decimal.NullDecimal `db:"share_value"` // NULL for equal splits
+	Amount          decimal.Decimal     `db:"amount"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// TaxCode represents a row of the tax_codes table
+type TaxCode struct {
+	TaxCodeID    string          `db:"tax_code_id"`
+	WorkplaceID  string          `db:"workplace_id"`
+	Code         string          `db:"code"`
+	Name         string          `db:"name"`
+	Rate         decimal.Decimal `db:"rate"`
+	TaxAccountID string          `db:"tax_account_id"`
+	Inclusive    bool            `db:"inclusive"`
+	IsActive     bool            `db:"is_active"`
+	AuditFields
+}
@@ -27,6 +27,9 @@ type Config struct {
 	GoogleClientSecret string `mapstructure:"GOOGLE_CLIENT_SECRET"`
 	GoogleRedirectURL  string `mapstructure:"GOOGLE_REDIRECT_URL"`
 	PosthogAPIKey      string `mapstructure:"POSTHOG_API_KEY"`
+
+	// Commodity prices
+	PriceFilePath string `mapstructure:"PRICE_FILE_PATH"` // CSV file read by the local price provider; empty disables it
 }
 
 // LoadConfig loads configuration from environment variables and .env file if present.
@@ -48,6 +51,7 @@ func LoadConfig() (*Config, error) {
 	viper.SetDefault("GOOGLE_CLIENT_SECRET", "")
 	viper.SetDefault("GOOGLE_REDIRECT_URL", "")
 	viper.SetDefault("POSTHOG_API_KEY", "")
+	viper.SetDefault("PRICE_FILE_PATH", "")
 
 	// Read .env file if it exists
 	// This allows overriding defaults with .env file values, which can then be overridden by actual environment variables.
@@ -149,6 +153,7 @@ func LoadConfig() (*Config, error) {
 	cfg.RefreshTokenCookieName = refreshTokenCookieName
 	cfg.RefreshTokenSecret = refreshTokenSecret
 	cfg.PosthogAPIKey = viper.GetString("POSTHOG_API_KEY")
+	cfg.PriceFilePath = viper.GetString("PRICE_FILE_PATH")
 
 	return cfg, nil
 }
@@ -44,8 +44,9 @@ func (r *PgxAccountRepository) SaveAccount(ctx context.Context, account domain.A
 		INSERT INTO accounts (
 			account_id, workplace_id, cfid, name, account_type, 
 			currency_code, parent_account_id, description, is_active, 
-			created_at, created_by, last_updated_at, last_updated_by, balance
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
+			created_at, created_by, last_updated_at, last_updated_by, balance,
+			cash_flow_category
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
 	`
 	// Use sql.NullString for potentially NULL parent_account_id and cfid
 	var parentID sql.NullString
@@ -60,6 +61,12 @@ func (r *PgxAccountRepository) SaveAccount(ctx context.Context, account domain.A
 		cfid.Valid = true
 	}
 
+	var cashFlowCategory sql.NullString
+	if modelAcc.CashFlowCategory != "" {
+		cashFlowCategory.String = modelAcc.CashFlowCategory
+		cashFlowCategory.Valid = true
+	}
+
 	_, err := r.Pool.Exec(ctx, query,
 		modelAcc.AccountID,
 		modelAcc.WorkplaceID,
@@ -75,6 +82,7 @@ func (r *PgxAccountRepository) SaveAccount(ctx context.Context, account domain.A
 		modelAcc.LastUpdatedAt,
 		modelAcc.CreatedBy, // Corrected: Should use CreatedBy here too
 		modelAcc.Balance,
+		cashFlowCategory,
 	)
 
 	if err != nil {
@@ -100,7 +108,7 @@ func (r *PgxAccountRepository) FindAccountByCFID(ctx context.Context, cfid strin
 		SELECT 
 			account_id, workplace_id, cfid, name, account_type, 
 			currency_code, parent_account_id, description, is_active, 
-			created_at, created_by, last_updated_at, last_updated_by, balance
+			created_at, created_by, last_updated_at, last_updated_by, balance, cash_flow_category
 		FROM accounts
 		WHERE cfid = $1 AND workplace_id = $2;
 	`
@@ -109,6 +117,7 @@ func (r *PgxAccountRepository) FindAccountByCFID(ctx context.Context, cfid strin
 	var parentID sql.NullString // For potentially NULL parent_account_id
 	var cfidVal sql.NullString  // For potentially NULL cfid
 	var balance decimal.Decimal
+	var cashFlowCategory sql.NullString // For potentially NULL cash_flow_category
 
 	err := r.Pool.QueryRow(ctx, query, cfid, workplaceID).Scan(
 		&modelAcc.AccountID,
@@ -125,6 +134,7 @@ func (r *PgxAccountRepository) FindAccountByCFID(ctx context.Context, cfid strin
 		&modelAcc.LastUpdatedAt,
 		&modelAcc.LastUpdatedBy,
 		&balance,
+		&cashFlowCategory,
 	)
 
 	if err != nil {
@@ -147,6 +157,7 @@ func (r *PgxAccountRepository) FindAccountByCFID(ctx context.Context, cfid strin
 	}
 
 	modelAcc.Balance = balance
+	modelAcc.CashFlowCategory = cashFlowCategory.String
 
 	// Convert to domain model
 	account := mapping.ToDomainAccount(modelAcc)
@@ -159,7 +170,7 @@ func (r *PgxAccountRepository) FindAccountByID(ctx context.Context, accountID st
 		SELECT 
 			account_id, workplace_id, cfid, name, account_type, 
 			currency_code, parent_account_id, description, is_active, 
-			created_at, created_by, last_updated_at, last_updated_by, balance
+			created_at, created_by, last_updated_at, last_updated_by, balance, cash_flow_category
 		FROM accounts
 		WHERE account_id = $1;
 	`
@@ -167,6 +178,7 @@ func (r *PgxAccountRepository) FindAccountByID(ctx context.Context, accountID st
 	var parentID sql.NullString // For potentially NULL parent_account_id
 	var cfid sql.NullString     // For potentially NULL cfid
 	var balance decimal.Decimal
+	var cashFlowCategory sql.NullString // For potentially NULL cash_flow_category
 
 	err := r.Pool.QueryRow(ctx, query, accountID).Scan(
 		&modelAcc.AccountID,
@@ -183,6 +195,7 @@ func (r *PgxAccountRepository) FindAccountByID(ctx context.Context, accountID st
 		&modelAcc.LastUpdatedAt,
 		&modelAcc.LastUpdatedBy,
 		&balance,
+		&cashFlowCategory,
 	)
 
 	if err != nil {
@@ -205,6 +218,7 @@ func (r *PgxAccountRepository) FindAccountByID(ctx context.Context, accountID st
 	}
 
 	modelAcc.Balance = balance
+	modelAcc.CashFlowCategory = cashFlowCategory.String
 	domainAcc := mapping.ToDomainAccount(modelAcc)
 	return &domainAcc, nil
 }
@@ -219,7 +233,7 @@ func (r *PgxAccountRepository) FindAccountsByIDs(ctx context.Context, accountIDs
 		SELECT 
 			account_id, workplace_id, cfid, name, account_type, 
 			currency_code, parent_account_id, description, is_active, 
-			created_at, created_by, last_updated_at, last_updated_by, balance
+			created_at, created_by, last_updated_at, last_updated_by, balance, cash_flow_category
 		FROM accounts
 		WHERE account_id = ANY($1);
 	`
@@ -236,6 +250,7 @@ func (r *PgxAccountRepository) FindAccountsByIDs(ctx context.Context, accountIDs
 		var parentID sql.NullString // For potentially NULL parent_account_id
 		var cfid sql.NullString     // For potentially NULL cfid
 		var balance decimal.Decimal
+		var cashFlowCategory sql.NullString // For potentially NULL cash_flow_category
 
 		err := rows.Scan(
 			&modelAcc.AccountID,
@@ -252,6 +267,7 @@ func (r *PgxAccountRepository) FindAccountsByIDs(ctx context.Context, accountIDs
 			&modelAcc.LastUpdatedAt,
 			&modelAcc.LastUpdatedBy,
 			&balance,
+			&cashFlowCategory,
 		)
 		if err != nil {
 			return nil, apperrors.NewAppError(500, "failed to scan account row", err)
@@ -264,6 +280,7 @@ func (r *PgxAccountRepository) FindAccountsByIDs(ctx context.Context, accountIDs
 		}
 
 		modelAcc.Balance = balance
+		modelAcc.CashFlowCategory = cashFlowCategory.String
 		accountsMap[modelAcc.AccountID] = mapping.ToDomainAccount(modelAcc)
 	}
 
@@ -288,7 +305,7 @@ func (r *PgxAccountRepository) ListAccounts(ctx context.Context, workplaceID str
 		SELECT 
 			account_id, workplace_id, cfid, name, account_type, 
 			currency_code, parent_account_id, description, is_active, 
-			created_at, created_by, last_updated_at, last_updated_by, balance
+			created_at, created_by, last_updated_at, last_updated_by, balance, cash_flow_category
 		FROM accounts
 		WHERE workplace_id = $1
 		ORDER BY name
@@ -307,6 +324,7 @@ func (r *PgxAccountRepository) ListAccounts(ctx context.Context, workplaceID str
 		var parentID sql.NullString
 		var cfid sql.NullString
 		var balance decimal.Decimal
+		var cashFlowCategory sql.NullString // For potentially NULL cash_flow_category
 
 		err := rows.Scan(
 			&modelAcc.AccountID,
@@ -323,6 +341,7 @@ func (r *PgxAccountRepository) ListAccounts(ctx context.Context, workplaceID str
 			&modelAcc.LastUpdatedAt,
 			&modelAcc.LastUpdatedBy,
 			&balance,
+			&cashFlowCategory,
 		)
 		if err != nil {
 			return nil, apperrors.NewAppError(500, "failed to scan account row", err)
@@ -341,6 +360,7 @@ func (r *PgxAccountRepository) ListAccounts(ctx context.Context, workplaceID str
 		}
 
 		modelAcc.Balance = balance
+		modelAcc.CashFlowCategory = cashFlowCategory.String
 		accounts = append(accounts, modelAcc)
 	}
 
@@ -366,6 +386,11 @@ func (r *PgxAccountRepository) UpdateAccount(ctx context.Context, account domain
 		cfid = sql.NullString{String: modelAcc.CFID, Valid: true}
 	}
 
+	var cashFlowCategory sql.NullString
+	if modelAcc.CashFlowCategory != "" {
+		cashFlowCategory = sql.NullString{String: modelAcc.CashFlowCategory, Valid: true}
+	}
+
 	query := `
 		UPDATE accounts
 		SET name = $2,
@@ -374,7 +399,8 @@ func (r *PgxAccountRepository) UpdateAccount(ctx context.Context, account domain
 			cfid = $5,
 			is_active = $6,
 			last_updated_at = $7,
-			last_updated_by = $8
+			last_updated_by = $8,
+			cash_flow_category = $9
 		WHERE account_id = $1;
 	`
 
@@ -387,6 +413,7 @@ func (r *PgxAccountRepository) UpdateAccount(ctx context.Context, account domain
 		modelAcc.IsActive,
 		modelAcc.LastUpdatedAt,
 		modelAcc.LastUpdatedBy,
+		cashFlowCategory,
 	)
 	if err != nil {
 		return apperrors.NewAppError(500, "failed to update account", err)
@@ -443,7 +470,7 @@ func (r *PgxAccountRepository) FindAccountsByIDsForUpdate(ctx context.Context, t
 		SELECT 
 			account_id, workplace_id, cfid, name, account_type, 
 			currency_code, parent_account_id, description, is_active, 
-			created_at, created_by, last_updated_at, last_updated_by, balance
+			created_at, created_by, last_updated_at, last_updated_by, balance, cash_flow_category
 		FROM accounts
 		WHERE account_id = ANY($1)
 		FOR UPDATE; -- Lock rows for update
@@ -463,6 +490,7 @@ func (r *PgxAccountRepository) FindAccountsByIDsForUpdate(ctx context.Context, t
 		var parentID sql.NullString // For potentially NULL parent_account_id
 		var cfid sql.NullString     // For potentially NULL cfid
 		var balance decimal.Decimal
+		var cashFlowCategory sql.NullString // For potentially NULL cash_flow_category
 		err := rows.Scan(
 			&modelAcc.AccountID,
 			&modelAcc.WorkplaceID,
@@ -478,6 +506,7 @@ func (r *PgxAccountRepository) FindAccountsByIDsForUpdate(ctx context.Context, t
 			&modelAcc.LastUpdatedAt,
 			&modelAcc.LastUpdatedBy,
 			&balance,
+			&cashFlowCategory,
 		)
 		if err != nil {
 			return nil, apperrors.NewAppError(500, "failed to scan account row", err)
@@ -496,6 +525,7 @@ func (r *PgxAccountRepository) FindAccountsByIDsForUpdate(ctx context.Context, t
 		}
 
 		modelAcc.Balance = balance
+		modelAcc.CashFlowCategory = cashFlowCategory.String
 		accountsMap[modelAcc.AccountID] = mapping.ToDomainAccount(modelAcc)
 		foundIDs[modelAcc.AccountID] = struct{}{}
 	}
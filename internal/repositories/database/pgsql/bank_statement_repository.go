@@ -0,0 +1,291 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// PgxBankStatementRepository implements the bank statement repository using pgxpool.
+type PgxBankStatementRepository struct {
+	BaseRepository
+}
+
+// newPgxBankStatementRepository creates a new repository for staged bank statement data.
+func newPgxBankStatementRepository(pool *pgxpool.Pool) portsrepo.BankStatementRepositoryWithTx {
+	return &PgxBankStatementRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.BankStatementRepositoryWithTx = (*PgxBankStatementRepository)(nil)
+
+// selectStatementLines selects staged statement lines
+const selectStatementLines = `
+	SELECT
+		line_id, workplace_id, account_id, import_id, bank_reference, line_date, amount, payee, memo, status, journal_id,
+		counter_account_id, journal_description, notes, matched_rule_id,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM bank_statement_lines
+`
+
+// scanStatementLine scans a row produced by selectStatementLines
+func scanStatementLine(row pgx.Row) (domain.BankStatementLine, error) {
+	var m models.BankStatementLine
+	var payee, memo, journalID, counterAccountID, description, notes, matchedRuleID sql.NullString
+	if err := row.Scan(
+		&m.LineID,
+		&m.WorkplaceID,
+		&m.AccountID,
+		&m.ImportID,
+		&m.BankReference,
+		&m.Date,
+		&m.Amount,
+		&payee,
+		&memo,
+		&m.Status,
+		&journalID,
+		&counterAccountID,
+		&description,
+		&notes,
+		&matchedRuleID,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.BankStatementLine{}, err
+	}
+	m.Payee = payee.String
+	m.Memo = memo.String
+	m.JournalID = journalID.String
+	m.CounterAccountID = counterAccountID.String
+	m.Description = description.String
+	m.Notes = notes.String
+	m.MatchedRuleID = matchedRuleID.String
+	return mapping.ToDomainBankStatementLine(m), nil
+}
+
+// nullableString converts an empty string to a SQL NULL
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nullableDecimal converts a zero decimal to a SQL NULL
+func nullableDecimal(d decimal.Decimal) decimal.NullDecimal {
+	return decimal.NullDecimal{Decimal: d, Valid: !d.IsZero()}
+}
+
+// SaveStatementImport records an import and stages its new lines in a single transaction.
+// Lines already staged for the account under the same bank reference are skipped.
+func (r *PgxBankStatementRepository) SaveStatementImport(ctx context.Context, statementImport domain.BankStatementImport, lines []domain.BankStatementLine) (*domain.BankStatementImport, error) {
+	m := mapping.ToModelBankStatementImport(statementImport)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Rollback(ctx, tx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO bank_statement_imports (
+			import_id, workplace_id, account_id, file_format, file_name, total_lines, new_lines,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, 0, $7, $8, $9, $10);
+	`, m.ImportID, m.WorkplaceID, m.AccountID, m.Format, nullableString(m.FileName), len(lines),
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to save bank statement import "+m.ImportID, err)
+	}
+
+	newLines := 0
+	if len(lines) > 0 {
+		batch := &pgx.Batch{}
+		for _, line := range lines {
+			lm := mapping.ToModelBankStatementLine(line)
+			batch.Queue(`
+				INSERT INTO bank_statement_lines (
+					line_id, workplace_id, account_id, import_id, bank_reference, line_date, amount, payee, memo, status,
+					counter_account_id, journal_description, notes, matched_rule_id,
+					created_at, created_by, last_updated_at, last_updated_by
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+				ON CONFLICT (account_id, bank_reference) DO NOTHING;
+			`, lm.LineID, lm.WorkplaceID, lm.AccountID, lm.ImportID, lm.BankReference, lm.Date, lm.Amount,
+				nullableString(lm.Payee), nullableString(lm.Memo), lm.Status,
+				nullableString(lm.CounterAccountID), nullableString(lm.Description), nullableString(lm.Notes), nullableString(lm.MatchedRuleID),
+				lm.CreatedAt, lm.CreatedBy, lm.LastUpdatedAt, lm.LastUpdatedBy)
+		}
+
+		results := tx.SendBatch(ctx, batch)
+		for range lines {
+			tag, err := results.Exec()
+			if err != nil {
+				results.Close()
+				return nil, apperrors.NewAppError(500, "failed to stage bank statement lines", err)
+			}
+			newLines += int(tag.RowsAffected())
+		}
+		if err := results.Close(); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to stage bank statement lines", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE bank_statement_imports SET new_lines = $1 WHERE import_id = $2;`, newLines, m.ImportID); err != nil {
+		return nil, apperrors.NewAppError(500, "failed to update bank statement import counts", err)
+	}
+
+	if err := r.Commit(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	m.TotalLines = len(lines)
+	m.NewLines = newLines
+	saved := mapping.ToDomainBankStatementImport(m)
+	return &saved, nil
+}
+
+// FindStatementLineByID retrieves a staged statement line.
+func (r *PgxBankStatementRepository) FindStatementLineByID(ctx context.Context, lineID string) (*domain.BankStatementLine, error) {
+	line, err := scanStatementLine(r.Pool.QueryRow(ctx, selectStatementLines+`WHERE line_id = $1;`, lineID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find bank statement line by ID", err)
+	}
+	return &line, nil
+}
+
+// ListStatementLines retrieves the staged lines of an account, newest first. An empty status lists all lines.
+func (r *PgxBankStatementRepository) ListStatementLines(ctx context.Context, accountID string, status domain.StatementLineStatus, limit int, offset int) ([]domain.BankStatementLine, error) {
+	rows, err := r.Pool.Query(ctx, selectStatementLines+`
+		WHERE account_id = $1 AND ($2::varchar = '' OR status = $2)
+		ORDER BY line_date DESC, bank_reference
+		LIMIT $3 OFFSET $4;
+	`, accountID, string(status), limit, offset)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query bank statement lines", err)
+	}
+	return collectStatementLines(rows)
+}
+
+// ListStatementLinesByImport retrieves the lines staged by one import, oldest first.
+func (r *PgxBankStatementRepository) ListStatementLinesByImport(ctx context.Context, importID string) ([]domain.BankStatementLine, error) {
+	rows, err := r.Pool.Query(ctx, selectStatementLines+`
+		WHERE import_id = $1
+		ORDER BY line_date, bank_reference;
+	`, importID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query bank statement lines by import", err)
+	}
+	return collectStatementLines(rows)
+}
+
+// ListPendingStatementLines retrieves the pending lines of a workplace, oldest first. An empty account ID lists all accounts.
+func (r *PgxBankStatementRepository) ListPendingStatementLines(ctx context.Context, workplaceID string, accountID string) ([]domain.BankStatementLine, error) {
+	rows, err := r.Pool.Query(ctx, selectStatementLines+`
+		WHERE workplace_id = $1 AND status = 'PENDING' AND ($2::varchar = '' OR account_id = $2)
+		ORDER BY line_date, bank_reference;
+	`, workplaceID, accountID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query pending bank statement lines", err)
+	}
+	return collectStatementLines(rows)
+}
+
+// ListManuallyPostedLines retrieves posted lines dated on or after since whose counter-account was chosen by hand.
+func (r *PgxBankStatementRepository) ListManuallyPostedLines(ctx context.Context, workplaceID string, since time.Time) ([]domain.BankStatementLine, error) {
+	rows, err := r.Pool.Query(ctx, selectStatementLines+`
+		WHERE workplace_id = $1 AND status = 'POSTED' AND matched_rule_id IS NULL
+			AND counter_account_id IS NOT NULL AND line_date >= $2
+		ORDER BY line_date, bank_reference;
+	`, workplaceID, since)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query manually posted bank statement lines", err)
+	}
+	return collectStatementLines(rows)
+}
+
+// collectStatementLines scans and closes rows produced by selectStatementLines
+func collectStatementLines(rows pgx.Rows) ([]domain.BankStatementLine, error) {
+	defer rows.Close()
+
+	lines := []domain.BankStatementLine{}
+	for rows.Next() {
+		line, err := scanStatementLine(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan bank statement line", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating bank statement lines", err)
+	}
+
+	return lines, nil
+}
+
+// SaveStatementLineCategorizations stores the counter-account, description, notes and matched rule of lines in a single transaction.
+func (r *PgxBankStatementRepository) SaveStatementLineCategorizations(ctx context.Context, lines []domain.BankStatementLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	for _, line := range lines {
+		m := mapping.ToModelBankStatementLine(line)
+		batch.Queue(`
+			UPDATE bank_statement_lines
+			SET counter_account_id = $1, journal_description = $2, notes = $3, matched_rule_id = $4,
+				last_updated_at = $5, last_updated_by = $6
+			WHERE line_id = $7;
+		`, nullableString(m.CounterAccountID), nullableString(m.Description), nullableString(m.Notes), nullableString(m.MatchedRuleID),
+			m.LastUpdatedAt, m.LastUpdatedBy, m.LineID)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range lines {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return apperrors.NewAppError(500, "failed to save bank statement line categorization", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save bank statement line categorization", err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// UpdateStatementLineStatus moves a line between statuses only if it is still in the expected status,
+// so two concurrent requests cannot both post the same line.
+func (r *PgxBankStatementRepository) UpdateStatementLineStatus(ctx context.Context, lineID string, from, to domain.StatementLineStatus, journalID string, userID string, now time.Time) error {
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE bank_statement_lines
+		SET status = $1, journal_id = $2, last_updated_at = $3, last_updated_by = $4
+		WHERE line_id = $5 AND status = $6;
+	`, string(to), nullableString(journalID), now, userID, lineID, string(from))
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update bank statement line "+lineID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrConflict
+	}
+	return nil
+}
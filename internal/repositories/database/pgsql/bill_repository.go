@@ -0,0 +1,433 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxBillRepository implements the bill repository using pgxpool.
+type PgxBillRepository struct {
+	BaseRepository
+}
+
+// newPgxBillRepository creates a new repository for vendor bills.
+func newPgxBillRepository(pool *pgxpool.Pool) portsrepo.BillRepositoryWithTx {
+	return &PgxBillRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.BillRepositoryWithTx = (*PgxBillRepository)(nil)
+
+// selectBills selects bills with the status of their posting journal
+const selectBills = `
+	SELECT
+		b.bill_id, b.workplace_id, b.vendor_id, b.payable_account_id, b.reference, b.bill_date, b.due_date,
+		b.currency_code, b.notes, b.subtotal, b.tax_total, b.total, b.post_journal_id, j.status,
+		b.created_at, b.created_by, b.last_updated_at, b.last_updated_by
+	FROM bills b
+	LEFT JOIN journals j ON j.journal_id = b.post_journal_id
+`
+
+// selectScheduledBillPayments selects scheduled payments with the payment posted for them, if its journal is still posted
+const selectScheduledBillPayments = `
+	SELECT
+		s.scheduled_payment_id, s.bill_id, s.payment_account_id, s.scheduled_date, s.amount, s.created_at, s.created_by,
+		(
+			SELECT p.payment_id
+			FROM bill_payments p
+			JOIN journals pj ON pj.journal_id = p.journal_id
+			WHERE p.scheduled_payment_id = s.scheduled_payment_id AND pj.status = 'POSTED'
+			LIMIT 1
+		) AS payment_id
+	FROM bill_scheduled_payments s
+`
+
+// scanBill scans a row produced by selectBills
+func scanBill(row pgx.Row) (domain.Bill, error) {
+	var m models.Bill
+	var reference, notes, postJournalID, postJournalStatus sql.NullString
+	if err := row.Scan(
+		&m.BillID,
+		&m.WorkplaceID,
+		&m.VendorID,
+		&m.PayableAccountID,
+		&reference,
+		&m.BillDate,
+		&m.DueDate,
+		&m.CurrencyCode,
+		&notes,
+		&m.Subtotal,
+		&m.TaxTotal,
+		&m.Total,
+		&postJournalID,
+		&postJournalStatus,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.Bill{}, err
+	}
+	m.Reference = reference.String
+	m.Notes = notes.String
+	m.PostJournalID = postJournalID.String
+	bill := mapping.ToDomainBill(m)
+	bill.PostJournalStatus = domain.JournalStatus(postJournalStatus.String)
+	return bill, nil
+}
+
+// scanScheduledBillPayment scans a row produced by selectScheduledBillPayments
+func scanScheduledBillPayment(row pgx.Row) (domain.ScheduledBillPayment, error) {
+	var m models.ScheduledBillPayment
+	var paymentID sql.NullString
+	if err := row.Scan(&m.ScheduledPaymentID, &m.BillID, &m.PaymentAccountID, &m.ScheduledDate, &m.Amount,
+		&m.CreatedAt, &m.CreatedBy, &paymentID); err != nil {
+		return domain.ScheduledBillPayment{}, err
+	}
+	scheduled := mapping.ToDomainScheduledBillPayment(m)
+	scheduled.PaymentID = paymentID.String
+	return scheduled, nil
+}
+
+// queueBillLines queues the inserts of the lines of a bill
+func queueBillLines(batch *pgx.Batch, bill domain.Bill) {
+	for _, line := range bill.Lines {
+		m := mapping.ToModelBillLine(line)
+		batch.Queue(`
+			INSERT INTO bill_lines (
+				line_id, bill_id, line_number, description, expense_account_id, tax_code_id, amount, net_amount, tax_amount
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+		`, m.LineID, bill.BillID, m.LineNumber, m.Description, m.ExpenseAccountID, nullableString(m.TaxCodeID),
+			m.Amount, m.NetAmount, m.TaxAmount)
+	}
+}
+
+// sendBillBatch executes a batch of bill line statements
+func sendBillBatch(ctx context.Context, tx pgx.Tx, batch *pgx.Batch, billID string) error {
+	results := tx.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return apperrors.NewAppError(500, "failed to save lines of bill "+billID, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save lines of bill "+billID, err)
+	}
+	return nil
+}
+
+// SaveBill persists a new draft bill and its lines in a single transaction.
+func (r *PgxBillRepository) SaveBill(ctx context.Context, bill domain.Bill) error {
+	m := mapping.ToModelBill(bill)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO bills (
+			bill_id, workplace_id, vendor_id, payable_account_id, reference, bill_date, due_date, currency_code, notes,
+			subtotal, tax_total, total, created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+	`, m.BillID, m.WorkplaceID, m.VendorID, m.PayableAccountID, nullableString(m.Reference), m.BillDate, m.DueDate,
+		m.CurrencyCode, nullableString(m.Notes), m.Subtotal, m.TaxTotal, m.Total, m.CreatedAt, m.CreatedBy,
+		m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save bill "+m.BillID, err)
+	}
+
+	batch := &pgx.Batch{}
+	queueBillLines(batch, bill)
+	if err := sendBillBatch(ctx, tx, batch, m.BillID); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// UpdateBill replaces the details and lines of a draft bill in a single transaction.
+func (r *PgxBillRepository) UpdateBill(ctx context.Context, bill domain.Bill) error {
+	m := mapping.ToModelBill(bill)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE bills
+		SET vendor_id = $1, payable_account_id = $2, reference = $3, bill_date = $4, due_date = $5, currency_code = $6,
+			notes = $7, subtotal = $8, tax_total = $9, total = $10, last_updated_at = $11, last_updated_by = $12
+		WHERE bill_id = $13 AND post_journal_id IS NULL;
+	`, m.VendorID, m.PayableAccountID, nullableString(m.Reference), m.BillDate, m.DueDate, m.CurrencyCode,
+		nullableString(m.Notes), m.Subtotal, m.TaxTotal, m.Total, m.LastUpdatedAt, m.LastUpdatedBy, m.BillID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update bill "+m.BillID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM bill_lines WHERE bill_id = $1;`, m.BillID)
+	queueBillLines(batch, bill)
+	if err := sendBillBatch(ctx, tx, batch, m.BillID); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// DeleteBill removes a bill and its lines.
+func (r *PgxBillRepository) DeleteBill(ctx context.Context, billID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM bills WHERE bill_id = $1;`, billID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete bill "+billID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// MarkBillPosted records the posting journal of a draft bill.
+func (r *PgxBillRepository) MarkBillPosted(ctx context.Context, billID string, journalID string, userID string, at time.Time) error {
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE bills
+		SET post_journal_id = $1, last_updated_at = $2, last_updated_by = $3
+		WHERE bill_id = $4 AND post_journal_id IS NULL;
+	`, journalID, at, userID, billID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to mark bill "+billID+" posted", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// SaveBillPayment records a payment made against a bill.
+func (r *PgxBillRepository) SaveBillPayment(ctx context.Context, payment domain.BillPayment) error {
+	m := mapping.ToModelBillPayment(payment)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO bill_payments (
+			payment_id, bill_id, journal_id, payment_account_id, scheduled_payment_id, payment_date, amount, created_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`, m.PaymentID, m.BillID, m.JournalID, m.PaymentAccountID, nullableString(m.ScheduledPaymentID), m.PaymentDate,
+		m.Amount, m.CreatedAt, m.CreatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save payment of bill "+m.BillID, err)
+	}
+	return nil
+}
+
+// SaveScheduledBillPayment records a payment planned against a bill.
+func (r *PgxBillRepository) SaveScheduledBillPayment(ctx context.Context, scheduled domain.ScheduledBillPayment) error {
+	m := mapping.ToModelScheduledBillPayment(scheduled)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO bill_scheduled_payments (
+			scheduled_payment_id, bill_id, payment_account_id, scheduled_date, amount, created_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7);
+	`, m.ScheduledPaymentID, m.BillID, m.PaymentAccountID, m.ScheduledDate, m.Amount, m.CreatedAt, m.CreatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save scheduled payment of bill "+m.BillID, err)
+	}
+	return nil
+}
+
+// DeleteScheduledBillPayment removes a scheduled payment.
+func (r *PgxBillRepository) DeleteScheduledBillPayment(ctx context.Context, scheduledPaymentID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM bill_scheduled_payments WHERE scheduled_payment_id = $1;`, scheduledPaymentID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete scheduled bill payment "+scheduledPaymentID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindBillByID retrieves a bill with its lines, posted payments and scheduled payments.
+func (r *PgxBillRepository) FindBillByID(ctx context.Context, billID string) (*domain.Bill, error) {
+	bill, err := scanBill(r.Pool.QueryRow(ctx, selectBills+`WHERE b.bill_id = $1;`, billID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find bill by ID", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, `
+		SELECT line_id, bill_id, line_number, description, expense_account_id, tax_code_id, amount, net_amount, tax_amount
+		FROM bill_lines
+		WHERE bill_id = $1
+		ORDER BY line_number;
+	`, billID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query lines of bill "+billID, err)
+	}
+	defer rows.Close()
+
+	bill.Lines = []domain.BillLine{}
+	for rows.Next() {
+		var m models.BillLine
+		var taxCodeID sql.NullString
+		if err := rows.Scan(&m.LineID, &m.BillID, &m.LineNumber, &m.Description, &m.ExpenseAccountID, &taxCodeID,
+			&m.Amount, &m.NetAmount, &m.TaxAmount); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan bill line", err)
+		}
+		m.TaxCodeID = taxCodeID.String
+		bill.Lines = append(bill.Lines, mapping.ToDomainBillLine(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating bill lines", err)
+	}
+
+	bills := []domain.Bill{bill}
+	if err := r.loadBillPayments(ctx, bills); err != nil {
+		return nil, err
+	}
+	return &bills[0], nil
+}
+
+// ListBills retrieves the bills of a workplace, optionally of one vendor, newest first.
+func (r *PgxBillRepository) ListBills(ctx context.Context, workplaceID string, vendorID string) ([]domain.Bill, error) {
+	rows, err := r.Pool.Query(ctx, selectBills+`
+		WHERE b.workplace_id = $1 AND ($2 = '' OR b.vendor_id = $2)
+		ORDER BY b.bill_date DESC, b.created_at DESC;
+	`, workplaceID, vendorID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query bills", err)
+	}
+	defer rows.Close()
+
+	bills := []domain.Bill{}
+	for rows.Next() {
+		bill, err := scanBill(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan bill", err)
+		}
+		bills = append(bills, bill)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating bills", err)
+	}
+
+	if err := r.loadBillPayments(ctx, bills); err != nil {
+		return nil, err
+	}
+	return bills, nil
+}
+
+// ListDueScheduledBillPayments retrieves the pending scheduled payments of posted bills due on or before a date.
+func (r *PgxBillRepository) ListDueScheduledBillPayments(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.ScheduledBillPayment, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT * FROM (`+selectScheduledBillPayments+`
+			JOIN bills b ON b.bill_id = s.bill_id
+			JOIN journals j ON j.journal_id = b.post_journal_id
+			WHERE b.workplace_id = $1 AND j.status = 'POSTED' AND s.scheduled_date <= $2
+		) due
+		WHERE due.payment_id IS NULL
+		ORDER BY due.scheduled_date, due.created_at;
+	`, workplaceID, asOf)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query due scheduled bill payments", err)
+	}
+	defer rows.Close()
+
+	scheduled := []domain.ScheduledBillPayment{}
+	for rows.Next() {
+		payment, err := scanScheduledBillPayment(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan scheduled bill payment", err)
+		}
+		scheduled = append(scheduled, payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating scheduled bill payments", err)
+	}
+	return scheduled, nil
+}
+
+// loadBillPayments attaches to each bill the payments whose journals are still posted and its scheduled payments,
+// oldest first
+func (r *PgxBillRepository) loadBillPayments(ctx context.Context, bills []domain.Bill) error {
+	index := make(map[string]int, len(bills))
+	billIDs := make([]string, len(bills))
+	for i := range bills {
+		bills[i].Payments = []domain.BillPayment{}
+		bills[i].ScheduledPayments = []domain.ScheduledBillPayment{}
+		index[bills[i].BillID] = i
+		billIDs[i] = bills[i].BillID
+	}
+	if len(billIDs) == 0 {
+		return nil
+	}
+
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			p.payment_id, p.bill_id, p.journal_id, p.payment_account_id, p.scheduled_payment_id, p.payment_date, p.amount,
+			p.created_at, p.created_by
+		FROM bill_payments p
+		JOIN journals j ON j.journal_id = p.journal_id
+		WHERE p.bill_id = ANY($1) AND j.status = 'POSTED'
+		ORDER BY p.payment_date, p.created_at, p.payment_id;
+	`, billIDs)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to query bill payments", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m models.BillPayment
+		var scheduledPaymentID sql.NullString
+		if err := rows.Scan(&m.PaymentID, &m.BillID, &m.JournalID, &m.PaymentAccountID, &scheduledPaymentID,
+			&m.PaymentDate, &m.Amount, &m.CreatedAt, &m.CreatedBy); err != nil {
+			return apperrors.NewAppError(500, "failed to scan bill payment", err)
+		}
+		m.ScheduledPaymentID = scheduledPaymentID.String
+		i := index[m.BillID]
+		bills[i].Payments = append(bills[i].Payments, mapping.ToDomainBillPayment(m))
+	}
+	if err := rows.Err(); err != nil {
+		return apperrors.NewAppError(500, "error iterating bill payments", err)
+	}
+	rows.Close()
+
+	scheduledRows, err := r.Pool.Query(ctx, selectScheduledBillPayments+`
+		WHERE s.bill_id = ANY($1)
+		ORDER BY s.scheduled_date, s.created_at;
+	`, billIDs)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to query scheduled bill payments", err)
+	}
+	defer scheduledRows.Close()
+
+	for scheduledRows.Next() {
+		scheduled, err := scanScheduledBillPayment(scheduledRows)
+		if err != nil {
+			return apperrors.NewAppError(500, "failed to scan scheduled bill payment", err)
+		}
+		i := index[scheduled.BillID]
+		bills[i].ScheduledPayments = append(bills[i].ScheduledPayments, scheduled)
+	}
+	if err := scheduledRows.Err(); err != nil {
+		return apperrors.NewAppError(500, "error iterating scheduled bill payments", err)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxBudgetRepository implements the budget repository using pgxpool.
+type PgxBudgetRepository struct {
+	BaseRepository
+}
+
+// newPgxBudgetRepository creates a new repository for budget data.
+func newPgxBudgetRepository(pool *pgxpool.Pool) portsrepo.BudgetRepositoryWithTx {
+	return &PgxBudgetRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.BudgetRepositoryWithTx = (*PgxBudgetRepository)(nil)
+
+// SaveBudget inserts a new budget and its lines in a single transaction.
+func (r *PgxBudgetRepository) SaveBudget(ctx context.Context, budget domain.Budget) error {
+	modelBudget := mapping.ToModelBudget(budget)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	var description sql.NullString
+	if modelBudget.Description != "" {
+		description = sql.NullString{String: modelBudget.Description, Valid: true}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO budgets (
+			budget_id, workplace_id, name, description, period_type, budget_year,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`,
+		modelBudget.BudgetID,
+		modelBudget.WorkplaceID,
+		modelBudget.Name,
+		description,
+		modelBudget.PeriodType,
+		modelBudget.Year,
+		modelBudget.CreatedAt,
+		modelBudget.CreatedBy,
+		modelBudget.LastUpdatedAt,
+		modelBudget.LastUpdatedBy,
+	)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save budget "+modelBudget.BudgetID, err)
+	}
+
+	if err := r.upsertLines(ctx, tx, mapping.ToModelBudgetLines(budget.BudgetID, budget.Lines)); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// UpdateBudget updates a budget's name and description.
+func (r *PgxBudgetRepository) UpdateBudget(ctx context.Context, budget domain.Budget) error {
+	modelBudget := mapping.ToModelBudget(budget)
+
+	var description sql.NullString
+	if modelBudget.Description != "" {
+		description = sql.NullString{String: modelBudget.Description, Valid: true}
+	}
+
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE budgets
+		SET name = $1, description = $2, last_updated_at = $3, last_updated_by = $4
+		WHERE budget_id = $5;
+	`, modelBudget.Name, description, modelBudget.LastUpdatedAt, modelBudget.LastUpdatedBy, modelBudget.BudgetID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update budget "+modelBudget.BudgetID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// SetBudgetLines inserts or replaces the given lines of a budget; lines with a zero amount are removed.
+func (r *PgxBudgetRepository) SetBudgetLines(ctx context.Context, budgetID string, lines []domain.BudgetLine, userID string, now time.Time) error {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE budgets SET last_updated_at = $1, last_updated_by = $2 WHERE budget_id = $3;
+	`, now, userID, budgetID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to touch budget "+budgetID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	if err := r.upsertLines(ctx, tx, mapping.ToModelBudgetLines(budgetID, lines)); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// upsertLines writes budget lines in a batch, deleting those with a zero amount
+func (r *PgxBudgetRepository) upsertLines(ctx context.Context, tx pgx.Tx, lines []models.BudgetLine) error {
+	if len(lines) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, line := range lines {
+		if line.Amount.IsZero() {
+			batch.Queue(`
+				DELETE FROM budget_lines
+				WHERE budget_id = $1 AND account_id = $2 AND period_start = $3;
+			`, line.BudgetID, line.AccountID, line.PeriodStart)
+			continue
+		}
+		batch.Queue(`
+			INSERT INTO budget_lines (budget_id, account_id, period_start, amount)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (budget_id, account_id, period_start) DO UPDATE SET amount = EXCLUDED.amount;
+		`, line.BudgetID, line.AccountID, line.PeriodStart, line.Amount)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range lines {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return apperrors.NewAppError(500, "failed to save budget lines", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save budget lines", err)
+	}
+	return nil
+}
+
+// DeleteBudget removes a budget; its lines are removed by the cascading foreign key.
+func (r *PgxBudgetRepository) DeleteBudget(ctx context.Context, budgetID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM budgets WHERE budget_id = $1;`, budgetID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete budget "+budgetID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindBudgetByID retrieves a budget together with its lines.
+func (r *PgxBudgetRepository) FindBudgetByID(ctx context.Context, budgetID string) (*domain.Budget, error) {
+	var modelBudget models.Budget
+	var description sql.NullString
+
+	err := r.Pool.QueryRow(ctx, `
+		SELECT
+			budget_id, workplace_id, name, description, period_type, budget_year,
+			created_at, created_by, last_updated_at, last_updated_by
+		FROM budgets
+		WHERE budget_id = $1;
+	`, budgetID).Scan(
+		&modelBudget.BudgetID,
+		&modelBudget.WorkplaceID,
+		&modelBudget.Name,
+		&description,
+		&modelBudget.PeriodType,
+		&modelBudget.Year,
+		&modelBudget.CreatedAt,
+		&modelBudget.CreatedBy,
+		&modelBudget.LastUpdatedAt,
+		&modelBudget.LastUpdatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find budget by ID", err)
+	}
+	modelBudget.Description = description.String
+
+	rows, err := r.Pool.Query(ctx, `
+		SELECT l.budget_id, l.account_id, l.period_start, l.amount
+		FROM budget_lines l
+		JOIN accounts a ON a.account_id = l.account_id
+		WHERE l.budget_id = $1
+		ORDER BY a.account_type, a.name, l.period_start;
+	`, budgetID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query budget lines", err)
+	}
+	defer rows.Close()
+
+	lines := []models.BudgetLine{}
+	for rows.Next() {
+		var line models.BudgetLine
+		if err := rows.Scan(&line.BudgetID, &line.AccountID, &line.PeriodStart, &line.Amount); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan budget line", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating budget lines", err)
+	}
+
+	budget := mapping.ToDomainBudget(modelBudget, lines)
+	return &budget, nil
+}
+
+// ListBudgets retrieves the budgets of a workplace without their lines, newest year first.
+func (r *PgxBudgetRepository) ListBudgets(ctx context.Context, workplaceID string) ([]domain.Budget, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			budget_id, workplace_id, name, description, period_type, budget_year,
+			created_at, created_by, last_updated_at, last_updated_by
+		FROM budgets
+		WHERE workplace_id = $1
+		ORDER BY budget_year DESC, name;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query budgets for workplace", err)
+	}
+	defer rows.Close()
+
+	budgets := []domain.Budget{}
+	for rows.Next() {
+		var modelBudget models.Budget
+		var description sql.NullString
+		if err := rows.Scan(
+			&modelBudget.BudgetID,
+			&modelBudget.WorkplaceID,
+			&modelBudget.Name,
+			&description,
+			&modelBudget.PeriodType,
+			&modelBudget.Year,
+			&modelBudget.CreatedAt,
+			&modelBudget.CreatedBy,
+			&modelBudget.LastUpdatedAt,
+			&modelBudget.LastUpdatedBy,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan budget", err)
+		}
+		modelBudget.Description = description.String
+		budgets = append(budgets, mapping.ToDomainBudget(modelBudget, nil))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating budgets", err)
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,162 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxCategorizationRuleRepository implements the categorization rule repository using pgxpool.
+type PgxCategorizationRuleRepository struct {
+	BaseRepository
+}
+
+// newPgxCategorizationRuleRepository creates a new repository for categorization rule data.
+func newPgxCategorizationRuleRepository(pool *pgxpool.Pool) portsrepo.CategorizationRuleRepositoryWithTx {
+	return &PgxCategorizationRuleRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.CategorizationRuleRepositoryWithTx = (*PgxCategorizationRuleRepository)(nil)
+
+// selectCategorizationRules selects categorization rules
+const selectCategorizationRules = `
+	SELECT
+		rule_id, workplace_id, name, priority, is_active, payee_pattern, description_pattern, min_amount, max_amount,
+		source_account_id, sign, counter_account_id, journal_description, notes,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM categorization_rules
+`
+
+// scanCategorizationRule scans a row produced by selectCategorizationRules
+func scanCategorizationRule(row pgx.Row) (domain.CategorizationRule, error) {
+	var m models.CategorizationRule
+	var payeePattern, descriptionPattern, sourceAccountID, journalDescription, notes sql.NullString
+	if err := row.Scan(
+		&m.RuleID,
+		&m.WorkplaceID,
+		&m.Name,
+		&m.Priority,
+		&m.IsActive,
+		&payeePattern,
+		&descriptionPattern,
+		&m.MinAmount,
+		&m.MaxAmount,
+		&sourceAccountID,
+		&m.Sign,
+		&m.CounterAccountID,
+		&journalDescription,
+		&notes,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.CategorizationRule{}, err
+	}
+	m.PayeePattern = payeePattern.String
+	m.DescriptionPattern = descriptionPattern.String
+	m.SourceAccountID = sourceAccountID.String
+	m.JournalDescription = journalDescription.String
+	m.Notes = notes.String
+	return mapping.ToDomainCategorizationRule(m), nil
+}
+
+// SaveCategorizationRule persists a new categorization rule.
+func (r *PgxCategorizationRuleRepository) SaveCategorizationRule(ctx context.Context, rule domain.CategorizationRule) error {
+	m := mapping.ToModelCategorizationRule(rule)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO categorization_rules (
+			rule_id, workplace_id, name, priority, is_active, payee_pattern, description_pattern, min_amount, max_amount,
+			source_account_id, sign, counter_account_id, journal_description, notes,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18);
+	`, m.RuleID, m.WorkplaceID, m.Name, m.Priority, m.IsActive, nullableString(m.PayeePattern), nullableString(m.DescriptionPattern),
+		m.MinAmount, m.MaxAmount, nullableString(m.SourceAccountID), m.Sign, m.CounterAccountID,
+		nullableString(m.JournalDescription), nullableString(m.Notes),
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save categorization rule "+m.RuleID, err)
+	}
+	return nil
+}
+
+// UpdateCategorizationRule updates an existing categorization rule.
+func (r *PgxCategorizationRuleRepository) UpdateCategorizationRule(ctx context.Context, rule domain.CategorizationRule) error {
+	m := mapping.ToModelCategorizationRule(rule)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE categorization_rules
+		SET name = $1, priority = $2, is_active = $3, payee_pattern = $4, description_pattern = $5, min_amount = $6,
+			max_amount = $7, source_account_id = $8, sign = $9, counter_account_id = $10, journal_description = $11,
+			notes = $12, last_updated_at = $13, last_updated_by = $14
+		WHERE rule_id = $15;
+	`, m.Name, m.Priority, m.IsActive, nullableString(m.PayeePattern), nullableString(m.DescriptionPattern), m.MinAmount,
+		m.MaxAmount, nullableString(m.SourceAccountID), m.Sign, m.CounterAccountID, nullableString(m.JournalDescription),
+		nullableString(m.Notes), m.LastUpdatedAt, m.LastUpdatedBy, m.RuleID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update categorization rule "+m.RuleID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteCategorizationRule removes a categorization rule; lines it matched keep their counter-account.
+func (r *PgxCategorizationRuleRepository) DeleteCategorizationRule(ctx context.Context, ruleID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM categorization_rules WHERE rule_id = $1;`, ruleID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete categorization rule "+ruleID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindCategorizationRuleByID retrieves a categorization rule.
+func (r *PgxCategorizationRuleRepository) FindCategorizationRuleByID(ctx context.Context, ruleID string) (*domain.CategorizationRule, error) {
+	rule, err := scanCategorizationRule(r.Pool.QueryRow(ctx, selectCategorizationRules+`WHERE rule_id = $1;`, ruleID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find categorization rule by ID", err)
+	}
+	return &rule, nil
+}
+
+// ListCategorizationRules retrieves the rules of a workplace in ascending priority, then by name.
+func (r *PgxCategorizationRuleRepository) ListCategorizationRules(ctx context.Context, workplaceID string) ([]domain.CategorizationRule, error) {
+	rows, err := r.Pool.Query(ctx, selectCategorizationRules+`
+		WHERE workplace_id = $1
+		ORDER BY priority, name, rule_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query categorization rules", err)
+	}
+	defer rows.Close()
+
+	rules := []domain.CategorizationRule{}
+	for rows.Next() {
+		rule, err := scanCategorizationRule(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan categorization rule", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating categorization rules", err)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,314 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// PgxConsolidationRepository implements the consolidation repository using pgxpool.
+type PgxConsolidationRepository struct {
+	BaseRepository
+}
+
+// newPgxConsolidationRepository creates a new repository for consolidation groups.
+func newPgxConsolidationRepository(pool *pgxpool.Pool) portsrepo.ConsolidationRepositoryWithTx {
+	return &PgxConsolidationRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.ConsolidationRepositoryWithTx = (*PgxConsolidationRepository)(nil)
+
+// selectConsolidationGroups selects consolidation groups
+const selectConsolidationGroups = `
+	SELECT
+		group_id, workplace_id, name, description, currency_code,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM consolidation_groups
+`
+
+// scanConsolidationGroup scans a row produced by selectConsolidationGroups
+func scanConsolidationGroup(row pgx.Row) (domain.ConsolidationGroup, error) {
+	var m models.ConsolidationGroup
+	var description sql.NullString
+	if err := row.Scan(
+		&m.GroupID,
+		&m.WorkplaceID,
+		&m.Name,
+		&description,
+		&m.CurrencyCode,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.ConsolidationGroup{}, err
+	}
+	m.Description = description.String
+	return mapping.ToDomainConsolidationGroup(m), nil
+}
+
+// queueConsolidationDetails queues the inserts of the members and account mappings of a group.
+func queueConsolidationDetails(batch *pgx.Batch, group domain.ConsolidationGroup) {
+	for i, workplaceID := range group.MemberIDs {
+		batch.Queue(`INSERT INTO consolidation_group_members (group_id, workplace_id, position) VALUES ($1, $2, $3);`,
+			group.GroupID, workplaceID, i)
+	}
+	for _, account := range group.Accounts {
+		m := mapping.ToModelConsolidationAccountMapping(group.GroupID, account)
+		batch.Queue(`
+			INSERT INTO consolidation_account_mappings (group_id, account_id, group_code, intercompany)
+			VALUES ($1, $2, $3, $4);
+		`, m.GroupID, m.AccountID, nullableString(m.GroupCode), m.Intercompany)
+	}
+}
+
+// SaveConsolidationGroup persists a new group with its members and account mappings.
+func (r *PgxConsolidationRepository) SaveConsolidationGroup(ctx context.Context, group domain.ConsolidationGroup) error {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to begin transaction", err)
+	}
+	defer r.Rollback(ctx, tx)
+
+	m := mapping.ToModelConsolidationGroup(group)
+	batch := &pgx.Batch{}
+	batch.Queue(`
+		INSERT INTO consolidation_groups (
+			group_id, workplace_id, name, description, currency_code,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`, m.GroupID, m.WorkplaceID, m.Name, nullableString(m.Description), m.CurrencyCode,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	queueConsolidationDetails(batch, group)
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrNotFound
+		}
+		return apperrors.NewAppError(500, "failed to save consolidation group "+m.GroupID, err)
+	}
+
+	if err := r.Commit(ctx, tx); err != nil {
+		return apperrors.NewAppError(500, "failed to commit consolidation group "+m.GroupID, err)
+	}
+	return nil
+}
+
+// UpdateConsolidationGroup updates a group and replaces its members and account mappings.
+func (r *PgxConsolidationRepository) UpdateConsolidationGroup(ctx context.Context, group domain.ConsolidationGroup) error {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to begin transaction", err)
+	}
+	defer r.Rollback(ctx, tx)
+
+	m := mapping.ToModelConsolidationGroup(group)
+	tag, err := tx.Exec(ctx, `
+		UPDATE consolidation_groups
+		SET name = $1, description = $2, currency_code = $3, last_updated_at = $4, last_updated_by = $5
+		WHERE group_id = $6;
+	`, m.Name, nullableString(m.Description), m.CurrencyCode, m.LastUpdatedAt, m.LastUpdatedBy, m.GroupID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update consolidation group "+m.GroupID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM consolidation_group_members WHERE group_id = $1;`, m.GroupID)
+	batch.Queue(`DELETE FROM consolidation_account_mappings WHERE group_id = $1;`, m.GroupID)
+	queueConsolidationDetails(batch, group)
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrNotFound
+		}
+		return apperrors.NewAppError(500, "failed to replace details of consolidation group "+m.GroupID, err)
+	}
+
+	if err := r.Commit(ctx, tx); err != nil {
+		return apperrors.NewAppError(500, "failed to commit consolidation group "+m.GroupID, err)
+	}
+	return nil
+}
+
+// DeleteConsolidationGroup removes a group; members and account mappings are removed by cascade.
+func (r *PgxConsolidationRepository) DeleteConsolidationGroup(ctx context.Context, groupID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM consolidation_groups WHERE group_id = $1;`, groupID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete consolidation group "+groupID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindConsolidationGroupByID retrieves a group with its members and account mappings.
+func (r *PgxConsolidationRepository) FindConsolidationGroupByID(ctx context.Context, groupID string) (*domain.ConsolidationGroup, error) {
+	group, err := scanConsolidationGroup(r.Pool.QueryRow(ctx, selectConsolidationGroups+`WHERE group_id = $1;`, groupID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find consolidation group by ID", err)
+	}
+	groups := []domain.ConsolidationGroup{group}
+	if err := r.loadConsolidationDetails(ctx, groups); err != nil {
+		return nil, err
+	}
+	return &groups[0], nil
+}
+
+// ListConsolidationGroups retrieves the groups managed from a workplace, ordered by name.
+func (r *PgxConsolidationRepository) ListConsolidationGroups(ctx context.Context, workplaceID string) ([]domain.ConsolidationGroup, error) {
+	rows, err := r.Pool.Query(ctx, selectConsolidationGroups+`WHERE workplace_id = $1 ORDER BY name;`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query consolidation groups", err)
+	}
+	defer rows.Close()
+
+	groups := []domain.ConsolidationGroup{}
+	for rows.Next() {
+		group, err := scanConsolidationGroup(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan consolidation group", err)
+		}
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating consolidation groups", err)
+	}
+	if len(groups) == 0 {
+		return groups, nil
+	}
+	if err := r.loadConsolidationDetails(ctx, groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// loadConsolidationDetails fills in the members and account mappings of the given groups.
+func (r *PgxConsolidationRepository) loadConsolidationDetails(ctx context.Context, groups []domain.ConsolidationGroup) error {
+	index := make(map[string]int, len(groups))
+	groupIDs := make([]string, len(groups))
+	for i, group := range groups {
+		index[group.GroupID] = i
+		groupIDs[i] = group.GroupID
+	}
+
+	rows, err := r.Pool.Query(ctx, `
+		SELECT group_id, workplace_id FROM consolidation_group_members
+		WHERE group_id = ANY($1)
+		ORDER BY group_id, position;
+	`, groupIDs)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to query consolidation group members", err)
+	}
+	for rows.Next() {
+		var groupID, workplaceID string
+		if err := rows.Scan(&groupID, &workplaceID); err != nil {
+			rows.Close()
+			return apperrors.NewAppError(500, "failed to scan consolidation group member", err)
+		}
+		if i, ok := index[groupID]; ok {
+			groups[i].MemberIDs = append(groups[i].MemberIDs, workplaceID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return apperrors.NewAppError(500, "error iterating consolidation group members", err)
+	}
+
+	rows, err = r.Pool.Query(ctx, `
+		SELECT group_id, account_id, group_code, intercompany FROM consolidation_account_mappings
+		WHERE group_id = ANY($1)
+		ORDER BY group_id, account_id;
+	`, groupIDs)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to query consolidation account mappings", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var m models.ConsolidationAccountMapping
+		var groupCode sql.NullString
+		if err := rows.Scan(&m.GroupID, &m.AccountID, &groupCode, &m.Intercompany); err != nil {
+			return apperrors.NewAppError(500, "failed to scan consolidation account mapping", err)
+		}
+		m.GroupCode = groupCode.String
+		if i, ok := index[m.GroupID]; ok {
+			groups[i].Accounts = append(groups[i].Accounts, mapping.ToDomainConsolidationAccountMapping(m))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperrors.NewAppError(500, "error iterating consolidation account mappings", err)
+	}
+	return nil
+}
+
+// GetConsolidationBalances retrieves the net debit-positive posted amount of the accounts of several workplaces.
+func (r *PgxConsolidationRepository) GetConsolidationBalances(ctx context.Context, workplaceIDs []string, from *time.Time, to time.Time) ([]domain.ConsolidationBalance, error) {
+	query := `
+		SELECT
+			a.workplace_id,
+			a.account_id,
+			a.cfid,
+			a.name,
+			a.account_type,
+			a.currency_code,
+			SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END) AS net
+		FROM transactions t
+		JOIN accounts a ON t.account_id = a.account_id
+		JOIN journals j ON t.journal_id = j.journal_id
+		WHERE a.workplace_id = ANY($1)
+			AND ($2::timestamptz IS NULL OR j.journal_date >= $2)
+			AND j.journal_date <= $3
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+		GROUP BY a.workplace_id, a.account_id, a.cfid, a.name, a.account_type, a.currency_code
+		ORDER BY a.workplace_id, a.name
+	`
+
+	rows, err := r.Pool.Query(ctx, query, workplaceIDs, from, to)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query consolidation balances", err)
+	}
+	defer rows.Close()
+
+	balances := []domain.ConsolidationBalance{}
+	for rows.Next() {
+		var balance domain.ConsolidationBalance
+		var cfid sql.NullString
+		var accountType string
+		var net decimal.Decimal
+		if err := rows.Scan(&balance.WorkplaceID, &balance.AccountID, &cfid, &balance.Name, &accountType,
+			&balance.CurrencyCode, &net); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan consolidation balance", err)
+		}
+		balance.CFID = cfid.String
+		balance.AccountType = domain.AccountType(accountType)
+		balance.NetAmount = net
+		balances = append(balances, balance)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating consolidation balances", err)
+	}
+	return balances, nil
+}
@@ -0,0 +1,180 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// PgxCreditCardRepository implements the credit card repository using pgxpool.
+type PgxCreditCardRepository struct {
+	BaseRepository
+}
+
+// newPgxCreditCardRepository creates a new repository for credit card billing cycles.
+func newPgxCreditCardRepository(pool *pgxpool.Pool) portsrepo.CreditCardRepositoryWithTx {
+	return &PgxCreditCardRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.CreditCardRepositoryWithTx = (*PgxCreditCardRepository)(nil)
+
+// selectCreditCards selects credit cards
+const selectCreditCards = `
+	SELECT
+		account_id, workplace_id, closing_day, due_day, credit_limit, minimum_payment_percent, minimum_payment_amount,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM credit_cards
+`
+
+// scanCreditCard scans a row produced by selectCreditCards
+func scanCreditCard(row pgx.Row) (domain.CreditCard, error) {
+	var m models.CreditCard
+	if err := row.Scan(
+		&m.AccountID,
+		&m.WorkplaceID,
+		&m.ClosingDay,
+		&m.DueDay,
+		&m.CreditLimit,
+		&m.MinimumPaymentPercent,
+		&m.MinimumPaymentAmount,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.CreditCard{}, err
+	}
+	return mapping.ToDomainCreditCard(m), nil
+}
+
+// SaveCreditCard persists the billing-cycle settings of an account.
+func (r *PgxCreditCardRepository) SaveCreditCard(ctx context.Context, card domain.CreditCard) error {
+	m := mapping.ToModelCreditCard(card)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO credit_cards (
+			account_id, workplace_id, closing_day, due_day, credit_limit, minimum_payment_percent, minimum_payment_amount,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+	`, m.AccountID, m.WorkplaceID, m.ClosingDay, m.DueDay, m.CreditLimit, m.MinimumPaymentPercent, m.MinimumPaymentAmount,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save credit card "+m.AccountID, err)
+	}
+	return nil
+}
+
+// UpdateCreditCard replaces the billing-cycle settings of an account.
+func (r *PgxCreditCardRepository) UpdateCreditCard(ctx context.Context, card domain.CreditCard) error {
+	m := mapping.ToModelCreditCard(card)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE credit_cards
+		SET closing_day = $1, due_day = $2, credit_limit = $3, minimum_payment_percent = $4, minimum_payment_amount = $5,
+			last_updated_at = $6, last_updated_by = $7
+		WHERE account_id = $8;
+	`, m.ClosingDay, m.DueDay, m.CreditLimit, m.MinimumPaymentPercent, m.MinimumPaymentAmount,
+		m.LastUpdatedAt, m.LastUpdatedBy, m.AccountID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update credit card "+m.AccountID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteCreditCard removes the billing-cycle settings of an account.
+func (r *PgxCreditCardRepository) DeleteCreditCard(ctx context.Context, accountID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM credit_cards WHERE account_id = $1;`, accountID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete credit card "+accountID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindCreditCardByAccountID retrieves the billing-cycle settings of an account.
+func (r *PgxCreditCardRepository) FindCreditCardByAccountID(ctx context.Context, accountID string) (*domain.CreditCard, error) {
+	card, err := scanCreditCard(r.Pool.QueryRow(ctx, selectCreditCards+`WHERE account_id = $1;`, accountID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find credit card by account ID", err)
+	}
+	return &card, nil
+}
+
+// ListCreditCards retrieves the credit cards of a workplace, ordered by account name.
+func (r *PgxCreditCardRepository) ListCreditCards(ctx context.Context, workplaceID string) ([]domain.CreditCard, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			c.account_id, c.workplace_id, c.closing_day, c.due_day, c.credit_limit, c.minimum_payment_percent,
+			c.minimum_payment_amount, c.created_at, c.created_by, c.last_updated_at, c.last_updated_by
+		FROM credit_cards c
+		JOIN accounts a ON a.account_id = c.account_id
+		WHERE c.workplace_id = $1
+		ORDER BY lower(a.name), c.account_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query credit cards", err)
+	}
+	defer rows.Close()
+
+	cards := []domain.CreditCard{}
+	for rows.Next() {
+		card, err := scanCreditCard(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan credit card", err)
+		}
+		cards = append(cards, card)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating credit cards", err)
+	}
+	return cards, nil
+}
+
+// GetCreditCardBalanceBefore sums, as credits minus debits, the account's lines of posted, non-reversal journals
+// dated before a date.
+func (r *PgxCreditCardRepository) GetCreditCardBalanceBefore(ctx context.Context, workplaceID string, accountID string, before time.Time) (decimal.Decimal, error) {
+	var balance decimal.Decimal
+	err := r.Pool.QueryRow(ctx, `
+		SELECT COALESCE(SUM(CASE WHEN t.transaction_type = 'CREDIT' THEN t.amount ELSE -t.amount END), 0)
+		FROM transactions t
+		JOIN journals j ON t.journal_id = j.journal_id
+		WHERE t.account_id = $1 AND j.workplace_id = $2 AND j.status = 'POSTED' AND j.original_journal_id IS NULL
+			AND t.transaction_date < $3;
+	`, accountID, workplaceID, before).Scan(&balance)
+	if err != nil {
+		return decimal.Zero, apperrors.NewAppError(500, "failed to sum credit card balance for account "+accountID, err)
+	}
+	return balance, nil
+}
+
+// ListCreditCardTransactions retrieves the account's lines of posted, non-reversal journals dated in [from, to).
+func (r *PgxCreditCardRepository) ListCreditCardTransactions(ctx context.Context, workplaceID string, accountID string, from, to time.Time) ([]domain.Transaction, error) {
+	rows, err := r.Pool.Query(ctx, selectAccountTransactions+`
+		AND t.transaction_date >= $3 AND t.transaction_date < $4
+		ORDER BY t.transaction_date, t.created_at;
+	`, accountID, workplaceID, from, to)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query credit card transactions for account "+accountID, err)
+	}
+	return collectAccountTransactions(rows)
+}
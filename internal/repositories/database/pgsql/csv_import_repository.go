@@ -0,0 +1,170 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxCSVImportProfileRepository implements the CSV import profile repository using pgxpool.
+type PgxCSVImportProfileRepository struct {
+	BaseRepository
+}
+
+// newPgxCSVImportProfileRepository creates a new repository for CSV import profile data.
+func newPgxCSVImportProfileRepository(pool *pgxpool.Pool) portsrepo.CSVImportProfileRepositoryWithTx {
+	return &PgxCSVImportProfileRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.CSVImportProfileRepositoryWithTx = (*PgxCSVImportProfileRepository)(nil)
+
+// selectCSVImportProfiles selects CSV import profiles
+const selectCSVImportProfiles = `
+	SELECT
+		profile_id, workplace_id, account_id, name, delimiter, skip_rows, has_header, date_column, date_format,
+		amount_mode, amount_column, debit_column, credit_column, decimal_separator, payee_column, memo_column, reference_column,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM csv_import_profiles
+`
+
+// scanCSVImportProfile scans a row produced by selectCSVImportProfiles
+func scanCSVImportProfile(row pgx.Row) (domain.CSVImportProfile, error) {
+	var m models.CSVImportProfile
+	if err := row.Scan(
+		&m.ProfileID,
+		&m.WorkplaceID,
+		&m.AccountID,
+		&m.Name,
+		&m.Delimiter,
+		&m.SkipRows,
+		&m.HasHeader,
+		&m.DateColumn,
+		&m.DateFormat,
+		&m.AmountMode,
+		&m.AmountColumn,
+		&m.DebitColumn,
+		&m.CreditColumn,
+		&m.DecimalSeparator,
+		&m.PayeeColumn,
+		&m.MemoColumn,
+		&m.ReferenceColumn,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.CSVImportProfile{}, err
+	}
+	return mapping.ToDomainCSVImportProfile(m), nil
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// SaveCSVImportProfile persists a new CSV import profile.
+func (r *PgxCSVImportProfileRepository) SaveCSVImportProfile(ctx context.Context, profile domain.CSVImportProfile) error {
+	m := mapping.ToModelCSVImportProfile(profile)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO csv_import_profiles (
+			profile_id, workplace_id, account_id, name, delimiter, skip_rows, has_header, date_column, date_format,
+			amount_mode, amount_column, debit_column, credit_column, decimal_separator, payee_column, memo_column, reference_column,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21);
+	`, m.ProfileID, m.WorkplaceID, m.AccountID, m.Name, m.Delimiter, m.SkipRows, m.HasHeader, m.DateColumn, m.DateFormat,
+		m.AmountMode, m.AmountColumn, m.DebitColumn, m.CreditColumn, m.DecimalSeparator, m.PayeeColumn, m.MemoColumn, m.ReferenceColumn,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save CSV import profile "+m.ProfileID, err)
+	}
+	return nil
+}
+
+// UpdateCSVImportProfile updates an existing CSV import profile.
+func (r *PgxCSVImportProfileRepository) UpdateCSVImportProfile(ctx context.Context, profile domain.CSVImportProfile) error {
+	m := mapping.ToModelCSVImportProfile(profile)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE csv_import_profiles
+		SET name = $1, delimiter = $2, skip_rows = $3, has_header = $4, date_column = $5, date_format = $6,
+			amount_mode = $7, amount_column = $8, debit_column = $9, credit_column = $10, decimal_separator = $11,
+			payee_column = $12, memo_column = $13, reference_column = $14, last_updated_at = $15, last_updated_by = $16
+		WHERE profile_id = $17;
+	`, m.Name, m.Delimiter, m.SkipRows, m.HasHeader, m.DateColumn, m.DateFormat,
+		m.AmountMode, m.AmountColumn, m.DebitColumn, m.CreditColumn, m.DecimalSeparator,
+		m.PayeeColumn, m.MemoColumn, m.ReferenceColumn, m.LastUpdatedAt, m.LastUpdatedBy, m.ProfileID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update CSV import profile "+m.ProfileID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteCSVImportProfile removes a CSV import profile; statement lines imported with it are kept.
+func (r *PgxCSVImportProfileRepository) DeleteCSVImportProfile(ctx context.Context, profileID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM csv_import_profiles WHERE profile_id = $1;`, profileID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete CSV import profile "+profileID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindCSVImportProfileByID retrieves a CSV import profile.
+func (r *PgxCSVImportProfileRepository) FindCSVImportProfileByID(ctx context.Context, profileID string) (*domain.CSVImportProfile, error) {
+	profile, err := scanCSVImportProfile(r.Pool.QueryRow(ctx, selectCSVImportProfiles+`WHERE profile_id = $1;`, profileID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find CSV import profile by ID", err)
+	}
+	return &profile, nil
+}
+
+// ListCSVImportProfiles retrieves the profiles of a workplace by name. An empty account ID lists all accounts.
+func (r *PgxCSVImportProfileRepository) ListCSVImportProfiles(ctx context.Context, workplaceID string, accountID string) ([]domain.CSVImportProfile, error) {
+	rows, err := r.Pool.Query(ctx, selectCSVImportProfiles+`
+		WHERE workplace_id = $1 AND ($2::varchar = '' OR account_id = $2)
+		ORDER BY name, profile_id;
+	`, workplaceID, accountID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query CSV import profiles", err)
+	}
+	defer rows.Close()
+
+	profiles := []domain.CSVImportProfile{}
+	for rows.Next() {
+		profile, err := scanCSVImportProfile(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan CSV import profile", err)
+		}
+		profiles = append(profiles, profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating CSV import profiles", err)
+	}
+
+	return profiles, nil
+}
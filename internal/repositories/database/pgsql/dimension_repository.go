@@ -0,0 +1,370 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxDimensionRepository implements the dimension repository using pgxpool.
+type PgxDimensionRepository struct {
+	BaseRepository
+}
+
+// newPgxDimensionRepository creates a new repository for analytic dimensions.
+func newPgxDimensionRepository(pool *pgxpool.Pool) portsrepo.DimensionRepositoryWithTx {
+	return &PgxDimensionRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.DimensionRepositoryWithTx = (*PgxDimensionRepository)(nil)
+
+// selectDimensions selects dimensions
+const selectDimensions = `
+	SELECT
+		dimension_id, workplace_id, code, name, description, is_active,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM dimensions
+`
+
+// selectDimensionValues selects dimension values
+const selectDimensionValues = `
+	SELECT
+		value_id, dimension_id, code, name, is_active,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM dimension_values
+`
+
+// scanDimension scans a row produced by selectDimensions
+func scanDimension(row pgx.Row) (domain.Dimension, error) {
+	var m models.Dimension
+	var description sql.NullString
+	if err := row.Scan(
+		&m.DimensionID,
+		&m.WorkplaceID,
+		&m.Code,
+		&m.Name,
+		&description,
+		&m.IsActive,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.Dimension{}, err
+	}
+	m.Description = description.String
+	return mapping.ToDomainDimension(m), nil
+}
+
+// scanDimensionValue scans a row produced by selectDimensionValues
+func scanDimensionValue(row pgx.Row) (domain.DimensionValue, error) {
+	var m models.DimensionValue
+	if err := row.Scan(
+		&m.ValueID,
+		&m.DimensionID,
+		&m.Code,
+		&m.Name,
+		&m.IsActive,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.DimensionValue{}, err
+	}
+	return mapping.ToDomainDimensionValue(m), nil
+}
+
+// queueJournalDimensions queues the inserts of the values assigned to a journal.
+func queueJournalDimensions(batch *pgx.Batch, journalID string, tags []domain.DimensionTag) {
+	for _, tag := range tags {
+		batch.Queue(`INSERT INTO journal_dimensions (journal_id, dimension_id, value_id) VALUES ($1, $2, $3);`,
+			journalID, tag.DimensionID, tag.ValueID)
+	}
+}
+
+// queueTransactionDimensions queues the inserts of the values assigned to a journal line.
+func queueTransactionDimensions(batch *pgx.Batch, transactionID string, tags []domain.DimensionTag) {
+	for _, tag := range tags {
+		batch.Queue(`INSERT INTO transaction_dimensions (transaction_id, dimension_id, value_id) VALUES ($1, $2, $3);`,
+			transactionID, tag.DimensionID, tag.ValueID)
+	}
+}
+
+// SaveDimension persists a new dimension.
+func (r *PgxDimensionRepository) SaveDimension(ctx context.Context, dimension domain.Dimension) error {
+	m := mapping.ToModelDimension(dimension)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO dimensions (
+			dimension_id, workplace_id, code, name, description, is_active,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`, m.DimensionID, m.WorkplaceID, m.Code, m.Name, nullableString(m.Description), m.IsActive,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save dimension "+m.DimensionID, err)
+	}
+	return nil
+}
+
+// UpdateDimension updates a dimension.
+func (r *PgxDimensionRepository) UpdateDimension(ctx context.Context, dimension domain.Dimension) error {
+	m := mapping.ToModelDimension(dimension)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE dimensions
+		SET code = $1, name = $2, description = $3, is_active = $4, last_updated_at = $5, last_updated_by = $6
+		WHERE dimension_id = $7;
+	`, m.Code, m.Name, nullableString(m.Description), m.IsActive, m.LastUpdatedAt, m.LastUpdatedBy, m.DimensionID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update dimension "+m.DimensionID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteDimension removes a dimension and its values; dimensions whose values are in use cannot be deleted.
+func (r *PgxDimensionRepository) DeleteDimension(ctx context.Context, dimensionID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM dimensions WHERE dimension_id = $1;`, dimensionID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrConflict
+		}
+		return apperrors.NewAppError(500, "failed to delete dimension "+dimensionID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// SaveDimensionValue persists a new dimension value.
+func (r *PgxDimensionRepository) SaveDimensionValue(ctx context.Context, value domain.DimensionValue) error {
+	m := mapping.ToModelDimensionValue(value)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO dimension_values (
+			value_id, dimension_id, code, name, is_active,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`, m.ValueID, m.DimensionID, m.Code, m.Name, m.IsActive,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save dimension value "+m.ValueID, err)
+	}
+	return nil
+}
+
+// UpdateDimensionValue updates a dimension value.
+func (r *PgxDimensionRepository) UpdateDimensionValue(ctx context.Context, value domain.DimensionValue) error {
+	m := mapping.ToModelDimensionValue(value)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE dimension_values
+		SET code = $1, name = $2, is_active = $3, last_updated_at = $4, last_updated_by = $5
+		WHERE value_id = $6;
+	`, m.Code, m.Name, m.IsActive, m.LastUpdatedAt, m.LastUpdatedBy, m.ValueID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update dimension value "+m.ValueID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteDimensionValue removes a dimension value; values in use cannot be deleted.
+func (r *PgxDimensionRepository) DeleteDimensionValue(ctx context.Context, valueID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM dimension_values WHERE value_id = $1;`, valueID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrConflict
+		}
+		return apperrors.NewAppError(500, "failed to delete dimension value "+valueID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindDimensionByID retrieves a dimension with its values.
+func (r *PgxDimensionRepository) FindDimensionByID(ctx context.Context, dimensionID string) (*domain.Dimension, error) {
+	dimension, err := scanDimension(r.Pool.QueryRow(ctx, selectDimensions+`WHERE dimension_id = $1;`, dimensionID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find dimension by ID", err)
+	}
+	values, err := r.listDimensionValues(ctx, selectDimensionValues+`WHERE dimension_id = $1 ORDER BY code;`, dimensionID)
+	if err != nil {
+		return nil, err
+	}
+	dimension.Values = values
+	return &dimension, nil
+}
+
+// ListDimensions retrieves the dimensions of a workplace with their values, ordered by code.
+func (r *PgxDimensionRepository) ListDimensions(ctx context.Context, workplaceID string) ([]domain.Dimension, error) {
+	rows, err := r.Pool.Query(ctx, selectDimensions+`WHERE workplace_id = $1 ORDER BY code;`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query dimensions", err)
+	}
+	defer rows.Close()
+
+	dimensions := []domain.Dimension{}
+	index := make(map[string]int)
+	for rows.Next() {
+		dimension, err := scanDimension(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan dimension", err)
+		}
+		index[dimension.DimensionID] = len(dimensions)
+		dimensions = append(dimensions, dimension)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating dimensions", err)
+	}
+	if len(dimensions) == 0 {
+		return dimensions, nil
+	}
+
+	values, err := r.listDimensionValues(ctx, `
+		SELECT
+			v.value_id, v.dimension_id, v.code, v.name, v.is_active,
+			v.created_at, v.created_by, v.last_updated_at, v.last_updated_by
+		FROM dimension_values v
+		JOIN dimensions d ON d.dimension_id = v.dimension_id
+		WHERE d.workplace_id = $1
+		ORDER BY v.code;
+	`, workplaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		if i, ok := index[value.DimensionID]; ok {
+			dimensions[i].Values = append(dimensions[i].Values, value)
+		}
+	}
+	return dimensions, nil
+}
+
+// listDimensionValues runs a query producing selectDimensionValues rows.
+func (r *PgxDimensionRepository) listDimensionValues(ctx context.Context, query string, args ...any) ([]domain.DimensionValue, error) {
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query dimension values", err)
+	}
+	defer rows.Close()
+
+	values := []domain.DimensionValue{}
+	for rows.Next() {
+		value, err := scanDimensionValue(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan dimension value", err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating dimension values", err)
+	}
+	return values, nil
+}
+
+// FindJournalDimensions retrieves the values assigned to a journal and to each of its lines.
+func (r *PgxDimensionRepository) FindJournalDimensions(ctx context.Context, journalID string) ([]domain.DimensionTag, map[string][]domain.DimensionTag, error) {
+	journalTags := []domain.DimensionTag{}
+	rows, err := r.Pool.Query(ctx, `
+		SELECT dimension_id, value_id FROM journal_dimensions WHERE journal_id = $1 ORDER BY dimension_id;
+	`, journalID)
+	if err != nil {
+		return nil, nil, apperrors.NewAppError(500, "failed to query journal dimensions", err)
+	}
+	for rows.Next() {
+		var tag domain.DimensionTag
+		if err := rows.Scan(&tag.DimensionID, &tag.ValueID); err != nil {
+			rows.Close()
+			return nil, nil, apperrors.NewAppError(500, "failed to scan journal dimension", err)
+		}
+		journalTags = append(journalTags, tag)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, apperrors.NewAppError(500, "error iterating journal dimensions", err)
+	}
+
+	lineTags := make(map[string][]domain.DimensionTag)
+	rows, err = r.Pool.Query(ctx, `
+		SELECT td.transaction_id, td.dimension_id, td.value_id
+		FROM transaction_dimensions td
+		JOIN transactions t ON t.transaction_id = td.transaction_id
+		WHERE t.journal_id = $1
+		ORDER BY td.transaction_id, td.dimension_id;
+	`, journalID)
+	if err != nil {
+		return nil, nil, apperrors.NewAppError(500, "failed to query transaction dimensions", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var transactionID string
+		var tag domain.DimensionTag
+		if err := rows.Scan(&transactionID, &tag.DimensionID, &tag.ValueID); err != nil {
+			return nil, nil, apperrors.NewAppError(500, "failed to scan transaction dimension", err)
+		}
+		lineTags[transactionID] = append(lineTags[transactionID], tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, apperrors.NewAppError(500, "error iterating transaction dimensions", err)
+	}
+	return journalTags, lineTags, nil
+}
+
+// ReplaceJournalDimensions replaces the values assigned to a journal and to the given lines.
+func (r *PgxDimensionRepository) ReplaceJournalDimensions(ctx context.Context, journalID string, journalTags []domain.DimensionTag, lineTags map[string][]domain.DimensionTag) error {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to begin transaction", err)
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM journal_dimensions WHERE journal_id = $1;`, journalID)
+	queueJournalDimensions(batch, journalID, journalTags)
+	for transactionID, tags := range lineTags {
+		batch.Queue(`DELETE FROM transaction_dimensions WHERE transaction_id = $1;`, transactionID)
+		queueTransactionDimensions(batch, transactionID, tags)
+	}
+	if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrNotFound
+		}
+		return apperrors.NewAppError(500, "failed to replace dimensions of journal "+journalID, err)
+	}
+
+	if err := r.Commit(ctx, tx); err != nil {
+		return apperrors.NewAppError(500, "failed to commit dimensions of journal "+journalID, err)
+	}
+	return nil
+}
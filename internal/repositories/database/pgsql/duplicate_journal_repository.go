@@ -0,0 +1,199 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// PgxDuplicateJournalRepository implements the duplicate journal repository using pgxpool.
+type PgxDuplicateJournalRepository struct {
+	BaseRepository
+}
+
+// newPgxDuplicateJournalRepository creates a new repository for suspected duplicate journals.
+func newPgxDuplicateJournalRepository(pool *pgxpool.Pool) portsrepo.DuplicateJournalRepositoryWithTx {
+	return &PgxDuplicateJournalRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.DuplicateJournalRepositoryWithTx = (*PgxDuplicateJournalRepository)(nil)
+
+// selectDuplicatePairs selects pairs together with the details of both journals
+const selectDuplicatePairs = `
+	SELECT
+		p.pair_id, p.workplace_id, p.journal_id, p.duplicate_of_journal_id, p.similarity, p.status,
+		p.reversed_journal_id, p.resolution_note, p.resolved_at, p.resolved_by,
+		p.created_at, p.created_by, p.last_updated_at, p.last_updated_by,
+		j.journal_date, j.description, j.currency_code, j.amount, j.status,
+		d.journal_date, d.description, d.currency_code, d.amount, d.status
+	FROM duplicate_journal_pairs p
+	JOIN journals j ON p.journal_id = j.journal_id
+	JOIN journals d ON p.duplicate_of_journal_id = d.journal_id
+`
+
+// scanDuplicatePair scans a row produced by selectDuplicatePairs
+func scanDuplicatePair(row pgx.Row) (domain.DuplicateJournalPair, error) {
+	var m models.DuplicateJournalPair
+	var journal, duplicateOf domain.DuplicateJournalInfo
+	var journalDescription, duplicateOfDescription sql.NullString
+	if err := row.Scan(
+		&m.PairID, &m.WorkplaceID, &m.JournalID, &m.DuplicateOfJournalID, &m.Similarity, &m.Status,
+		&m.ReversedJournalID, &m.ResolutionNote, &m.ResolvedAt, &m.ResolvedBy,
+		&m.CreatedAt, &m.CreatedBy, &m.LastUpdatedAt, &m.LastUpdatedBy,
+		&journal.JournalDate, &journalDescription, &journal.CurrencyCode, &journal.Amount, &journal.Status,
+		&duplicateOf.JournalDate, &duplicateOfDescription, &duplicateOf.CurrencyCode, &duplicateOf.Amount, &duplicateOf.Status,
+	); err != nil {
+		return domain.DuplicateJournalPair{}, err
+	}
+
+	pair := mapping.ToDomainDuplicateJournalPair(m)
+	journal.JournalID, journal.Description = m.JournalID, journalDescription.String
+	duplicateOf.JournalID, duplicateOf.Description = m.DuplicateOfJournalID, duplicateOfDescription.String
+	pair.Journal, pair.DuplicateOf = &journal, &duplicateOf
+	return pair, nil
+}
+
+// FindDuplicateCandidates retrieves posted, non-reversal journals with the same currency and amount dated within the window.
+func (r *PgxDuplicateJournalRepository) FindDuplicateCandidates(ctx context.Context, workplaceID string, journalID string, currencyCode string, amount decimal.Decimal, from time.Time, to time.Time) ([]domain.Journal, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT journal_id, workplace_id, journal_date, description, currency_code, status, amount,
+		       created_at, created_by, last_updated_at, last_updated_by
+		FROM journals
+		WHERE workplace_id = $1 AND journal_id <> $2 AND currency_code = $3 AND amount = $4
+		  AND journal_date BETWEEN $5 AND $6
+		  AND status = 'POSTED' AND original_journal_id IS NULL
+		ORDER BY journal_date, created_at;
+	`, workplaceID, journalID, currencyCode, amount, from, to)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query duplicate journal candidates", err)
+	}
+	defer rows.Close()
+
+	journals := []domain.Journal{}
+	for rows.Next() {
+		var m models.Journal
+		var description sql.NullString
+		if err := rows.Scan(
+			&m.JournalID, &m.WorkplaceID, &m.JournalDate, &description, &m.CurrencyCode, &m.Status, &m.Amount,
+			&m.CreatedAt, &m.CreatedBy, &m.LastUpdatedAt, &m.LastUpdatedBy,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan duplicate journal candidate", err)
+		}
+		m.Description = description.String
+		journals = append(journals, mapping.ToDomainJournal(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating duplicate journal candidates", err)
+	}
+
+	return journals, nil
+}
+
+// FindDuplicatePairByID retrieves a suspected duplicate pair with the details of both journals.
+func (r *PgxDuplicateJournalRepository) FindDuplicatePairByID(ctx context.Context, pairID string) (*domain.DuplicateJournalPair, error) {
+	pair, err := scanDuplicatePair(r.Pool.QueryRow(ctx, selectDuplicatePairs+`WHERE p.pair_id = $1;`, pairID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find duplicate journal pair by ID", err)
+	}
+	return &pair, nil
+}
+
+// ListDuplicatePairs retrieves the pairs of a workplace, optionally filtered by status, newest first.
+func (r *PgxDuplicateJournalRepository) ListDuplicatePairs(ctx context.Context, workplaceID string, status domain.DuplicatePairStatus) ([]domain.DuplicateJournalPair, error) {
+	rows, err := r.Pool.Query(ctx, selectDuplicatePairs+`
+		WHERE p.workplace_id = $1 AND ($2::varchar = '' OR p.status = $2)
+		ORDER BY p.created_at DESC;
+	`, workplaceID, string(status))
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query duplicate journal pairs", err)
+	}
+	defer rows.Close()
+
+	pairs := []domain.DuplicateJournalPair{}
+	for rows.Next() {
+		pair, err := scanDuplicatePair(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan duplicate journal pair", err)
+		}
+		pairs = append(pairs, pair)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating duplicate journal pairs", err)
+	}
+
+	return pairs, nil
+}
+
+// SaveDuplicatePairs persists newly flagged pairs in a single transaction; pairs already recorded are skipped.
+func (r *PgxDuplicateJournalRepository) SaveDuplicatePairs(ctx context.Context, pairs []domain.DuplicateJournalPair) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	for _, pair := range pairs {
+		m := mapping.ToModelDuplicateJournalPair(pair)
+		batch.Queue(`
+			INSERT INTO duplicate_journal_pairs (
+				pair_id, workplace_id, journal_id, duplicate_of_journal_id, similarity, status,
+				created_at, created_by, last_updated_at, last_updated_by
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			ON CONFLICT (journal_id, duplicate_of_journal_id) DO NOTHING;
+		`, m.PairID, m.WorkplaceID, m.JournalID, m.DuplicateOfJournalID, m.Similarity, m.Status,
+			m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range pairs {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return apperrors.NewAppError(500, "failed to save duplicate journal pair", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save duplicate journal pair", err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// ResolveDuplicatePair records the review decision of a pair only if it is still pending,
+// so a pair cannot be confirmed and dismissed concurrently.
+func (r *PgxDuplicateJournalRepository) ResolveDuplicatePair(ctx context.Context, pair domain.DuplicateJournalPair) error {
+	m := mapping.ToModelDuplicateJournalPair(pair)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE duplicate_journal_pairs
+		SET status = $1, reversed_journal_id = $2, resolution_note = $3, resolved_at = $4, resolved_by = $5,
+			last_updated_at = $6, last_updated_by = $7
+		WHERE pair_id = $8 AND status = 'PENDING';
+	`, m.Status, m.ReversedJournalID, m.ResolutionNote, m.ResolvedAt, m.ResolvedBy,
+		m.LastUpdatedAt, m.LastUpdatedBy, m.PairID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to resolve duplicate journal pair "+m.PairID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrConflict
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxEnvelopeRepository implements the envelope budgeting repository using pgxpool.
+type PgxEnvelopeRepository struct {
+	BaseRepository
+}
+
+// newPgxEnvelopeRepository creates a new repository for envelope budgeting data.
+func newPgxEnvelopeRepository(pool *pgxpool.Pool) portsrepo.EnvelopeRepositoryWithTx {
+	return &PgxEnvelopeRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.EnvelopeRepositoryWithTx = (*PgxEnvelopeRepository)(nil)
+
+// SaveEnvelopeSettings inserts or replaces the envelope settings of a workplace.
+func (r *PgxEnvelopeRepository) SaveEnvelopeSettings(ctx context.Context, settings domain.EnvelopeSettings) error {
+	modelSettings := mapping.ToModelEnvelopeSettings(settings)
+
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO envelope_settings (workplace_id, start_month, created_at, created_by, last_updated_at, last_updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (workplace_id) DO UPDATE
+		SET start_month = EXCLUDED.start_month,
+			last_updated_at = EXCLUDED.last_updated_at,
+			last_updated_by = EXCLUDED.last_updated_by;
+	`,
+		modelSettings.WorkplaceID,
+		modelSettings.StartMonth,
+		modelSettings.CreatedAt,
+		modelSettings.CreatedBy,
+		modelSettings.LastUpdatedAt,
+		modelSettings.LastUpdatedBy,
+	)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save envelope settings for workplace "+modelSettings.WorkplaceID, err)
+	}
+	return nil
+}
+
+// FindEnvelopeSettings retrieves the envelope settings of a workplace.
+func (r *PgxEnvelopeRepository) FindEnvelopeSettings(ctx context.Context, workplaceID string) (*domain.EnvelopeSettings, error) {
+	var modelSettings models.EnvelopeSettings
+	err := r.Pool.QueryRow(ctx, `
+		SELECT workplace_id, start_month, created_at, created_by, last_updated_at, last_updated_by
+		FROM envelope_settings
+		WHERE workplace_id = $1;
+	`, workplaceID).Scan(
+		&modelSettings.WorkplaceID,
+		&modelSettings.StartMonth,
+		&modelSettings.CreatedAt,
+		&modelSettings.CreatedBy,
+		&modelSettings.LastUpdatedAt,
+		&modelSettings.LastUpdatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find envelope settings", err)
+	}
+
+	settings := mapping.ToDomainEnvelopeSettings(modelSettings)
+	return &settings, nil
+}
+
+// SaveEnvelopeAllocations persists allocations in a single transaction.
+func (r *PgxEnvelopeRepository) SaveEnvelopeAllocations(ctx context.Context, allocations []domain.EnvelopeAllocation) error {
+	if len(allocations) == 0 {
+		return nil
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	for _, allocation := range allocations {
+		m := mapping.ToModelEnvelopeAllocation(allocation)
+		var transferID, note sql.NullString
+		if m.TransferID != "" {
+			transferID = sql.NullString{String: m.TransferID, Valid: true}
+		}
+		if m.Note != "" {
+			note = sql.NullString{String: m.Note, Valid: true}
+		}
+		batch.Queue(`
+			INSERT INTO envelope_allocations (
+				allocation_id, workplace_id, account_id, allocation_month, amount, transfer_id, note,
+				created_at, created_by, last_updated_at, last_updated_by
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+		`, m.AllocationID, m.WorkplaceID, m.AccountID, m.Month, m.Amount, transferID, note,
+			m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range allocations {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return apperrors.NewAppError(500, "failed to save envelope allocations", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save envelope allocations", err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// ListEnvelopeAllocations retrieves the allocations of a workplace between two months, oldest first.
+func (r *PgxEnvelopeRepository) ListEnvelopeAllocations(ctx context.Context, workplaceID string, from, to time.Time) ([]domain.EnvelopeAllocation, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			allocation_id, workplace_id, account_id, allocation_month, amount, transfer_id, note,
+			created_at, created_by, last_updated_at, last_updated_by
+		FROM envelope_allocations
+		WHERE workplace_id = $1 AND allocation_month BETWEEN $2 AND $3
+		ORDER BY allocation_month, created_at, allocation_id;
+	`, workplaceID, from, to)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query envelope allocations", err)
+	}
+	defer rows.Close()
+
+	allocations := []domain.EnvelopeAllocation{}
+	for rows.Next() {
+		var m models.EnvelopeAllocation
+		var transferID, note sql.NullString
+		if err := rows.Scan(
+			&m.AllocationID,
+			&m.WorkplaceID,
+			&m.AccountID,
+			&m.Month,
+			&m.Amount,
+			&transferID,
+			&note,
+			&m.CreatedAt,
+			&m.CreatedBy,
+			&m.LastUpdatedAt,
+			&m.LastUpdatedBy,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan envelope allocation", err)
+		}
+		m.TransferID = transferID.String
+		m.Note = note.String
+		allocations = append(allocations, mapping.ToDomainEnvelopeAllocation(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating envelope allocations", err)
+	}
+
+	return allocations, nil
+}
+
+// ListEnvelopeAccountIDs retrieves the distinct accounts that have ever received an allocation in a workplace.
+func (r *PgxEnvelopeRepository) ListEnvelopeAccountIDs(ctx context.Context, workplaceID string) ([]string, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT DISTINCT account_id FROM envelope_allocations WHERE workplace_id = $1 ORDER BY account_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query envelope accounts", err)
+	}
+	defer rows.Close()
+
+	accountIDs := []string{}
+	for rows.Next() {
+		var accountID string
+		if err := rows.Scan(&accountID); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan envelope account", err)
+		}
+		accountIDs = append(accountIDs, accountID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating envelope accounts", err)
+	}
+
+	return accountIDs, nil
+}
@@ -99,6 +99,17 @@ func (r *PgxExchangeRateRepository) SaveExchangeRate(ctx context.Context, rate d
 
 // FindExchangeRate retrieves the most recent exchange rate between two currencies.
 func (r *PgxExchangeRateRepository) FindExchangeRate(ctx context.Context, fromCurrencyCode, toCurrencyCode string) (*domain.ExchangeRate, error) {
+	return r.findRateOrInverse(ctx, fromCurrencyCode, toCurrencyCode, nil)
+}
+
+// FindExchangeRateAsOf retrieves the most recent exchange rate between two currencies effective on or before date.
+func (r *PgxExchangeRateRepository) FindExchangeRateAsOf(ctx context.Context, fromCurrencyCode, toCurrencyCode string, date time.Time) (*domain.ExchangeRate, error) {
+	return r.findRateOrInverse(ctx, fromCurrencyCode, toCurrencyCode, &date)
+}
+
+// findRateOrInverse finds the most recent rate between two currencies, effective on or before asOf when given,
+// falling back to the inverse of the opposite rate
+func (r *PgxExchangeRateRepository) findRateOrInverse(ctx context.Context, fromCurrencyCode, toCurrencyCode string, asOf *time.Time) (*domain.ExchangeRate, error) {
 	// Normalize currency codes
 	fromCurrency := strings.ToUpper(fromCurrencyCode)
 	toCurrency := strings.ToUpper(toCurrencyCode)
@@ -107,6 +118,9 @@ func (r *PgxExchangeRateRepository) FindExchangeRate(ctx context.Context, fromCu
 	if fromCurrency == toCurrency {
 		rate := decimal.NewFromInt(1)
 		now := time.Now().Truncate(24 * time.Hour)
+		if asOf != nil {
+			now = *asOf
+		}
 		return &domain.ExchangeRate{
 			FromCurrencyCode: fromCurrency,
 			ToCurrencyCode:   toCurrency,
@@ -116,14 +130,14 @@ func (r *PgxExchangeRateRepository) FindExchangeRate(ctx context.Context, fromCu
 	}
 
 	// First try to find the direct rate
-	directRate, err := r.findRate(ctx, fromCurrency, toCurrency)
+	directRate, err := r.findRate(ctx, fromCurrency, toCurrency, asOf)
 	if err == nil {
 		return directRate, nil
 	}
 
 	// If direct rate not found, try to find the inverse rate
 	if errors.Is(err, apperrors.ErrNotFound) {
-		inverseRate, inverseErr := r.findRate(ctx, toCurrency, fromCurrency)
+		inverseRate, inverseErr := r.findRate(ctx, toCurrency, fromCurrency, asOf)
 		if inverseErr == nil {
 			// Calculate the inverse rate
 			inverseRate.FromCurrencyCode = fromCurrency
@@ -138,20 +152,21 @@ func (r *PgxExchangeRateRepository) FindExchangeRate(ctx context.Context, fromCu
 	return nil, apperrors.NewNotFoundError("no exchange rate found for currency pair " + fromCurrency + " to " + toCurrency)
 }
 
-// findRate is a helper method to find the most recent exchange rate
-func (r *PgxExchangeRateRepository) findRate(ctx context.Context, fromCurrency, toCurrency string) (*domain.ExchangeRate, error) {
+// findRate is a helper method to find the most recent exchange rate, effective on or before asOf when given
+func (r *PgxExchangeRateRepository) findRate(ctx context.Context, fromCurrency, toCurrency string, asOf *time.Time) (*domain.ExchangeRate, error) {
 	query := `
 		SELECT
 			exchange_rate_id, from_currency_code, to_currency_code, rate, date_effective,
 			created_at, created_by, last_updated_at, last_updated_by
 		FROM exchange_rates
 		WHERE from_currency_code = $1 AND to_currency_code = $2
+			AND ($3::date IS NULL OR date_effective <= $3::date)
 		ORDER BY date_effective DESC
 		LIMIT 1;
 	`
 
 	var modelRate models.ExchangeRate
-	err := r.Pool.QueryRow(ctx, query, fromCurrency, toCurrency).Scan(
+	err := r.Pool.QueryRow(ctx, query, fromCurrency, toCurrency, asOf).Scan(
 		&modelRate.ExchangeRateID, &modelRate.FromCurrencyCode, &modelRate.ToCurrencyCode,
 		&modelRate.Rate, &modelRate.DateEffective, &modelRate.CreatedAt,
 		&modelRate.CreatedBy, &modelRate.LastUpdatedAt, &modelRate.LastUpdatedBy,
@@ -0,0 +1,189 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxInvestmentRepository implements the investment repository using pgxpool.
+type PgxInvestmentRepository struct {
+	BaseRepository
+}
+
+// newPgxInvestmentRepository creates a new repository for securities and investment lines.
+func newPgxInvestmentRepository(pool *pgxpool.Pool) portsrepo.InvestmentRepositoryWithTx {
+	return &PgxInvestmentRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.InvestmentRepositoryWithTx = (*PgxInvestmentRepository)(nil)
+
+// selectSecurities selects securities
+const selectSecurities = `
+	SELECT
+		security_id, workplace_id, symbol, name, security_type, currency_code, cost_method, is_active,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM securities
+`
+
+// scanSecurity scans a row produced by selectSecurities
+func scanSecurity(row pgx.Row) (domain.Security, error) {
+	var m models.Security
+	if err := row.Scan(
+		&m.SecurityID,
+		&m.WorkplaceID,
+		&m.Symbol,
+		&m.Name,
+		&m.SecurityType,
+		&m.CurrencyCode,
+		&m.CostMethod,
+		&m.IsActive,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.Security{}, err
+	}
+	return mapping.ToDomainSecurity(m), nil
+}
+
+// SaveSecurity persists a new security.
+func (r *PgxInvestmentRepository) SaveSecurity(ctx context.Context, security domain.Security) error {
+	m := mapping.ToModelSecurity(security)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO securities (
+			security_id, workplace_id, symbol, name, security_type, currency_code, cost_method, is_active,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+	`, m.SecurityID, m.WorkplaceID, m.Symbol, m.Name, m.SecurityType, m.CurrencyCode, m.CostMethod, m.IsActive,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save security "+m.SecurityID, err)
+	}
+	return nil
+}
+
+// UpdateSecurity updates the descriptive fields, cost method and active flag of a security.
+func (r *PgxInvestmentRepository) UpdateSecurity(ctx context.Context, security domain.Security) error {
+	m := mapping.ToModelSecurity(security)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE securities
+		SET symbol = $1, name = $2, security_type = $3, cost_method = $4, is_active = $5, last_updated_at = $6, last_updated_by = $7
+		WHERE security_id = $8;
+	`, m.Symbol, m.Name, m.SecurityType, m.CostMethod, m.IsActive, m.LastUpdatedAt, m.LastUpdatedBy, m.SecurityID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update security "+m.SecurityID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteSecurity removes a security; securities referenced by transactions cannot be deleted.
+func (r *PgxInvestmentRepository) DeleteSecurity(ctx context.Context, securityID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM securities WHERE security_id = $1;`, securityID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrConflict
+		}
+		return apperrors.NewAppError(500, "failed to delete security "+securityID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindSecurityByID retrieves a security.
+func (r *PgxInvestmentRepository) FindSecurityByID(ctx context.Context, securityID string) (*domain.Security, error) {
+	security, err := scanSecurity(r.Pool.QueryRow(ctx, selectSecurities+`WHERE security_id = $1;`, securityID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find security by ID", err)
+	}
+	return &security, nil
+}
+
+// ListSecurities retrieves the securities of a workplace, ordered by symbol.
+func (r *PgxInvestmentRepository) ListSecurities(ctx context.Context, workplaceID string) ([]domain.Security, error) {
+	rows, err := r.Pool.Query(ctx, selectSecurities+`
+		WHERE workplace_id = $1
+		ORDER BY lower(symbol), security_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query securities", err)
+	}
+	defer rows.Close()
+
+	securities := []domain.Security{}
+	for rows.Next() {
+		security, err := scanSecurity(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan security", err)
+		}
+		securities = append(securities, security)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating securities", err)
+	}
+	return securities, nil
+}
+
+// ListSecurityLines retrieves the investment lines of posted, unreversed journals dated on or before asOf.
+func (r *PgxInvestmentRepository) ListSecurityLines(ctx context.Context, workplaceID string, accountID string, securityID string, asOf time.Time) ([]domain.SecurityLine, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			t.transaction_id, t.journal_id, t.account_id, t.security_id, t.transaction_type, t.amount,
+			t.quantity, t.unit_price, j.journal_date, t.created_at
+		FROM transactions t
+		JOIN journals j ON j.journal_id = t.journal_id
+		WHERE j.workplace_id = $1
+			AND t.security_id IS NOT NULL
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND j.journal_date <= $2
+			AND ($3 = '' OR t.account_id = $3)
+			AND ($4 = '' OR t.security_id = $4)
+		ORDER BY j.journal_date, t.created_at, t.transaction_id;
+	`, workplaceID, asOf, accountID, securityID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query security lines", err)
+	}
+	defer rows.Close()
+
+	lines := []domain.SecurityLine{}
+	for rows.Next() {
+		var line domain.SecurityLine
+		var transactionType string
+		if err := rows.Scan(&line.TransactionID, &line.JournalID, &line.AccountID, &line.SecurityID, &transactionType,
+			&line.Amount, &line.Quantity, &line.UnitPrice, &line.JournalDate, &line.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan security line", err)
+		}
+		line.TransactionType = domain.TransactionType(transactionType)
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating security lines", err)
+	}
+	return lines, nil
+}
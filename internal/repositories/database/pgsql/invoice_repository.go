@@ -0,0 +1,375 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxInvoiceRepository implements the invoice repository using pgxpool.
+type PgxInvoiceRepository struct {
+	BaseRepository
+}
+
+// newPgxInvoiceRepository creates a new repository for invoices.
+func newPgxInvoiceRepository(pool *pgxpool.Pool) portsrepo.InvoiceRepositoryWithTx {
+	return &PgxInvoiceRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.InvoiceRepositoryWithTx = (*PgxInvoiceRepository)(nil)
+
+// selectInvoices selects invoices with the status of their issue journal
+const selectInvoices = `
+	SELECT
+		i.invoice_id, i.workplace_id, i.customer_id, i.receivable_account_id, i.invoice_number, i.issue_date, i.due_date,
+		i.currency_code, i.notes, i.subtotal, i.tax_total, i.total, i.issue_journal_id, j.status,
+		i.created_at, i.created_by, i.last_updated_at, i.last_updated_by
+	FROM invoices i
+	LEFT JOIN journals j ON j.journal_id = i.issue_journal_id
+`
+
+// scanInvoice scans a row produced by selectInvoices
+func scanInvoice(row pgx.Row) (domain.Invoice, error) {
+	var m models.Invoice
+	var invoiceNumber, notes, issueJournalID, issueJournalStatus sql.NullString
+	if err := row.Scan(
+		&m.InvoiceID,
+		&m.WorkplaceID,
+		&m.CustomerID,
+		&m.ReceivableAccountID,
+		&invoiceNumber,
+		&m.IssueDate,
+		&m.DueDate,
+		&m.CurrencyCode,
+		&notes,
+		&m.Subtotal,
+		&m.TaxTotal,
+		&m.Total,
+		&issueJournalID,
+		&issueJournalStatus,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.Invoice{}, err
+	}
+	m.InvoiceNumber = invoiceNumber.String
+	m.Notes = notes.String
+	m.IssueJournalID = issueJournalID.String
+	invoice := mapping.ToDomainInvoice(m)
+	invoice.IssueJournalStatus = domain.JournalStatus(issueJournalStatus.String)
+	return invoice, nil
+}
+
+// queueInvoiceLines queues the inserts of the lines of an invoice
+func queueInvoiceLines(batch *pgx.Batch, invoice domain.Invoice) {
+	for _, line := range invoice.Lines {
+		m := mapping.ToModelInvoiceLine(line)
+		batch.Queue(`
+			INSERT INTO invoice_lines (
+				line_id, invoice_id, line_number, description, quantity, unit_price, revenue_account_id, tax_code_id,
+				amount, net_amount, tax_amount
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+		`, m.LineID, invoice.InvoiceID, m.LineNumber, m.Description, m.Quantity, m.UnitPrice, m.RevenueAccountID,
+			nullableString(m.TaxCodeID), m.Amount, m.NetAmount, m.TaxAmount)
+	}
+}
+
+// sendInvoiceBatch executes a batch of invoice line statements
+func sendInvoiceBatch(ctx context.Context, tx pgx.Tx, batch *pgx.Batch, invoiceID string) error {
+	results := tx.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return apperrors.NewAppError(500, "failed to save lines of invoice "+invoiceID, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save lines of invoice "+invoiceID, err)
+	}
+	return nil
+}
+
+// SaveInvoice persists a new draft invoice and its lines in a single transaction.
+func (r *PgxInvoiceRepository) SaveInvoice(ctx context.Context, invoice domain.Invoice) error {
+	m := mapping.ToModelInvoice(invoice)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO invoices (
+			invoice_id, workplace_id, customer_id, receivable_account_id, issue_date, due_date, currency_code, notes,
+			subtotal, tax_total, total, created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
+	`, m.InvoiceID, m.WorkplaceID, m.CustomerID, m.ReceivableAccountID, m.IssueDate, m.DueDate, m.CurrencyCode,
+		nullableString(m.Notes), m.Subtotal, m.TaxTotal, m.Total, m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save invoice "+m.InvoiceID, err)
+	}
+
+	batch := &pgx.Batch{}
+	queueInvoiceLines(batch, invoice)
+	if err := sendInvoiceBatch(ctx, tx, batch, m.InvoiceID); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// UpdateInvoice replaces the details and lines of a draft invoice in a single transaction.
+func (r *PgxInvoiceRepository) UpdateInvoice(ctx context.Context, invoice domain.Invoice) error {
+	m := mapping.ToModelInvoice(invoice)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE invoices
+		SET customer_id = $1, receivable_account_id = $2, issue_date = $3, due_date = $4, currency_code = $5, notes = $6,
+			subtotal = $7, tax_total = $8, total = $9, last_updated_at = $10, last_updated_by = $11
+		WHERE invoice_id = $12 AND issue_journal_id IS NULL;
+	`, m.CustomerID, m.ReceivableAccountID, m.IssueDate, m.DueDate, m.CurrencyCode, nullableString(m.Notes),
+		m.Subtotal, m.TaxTotal, m.Total, m.LastUpdatedAt, m.LastUpdatedBy, m.InvoiceID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update invoice "+m.InvoiceID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM invoice_lines WHERE invoice_id = $1;`, m.InvoiceID)
+	queueInvoiceLines(batch, invoice)
+	if err := sendInvoiceBatch(ctx, tx, batch, m.InvoiceID); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// DeleteInvoice removes an invoice and its lines.
+func (r *PgxInvoiceRepository) DeleteInvoice(ctx context.Context, invoiceID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM invoices WHERE invoice_id = $1;`, invoiceID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete invoice "+invoiceID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// MarkInvoiceIssued records the number and issue journal of a draft invoice.
+func (r *PgxInvoiceRepository) MarkInvoiceIssued(ctx context.Context, invoiceID string, invoiceNumber string, journalID string, userID string, at time.Time) error {
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE invoices
+		SET invoice_number = $1, issue_journal_id = $2, last_updated_at = $3, last_updated_by = $4
+		WHERE invoice_id = $5 AND issue_journal_id IS NULL;
+	`, invoiceNumber, journalID, at, userID, invoiceID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to mark invoice "+invoiceID+" issued", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// SaveInvoicePayment records a payment received against an invoice.
+func (r *PgxInvoiceRepository) SaveInvoicePayment(ctx context.Context, payment domain.InvoicePayment) error {
+	m := mapping.ToModelInvoicePayment(payment)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO invoice_payments (payment_id, invoice_id, journal_id, payment_date, amount, created_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7);
+	`, m.PaymentID, m.InvoiceID, m.JournalID, m.PaymentDate, m.Amount, m.CreatedAt, m.CreatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save payment of invoice "+m.InvoiceID, err)
+	}
+	return nil
+}
+
+// SaveInvoiceSequence creates or replaces the invoice numbering of a workplace.
+func (r *PgxInvoiceRepository) SaveInvoiceSequence(ctx context.Context, sequence domain.InvoiceSequence) error {
+	m := mapping.ToModelInvoiceSequence(sequence)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO invoice_sequences (workplace_id, prefix, next_number, padding, last_updated_at, last_updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (workplace_id) DO UPDATE
+		SET prefix = EXCLUDED.prefix, next_number = EXCLUDED.next_number, padding = EXCLUDED.padding,
+			last_updated_at = EXCLUDED.last_updated_at, last_updated_by = EXCLUDED.last_updated_by;
+	`, m.WorkplaceID, m.Prefix, m.NextNumber, m.Padding, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save invoice sequence of workplace "+m.WorkplaceID, err)
+	}
+	return nil
+}
+
+// NextInvoiceNumber atomically takes the next number of the workplace's invoice sequence.
+func (r *PgxInvoiceRepository) NextInvoiceNumber(ctx context.Context, workplaceID string) (string, error) {
+	var sequence domain.InvoiceSequence
+	var number int64
+	err := r.Pool.QueryRow(ctx, `
+		INSERT INTO invoice_sequences (workplace_id, next_number) VALUES ($1, 2)
+		ON CONFLICT (workplace_id) DO UPDATE SET next_number = invoice_sequences.next_number + 1
+		RETURNING prefix, next_number - 1, padding;
+	`, workplaceID).Scan(&sequence.Prefix, &number, &sequence.Padding)
+	if err != nil {
+		return "", apperrors.NewAppError(500, "failed to take next invoice number of workplace "+workplaceID, err)
+	}
+	return sequence.Format(number), nil
+}
+
+// GetInvoiceSequence retrieves the invoice numbering of a workplace.
+func (r *PgxInvoiceRepository) GetInvoiceSequence(ctx context.Context, workplaceID string) (*domain.InvoiceSequence, error) {
+	var m models.InvoiceSequence
+	var lastUpdatedBy sql.NullString
+	err := r.Pool.QueryRow(ctx, `
+		SELECT workplace_id, prefix, next_number, padding, last_updated_at, last_updated_by
+		FROM invoice_sequences
+		WHERE workplace_id = $1;
+	`, workplaceID).Scan(&m.WorkplaceID, &m.Prefix, &m.NextNumber, &m.Padding, &m.LastUpdatedAt, &lastUpdatedBy)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find invoice sequence", err)
+	}
+	m.LastUpdatedBy = lastUpdatedBy.String
+	sequence := mapping.ToDomainInvoiceSequence(m)
+	return &sequence, nil
+}
+
+// FindInvoiceByID retrieves an invoice with its lines and posted payments.
+func (r *PgxInvoiceRepository) FindInvoiceByID(ctx context.Context, invoiceID string) (*domain.Invoice, error) {
+	invoice, err := scanInvoice(r.Pool.QueryRow(ctx, selectInvoices+`WHERE i.invoice_id = $1;`, invoiceID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find invoice by ID", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			line_id, invoice_id, line_number, description, quantity, unit_price, revenue_account_id, tax_code_id,
+			amount, net_amount, tax_amount
+		FROM invoice_lines
+		WHERE invoice_id = $1
+		ORDER BY line_number;
+	`, invoiceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query lines of invoice "+invoiceID, err)
+	}
+	defer rows.Close()
+
+	invoice.Lines = []domain.InvoiceLine{}
+	for rows.Next() {
+		var m models.InvoiceLine
+		var taxCodeID sql.NullString
+		if err := rows.Scan(&m.LineID, &m.InvoiceID, &m.LineNumber, &m.Description, &m.Quantity, &m.UnitPrice,
+			&m.RevenueAccountID, &taxCodeID, &m.Amount, &m.NetAmount, &m.TaxAmount); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan invoice line", err)
+		}
+		m.TaxCodeID = taxCodeID.String
+		invoice.Lines = append(invoice.Lines, mapping.ToDomainInvoiceLine(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating invoice lines", err)
+	}
+
+	invoices := []domain.Invoice{invoice}
+	if err := r.loadInvoicePayments(ctx, invoices); err != nil {
+		return nil, err
+	}
+	return &invoices[0], nil
+}
+
+// ListInvoices retrieves the invoices of a workplace, optionally of one customer, newest first.
+func (r *PgxInvoiceRepository) ListInvoices(ctx context.Context, workplaceID string, customerID string) ([]domain.Invoice, error) {
+	rows, err := r.Pool.Query(ctx, selectInvoices+`
+		WHERE i.workplace_id = $1 AND ($2 = '' OR i.customer_id = $2)
+		ORDER BY i.issue_date DESC, i.invoice_number DESC NULLS FIRST, i.created_at DESC;
+	`, workplaceID, customerID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query invoices", err)
+	}
+	defer rows.Close()
+
+	invoices := []domain.Invoice{}
+	for rows.Next() {
+		invoice, err := scanInvoice(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan invoice", err)
+		}
+		invoices = append(invoices, invoice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating invoices", err)
+	}
+
+	if err := r.loadInvoicePayments(ctx, invoices); err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// loadInvoicePayments attaches to each invoice the payments whose journals are still posted, oldest first
+func (r *PgxInvoiceRepository) loadInvoicePayments(ctx context.Context, invoices []domain.Invoice) error {
+	index := make(map[string]int, len(invoices))
+	invoiceIDs := make([]string, len(invoices))
+	for i := range invoices {
+		invoices[i].Payments = []domain.InvoicePayment{}
+		index[invoices[i].InvoiceID] = i
+		invoiceIDs[i] = invoices[i].InvoiceID
+	}
+	if len(invoiceIDs) == 0 {
+		return nil
+	}
+
+	rows, err := r.Pool.Query(ctx, `
+		SELECT p.payment_id, p.invoice_id, p.journal_id, p.payment_date, p.amount, p.created_at, p.created_by
+		FROM invoice_payments p
+		JOIN journals j ON j.journal_id = p.journal_id
+		WHERE p.invoice_id = ANY($1) AND j.status = 'POSTED'
+		ORDER BY p.payment_date, p.created_at, p.payment_id;
+	`, invoiceIDs)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to query invoice payments", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var m models.InvoicePayment
+		if err := rows.Scan(&m.PaymentID, &m.InvoiceID, &m.JournalID, &m.PaymentDate, &m.Amount, &m.CreatedAt, &m.CreatedBy); err != nil {
+			return apperrors.NewAppError(500, "failed to scan invoice payment", err)
+		}
+		i := index[m.InvoiceID]
+		invoices[i].Payments = append(invoices[i].Payments, mapping.ToDomainInvoicePayment(m))
+	}
+	if err := rows.Err(); err != nil {
+		return apperrors.NewAppError(500, "error iterating invoice payments", err)
+	}
+	return nil
+}
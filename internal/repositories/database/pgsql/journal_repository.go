@@ -63,9 +63,6 @@ func calculateSignedAmount(txn domain.Transaction, accountType domain.AccountTyp
 
 // SaveJournal saves a journal, updates account balances, and saves associated transactions within a DB transaction.
 func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.Journal, transactions []domain.Transaction, balanceChanges map[string]decimal.Decimal) error {
-	// Use the injected account repository dependency
-	accountRepo := r.accountRepo
-
 	// Start a database transaction
 	tx, err := r.Begin(ctx)
 	if err != nil {
@@ -74,6 +71,59 @@ func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.J
 	// Defer rollback in case of error
 	defer r.Rollback(ctx, tx) // Will be ignored if transaction is committed successfully
 
+	if err := r.saveJournalInTx(ctx, tx, journal, transactions, balanceChanges); err != nil {
+		return err
+	}
+
+	// If all inserts/updates were successful, commit the transaction
+	if err := r.Commit(ctx, tx); err != nil {
+		return apperrors.NewAppError(500, "failed to commit transaction for journal "+journal.JournalID, err)
+	}
+
+	return nil
+}
+
+// SaveTwinJournals saves the two journals of an intercompany posting, with their transactions and balance changes,
+// in one DB transaction. A journal reversing another marks the original REVERSED in the same transaction.
+func (r *PgxJournalRepository) SaveTwinJournals(ctx context.Context, first domain.JournalPosting, second domain.JournalPosting) error {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to begin transaction", err)
+	}
+	defer r.Rollback(ctx, tx)
+
+	for _, posting := range []domain.JournalPosting{first, second} {
+		if err := r.saveJournalInTx(ctx, tx, posting.Journal, posting.Transactions, posting.BalanceChanges); err != nil {
+			return err
+		}
+		if posting.Journal.OriginalJournalID == nil {
+			continue
+		}
+		cmdTag, err := tx.Exec(ctx, `
+			UPDATE journals
+			SET status = $2, reversing_journal_id = $3, last_updated_at = $4, last_updated_by = $5
+			WHERE journal_id = $1 AND status = $6;
+		`, *posting.Journal.OriginalJournalID, domain.Reversed, posting.Journal.JournalID,
+			posting.Journal.CreatedAt, posting.Journal.CreatedBy, domain.Posted)
+		if err != nil {
+			return apperrors.NewAppError(500, "failed to mark journal "+*posting.Journal.OriginalJournalID+" reversed", err)
+		}
+		if cmdTag.RowsAffected() == 0 {
+			return apperrors.ErrConflict
+		}
+	}
+
+	if err := r.Commit(ctx, tx); err != nil {
+		return apperrors.NewAppError(500, "failed to commit intercompany journals "+first.Journal.JournalID+" and "+second.Journal.JournalID, err)
+	}
+	return nil
+}
+
+// saveJournalInTx inserts a journal and its transactions and applies the balance changes within tx.
+func (r *PgxJournalRepository) saveJournalInTx(ctx context.Context, tx pgx.Tx, journal domain.Journal, transactions []domain.Transaction, balanceChanges map[string]decimal.Decimal) error {
+	// Use the injected account repository dependency
+	accountRepo := r.accountRepo
+
 	now := journal.CreatedAt // Use consistent time from journal
 	userID := journal.CreatedBy
 
@@ -82,12 +132,12 @@ func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.J
 	journalQuery := `
 		INSERT INTO journals (
 			journal_id, workplace_id, journal_date, description, currency_code, status, 
-			original_journal_id, reversing_journal_id, amount,
-			created_at, created_by, last_updated_at, last_updated_by
+			original_journal_id, reversing_journal_id, amount, payee_id,
+			created_at, created_by, last_updated_at, last_updated_by, twin_journal_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13); -- Update placeholders
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15); -- Update placeholders
 	`
-	_, err = tx.Exec(ctx, journalQuery,
+	_, err := tx.Exec(ctx, journalQuery,
 		modelJournal.JournalID,
 		modelJournal.WorkplaceID,
 		modelJournal.JournalDate,
@@ -97,10 +147,12 @@ func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.J
 		modelJournal.OriginalJournalID,
 		modelJournal.ReversingJournalID,
 		modelJournal.Amount,
+		nullableString(modelJournal.PayeeID),
 		modelJournal.CreatedAt,
 		modelJournal.CreatedBy,
 		modelJournal.LastUpdatedAt,
 		modelJournal.LastUpdatedBy,
+		modelJournal.TwinJournalID,
 	)
 	if err != nil {
 		return apperrors.NewAppError(500, "failed to insert journal "+modelJournal.JournalID, err)
@@ -129,9 +181,10 @@ func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.J
 		INSERT INTO transactions (
 			transaction_id, journal_id, account_id, amount, transaction_type, 
 			currency_code, notes, transaction_date, created_at, created_by, 
-			last_updated_at, last_updated_by, running_balance
+			last_updated_at, last_updated_by, running_balance, payee_id,
+			security_id, quantity, unit_price, tax_code_id, tax_role
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19);
 	`
 	// Keep track of running balance calculation per account within this journal context
 	currentRunningBalances := make(map[string]decimal.Decimal)
@@ -186,8 +239,16 @@ func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.J
 			modelTxn.LastUpdatedAt,
 			modelTxn.LastUpdatedBy,
 			modelTxn.RunningBalance, // Store the calculated running balance
+			nullableString(modelTxn.PayeeID),
+			nullableString(modelTxn.SecurityID),
+			nullableDecimal(modelTxn.Quantity),
+			nullableDecimal(modelTxn.UnitPrice),
+			nullableString(modelTxn.TaxCodeID),
+			nullableString(modelTxn.TaxRole),
 		)
+		queueTransactionDimensions(batch, txn.TransactionID, txn.Dimensions)
 	}
+	queueJournalDimensions(batch, modelJournal.JournalID, journal.Dimensions)
 
 	// 5. Send the batch of transaction inserts
 	br := tx.SendBatch(ctx, batch)
@@ -196,26 +257,25 @@ func (r *PgxJournalRepository) SaveJournal(ctx context.Context, journal domain.J
 		return apperrors.NewAppError(500, "failed to execute transaction batch for journal "+modelJournal.JournalID, err)
 	}
 
-	// If all inserts/updates were successful, commit the transaction
-	if err := r.Commit(ctx, tx); err != nil {
-		return apperrors.NewAppError(500, "failed to commit transaction for journal "+modelJournal.JournalID, err)
-	}
-
 	return nil
 }
 
 // FindJournalByID retrieves a journal by its ID.
 func (r *PgxJournalRepository) FindJournalByID(ctx context.Context, journalID string) (*domain.Journal, error) {
 	query := `
-		SELECT journal_id, workplace_id, journal_date, description, currency_code, status, 
-		       original_journal_id, reversing_journal_id, amount,
-		       created_at, created_by, last_updated_at, last_updated_by
-		FROM journals
-		WHERE journal_id = $1;
+		SELECT j.journal_id, j.workplace_id, j.journal_date, j.description, j.currency_code, j.status, 
+		       j.original_journal_id, j.reversing_journal_id, j.amount, j.payee_id,
+		       j.created_at, j.created_by, j.last_updated_at, j.last_updated_by,
+		       j.twin_journal_id, t.workplace_id, t.status
+		FROM journals j
+		LEFT JOIN journals t ON t.journal_id = j.twin_journal_id
+		WHERE j.journal_id = $1;
 	`
 	var modelJournal models.Journal
 	var originalID sql.NullString  // Use sql.NullString for nullable text
 	var reversingID sql.NullString // Use sql.NullString for nullable text
+	var payeeID sql.NullString
+	var twinID, twinWorkplaceID, twinStatus sql.NullString
 
 	err := r.Pool.QueryRow(ctx, query, journalID).Scan(
 		&modelJournal.JournalID,
@@ -227,10 +287,14 @@ func (r *PgxJournalRepository) FindJournalByID(ctx context.Context, journalID st
 		&originalID,  // Scan into NullString
 		&reversingID, // Scan into NullString
 		&modelJournal.Amount,
+		&payeeID,
 		&modelJournal.CreatedAt,
 		&modelJournal.CreatedBy,
 		&modelJournal.LastUpdatedAt,
 		&modelJournal.LastUpdatedBy,
+		&twinID,
+		&twinWorkplaceID,
+		&twinStatus,
 	)
 
 	if err != nil {
@@ -249,8 +313,16 @@ func (r *PgxJournalRepository) FindJournalByID(ctx context.Context, journalID st
 	if reversingID.Valid {
 		modelJournal.ReversingJournalID = &reversingID.String
 	}
+	modelJournal.PayeeID = payeeID.String
+	if twinID.Valid {
+		modelJournal.TwinJournalID = &twinID.String
+	}
 
 	domainJournal := mapping.ToDomainJournal(modelJournal)
+	if domainJournal.Twin != nil {
+		domainJournal.Twin.WorkplaceID = twinWorkplaceID.String
+		domainJournal.Twin.Status = domain.JournalStatus(twinStatus.String)
+	}
 	return &domainJournal, nil
 }
 
@@ -260,7 +332,8 @@ func (r *PgxJournalRepository) FindTransactionsByJournalID(ctx context.Context,
 		SELECT 
 			transaction_id, journal_id, account_id, amount, transaction_type, 
 			currency_code, notes, transaction_date, created_at, created_by, 
-			last_updated_at, last_updated_by, running_balance
+			last_updated_at, last_updated_by, running_balance, clearing_status, reconciliation_id, payee_id,
+			security_id, quantity, unit_price, tax_code_id, tax_role
 		FROM transactions
 		WHERE journal_id = $1
 		ORDER BY transaction_date, created_at; -- Order by transaction date then creation time
@@ -274,6 +347,8 @@ func (r *PgxJournalRepository) FindTransactionsByJournalID(ctx context.Context,
 	transactions := []models.Transaction{}
 	for rows.Next() {
 		var t models.Transaction
+		var reconciliationID, payeeID, securityID, taxCodeID, taxRole sql.NullString
+		var quantity, unitPrice decimal.NullDecimal
 		err := rows.Scan(
 			&t.TransactionID,
 			&t.JournalID,
@@ -288,10 +363,25 @@ func (r *PgxJournalRepository) FindTransactionsByJournalID(ctx context.Context,
 			&t.LastUpdatedAt,
 			&t.LastUpdatedBy,
 			&t.RunningBalance, // Scan the running balance
+			&t.ClearingStatus,
+			&reconciliationID,
+			&payeeID,
+			&securityID,
+			&quantity,
+			&unitPrice,
+			&taxCodeID,
+			&taxRole,
 		)
 		if err != nil {
 			return nil, apperrors.NewAppError(500, "failed to scan transaction row for journal "+journalID, err)
 		}
+		t.ReconciliationID = reconciliationID.String
+		t.PayeeID = payeeID.String
+		t.SecurityID = securityID.String
+		t.Quantity = quantity.Decimal
+		t.UnitPrice = unitPrice.Decimal
+		t.TaxCodeID = taxCodeID.String
+		t.TaxRole = taxRole.String
 		transactions = append(transactions, t)
 	}
 
@@ -318,7 +408,8 @@ func (r *PgxJournalRepository) ListTransactionsByAccountID(ctx context.Context,
 			t.transaction_id, t.journal_id, t.account_id, t.amount, t.transaction_type, 
 			t.currency_code, t.notes, t.transaction_date, t.created_at, t.created_by, 
 			t.last_updated_at, t.last_updated_by, t.running_balance, 
-			j.journal_date, j.description
+			t.clearing_status, t.reconciliation_id, t.payee_id, t.security_id, t.quantity, t.unit_price,
+			t.tax_code_id, t.tax_role, j.journal_date, j.description
 		FROM transactions t
 		JOIN journals j ON t.journal_id = j.journal_id
 		WHERE t.account_id = $1 AND j.workplace_id = $2 AND j.status = 'POSTED' AND j.original_journal_id IS NULL
@@ -369,6 +460,8 @@ func (r *PgxJournalRepository) ListTransactionsByAccountID(ctx context.Context,
 
 	for rows.Next() {
 		var t models.Transaction
+		var reconciliationID, payeeID, securityID, taxCodeID, taxRole sql.NullString
+		var quantity, unitPrice decimal.NullDecimal
 		err := rows.Scan(
 			&t.TransactionID,
 			&t.JournalID,
@@ -383,6 +476,14 @@ func (r *PgxJournalRepository) ListTransactionsByAccountID(ctx context.Context,
 			&t.LastUpdatedAt,
 			&t.LastUpdatedBy,
 			&t.RunningBalance,
+			&t.ClearingStatus,
+			&reconciliationID,
+			&payeeID,
+			&securityID,
+			&quantity,
+			&unitPrice,
+			&taxCodeID,
+			&taxRole,
 			&t.JournalDate,
 			&t.JournalDescription,
 		)
@@ -390,6 +491,13 @@ func (r *PgxJournalRepository) ListTransactionsByAccountID(ctx context.Context,
 		if err != nil {
 			return nil, nil, apperrors.NewAppError(500, "failed to scan transaction row for account "+accountID, err)
 		}
+		t.ReconciliationID = reconciliationID.String
+		t.PayeeID = payeeID.String
+		t.SecurityID = securityID.String
+		t.Quantity = quantity.Decimal
+		t.UnitPrice = unitPrice.Decimal
+		t.TaxCodeID = taxCodeID.String
+		t.TaxRole = taxRole.String
 		transactions = append(transactions, struct {
 			transaction models.Transaction
 		}{t})
@@ -441,7 +549,7 @@ func (r *PgxJournalRepository) ListJournalsByWorkplace(ctx context.Context, work
 	// Base query
 	baseQuery := `
 		SELECT journal_id, workplace_id, journal_date, description, currency_code, status, 
-		       original_journal_id, reversing_journal_id, amount,
+		       original_journal_id, reversing_journal_id, amount, payee_id,
 		       created_at, created_by, last_updated_at, last_updated_by
 		FROM journals
 	`
@@ -500,6 +608,7 @@ func (r *PgxJournalRepository) ListJournalsByWorkplace(ctx context.Context, work
 		var m models.Journal
 		var originalID sql.NullString
 		var reversingID sql.NullString
+		var payeeID sql.NullString
 
 		scanErr := rows.Scan(
 			&m.JournalID,
@@ -511,6 +620,7 @@ func (r *PgxJournalRepository) ListJournalsByWorkplace(ctx context.Context, work
 			&originalID,
 			&reversingID,
 			&m.Amount,
+			&payeeID,
 			&m.CreatedAt,
 			&m.CreatedBy,
 			&m.LastUpdatedAt,
@@ -527,6 +637,7 @@ func (r *PgxJournalRepository) ListJournalsByWorkplace(ctx context.Context, work
 		if reversingID.Valid {
 			m.ReversingJournalID = &reversingID.String
 		}
+		m.PayeeID = payeeID.String
 		modelJournals = append(modelJournals, m)
 	}
 
@@ -571,7 +682,8 @@ func (r *PgxJournalRepository) FindTransactionsByJournalIDs(ctx context.Context,
 		SELECT 
 			transaction_id, journal_id, account_id, amount, transaction_type, 
 			currency_code, notes, transaction_date, created_at, created_by, 
-			last_updated_at, last_updated_by, running_balance
+			last_updated_at, last_updated_by, running_balance, clearing_status, reconciliation_id, payee_id,
+			security_id, quantity, unit_price, tax_code_id, tax_role
 		FROM transactions
 		WHERE journal_id = ANY($1)
 		ORDER BY journal_id, transaction_date, created_at; -- Order by journal_id for grouping, then by transaction date and time
@@ -588,6 +700,8 @@ func (r *PgxJournalRepository) FindTransactionsByJournalIDs(ctx context.Context,
 		var modelTxn models.Transaction
 		var amount decimal.Decimal
 		var runningBalancePtr *decimal.Decimal // Use pointer for nullable column
+		var reconciliationID, payeeID, securityID, taxCodeID, taxRole sql.NullString
+		var quantity, unitPrice decimal.NullDecimal
 
 		if err := rows.Scan(
 			&modelTxn.TransactionID,
@@ -603,10 +717,25 @@ func (r *PgxJournalRepository) FindTransactionsByJournalIDs(ctx context.Context,
 			&modelTxn.LastUpdatedAt,
 			&modelTxn.LastUpdatedBy,
 			&runningBalancePtr, // Scan into pointer
+			&modelTxn.ClearingStatus,
+			&reconciliationID,
+			&payeeID,
+			&securityID,
+			&quantity,
+			&unitPrice,
+			&taxCodeID,
+			&taxRole,
 		); err != nil {
 			return nil, apperrors.NewAppError(500, "failed to scan transaction row during batch fetch", err)
 		}
 		modelTxn.Amount = amount
+		modelTxn.ReconciliationID = reconciliationID.String
+		modelTxn.PayeeID = payeeID.String
+		modelTxn.SecurityID = securityID.String
+		modelTxn.Quantity = quantity.Decimal
+		modelTxn.UnitPrice = unitPrice.Decimal
+		modelTxn.TaxCodeID = taxCodeID.String
+		modelTxn.TaxRole = taxRole.String
 		if runningBalancePtr != nil {
 			modelTxn.RunningBalance = *runningBalancePtr // Assign dereferenced value if not null
 		} else {
@@ -682,8 +811,9 @@ func (r *PgxJournalRepository) UpdateJournal(ctx context.Context, journal domain
 		UPDATE journals
 		SET journal_date = $2,
 		    description = $3,
-		    last_updated_at = $4,
-		    last_updated_by = $5
+		    payee_id = $4,
+		    last_updated_at = $5,
+		    last_updated_by = $6
 		WHERE journal_id = $1
 		RETURNING journal_id;`
 
@@ -692,6 +822,7 @@ func (r *PgxJournalRepository) UpdateJournal(ctx context.Context, journal domain
 		modelJournal.JournalID,
 		modelJournal.JournalDate,
 		modelJournal.Description,
+		nullableString(modelJournal.PayeeID),
 		modelJournal.LastUpdatedAt,
 		modelJournal.LastUpdatedBy,
 	).Scan(&journalID)
@@ -0,0 +1,204 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxLoanRepository implements the loan repository using pgxpool.
+type PgxLoanRepository struct {
+	BaseRepository
+}
+
+// newPgxLoanRepository creates a new repository for loans and their payments.
+func newPgxLoanRepository(pool *pgxpool.Pool) portsrepo.LoanRepositoryWithTx {
+	return &PgxLoanRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.LoanRepositoryWithTx = (*PgxLoanRepository)(nil)
+
+// selectLoans selects loans
+const selectLoans = `
+	SELECT
+		loan_id, workplace_id, account_id, interest_account_id, name, principal, annual_rate, term, frequency,
+		start_date, disbursement_journal_id, created_at, created_by, last_updated_at, last_updated_by
+	FROM loans
+`
+
+// scanLoan scans a row produced by selectLoans
+func scanLoan(row pgx.Row) (domain.Loan, error) {
+	var m models.Loan
+	var disbursementJournalID sql.NullString
+	if err := row.Scan(
+		&m.LoanID,
+		&m.WorkplaceID,
+		&m.AccountID,
+		&m.InterestAccountID,
+		&m.Name,
+		&m.Principal,
+		&m.AnnualRate,
+		&m.Term,
+		&m.Frequency,
+		&m.StartDate,
+		&disbursementJournalID,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.Loan{}, err
+	}
+	m.DisbursementJournalID = disbursementJournalID.String
+	return mapping.ToDomainLoan(m), nil
+}
+
+// SaveLoan persists a new loan.
+func (r *PgxLoanRepository) SaveLoan(ctx context.Context, loan domain.Loan) error {
+	m := mapping.ToModelLoan(loan)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO loans (
+			loan_id, workplace_id, account_id, interest_account_id, name, principal, annual_rate, term, frequency,
+			start_date, disbursement_journal_id, created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
+	`, m.LoanID, m.WorkplaceID, m.AccountID, m.InterestAccountID, m.Name, m.Principal, m.AnnualRate, m.Term, m.Frequency,
+		m.StartDate, nullableString(m.DisbursementJournalID), m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save loan "+m.LoanID, err)
+	}
+	return nil
+}
+
+// UpdateLoan updates the name and interest account of a loan.
+func (r *PgxLoanRepository) UpdateLoan(ctx context.Context, loan domain.Loan) error {
+	m := mapping.ToModelLoan(loan)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE loans
+		SET name = $1, interest_account_id = $2, last_updated_at = $3, last_updated_by = $4
+		WHERE loan_id = $5;
+	`, m.Name, m.InterestAccountID, m.LastUpdatedAt, m.LastUpdatedBy, m.LoanID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update loan "+m.LoanID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteLoan removes a loan; its payment records go with it.
+func (r *PgxLoanRepository) DeleteLoan(ctx context.Context, loanID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM loans WHERE loan_id = $1;`, loanID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete loan "+loanID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindLoanByID retrieves a loan.
+func (r *PgxLoanRepository) FindLoanByID(ctx context.Context, loanID string) (*domain.Loan, error) {
+	loan, err := scanLoan(r.Pool.QueryRow(ctx, selectLoans+`WHERE loan_id = $1;`, loanID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find loan by ID", err)
+	}
+	return &loan, nil
+}
+
+// ListLoans retrieves the loans of a workplace, ordered by name.
+func (r *PgxLoanRepository) ListLoans(ctx context.Context, workplaceID string) ([]domain.Loan, error) {
+	rows, err := r.Pool.Query(ctx, selectLoans+`
+		WHERE workplace_id = $1
+		ORDER BY lower(name), loan_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query loans", err)
+	}
+	defer rows.Close()
+
+	loans := []domain.Loan{}
+	for rows.Next() {
+		loan, err := scanLoan(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan loan", err)
+		}
+		loans = append(loans, loan)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating loans", err)
+	}
+	return loans, nil
+}
+
+// SaveLoanPayment records a payment posted against a loan.
+func (r *PgxLoanRepository) SaveLoanPayment(ctx context.Context, payment domain.LoanPayment) error {
+	m := mapping.ToModelLoanPayment(payment)
+	var installmentNumber sql.NullInt32
+	if m.InstallmentNumber > 0 {
+		installmentNumber = sql.NullInt32{Int32: int32(m.InstallmentNumber), Valid: true}
+	}
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO loan_payments (
+			payment_id, loan_id, journal_id, payment_type, installment_number, prepayment_mode, payment_date,
+			principal, interest, created_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+	`, m.PaymentID, m.LoanID, m.JournalID, m.PaymentType, installmentNumber, nullableString(m.PrepaymentMode), m.PaymentDate,
+		m.Principal, m.Interest, m.CreatedAt, m.CreatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save loan payment "+m.PaymentID, err)
+	}
+	return nil
+}
+
+// ListLoanPayments retrieves the payments of a loan whose journals have not been reversed, oldest first.
+func (r *PgxLoanRepository) ListLoanPayments(ctx context.Context, loanID string) ([]domain.LoanPayment, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT
+			p.payment_id, p.loan_id, p.journal_id, p.payment_type, p.installment_number, p.prepayment_mode, p.payment_date,
+			p.principal, p.interest, p.created_at, p.created_by
+		FROM loan_payments p
+		JOIN journals j ON j.journal_id = p.journal_id
+		WHERE p.loan_id = $1 AND j.status = 'POSTED'
+		ORDER BY p.payment_date, p.created_at, p.payment_id;
+	`, loanID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query loan payments", err)
+	}
+	defer rows.Close()
+
+	payments := []domain.LoanPayment{}
+	for rows.Next() {
+		var m models.LoanPayment
+		var installmentNumber sql.NullInt32
+		var prepaymentMode sql.NullString
+		if err := rows.Scan(&m.PaymentID, &m.LoanID, &m.JournalID, &m.PaymentType, &installmentNumber, &prepaymentMode,
+			&m.PaymentDate, &m.Principal, &m.Interest, &m.CreatedAt, &m.CreatedBy); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan loan payment", err)
+		}
+		m.InstallmentNumber = int(installmentNumber.Int32)
+		m.PrepaymentMode = prepaymentMode.String
+		payments = append(payments, mapping.ToDomainLoanPayment(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating loan payments", err)
+	}
+	return payments, nil
+}
@@ -0,0 +1,217 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxPayeeRepository implements the payee repository using pgxpool.
+type PgxPayeeRepository struct {
+	BaseRepository
+}
+
+// newPgxPayeeRepository creates a new repository for payee data.
+func newPgxPayeeRepository(pool *pgxpool.Pool) portsrepo.PayeeRepositoryWithTx {
+	return &PgxPayeeRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.PayeeRepositoryWithTx = (*PgxPayeeRepository)(nil)
+
+// selectPayees selects payees together with their aliases
+const selectPayees = `
+	SELECT
+		p.payee_id, p.workplace_id, p.name, p.default_counter_account_id, p.is_active,
+		p.created_at, p.created_by, p.last_updated_at, p.last_updated_by,
+		COALESCE((SELECT array_agg(a.alias ORDER BY a.alias) FROM payee_aliases a WHERE a.payee_id = p.payee_id), '{}') AS aliases
+	FROM payees p
+`
+
+// scanPayee scans a row produced by selectPayees
+func scanPayee(row pgx.Row) (domain.Payee, error) {
+	var m models.Payee
+	var defaultCounterAccountID sql.NullString
+	var aliases []string
+	if err := row.Scan(
+		&m.PayeeID,
+		&m.WorkplaceID,
+		&m.Name,
+		&defaultCounterAccountID,
+		&m.IsActive,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+		&aliases,
+	); err != nil {
+		return domain.Payee{}, err
+	}
+	m.DefaultCounterAccountID = defaultCounterAccountID.String
+	return mapping.ToDomainPayee(m, aliases), nil
+}
+
+// isForeignKeyViolation reports whether err is a PostgreSQL foreign key constraint violation
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+// queueAliases queues the inserts of a payee's aliases; normalized aliases are expected to be unique
+func queueAliases(batch *pgx.Batch, payee domain.Payee) {
+	for _, alias := range payee.Aliases {
+		batch.Queue(`
+			INSERT INTO payee_aliases (payee_id, workplace_id, alias, normalized_alias)
+			VALUES ($1, $2, $3, $4);
+		`, payee.PayeeID, payee.WorkplaceID, alias, domain.NormalizePayee(alias))
+	}
+}
+
+// sendAliasBatch executes the alias batch, mapping unique violations to ErrDuplicate
+func sendAliasBatch(ctx context.Context, tx pgx.Tx, batch *pgx.Batch, payeeID string) error {
+	results := tx.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			if isUniqueViolation(err) {
+				return apperrors.ErrDuplicate
+			}
+			return apperrors.NewAppError(500, "failed to save aliases of payee "+payeeID, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save aliases of payee "+payeeID, err)
+	}
+	return nil
+}
+
+// SavePayee persists a new payee and its aliases in a single transaction.
+func (r *PgxPayeeRepository) SavePayee(ctx context.Context, payee domain.Payee) error {
+	m := mapping.ToModelPayee(payee)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO payees (
+			payee_id, workplace_id, name, default_counter_account_id, is_active,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+	`, m.PayeeID, m.WorkplaceID, m.Name, nullableString(m.DefaultCounterAccountID), m.IsActive,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save payee "+m.PayeeID, err)
+	}
+
+	batch := &pgx.Batch{}
+	queueAliases(batch, payee)
+	if err := sendAliasBatch(ctx, tx, batch, m.PayeeID); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// UpdatePayee updates a payee and replaces its aliases in a single transaction.
+func (r *PgxPayeeRepository) UpdatePayee(ctx context.Context, payee domain.Payee) error {
+	m := mapping.ToModelPayee(payee)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE payees
+		SET name = $1, default_counter_account_id = $2, is_active = $3, last_updated_at = $4, last_updated_by = $5
+		WHERE payee_id = $6;
+	`, m.Name, nullableString(m.DefaultCounterAccountID), m.IsActive, m.LastUpdatedAt, m.LastUpdatedBy, m.PayeeID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update payee "+m.PayeeID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	batch := &pgx.Batch{}
+	batch.Queue(`DELETE FROM payee_aliases WHERE payee_id = $1;`, m.PayeeID)
+	queueAliases(batch, payee)
+	if err := sendAliasBatch(ctx, tx, batch, m.PayeeID); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// DeletePayee removes a payee and its aliases; payees referenced by journals or transactions cannot be deleted.
+func (r *PgxPayeeRepository) DeletePayee(ctx context.Context, payeeID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM payees WHERE payee_id = $1;`, payeeID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrConflict
+		}
+		return apperrors.NewAppError(500, "failed to delete payee "+payeeID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindPayeeByID retrieves a payee with its aliases.
+func (r *PgxPayeeRepository) FindPayeeByID(ctx context.Context, payeeID string) (*domain.Payee, error) {
+	payee, err := scanPayee(r.Pool.QueryRow(ctx, selectPayees+`WHERE p.payee_id = $1;`, payeeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find payee by ID", err)
+	}
+	return &payee, nil
+}
+
+// ListPayees retrieves the payees of a workplace with their aliases, ordered by name.
+func (r *PgxPayeeRepository) ListPayees(ctx context.Context, workplaceID string) ([]domain.Payee, error) {
+	rows, err := r.Pool.Query(ctx, selectPayees+`
+		WHERE p.workplace_id = $1
+		ORDER BY lower(p.name), p.payee_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query payees", err)
+	}
+	defer rows.Close()
+
+	payees := []domain.Payee{}
+	for rows.Next() {
+		payee, err := scanPayee(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan payee", err)
+		}
+		payees = append(payees, payee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating payees", err)
+	}
+
+	return payees, nil
+}
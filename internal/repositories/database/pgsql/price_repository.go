@@ -0,0 +1,140 @@
+package pgsql
+
+import (
+	"context"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxPriceRepository implements the commodity price repository using pgxpool.
+type PgxPriceRepository struct {
+	BaseRepository
+}
+
+// newPgxPriceRepository creates a new repository for commodity prices.
+func newPgxPriceRepository(pool *pgxpool.Pool) portsrepo.PriceRepositoryWithTx {
+	return &PgxPriceRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.PriceRepositoryWithTx = (*PgxPriceRepository)(nil)
+
+// scanCommodityPrices collects the rows of a query selecting the commodity_prices columns in table order
+func scanCommodityPrices(rows pgx.Rows) ([]domain.CommodityPrice, error) {
+	defer rows.Close()
+
+	prices := []domain.CommodityPrice{}
+	for rows.Next() {
+		var m models.CommodityPrice
+		var createdBy *string
+		if err := rows.Scan(&m.Commodity, &m.QuoteCurrency, &m.PriceDate, &m.Price, &m.Source, &m.CreatedAt, &createdBy); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan commodity price", err)
+		}
+		if createdBy != nil {
+			m.CreatedBy = *createdBy
+		}
+		prices = append(prices, mapping.ToDomainCommodityPrice(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating commodity prices", err)
+	}
+	return prices, nil
+}
+
+// SavePrices upserts prices in one transaction; the latest write for a commodity, currency and date wins.
+func (r *PgxPriceRepository) SavePrices(ctx context.Context, prices []domain.CommodityPrice) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	for _, price := range prices {
+		m := mapping.ToModelCommodityPrice(price)
+		batch.Queue(`
+			INSERT INTO commodity_prices (commodity, quote_currency, price_date, price, source, created_at, created_by)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (commodity, quote_currency, price_date)
+			DO UPDATE SET price = EXCLUDED.price, source = EXCLUDED.source,
+				created_at = EXCLUDED.created_at, created_by = EXCLUDED.created_by;
+		`, m.Commodity, m.QuoteCurrency, m.PriceDate, m.Price, m.Source, m.CreatedAt, nullableString(m.CreatedBy))
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for range prices {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			if isForeignKeyViolation(err) {
+				return apperrors.ErrNotFound
+			}
+			return apperrors.NewAppError(500, "failed to save commodity prices", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save commodity prices", err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// ListPrices retrieves the prices of one series in a date range, newest first.
+func (r *PgxPriceRepository) ListPrices(ctx context.Context, key domain.PriceKey, from time.Time, to time.Time) ([]domain.CommodityPrice, error) {
+	var fromArg, toArg *time.Time
+	if !from.IsZero() {
+		fromArg = &from
+	}
+	if !to.IsZero() {
+		toArg = &to
+	}
+	rows, err := r.Pool.Query(ctx, `
+		SELECT commodity, quote_currency, price_date, price, source, created_at, created_by
+		FROM commodity_prices
+		WHERE commodity = $1 AND quote_currency = $2
+			AND ($3::date IS NULL OR price_date >= $3)
+			AND ($4::date IS NULL OR price_date <= $4)
+		ORDER BY price_date DESC;
+	`, key.Commodity, key.QuoteCurrency, fromArg, toArg)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query commodity prices", err)
+	}
+	return scanCommodityPrices(rows)
+}
+
+// FindPricesAsOf retrieves the latest price on or before asOf of each requested series.
+func (r *PgxPriceRepository) FindPricesAsOf(ctx context.Context, keys []domain.PriceKey, asOf time.Time) ([]domain.CommodityPrice, error) {
+	if len(keys) == 0 {
+		return []domain.CommodityPrice{}, nil
+	}
+	commodities := make([]string, len(keys))
+	currencies := make([]string, len(keys))
+	for i, key := range keys {
+		commodities[i] = key.Commodity
+		currencies[i] = key.QuoteCurrency
+	}
+	rows, err := r.Pool.Query(ctx, `
+		SELECT DISTINCT ON (p.commodity, p.quote_currency)
+			p.commodity, p.quote_currency, p.price_date, p.price, p.source, p.created_at, p.created_by
+		FROM commodity_prices p
+		JOIN unnest($1::text[], $2::text[]) AS k(commodity, quote_currency)
+			ON k.commodity = p.commodity AND k.quote_currency = p.quote_currency
+		WHERE p.price_date <= $3
+		ORDER BY p.commodity, p.quote_currency, p.price_date DESC;
+	`, commodities, currencies, asOf)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query latest commodity prices", err)
+	}
+	return scanCommodityPrices(rows)
+}
@@ -0,0 +1,292 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxReconciliationRepository implements the reconciliation repository using pgxpool.
+type PgxReconciliationRepository struct {
+	BaseRepository
+}
+
+// newPgxReconciliationRepository creates a new repository for reconciliation data.
+func newPgxReconciliationRepository(pool *pgxpool.Pool) portsrepo.ReconciliationRepositoryWithTx {
+	return &PgxReconciliationRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.ReconciliationRepositoryWithTx = (*PgxReconciliationRepository)(nil)
+
+// selectReconciliations selects reconciliation sessions
+const selectReconciliations = `
+	SELECT
+		reconciliation_id, workplace_id, account_id, statement_date, ending_balance, status, completed_at, completed_by,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM reconciliations
+`
+
+// selectAccountTransactions selects the lines of posted, non-reversal journals together with their journal details,
+// matching the transactions shown by ListTransactionsByAccountID
+const selectAccountTransactions = `
+	SELECT
+		t.transaction_id, t.journal_id, t.account_id, t.amount, t.transaction_type,
+		t.currency_code, t.notes, t.transaction_date, t.created_at, t.created_by,
+		t.last_updated_at, t.last_updated_by, t.running_balance,
+		t.clearing_status, t.reconciliation_id, j.journal_date, j.description
+	FROM transactions t
+	JOIN journals j ON t.journal_id = j.journal_id
+	WHERE t.account_id = $1 AND j.workplace_id = $2 AND j.status = 'POSTED' AND j.original_journal_id IS NULL
+`
+
+// scanReconciliation scans a row produced by selectReconciliations
+func scanReconciliation(row pgx.Row) (domain.Reconciliation, error) {
+	var m models.Reconciliation
+	if err := row.Scan(
+		&m.ReconciliationID,
+		&m.WorkplaceID,
+		&m.AccountID,
+		&m.StatementDate,
+		&m.EndingBalance,
+		&m.Status,
+		&m.CompletedAt,
+		&m.CompletedBy,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.Reconciliation{}, err
+	}
+	return mapping.ToDomainReconciliation(m), nil
+}
+
+// collectAccountTransactions scans rows produced by selectAccountTransactions
+func collectAccountTransactions(rows pgx.Rows) ([]domain.Transaction, error) {
+	defer rows.Close()
+
+	transactions := []models.Transaction{}
+	for rows.Next() {
+		var t models.Transaction
+		var reconciliationID sql.NullString
+		if err := rows.Scan(
+			&t.TransactionID,
+			&t.JournalID,
+			&t.AccountID,
+			&t.Amount,
+			&t.TransactionType,
+			&t.CurrencyCode,
+			&t.Notes,
+			&t.TransactionDate,
+			&t.CreatedAt,
+			&t.CreatedBy,
+			&t.LastUpdatedAt,
+			&t.LastUpdatedBy,
+			&t.RunningBalance,
+			&t.ClearingStatus,
+			&reconciliationID,
+			&t.JournalDate,
+			&t.JournalDescription,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan transaction row for reconciliation", err)
+		}
+		t.ReconciliationID = reconciliationID.String
+		transactions = append(transactions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating transaction rows for reconciliation", err)
+	}
+	return mapping.ToDomainTransactionSlice(transactions), nil
+}
+
+// SaveReconciliation persists a new reconciliation session.
+func (r *PgxReconciliationRepository) SaveReconciliation(ctx context.Context, reconciliation domain.Reconciliation) error {
+	m := mapping.ToModelReconciliation(reconciliation)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO reconciliations (
+			reconciliation_id, workplace_id, account_id, statement_date, ending_balance, status,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`, m.ReconciliationID, m.WorkplaceID, m.AccountID, m.StatementDate, m.EndingBalance, m.Status,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save reconciliation "+m.ReconciliationID, err)
+	}
+	return nil
+}
+
+// FindReconciliationByID retrieves a reconciliation session.
+func (r *PgxReconciliationRepository) FindReconciliationByID(ctx context.Context, reconciliationID string) (*domain.Reconciliation, error) {
+	reconciliation, err := scanReconciliation(r.Pool.QueryRow(ctx, selectReconciliations+`WHERE reconciliation_id = $1;`, reconciliationID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find reconciliation by ID", err)
+	}
+	return &reconciliation, nil
+}
+
+// ListReconciliations retrieves the sessions of a workplace, optionally for one account, latest statement first.
+func (r *PgxReconciliationRepository) ListReconciliations(ctx context.Context, workplaceID string, accountID string) ([]domain.Reconciliation, error) {
+	rows, err := r.Pool.Query(ctx, selectReconciliations+`
+		WHERE workplace_id = $1 AND ($2::varchar = '' OR account_id = $2)
+		ORDER BY statement_date DESC, created_at DESC;
+	`, workplaceID, accountID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query reconciliations", err)
+	}
+	defer rows.Close()
+
+	reconciliations := []domain.Reconciliation{}
+	for rows.Next() {
+		reconciliation, err := scanReconciliation(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan reconciliation", err)
+		}
+		reconciliations = append(reconciliations, reconciliation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating reconciliations", err)
+	}
+
+	return reconciliations, nil
+}
+
+// GetReconciliationTotals sums, as debits minus credits, the account's reconciled lines and the lines cleared in the session.
+func (r *PgxReconciliationRepository) GetReconciliationTotals(ctx context.Context, accountID string, reconciliationID string) (*domain.ReconciliationTotals, error) {
+	var totals domain.ReconciliationTotals
+	err := r.Pool.QueryRow(ctx, `
+		SELECT
+			COALESCE(SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END)
+				FILTER (WHERE t.clearing_status = 'RECONCILED'), 0),
+			COALESCE(SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END)
+				FILTER (WHERE t.clearing_status = 'CLEARED' AND t.reconciliation_id = $2), 0),
+			COUNT(*) FILTER (WHERE t.clearing_status = 'CLEARED' AND t.reconciliation_id = $2)
+		FROM transactions t
+		JOIN journals j ON t.journal_id = j.journal_id
+		WHERE t.account_id = $1 AND j.status = 'POSTED' AND j.original_journal_id IS NULL;
+	`, accountID, reconciliationID).Scan(&totals.ReconciledTotal, &totals.ClearedTotal, &totals.ClearedCount)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to sum reconciliation totals for account "+accountID, err)
+	}
+	return &totals, nil
+}
+
+// ListReconciliationCandidates retrieves the lines of the account dated on or before the statement date that are
+// unreconciled or belong to the session.
+func (r *PgxReconciliationRepository) ListReconciliationCandidates(ctx context.Context, workplaceID string, accountID string, reconciliationID string, statementDate time.Time) ([]domain.Transaction, error) {
+	rows, err := r.Pool.Query(ctx, selectAccountTransactions+`
+		AND (t.clearing_status <> 'RECONCILED' OR t.reconciliation_id = $4) AND t.transaction_date < $3
+		ORDER BY t.transaction_date, t.created_at;
+	`, accountID, workplaceID, statementDate.AddDate(0, 0, 1), reconciliationID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query reconciliation candidates for account "+accountID, err)
+	}
+	return collectAccountTransactions(rows)
+}
+
+// FindAccountTransactionsByIDs retrieves lines of posted, non-reversal journals of the account by ID.
+func (r *PgxReconciliationRepository) FindAccountTransactionsByIDs(ctx context.Context, workplaceID string, accountID string, transactionIDs []string) ([]domain.Transaction, error) {
+	if len(transactionIDs) == 0 {
+		return []domain.Transaction{}, nil
+	}
+	rows, err := r.Pool.Query(ctx, selectAccountTransactions+`
+		AND t.transaction_id = ANY($3)
+		ORDER BY t.transaction_date, t.created_at;
+	`, accountID, workplaceID, transactionIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query transactions for account "+accountID, err)
+	}
+	return collectAccountTransactions(rows)
+}
+
+// SetTransactionsClearing sets the clearing status and session of lines; an empty session ID clears the link.
+func (r *PgxReconciliationRepository) SetTransactionsClearing(ctx context.Context, transactionIDs []string, status domain.ClearingStatus, reconciliationID string, userID string, now time.Time) error {
+	if len(transactionIDs) == 0 {
+		return nil
+	}
+	_, err := r.Pool.Exec(ctx, `
+		UPDATE transactions
+		SET clearing_status = $1, reconciliation_id = $2, last_updated_at = $3, last_updated_by = $4
+		WHERE transaction_id = ANY($5) AND clearing_status <> 'RECONCILED';
+	`, string(status), nullableString(reconciliationID), now, userID, transactionIDs)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update transaction clearing status", err)
+	}
+	return nil
+}
+
+// CompleteReconciliation marks an open session completed and reconciles its cleared lines in a single transaction.
+func (r *PgxReconciliationRepository) CompleteReconciliation(ctx context.Context, reconciliation domain.Reconciliation) error {
+	m := mapping.ToModelReconciliation(reconciliation)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE reconciliations
+		SET status = $1, completed_at = $2, completed_by = $3, last_updated_at = $4, last_updated_by = $5
+		WHERE reconciliation_id = $6 AND status = 'IN_PROGRESS';
+	`, m.Status, m.CompletedAt, m.CompletedBy, m.LastUpdatedAt, m.LastUpdatedBy, m.ReconciliationID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to complete reconciliation "+m.ReconciliationID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrConflict
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE transactions
+		SET clearing_status = 'RECONCILED', last_updated_at = $1, last_updated_by = $2
+		WHERE reconciliation_id = $3 AND clearing_status = 'CLEARED';
+	`, m.LastUpdatedAt, m.LastUpdatedBy, m.ReconciliationID); err != nil {
+		return apperrors.NewAppError(500, "failed to reconcile cleared transactions of "+m.ReconciliationID, err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// DeleteReconciliation removes an open session and returns its cleared lines to UNCLEARED in a single transaction.
+func (r *PgxReconciliationRepository) DeleteReconciliation(ctx context.Context, reconciliationID string, userID string, now time.Time) error {
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE transactions
+		SET clearing_status = 'UNCLEARED', reconciliation_id = NULL, last_updated_at = $1, last_updated_by = $2
+		WHERE reconciliation_id = $3 AND clearing_status = 'CLEARED';
+	`, now, userID, reconciliationID); err != nil {
+		return apperrors.NewAppError(500, "failed to release cleared transactions of "+reconciliationID, err)
+	}
+
+	tag, err := tx.Exec(ctx, `DELETE FROM reconciliations WHERE reconciliation_id = $1 AND status = 'IN_PROGRESS';`, reconciliationID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete reconciliation "+reconciliationID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrConflict
+	}
+
+	return r.Commit(ctx, tx)
+}
@@ -14,15 +14,53 @@ func NewRepositoryProvider(dbPool *pgxpool.Pool) portsrepo.RepositoryProvider {
 	workplaceRepo := newPgxWorkplaceRepository(dbPool)
 	reportingRepo := newReportingRepository(dbPool)
 	apiTokenRepo := newPgxAPITokenRepository(dbPool)
+	budgetRepo := newPgxBudgetRepository(dbPool)
+	envelopeRepo := newPgxEnvelopeRepository(dbPool)
+	savingsGoalRepo := newPgxSavingsGoalRepository(dbPool)
+	bankStatementRepo := newPgxBankStatementRepository(dbPool)
+	csvImportProfileRepo := newPgxCSVImportProfileRepository(dbPool)
+	categorizationRuleRepo := newPgxCategorizationRuleRepository(dbPool)
+	reconciliationRepo := newPgxReconciliationRepository(dbPool)
+	duplicateJournalRepo := newPgxDuplicateJournalRepository(dbPool)
+	payeeRepo := newPgxPayeeRepository(dbPool)
+	sharedExpenseRepo := newPgxSharedExpenseRepository(dbPool)
+	investmentRepo := newPgxInvestmentRepository(dbPool)
+	priceRepo := newPgxPriceRepository(dbPool)
+	loanRepo := newPgxLoanRepository(dbPool)
+	creditCardRepo := newPgxCreditCardRepository(dbPool)
+	taxCodeRepo := newPgxTaxCodeRepository(dbPool)
+	invoiceRepo := newPgxInvoiceRepository(dbPool)
+	billRepo := newPgxBillRepository(dbPool)
+	dimensionRepo := newPgxDimensionRepository(dbPool)
+	consolidationRepo := newPgxConsolidationRepository(dbPool)
 
 	return portsrepo.RepositoryProvider{
-		AccountRepo:      accountRepo,
-		CurrencyRepo:     currencyRepo,
-		ExchangeRateRepo: exchangeRateRepo,
-		UserRepo:         userRepo,
-		JournalRepo:      journalRepo,
-		WorkplaceRepo:    workplaceRepo,
-		ReportingRepo:    reportingRepo,
-		APITokenRepo:     apiTokenRepo,
+		AccountRepo:            accountRepo,
+		CurrencyRepo:           currencyRepo,
+		ExchangeRateRepo:       exchangeRateRepo,
+		UserRepo:               userRepo,
+		JournalRepo:            journalRepo,
+		WorkplaceRepo:          workplaceRepo,
+		ReportingRepo:          reportingRepo,
+		APITokenRepo:           apiTokenRepo,
+		BudgetRepo:             budgetRepo,
+		EnvelopeRepo:           envelopeRepo,
+		SavingsGoalRepo:        savingsGoalRepo,
+		BankStatementRepo:      bankStatementRepo,
+		CSVImportProfileRepo:   csvImportProfileRepo,
+		CategorizationRuleRepo: categorizationRuleRepo,
+		ReconciliationRepo:     reconciliationRepo,
+		DuplicateJournalRepo:   duplicateJournalRepo,
+		PayeeRepo:              payeeRepo,
+		SharedExpenseRepo:      sharedExpenseRepo,
+		InvestmentRepo:         investmentRepo,
+		PriceRepo:              priceRepo,
+		LoanRepo:               loanRepo,
+		CreditCardRepo:         creditCardRepo,
+		TaxCodeRepo:            taxCodeRepo,
+		InvoiceRepo:            invoiceRepo,
+		BillRepo:               billRepo,
+		DimensionRepo:          dimensionRepo,
+		ConsolidationRepo:      consolidationRepo,
 	}
 }
@@ -2,6 +2,8 @@ package pgsql
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/SscSPs/money_managemet_app/internal/apperrors"
@@ -24,7 +26,7 @@ func newReportingRepository(db *pgxpool.Pool) portsrepo.ReportingRepository {
 }
 
 // GetTrialBalanceData retrieves trial balance data as of a specific date
-func (r *reportingRepository) GetTrialBalanceData(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.TrialBalanceRow, error) {
+func (r *reportingRepository) GetTrialBalanceData(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter) ([]domain.TrialBalanceRow, error) {
 	query := `
 		SELECT
 			a.account_id,
@@ -39,10 +41,12 @@ func (r *reportingRepository) GetTrialBalanceData(ctx context.Context, workplace
 			AND a.workplace_id = $2
 			AND j.status = 'POSTED'
 			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(3) + `
 		GROUP BY a.account_id, a.name, a.account_type
 	`
 
-	rows, err := r.Pool.Query(ctx, query, asOf, workplaceID)
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, asOf, workplaceID, dimensionIDs, valueIDs)
 	if err != nil {
 		return nil, apperrors.NewAppError(500, "failed to query trial balance data for workplace "+workplaceID, err)
 	}
@@ -80,7 +84,7 @@ func (r *reportingRepository) GetTrialBalanceData(ctx context.Context, workplace
 }
 
 // GetProfitAndLossData retrieves profit and loss data for a specific period
-func (r *reportingRepository) GetProfitAndLossData(ctx context.Context, workplaceID string, from, to time.Time) ([]domain.AccountAmount, []domain.AccountAmount, error) {
+func (r *reportingRepository) GetProfitAndLossData(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountAmount, []domain.AccountAmount, error) {
 	query := `
 		SELECT
 			a.account_type,
@@ -94,11 +98,13 @@ func (r *reportingRepository) GetProfitAndLossData(ctx context.Context, workplac
 			AND a.workplace_id = $3
 			AND j.status = 'POSTED'
 			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(4) + `
 			AND a.account_type IN ('REVENUE', 'EXPENSE')
 		GROUP BY a.account_type, a.account_id, a.name
 	`
 
-	rows, err := r.Pool.Query(ctx, query, from, to, workplaceID)
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, from, to, workplaceID, dimensionIDs, valueIDs)
 	if err != nil {
 		return nil, nil, apperrors.NewAppError(500, "error querying profit and loss data", err)
 	}
@@ -149,7 +155,7 @@ func (r *reportingRepository) GetProfitAndLossData(ctx context.Context, workplac
 }
 
 // GetBalanceSheetData retrieves balance sheet data as of a specific date
-func (r *reportingRepository) GetBalanceSheetData(ctx context.Context, workplaceID string, asOf time.Time) ([]domain.AccountAmount, []domain.AccountAmount, []domain.AccountAmount, error) {
+func (r *reportingRepository) GetBalanceSheetData(ctx context.Context, workplaceID string, asOf time.Time, filters []domain.DimensionFilter) ([]domain.AccountAmount, []domain.AccountAmount, []domain.AccountAmount, error) {
 	query := `
 		SELECT
 			a.account_type,
@@ -163,11 +169,13 @@ func (r *reportingRepository) GetBalanceSheetData(ctx context.Context, workplace
 			AND a.workplace_id = $2
 			AND j.status = 'POSTED'
 			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(3) + `
 			AND a.account_type IN ('ASSET', 'LIABILITY', 'EQUITY')
 		GROUP BY a.account_type, a.account_id, a.name
 	`
 
-	rows, err := r.Pool.Query(ctx, query, asOf, workplaceID)
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, asOf, workplaceID, dimensionIDs, valueIDs)
 	if err != nil {
 		return nil, nil, nil, apperrors.NewAppError(500, "error querying balance sheet data", err)
 	}
@@ -225,3 +233,581 @@ func (r *reportingRepository) GetBalanceSheetData(ctx context.Context, workplace
 
 	return assets, liabilities, equity, nil
 }
+
+// GetBalanceSheetMovements retrieves, for every balance sheet account, the balance before from and the movement between from and to
+func (r *reportingRepository) GetBalanceSheetMovements(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.AccountMovement, error) {
+	query := `
+		SELECT
+			a.account_id,
+			a.name,
+			a.account_type,
+			a.cash_flow_category,
+			SUM(CASE WHEN j.journal_date < $1
+				THEN (CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END)
+				ELSE 0 END) AS opening,
+			SUM(CASE WHEN j.journal_date >= $1
+				THEN (CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END)
+				ELSE 0 END) AS movement
+		FROM transactions t
+		JOIN accounts a ON t.account_id = a.account_id
+		JOIN journals j ON t.journal_id = j.journal_id
+		WHERE j.journal_date <= $2
+			AND a.workplace_id = $3
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(4) + `
+			AND a.account_type IN ('ASSET', 'LIABILITY', 'EQUITY')
+		GROUP BY a.account_id, a.name, a.account_type, a.cash_flow_category
+	`
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, from, to, workplaceID, dimensionIDs, valueIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying balance sheet movements", err)
+	}
+	defer rows.Close()
+
+	result := []domain.AccountMovement{}
+	for rows.Next() {
+		var accountType string
+		var category sql.NullString
+		var movement domain.AccountMovement
+
+		if err := rows.Scan(
+			&movement.AccountID,
+			&movement.Name,
+			&accountType,
+			&category,
+			&movement.OpeningBalance,
+			&movement.Movement,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning balance sheet movement row", err)
+		}
+
+		movement.AccountType = domain.AccountType(accountType)
+		movement.CashFlowCategory = domain.Account{
+			AccountType:      movement.AccountType,
+			CashFlowCategory: domain.CashFlowCategory(category.String),
+		}.EffectiveCashFlowCategory()
+		result = append(result, movement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating balance sheet movement rows", err)
+	}
+
+	return result, nil
+}
+
+// GetLedgerAccounts retrieves the accounts of a workplace (or the given subset) with their net debit balance before a date
+func (r *reportingRepository) GetLedgerAccounts(ctx context.Context, workplaceID string, accountIDs []string, before time.Time, filters []domain.DimensionFilter) ([]domain.AccountLedger, error) {
+	query := `
+		SELECT
+			a.account_id,
+			a.name,
+			a.account_type,
+			a.currency_code,
+			COALESCE(ob.net, 0) AS opening
+		FROM accounts a
+		LEFT JOIN (
+			SELECT
+				t.account_id,
+				SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END) AS net
+			FROM transactions t
+			JOIN journals j ON t.journal_id = j.journal_id
+			WHERE j.workplace_id = $1
+				AND j.journal_date < $3
+				AND j.status = 'POSTED'
+				AND j.original_journal_id IS NULL
+				AND ` + dimensionFilterClause(4) + `
+			GROUP BY t.account_id
+		) ob ON ob.account_id = a.account_id
+		WHERE a.workplace_id = $1
+			AND (cardinality($2::varchar[]) = 0 OR a.account_id = ANY($2))
+		ORDER BY a.account_type, a.name
+	`
+
+	if accountIDs == nil {
+		accountIDs = []string{}
+	}
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, workplaceID, accountIDs, before, dimensionIDs, valueIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying ledger accounts", err)
+	}
+	defer rows.Close()
+
+	result := []domain.AccountLedger{}
+	for rows.Next() {
+		var accountType string
+		var ledger domain.AccountLedger
+
+		if err := rows.Scan(
+			&ledger.AccountID,
+			&ledger.Name,
+			&accountType,
+			&ledger.CurrencyCode,
+			&ledger.OpeningBalance,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning ledger account row", err)
+		}
+
+		ledger.AccountType = domain.AccountType(accountType)
+		result = append(result, ledger)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating ledger account rows", err)
+	}
+
+	return result, nil
+}
+
+// GetLedgerEntries retrieves the postings of a workplace (or the given accounts) for a specific period with their counter-accounts
+func (r *reportingRepository) GetLedgerEntries(ctx context.Context, workplaceID string, accountIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.LedgerEntry, error) {
+	query := `
+		SELECT
+			t.account_id,
+			t.transaction_id,
+			t.journal_id,
+			j.journal_date,
+			t.transaction_date,
+			COALESCE(j.description, ''),
+			COALESCE(t.notes, ''),
+			t.transaction_type,
+			t.amount,
+			COALESCE(cp.ids, '{}'),
+			COALESCE(cp.names, '{}')
+		FROM transactions t
+		JOIN journals j ON t.journal_id = j.journal_id
+		LEFT JOIN LATERAL (
+			SELECT
+				array_agg(c.account_id ORDER BY c.name) AS ids,
+				array_agg(c.name ORDER BY c.name) AS names
+			FROM (
+				SELECT DISTINCT ca.account_id, ca.name
+				FROM transactions ct
+				JOIN accounts ca ON ct.account_id = ca.account_id
+				WHERE ct.journal_id = t.journal_id
+					AND ct.account_id <> t.account_id
+			) c
+		) cp ON TRUE
+		WHERE j.workplace_id = $1
+			AND (cardinality($2::varchar[]) = 0 OR t.account_id = ANY($2))
+			AND j.journal_date BETWEEN $3 AND $4
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(5) + `
+		ORDER BY t.account_id, j.journal_date, t.created_at, t.transaction_id
+	`
+
+	if accountIDs == nil {
+		accountIDs = []string{}
+	}
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, workplaceID, accountIDs, from, to, dimensionIDs, valueIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying ledger entries", err)
+	}
+	defer rows.Close()
+
+	result := []domain.LedgerEntry{}
+	for rows.Next() {
+		var entry domain.LedgerEntry
+		var transactionType string
+		var counterIDs, counterNames []string
+
+		if err := rows.Scan(
+			&entry.AccountID,
+			&entry.TransactionID,
+			&entry.JournalID,
+			&entry.JournalDate,
+			&entry.TransactionDate,
+			&entry.JournalDescription,
+			&entry.Notes,
+			&transactionType,
+			&entry.Amount,
+			&counterIDs,
+			&counterNames,
+		); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning ledger entry row", err)
+		}
+
+		entry.TransactionType = domain.TransactionType(transactionType)
+		entry.CounterAccounts = make([]domain.LedgerCounterAccount, len(counterIDs))
+		for i := range counterIDs {
+			entry.CounterAccounts[i] = domain.LedgerCounterAccount{AccountID: counterIDs[i], Name: counterNames[i]}
+		}
+		result = append(result, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating ledger entry rows", err)
+	}
+
+	return result, nil
+}
+
+// GetProfitAndLossByPeriods retrieves revenue and expense amounts for several periods in a single query
+func (r *reportingRepository) GetProfitAndLossByPeriods(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.PeriodAccountAmount, error) {
+	query := `
+		WITH periods AS (
+			SELECT p.idx, p.from_date, p.to_date
+			FROM unnest($2::timestamptz[], $3::timestamptz[]) WITH ORDINALITY AS p(from_date, to_date, idx)
+		)
+		SELECT
+			p.idx - 1,
+			a.account_type,
+			a.account_id,
+			a.name,
+			SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END) AS net
+		FROM periods p
+		JOIN journals j ON j.journal_date BETWEEN p.from_date AND p.to_date
+		JOIN transactions t ON t.journal_id = j.journal_id
+		JOIN accounts a ON t.account_id = a.account_id
+		WHERE j.workplace_id = $1
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(4) + `
+			AND a.account_type IN ('REVENUE', 'EXPENSE')
+		GROUP BY p.idx, a.account_type, a.account_id, a.name
+		ORDER BY p.idx, a.name
+	`
+
+	froms := make([]time.Time, len(periods))
+	tos := make([]time.Time, len(periods))
+	for i, p := range periods {
+		froms[i] = p.From
+		tos[i] = p.To
+	}
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	return r.queryPeriodAmounts(ctx, "profit and loss", query, workplaceID, froms, tos, dimensionIDs, valueIDs)
+}
+
+// GetBalanceSheetByPeriods retrieves asset, liability and equity balances as of the end of several periods in a single query
+func (r *reportingRepository) GetBalanceSheetByPeriods(ctx context.Context, workplaceID string, periods []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.PeriodAccountAmount, error) {
+	query := `
+		WITH periods AS (
+			SELECT p.idx, p.as_of
+			FROM unnest($2::timestamptz[]) WITH ORDINALITY AS p(as_of, idx)
+		)
+		SELECT
+			p.idx - 1,
+			a.account_type,
+			a.account_id,
+			a.name,
+			SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END) AS net
+		FROM periods p
+		JOIN journals j ON j.journal_date <= p.as_of
+		JOIN transactions t ON t.journal_id = j.journal_id
+		JOIN accounts a ON t.account_id = a.account_id
+		WHERE j.workplace_id = $1
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(3) + `
+			AND a.account_type IN ('ASSET', 'LIABILITY', 'EQUITY')
+		GROUP BY p.idx, a.account_type, a.account_id, a.name
+		ORDER BY p.idx, a.name
+	`
+
+	asOfs := make([]time.Time, len(periods))
+	for i, p := range periods {
+		asOfs[i] = p.To
+	}
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	return r.queryPeriodAmounts(ctx, "balance sheet", query, workplaceID, asOfs, dimensionIDs, valueIDs)
+}
+
+// queryPeriodAmounts runs a multi-period query returning (period index, account type, account id, name, net debit)
+// rows and converts the net amounts to each account's normal sign
+func (r *reportingRepository) queryPeriodAmounts(ctx context.Context, report string, query string, args ...any) ([]domain.PeriodAccountAmount, error) {
+	rows, err := r.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying "+report+" data by period", err)
+	}
+	defer rows.Close()
+
+	result := []domain.PeriodAccountAmount{}
+	for rows.Next() {
+		var periodIndex int64
+		var accountType string
+		var amount domain.PeriodAccountAmount
+
+		if err := rows.Scan(&periodIndex, &accountType, &amount.AccountID, &amount.Name, &amount.NetAmount); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning "+report+" period row", err)
+		}
+
+		amount.PeriodIndex = int(periodIndex)
+		amount.AccountType = domain.AccountType(accountType)
+		// Credit-normal accounts: invert sign for display purposes
+		if amount.AccountType != domain.Asset && amount.AccountType != domain.Expense {
+			amount.NetAmount = amount.NetAmount.Neg()
+		}
+		result = append(result, amount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating "+report+" period rows", err)
+	}
+
+	return result, nil
+}
+
+// GetTimeSeries retrieves the debit-positive flow and end-of-bucket balance of every selected account for each bucket.
+// Flows are aggregated per bucket and turned into balances with a running window sum on top of the opening balance.
+func (r *reportingRepository) GetTimeSeries(ctx context.Context, workplaceID string, selection domain.TimeSeriesSelection, buckets []domain.ReportPeriod, filters []domain.DimensionFilter) ([]domain.TimeSeriesPoint, error) {
+	query := `
+		WITH RECURSIVE subtree AS (
+			SELECT a.account_id
+			FROM accounts a
+			WHERE a.workplace_id = $1
+				AND a.account_id = ANY($4)
+			UNION
+			SELECT c.account_id
+			FROM accounts c
+			JOIN subtree s ON c.parent_account_id = s.account_id
+			WHERE c.workplace_id = $1
+		),
+		selected AS (
+			SELECT a.account_id, a.name, a.account_type
+			FROM accounts a
+			WHERE a.workplace_id = $1
+				AND (a.account_id = ANY($2)
+					OR a.account_type = ANY($3)
+					OR a.account_id IN (SELECT account_id FROM subtree))
+		),
+		buckets AS (
+			SELECT b.idx, b.from_date, b.to_date, lead(b.from_date) OVER (ORDER BY b.idx) AS next_from
+			FROM unnest($5::timestamptz[], $6::timestamptz[]) WITH ORDINALITY AS b(from_date, to_date, idx)
+		),
+		opening AS (
+			SELECT
+				t.account_id,
+				SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END) AS net
+			FROM transactions t
+			JOIN journals j ON t.journal_id = j.journal_id
+			WHERE j.workplace_id = $1
+				AND t.account_id IN (SELECT account_id FROM selected)
+				AND j.journal_date < (SELECT from_date FROM buckets WHERE idx = 1)
+				AND j.status = 'POSTED'
+				AND j.original_journal_id IS NULL
+				AND ` + dimensionFilterClause(7) + `
+			GROUP BY t.account_id
+		),
+		flows AS (
+			SELECT
+				t.account_id,
+				b.idx,
+				SUM(CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END) AS net
+			FROM transactions t
+			JOIN journals j ON t.journal_id = j.journal_id
+			JOIN buckets b ON j.journal_date >= b.from_date
+				AND (j.journal_date < b.next_from OR (b.next_from IS NULL AND j.journal_date <= b.to_date))
+			WHERE j.workplace_id = $1
+				AND t.account_id IN (SELECT account_id FROM selected)
+				AND j.status = 'POSTED'
+				AND j.original_journal_id IS NULL
+				AND ` + dimensionFilterClause(7) + `
+			GROUP BY t.account_id, b.idx
+		)
+		SELECT
+			b.idx - 1,
+			s.account_id,
+			s.name,
+			s.account_type,
+			COALESCE(f.net, 0) AS flow,
+			COALESCE(o.net, 0) + SUM(COALESCE(f.net, 0)) OVER (PARTITION BY s.account_id ORDER BY b.idx) AS balance
+		FROM selected s
+		CROSS JOIN buckets b
+		LEFT JOIN flows f ON f.account_id = s.account_id AND f.idx = b.idx
+		LEFT JOIN opening o ON o.account_id = s.account_id
+		ORDER BY s.account_type, s.name, s.account_id, b.idx
+	`
+
+	accountIDs := selection.AccountIDs
+	if accountIDs == nil {
+		accountIDs = []string{}
+	}
+	parentIDs := selection.ParentAccountIDs
+	if parentIDs == nil {
+		parentIDs = []string{}
+	}
+	accountTypes := make([]string, len(selection.AccountTypes))
+	for i, accountType := range selection.AccountTypes {
+		accountTypes[i] = string(accountType)
+	}
+
+	froms := make([]time.Time, len(buckets))
+	tos := make([]time.Time, len(buckets))
+	for i, b := range buckets {
+		froms[i] = b.From
+		tos[i] = b.To
+	}
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, workplaceID, accountIDs, accountTypes, parentIDs, froms, tos, dimensionIDs, valueIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying time series", err)
+	}
+	defer rows.Close()
+
+	result := []domain.TimeSeriesPoint{}
+	for rows.Next() {
+		var bucketIndex int64
+		var accountType string
+		var point domain.TimeSeriesPoint
+
+		if err := rows.Scan(&bucketIndex, &point.AccountID, &point.Name, &accountType, &point.Flow, &point.Balance); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning time series row", err)
+		}
+
+		point.BucketIndex = int(bucketIndex)
+		point.AccountType = domain.AccountType(accountType)
+		result = append(result, point)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating time series rows", err)
+	}
+
+	return result, nil
+}
+
+// GetPayeeAmounts retrieves expense and revenue amounts per payee and currency for a period
+func (r *reportingRepository) GetPayeeAmounts(ctx context.Context, workplaceID string, payeeIDs []string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.PayeeAmount, error) {
+	query := `
+		SELECT
+			COALESCE(p.payee_id, '') AS payee_id,
+			COALESCE(p.name, '') AS name,
+			j.currency_code,
+			SUM(CASE WHEN a.account_type = 'EXPENSE'
+				THEN CASE WHEN t.transaction_type = 'DEBIT' THEN t.amount ELSE -t.amount END
+				ELSE 0 END) AS spend,
+			SUM(CASE WHEN a.account_type = 'REVENUE'
+				THEN CASE WHEN t.transaction_type = 'CREDIT' THEN t.amount ELSE -t.amount END
+				ELSE 0 END) AS income
+		FROM transactions t
+		JOIN journals j ON t.journal_id = j.journal_id
+		JOIN accounts a ON t.account_id = a.account_id
+		LEFT JOIN payees p ON p.payee_id = COALESCE(t.payee_id, j.payee_id)
+		WHERE j.workplace_id = $1
+			AND j.journal_date BETWEEN $3 AND $4
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(5) + `
+			AND a.account_type IN ('REVENUE', 'EXPENSE')
+			AND (cardinality($2::varchar[]) = 0 OR COALESCE(t.payee_id, j.payee_id) = ANY($2))
+		GROUP BY p.payee_id, p.name, j.currency_code
+		ORDER BY p.name NULLS LAST, j.currency_code
+	`
+
+	if payeeIDs == nil {
+		payeeIDs = []string{}
+	}
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, workplaceID, payeeIDs, from, to, dimensionIDs, valueIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying payee amounts", err)
+	}
+	defer rows.Close()
+
+	result := []domain.PayeeAmount{}
+	for rows.Next() {
+		var amount domain.PayeeAmount
+		if err := rows.Scan(&amount.PayeeID, &amount.Name, &amount.CurrencyCode, &amount.Spend, &amount.Income); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning payee amount row", err)
+		}
+		result = append(result, amount)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating payee amount rows", err)
+	}
+
+	return result, nil
+}
+
+// GetTaxAmounts retrieves net and tax amounts per tax code and currency for a period
+func (r *reportingRepository) GetTaxAmounts(ctx context.Context, workplaceID string, from, to time.Time, filters []domain.DimensionFilter) ([]domain.TaxReturnLine, error) {
+	query := `
+		SELECT
+			tc.tax_code_id,
+			tc.code,
+			tc.name,
+			tc.rate,
+			j.currency_code,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.tax_role = 'NET' AND t.transaction_type = 'CREDIT'), 0) AS taxable_sales,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.tax_role = 'TAX' AND t.transaction_type = 'CREDIT'), 0) AS output_tax,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.tax_role = 'NET' AND t.transaction_type = 'DEBIT'), 0) AS taxable_purchases,
+			COALESCE(SUM(t.amount) FILTER (WHERE t.tax_role = 'TAX' AND t.transaction_type = 'DEBIT'), 0) AS input_tax
+		FROM transactions t
+		JOIN journals j ON t.journal_id = j.journal_id
+		JOIN tax_codes tc ON tc.tax_code_id = t.tax_code_id
+		WHERE j.workplace_id = $1
+			AND j.journal_date BETWEEN $2 AND $3
+			AND j.status = 'POSTED'
+			AND j.original_journal_id IS NULL
+			AND ` + dimensionFilterClause(4) + `
+		GROUP BY tc.tax_code_id, tc.code, tc.name, tc.rate, j.currency_code
+		ORDER BY tc.code, j.currency_code
+	`
+
+	dimensionIDs, valueIDs := dimensionFilterArgs(filters)
+	rows, err := r.Pool.Query(ctx, query, workplaceID, from, to, dimensionIDs, valueIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "error querying tax amounts", err)
+	}
+	defer rows.Close()
+
+	result := []domain.TaxReturnLine{}
+	for rows.Next() {
+		var line domain.TaxReturnLine
+		if err := rows.Scan(&line.TaxCodeID, &line.Code, &line.Name, &line.Rate, &line.CurrencyCode,
+			&line.TaxableSales, &line.OutputTax, &line.TaxablePurchases, &line.InputTax); err != nil {
+			return nil, apperrors.NewAppError(500, "error scanning tax amount row", err)
+		}
+		result = append(result, line)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating tax amount rows", err)
+	}
+
+	return result, nil
+}
+
+// dimensionFilterClause returns a condition restricting the lines t of journals j to those matching dimension
+// filters passed as two parallel arrays: $n holds dimension IDs and $n+1 value IDs. The value of a line for a
+// dimension is its own, else its journal's, else the empty untagged value. Pairs on the same dimension are
+// alternatives; every dimension present must match.
+func dimensionFilterClause(n int) string {
+	return fmt.Sprintf(`NOT EXISTS (
+			SELECT 1
+			FROM unnest($%[1]d::varchar[], $%[2]d::varchar[]) AS f(dimension_id, value_id)
+			GROUP BY f.dimension_id
+			HAVING NOT bool_or(f.value_id = COALESCE(
+				(SELECT td.value_id FROM transaction_dimensions td
+					WHERE td.transaction_id = t.transaction_id AND td.dimension_id = f.dimension_id),
+				(SELECT jd.value_id FROM journal_dimensions jd
+					WHERE jd.journal_id = t.journal_id AND jd.dimension_id = f.dimension_id),
+				''))
+		)`, n, n+1)
+}
+
+// dimensionFilterArgs flattens dimension filters into the parallel arrays expected by dimensionFilterClause
+func dimensionFilterArgs(filters []domain.DimensionFilter) ([]string, []string) {
+	dimensionIDs := []string{}
+	valueIDs := []string{}
+	for _, filter := range filters {
+		for _, valueID := range filter.ValueIDs {
+			dimensionIDs = append(dimensionIDs, filter.DimensionID)
+			valueIDs = append(valueIDs, valueID)
+		}
+	}
+	return dimensionIDs, valueIDs
+}
@@ -0,0 +1,200 @@
+package pgsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxSavingsGoalRepository implements the savings goal repository using pgxpool.
+type PgxSavingsGoalRepository struct {
+	BaseRepository
+}
+
+// newPgxSavingsGoalRepository creates a new repository for savings goal data.
+func newPgxSavingsGoalRepository(pool *pgxpool.Pool) portsrepo.SavingsGoalRepositoryWithTx {
+	return &PgxSavingsGoalRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.SavingsGoalRepositoryWithTx = (*PgxSavingsGoalRepository)(nil)
+
+// selectSavingsGoals selects goals with their linked account IDs aggregated into an array
+const selectSavingsGoals = `
+	SELECT
+		g.goal_id, g.workplace_id, g.name, g.description, g.target_amount, g.target_date, g.currency_code,
+		g.created_at, g.created_by, g.last_updated_at, g.last_updated_by,
+		COALESCE(array_agg(ga.account_id ORDER BY ga.account_id) FILTER (WHERE ga.account_id IS NOT NULL), '{}') AS account_ids
+	FROM savings_goals g
+	LEFT JOIN savings_goal_accounts ga ON ga.goal_id = g.goal_id
+`
+
+// scanSavingsGoal scans a row produced by selectSavingsGoals
+func scanSavingsGoal(row pgx.Row) (domain.SavingsGoal, error) {
+	var m models.SavingsGoal
+	var description sql.NullString
+	var accountIDs []string
+	if err := row.Scan(
+		&m.GoalID,
+		&m.WorkplaceID,
+		&m.Name,
+		&description,
+		&m.TargetAmount,
+		&m.TargetDate,
+		&m.CurrencyCode,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+		&accountIDs,
+	); err != nil {
+		return domain.SavingsGoal{}, err
+	}
+	m.Description = description.String
+	return mapping.ToDomainSavingsGoal(m, accountIDs), nil
+}
+
+// replaceGoalAccounts replaces the linked accounts of a goal within a transaction
+func (r *PgxSavingsGoalRepository) replaceGoalAccounts(ctx context.Context, tx pgx.Tx, goalID string, accountIDs []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM savings_goal_accounts WHERE goal_id = $1;`, goalID); err != nil {
+		return apperrors.NewAppError(500, "failed to clear savings goal accounts", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO savings_goal_accounts (goal_id, account_id)
+		SELECT $1, unnest($2::varchar[]);
+	`, goalID, accountIDs); err != nil {
+		return apperrors.NewAppError(500, "failed to save savings goal accounts", err)
+	}
+	return nil
+}
+
+// SaveSavingsGoal persists a new savings goal and its linked accounts in a single transaction.
+func (r *PgxSavingsGoalRepository) SaveSavingsGoal(ctx context.Context, goal domain.SavingsGoal) error {
+	m := mapping.ToModelSavingsGoal(goal)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	var description sql.NullString
+	if m.Description != "" {
+		description = sql.NullString{String: m.Description, Valid: true}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO savings_goals (
+			goal_id, workplace_id, name, description, target_amount, target_date, currency_code,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+	`, m.GoalID, m.WorkplaceID, m.Name, description, m.TargetAmount, m.TargetDate, m.CurrencyCode,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to save savings goal "+m.GoalID, err)
+	}
+
+	if err := r.replaceGoalAccounts(ctx, tx, m.GoalID, goal.AccountIDs); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// UpdateSavingsGoal updates a savings goal and replaces its linked accounts in a single transaction.
+func (r *PgxSavingsGoalRepository) UpdateSavingsGoal(ctx context.Context, goal domain.SavingsGoal) error {
+	m := mapping.ToModelSavingsGoal(goal)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	var description sql.NullString
+	if m.Description != "" {
+		description = sql.NullString{String: m.Description, Valid: true}
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE savings_goals
+		SET name = $1, description = $2, target_amount = $3, target_date = $4, currency_code = $5,
+			last_updated_at = $6, last_updated_by = $7
+		WHERE goal_id = $8;
+	`, m.Name, description, m.TargetAmount, m.TargetDate, m.CurrencyCode, m.LastUpdatedAt, m.LastUpdatedBy, m.GoalID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to update savings goal "+m.GoalID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+
+	if err := r.replaceGoalAccounts(ctx, tx, m.GoalID, goal.AccountIDs); err != nil {
+		return err
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// DeleteSavingsGoal removes a savings goal; its account links are removed by the cascading foreign key.
+func (r *PgxSavingsGoalRepository) DeleteSavingsGoal(ctx context.Context, goalID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM savings_goals WHERE goal_id = $1;`, goalID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete savings goal "+goalID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindSavingsGoalByID retrieves a savings goal with its linked account IDs.
+func (r *PgxSavingsGoalRepository) FindSavingsGoalByID(ctx context.Context, goalID string) (*domain.SavingsGoal, error) {
+	goal, err := scanSavingsGoal(r.Pool.QueryRow(ctx, selectSavingsGoals+`
+		WHERE g.goal_id = $1
+		GROUP BY g.goal_id;
+	`, goalID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find savings goal by ID", err)
+	}
+	return &goal, nil
+}
+
+// ListSavingsGoals retrieves the savings goals of a workplace, nearest target date first.
+func (r *PgxSavingsGoalRepository) ListSavingsGoals(ctx context.Context, workplaceID string) ([]domain.SavingsGoal, error) {
+	rows, err := r.Pool.Query(ctx, selectSavingsGoals+`
+		WHERE g.workplace_id = $1
+		GROUP BY g.goal_id
+		ORDER BY g.target_date, g.name;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query savings goals for workplace", err)
+	}
+	defer rows.Close()
+
+	goals := []domain.SavingsGoal{}
+	for rows.Next() {
+		goal, err := scanSavingsGoal(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan savings goal", err)
+		}
+		goals = append(goals, goal)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating savings goals", err)
+	}
+
+	return goals, nil
+}
@@ -0,0 +1,277 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxSharedExpenseRepository implements the shared expense repository using pgxpool.
+type PgxSharedExpenseRepository struct {
+	BaseRepository
+}
+
+// newPgxSharedExpenseRepository creates a new repository for shared expense data.
+func newPgxSharedExpenseRepository(pool *pgxpool.Pool) portsrepo.SharedExpenseRepositoryWithTx {
+	return &PgxSharedExpenseRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.SharedExpenseRepositoryWithTx = (*PgxSharedExpenseRepository)(nil)
+
+// selectSharedExpenses selects shared expenses without their shares
+const selectSharedExpenses = `
+	SELECT
+		shared_expense_id, workplace_id, journal_id, paid_by_user_id, split_method, currency_code, total_amount,
+		share_journal_id, created_at, created_by, last_updated_at, last_updated_by
+	FROM shared_expenses
+`
+
+// scanSharedExpense scans a row produced by selectSharedExpenses
+func scanSharedExpense(row pgx.Row) (models.SharedExpense, error) {
+	var m models.SharedExpense
+	err := row.Scan(
+		&m.SharedExpenseID,
+		&m.WorkplaceID,
+		&m.JournalID,
+		&m.PaidByUserID,
+		&m.SplitMethod,
+		&m.CurrencyCode,
+		&m.TotalAmount,
+		&m.ShareJournalID,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	)
+	return m, err
+}
+
+// findShares loads the shares of the given shared expenses, keyed by shared expense ID
+func (r *PgxSharedExpenseRepository) findShares(ctx context.Context, sharedExpenseIDs []string) (map[string][]models.SharedExpenseShare, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT shared_expense_id, user_id, share_value, amount
+		FROM shared_expense_shares
+		WHERE shared_expense_id = ANY($1)
+		ORDER BY shared_expense_id, user_id;
+	`, sharedExpenseIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query shared expense shares", err)
+	}
+	defer rows.Close()
+
+	shares := make(map[string][]models.SharedExpenseShare)
+	for rows.Next() {
+		var m models.SharedExpenseShare
+		if err := rows.Scan(&m.SharedExpenseID, &m.UserID, &m.ShareValue, &m.Amount); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan shared expense share", err)
+		}
+		shares[m.SharedExpenseID] = append(shares[m.SharedExpenseID], m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating shared expense shares", err)
+	}
+	return shares, nil
+}
+
+// findSharedExpense loads a single shared expense matching the condition, with its shares
+func (r *PgxSharedExpenseRepository) findSharedExpense(ctx context.Context, condition string, arg string) (*domain.SharedExpense, error) {
+	m, err := scanSharedExpense(r.Pool.QueryRow(ctx, selectSharedExpenses+condition, arg))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find shared expense", err)
+	}
+
+	shares, err := r.findShares(ctx, []string{m.SharedExpenseID})
+	if err != nil {
+		return nil, err
+	}
+	expense := mapping.ToDomainSharedExpense(m, shares[m.SharedExpenseID])
+	return &expense, nil
+}
+
+// ListMemberAccounts retrieves the member accounts of a workplace, parent entries first.
+func (r *PgxSharedExpenseRepository) ListMemberAccounts(ctx context.Context, workplaceID string) ([]domain.MemberAccounts, error) {
+	rows, err := r.Pool.Query(ctx, `
+		SELECT workplace_id, user_id, currency_code, receivable_account_id, payable_account_id, created_at, created_by
+		FROM member_accounts
+		WHERE workplace_id = $1
+		ORDER BY currency_code, user_id NULLS FIRST;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query member accounts", err)
+	}
+	defer rows.Close()
+
+	memberAccounts := []domain.MemberAccounts{}
+	for rows.Next() {
+		var m models.MemberAccounts
+		if err := rows.Scan(&m.WorkplaceID, &m.UserID, &m.CurrencyCode, &m.ReceivableAccountID, &m.PayableAccountID,
+			&m.CreatedAt, &m.CreatedBy); err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan member accounts", err)
+		}
+		memberAccounts = append(memberAccounts, mapping.ToDomainMemberAccounts(m))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating member accounts", err)
+	}
+	return memberAccounts, nil
+}
+
+// SaveMemberAccounts creates the accounts and the member_accounts row in a single transaction.
+func (r *PgxSharedExpenseRepository) SaveMemberAccounts(ctx context.Context, memberAccounts domain.MemberAccounts, accounts []domain.Account) error {
+	m := mapping.ToModelMemberAccounts(memberAccounts)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	for _, account := range accounts {
+		a := mapping.ToModelAccount(account)
+		batch.Queue(`
+			INSERT INTO accounts (
+				account_id, workplace_id, name, account_type, currency_code, parent_account_id, description, is_active,
+				created_at, created_by, last_updated_at, last_updated_by, balance
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+		`, a.AccountID, a.WorkplaceID, a.Name, a.AccountType, a.CurrencyCode, nullableString(a.ParentAccountID), a.Description,
+			a.IsActive, a.CreatedAt, a.CreatedBy, a.LastUpdatedAt, a.LastUpdatedBy, a.Balance)
+	}
+	batch.Queue(`
+		INSERT INTO member_accounts (
+			workplace_id, user_id, currency_code, receivable_account_id, payable_account_id, created_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7);
+	`, m.WorkplaceID, m.UserID, m.CurrencyCode, m.ReceivableAccountID, m.PayableAccountID, m.CreatedAt, m.CreatedBy)
+
+	results := tx.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			if isUniqueViolation(err) {
+				return apperrors.ErrDuplicate
+			}
+			return apperrors.NewAppError(500, "failed to save member accounts", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save member accounts", err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// SaveSharedExpense persists a new shared expense and its shares in a single transaction.
+func (r *PgxSharedExpenseRepository) SaveSharedExpense(ctx context.Context, expense domain.SharedExpense) error {
+	m, shares := mapping.ToModelSharedExpense(expense)
+
+	tx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.Rollback(ctx, tx)
+
+	batch := &pgx.Batch{}
+	batch.Queue(`
+		INSERT INTO shared_expenses (
+			shared_expense_id, workplace_id, journal_id, paid_by_user_id, split_method, currency_code, total_amount,
+			share_journal_id, created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+	`, m.SharedExpenseID, m.WorkplaceID, m.JournalID, m.PaidByUserID, m.SplitMethod, m.CurrencyCode, m.TotalAmount,
+		m.ShareJournalID, m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	for _, share := range shares {
+		batch.Queue(`
+			INSERT INTO shared_expense_shares (shared_expense_id, user_id, share_value, amount)
+			VALUES ($1, $2, $3, $4);
+		`, share.SharedExpenseID, share.UserID, share.ShareValue, share.Amount)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for i := 0; i < batch.Len(); i++ {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			if isUniqueViolation(err) {
+				return apperrors.ErrDuplicate
+			}
+			return apperrors.NewAppError(500, "failed to save shared expense "+m.SharedExpenseID, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return apperrors.NewAppError(500, "failed to save shared expense "+m.SharedExpenseID, err)
+	}
+
+	return r.Commit(ctx, tx)
+}
+
+// DeleteSharedExpense removes a shared expense; its shares are removed by cascade.
+func (r *PgxSharedExpenseRepository) DeleteSharedExpense(ctx context.Context, sharedExpenseID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM shared_expenses WHERE shared_expense_id = $1;`, sharedExpenseID)
+	if err != nil {
+		return apperrors.NewAppError(500, "failed to delete shared expense "+sharedExpenseID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindSharedExpenseByID retrieves a shared expense with its shares.
+func (r *PgxSharedExpenseRepository) FindSharedExpenseByID(ctx context.Context, sharedExpenseID string) (*domain.SharedExpense, error) {
+	return r.findSharedExpense(ctx, `WHERE shared_expense_id = $1;`, sharedExpenseID)
+}
+
+// FindSharedExpenseByJournalID retrieves the shared expense of an expense journal.
+func (r *PgxSharedExpenseRepository) FindSharedExpenseByJournalID(ctx context.Context, journalID string) (*domain.SharedExpense, error) {
+	return r.findSharedExpense(ctx, `WHERE journal_id = $1;`, journalID)
+}
+
+// ListSharedExpenses retrieves the shared expenses of a workplace with their shares, newest first.
+func (r *PgxSharedExpenseRepository) ListSharedExpenses(ctx context.Context, workplaceID string) ([]domain.SharedExpense, error) {
+	rows, err := r.Pool.Query(ctx, selectSharedExpenses+`
+		WHERE workplace_id = $1
+		ORDER BY created_at DESC, shared_expense_id;
+	`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query shared expenses", err)
+	}
+	defer rows.Close()
+
+	expenseModels := []models.SharedExpense{}
+	ids := []string{}
+	for rows.Next() {
+		m, err := scanSharedExpense(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan shared expense", err)
+		}
+		expenseModels = append(expenseModels, m)
+		ids = append(ids, m.SharedExpenseID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating shared expenses", err)
+	}
+	rows.Close()
+
+	expenses := make([]domain.SharedExpense, 0, len(expenseModels))
+	if len(expenseModels) == 0 {
+		return expenses, nil
+	}
+	shares, err := r.findShares(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range expenseModels {
+		expenses = append(expenses, mapping.ToDomainSharedExpense(m, shares[m.SharedExpenseID]))
+	}
+	return expenses, nil
+}
@@ -0,0 +1,173 @@
+package pgsql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/SscSPs/money_managemet_app/internal/apperrors"
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/SscSPs/money_managemet_app/internal/utils/mapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgxTaxCodeRepository implements the tax code repository using pgxpool.
+type PgxTaxCodeRepository struct {
+	BaseRepository
+}
+
+// newPgxTaxCodeRepository creates a new repository for tax codes.
+func newPgxTaxCodeRepository(pool *pgxpool.Pool) portsrepo.TaxCodeRepositoryWithTx {
+	return &PgxTaxCodeRepository{
+		BaseRepository: BaseRepository{Pool: pool},
+	}
+}
+
+var _ portsrepo.TaxCodeRepositoryWithTx = (*PgxTaxCodeRepository)(nil)
+
+// selectTaxCodes selects tax codes
+const selectTaxCodes = `
+	SELECT
+		tax_code_id, workplace_id, code, name, rate, tax_account_id, inclusive, is_active,
+		created_at, created_by, last_updated_at, last_updated_by
+	FROM tax_codes
+`
+
+// scanTaxCode scans a row produced by selectTaxCodes
+func scanTaxCode(row pgx.Row) (domain.TaxCode, error) {
+	var m models.TaxCode
+	if err := row.Scan(
+		&m.TaxCodeID,
+		&m.WorkplaceID,
+		&m.Code,
+		&m.Name,
+		&m.Rate,
+		&m.TaxAccountID,
+		&m.Inclusive,
+		&m.IsActive,
+		&m.CreatedAt,
+		&m.CreatedBy,
+		&m.LastUpdatedAt,
+		&m.LastUpdatedBy,
+	); err != nil {
+		return domain.TaxCode{}, err
+	}
+	return mapping.ToDomainTaxCode(m), nil
+}
+
+// SaveTaxCode persists a new tax code.
+func (r *PgxTaxCodeRepository) SaveTaxCode(ctx context.Context, taxCode domain.TaxCode) error {
+	m := mapping.ToModelTaxCode(taxCode)
+	_, err := r.Pool.Exec(ctx, `
+		INSERT INTO tax_codes (
+			tax_code_id, workplace_id, code, name, rate, tax_account_id, inclusive, is_active,
+			created_at, created_by, last_updated_at, last_updated_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+	`, m.TaxCodeID, m.WorkplaceID, m.Code, m.Name, m.Rate, m.TaxAccountID, m.Inclusive, m.IsActive,
+		m.CreatedAt, m.CreatedBy, m.LastUpdatedAt, m.LastUpdatedBy)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to save tax code "+m.TaxCodeID, err)
+	}
+	return nil
+}
+
+// UpdateTaxCode updates a tax code.
+func (r *PgxTaxCodeRepository) UpdateTaxCode(ctx context.Context, taxCode domain.TaxCode) error {
+	m := mapping.ToModelTaxCode(taxCode)
+	tag, err := r.Pool.Exec(ctx, `
+		UPDATE tax_codes
+		SET code = $1, name = $2, rate = $3, tax_account_id = $4, inclusive = $5, is_active = $6,
+			last_updated_at = $7, last_updated_by = $8
+		WHERE tax_code_id = $9;
+	`, m.Code, m.Name, m.Rate, m.TaxAccountID, m.Inclusive, m.IsActive,
+		m.LastUpdatedAt, m.LastUpdatedBy, m.TaxCodeID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperrors.ErrDuplicate
+		}
+		return apperrors.NewAppError(500, "failed to update tax code "+m.TaxCodeID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteTaxCode removes a tax code; codes referenced by transactions cannot be deleted.
+func (r *PgxTaxCodeRepository) DeleteTaxCode(ctx context.Context, taxCodeID string) error {
+	tag, err := r.Pool.Exec(ctx, `DELETE FROM tax_codes WHERE tax_code_id = $1;`, taxCodeID)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperrors.ErrConflict
+		}
+		return apperrors.NewAppError(500, "failed to delete tax code "+taxCodeID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return apperrors.ErrNotFound
+	}
+	return nil
+}
+
+// FindTaxCodeByID retrieves a tax code.
+func (r *PgxTaxCodeRepository) FindTaxCodeByID(ctx context.Context, taxCodeID string) (*domain.TaxCode, error) {
+	taxCode, err := scanTaxCode(r.Pool.QueryRow(ctx, selectTaxCodes+`WHERE tax_code_id = $1;`, taxCodeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.ErrNotFound
+		}
+		return nil, apperrors.NewAppError(500, "failed to find tax code by ID", err)
+	}
+	return &taxCode, nil
+}
+
+// FindTaxCodesByIDs retrieves the tax codes with the given IDs, keyed by ID.
+func (r *PgxTaxCodeRepository) FindTaxCodesByIDs(ctx context.Context, taxCodeIDs []string) (map[string]domain.TaxCode, error) {
+	taxCodes := make(map[string]domain.TaxCode, len(taxCodeIDs))
+	if len(taxCodeIDs) == 0 {
+		return taxCodes, nil
+	}
+	rows, err := r.Pool.Query(ctx, selectTaxCodes+`WHERE tax_code_id = ANY($1);`, taxCodeIDs)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query tax codes by IDs", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		taxCode, err := scanTaxCode(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan tax code", err)
+		}
+		taxCodes[taxCode.TaxCodeID] = taxCode
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating tax codes", err)
+	}
+	return taxCodes, nil
+}
+
+// ListTaxCodes retrieves the tax codes of a workplace, ordered by code.
+func (r *PgxTaxCodeRepository) ListTaxCodes(ctx context.Context, workplaceID string) ([]domain.TaxCode, error) {
+	rows, err := r.Pool.Query(ctx, selectTaxCodes+`WHERE workplace_id = $1 ORDER BY code;`, workplaceID)
+	if err != nil {
+		return nil, apperrors.NewAppError(500, "failed to query tax codes", err)
+	}
+	defer rows.Close()
+
+	taxCodes := []domain.TaxCode{}
+	for rows.Next() {
+		taxCode, err := scanTaxCode(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(500, "failed to scan tax code", err)
+		}
+		taxCodes = append(taxCodes, taxCode)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(500, "error iterating tax codes", err)
+	}
+	return taxCodes, nil
+}
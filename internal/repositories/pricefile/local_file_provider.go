@@ -0,0 +1,71 @@
+// Package pricefile implements a price provider backed by a CSV price file on the local filesystem.
+package pricefile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	portsrepo "github.com/SscSPs/money_managemet_app/internal/core/ports/repositories"
+	"github.com/SscSPs/money_managemet_app/internal/utils/pricecsv"
+)
+
+// ProviderName is recorded as the source of prices loaded from the local file
+const ProviderName = "LOCAL_FILE"
+
+// LocalFileProvider reads prices from a CSV file in the pricecsv format. The file is re-read on every fetch,
+// so it can be replaced by an external job without restarting the server.
+type LocalFileProvider struct {
+	path string
+}
+
+// NewLocalFileProvider creates a provider reading the price file at path
+func NewLocalFileProvider(path string) *LocalFileProvider {
+	return &LocalFileProvider{path: path}
+}
+
+var _ portsrepo.PriceProvider = (*LocalFileProvider)(nil)
+
+// Name identifies the provider
+func (p *LocalFileProvider) Name() string {
+	return ProviderName
+}
+
+// FetchPrices returns the valid rows of the file matching the filters. Unreadable rows are skipped,
+// since a sync has nobody to report them to row by row.
+func (p *LocalFileProvider) FetchPrices(ctx context.Context, commodity string, quoteCurrency string, from time.Time, to time.Time) ([]domain.CommodityPrice, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price file %s: %w", p.path, err)
+	}
+	rows, err := pricecsv.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := []domain.CommodityPrice{}
+	for _, row := range rows {
+		if row.Err != nil {
+			continue
+		}
+		if commodity != "" && row.Commodity != commodity {
+			continue
+		}
+		if quoteCurrency != "" && row.QuoteCurrency != quoteCurrency {
+			continue
+		}
+		if (!from.IsZero() && row.Date.Before(from)) || (!to.IsZero() && row.Date.After(to)) {
+			continue
+		}
+		prices = append(prices, domain.CommodityPrice{
+			Commodity:     row.Commodity,
+			QuoteCurrency: row.QuoteCurrency,
+			PriceDate:     row.Date,
+			Price:         row.Price,
+			Source:        ProviderName,
+		})
+	}
+	return prices, nil
+}
@@ -0,0 +1,160 @@
+package bankstatement
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// camtDocument covers the parts of an ISO 20022 camt.053 document needed for statement lines.
+// Element names are matched without namespace so all camt.053 versions are accepted.
+type camtDocument struct {
+	Statements []struct {
+		Account struct {
+			IBAN     string `xml:"Id>IBAN"`
+			Other    string `xml:"Id>Othr>Id"`
+			Currency string `xml:"Ccy"`
+		} `xml:"Acct"`
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	EntryRef       string     `xml:"NtryRef"`
+	Amount         camtAmount `xml:"Amt"`
+	CreditDebit    string     `xml:"CdtDbtInd"`
+	Reversal       bool       `xml:"RvslInd"`
+	Status         camtStatus `xml:"Sts"`
+	BookingDate    string     `xml:"BookgDt>Dt"`
+	BookingTime    string     `xml:"BookgDt>DtTm"`
+	ValueDate      string     `xml:"ValDt>Dt"`
+	ServicerRef    string     `xml:"AcctSvcrRef"`
+	AdditionalInfo string     `xml:"AddtlNtryInf"`
+	Details        []struct {
+		ServicerRef  string   `xml:"Refs>AcctSvcrRef"`
+		EndToEndID   string   `xml:"Refs>EndToEndId"`
+		TxID         string   `xml:"Refs>TxId"`
+		DebtorName   string   `xml:"RltdPties>Dbtr>Nm"`
+		DebtorParty  string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+		CreditorName string   `xml:"RltdPties>Cdtr>Nm"`
+		CreditorPty  string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Unstructured []string `xml:"RmtInf>Ustrd"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+// camtStatus is a plain code up to camt.053.001.07 and wrapped in <Cd> from camt.053.001.08
+type camtStatus struct {
+	Value string `xml:",chardata"`
+	Code  string `xml:"Cd"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// parseCAMT053 parses booked entries of an ISO 20022 camt.053 statement; one entry becomes one line
+func parseCAMT053(data []byte) (*Statement, error) {
+	var document camtDocument
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+	if len(document.Statements) == 0 {
+		return nil, fmt.Errorf("%w: no statement found", ErrInvalidStatement)
+	}
+
+	statement := &Statement{Lines: []Line{}}
+	for _, stmt := range document.Statements {
+		if statement.CurrencyCode == "" {
+			statement.CurrencyCode = strings.ToUpper(stmt.Account.Currency)
+		}
+		if statement.AccountNumber == "" {
+			statement.AccountNumber = firstNonEmpty(stmt.Account.IBAN, stmt.Account.Other)
+		}
+
+		for _, entry := range stmt.Entries {
+			status := strings.ToUpper(strings.TrimSpace(firstNonEmpty(entry.Status.Code, entry.Status.Value)))
+			if status != "" && status != "BOOK" {
+				continue // Pending and informational entries are not booked yet
+			}
+
+			line, err := camtEntryToLine(entry)
+			if err != nil {
+				return nil, err
+			}
+			if statement.CurrencyCode == "" {
+				statement.CurrencyCode = strings.ToUpper(entry.Amount.Currency)
+			}
+			statement.Lines = append(statement.Lines, line)
+		}
+	}
+	return statement, nil
+}
+
+func camtEntryToLine(entry camtEntry) (Line, error) {
+	amount, err := parseAmount(entry.Amount.Value)
+	if err != nil {
+		return Line{}, err
+	}
+	switch strings.ToUpper(strings.TrimSpace(entry.CreditDebit)) {
+	case "CRDT":
+	case "DBIT":
+		amount = amount.Neg()
+	default:
+		return Line{}, fmt.Errorf("%w: invalid credit/debit indicator %q", ErrInvalidStatement, entry.CreditDebit)
+	}
+	if entry.Reversal {
+		amount = amount.Neg()
+	}
+
+	dateValue := firstNonEmpty(entry.BookingDate, entry.BookingTime, entry.ValueDate)
+	if len(dateValue) < 10 {
+		return Line{}, fmt.Errorf("%w: entry %q has no booking date", ErrInvalidStatement, entry.EntryRef)
+	}
+	date, err := time.Parse("2006-01-02", dateValue[:10])
+	if err != nil {
+		return Line{}, fmt.Errorf("%w: invalid booking date %q", ErrInvalidStatement, dateValue)
+	}
+
+	line := Line{
+		Reference: firstNonEmpty(entry.ServicerRef, entry.EntryRef),
+		Date:      date,
+		Amount:    amount,
+		Memo:      strings.TrimSpace(entry.AdditionalInfo),
+	}
+	if len(entry.Details) > 0 {
+		details := entry.Details[0]
+		if line.Reference == "" {
+			line.Reference = firstNonEmpty(details.ServicerRef, details.TxID, notProvided(details.EndToEndID))
+		}
+		// The counterparty is the debtor of money received and the creditor of money paid
+		if amount.IsPositive() {
+			line.Payee = firstNonEmpty(details.DebtorName, details.DebtorParty)
+		} else {
+			line.Payee = firstNonEmpty(details.CreditorName, details.CreditorPty)
+		}
+		if remittance := strings.TrimSpace(strings.Join(details.Unstructured, " ")); remittance != "" {
+			line.Memo = remittance
+		}
+	}
+	return line, nil
+}
+
+// notProvided blanks the placeholder used for missing end-to-end IDs
+func notProvided(value string) string {
+	if strings.EqualFold(strings.TrimSpace(value), "NOTPROVIDED") {
+		return ""
+	}
+	return value
+}
+
+// firstNonEmpty returns the first value that is not blank, trimmed
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
@@ -0,0 +1,287 @@
+package bankstatement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+)
+
+// FormatCSV identifies statements read from a bank's CSV export through a CSVLayout
+const FormatCSV Format = "CSV"
+
+// AmountMode tells how the amount of a CSV row is laid out
+type AmountMode string
+
+const (
+	AmountSigned      AmountMode = "SIGNED"       // One column; positive is money in
+	AmountInverted    AmountMode = "INVERTED"     // One column; positive is money out
+	AmountDebitCredit AmountMode = "DEBIT_CREDIT" // Separate money-out (debit) and money-in (credit) columns
+)
+
+// CSVLayout describes how to read one bank's CSV export. Column numbers are 1-based; 0 means absent.
+type CSVLayout struct {
+	Delimiter        rune   // Defaults to ','
+	SkipRows         int    // Non-empty rows skipped at the top of the file, before the header
+	HasHeader        bool   // Whether the first row after SkipRows is a header
+	DateColumn       int    // Required
+	DateFormat       string // Tokens such as DD/MM/YYYY or a Go layout such as 02.01.2006
+	AmountMode       AmountMode
+	AmountColumn     int    // Used by SIGNED and INVERTED
+	DebitColumn      int    // Used by DEBIT_CREDIT
+	CreditColumn     int    // Used by DEBIT_CREDIT
+	DecimalSeparator string // "." or ","; the other character is treated as a thousands separator
+	PayeeColumn      int
+	MemoColumn       int
+	ReferenceColumn  int // When absent, references are derived from the row content
+}
+
+// CSVRow is one data row of a CSV statement: the parsed line, or the reason it could not be read
+type CSVRow struct {
+	RowNumber int // 1-based row number in the file
+	Line      Line
+	Err       error
+}
+
+// Validate checks that a layout is complete and consistent
+func (l CSVLayout) Validate() error {
+	if l.SkipRows < 0 {
+		return errors.New("rows to skip cannot be negative")
+	}
+	if l.DateColumn <= 0 {
+		return errors.New("date column is required")
+	}
+	if strings.TrimSpace(l.DateFormat) == "" {
+		return errors.New("date format is required")
+	}
+	if l.DecimalSeparator != "." && l.DecimalSeparator != "," {
+		return errors.New("decimal separator must be '.' or ','")
+	}
+	if string(l.Delimiter) == l.DecimalSeparator {
+		return errors.New("delimiter and decimal separator must differ")
+	}
+	switch l.AmountMode {
+	case AmountSigned, AmountInverted:
+		if l.AmountColumn <= 0 {
+			return errors.New("amount column is required")
+		}
+	case AmountDebitCredit:
+		if l.DebitColumn <= 0 || l.CreditColumn <= 0 {
+			return errors.New("debit and credit columns are required")
+		}
+		if l.DebitColumn == l.CreditColumn {
+			return errors.New("debit and credit columns must differ")
+		}
+	default:
+		return fmt.Errorf("unknown amount mode %q", l.AmountMode)
+	}
+	for _, column := range []int{l.AmountColumn, l.DebitColumn, l.CreditColumn, l.PayeeColumn, l.MemoColumn, l.ReferenceColumn} {
+		if column < 0 {
+			return errors.New("column numbers cannot be negative")
+		}
+	}
+	return nil
+}
+
+// ParseCSV reads a CSV statement with the given layout. Rows that cannot be read are returned with an
+// error instead of failing the whole file, so they can be shown in a preview. Blank rows are skipped.
+func ParseCSV(data []byte, layout CSVLayout) ([]CSVRow, error) {
+	if layout.Delimiter == 0 {
+		layout.Delimiter = ','
+	}
+	if err := layout.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+	dateLayout := GoDateLayout(layout.DateFormat)
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.Comma = layout.Delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	start := layout.SkipRows
+	if layout.HasHeader {
+		start++
+	}
+	rows := []CSVRow{}
+	skipped := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+		}
+		if skipped < start {
+			skipped++
+			continue
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		rowNumber, _ := reader.FieldPos(0)
+		line, err := parseCSVRecord(record, layout, dateLayout)
+		rows = append(rows, CSVRow{RowNumber: rowNumber, Line: line, Err: err})
+	}
+
+	// Derive references only for readable rows so the numbering does not depend on broken ones
+	lines := make([]Line, 0, len(rows))
+	for _, row := range rows {
+		if row.Err == nil {
+			lines = append(lines, row.Line)
+		}
+	}
+	assignFallbackReferences(lines)
+	next := 0
+	for i := range rows {
+		if rows[i].Err == nil {
+			rows[i].Line = lines[next]
+			next++
+		}
+	}
+	return rows, nil
+}
+
+// parseCSVRecord converts one CSV record to a statement line
+func parseCSVRecord(record []string, layout CSVLayout, dateLayout string) (Line, error) {
+	field := func(column int) string {
+		if column <= 0 || column > len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[column-1])
+	}
+
+	if layout.DateColumn > len(record) {
+		return Line{}, fmt.Errorf("row has %d columns, date column is %d", len(record), layout.DateColumn)
+	}
+	date, err := time.Parse(dateLayout, field(layout.DateColumn))
+	if err != nil {
+		return Line{}, fmt.Errorf("invalid date %q for format %s", field(layout.DateColumn), layout.DateFormat)
+	}
+
+	var amount decimal.Decimal
+	switch layout.AmountMode {
+	case AmountSigned, AmountInverted:
+		amount, err = parseCSVAmount(field(layout.AmountColumn), layout.DecimalSeparator)
+		if err != nil {
+			return Line{}, err
+		}
+		if layout.AmountMode == AmountInverted {
+			amount = amount.Neg()
+		}
+	case AmountDebitCredit:
+		debit, err := parseCSVAmount(field(layout.DebitColumn), layout.DecimalSeparator)
+		if err != nil {
+			return Line{}, err
+		}
+		credit, err := parseCSVAmount(field(layout.CreditColumn), layout.DecimalSeparator)
+		if err != nil {
+			return Line{}, err
+		}
+		// Some banks show money out as a negative debit; the column decides the direction, not the sign
+		amount = credit.Abs().Sub(debit.Abs())
+	}
+
+	return Line{
+		Reference: field(layout.ReferenceColumn),
+		Date:      date,
+		Amount:    amount,
+		Payee:     field(layout.PayeeColumn),
+		Memo:      field(layout.MemoColumn),
+	}, nil
+}
+
+// parseCSVAmount parses an amount written with the given decimal separator. Thousands separators, spaces,
+// parentheses and trailing minus signs are accepted; an empty value is zero.
+func parseCSVAmount(value string, decimalSeparator string) (decimal.Decimal, error) {
+	original := value
+	value = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\u00a0' || r == '\'' {
+			return -1
+		}
+		return r
+	}, value)
+	if value == "" {
+		return decimal.Zero, nil
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+	if strings.HasSuffix(value, "-") {
+		negative = true
+		value = strings.TrimSuffix(value, "-")
+	}
+
+	if decimalSeparator == "," {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.Replace(value, ",", ".", 1)
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", original)
+	}
+	if negative {
+		amount = amount.Neg()
+	}
+	return amount, nil
+}
+
+// isBlankRecord reports whether every field of a record is empty
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// dateTokens maps user-friendly date tokens to Go layout elements, longest first
+var dateTokens = []struct{ token, layout string }{
+	{"YYYY", "2006"},
+	{"YY", "06"},
+	{"MMM", "Jan"},
+	{"MM", "01"},
+	{"M", "1"},
+	{"DD", "02"},
+	{"D", "2"},
+}
+
+// GoDateLayout converts a date format such as DD/MM/YYYY to a Go time layout. Formats that already
+// contain the Go reference year (2006) or no tokens at all are returned unchanged.
+func GoDateLayout(format string) string {
+	if strings.Contains(format, "2006") || !strings.ContainsAny(format, "YMD") {
+		return format
+	}
+	var b strings.Builder
+	for i := 0; i < len(format); {
+		matched := false
+		for _, t := range dateTokens {
+			if strings.HasPrefix(format[i:], t.token) {
+				b.WriteString(t.layout)
+				i += len(t.token)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			r, size := utf8.DecodeRuneInString(format[i:])
+			b.WriteRune(r)
+			i += size
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,184 @@
+package bankstatement
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// mt940StatementLine matches the :61: field: value date, optional entry date, debit/credit mark
+// (R marks a reversal), optional funds code, amount, transaction type, customer reference and
+// optional bank reference after "//"
+var mt940StatementLine = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+,\d*)([NSF][A-Z0-9]{3})([^/\n]*)(?://([^\n]*))?`)
+
+// mt940InformationCode matches the "/CODE/" markers of structured :86: fields
+var mt940InformationCode = regexp.MustCompile(`/(NAME|REMI|EREF|ORDP|BENM|IBAN|BIC|ADDR|CSID|MARF|PURP|ULTC|ULTD|TRCD|RTRN|CNTP|KREF|MREF|SVWZ)/`)
+
+// mt940Field is one tagged field of an MT940 message with its continuation lines joined
+type mt940Field struct {
+	tag   string
+	value string
+}
+
+// splitMT940Fields splits an MT940 message into tagged fields
+func splitMT940Fields(data []byte) []mt940Field {
+	var fields []mt940Field
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	for _, raw := range strings.Split(content, "\n") {
+		line := strings.TrimRight(raw, " \r")
+		if strings.HasPrefix(line, ":") {
+			if end := strings.Index(line[1:], ":"); end > 0 {
+				fields = append(fields, mt940Field{tag: line[1 : end+1], value: line[end+2:]})
+				continue
+			}
+		}
+		if len(fields) > 0 && line != "" && line != "-" && !strings.HasPrefix(line, "-}") {
+			fields[len(fields)-1].value += "\n" + line
+		}
+	}
+	return fields
+}
+
+// parseMT940 parses SWIFT MT940 customer statements; each :61: field becomes one line and the
+// following :86: field supplies payee and memo
+func parseMT940(data []byte) (*Statement, error) {
+	statement := &Statement{Lines: []Line{}}
+	fields := splitMT940Fields(data)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no MT940 fields found", ErrInvalidStatement)
+	}
+
+	var current *Line
+	for _, field := range fields {
+		switch field.tag {
+		case "25":
+			if statement.AccountNumber == "" {
+				statement.AccountNumber = strings.TrimSpace(field.value)
+			}
+		case "60F", "60M":
+			// Opening balance: D/C mark, YYMMDD, currency, amount
+			if statement.CurrencyCode == "" && len(field.value) >= 10 {
+				statement.CurrencyCode = strings.ToUpper(field.value[7:10])
+			}
+		case "61":
+			if current != nil {
+				statement.Lines = append(statement.Lines, *current)
+			}
+			line, err := parseMT940StatementLine(field.value)
+			if err != nil {
+				return nil, err
+			}
+			current = &line
+		case "86":
+			if current != nil {
+				current.Payee, current.Memo = parseMT940Information(field.value)
+				statement.Lines = append(statement.Lines, *current)
+				current = nil
+			}
+		}
+	}
+	if current != nil {
+		statement.Lines = append(statement.Lines, *current)
+	}
+	return statement, nil
+}
+
+func parseMT940StatementLine(value string) (Line, error) {
+	match := mt940StatementLine.FindStringSubmatch(value)
+	if match == nil {
+		return Line{}, fmt.Errorf("%w: invalid :61: statement line %q", ErrInvalidStatement, firstLine(value))
+	}
+
+	date, err := parseMT940Date(match[1])
+	if err != nil {
+		return Line{}, err
+	}
+	amount, err := parseAmount(match[5])
+	if err != nil {
+		return Line{}, err
+	}
+	// D is money out; RC reverses a credit, so it is money out as well
+	if match[3] == "D" || match[3] == "RC" {
+		amount = amount.Neg()
+	}
+
+	reference := strings.TrimSpace(match[8])
+	if reference == "" {
+		if customerRef := strings.TrimSpace(match[7]); !strings.EqualFold(customerRef, "NONREF") {
+			reference = customerRef
+		}
+	}
+
+	line := Line{Reference: reference, Date: date, Amount: amount}
+	if newline := strings.Index(value, "\n"); newline >= 0 {
+		line.Memo = strings.TrimSpace(value[newline+1:])
+	}
+	return line, nil
+}
+
+// parseMT940Date parses a YYMMDD date; years before 80 are in the 2000s
+func parseMT940Date(value string) (time.Time, error) {
+	date, err := time.Parse("060102", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid MT940 date %q", ErrInvalidStatement, value)
+	}
+	if date.Year() >= 2080 {
+		date = date.AddDate(-100, 0, 0)
+	}
+	return date, nil
+}
+
+// parseMT940Information extracts the payee and memo from an :86: field. Structured German
+// (?20-?29 purpose, ?32/?33 name) and "/NAME/" styles are recognised; otherwise the whole
+// field is the memo.
+func parseMT940Information(value string) (payee, memo string) {
+	value = strings.ReplaceAll(value, "\n", "")
+	if strings.Contains(value, "?") && len(value) > 3 {
+		subfields := make(map[string]string)
+		var purpose []string
+		parts := strings.Split(value, "?")
+		for _, part := range parts[1:] {
+			if len(part) < 2 {
+				continue
+			}
+			code, text := part[:2], strings.TrimSpace(part[2:])
+			subfields[code] += text
+			if code >= "20" && code <= "29" || code >= "60" && code <= "63" {
+				purpose = append(purpose, text)
+			}
+		}
+		payee = strings.TrimSpace(subfields["32"] + subfields["33"])
+		memo = strings.TrimSpace(strings.Join(purpose, " "))
+		if payee != "" || memo != "" {
+			return payee, memo
+		}
+	}
+
+	if codes := mt940InformationCode.FindAllStringSubmatchIndex(value, -1); len(codes) > 0 {
+		for i, code := range codes {
+			end := len(value)
+			if i+1 < len(codes) {
+				end = codes[i+1][0]
+			}
+			text := strings.TrimSpace(value[code[1]:end])
+			switch value[code[2]:code[3]] {
+			case "NAME":
+				payee = text
+			case "REMI":
+				memo = text
+			}
+		}
+	}
+	if memo == "" {
+		memo = strings.TrimSpace(value)
+	}
+	return payee, memo
+}
+
+func firstLine(value string) string {
+	if newline := strings.Index(value, "\n"); newline >= 0 {
+		return value[:newline]
+	}
+	return value
+}
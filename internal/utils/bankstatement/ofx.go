@@ -0,0 +1,102 @@
+package bankstatement
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+)
+
+// parseOFX parses OFX 1.x (SGML, closing tags optional) and OFX 2.x (XML) bank and credit card statements.
+// The file is read as a flat sequence of tags; each <STMTTRN> aggregate becomes one line.
+func parseOFX(data []byte) (*Statement, error) {
+	statement := &Statement{Lines: []Line{}}
+	content := string(data)
+	if start := strings.Index(strings.ToUpper(content), "<OFX>"); start >= 0 {
+		content = content[start:]
+	} else {
+		return nil, fmt.Errorf("%w: missing <OFX> element", ErrInvalidStatement)
+	}
+
+	var current *Line
+	var inPayee bool
+	for _, token := range strings.Split(content, "<")[1:] {
+		end := strings.Index(token, ">")
+		if end < 0 {
+			continue
+		}
+		tag := strings.ToUpper(strings.TrimSpace(token[:end]))
+		value := strings.TrimSpace(html.UnescapeString(token[end+1:]))
+
+		switch tag {
+		case "STMTTRN", "/STMTTRN", "/BANKTRANLIST":
+			// SGML files may omit </STMTTRN>, so a new aggregate or the end of the list also closes one
+			if current != nil {
+				if current.Date.IsZero() {
+					return nil, fmt.Errorf("%w: transaction %q has no DTPOSTED", ErrInvalidStatement, current.Reference)
+				}
+				statement.Lines = append(statement.Lines, *current)
+			}
+			current = nil
+			if tag == "STMTTRN" {
+				current = &Line{}
+			}
+			continue
+		case "PAYEE":
+			inPayee = true
+			continue
+		case "/PAYEE":
+			inPayee = false
+			continue
+		case "CURDEF":
+			statement.CurrencyCode = strings.ToUpper(value)
+			continue
+		case "ACCTID":
+			statement.AccountNumber = value
+			continue
+		}
+
+		if current == nil || value == "" {
+			continue
+		}
+		switch tag {
+		case "FITID":
+			current.Reference = value
+		case "DTPOSTED":
+			date, err := parseOFXDate(value)
+			if err != nil {
+				return nil, err
+			}
+			current.Date = date
+		case "TRNAMT":
+			amount, err := parseAmount(value)
+			if err != nil {
+				return nil, err
+			}
+			current.Amount = amount
+		case "NAME":
+			if current.Payee == "" || inPayee {
+				current.Payee = value
+			}
+		case "MEMO":
+			current.Memo = value
+		}
+	}
+
+	if current != nil && !current.Date.IsZero() {
+		statement.Lines = append(statement.Lines, *current)
+	}
+	return statement, nil
+}
+
+// parseOFXDate parses the date part of an OFX datetime (YYYYMMDD[HHMMSS[.XXX]][[offset:TZ]])
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, fmt.Errorf("%w: invalid OFX date %q", ErrInvalidStatement, value)
+	}
+	date, err := time.Parse("20060102", value[:8])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid OFX date %q", ErrInvalidStatement, value)
+	}
+	return date, nil
+}
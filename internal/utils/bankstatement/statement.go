@@ -0,0 +1,150 @@
+// Package bankstatement parses bank statement files (OFX/QFX, ISO 20022 CAMT.053 and SWIFT MT940)
+// into a common list of statement lines.
+package bankstatement
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Format identifies a bank statement file format
+type Format string
+
+const (
+	FormatOFX     Format = "OFX"
+	FormatQFX     Format = "QFX" // Quicken's OFX variant; parsed as OFX
+	FormatCAMT053 Format = "CAMT053"
+	FormatMT940   Format = "MT940"
+)
+
+var (
+	// ErrUnsupportedFormat is returned for unknown or undetectable formats
+	ErrUnsupportedFormat = errors.New("unsupported bank statement format")
+	// ErrInvalidStatement is returned when a file cannot be parsed in its format
+	ErrInvalidStatement = errors.New("invalid bank statement")
+)
+
+// Line is one booked transaction of a statement, seen from the account holder:
+// a positive amount is money in, a negative amount money out.
+type Line struct {
+	Reference string // FITID or bank reference; generated from the line content when the file has none
+	Date      time.Time
+	Amount    decimal.Decimal
+	Payee     string
+	Memo      string
+}
+
+// Statement is the parsed content of a statement file
+type Statement struct {
+	Format        Format
+	CurrencyCode  string // Empty when the file does not state it
+	AccountNumber string // Empty when the file does not state it
+	Lines         []Line
+}
+
+// ParseFormat converts a user-supplied format name to a Format; an empty name means auto-detection
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToUpper(strings.NewReplacer(".", "", "-", "", "_", "").Replace(strings.TrimSpace(name))) {
+	case "":
+		return "", nil
+	case "OFX":
+		return FormatOFX, nil
+	case "QFX":
+		return FormatQFX, nil
+	case "CAMT053", "CAMT":
+		return FormatCAMT053, nil
+	case "MT940":
+		return FormatMT940, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, name)
+}
+
+// DetectFormat guesses the format of a statement file from its content
+func DetectFormat(data []byte) (Format, error) {
+	head := data
+	if len(head) > 4096 {
+		head = head[:4096]
+	}
+	upper := bytes.ToUpper(head)
+	switch {
+	case bytes.Contains(upper, []byte("OFXHEADER")) || bytes.Contains(upper, []byte("<OFX>")):
+		return FormatOFX, nil
+	case bytes.Contains(head, []byte("camt.053")) || bytes.Contains(head, []byte("BkToCstmrStmt")):
+		return FormatCAMT053, nil
+	case bytes.Contains(head, []byte(":20:")) && bytes.Contains(data, []byte(":61:")):
+		return FormatMT940, nil
+	}
+	return "", fmt.Errorf("%w: could not detect the file format", ErrUnsupportedFormat)
+}
+
+// Parse parses a statement file; an empty format is detected from the content.
+// Lines without a bank reference get a reference derived from their content, so re-importing the same
+// file yields the same references.
+func Parse(format Format, data []byte) (*Statement, error) {
+	if format == "" {
+		detected, err := DetectFormat(data)
+		if err != nil {
+			return nil, err
+		}
+		format = detected
+	}
+
+	var statement *Statement
+	var err error
+	switch format {
+	case FormatOFX, FormatQFX:
+		statement, err = parseOFX(data)
+	case FormatCAMT053:
+		statement, err = parseCAMT053(data)
+	case FormatMT940:
+		statement, err = parseMT940(data)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	statement.Format = format
+	assignFallbackReferences(statement.Lines)
+	return statement, nil
+}
+
+// assignFallbackReferences derives a stable reference for lines without one. Identical lines within
+// a file are told apart by their occurrence number.
+func assignFallbackReferences(lines []Line) {
+	occurrences := make(map[string]int)
+	for i := range lines {
+		if lines[i].Reference != "" {
+			continue
+		}
+		key := strings.Join([]string{
+			lines[i].Date.Format("2006-01-02"),
+			lines[i].Amount.String(),
+			lines[i].Payee,
+			lines[i].Memo,
+		}, "|")
+		occurrences[key]++
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d", key, occurrences[key])))
+		lines[i].Reference = "GEN-" + hex.EncodeToString(sum[:16])
+	}
+}
+
+// parseAmount parses an amount using either a dot or a comma as decimal separator
+func parseAmount(value string) (decimal.Decimal, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, ",") && !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: invalid amount %q", ErrInvalidStatement, value)
+	}
+	return amount, nil
+}
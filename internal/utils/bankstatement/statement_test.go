@@ -0,0 +1,249 @@
+package bankstatement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKACCTFROM><BANKID>123<ACCTID>987654<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20250101
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20250103120000[-5:EST]
+<TRNAMT>-42.50
+<FITID>2025010301
+<NAME>Corner Grocery
+<MEMO>Card purchase &amp; cashback
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20250105
+<TRNAMT>1500.00
+<FITID>2025010502
+<NAME>ACME Payroll
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+const camt053 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.08">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Acct><Id><IBAN>DE89370400440532013000</IBAN></Id><Ccy>EUR</Ccy></Acct>
+      <Ntry>
+        <Amt Ccy="EUR">19.99</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><Dt>2025-02-03</Dt></BookgDt>
+        <AcctSvcrRef>BANKREF-1</AcctSvcrRef>
+        <NtryDtls><TxDtls>
+          <RltdPties><Cdtr><Pty><Nm>Streaming Ltd</Nm></Pty></Cdtr></RltdPties>
+          <RmtInf><Ustrd>Monthly plan</Ustrd></RmtInf>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">250,00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <Sts><Cd>BOOK</Cd></Sts>
+        <BookgDt><DtTm>2025-02-04T09:30:00</DtTm></BookgDt>
+        <NtryDtls><TxDtls>
+          <Refs><EndToEndId>E2E-77</EndToEndId></Refs>
+          <RltdPties><Dbtr><Nm>Jane Doe</Nm></Dbtr></RltdPties>
+        </TxDtls></NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">5.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <Sts><Cd>PDNG</Cd></Sts>
+        <BookgDt><Dt>2025-02-05</Dt></BookgDt>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+const mt940 = `{1:F01BANKDEFFXXXX0000000000}{4:
+:20:STMT-2025-03
+:25:DE89370400440532013000
+:28C:00003/001
+:60F:C250301EUR1000,00
+:61:2503040304D12,30NTRFNONREF//BR-555
+Card payment
+:86:/NAME/Coffee House/REMI/Latte and cake
+:61:2503050305C800,00NTRFPAY-123
+:86:166?00GUTSCHRIFT?20Salary March?32ACME GMBH
+:61:2503060306D5,00NMSCNONREF
+:62F:C250306EUR1782,70
+-}`
+
+func TestParseOFX(t *testing.T) {
+	statement, err := Parse("", []byte(ofxSGML))
+	require.NoError(t, err)
+	assert.Equal(t, FormatOFX, statement.Format)
+	assert.Equal(t, "USD", statement.CurrencyCode)
+	assert.Equal(t, "987654", statement.AccountNumber)
+	require.Len(t, statement.Lines, 2)
+
+	assert.Equal(t, "2025010301", statement.Lines[0].Reference)
+	assert.Equal(t, time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), statement.Lines[0].Date)
+	assert.True(t, statement.Lines[0].Amount.Equal(decimal.RequireFromString("-42.50")))
+	assert.Equal(t, "Corner Grocery", statement.Lines[0].Payee)
+	assert.Equal(t, "Card purchase & cashback", statement.Lines[0].Memo)
+	assert.Equal(t, "ACME Payroll", statement.Lines[1].Payee)
+}
+
+func TestParseCAMT053(t *testing.T) {
+	statement, err := Parse("", []byte(camt053))
+	require.NoError(t, err)
+	assert.Equal(t, FormatCAMT053, statement.Format)
+	assert.Equal(t, "EUR", statement.CurrencyCode)
+	require.Len(t, statement.Lines, 2, "pending entries are skipped")
+
+	assert.Equal(t, "BANKREF-1", statement.Lines[0].Reference)
+	assert.True(t, statement.Lines[0].Amount.Equal(decimal.RequireFromString("-19.99")))
+	assert.Equal(t, "Streaming Ltd", statement.Lines[0].Payee)
+	assert.Equal(t, "Monthly plan", statement.Lines[0].Memo)
+
+	assert.Equal(t, "E2E-77", statement.Lines[1].Reference)
+	assert.Equal(t, time.Date(2025, 2, 4, 0, 0, 0, 0, time.UTC), statement.Lines[1].Date)
+	assert.True(t, statement.Lines[1].Amount.Equal(decimal.NewFromInt(250)))
+	assert.Equal(t, "Jane Doe", statement.Lines[1].Payee)
+}
+
+func TestParseMT940(t *testing.T) {
+	statement, err := Parse(FormatMT940, []byte(mt940))
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", statement.CurrencyCode)
+	assert.Equal(t, "DE89370400440532013000", statement.AccountNumber)
+	require.Len(t, statement.Lines, 3)
+
+	assert.Equal(t, "BR-555", statement.Lines[0].Reference)
+	assert.Equal(t, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), statement.Lines[0].Date)
+	assert.True(t, statement.Lines[0].Amount.Equal(decimal.RequireFromString("-12.30")))
+	assert.Equal(t, "Coffee House", statement.Lines[0].Payee)
+	assert.Equal(t, "Latte and cake", statement.Lines[0].Memo)
+
+	assert.Equal(t, "PAY-123", statement.Lines[1].Reference)
+	assert.True(t, statement.Lines[1].Amount.Equal(decimal.NewFromInt(800)))
+	assert.Equal(t, "ACME GMBH", statement.Lines[1].Payee)
+	assert.Equal(t, "Salary March", statement.Lines[1].Memo)
+
+	assert.Regexp(t, `^GEN-[0-9a-f]{32}$`, statement.Lines[2].Reference, "lines without references get a generated one")
+}
+
+func TestParse_FallbackReferencesAreStable(t *testing.T) {
+	content := []byte(":20:X\n:61:2503060306D5,00NMSCNONREF\n:61:2503060306D5,00NMSCNONREF\n")
+	first, err := Parse(FormatMT940, content)
+	require.NoError(t, err)
+	second, err := Parse(FormatMT940, content)
+	require.NoError(t, err)
+
+	require.Len(t, first.Lines, 2)
+	assert.NotEqual(t, first.Lines[0].Reference, first.Lines[1].Reference, "identical lines are told apart")
+	assert.Equal(t, first.Lines[1].Reference, second.Lines[1].Reference)
+}
+
+func TestParse_Errors(t *testing.T) {
+	_, err := Parse("", []byte("date,amount\n2025-01-01,5"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = Parse(FormatCAMT053, []byte("<Document></Document>"))
+	assert.ErrorIs(t, err, ErrInvalidStatement)
+
+	_, err = ParseFormat("pdf")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	f, err := ParseFormat("camt.053")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatCAMT053, f)
+}
+
+func TestParseCSV_SignedAmountsWithCommaDecimals(t *testing.T) {
+	data := "Kontoauszug Girokonto\n" +
+		"Datum;Empfänger;Verwendungszweck;Betrag\n" +
+		"03.01.2025;Corner Grocery;Card purchase;-1.042,50\n" +
+		"\n" +
+		"05.01.2025;ACME Payroll;Salary;2.500,00\n" +
+		"xx.01.2025;Broken;Row;1,00\n"
+
+	rows, err := ParseCSV([]byte(data), CSVLayout{
+		Delimiter:        ';',
+		SkipRows:         1,
+		HasHeader:        true,
+		DateColumn:       1,
+		DateFormat:       "DD.MM.YYYY",
+		AmountMode:       AmountSigned,
+		AmountColumn:     4,
+		DecimalSeparator: ",",
+		PayeeColumn:      2,
+		MemoColumn:       3,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	require.NoError(t, rows[0].Err)
+	assert.Equal(t, 3, rows[0].RowNumber)
+	assert.Equal(t, time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), rows[0].Line.Date)
+	assert.True(t, rows[0].Line.Amount.Equal(decimal.RequireFromString("-1042.50")))
+	assert.Equal(t, "Corner Grocery", rows[0].Line.Payee)
+	assert.NotEmpty(t, rows[0].Line.Reference)
+
+	require.NoError(t, rows[1].Err)
+	assert.Equal(t, 5, rows[1].RowNumber)
+	assert.True(t, rows[1].Line.Amount.Equal(decimal.RequireFromString("2500")))
+
+	assert.Error(t, rows[2].Err)
+}
+
+func TestParseCSV_DebitCreditColumns(t *testing.T) {
+	data := "Date,Description,Debit,Credit,Ref\n" +
+		"01/15/2025,Coffee,\"1,234.56\",,R1\n" +
+		"01/16/2025,Refund,,(10.00),R2\n" +
+		"01/17/2025,Fee,-2.00,,R3\n"
+
+	rows, err := ParseCSV([]byte(data), CSVLayout{
+		HasHeader:        true,
+		DateColumn:       1,
+		DateFormat:       "MM/DD/YYYY",
+		AmountMode:       AmountDebitCredit,
+		DebitColumn:      3,
+		CreditColumn:     4,
+		DecimalSeparator: ".",
+		MemoColumn:       2,
+		ReferenceColumn:  5,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.True(t, rows[0].Line.Amount.Equal(decimal.RequireFromString("-1234.56")))
+	assert.Equal(t, "R1", rows[0].Line.Reference)
+	assert.True(t, rows[1].Line.Amount.Equal(decimal.RequireFromString("10")))
+	assert.True(t, rows[2].Line.Amount.Equal(decimal.RequireFromString("-2")))
+}
+
+func TestCSVLayout_Validate(t *testing.T) {
+	valid := CSVLayout{Delimiter: ',', DateColumn: 1, DateFormat: "YYYY-MM-DD", AmountMode: AmountInverted, AmountColumn: 2, DecimalSeparator: "."}
+	assert.NoError(t, valid.Validate())
+
+	sameSeparator := valid
+	sameSeparator.DecimalSeparator = ","
+	assert.Error(t, sameSeparator.Validate())
+
+	missingColumns := valid
+	missingColumns.AmountMode = AmountDebitCredit
+	assert.Error(t, missingColumns.Validate())
+
+	assert.Equal(t, "2006-01-02", GoDateLayout("YYYY-MM-DD"))
+	assert.Equal(t, "2/1/06", GoDateLayout("D/M/YY"))
+	assert.Equal(t, "02.01.2006", GoDateLayout("02.01.2006"))
+}
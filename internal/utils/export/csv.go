@@ -0,0 +1,55 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// csvFlushInterval is the number of rows written between flushes to the underlying writer
+const csvFlushInterval = 500
+
+// csvWriter writes the metadata as leading label/value rows, a blank row, then the table
+type csvWriter struct {
+	w    *csv.Writer
+	rows int
+}
+
+func newCSVWriter(w io.Writer, meta Metadata, columns []Column) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+
+	for _, line := range meta.headerLines() {
+		if err := cw.w.Write([]string{line[0], line[1]}); err != nil {
+			return nil, err
+		}
+	}
+	if err := cw.w.Write([]string{}); err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, len(columns))
+	for i, c := range columns {
+		titles[i] = c.Title
+	}
+	if err := cw.w.Write(titles); err != nil {
+		return nil, err
+	}
+
+	return cw, nil
+}
+
+func (cw *csvWriter) WriteRow(cells ...string) error {
+	if err := cw.w.Write(cells); err != nil {
+		return err
+	}
+	cw.rows++
+	if cw.rows%csvFlushInterval == 0 {
+		cw.w.Flush()
+		return cw.w.Error()
+	}
+	return nil
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
@@ -0,0 +1,116 @@
+// Package export writes tabular report data as CSV, XLSX or PDF files.
+// Writers are row-oriented so callers can stream large exports without buffering them.
+package export
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+)
+
+// Format identifies an export file format
+type Format string
+
+const (
+	FormatJSON Format = "json" // Not a file format; the regular API response
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	FormatPDF  Format = "pdf"
+)
+
+// contentTypes maps each file format to its MIME type
+var contentTypes = map[Format]string{
+	FormatCSV:  "text/csv",
+	FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	FormatPDF:  "application/pdf",
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if ct, ok := contentTypes[f]; ok {
+		return ct
+	}
+	return "application/json"
+}
+
+// FileName returns a file name with the format's extension
+func (f Format) FileName(base string) string {
+	return base + "." + string(f)
+}
+
+// ResolveFormat selects the export format from an explicit format parameter, falling back to the Accept header.
+// An empty result defaults to JSON; an unknown format parameter is an error.
+func ResolveFormat(formatParam, accept string) (Format, error) {
+	if formatParam != "" {
+		f := Format(strings.ToLower(formatParam))
+		if f == FormatJSON {
+			return FormatJSON, nil
+		}
+		if _, ok := contentTypes[f]; !ok {
+			return "", fmt.Errorf("unsupported export format %q", formatParam)
+		}
+		return f, nil
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		for f, ct := range contentTypes {
+			if mediaType == ct {
+				return f, nil
+			}
+		}
+	}
+	return FormatJSON, nil
+}
+
+// Metadata describes the document header written above the table
+type Metadata struct {
+	Title       string    // e.g. "Trial Balance"
+	Workplace   string    // Workplace name
+	Period      string    // Human-readable period or as-of date
+	GeneratedAt time.Time // Generation timestamp
+}
+
+// headerLines returns the metadata as label/value lines
+func (m Metadata) headerLines() [][2]string {
+	return [][2]string{
+		{"Report", m.Title},
+		{"Workplace", m.Workplace},
+		{"Period", m.Period},
+		{"Generated at", m.GeneratedAt.UTC().Format(time.RFC3339)},
+	}
+}
+
+// Column describes a table column
+type Column struct {
+	Title   string
+	Numeric bool // Right-aligned and written as a number where the format supports it
+	Width   int  // Width hint in characters for fixed-layout formats (PDF); 0 uses a default
+}
+
+// Writer writes a single table preceded by its metadata
+type Writer interface {
+	// WriteRow writes one row; cells are matched to columns by position
+	WriteRow(cells ...string) error
+	// Close finishes the document and flushes any buffered output. It does not close the underlying io.Writer.
+	Close() error
+}
+
+// NewWriter starts a document of the given format on w, writing the metadata and column headers
+func NewWriter(format Format, w io.Writer, meta Metadata, columns []Column) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, meta, columns)
+	case FormatXLSX:
+		return newXLSXWriter(w, meta, columns)
+	case FormatPDF:
+		return newPDFWriter(w, meta, columns)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
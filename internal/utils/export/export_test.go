@@ -0,0 +1,101 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMeta = Metadata{
+	Title:       "Trial Balance",
+	Workplace:   "Household",
+	Period:      "As of 2025-01-31",
+	GeneratedAt: time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC),
+}
+
+var testColumns = []Column{
+	{Title: "Account", Width: 20},
+	{Title: "Debit", Numeric: true},
+}
+
+func TestResolveFormat(t *testing.T) {
+	f, err := ResolveFormat("", "")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatJSON, f)
+
+	f, err = ResolveFormat("XLSX", "application/pdf")
+	assert.NoError(t, err, "the format parameter wins over the Accept header")
+	assert.Equal(t, FormatXLSX, f)
+
+	f, err = ResolveFormat("", "text/html, application/pdf;q=0.9")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatPDF, f)
+
+	_, err = ResolveFormat("docx", "")
+	assert.Error(t, err)
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatCSV, &buf, testMeta, testColumns)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow("Cash, in hand", "10.00"))
+	require.NoError(t, w.Close())
+
+	records, err := csv.NewReader(strings.NewReader(buf.String())).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Workplace", "Household"}, records[1])
+	assert.Equal(t, []string{"Account", "Debit"}, records[4])
+	assert.Equal(t, []string{"Cash, in hand", "10.00"}, records[5])
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatXLSX, &buf, testMeta, testColumns)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow("Bank & <Cash>", "10.50"))
+	require.NoError(t, w.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	var sheet string
+	names := []string{}
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, err := f.Open()
+			require.NoError(t, err)
+			content, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			sheet = string(content)
+		}
+	}
+	assert.Contains(t, names, "[Content_Types].xml")
+	assert.Contains(t, names, "xl/workbook.xml")
+	assert.Contains(t, sheet, "Bank &amp; &lt;Cash&gt;")
+	assert.Contains(t, sheet, "<c><v>10.50</v></c>", "numeric columns are written as numbers")
+}
+
+func TestPDFWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatPDF, &buf, testMeta, testColumns)
+	require.NoError(t, err)
+	for i := 0; i < 120; i++ {
+		require.NoError(t, w.WriteRow("Account (main)", "1.00"))
+	}
+	require.NoError(t, w.Close())
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, "/Count 3", "rows overflow onto additional pages")
+	assert.Contains(t, out, `Account \(main\)`)
+}
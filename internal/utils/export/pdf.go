@@ -0,0 +1,236 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PDF page layout: A4 landscape with a monospaced font so columns line up without font metrics
+const (
+	pdfPageWidth    = 842
+	pdfPageHeight   = 595
+	pdfMargin       = 36
+	pdfFontSize     = 8
+	pdfLineHeight   = 10
+	pdfCharsPerLine = 160 // (pdfPageWidth - 2*pdfMargin) / (0.6 * pdfFontSize)
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+	pdfDefaultWidth = 12
+	pdfMinWidth     = 4
+)
+
+// Reserved object numbers; pages start after the fonts
+const (
+	pdfCatalogObj  = 1
+	pdfPagesObj    = 2
+	pdfFontObj     = 3
+	pdfBoldFontObj = 4
+	pdfFirstPage   = 5
+)
+
+// countingWriter tracks the byte offset needed for the PDF cross-reference table
+type countingWriter struct {
+	w *bufio.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+// pdfLine is a line of page text, optionally in bold
+type pdfLine struct {
+	text string
+	bold bool
+}
+
+// pdfWriter streams a printable table one page at a time; only the current page is held in memory
+type pdfWriter struct {
+	out     *countingWriter
+	offsets map[int]int64
+	nextObj int
+	pages   []int
+	widths  []int
+	columns []Column
+	header  string
+	lines   []pdfLine
+	err     error
+}
+
+func newPDFWriter(w io.Writer, meta Metadata, columns []Column) (*pdfWriter, error) {
+	pw := &pdfWriter{
+		out:     &countingWriter{w: bufio.NewWriter(w)},
+		offsets: make(map[int]int64),
+		nextObj: pdfFirstPage,
+		columns: columns,
+		widths:  pdfColumnWidths(columns),
+	}
+
+	pw.printf("%%PDF-1.4\n%%\xe2\xe3\xcf\xd3\n")
+	pw.writeObject(pdfFontObj, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	pw.writeObject(pdfBoldFontObj, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>")
+
+	titles := make([]string, len(columns))
+	for i, c := range columns {
+		titles[i] = c.Title
+	}
+	pw.header = pw.formatRow(titles)
+
+	pw.lines = append(pw.lines, pdfLine{text: meta.Title, bold: true})
+	for _, line := range meta.headerLines()[1:] {
+		pw.lines = append(pw.lines, pdfLine{text: line[0] + ": " + line[1]})
+	}
+	pw.lines = append(pw.lines, pdfLine{}, pdfLine{text: pw.header, bold: true})
+
+	return pw, pw.err
+}
+
+// pdfColumnWidths assigns character widths to columns, shrinking them proportionally to fit the page
+func pdfColumnWidths(columns []Column) []int {
+	widths := make([]int, len(columns))
+	total := 0
+	for i, c := range columns {
+		widths[i] = c.Width
+		if widths[i] <= 0 {
+			widths[i] = pdfDefaultWidth
+		}
+		total += widths[i]
+	}
+
+	available := pdfCharsPerLine - (len(columns) - 1) // one space between columns
+	if total > available {
+		for i := range widths {
+			widths[i] = widths[i] * available / total
+			if widths[i] < pdfMinWidth {
+				widths[i] = pdfMinWidth
+			}
+		}
+	}
+	return widths
+}
+
+// formatRow lays out cells in fixed-width columns, truncating long values and right-aligning numeric columns
+func (pw *pdfWriter) formatRow(cells []string) string {
+	parts := make([]string, len(pw.widths))
+	for i, width := range pw.widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		runes := []rune(cell)
+		if len(runes) > width {
+			runes = runes[:width]
+		}
+		pad := strings.Repeat(" ", width-len(runes))
+		if pw.columns[i].Numeric {
+			parts[i] = pad + string(runes)
+		} else {
+			parts[i] = string(runes) + pad
+		}
+	}
+	return strings.TrimRight(strings.Join(parts, " "), " ")
+}
+
+func (pw *pdfWriter) WriteRow(cells ...string) error {
+	if len(pw.lines) >= pdfLinesPerPage-1 { // keep the last line for the page number
+		pw.flushPage()
+		pw.lines = append(pw.lines, pdfLine{text: pw.header, bold: true})
+	}
+	pw.lines = append(pw.lines, pdfLine{text: pw.formatRow(cells)})
+	return pw.err
+}
+
+func (pw *pdfWriter) Close() error {
+	if len(pw.lines) > 0 || len(pw.pages) == 0 {
+		pw.flushPage()
+	}
+
+	kids := make([]string, len(pw.pages))
+	for i, p := range pw.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", p)
+	}
+	pw.writeObject(pdfPagesObj, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pw.pages)))
+	pw.writeObject(pdfCatalogObj, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesObj))
+
+	xrefOffset := pw.out.n
+	pw.printf("xref\n0 %d\n0000000000 65535 f \n", pw.nextObj)
+	for obj := 1; obj < pw.nextObj; obj++ {
+		pw.printf("%010d 00000 n \n", pw.offsets[obj])
+	}
+	pw.printf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", pw.nextObj, pdfCatalogObj, xrefOffset)
+
+	if pw.err != nil {
+		return pw.err
+	}
+	return pw.out.w.Flush()
+}
+
+// flushPage writes the buffered lines as a content stream and page object, then starts a new page
+func (pw *pdfWriter) flushPage() {
+	pageNumber := len(pw.pages) + 1
+
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n%d TL\n%d %d Td\n", pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+	currentFont := ""
+	for _, line := range pw.lines {
+		font := "/F1"
+		if line.bold {
+			font = "/F2"
+		}
+		if font != currentFont {
+			fmt.Fprintf(&content, "%s %d Tf\n", font, pdfFontSize)
+			currentFont = font
+		}
+		fmt.Fprintf(&content, "(%s) Tj T*\n", pdfEscape(line.text))
+	}
+	fmt.Fprintf(&content, "ET\nBT\n/F1 %d Tf\n%d %d Td\n(Page %d) Tj\nET\n", pdfFontSize, pdfPageWidth-pdfMargin-60, pdfMargin/2, pageNumber)
+
+	contentObj := pw.nextObj
+	pageObj := pw.nextObj + 1
+	pw.nextObj += 2
+
+	pw.writeObject(contentObj, fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	pw.writeObject(pageObj, fmt.Sprintf(
+		"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+		pdfPagesObj, pdfPageWidth, pdfPageHeight, pdfFontObj, pdfBoldFontObj, contentObj))
+	pw.pages = append(pw.pages, pageObj)
+	pw.lines = pw.lines[:0]
+
+	if pw.err == nil {
+		pw.err = pw.out.w.Flush()
+	}
+}
+
+func (pw *pdfWriter) writeObject(obj int, body string) {
+	pw.offsets[obj] = pw.out.n
+	pw.printf("%d 0 obj\n%s\nendobj\n", obj, body)
+}
+
+func (pw *pdfWriter) printf(format string, args ...any) {
+	if pw.err != nil {
+		return
+	}
+	_, pw.err = fmt.Fprintf(pw.out, format, args...)
+}
+
+// pdfEscape escapes a string for a PDF literal, replacing characters outside Latin-1 with '?'
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
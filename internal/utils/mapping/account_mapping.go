@@ -8,34 +8,36 @@ import (
 // ToModelAccount converts a domain Account to a model Account
 func ToModelAccount(d domain.Account) models.Account {
 	return models.Account{
-		AccountID:       d.AccountID,
-		WorkplaceID:     d.WorkplaceID,
-		CFID:            d.CFID,
-		Name:            d.Name,
-		AccountType:     models.AccountType(d.AccountType),
-		CurrencyCode:    d.CurrencyCode,
-		ParentAccountID: d.ParentAccountID,
-		Description:     d.Description,
-		IsActive:        d.IsActive,
-		AuditFields:     ToModelAuditFields(d.AuditFields),
-		Balance:         d.Balance,
+		AccountID:        d.AccountID,
+		WorkplaceID:      d.WorkplaceID,
+		CFID:             d.CFID,
+		Name:             d.Name,
+		AccountType:      models.AccountType(d.AccountType),
+		CurrencyCode:     d.CurrencyCode,
+		ParentAccountID:  d.ParentAccountID,
+		Description:      d.Description,
+		IsActive:         d.IsActive,
+		AuditFields:      ToModelAuditFields(d.AuditFields),
+		Balance:          d.Balance,
+		CashFlowCategory: string(d.CashFlowCategory),
 	}
 }
 
 // ToDomainAccount converts a model Account to a domain Account
 func ToDomainAccount(m models.Account) domain.Account {
 	return domain.Account{
-		AccountID:       m.AccountID,
-		WorkplaceID:     m.WorkplaceID,
-		CFID:            m.CFID,
-		Name:            m.Name,
-		AccountType:     domain.AccountType(m.AccountType),
-		CurrencyCode:    m.CurrencyCode,
-		ParentAccountID: m.ParentAccountID,
-		Description:     m.Description,
-		IsActive:        m.IsActive,
-		AuditFields:     ToDomainAuditFields(m.AuditFields),
-		Balance:         m.Balance,
+		AccountID:        m.AccountID,
+		WorkplaceID:      m.WorkplaceID,
+		CFID:             m.CFID,
+		Name:             m.Name,
+		AccountType:      domain.AccountType(m.AccountType),
+		CurrencyCode:     m.CurrencyCode,
+		ParentAccountID:  m.ParentAccountID,
+		Description:      m.Description,
+		IsActive:         m.IsActive,
+		AuditFields:      ToDomainAuditFields(m.AuditFields),
+		Balance:          m.Balance,
+		CashFlowCategory: domain.CashFlowCategory(m.CashFlowCategory),
 	}
 }
 
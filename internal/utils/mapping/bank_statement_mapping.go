@@ -0,0 +1,79 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelBankStatementImport converts a domain BankStatementImport to a model BankStatementImport
+func ToModelBankStatementImport(d domain.BankStatementImport) models.BankStatementImport {
+	return models.BankStatementImport{
+		ImportID:    d.ImportID,
+		WorkplaceID: d.WorkplaceID,
+		AccountID:   d.AccountID,
+		Format:      d.Format,
+		FileName:    d.FileName,
+		TotalLines:  d.TotalLines,
+		NewLines:    d.NewLines,
+		AuditFields: ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainBankStatementImport converts a model BankStatementImport to a domain BankStatementImport
+func ToDomainBankStatementImport(m models.BankStatementImport) domain.BankStatementImport {
+	return domain.BankStatementImport{
+		ImportID:       m.ImportID,
+		WorkplaceID:    m.WorkplaceID,
+		AccountID:      m.AccountID,
+		Format:         m.Format,
+		FileName:       m.FileName,
+		TotalLines:     m.TotalLines,
+		NewLines:       m.NewLines,
+		DuplicateLines: m.TotalLines - m.NewLines,
+		AuditFields:    ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelBankStatementLine converts a domain BankStatementLine to a model BankStatementLine
+func ToModelBankStatementLine(d domain.BankStatementLine) models.BankStatementLine {
+	return models.BankStatementLine{
+		LineID:           d.LineID,
+		WorkplaceID:      d.WorkplaceID,
+		AccountID:        d.AccountID,
+		ImportID:         d.ImportID,
+		BankReference:    d.BankReference,
+		Date:             d.Date,
+		Amount:           d.Amount,
+		Payee:            d.Payee,
+		Memo:             d.Memo,
+		Status:           string(d.Status),
+		JournalID:        d.JournalID,
+		CounterAccountID: d.CounterAccountID,
+		Description:      d.Description,
+		Notes:            d.Notes,
+		MatchedRuleID:    d.MatchedRuleID,
+		AuditFields:      ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainBankStatementLine converts a model BankStatementLine to a domain BankStatementLine
+func ToDomainBankStatementLine(m models.BankStatementLine) domain.BankStatementLine {
+	return domain.BankStatementLine{
+		LineID:           m.LineID,
+		WorkplaceID:      m.WorkplaceID,
+		AccountID:        m.AccountID,
+		ImportID:         m.ImportID,
+		BankReference:    m.BankReference,
+		Date:             m.Date,
+		Amount:           m.Amount,
+		Payee:            m.Payee,
+		Memo:             m.Memo,
+		Status:           domain.StatementLineStatus(m.Status),
+		JournalID:        m.JournalID,
+		CounterAccountID: m.CounterAccountID,
+		Description:      m.Description,
+		Notes:            m.Notes,
+		MatchedRuleID:    m.MatchedRuleID,
+		AuditFields:      ToDomainAuditFields(m.AuditFields),
+	}
+}
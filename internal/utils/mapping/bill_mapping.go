@@ -0,0 +1,132 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelBill converts a domain Bill to a model Bill
+func ToModelBill(d domain.Bill) models.Bill {
+	return models.Bill{
+		BillID:           d.BillID,
+		WorkplaceID:      d.WorkplaceID,
+		VendorID:         d.VendorID,
+		PayableAccountID: d.PayableAccountID,
+		Reference:        d.Reference,
+		BillDate:         d.BillDate,
+		DueDate:          d.DueDate,
+		CurrencyCode:     d.CurrencyCode,
+		Notes:            d.Notes,
+		Subtotal:         d.Subtotal,
+		TaxTotal:         d.TaxTotal,
+		Total:            d.Total,
+		PostJournalID:    d.PostJournalID,
+		AuditFields:      ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainBill converts a model Bill to a domain Bill without lines or payments
+func ToDomainBill(m models.Bill) domain.Bill {
+	return domain.Bill{
+		BillID:           m.BillID,
+		WorkplaceID:      m.WorkplaceID,
+		VendorID:         m.VendorID,
+		PayableAccountID: m.PayableAccountID,
+		Reference:        m.Reference,
+		BillDate:         m.BillDate,
+		DueDate:          m.DueDate,
+		CurrencyCode:     m.CurrencyCode,
+		Notes:            m.Notes,
+		Subtotal:         m.Subtotal,
+		TaxTotal:         m.TaxTotal,
+		Total:            m.Total,
+		PostJournalID:    m.PostJournalID,
+		AuditFields:      ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelBillLine converts a domain BillLine to a model BillLine
+func ToModelBillLine(d domain.BillLine) models.BillLine {
+	return models.BillLine{
+		LineID:           d.LineID,
+		BillID:           d.BillID,
+		LineNumber:       d.LineNumber,
+		Description:      d.Description,
+		ExpenseAccountID: d.ExpenseAccountID,
+		TaxCodeID:        d.TaxCodeID,
+		Amount:           d.Amount,
+		NetAmount:        d.NetAmount,
+		TaxAmount:        d.TaxAmount,
+	}
+}
+
+// ToDomainBillLine converts a model BillLine to a domain BillLine
+func ToDomainBillLine(m models.BillLine) domain.BillLine {
+	return domain.BillLine{
+		LineID:           m.LineID,
+		BillID:           m.BillID,
+		LineNumber:       m.LineNumber,
+		Description:      m.Description,
+		ExpenseAccountID: m.ExpenseAccountID,
+		TaxCodeID:        m.TaxCodeID,
+		Amount:           m.Amount,
+		NetAmount:        m.NetAmount,
+		TaxAmount:        m.TaxAmount,
+	}
+}
+
+// ToModelBillPayment converts a domain BillPayment to a model BillPayment
+func ToModelBillPayment(d domain.BillPayment) models.BillPayment {
+	return models.BillPayment{
+		PaymentID:          d.PaymentID,
+		BillID:             d.BillID,
+		JournalID:          d.JournalID,
+		PaymentAccountID:   d.PaymentAccountID,
+		ScheduledPaymentID: d.ScheduledPaymentID,
+		PaymentDate:        d.PaymentDate,
+		Amount:             d.Amount,
+		CreatedAt:          d.CreatedAt,
+		CreatedBy:          d.CreatedBy,
+	}
+}
+
+// ToDomainBillPayment converts a model BillPayment to a domain BillPayment
+func ToDomainBillPayment(m models.BillPayment) domain.BillPayment {
+	return domain.BillPayment{
+		PaymentID:          m.PaymentID,
+		BillID:             m.BillID,
+		JournalID:          m.JournalID,
+		PaymentAccountID:   m.PaymentAccountID,
+		ScheduledPaymentID: m.ScheduledPaymentID,
+		PaymentDate:        m.PaymentDate,
+		Amount:             m.Amount,
+		CreatedAt:          m.CreatedAt,
+		CreatedBy:          m.CreatedBy,
+	}
+}
+
+// ToModelScheduledBillPayment converts a domain ScheduledBillPayment to a model ScheduledBillPayment
+func ToModelScheduledBillPayment(d domain.ScheduledBillPayment) models.ScheduledBillPayment {
+	return models.ScheduledBillPayment{
+		ScheduledPaymentID: d.ScheduledPaymentID,
+		BillID:             d.BillID,
+		PaymentAccountID:   d.PaymentAccountID,
+		ScheduledDate:      d.ScheduledDate,
+		Amount:             d.Amount,
+		CreatedAt:          d.CreatedAt,
+		CreatedBy:          d.CreatedBy,
+	}
+}
+
+// ToDomainScheduledBillPayment converts a model ScheduledBillPayment to a domain ScheduledBillPayment
+func ToDomainScheduledBillPayment(m models.ScheduledBillPayment) domain.ScheduledBillPayment {
+	return domain.ScheduledBillPayment{
+		ScheduledPaymentID: m.ScheduledPaymentID,
+		BillID:             m.BillID,
+		PaymentAccountID:   m.PaymentAccountID,
+		ScheduledDate:      m.ScheduledDate,
+		Amount:             m.Amount,
+		CreatedAt:          m.CreatedAt,
+		CreatedBy:          m.CreatedBy,
+	}
+}
@@ -0,0 +1,55 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelBudget converts a domain Budget to a model Budget (lines are mapped separately)
+func ToModelBudget(d domain.Budget) models.Budget {
+	return models.Budget{
+		BudgetID:    d.BudgetID,
+		WorkplaceID: d.WorkplaceID,
+		Name:        d.Name,
+		Description: d.Description,
+		PeriodType:  string(d.PeriodType),
+		Year:        d.Year,
+		AuditFields: ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainBudget converts a model Budget and its lines to a domain Budget
+func ToDomainBudget(m models.Budget, lines []models.BudgetLine) domain.Budget {
+	budget := domain.Budget{
+		BudgetID:    m.BudgetID,
+		WorkplaceID: m.WorkplaceID,
+		Name:        m.Name,
+		Description: m.Description,
+		PeriodType:  domain.BudgetPeriodType(m.PeriodType),
+		Year:        m.Year,
+		Lines:       make([]domain.BudgetLine, len(lines)),
+		AuditFields: ToDomainAuditFields(m.AuditFields),
+	}
+	for i, line := range lines {
+		budget.Lines[i] = domain.BudgetLine{
+			AccountID:   line.AccountID,
+			PeriodStart: line.PeriodStart,
+			Amount:      line.Amount,
+		}
+	}
+	return budget
+}
+
+// ToModelBudgetLines converts the lines of a domain Budget to model BudgetLines
+func ToModelBudgetLines(budgetID string, lines []domain.BudgetLine) []models.BudgetLine {
+	result := make([]models.BudgetLine, len(lines))
+	for i, line := range lines {
+		result[i] = models.BudgetLine{
+			BudgetID:    budgetID,
+			AccountID:   line.AccountID,
+			PeriodStart: line.PeriodStart,
+			Amount:      line.Amount,
+		}
+	}
+	return result
+}
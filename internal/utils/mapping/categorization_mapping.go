@@ -0,0 +1,66 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// toNullDecimal converts an optional decimal to a nullable one
+func toNullDecimal(d *decimal.Decimal) decimal.NullDecimal {
+	if d == nil {
+		return decimal.NullDecimal{}
+	}
+	return decimal.NullDecimal{Decimal: *d, Valid: true}
+}
+
+// fromNullDecimal converts a nullable decimal to an optional one
+func fromNullDecimal(d decimal.NullDecimal) *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	value := d.Decimal
+	return &value
+}
+
+// ToModelCategorizationRule converts a domain CategorizationRule to a model CategorizationRule
+func ToModelCategorizationRule(d domain.CategorizationRule) models.CategorizationRule {
+	return models.CategorizationRule{
+		RuleID:             d.RuleID,
+		WorkplaceID:        d.WorkplaceID,
+		Name:               d.Name,
+		Priority:           d.Priority,
+		IsActive:           d.IsActive,
+		PayeePattern:       d.PayeePattern,
+		DescriptionPattern: d.DescriptionPattern,
+		MinAmount:          toNullDecimal(d.MinAmount),
+		MaxAmount:          toNullDecimal(d.MaxAmount),
+		SourceAccountID:    d.SourceAccountID,
+		Sign:               string(d.Sign),
+		CounterAccountID:   d.CounterAccountID,
+		JournalDescription: d.JournalDescription,
+		Notes:              d.Notes,
+		AuditFields:        ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainCategorizationRule converts a model CategorizationRule to a domain CategorizationRule
+func ToDomainCategorizationRule(m models.CategorizationRule) domain.CategorizationRule {
+	return domain.CategorizationRule{
+		RuleID:             m.RuleID,
+		WorkplaceID:        m.WorkplaceID,
+		Name:               m.Name,
+		Priority:           m.Priority,
+		IsActive:           m.IsActive,
+		PayeePattern:       m.PayeePattern,
+		DescriptionPattern: m.DescriptionPattern,
+		MinAmount:          fromNullDecimal(m.MinAmount),
+		MaxAmount:          fromNullDecimal(m.MaxAmount),
+		SourceAccountID:    m.SourceAccountID,
+		Sign:               domain.RuleSign(m.Sign),
+		CounterAccountID:   m.CounterAccountID,
+		JournalDescription: m.JournalDescription,
+		Notes:              m.Notes,
+		AuditFields:        ToDomainAuditFields(m.AuditFields),
+	}
+}
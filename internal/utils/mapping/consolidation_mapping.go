@@ -0,0 +1,52 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelConsolidationGroup converts a domain ConsolidationGroup to a model ConsolidationGroup
+func ToModelConsolidationGroup(g domain.ConsolidationGroup) models.ConsolidationGroup {
+	return models.ConsolidationGroup{
+		GroupID:      g.GroupID,
+		WorkplaceID:  g.WorkplaceID,
+		Name:         g.Name,
+		Description:  g.Description,
+		CurrencyCode: g.CurrencyCode,
+		AuditFields:  ToModelAuditFields(g.AuditFields),
+	}
+}
+
+// ToDomainConsolidationGroup converts a model ConsolidationGroup to a domain ConsolidationGroup without members
+// and account mappings
+func ToDomainConsolidationGroup(m models.ConsolidationGroup) domain.ConsolidationGroup {
+	return domain.ConsolidationGroup{
+		GroupID:      m.GroupID,
+		WorkplaceID:  m.WorkplaceID,
+		Name:         m.Name,
+		Description:  m.Description,
+		CurrencyCode: m.CurrencyCode,
+		MemberIDs:    []string{},
+		Accounts:     []domain.ConsolidationAccountMapping{},
+		AuditFields:  ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelConsolidationAccountMapping converts a domain ConsolidationAccountMapping of a group to a model
+func ToModelConsolidationAccountMapping(groupID string, d domain.ConsolidationAccountMapping) models.ConsolidationAccountMapping {
+	return models.ConsolidationAccountMapping{
+		GroupID:      groupID,
+		AccountID:    d.AccountID,
+		GroupCode:    d.GroupCode,
+		Intercompany: d.Intercompany,
+	}
+}
+
+// ToDomainConsolidationAccountMapping converts a model ConsolidationAccountMapping to a domain one
+func ToDomainConsolidationAccountMapping(m models.ConsolidationAccountMapping) domain.ConsolidationAccountMapping {
+	return domain.ConsolidationAccountMapping{
+		AccountID:    m.AccountID,
+		GroupCode:    m.GroupCode,
+		Intercompany: m.Intercompany,
+	}
+}
@@ -0,0 +1,34 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelCreditCard converts a domain CreditCard to a model CreditCard
+func ToModelCreditCard(d domain.CreditCard) models.CreditCard {
+	return models.CreditCard{
+		AccountID:             d.AccountID,
+		WorkplaceID:           d.WorkplaceID,
+		ClosingDay:            d.ClosingDay,
+		DueDay:                d.DueDay,
+		CreditLimit:           d.CreditLimit,
+		MinimumPaymentPercent: d.MinimumPaymentPercent,
+		MinimumPaymentAmount:  d.MinimumPaymentAmount,
+		AuditFields:           ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainCreditCard converts a model CreditCard to a domain CreditCard
+func ToDomainCreditCard(m models.CreditCard) domain.CreditCard {
+	return domain.CreditCard{
+		AccountID:             m.AccountID,
+		WorkplaceID:           m.WorkplaceID,
+		ClosingDay:            m.ClosingDay,
+		DueDay:                m.DueDay,
+		CreditLimit:           m.CreditLimit,
+		MinimumPaymentPercent: m.MinimumPaymentPercent,
+		MinimumPaymentAmount:  m.MinimumPaymentAmount,
+		AuditFields:           ToDomainAuditFields(m.AuditFields),
+	}
+}
@@ -0,0 +1,54 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelCSVImportProfile converts a domain CSVImportProfile to a model CSVImportProfile
+func ToModelCSVImportProfile(d domain.CSVImportProfile) models.CSVImportProfile {
+	return models.CSVImportProfile{
+		ProfileID:        d.ProfileID,
+		WorkplaceID:      d.WorkplaceID,
+		AccountID:        d.AccountID,
+		Name:             d.Name,
+		Delimiter:        d.Delimiter,
+		SkipRows:         d.SkipRows,
+		HasHeader:        d.HasHeader,
+		DateColumn:       d.DateColumn,
+		DateFormat:       d.DateFormat,
+		AmountMode:       string(d.AmountMode),
+		AmountColumn:     d.AmountColumn,
+		DebitColumn:      d.DebitColumn,
+		CreditColumn:     d.CreditColumn,
+		DecimalSeparator: d.DecimalSeparator,
+		PayeeColumn:      d.PayeeColumn,
+		MemoColumn:       d.MemoColumn,
+		ReferenceColumn:  d.ReferenceColumn,
+		AuditFields:      ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainCSVImportProfile converts a model CSVImportProfile to a domain CSVImportProfile
+func ToDomainCSVImportProfile(m models.CSVImportProfile) domain.CSVImportProfile {
+	return domain.CSVImportProfile{
+		ProfileID:        m.ProfileID,
+		WorkplaceID:      m.WorkplaceID,
+		AccountID:        m.AccountID,
+		Name:             m.Name,
+		Delimiter:        m.Delimiter,
+		SkipRows:         m.SkipRows,
+		HasHeader:        m.HasHeader,
+		DateColumn:       m.DateColumn,
+		DateFormat:       m.DateFormat,
+		AmountMode:       domain.CSVAmountMode(m.AmountMode),
+		AmountColumn:     m.AmountColumn,
+		DebitColumn:      m.DebitColumn,
+		CreditColumn:     m.CreditColumn,
+		DecimalSeparator: m.DecimalSeparator,
+		PayeeColumn:      m.PayeeColumn,
+		MemoColumn:       m.MemoColumn,
+		ReferenceColumn:  m.ReferenceColumn,
+		AuditFields:      ToDomainAuditFields(m.AuditFields),
+	}
+}
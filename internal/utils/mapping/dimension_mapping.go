@@ -0,0 +1,57 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelDimension converts a domain Dimension to a model Dimension
+func ToModelDimension(d domain.Dimension) models.Dimension {
+	return models.Dimension{
+		DimensionID: d.DimensionID,
+		WorkplaceID: d.WorkplaceID,
+		Code:        d.Code,
+		Name:        d.Name,
+		Description: d.Description,
+		IsActive:    d.IsActive,
+		AuditFields: ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainDimension converts a model Dimension to a domain Dimension without values
+func ToDomainDimension(m models.Dimension) domain.Dimension {
+	return domain.Dimension{
+		DimensionID: m.DimensionID,
+		WorkplaceID: m.WorkplaceID,
+		Code:        m.Code,
+		Name:        m.Name,
+		Description: m.Description,
+		IsActive:    m.IsActive,
+		Values:      []domain.DimensionValue{},
+		AuditFields: ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelDimensionValue converts a domain DimensionValue to a model DimensionValue
+func ToModelDimensionValue(d domain.DimensionValue) models.DimensionValue {
+	return models.DimensionValue{
+		ValueID:     d.ValueID,
+		DimensionID: d.DimensionID,
+		Code:        d.Code,
+		Name:        d.Name,
+		IsActive:    d.IsActive,
+		AuditFields: ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainDimensionValue converts a model DimensionValue to a domain DimensionValue
+func ToDomainDimensionValue(m models.DimensionValue) domain.DimensionValue {
+	return domain.DimensionValue{
+		ValueID:     m.ValueID,
+		DimensionID: m.DimensionID,
+		Code:        m.Code,
+		Name:        m.Name,
+		IsActive:    m.IsActive,
+		AuditFields: ToDomainAuditFields(m.AuditFields),
+	}
+}
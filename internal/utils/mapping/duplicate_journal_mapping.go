@@ -0,0 +1,49 @@
+package mapping
+
+import (
+	"database/sql"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelDuplicateJournalPair converts a domain DuplicateJournalPair to a model DuplicateJournalPair
+func ToModelDuplicateJournalPair(d domain.DuplicateJournalPair) models.DuplicateJournalPair {
+	m := models.DuplicateJournalPair{
+		PairID:               d.PairID,
+		WorkplaceID:          d.WorkplaceID,
+		JournalID:            d.JournalID,
+		DuplicateOfJournalID: d.DuplicateOfJournalID,
+		Similarity:           d.Similarity,
+		Status:               string(d.Status),
+		ReversedJournalID:    sql.NullString{String: d.ReversedJournalID, Valid: d.ReversedJournalID != ""},
+		ResolutionNote:       sql.NullString{String: d.ResolutionNote, Valid: d.ResolutionNote != ""},
+		ResolvedBy:           sql.NullString{String: d.ResolvedBy, Valid: d.ResolvedBy != ""},
+		AuditFields:          ToModelAuditFields(d.AuditFields),
+	}
+	if d.ResolvedAt != nil {
+		m.ResolvedAt = sql.NullTime{Time: *d.ResolvedAt, Valid: true}
+	}
+	return m
+}
+
+// ToDomainDuplicateJournalPair converts a model DuplicateJournalPair to a domain DuplicateJournalPair
+func ToDomainDuplicateJournalPair(m models.DuplicateJournalPair) domain.DuplicateJournalPair {
+	d := domain.DuplicateJournalPair{
+		PairID:               m.PairID,
+		WorkplaceID:          m.WorkplaceID,
+		JournalID:            m.JournalID,
+		DuplicateOfJournalID: m.DuplicateOfJournalID,
+		Similarity:           m.Similarity,
+		Status:               domain.DuplicatePairStatus(m.Status),
+		ReversedJournalID:    m.ReversedJournalID.String,
+		ResolutionNote:       m.ResolutionNote.String,
+		ResolvedBy:           m.ResolvedBy.String,
+		AuditFields:          ToDomainAuditFields(m.AuditFields),
+	}
+	if m.ResolvedAt.Valid {
+		resolvedAt := m.ResolvedAt.Time
+		d.ResolvedAt = &resolvedAt
+	}
+	return d
+}
@@ -0,0 +1,52 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelEnvelopeSettings converts domain EnvelopeSettings to model EnvelopeSettings
+func ToModelEnvelopeSettings(d domain.EnvelopeSettings) models.EnvelopeSettings {
+	return models.EnvelopeSettings{
+		WorkplaceID: d.WorkplaceID,
+		StartMonth:  d.StartMonth,
+		AuditFields: ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainEnvelopeSettings converts model EnvelopeSettings to domain EnvelopeSettings
+func ToDomainEnvelopeSettings(m models.EnvelopeSettings) domain.EnvelopeSettings {
+	return domain.EnvelopeSettings{
+		WorkplaceID: m.WorkplaceID,
+		StartMonth:  m.StartMonth,
+		AuditFields: ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelEnvelopeAllocation converts a domain EnvelopeAllocation to a model EnvelopeAllocation
+func ToModelEnvelopeAllocation(d domain.EnvelopeAllocation) models.EnvelopeAllocation {
+	return models.EnvelopeAllocation{
+		AllocationID: d.AllocationID,
+		WorkplaceID:  d.WorkplaceID,
+		AccountID:    d.AccountID,
+		Month:        d.Month,
+		Amount:       d.Amount,
+		TransferID:   d.TransferID,
+		Note:         d.Note,
+		AuditFields:  ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainEnvelopeAllocation converts a model EnvelopeAllocation to a domain EnvelopeAllocation
+func ToDomainEnvelopeAllocation(m models.EnvelopeAllocation) domain.EnvelopeAllocation {
+	return domain.EnvelopeAllocation{
+		AllocationID: m.AllocationID,
+		WorkplaceID:  m.WorkplaceID,
+		AccountID:    m.AccountID,
+		Month:        m.Month,
+		Amount:       m.Amount,
+		TransferID:   m.TransferID,
+		Note:         m.Note,
+		AuditFields:  ToDomainAuditFields(m.AuditFields),
+	}
+}
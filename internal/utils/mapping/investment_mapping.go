@@ -0,0 +1,36 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelSecurity converts a domain Security to a model Security
+func ToModelSecurity(d domain.Security) models.Security {
+	return models.Security{
+		SecurityID:   d.SecurityID,
+		WorkplaceID:  d.WorkplaceID,
+		Symbol:       d.Symbol,
+		Name:         d.Name,
+		SecurityType: string(d.SecurityType),
+		CurrencyCode: d.CurrencyCode,
+		CostMethod:   string(d.CostMethod),
+		IsActive:     d.IsActive,
+		AuditFields:  ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainSecurity converts a model Security to a domain Security
+func ToDomainSecurity(m models.Security) domain.Security {
+	return domain.Security{
+		SecurityID:   m.SecurityID,
+		WorkplaceID:  m.WorkplaceID,
+		Symbol:       m.Symbol,
+		Name:         m.Name,
+		SecurityType: domain.SecurityType(m.SecurityType),
+		CurrencyCode: m.CurrencyCode,
+		CostMethod:   domain.CostMethod(m.CostMethod),
+		IsActive:     m.IsActive,
+		AuditFields:  ToDomainAuditFields(m.AuditFields),
+	}
+}
@@ -0,0 +1,130 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelInvoice converts a domain Invoice to a model Invoice
+func ToModelInvoice(d domain.Invoice) models.Invoice {
+	return models.Invoice{
+		InvoiceID:           d.InvoiceID,
+		WorkplaceID:         d.WorkplaceID,
+		CustomerID:          d.CustomerID,
+		ReceivableAccountID: d.ReceivableAccountID,
+		InvoiceNumber:       d.InvoiceNumber,
+		IssueDate:           d.IssueDate,
+		DueDate:             d.DueDate,
+		CurrencyCode:        d.CurrencyCode,
+		Notes:               d.Notes,
+		Subtotal:            d.Subtotal,
+		TaxTotal:            d.TaxTotal,
+		Total:               d.Total,
+		IssueJournalID:      d.IssueJournalID,
+		AuditFields:         ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainInvoice converts a model Invoice to a domain Invoice without lines or payments
+func ToDomainInvoice(m models.Invoice) domain.Invoice {
+	return domain.Invoice{
+		InvoiceID:           m.InvoiceID,
+		WorkplaceID:         m.WorkplaceID,
+		CustomerID:          m.CustomerID,
+		ReceivableAccountID: m.ReceivableAccountID,
+		InvoiceNumber:       m.InvoiceNumber,
+		IssueDate:           m.IssueDate,
+		DueDate:             m.DueDate,
+		CurrencyCode:        m.CurrencyCode,
+		Notes:               m.Notes,
+		Subtotal:            m.Subtotal,
+		TaxTotal:            m.TaxTotal,
+		Total:               m.Total,
+		IssueJournalID:      m.IssueJournalID,
+		AuditFields:         ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelInvoiceLine converts a domain InvoiceLine to a model InvoiceLine
+func ToModelInvoiceLine(d domain.InvoiceLine) models.InvoiceLine {
+	return models.InvoiceLine{
+		LineID:           d.LineID,
+		InvoiceID:        d.InvoiceID,
+		LineNumber:       d.LineNumber,
+		Description:      d.Description,
+		Quantity:         d.Quantity,
+		UnitPrice:        d.UnitPrice,
+		RevenueAccountID: d.RevenueAccountID,
+		TaxCodeID:        d.TaxCodeID,
+		Amount:           d.Amount,
+		NetAmount:        d.NetAmount,
+		TaxAmount:        d.TaxAmount,
+	}
+}
+
+// ToDomainInvoiceLine converts a model InvoiceLine to a domain InvoiceLine
+func ToDomainInvoiceLine(m models.InvoiceLine) domain.InvoiceLine {
+	return domain.InvoiceLine{
+		LineID:           m.LineID,
+		InvoiceID:        m.InvoiceID,
+		LineNumber:       m.LineNumber,
+		Description:      m.Description,
+		Quantity:         m.Quantity,
+		UnitPrice:        m.UnitPrice,
+		RevenueAccountID: m.RevenueAccountID,
+		TaxCodeID:        m.TaxCodeID,
+		Amount:           m.Amount,
+		NetAmount:        m.NetAmount,
+		TaxAmount:        m.TaxAmount,
+	}
+}
+
+// ToModelInvoicePayment converts a domain InvoicePayment to a model InvoicePayment
+func ToModelInvoicePayment(d domain.InvoicePayment) models.InvoicePayment {
+	return models.InvoicePayment{
+		PaymentID:   d.PaymentID,
+		InvoiceID:   d.InvoiceID,
+		JournalID:   d.JournalID,
+		PaymentDate: d.PaymentDate,
+		Amount:      d.Amount,
+		CreatedAt:   d.CreatedAt,
+		CreatedBy:   d.CreatedBy,
+	}
+}
+
+// ToDomainInvoicePayment converts a model InvoicePayment to a domain InvoicePayment
+func ToDomainInvoicePayment(m models.InvoicePayment) domain.InvoicePayment {
+	return domain.InvoicePayment{
+		PaymentID:   m.PaymentID,
+		InvoiceID:   m.InvoiceID,
+		JournalID:   m.JournalID,
+		PaymentDate: m.PaymentDate,
+		Amount:      m.Amount,
+		CreatedAt:   m.CreatedAt,
+		CreatedBy:   m.CreatedBy,
+	}
+}
+
+// ToModelInvoiceSequence converts a domain InvoiceSequence to a model InvoiceSequence
+func ToModelInvoiceSequence(d domain.InvoiceSequence) models.InvoiceSequence {
+	return models.InvoiceSequence{
+		WorkplaceID:   d.WorkplaceID,
+		Prefix:        d.Prefix,
+		NextNumber:    d.NextNumber,
+		Padding:       d.Padding,
+		LastUpdatedAt: d.LastUpdatedAt,
+		LastUpdatedBy: d.LastUpdatedBy,
+	}
+}
+
+// ToDomainInvoiceSequence converts a model InvoiceSequence to a domain InvoiceSequence
+func ToDomainInvoiceSequence(m models.InvoiceSequence) domain.InvoiceSequence {
+	return domain.InvoiceSequence{
+		WorkplaceID:   m.WorkplaceID,
+		Prefix:        m.Prefix,
+		NextNumber:    m.NextNumber,
+		Padding:       m.Padding,
+		LastUpdatedAt: m.LastUpdatedAt,
+		LastUpdatedBy: m.LastUpdatedBy,
+	}
+}
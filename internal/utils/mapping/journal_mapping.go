@@ -7,7 +7,7 @@ import (
 
 // ToModelJournal converts a domain Journal to a model Journal
 func ToModelJournal(d domain.Journal) models.Journal {
-	return models.Journal{
+	m := models.Journal{
 		JournalID:          d.JournalID,
 		WorkplaceID:        d.WorkplaceID,
 		JournalDate:        d.JournalDate,
@@ -17,13 +17,18 @@ func ToModelJournal(d domain.Journal) models.Journal {
 		OriginalJournalID:  d.OriginalJournalID,
 		ReversingJournalID: d.ReversingJournalID,
 		Amount:             d.Amount,
+		PayeeID:            d.PayeeID,
 		AuditFields:        ToModelAuditFields(d.AuditFields),
 	}
+	if d.Twin != nil {
+		m.TwinJournalID = &d.Twin.JournalID
+	}
+	return m
 }
 
 // ToDomainJournal converts a model Journal to a domain Journal
 func ToDomainJournal(m models.Journal) domain.Journal {
-	return domain.Journal{
+	d := domain.Journal{
 		JournalID:          m.JournalID,
 		WorkplaceID:        m.WorkplaceID,
 		JournalDate:        m.JournalDate,
@@ -33,8 +38,13 @@ func ToDomainJournal(m models.Journal) domain.Journal {
 		OriginalJournalID:  m.OriginalJournalID,
 		ReversingJournalID: m.ReversingJournalID,
 		Amount:             m.Amount,
+		PayeeID:            m.PayeeID,
 		AuditFields:        ToDomainAuditFields(m.AuditFields),
 	}
+	if m.TwinJournalID != nil {
+		d.Twin = &domain.JournalTwin{JournalID: *m.TwinJournalID}
+	}
+	return d
 }
 
 // ToModelTransaction converts a domain Transaction to a model Transaction
@@ -48,6 +58,14 @@ func ToModelTransaction(d domain.Transaction) models.Transaction {
 		CurrencyCode:       d.CurrencyCode,
 		Notes:              d.Notes,
 		TransactionDate:    d.TransactionDate,
+		ClearingStatus:     string(d.ClearingStatus),
+		ReconciliationID:   d.ReconciliationID,
+		PayeeID:            d.PayeeID,
+		SecurityID:         d.SecurityID,
+		Quantity:           d.Quantity,
+		UnitPrice:          d.UnitPrice,
+		TaxCodeID:          d.TaxCodeID,
+		TaxRole:            string(d.TaxRole),
 		AuditFields:        ToModelAuditFields(d.AuditFields),
 		RunningBalance:     d.RunningBalance,
 		JournalDate:        d.JournalDate,
@@ -66,6 +84,14 @@ func ToDomainTransaction(m models.Transaction) domain.Transaction {
 		CurrencyCode:       m.CurrencyCode,
 		Notes:              m.Notes,
 		TransactionDate:    m.TransactionDate,
+		ClearingStatus:     domain.ClearingStatus(m.ClearingStatus),
+		ReconciliationID:   m.ReconciliationID,
+		PayeeID:            m.PayeeID,
+		SecurityID:         m.SecurityID,
+		Quantity:           m.Quantity,
+		UnitPrice:          m.UnitPrice,
+		TaxCodeID:          m.TaxCodeID,
+		TaxRole:            domain.TaxRole(m.TaxRole),
 		AuditFields:        ToDomainAuditFields(m.AuditFields),
 		RunningBalance:     m.RunningBalance,
 		JournalDate:        m.JournalDate,
@@ -0,0 +1,76 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelLoan converts a domain Loan to a model Loan
+func ToModelLoan(d domain.Loan) models.Loan {
+	return models.Loan{
+		LoanID:                d.LoanID,
+		WorkplaceID:           d.WorkplaceID,
+		AccountID:             d.AccountID,
+		InterestAccountID:     d.InterestAccountID,
+		Name:                  d.Name,
+		Principal:             d.Principal,
+		AnnualRate:            d.AnnualRate,
+		Term:                  d.Term,
+		Frequency:             string(d.Frequency),
+		StartDate:             d.StartDate,
+		DisbursementJournalID: d.DisbursementJournalID,
+		AuditFields:           ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainLoan converts a model Loan to a domain Loan
+func ToDomainLoan(m models.Loan) domain.Loan {
+	return domain.Loan{
+		LoanID:                m.LoanID,
+		WorkplaceID:           m.WorkplaceID,
+		AccountID:             m.AccountID,
+		InterestAccountID:     m.InterestAccountID,
+		Name:                  m.Name,
+		Principal:             m.Principal,
+		AnnualRate:            m.AnnualRate,
+		Term:                  m.Term,
+		Frequency:             domain.LoanFrequency(m.Frequency),
+		StartDate:             m.StartDate,
+		DisbursementJournalID: m.DisbursementJournalID,
+		AuditFields:           ToDomainAuditFields(m.AuditFields),
+	}
+}
+
+// ToModelLoanPayment converts a domain LoanPayment to a model LoanPayment
+func ToModelLoanPayment(d domain.LoanPayment) models.LoanPayment {
+	return models.LoanPayment{
+		PaymentID:         d.PaymentID,
+		LoanID:            d.LoanID,
+		JournalID:         d.JournalID,
+		PaymentType:       string(d.PaymentType),
+		InstallmentNumber: d.InstallmentNumber,
+		PrepaymentMode:    string(d.PrepaymentMode),
+		PaymentDate:       d.PaymentDate,
+		Principal:         d.Principal,
+		Interest:          d.Interest,
+		CreatedAt:         d.CreatedAt,
+		CreatedBy:         d.CreatedBy,
+	}
+}
+
+// ToDomainLoanPayment converts a model LoanPayment to a domain LoanPayment
+func ToDomainLoanPayment(m models.LoanPayment) domain.LoanPayment {
+	return domain.LoanPayment{
+		PaymentID:         m.PaymentID,
+		LoanID:            m.LoanID,
+		JournalID:         m.JournalID,
+		PaymentType:       domain.LoanPaymentType(m.PaymentType),
+		InstallmentNumber: m.InstallmentNumber,
+		PrepaymentMode:    domain.PrepaymentMode(m.PrepaymentMode),
+		PaymentDate:       m.PaymentDate,
+		Principal:         m.Principal,
+		Interest:          m.Interest,
+		CreatedAt:         m.CreatedAt,
+		CreatedBy:         m.CreatedBy,
+	}
+}
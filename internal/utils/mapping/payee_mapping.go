@@ -0,0 +1,34 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelPayee converts a domain Payee to a model Payee; aliases are stored separately
+func ToModelPayee(d domain.Payee) models.Payee {
+	return models.Payee{
+		PayeeID:                 d.PayeeID,
+		WorkplaceID:             d.WorkplaceID,
+		Name:                    d.Name,
+		DefaultCounterAccountID: d.DefaultCounterAccountID,
+		IsActive:                d.IsActive,
+		AuditFields:             ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainPayee converts a model Payee and its aliases to a domain Payee
+func ToDomainPayee(m models.Payee, aliases []string) domain.Payee {
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return domain.Payee{
+		PayeeID:                 m.PayeeID,
+		WorkplaceID:             m.WorkplaceID,
+		Name:                    m.Name,
+		Aliases:                 aliases,
+		DefaultCounterAccountID: m.DefaultCounterAccountID,
+		IsActive:                m.IsActive,
+		AuditFields:             ToDomainAuditFields(m.AuditFields),
+	}
+}
@@ -0,0 +1,32 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelCommodityPrice converts a domain CommodityPrice to a model CommodityPrice
+func ToModelCommodityPrice(d domain.CommodityPrice) models.CommodityPrice {
+	return models.CommodityPrice{
+		Commodity:     d.Commodity,
+		QuoteCurrency: d.QuoteCurrency,
+		PriceDate:     d.PriceDate,
+		Price:         d.Price,
+		Source:        d.Source,
+		CreatedAt:     d.CreatedAt,
+		CreatedBy:     d.CreatedBy,
+	}
+}
+
+// ToDomainCommodityPrice converts a model CommodityPrice to a domain CommodityPrice
+func ToDomainCommodityPrice(m models.CommodityPrice) domain.CommodityPrice {
+	return domain.CommodityPrice{
+		Commodity:     m.Commodity,
+		QuoteCurrency: m.QuoteCurrency,
+		PriceDate:     m.PriceDate,
+		Price:         m.Price,
+		Source:        m.Source,
+		CreatedAt:     m.CreatedAt,
+		CreatedBy:     m.CreatedBy,
+	}
+}
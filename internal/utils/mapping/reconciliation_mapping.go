@@ -0,0 +1,45 @@
+package mapping
+
+import (
+	"database/sql"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelReconciliation converts a domain Reconciliation to a model Reconciliation
+func ToModelReconciliation(d domain.Reconciliation) models.Reconciliation {
+	m := models.Reconciliation{
+		ReconciliationID: d.ReconciliationID,
+		WorkplaceID:      d.WorkplaceID,
+		AccountID:        d.AccountID,
+		StatementDate:    d.StatementDate,
+		EndingBalance:    d.EndingBalance,
+		Status:           string(d.Status),
+		CompletedBy:      sql.NullString{String: d.CompletedBy, Valid: d.CompletedBy != ""},
+		AuditFields:      ToModelAuditFields(d.AuditFields),
+	}
+	if d.CompletedAt != nil {
+		m.CompletedAt = sql.NullTime{Time: *d.CompletedAt, Valid: true}
+	}
+	return m
+}
+
+// ToDomainReconciliation converts a model Reconciliation to a domain Reconciliation
+func ToDomainReconciliation(m models.Reconciliation) domain.Reconciliation {
+	d := domain.Reconciliation{
+		ReconciliationID: m.ReconciliationID,
+		WorkplaceID:      m.WorkplaceID,
+		AccountID:        m.AccountID,
+		StatementDate:    m.StatementDate,
+		EndingBalance:    m.EndingBalance,
+		Status:           domain.ReconciliationStatus(m.Status),
+		CompletedBy:      m.CompletedBy.String,
+		AuditFields:      ToDomainAuditFields(m.AuditFields),
+	}
+	if m.CompletedAt.Valid {
+		completedAt := m.CompletedAt.Time
+		d.CompletedAt = &completedAt
+	}
+	return d
+}
@@ -0,0 +1,38 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelSavingsGoal converts a domain SavingsGoal to a model SavingsGoal (linked accounts are stored separately)
+func ToModelSavingsGoal(d domain.SavingsGoal) models.SavingsGoal {
+	return models.SavingsGoal{
+		GoalID:       d.GoalID,
+		WorkplaceID:  d.WorkplaceID,
+		Name:         d.Name,
+		Description:  d.Description,
+		TargetAmount: d.TargetAmount,
+		TargetDate:   d.TargetDate,
+		CurrencyCode: d.CurrencyCode,
+		AuditFields:  ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainSavingsGoal converts a model SavingsGoal and its linked account IDs to a domain SavingsGoal
+func ToDomainSavingsGoal(m models.SavingsGoal, accountIDs []string) domain.SavingsGoal {
+	if accountIDs == nil {
+		accountIDs = []string{}
+	}
+	return domain.SavingsGoal{
+		GoalID:       m.GoalID,
+		WorkplaceID:  m.WorkplaceID,
+		Name:         m.Name,
+		Description:  m.Description,
+		TargetAmount: m.TargetAmount,
+		TargetDate:   m.TargetDate,
+		CurrencyCode: m.CurrencyCode,
+		AccountIDs:   accountIDs,
+		AuditFields:  ToDomainAuditFields(m.AuditFields),
+	}
+}
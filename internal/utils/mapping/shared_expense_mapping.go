@@ -0,0 +1,84 @@
+package mapping
+
+import (
+	"database/sql"
+
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// ToModelMemberAccounts converts domain MemberAccounts to model MemberAccounts
+func ToModelMemberAccounts(d domain.MemberAccounts) models.MemberAccounts {
+	return models.MemberAccounts{
+		WorkplaceID:         d.WorkplaceID,
+		UserID:              sql.NullString{String: d.UserID, Valid: d.UserID != ""},
+		CurrencyCode:        d.CurrencyCode,
+		ReceivableAccountID: d.ReceivableAccountID,
+		PayableAccountID:    d.PayableAccountID,
+		CreatedAt:           d.CreatedAt,
+		CreatedBy:           d.CreatedBy,
+	}
+}
+
+// ToDomainMemberAccounts converts model MemberAccounts to domain MemberAccounts
+func ToDomainMemberAccounts(m models.MemberAccounts) domain.MemberAccounts {
+	return domain.MemberAccounts{
+		WorkplaceID:         m.WorkplaceID,
+		UserID:              m.UserID.String,
+		CurrencyCode:        m.CurrencyCode,
+		ReceivableAccountID: m.ReceivableAccountID,
+		PayableAccountID:    m.PayableAccountID,
+		CreatedAt:           m.CreatedAt,
+		CreatedBy:           m.CreatedBy,
+	}
+}
+
+// ToModelSharedExpense converts a domain SharedExpense to a model SharedExpense and its share rows
+func ToModelSharedExpense(d domain.SharedExpense) (models.SharedExpense, []models.SharedExpenseShare) {
+	m := models.SharedExpense{
+		SharedExpenseID: d.SharedExpenseID,
+		WorkplaceID:     d.WorkplaceID,
+		JournalID:       d.JournalID,
+		PaidByUserID:    d.PaidByUserID,
+		SplitMethod:     string(d.SplitMethod),
+		CurrencyCode:    d.CurrencyCode,
+		TotalAmount:     d.TotalAmount,
+		ShareJournalID:  d.ShareJournalID,
+		AuditFields:     ToModelAuditFields(d.AuditFields),
+	}
+	shares := make([]models.SharedExpenseShare, 0, len(d.Shares))
+	for _, share := range d.Shares {
+		shares = append(shares, models.SharedExpenseShare{
+			SharedExpenseID: d.SharedExpenseID,
+			UserID:          share.UserID,
+			ShareValue:      decimal.NullDecimal{Decimal: share.Value, Valid: d.SplitMethod != domain.SplitEqual},
+			Amount:          share.Amount,
+		})
+	}
+	return m, shares
+}
+
+// ToDomainSharedExpense converts a model SharedExpense and its share rows to a domain SharedExpense
+func ToDomainSharedExpense(m models.SharedExpense, shares []models.SharedExpenseShare) domain.SharedExpense {
+	d := domain.SharedExpense{
+		SharedExpenseID: m.SharedExpenseID,
+		WorkplaceID:     m.WorkplaceID,
+		JournalID:       m.JournalID,
+		PaidByUserID:    m.PaidByUserID,
+		SplitMethod:     domain.SplitMethod(m.SplitMethod),
+		CurrencyCode:    m.CurrencyCode,
+		TotalAmount:     m.TotalAmount,
+		ShareJournalID:  m.ShareJournalID,
+		Shares:          make([]domain.ExpenseShare, 0, len(shares)),
+		AuditFields:     ToDomainAuditFields(m.AuditFields),
+	}
+	for _, share := range shares {
+		d.Shares = append(d.Shares, domain.ExpenseShare{
+			UserID: share.UserID,
+			Value:  share.ShareValue.Decimal,
+			Amount: share.Amount,
+		})
+	}
+	return d
+}
@@ -0,0 +1,36 @@
+package mapping
+
+import (
+	"github.com/SscSPs/money_managemet_app/internal/core/domain"
+	"github.com/SscSPs/money_managemet_app/internal/models"
+)
+
+// ToModelTaxCode converts a domain TaxCode to a model TaxCode
+func ToModelTaxCode(d domain.TaxCode) models.TaxCode {
+	return models.TaxCode{
+		TaxCodeID:    d.TaxCodeID,
+		WorkplaceID:  d.WorkplaceID,
+		Code:         d.Code,
+		Name:         d.Name,
+		Rate:         d.Rate,
+		TaxAccountID: d.TaxAccountID,
+		Inclusive:    d.Inclusive,
+		IsActive:     d.IsActive,
+		AuditFields:  ToModelAuditFields(d.AuditFields),
+	}
+}
+
+// ToDomainTaxCode converts a model TaxCode to a domain TaxCode
+func ToDomainTaxCode(m models.TaxCode) domain.TaxCode {
+	return domain.TaxCode{
+		TaxCodeID:    m.TaxCodeID,
+		WorkplaceID:  m.WorkplaceID,
+		Code:         m.Code,
+		Name:         m.Name,
+		Rate:         m.Rate,
+		TaxAccountID: m.TaxAccountID,
+		Inclusive:    m.Inclusive,
+		IsActive:     m.IsActive,
+		AuditFields:  ToDomainAuditFields(m.AuditFields),
+	}
+}
@@ -0,0 +1,145 @@
+// Package pricecsv reads commodity price files: CSV with a header naming the commodity, quote currency,
+// date and price columns.
+package pricecsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DateLayout is the layout of the date column
+const DateLayout = "2006-01-02"
+
+// ErrInvalidFile is returned when a file has no usable header
+var ErrInvalidFile = errors.New("invalid price file")
+
+// Row is one data row of a price file: the parsed price, or the reason it could not be read
+type Row struct {
+	RowNumber     int // 1-based line number in the file, counting the header
+	Commodity     string
+	QuoteCurrency string
+	Date          time.Time
+	Price         decimal.Decimal
+	Err           error
+}
+
+// headerAliases maps accepted header names to the column they identify
+var headerAliases = map[string]string{
+	"commodity":      "commodity",
+	"symbol":         "commodity",
+	"quote_currency": "quote_currency",
+	"quotecurrency":  "quote_currency",
+	"currency":       "quote_currency",
+	"date":           "date",
+	"price_date":     "date",
+	"price":          "price",
+}
+
+// Parse reads a price file. The header is matched case-insensitively and columns may come in any order;
+// rows that cannot be read are returned with Err set rather than failing the whole file.
+func Parse(data []byte) ([]Row, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: file is empty", ErrInvalidFile)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		if column, ok := headerAliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+			if _, seen := columns[column]; !seen {
+				columns[column] = i
+			}
+		}
+	}
+	for _, column := range []string{"commodity", "quote_currency", "date", "price"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: missing %s column", ErrInvalidFile, column)
+		}
+	}
+
+	rows := []Row{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+			}
+			rows = append(rows, Row{RowNumber: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		rowNumber, _ := reader.FieldPos(0)
+		if isBlank(record) {
+			continue
+		}
+		rows = append(rows, parseRecord(rowNumber, record, columns))
+	}
+	return rows, nil
+}
+
+// parseRecord reads one data record
+func parseRecord(rowNumber int, record []string, columns map[string]int) Row {
+	row := Row{RowNumber: rowNumber}
+	field := func(column string) string {
+		if i := columns[column]; i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	row.Commodity = strings.ToUpper(field("commodity"))
+	row.QuoteCurrency = strings.ToUpper(field("quote_currency"))
+	if row.Commodity == "" {
+		row.Err = errors.New("commodity is required")
+		return row
+	}
+	if row.QuoteCurrency == "" {
+		row.Err = errors.New("quote currency is required")
+		return row
+	}
+
+	date, err := time.Parse(DateLayout, field("date"))
+	if err != nil {
+		row.Err = fmt.Errorf("invalid date %q, expected YYYY-MM-DD", field("date"))
+		return row
+	}
+	row.Date = date
+
+	price, err := decimal.NewFromString(field("price"))
+	if err != nil {
+		row.Err = fmt.Errorf("invalid price %q", field("price"))
+		return row
+	}
+	if !price.IsPositive() {
+		row.Err = errors.New("price must be positive")
+		return row
+	}
+	row.Price = price
+	return row
+}
+
+// isBlank reports whether every field of a record is empty
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package pricecsv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	data := "\xef\xbb\xbfDate,Symbol,Price,Currency\n" +
+		"2025-03-03, aapl ,172.50,usd\n" +
+		"\n" +
+		"03/04/2025,AAPL,173,USD\n" +
+		"2025-03-05,BTC,-1,USD\n" +
+		"2025-03-05,BTC,abc,USD\n" +
+		"2025-03-05,,1,USD\n"
+
+	rows, err := Parse([]byte(data))
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	assert.NoError(t, rows[0].Err)
+	assert.Equal(t, 2, rows[0].RowNumber)
+	assert.Equal(t, "AAPL", rows[0].Commodity)
+	assert.Equal(t, "USD", rows[0].QuoteCurrency)
+	assert.Equal(t, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.True(t, decimal.RequireFromString("172.50").Equal(rows[0].Price))
+
+	assert.Equal(t, 4, rows[1].RowNumber, "blank rows are skipped but still counted")
+	assert.ErrorContains(t, rows[1].Err, "invalid date")
+	assert.ErrorContains(t, rows[2].Err, "must be positive")
+	assert.ErrorContains(t, rows[3].Err, "invalid price")
+	assert.ErrorContains(t, rows[4].Err, "commodity is required")
+}
+
+func TestParseRequiresHeader(t *testing.T) {
+	_, err := Parse([]byte("commodity,date,price\nAAPL,2025-03-03,1\n"))
+	assert.ErrorIs(t, err, ErrInvalidFile)
+	assert.ErrorContains(t, err, "quote_currency")
+
+	_, err = Parse(nil)
+	assert.ErrorIs(t, err, ErrInvalidFile)
+}
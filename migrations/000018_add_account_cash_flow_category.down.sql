@@ -0,0 +1,3 @@
+-- Remove the cash_flow_category column
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS cash_flow_category;
@@ -0,0 +1,7 @@
+-- Add cash_flow_category column to accounts table
+ALTER TABLE accounts
+    ADD COLUMN cash_flow_category VARCHAR(50)
+    CHECK (cash_flow_category IN ('CASH', 'OPERATING', 'INVESTING', 'FINANCING'));
+
+-- Add a comment to explain the column
+COMMENT ON COLUMN accounts.cash_flow_category IS 'Classification used by the cash flow statement. CASH marks cash and cash-equivalent ASSET accounts; NULL falls back to OPERATING for assets/liabilities and FINANCING for equity.';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS budget_lines;
+DROP TRIGGER IF EXISTS trigger_budgets_update_last_updated_at ON budgets;
+DROP INDEX IF EXISTS idx_budgets_workplace_id;
+DROP TABLE IF EXISTS budgets;
@@ -0,0 +1,31 @@
+-- Budgets hold planned revenue and expense amounts per account for one year
+CREATE TABLE IF NOT EXISTS budgets (
+    budget_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    period_type VARCHAR(10) NOT NULL CHECK (period_type IN ('MONTHLY', 'YEARLY')),
+    budget_year INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budgets_workplace_id ON budgets(workplace_id);
+
+CREATE TRIGGER trigger_budgets_update_last_updated_at
+BEFORE UPDATE ON budgets
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Budget lines hold the amount of one account (or parent account) for one period of a budget
+CREATE TABLE IF NOT EXISTS budget_lines (
+    budget_id VARCHAR(255) NOT NULL REFERENCES budgets(budget_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    amount NUMERIC(57, 18) NOT NULL CHECK (amount >= 0),
+    PRIMARY KEY (budget_id, account_id, period_start)
+);
+
+COMMENT ON TABLE budgets IS 'Planned revenue and expense amounts per workplace and year.';
+COMMENT ON COLUMN budget_lines.period_start IS 'First day of the budgeted month (MONTHLY) or year (YEARLY).';
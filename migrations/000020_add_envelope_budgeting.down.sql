@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_envelope_allocations_workplace_month;
+DROP TABLE IF EXISTS envelope_allocations;
+DROP TRIGGER IF EXISTS trigger_envelope_settings_update_last_updated_at ON envelope_settings;
+DROP TABLE IF EXISTS envelope_settings;
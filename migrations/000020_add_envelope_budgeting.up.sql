@@ -0,0 +1,35 @@
+-- Envelope settings enable envelope budgeting for a workplace from a start month
+CREATE TABLE IF NOT EXISTS envelope_settings (
+    workplace_id VARCHAR(255) PRIMARY KEY REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    start_month DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE TRIGGER trigger_envelope_settings_update_last_updated_at
+BEFORE UPDATE ON envelope_settings
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Envelope allocations record money assigned to (or taken from) an expense envelope for a month.
+-- Allocations are append-only; moving money between envelopes writes two rows sharing a transfer_id.
+CREATE TABLE IF NOT EXISTS envelope_allocations (
+    allocation_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    allocation_month DATE NOT NULL,
+    amount NUMERIC(57, 18) NOT NULL CHECK (amount <> 0),
+    transfer_id VARCHAR(255),
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_envelope_allocations_workplace_month ON envelope_allocations(workplace_id, allocation_month);
+
+COMMENT ON TABLE envelope_allocations IS 'Money assigned to expense envelopes per month; envelope balances are derived from these rows and posted journals.';
+COMMENT ON COLUMN envelope_allocations.allocation_month IS 'First day of the month the money is assigned to.';
+COMMENT ON COLUMN envelope_allocations.transfer_id IS 'Shared by the two rows of a move between envelopes.';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS savings_goal_accounts;
+DROP TRIGGER IF EXISTS trigger_savings_goals_update_last_updated_at ON savings_goals;
+DROP INDEX IF EXISTS idx_savings_goals_workplace_id;
+DROP TABLE IF EXISTS savings_goals;
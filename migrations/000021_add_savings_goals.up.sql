@@ -0,0 +1,29 @@
+-- Savings goals track progress of one or more ASSET accounts towards a target amount and date
+CREATE TABLE IF NOT EXISTS savings_goals (
+    goal_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    target_amount NUMERIC(57, 18) NOT NULL CHECK (target_amount > 0),
+    target_date DATE NOT NULL,
+    currency_code VARCHAR(3) NOT NULL REFERENCES currencies(currency_code),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_savings_goals_workplace_id ON savings_goals(workplace_id);
+
+CREATE TRIGGER trigger_savings_goals_update_last_updated_at
+BEFORE UPDATE ON savings_goals
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Accounts whose balances count towards a goal
+CREATE TABLE IF NOT EXISTS savings_goal_accounts (
+    goal_id VARCHAR(255) NOT NULL REFERENCES savings_goals(goal_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    PRIMARY KEY (goal_id, account_id)
+);
+
+COMMENT ON TABLE savings_goals IS 'Savings targets per workplace; progress is derived from the balances of linked ASSET accounts.';
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trigger_bank_statement_lines_update_last_updated_at ON bank_statement_lines;
+DROP INDEX IF EXISTS idx_bank_statement_lines_account_status;
+DROP TABLE IF EXISTS bank_statement_lines;
+DROP INDEX IF EXISTS idx_bank_statement_imports_account_id;
+DROP TABLE IF EXISTS bank_statement_imports;
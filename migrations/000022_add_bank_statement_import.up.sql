@@ -0,0 +1,46 @@
+-- Bank statement imports record each uploaded statement file
+CREATE TABLE IF NOT EXISTS bank_statement_imports (
+    import_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    file_format VARCHAR(20) NOT NULL,
+    file_name VARCHAR(255),
+    total_lines INTEGER NOT NULL DEFAULT 0,
+    new_lines INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_statement_imports_account_id ON bank_statement_imports(account_id);
+
+-- Bank statement lines are staged until they are turned into journals or ignored
+CREATE TABLE IF NOT EXISTS bank_statement_lines (
+    line_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    import_id VARCHAR(255) NOT NULL REFERENCES bank_statement_imports(import_id) ON DELETE CASCADE,
+    bank_reference VARCHAR(255) NOT NULL,
+    line_date DATE NOT NULL,
+    amount NUMERIC(57, 18) NOT NULL,
+    payee VARCHAR(255),
+    memo TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'POSTED', 'IGNORED')),
+    journal_id VARCHAR(255) REFERENCES journals(journal_id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_bank_statement_lines_reference UNIQUE (account_id, bank_reference)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_statement_lines_account_status ON bank_statement_lines(account_id, status, line_date);
+
+CREATE TRIGGER trigger_bank_statement_lines_update_last_updated_at
+BEFORE UPDATE ON bank_statement_lines
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+COMMENT ON TABLE bank_statement_lines IS 'Staged bank statement lines; re-imports are deduplicated on (account_id, bank_reference).';
+COMMENT ON COLUMN bank_statement_lines.amount IS 'Signed from the account holder''s view: positive is money in, negative is money out.';
+COMMENT ON COLUMN bank_statement_lines.bank_reference IS 'FITID or bank reference; generated from the line content when the file has none.';
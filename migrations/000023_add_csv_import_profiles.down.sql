@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trigger_csv_import_profiles_update_last_updated_at ON csv_import_profiles;
+DROP INDEX IF EXISTS idx_csv_import_profiles_workplace_id;
+DROP TABLE IF EXISTS csv_import_profiles;
@@ -0,0 +1,34 @@
+-- CSV import profiles describe how to read one bank's CSV export for an account
+CREATE TABLE IF NOT EXISTS csv_import_profiles (
+    profile_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    delimiter VARCHAR(1) NOT NULL DEFAULT ',',
+    skip_rows INTEGER NOT NULL DEFAULT 0 CHECK (skip_rows >= 0),
+    has_header BOOLEAN NOT NULL DEFAULT TRUE,
+    date_column INTEGER NOT NULL CHECK (date_column > 0),
+    date_format VARCHAR(50) NOT NULL,
+    amount_mode VARCHAR(20) NOT NULL CHECK (amount_mode IN ('SIGNED', 'INVERTED', 'DEBIT_CREDIT')),
+    amount_column INTEGER NOT NULL DEFAULT 0,
+    debit_column INTEGER NOT NULL DEFAULT 0,
+    credit_column INTEGER NOT NULL DEFAULT 0,
+    decimal_separator VARCHAR(1) NOT NULL DEFAULT '.' CHECK (decimal_separator IN ('.', ',')),
+    payee_column INTEGER NOT NULL DEFAULT 0,
+    memo_column INTEGER NOT NULL DEFAULT 0,
+    reference_column INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_csv_import_profiles_account_name UNIQUE (account_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_csv_import_profiles_workplace_id ON csv_import_profiles(workplace_id);
+
+CREATE TRIGGER trigger_csv_import_profiles_update_last_updated_at
+BEFORE UPDATE ON csv_import_profiles
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+COMMENT ON COLUMN csv_import_profiles.date_column IS '1-based column numbers; 0 means the column is not present.';
+COMMENT ON COLUMN csv_import_profiles.amount_mode IS 'SIGNED: positive is money in; INVERTED: positive is money out; DEBIT_CREDIT: separate columns.';
@@ -0,0 +1,9 @@
+ALTER TABLE bank_statement_lines
+    DROP COLUMN IF EXISTS matched_rule_id,
+    DROP COLUMN IF EXISTS notes,
+    DROP COLUMN IF EXISTS journal_description,
+    DROP COLUMN IF EXISTS counter_account_id;
+
+DROP TRIGGER IF EXISTS trigger_categorization_rules_update_last_updated_at ON categorization_rules;
+DROP INDEX IF EXISTS idx_categorization_rules_workplace_priority;
+DROP TABLE IF EXISTS categorization_rules;
@@ -0,0 +1,40 @@
+-- Categorization rules assign counter-accounts to staged bank statement lines
+CREATE TABLE IF NOT EXISTS categorization_rules (
+    rule_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 100,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    payee_pattern TEXT,
+    description_pattern TEXT,
+    min_amount NUMERIC(57, 18) CHECK (min_amount >= 0),
+    max_amount NUMERIC(57, 18) CHECK (max_amount >= 0),
+    source_account_id VARCHAR(255) REFERENCES accounts(account_id) ON DELETE CASCADE,
+    sign VARCHAR(3) NOT NULL DEFAULT 'ANY' CHECK (sign IN ('ANY', 'IN', 'OUT')),
+    counter_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    journal_description VARCHAR(255),
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT chk_categorization_rules_amount_range CHECK (min_amount IS NULL OR max_amount IS NULL OR min_amount <= max_amount)
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_rules_workplace_priority ON categorization_rules(workplace_id, priority);
+
+CREATE TRIGGER trigger_categorization_rules_update_last_updated_at
+BEFORE UPDATE ON categorization_rules
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+COMMENT ON COLUMN categorization_rules.priority IS 'Rules are applied in ascending priority; the first match wins.';
+COMMENT ON COLUMN categorization_rules.min_amount IS 'Bounds apply to the absolute line amount; use sign to restrict the direction.';
+
+-- Categorization of staged lines: assigned by a rule or recorded when a line is posted manually
+ALTER TABLE bank_statement_lines
+    ADD COLUMN IF NOT EXISTS counter_account_id VARCHAR(255) REFERENCES accounts(account_id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS journal_description VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS notes TEXT,
+    ADD COLUMN IF NOT EXISTS matched_rule_id VARCHAR(255) REFERENCES categorization_rules(rule_id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN bank_statement_lines.matched_rule_id IS 'Rule that assigned the counter-account; NULL when categorized manually.';
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_transactions_reconciliation_id;
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS reconciliation_id,
+    DROP COLUMN IF EXISTS clearing_status;
+DROP TRIGGER IF EXISTS trigger_reconciliations_update_last_updated_at ON reconciliations;
+DROP INDEX IF EXISTS uq_reconciliations_account_in_progress;
+DROP INDEX IF EXISTS idx_reconciliations_account_statement_date;
+DROP TABLE IF EXISTS reconciliations;
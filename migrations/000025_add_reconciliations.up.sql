@@ -0,0 +1,33 @@
+-- Reconciliation sessions match an account against a bank statement's ending balance
+CREATE TABLE IF NOT EXISTS reconciliations (
+    reconciliation_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    statement_date DATE NOT NULL,
+    ending_balance NUMERIC(57, 18) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'IN_PROGRESS' CHECK (status IN ('IN_PROGRESS', 'COMPLETED')),
+    completed_at TIMESTAMPTZ,
+    completed_by VARCHAR(255) REFERENCES users(user_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliations_account_statement_date ON reconciliations(account_id, statement_date);
+
+-- Only one session per account can be open at a time
+CREATE UNIQUE INDEX IF NOT EXISTS uq_reconciliations_account_in_progress ON reconciliations(account_id) WHERE status = 'IN_PROGRESS';
+
+CREATE TRIGGER trigger_reconciliations_update_last_updated_at
+BEFORE UPDATE ON reconciliations
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Clearing status of each transaction line: ticked in an open session, or locked by a completed one
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS clearing_status VARCHAR(20) NOT NULL DEFAULT 'UNCLEARED' CHECK (clearing_status IN ('UNCLEARED', 'CLEARED', 'RECONCILED')),
+    ADD COLUMN IF NOT EXISTS reconciliation_id VARCHAR(255) REFERENCES reconciliations(reconciliation_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_reconciliation_id ON transactions(reconciliation_id);
+
+COMMENT ON COLUMN transactions.clearing_status IS 'RECONCILED lines belong to a completed reconciliation and cannot be reversed without ADMIN override.';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_journals_workplace_amount_date;
+DROP TRIGGER IF EXISTS trigger_duplicate_journal_pairs_update_last_updated_at ON duplicate_journal_pairs;
+DROP TABLE IF EXISTS duplicate_journal_pairs;
@@ -0,0 +1,27 @@
+-- Suspected duplicate journals flagged when a journal is created, pending review
+CREATE TABLE IF NOT EXISTS duplicate_journal_pairs (
+    pair_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    duplicate_of_journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    similarity DOUBLE PRECISION NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'CONFIRMED', 'DISMISSED')),
+    reversed_journal_id VARCHAR(255) REFERENCES journals(journal_id) ON DELETE SET NULL,
+    resolution_note TEXT,
+    resolved_at TIMESTAMPTZ,
+    resolved_by VARCHAR(255) REFERENCES users(user_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_duplicate_journal_pairs UNIQUE (journal_id, duplicate_of_journal_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_duplicate_journal_pairs_workplace_status ON duplicate_journal_pairs(workplace_id, status);
+
+CREATE TRIGGER trigger_duplicate_journal_pairs_update_last_updated_at
+BEFORE UPDATE ON duplicate_journal_pairs
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Duplicate detection looks up journals by amount within a date window
+CREATE INDEX IF NOT EXISTS idx_journals_workplace_amount_date ON journals(workplace_id, amount, journal_date);
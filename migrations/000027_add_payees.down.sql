@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_transactions_payee_id;
+DROP INDEX IF EXISTS idx_journals_payee_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS payee_id;
+ALTER TABLE journals DROP COLUMN IF EXISTS payee_id;
+DROP TABLE IF EXISTS payee_aliases;
+DROP TRIGGER IF EXISTS trigger_payees_update_last_updated_at ON payees;
+DROP INDEX IF EXISTS uq_payees_workplace_name;
+DROP TABLE IF EXISTS payees;
@@ -0,0 +1,36 @@
+-- Payees and counterparties of a workplace, referenced by journals and transactions
+CREATE TABLE IF NOT EXISTS payees (
+    payee_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    default_counter_account_id VARCHAR(255) REFERENCES accounts(account_id) ON DELETE SET NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_payees_workplace_name ON payees(workplace_id, lower(name));
+
+CREATE TRIGGER trigger_payees_update_last_updated_at
+BEFORE UPDATE ON payees
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Alternative spellings used to recognise a payee in free-text descriptions.
+-- normalized_alias is lowercase with collapsed whitespace and is unique within the workplace.
+CREATE TABLE IF NOT EXISTS payee_aliases (
+    payee_id VARCHAR(255) NOT NULL REFERENCES payees(payee_id) ON DELETE CASCADE,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    alias VARCHAR(255) NOT NULL,
+    normalized_alias VARCHAR(255) NOT NULL,
+    PRIMARY KEY (payee_id, normalized_alias),
+    CONSTRAINT uq_payee_aliases_workplace_alias UNIQUE (workplace_id, normalized_alias)
+);
+
+-- Payees stay referenced by history; deactivate a payee instead of deleting it once used
+ALTER TABLE journals ADD COLUMN IF NOT EXISTS payee_id VARCHAR(255) REFERENCES payees(payee_id);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS payee_id VARCHAR(255) REFERENCES payees(payee_id);
+
+CREATE INDEX IF NOT EXISTS idx_journals_payee_id ON journals(payee_id) WHERE payee_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_transactions_payee_id ON transactions(payee_id) WHERE payee_id IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS shared_expense_shares;
+DROP TRIGGER IF EXISTS trigger_shared_expenses_update_last_updated_at ON shared_expenses;
+DROP TABLE IF EXISTS shared_expenses;
+DROP INDEX IF EXISTS uq_member_accounts_workplace_user_currency;
+DROP TABLE IF EXISTS member_accounts;
@@ -0,0 +1,44 @@
+-- Receivable (ASSET) and payable (LIABILITY) sub-accounts tracking what each member owes and is owed, per currency.
+-- The row without a user holds the parent accounts the member sub-accounts are grouped under.
+CREATE TABLE IF NOT EXISTS member_accounts (
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) REFERENCES users(user_id),
+    currency_code VARCHAR(3) NOT NULL REFERENCES currencies(currency_code),
+    receivable_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    payable_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_member_accounts_workplace_user_currency ON member_accounts(workplace_id, currency_code, COALESCE(user_id, ''));
+
+-- Expense journals shared among workplace members, with the journal posting the shares to the member accounts
+CREATE TABLE IF NOT EXISTS shared_expenses (
+    shared_expense_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    paid_by_user_id VARCHAR(255) NOT NULL REFERENCES users(user_id),
+    split_method VARCHAR(20) NOT NULL CHECK (split_method IN ('EQUAL', 'PERCENTAGE', 'EXACT')),
+    currency_code VARCHAR(3) NOT NULL REFERENCES currencies(currency_code),
+    total_amount NUMERIC(57, 18) NOT NULL,
+    share_journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_shared_expenses_journal UNIQUE (journal_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shared_expenses_workplace ON shared_expenses(workplace_id, created_at);
+
+CREATE TRIGGER trigger_shared_expenses_update_last_updated_at
+BEFORE UPDATE ON shared_expenses
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS shared_expense_shares (
+    shared_expense_id VARCHAR(255) NOT NULL REFERENCES shared_expenses(shared_expense_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id),
+    share_value NUMERIC(57, 18), -- Percentage or exact amount as entered; NULL for equal splits
+    amount NUMERIC(57, 18) NOT NULL,
+    PRIMARY KEY (shared_expense_id, user_id)
+);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS security_prices;
+DROP INDEX IF EXISTS idx_transactions_security_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS unit_price;
+ALTER TABLE transactions DROP COLUMN IF EXISTS quantity;
+ALTER TABLE transactions DROP COLUMN IF EXISTS security_id;
+DROP TRIGGER IF EXISTS trigger_securities_update_last_updated_at ON securities;
+DROP INDEX IF EXISTS uq_securities_workplace_symbol;
+DROP TABLE IF EXISTS securities;
@@ -0,0 +1,38 @@
+-- Security master: stocks, funds and other instruments held in investment accounts
+CREATE TABLE IF NOT EXISTS securities (
+    security_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    symbol VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    security_type VARCHAR(20) NOT NULL CHECK (security_type IN ('STOCK', 'MUTUAL_FUND', 'ETF', 'BOND', 'CRYPTO', 'OTHER')),
+    currency_code VARCHAR(3) NOT NULL REFERENCES currencies(currency_code),
+    cost_method VARCHAR(10) NOT NULL DEFAULT 'FIFO' CHECK (cost_method IN ('FIFO', 'LIFO', 'AVERAGE')),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_securities_workplace_symbol ON securities(workplace_id, lower(symbol));
+
+CREATE TRIGGER trigger_securities_update_last_updated_at
+BEFORE UPDATE ON securities
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Investment lines record the units moved and the trade price; lots are derived from the posted lines
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS security_id VARCHAR(255) REFERENCES securities(security_id) ON DELETE RESTRICT;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS quantity NUMERIC(57, 18);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS unit_price NUMERIC(57, 18);
+
+CREATE INDEX IF NOT EXISTS idx_transactions_security_id ON transactions(security_id) WHERE security_id IS NOT NULL;
+
+-- Closing prices used to value holdings, in the currency of the security
+CREATE TABLE IF NOT EXISTS security_prices (
+    security_id VARCHAR(255) NOT NULL REFERENCES securities(security_id) ON DELETE CASCADE,
+    price_date DATE NOT NULL,
+    price NUMERIC(57, 18) NOT NULL CHECK (price > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    PRIMARY KEY (security_id, price_date)
+);
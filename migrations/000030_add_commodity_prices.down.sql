@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS security_prices (
+    security_id VARCHAR(255) NOT NULL REFERENCES securities(security_id) ON DELETE CASCADE,
+    price_date DATE NOT NULL,
+    price NUMERIC(57, 18) NOT NULL CHECK (price > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    PRIMARY KEY (security_id, price_date)
+);
+
+-- Prices are shared by symbol, so every security with the symbol and currency of a price gets it back
+INSERT INTO security_prices (security_id, price_date, price, created_at, created_by)
+SELECT s.security_id, c.price_date, c.price, c.created_at, c.created_by
+FROM commodity_prices c
+JOIN securities s ON upper(s.symbol) = c.commodity AND s.currency_code = c.quote_currency
+ON CONFLICT (security_id, price_date) DO NOTHING;
+
+DROP TABLE IF EXISTS commodity_prices;
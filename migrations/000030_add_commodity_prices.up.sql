@@ -0,0 +1,23 @@
+-- Prices of securities, crypto and other commodities over time, kept apart from currency exchange rates.
+-- Commodities are identified by their upper-case symbol and priced in a quote currency.
+CREATE TABLE IF NOT EXISTS commodity_prices (
+    commodity VARCHAR(50) NOT NULL,
+    quote_currency VARCHAR(3) NOT NULL REFERENCES currencies(currency_code),
+    price_date DATE NOT NULL,
+    price NUMERIC(57, 18) NOT NULL CHECK (price > 0),
+    source VARCHAR(50) NOT NULL DEFAULT 'MANUAL', -- MANUAL, IMPORT or the name of the price provider
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    PRIMARY KEY (commodity, quote_currency, price_date)
+);
+
+-- Security prices are now looked up by the symbol and currency of the security. Workplaces holding the same
+-- symbol may have recorded different prices for the same day; the most recently recorded price wins.
+INSERT INTO commodity_prices (commodity, quote_currency, price_date, price, source, created_at, created_by)
+SELECT DISTINCT ON (upper(s.symbol), s.currency_code, p.price_date)
+    upper(s.symbol), s.currency_code, p.price_date, p.price, 'MANUAL', p.created_at, p.created_by
+FROM security_prices p
+JOIN securities s ON s.security_id = p.security_id
+ORDER BY upper(s.symbol), s.currency_code, p.price_date, p.created_at DESC, p.security_id;
+
+DROP TABLE IF EXISTS security_prices;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_loan_payments_loan;
+DROP TABLE IF EXISTS loan_payments;
+DROP TRIGGER IF EXISTS trigger_loans_update_last_updated_at ON loans;
+DROP INDEX IF EXISTS idx_loans_workplace;
+DROP TABLE IF EXISTS loans;
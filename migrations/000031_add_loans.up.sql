@@ -0,0 +1,47 @@
+-- Loans and mortgages tracked in LIABILITY accounts. The amortization schedule is not stored; it is derived
+-- from the loan terms and the installments and prepayments posted so far.
+CREATE TABLE IF NOT EXISTS loans (
+    loan_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE, -- LIABILITY account of the loan
+    interest_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id), -- EXPENSE account charged with interest
+    name VARCHAR(255) NOT NULL,
+    principal NUMERIC(57, 18) NOT NULL CHECK (principal > 0),
+    annual_rate NUMERIC(12, 6) NOT NULL CHECK (annual_rate >= 0), -- Nominal annual interest rate in percent
+    term INTEGER NOT NULL CHECK (term > 0), -- Number of installments
+    frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('WEEKLY', 'BIWEEKLY', 'MONTHLY', 'QUARTERLY', 'SEMI_ANNUAL', 'ANNUAL')),
+    start_date DATE NOT NULL, -- Date the loan was disbursed; the first installment is due one period later
+    disbursement_journal_id VARCHAR(255) REFERENCES journals(journal_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_loans_account UNIQUE (account_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_loans_workplace ON loans(workplace_id);
+
+CREATE TRIGGER trigger_loans_update_last_updated_at
+BEFORE UPDATE ON loans
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Installments and prepayments posted against a loan. Payments whose journal has been reversed are ignored.
+CREATE TABLE IF NOT EXISTS loan_payments (
+    payment_id VARCHAR(255) PRIMARY KEY,
+    loan_id VARCHAR(255) NOT NULL REFERENCES loans(loan_id) ON DELETE CASCADE,
+    journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    payment_type VARCHAR(20) NOT NULL CHECK (payment_type IN ('INSTALLMENT', 'PREPAYMENT')),
+    installment_number INTEGER, -- Set for installments
+    prepayment_mode VARCHAR(20) CHECK (prepayment_mode IN ('REDUCE_PAYMENT', 'REDUCE_TERM')), -- Set for prepayments
+    payment_date DATE NOT NULL,
+    principal NUMERIC(57, 18) NOT NULL,
+    interest NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT chk_loan_payments_kind CHECK (
+        (payment_type = 'INSTALLMENT' AND installment_number IS NOT NULL AND prepayment_mode IS NULL) OR
+        (payment_type = 'PREPAYMENT' AND installment_number IS NULL AND prepayment_mode IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_loan_payments_loan ON loan_payments(loan_id, payment_date);
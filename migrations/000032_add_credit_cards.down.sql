@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trigger_credit_cards_update_last_updated_at ON credit_cards;
+DROP INDEX IF EXISTS idx_credit_cards_workplace;
+DROP TABLE IF EXISTS credit_cards;
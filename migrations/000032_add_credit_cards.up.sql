@@ -0,0 +1,21 @@
+-- Billing-cycle settings of credit card LIABILITY accounts. Statements are not stored; they are derived from
+-- the transactions of the account in each cycle.
+CREATE TABLE IF NOT EXISTS credit_cards (
+    account_id VARCHAR(255) PRIMARY KEY REFERENCES accounts(account_id) ON DELETE CASCADE,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    closing_day SMALLINT NOT NULL CHECK (closing_day BETWEEN 1 AND 31), -- Day of month the statement closes
+    due_day SMALLINT NOT NULL CHECK (due_day BETWEEN 1 AND 31), -- Day of month payment is due; the next month when not after the closing day
+    credit_limit NUMERIC(57, 18) NOT NULL CHECK (credit_limit >= 0),
+    minimum_payment_percent NUMERIC(7, 4) NOT NULL DEFAULT 0 CHECK (minimum_payment_percent BETWEEN 0 AND 100),
+    minimum_payment_amount NUMERIC(57, 18) NOT NULL DEFAULT 0 CHECK (minimum_payment_amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_cards_workplace ON credit_cards(workplace_id);
+
+CREATE TRIGGER trigger_credit_cards_update_last_updated_at
+BEFORE UPDATE ON credit_cards
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
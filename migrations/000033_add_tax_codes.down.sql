@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_transactions_tax_code;
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS tax_role,
+    DROP COLUMN IF EXISTS tax_code_id;
+DROP TRIGGER IF EXISTS trigger_tax_codes_update_last_updated_at ON tax_codes;
+DROP TABLE IF EXISTS tax_codes;
@@ -0,0 +1,28 @@
+-- Tax codes (VAT/GST) of a workplace. A journal line naming a tax code is split into a net line and a tax line
+-- posted to the tax account of the code.
+CREATE TABLE IF NOT EXISTS tax_codes (
+    tax_code_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate NUMERIC(7, 4) NOT NULL CHECK (rate BETWEEN 0 AND 100), -- Percent, e.g. 20 for 20%
+    tax_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id),
+    inclusive BOOLEAN NOT NULL DEFAULT FALSE, -- Amounts entered against the code already include the tax
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_tax_codes_workplace_code UNIQUE (workplace_id, code)
+);
+
+CREATE TRIGGER trigger_tax_codes_update_last_updated_at
+BEFORE UPDATE ON tax_codes
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Lines generated from a tax code carry the code and whether they hold the net amount or the tax.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS tax_code_id VARCHAR(255) REFERENCES tax_codes(tax_code_id),
+    ADD COLUMN IF NOT EXISTS tax_role VARCHAR(10) CHECK (tax_role IN ('NET', 'TAX'));
+
+CREATE INDEX IF NOT EXISTS idx_transactions_tax_code ON transactions(tax_code_id) WHERE tax_code_id IS NOT NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_invoice_payments_invoice;
+DROP TABLE IF EXISTS invoice_payments;
+DROP TABLE IF EXISTS invoice_lines;
+DROP TRIGGER IF EXISTS trigger_invoices_update_last_updated_at ON invoices;
+DROP INDEX IF EXISTS idx_invoices_customer;
+DROP INDEX IF EXISTS idx_invoices_workplace;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS invoice_sequences;
@@ -0,0 +1,68 @@
+-- Invoice numbering of a workplace. The next number is taken when an invoice is issued; drafts have no number.
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+    workplace_id VARCHAR(255) PRIMARY KEY REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    prefix VARCHAR(20) NOT NULL DEFAULT 'INV-',
+    next_number BIGINT NOT NULL DEFAULT 1 CHECK (next_number > 0),
+    padding SMALLINT NOT NULL DEFAULT 5 CHECK (padding BETWEEN 0 AND 12), -- Minimum number of digits
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+-- Sales invoices billed to a customer (a payee of the workplace). Issuing an invoice posts a journal debiting the
+-- receivable account; the status of an invoice is derived from that journal and the journals of its payments.
+CREATE TABLE IF NOT EXISTS invoices (
+    invoice_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    customer_id VARCHAR(255) NOT NULL REFERENCES payees(payee_id),
+    receivable_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id), -- ASSET account of the receivable
+    invoice_number VARCHAR(50), -- Set when the invoice is issued
+    issue_date DATE NOT NULL,
+    due_date DATE NOT NULL,
+    currency_code VARCHAR(10) NOT NULL REFERENCES currencies(currency_code),
+    notes TEXT,
+    subtotal NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    tax_total NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    total NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    issue_journal_id VARCHAR(255) REFERENCES journals(journal_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_invoices_number UNIQUE (workplace_id, invoice_number),
+    CONSTRAINT chk_invoices_due_date CHECK (due_date >= issue_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_workplace ON invoices(workplace_id, issue_date);
+CREATE INDEX IF NOT EXISTS idx_invoices_customer ON invoices(customer_id);
+
+CREATE TRIGGER trigger_invoices_update_last_updated_at
+BEFORE UPDATE ON invoices
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS invoice_lines (
+    line_id VARCHAR(255) PRIMARY KEY,
+    invoice_id VARCHAR(255) NOT NULL REFERENCES invoices(invoice_id) ON DELETE CASCADE,
+    line_number INTEGER NOT NULL,
+    description VARCHAR(500) NOT NULL,
+    quantity NUMERIC(57, 18) NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(57, 18) NOT NULL CHECK (unit_price >= 0),
+    revenue_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id),
+    tax_code_id VARCHAR(255) REFERENCES tax_codes(tax_code_id),
+    amount NUMERIC(57, 18) NOT NULL, -- Quantity times unit price; includes the tax for inclusive tax codes
+    net_amount NUMERIC(57, 18) NOT NULL,
+    tax_amount NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    CONSTRAINT uq_invoice_lines_number UNIQUE (invoice_id, line_number)
+);
+
+-- Payments received against an issued invoice. Payments whose journal has been reversed are ignored.
+CREATE TABLE IF NOT EXISTS invoice_payments (
+    payment_id VARCHAR(255) PRIMARY KEY,
+    invoice_id VARCHAR(255) NOT NULL REFERENCES invoices(invoice_id) ON DELETE CASCADE,
+    journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    payment_date DATE NOT NULL,
+    amount NUMERIC(57, 18) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_payments_invoice ON invoice_payments(invoice_id, payment_date);
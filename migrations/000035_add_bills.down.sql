@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_bill_payments_scheduled;
+DROP INDEX IF EXISTS idx_bill_payments_bill;
+DROP TABLE IF EXISTS bill_payments;
+DROP INDEX IF EXISTS idx_bill_scheduled_payments_bill;
+DROP TABLE IF EXISTS bill_scheduled_payments;
+DROP TABLE IF EXISTS bill_lines;
+DROP TRIGGER IF EXISTS trigger_bills_update_last_updated_at ON bills;
+DROP INDEX IF EXISTS idx_bills_vendor;
+DROP INDEX IF EXISTS idx_bills_workplace;
+DROP TABLE IF EXISTS bills;
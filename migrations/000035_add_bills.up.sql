@@ -0,0 +1,72 @@
+-- Vendor bills owed to a vendor (a payee of the workplace). Posting a bill credits the payable account; the status
+-- of a bill is derived from that journal and the journals of its payments.
+CREATE TABLE IF NOT EXISTS bills (
+    bill_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    vendor_id VARCHAR(255) NOT NULL REFERENCES payees(payee_id),
+    payable_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id), -- LIABILITY account of the payable
+    reference VARCHAR(100), -- The vendor's bill number
+    bill_date DATE NOT NULL,
+    due_date DATE NOT NULL,
+    currency_code VARCHAR(10) NOT NULL REFERENCES currencies(currency_code),
+    notes TEXT,
+    subtotal NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    tax_total NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    total NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    post_journal_id VARCHAR(255) REFERENCES journals(journal_id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT chk_bills_due_date CHECK (due_date >= bill_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bills_workplace ON bills(workplace_id, bill_date);
+CREATE INDEX IF NOT EXISTS idx_bills_vendor ON bills(vendor_id);
+
+CREATE TRIGGER trigger_bills_update_last_updated_at
+BEFORE UPDATE ON bills
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS bill_lines (
+    line_id VARCHAR(255) PRIMARY KEY,
+    bill_id VARCHAR(255) NOT NULL REFERENCES bills(bill_id) ON DELETE CASCADE,
+    line_number INTEGER NOT NULL,
+    description VARCHAR(500) NOT NULL,
+    expense_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id),
+    tax_code_id VARCHAR(255) REFERENCES tax_codes(tax_code_id),
+    amount NUMERIC(57, 18) NOT NULL CHECK (amount > 0), -- Includes the tax for inclusive tax codes
+    net_amount NUMERIC(57, 18) NOT NULL,
+    tax_amount NUMERIC(57, 18) NOT NULL DEFAULT 0,
+    CONSTRAINT uq_bill_lines_number UNIQUE (bill_id, line_number)
+);
+
+-- Payments planned against a posted bill. A scheduled payment is pending until a payment posted for it has a
+-- journal that is still posted.
+CREATE TABLE IF NOT EXISTS bill_scheduled_payments (
+    scheduled_payment_id VARCHAR(255) PRIMARY KEY,
+    bill_id VARCHAR(255) NOT NULL REFERENCES bills(bill_id) ON DELETE CASCADE,
+    payment_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id),
+    scheduled_date DATE NOT NULL,
+    amount NUMERIC(57, 18) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bill_scheduled_payments_bill ON bill_scheduled_payments(bill_id, scheduled_date);
+
+-- Payments made against a posted bill. Payments whose journal has been reversed are ignored.
+CREATE TABLE IF NOT EXISTS bill_payments (
+    payment_id VARCHAR(255) PRIMARY KEY,
+    bill_id VARCHAR(255) NOT NULL REFERENCES bills(bill_id) ON DELETE CASCADE,
+    journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    payment_account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id),
+    scheduled_payment_id VARCHAR(255) REFERENCES bill_scheduled_payments(scheduled_payment_id) ON DELETE SET NULL,
+    payment_date DATE NOT NULL,
+    amount NUMERIC(57, 18) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bill_payments_bill ON bill_payments(bill_id, payment_date);
+CREATE INDEX IF NOT EXISTS idx_bill_payments_scheduled ON bill_payments(scheduled_payment_id);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS transaction_dimensions;
+DROP TABLE IF EXISTS journal_dimensions;
+DROP TRIGGER IF EXISTS trigger_dimension_values_update_last_updated_at ON dimension_values;
+DROP TABLE IF EXISTS dimension_values;
+DROP TRIGGER IF EXISTS trigger_dimensions_update_last_updated_at ON dimensions;
+DROP TABLE IF EXISTS dimensions;
@@ -0,0 +1,59 @@
+-- Analytic dimensions of a workplace (e.g. projects, cost centers, tags), each with its own set of values.
+CREATE TABLE IF NOT EXISTS dimensions (
+    dimension_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_dimensions_workplace_code UNIQUE (workplace_id, code)
+);
+
+CREATE TRIGGER trigger_dimensions_update_last_updated_at
+BEFORE UPDATE ON dimensions
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS dimension_values (
+    value_id VARCHAR(255) PRIMARY KEY,
+    dimension_id VARCHAR(255) NOT NULL REFERENCES dimensions(dimension_id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_dimension_values_dimension_code UNIQUE (dimension_id, code),
+    CONSTRAINT uq_dimension_values_value_dimension UNIQUE (value_id, dimension_id) -- Target of the tag foreign keys
+);
+
+CREATE TRIGGER trigger_dimension_values_update_last_updated_at
+BEFORE UPDATE ON dimension_values
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+-- Values a journal assigns to all of its lines. A line's own value for a dimension takes precedence.
+-- Values in use cannot be deleted; deactivate them instead.
+CREATE TABLE IF NOT EXISTS journal_dimensions (
+    journal_id VARCHAR(255) NOT NULL REFERENCES journals(journal_id) ON DELETE CASCADE,
+    dimension_id VARCHAR(255) NOT NULL,
+    value_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (journal_id, dimension_id),
+    FOREIGN KEY (value_id, dimension_id) REFERENCES dimension_values(value_id, dimension_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_dimensions_value ON journal_dimensions(value_id);
+
+-- Values assigned to individual transaction lines, at most one per dimension
+CREATE TABLE IF NOT EXISTS transaction_dimensions (
+    transaction_id VARCHAR(255) NOT NULL REFERENCES transactions(transaction_id) ON DELETE CASCADE,
+    dimension_id VARCHAR(255) NOT NULL,
+    value_id VARCHAR(255) NOT NULL,
+    PRIMARY KEY (transaction_id, dimension_id),
+    FOREIGN KEY (value_id, dimension_id) REFERENCES dimension_values(value_id, dimension_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_dimensions_value ON transaction_dimensions(value_id);
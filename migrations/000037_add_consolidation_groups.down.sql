@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS consolidation_account_mappings;
+DROP TABLE IF EXISTS consolidation_group_members;
+DROP TRIGGER IF EXISTS trigger_consolidation_groups_update_last_updated_at ON consolidation_groups;
+DROP TABLE IF EXISTS consolidation_groups;
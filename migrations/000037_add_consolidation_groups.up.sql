@@ -0,0 +1,37 @@
+-- Consolidation groups report the combined books of several workplaces (one per entity) in a group currency.
+-- A group is managed from its home workplace, which does not have to be a member itself.
+CREATE TABLE IF NOT EXISTS consolidation_groups (
+    group_id VARCHAR(255) PRIMARY KEY,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE, -- Home workplace
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    currency_code VARCHAR(10) NOT NULL REFERENCES currencies(currency_code), -- Group (presentation) currency
+    created_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    created_by VARCHAR(255) REFERENCES users(user_id),
+    last_updated_at TIMESTAMPTZ NOT NULL DEFAULT timezone('utc', now()),
+    last_updated_by VARCHAR(255) REFERENCES users(user_id),
+    CONSTRAINT uq_consolidation_groups_workplace_name UNIQUE (workplace_id, name)
+);
+
+CREATE TRIGGER trigger_consolidation_groups_update_last_updated_at
+BEFORE UPDATE ON consolidation_groups
+FOR EACH ROW EXECUTE FUNCTION update_last_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS consolidation_group_members (
+    group_id VARCHAR(255) NOT NULL REFERENCES consolidation_groups(group_id) ON DELETE CASCADE,
+    workplace_id VARCHAR(255) NOT NULL REFERENCES workplaces(workplace_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL, -- Order of the entities in the reports
+    PRIMARY KEY (group_id, workplace_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_consolidation_group_members_workplace ON consolidation_group_members(workplace_id);
+
+-- Per-account settings of a group. Accounts are matched across workplaces by group code when one is set,
+-- otherwise by CFID. Intercompany accounts are eliminated from the consolidated figures.
+CREATE TABLE IF NOT EXISTS consolidation_account_mappings (
+    group_id VARCHAR(255) NOT NULL REFERENCES consolidation_groups(group_id) ON DELETE CASCADE,
+    account_id VARCHAR(255) NOT NULL REFERENCES accounts(account_id) ON DELETE CASCADE,
+    group_code VARCHAR(50),
+    intercompany BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (group_id, account_id)
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS uq_journals_twin_journal_id;
+ALTER TABLE journals DROP CONSTRAINT IF EXISTS fk_twin_journal;
+ALTER TABLE journals DROP COLUMN IF EXISTS twin_journal_id;
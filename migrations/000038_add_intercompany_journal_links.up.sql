@@ -0,0 +1,13 @@
+-- Intercompany postings write mirrored journals in two workplaces; each journal links to its twin.
+-- DEFERRABLE INITIALLY DEFERRED lets both journals be inserted in one transaction before the check.
+ALTER TABLE journals
+ADD COLUMN IF NOT EXISTS twin_journal_id TEXT NULL;
+
+ALTER TABLE journals
+ADD CONSTRAINT fk_twin_journal
+FOREIGN KEY (twin_journal_id)
+REFERENCES journals(journal_id)
+ON DELETE SET NULL
+DEFERRABLE INITIALLY DEFERRED;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_journals_twin_journal_id ON journals (twin_journal_id) WHERE twin_journal_id IS NOT NULL;